@@ -9,7 +9,7 @@ import (
 
 var (
 	usageLine = fmt.Sprintf("Usage:\n"+
-		"%s\n%s\n%s\n%s\n", runLine, stopLine, mckLine, serverTypeLine)
+		"%s\n%s\n%s\n%s\n%s\n", runLine, stopLine, mckLine, metaLine, serverTypeLine)
 
 	serverTypeLine = `
 [server type]
@@ -62,5 +62,26 @@ milvus mck run [flags]
 milvus mck cleanTrash [flags]
 	Clean the back inconsistent data
 	Tips: The flags is the same as its of the 'milvus mck [flags]'
+`
+	metaLine = `
+milvus meta snapshot [flags]
+	Dump the rootcoord, datacoord, indexcoord and querycoord meta at a single etcd revision, only etcd metastore is supported.
+[flags]
+	-file ''
+		The snapshot file to write.
+	-components 'rootcoord,datacoord,indexcoord,querycoord'
+		The components to dump.
+
+milvus meta restore [flags]
+	Restore the meta from a snapshot, all the components must be stopped, only etcd metastore is supported.
+[flags]
+	-file ''
+		The snapshot file to read.
+	-overwrite 'false'
+		Remove the existing meta of the components before restore, the existing meta is dumped to the backup file first.
+	-backupFile '<file>.bak'
+		The file to dump the existing meta to when overwriting, it must not exist.
+	-skipFileCheck 'false'
+		Skip checking the binlog and index files referenced by the snapshot exist.
 `
 )
@@ -0,0 +1,146 @@
+package milvus
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"strings"
+
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/cmd/tools/migration/backend"
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/internal/util"
+	"github.com/milvus-io/milvus/internal/util/etcd"
+	"github.com/milvus-io/milvus/internal/util/paramtable"
+	"github.com/milvus-io/milvus/internal/util/tsoutil"
+)
+
+const (
+	MetaCmd          = "meta"
+	MetaTypeSnapshot = "snapshot"
+	MetaTypeRestore  = "restore"
+)
+
+type meta struct {
+	params paramtable.ComponentParam
+
+	file          string
+	components    string
+	overwrite     bool
+	backupFile    string
+	skipFileCheck bool
+}
+
+func (c *meta) execute(args []string, flags *flag.FlagSet) {
+	if len(args) < 3 {
+		fmt.Fprintln(os.Stderr, metaLine)
+		return
+	}
+	flags.Usage = func() {
+		fmt.Fprintln(os.Stderr, metaLine)
+	}
+	c.formatFlags(args, flags)
+	if c.file == "" {
+		fmt.Fprintln(os.Stderr, metaLine)
+		return
+	}
+	c.params.Init()
+	if c.params.MetaStoreCfg.MetaStoreType != util.MetaStoreTypeEtcd {
+		log.Fatal("meta snapshot and restore only support etcd metastore", zap.String("metastore.type", c.params.MetaStoreCfg.MetaStoreType))
+	}
+
+	switch args[2] {
+	case MetaTypeSnapshot:
+		c.snapshot()
+	case MetaTypeRestore:
+		c.restore()
+	default:
+		fmt.Fprintln(os.Stderr, metaLine)
+	}
+}
+
+func (c *meta) formatFlags(args []string, flags *flag.FlagSet) {
+	flags.StringVar(&c.file, "file", "", "Snapshot file to write or read")
+	flags.StringVar(&c.components, "components", strings.Join(backend.MetaSnapshotAllComponents(), ","), "Components to snapshot")
+	flags.BoolVar(&c.overwrite, "overwrite", false, "Remove the existing meta of the components before restore")
+	flags.StringVar(&c.backupFile, "backupFile", "", "File to dump the existing meta to when overwriting, <file>.bak by default")
+	flags.BoolVar(&c.skipFileCheck, "skipFileCheck", false, "Restore without checking the referenced files")
+
+	if err := flags.Parse(args[3:]); err != nil {
+		log.Fatal("failed to parse flags", zap.Error(err))
+	}
+	if c.backupFile == "" && c.file != "" {
+		c.backupFile = c.file + ".bak"
+	}
+}
+
+func (c *meta) newSnapshotter() *backend.MetaSnapshotter {
+	etcdCli, err := etcd.GetEtcdClient(&c.params.EtcdCfg)
+	if err != nil {
+		log.Fatal("failed to connect to etcd", zap.Error(err))
+	}
+	return backend.NewMetaSnapshotter(etcdCli, c.params.EtcdCfg.MetaRootPath, c.params.EtcdCfg.KvRootPath)
+}
+
+func (c *meta) snapshot() {
+	ctx := context.Background()
+	file, err := c.newSnapshotter().Snapshot(ctx, strings.Split(c.components, ","))
+	if err != nil {
+		log.Fatal("failed to snapshot meta", zap.Error(err))
+	}
+	if err := ioutil.WriteFile(c.file, file, 0600); err != nil {
+		log.Fatal("failed to write snapshot", zap.String("file", c.file), zap.Error(err))
+	}
+	header, extra, _, err := backend.LoadMetaSnapshot(file)
+	if err != nil {
+		log.Fatal("failed to verify snapshot", zap.String("file", c.file), zap.Error(err))
+	}
+	c.printHeader(header, extra)
+}
+
+func (c *meta) restore() {
+	ctx := context.Background()
+	file, err := ioutil.ReadFile(c.file)
+	if err != nil {
+		log.Fatal("failed to read snapshot", zap.String("file", c.file), zap.Error(err))
+	}
+	header, extra, kvs, err := backend.LoadMetaSnapshot(file)
+	if err != nil {
+		log.Fatal("failed to load snapshot", zap.String("file", c.file), zap.Error(err))
+	}
+	c.printHeader(header, extra)
+
+	if !c.skipFileCheck {
+		chunkManager, err := storage.NewChunkManagerFactoryWithParam(&c.params).NewPersistentStorageChunkManager(ctx)
+		if err != nil {
+			log.Fatal("failed to connect to storage", zap.Error(err))
+		}
+		missing, err := backend.CheckReferencedFiles(ctx, chunkManager, kvs)
+		if err != nil {
+			log.Fatal("failed to check referenced files", zap.Error(err))
+		}
+		if len(missing) > 0 {
+			for _, file := range missing {
+				fmt.Printf("missing file: %s\n", file)
+			}
+			log.Fatal("files referenced by the snapshot are missing", zap.Int("num", len(missing)))
+		}
+	}
+
+	if err := c.newSnapshotter().Restore(ctx, header, extra, kvs, c.overwrite, c.backupFile); err != nil {
+		log.Fatal("failed to restore meta", zap.String("backup", c.backupFile), zap.Error(err))
+	}
+	fmt.Printf("%d entries restored\n", len(kvs))
+}
+
+func (c *meta) printHeader(header *backend.BackupHeader, extra *backend.BackupHeaderExtra) {
+	physical, _ := tsoutil.ParseTS(extra.SnapshotTs)
+	fmt.Printf("Instance: %s\t\tMeta Path: %s\n", header.Instance, header.MetaPath)
+	fmt.Printf("Components: %s\n", header.Component)
+	fmt.Printf("Snapshot Ts Upper Bound: %d (%s)\n", extra.SnapshotTs, physical.Format("2006-01-02 15:04:05.999 -0700"))
+	fmt.Printf("Entries: %d\t\tChecksum: %s\n", header.Entries, extra.Checksum)
+}
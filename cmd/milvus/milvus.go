@@ -53,6 +53,8 @@ func RunMilvus(args []string) {
 		c = &dryRun{}
 	case MckCmd:
 		c = &mck{}
+	case MetaCmd:
+		c = &meta{}
 	default:
 		c = &defaultCommand{}
 	}
@@ -56,6 +56,8 @@ func init() {
 	Registry.MustRegister(prometheus.NewProcessCollector(prometheus.ProcessCollectorOpts{}))
 	Registry.MustRegister(prometheus.NewGoCollector())
 	metrics.RegisterEtcdMetrics(Registry)
+	metrics.RegisterStorageMetrics(Registry)
+	metrics.RegisterMsgStreamMetrics(Registry)
 }
 
 func stopRocksmq() {
@@ -219,6 +221,10 @@ func (mr *MilvusRoles) Run(local bool, alias string) {
 				panic(err)
 			}
 			defer stopRocksmq()
+			management.Register(&management.HTTPHandler{
+				Path:        management.RocksmqStatsRouterPath,
+				HandlerFunc: rocksmqimpl.StatsHandler,
+			})
 		}
 
 		if params.EtcdCfg.UseEmbedEtcd {
@@ -308,6 +314,10 @@ func (mr *MilvusRoles) Run(local bool, alias string) {
 	mr.setupLogger()
 
 	metrics.Register(Registry)
+	management.Register(&management.HTTPHandler{
+		Path:        management.ConfigRouterPath,
+		HandlerFunc: paramtable.Get().ConfigHandler,
+	})
 	management.ServeHTTP()
 	sc := make(chan os.Signal, 1)
 	signal.Notify(sc,
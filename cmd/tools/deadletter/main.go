@@ -0,0 +1,101 @@
+// deadletter prints the messages skipped by msgstream consumers, recorded with the file or topic dead letter policy.
+//
+// With -replay the raw payload of the dead letters is produced to the channel named by -replayTo. The messages keep
+// their original timestamps, which are behind the time ticks of the channels they were skipped from, so they can't be
+// replayed to those channels: consumers would take them out of order. Replay them to a separate channel, and consume
+// that channel to inspect or repair the messages.
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/mq/msgstream"
+	"github.com/milvus-io/milvus/internal/util/dependency"
+	"github.com/milvus-io/milvus/internal/util/paramtable"
+)
+
+var (
+	filePath   = flag.String("file", "", "Dead letter file to read, recorded with the file policy")
+	topic      = flag.String("topic", "", "Dead letter topic to read, recorded with the topic policy")
+	standalone = flag.Bool("standalone", false, "Use the local rocksmq of a stopped standalone instead of the remote mq")
+	waitTime   = flag.Duration("wait", 5*time.Second, "Stop reading the dead letter topic when no message arrives in this duration")
+	channel    = flag.String("channel", "", "Channel name to filter with")
+	replay     = flag.Bool("replay", false, "Produce the raw payload of the dead letters to the channel set by -replayTo")
+	replayTo   = flag.String("replayTo", "", "Channel to replay the dead letters to, must not be one of their own channels")
+)
+
+func main() {
+	flag.Parse()
+	if (*filePath == "") == (*topic == "") {
+		log.Fatal("exactly one of -file and -topic must be set")
+	}
+	if *replay && *replayTo == "" {
+		log.Fatal("-replayTo must be set to replay dead letters")
+	}
+
+	ctx := context.Background()
+	var factory dependency.Factory
+	if *topic != "" || *replay {
+		var params paramtable.ComponentParam
+		params.Init()
+		factory = dependency.NewFactory(*standalone)
+		factory.Init(&params)
+	}
+
+	var letters []*msgstream.DeadLetter
+	var err error
+	if *filePath != "" {
+		letters, err = msgstream.ReadDeadLettersFromFile(*filePath)
+	} else {
+		letters, err = msgstream.ReadDeadLettersFromTopic(ctx, factory, *topic, *waitTime)
+	}
+	if err != nil {
+		log.Fatal("failed to read dead letters", zap.Error(err))
+	}
+
+	filtered := make([]*msgstream.DeadLetter, 0, len(letters))
+	for _, letter := range letters {
+		if *channel != "" && letter.Channel != *channel {
+			continue
+		}
+		filtered = append(filtered, letter)
+		printDeadLetter(letter)
+	}
+	fmt.Printf("%d dead letters\n", len(filtered))
+
+	if !*replay {
+		return
+	}
+	if err := msgstream.ReplayDeadLetters(ctx, factory, filtered, *replayTo); err != nil {
+		log.Fatal("failed to replay dead letters", zap.Error(err))
+	}
+	fmt.Printf("%d dead letters replayed\n", len(filtered))
+}
+
+const (
+	tsPrintFormat = "2006-01-02 15:04:05.999 -0700"
+)
+
+func printDeadLetter(letter *msgstream.DeadLetter) {
+	fmt.Println("================================================================================")
+	fmt.Printf("Channel: %s\t\tMsg Group: %s\n", letter.Channel, letter.MsgGroup)
+	fmt.Printf("Msg ID: %v\n", letter.MsgID)
+	fmt.Printf("Record Time: %s\n", letter.RecordTime.Format(tsPrintFormat))
+	fmt.Printf("Reason: %s\n", letter.Reason)
+	fmt.Printf("Payload Size: %d\n", len(letter.Payload))
+	header := commonpb.MsgHeader{}
+	if err := proto.Unmarshal(letter.Payload, &header); err == nil && header.GetBase() != nil {
+		fmt.Printf("Msg Type: %s\t\tMsg ID: %d\t\tTimestamp: %d\n", header.GetBase().GetMsgType().String(), header.GetBase().GetMsgID(), header.GetBase().GetTimestamp())
+	}
+	if len(letter.Properties) > 0 {
+		fmt.Printf("Properties: %v\n", letter.Properties)
+	}
+}
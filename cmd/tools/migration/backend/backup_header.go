@@ -12,6 +12,8 @@ type BackupHeaderVersion int32
 
 const (
 	BackupHeaderVersionV1 BackupHeaderVersion = iota
+	// BackupHeaderVersionV2 carries the checksum of the entries in the extra
+	BackupHeaderVersionV2
 )
 
 // BackupHeader stores etcd backup header information
@@ -42,6 +44,12 @@ func (v *BackupHeader) ProtoMessage() {}
 
 type BackupHeaderExtra struct {
 	EntryIncludeRootPath bool `json:"entry_include_root_path"`
+	// Checksum is the hex encoded sha256 of the sorted entries
+	Checksum string `json:"checksum,omitempty"`
+	// SnapshotTs is the TSO high-water mark persisted at the snapshot revision, an upper bound of the timestamps of the entries
+	SnapshotTs uint64 `json:"snapshot_ts,omitempty"`
+	// Allocators records the persisted allocator states, keyed by the path under the kv root path
+	Allocators map[string][]byte `json:"allocators,omitempty"`
 }
 
 type extraOption func(extra *BackupHeaderExtra)
@@ -52,6 +60,24 @@ func setEntryIncludeRootPath(include bool) extraOption {
 	}
 }
 
+func setChecksum(checksum string) extraOption {
+	return func(extra *BackupHeaderExtra) {
+		extra.Checksum = checksum
+	}
+}
+
+func setSnapshotTs(ts uint64) extraOption {
+	return func(extra *BackupHeaderExtra) {
+		extra.SnapshotTs = ts
+	}
+}
+
+func setAllocators(allocators map[string][]byte) extraOption {
+	return func(extra *BackupHeaderExtra) {
+		extra.Allocators = allocators
+	}
+}
+
 func newDefaultBackupHeaderExtra() *BackupHeaderExtra {
 	return &BackupHeaderExtra{EntryIncludeRootPath: false}
 }
@@ -0,0 +1,470 @@
+package backend
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"os"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/golang/protobuf/proto"
+	clientv3 "go.etcd.io/etcd/client/v3"
+
+	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+	"github.com/milvus-io/milvus/cmd/tools/migration/console"
+	"github.com/milvus-io/milvus/internal/metastore/kv/querycoord"
+	"github.com/milvus-io/milvus/internal/metastore/kv/rootcoord"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/proto/indexpb"
+	"github.com/milvus-io/milvus/internal/util"
+	"github.com/milvus-io/milvus/internal/util/etcd"
+	"github.com/milvus-io/milvus/internal/util/metautil"
+	"github.com/milvus-io/milvus/internal/util/sessionutil"
+	"github.com/milvus-io/milvus/internal/util/tsoutil"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
+)
+
+const (
+	// datacoord catalog prefixes, see internal/metastore/kv/datacoord which links the cgo storage
+	datacoordMetaPrefix      = "datacoord-meta"
+	datacoordSegmentPrefix   = datacoordMetaPrefix + "/s"
+	datacoordBinlogPrefix    = datacoordMetaPrefix + "/binlog"
+	datacoordDeltalogPrefix  = datacoordMetaPrefix + "/deltalog"
+	datacoordStatslogPrefix  = datacoordMetaPrefix + "/statslog"
+	tsoAllocatorKey          = "tso/timestamp"
+	idAllocatorKey           = "gid/idTimestamp"
+	metaSnapshotPageSize     = 1000
+	metaSnapshotComponentSep = ","
+)
+
+// MetaSnapshotComponents maps the coordinators to the prefixes of their catalogs under the meta root path.
+var MetaSnapshotComponents = map[string][]string{
+	typeutil.RootCoordRole: {
+		rootcoord.ComponentPrefix,
+		path.Join(rootcoord.SnapshotPrefix, rootcoord.ComponentPrefix),
+	},
+	typeutil.DataCoordRole: {
+		datacoordMetaPrefix,
+		util.FlushedSegmentPrefix,
+	},
+	typeutil.IndexCoordRole: {
+		util.FieldIndexPrefix,
+		util.SegmentIndexPrefix,
+	},
+	typeutil.QueryCoordRole: {
+		querycoord.CollectionLoadInfoPrefix,
+		querycoord.PartitionLoadInfoPrefix,
+		querycoord.ReplicaPrefix,
+		util.HandoffSegmentPrefix,
+	},
+}
+
+// MetaSnapshotAllComponents returns all the components supported by the metadata snapshot.
+func MetaSnapshotAllComponents() []string {
+	return []string{typeutil.RootCoordRole, typeutil.DataCoordRole, typeutil.IndexCoordRole, typeutil.QueryCoordRole}
+}
+
+// FileChecker is the part of storage.ChunkManager used to validate the files referenced by a snapshot.
+type FileChecker interface {
+	RootPath() string
+	Exist(ctx context.Context, filePath string) (bool, error)
+}
+
+// MetaSnapshotter dumps the coordinator catalogs in etcd to a backup file and restores them.
+type MetaSnapshotter struct {
+	etcdCli      *clientv3.Client
+	metaRootPath string
+	kvRootPath   string
+}
+
+// NewMetaSnapshotter creates a MetaSnapshotter.
+func NewMetaSnapshotter(etcdCli *clientv3.Client, metaRootPath, kvRootPath string) *MetaSnapshotter {
+	return &MetaSnapshotter{
+		etcdCli:      etcdCli,
+		metaRootPath: metaRootPath,
+		kvRootPath:   kvRootPath,
+	}
+}
+
+func componentPrefixes(components []string) ([]string, error) {
+	var prefixes []string
+	for _, component := range components {
+		p, ok := MetaSnapshotComponents[component]
+		if !ok {
+			return nil, fmt.Errorf("unknown component: %s", component)
+		}
+		prefixes = append(prefixes, p...)
+	}
+	return prefixes, nil
+}
+
+func (s *MetaSnapshotter) metaKey(key string) string {
+	return path.Join(s.metaRootPath, key)
+}
+
+// loadWithPrefix loads the keys under prefix at the revision page by page, the keys are relative to the meta root path.
+func (s *MetaSnapshotter) loadWithPrefix(ctx context.Context, prefix string, rev int64, kvs map[string]string) error {
+	fullPrefix := s.metaKey(prefix) + "/"
+	end := clientv3.GetPrefixRangeEnd(fullPrefix)
+	key := fullPrefix
+	for {
+		resp, err := s.etcdCli.Get(ctx, key, clientv3.WithRange(end), clientv3.WithRev(rev), clientv3.WithLimit(metaSnapshotPageSize))
+		if err != nil {
+			return err
+		}
+		for _, kv := range resp.Kvs {
+			key = string(append(kv.Key, 0))
+			if kv.Lease != 0 {
+				continue
+			}
+			kvs[strings.TrimPrefix(string(kv.Key), s.metaRootPath+"/")] = string(kv.Value)
+		}
+		if !resp.More {
+			return nil
+		}
+	}
+}
+
+// Snapshot dumps the catalogs of the components at a single etcd revision. The TSO allocator persists a
+// high-water mark ahead of the timestamps it allocates, the mark at the revision is recorded as the snapshot
+// timestamp, which is an upper bound of the timestamps of the meta changes included: meta changes timestamped
+// after it are never included, while those timestamped shortly before it may be missing.
+func (s *MetaSnapshotter) Snapshot(ctx context.Context, components []string) (BackupFile, error) {
+	prefixes, err := componentPrefixes(components)
+	if err != nil {
+		return nil, err
+	}
+
+	allocators := make(map[string][]byte)
+	var rev int64
+	for _, key := range []string{tsoAllocatorKey, idAllocatorKey} {
+		opts := make([]clientv3.OpOption, 0, 1)
+		if rev != 0 {
+			opts = append(opts, clientv3.WithRev(rev))
+		}
+		resp, err := s.etcdCli.Get(ctx, path.Join(s.kvRootPath, key), opts...)
+		if err != nil {
+			return nil, err
+		}
+		rev = resp.Header.Revision
+		if len(resp.Kvs) > 0 {
+			allocators[key] = resp.Kvs[0].Value
+		}
+	}
+	tsoValue, ok := allocators[tsoAllocatorKey]
+	if !ok {
+		return nil, fmt.Errorf("timestamp not found under %s", path.Join(s.kvRootPath, tsoAllocatorKey))
+	}
+	physical, err := typeutil.ParseTimestamp(tsoValue)
+	if err != nil {
+		return nil, err
+	}
+
+	kvs := make(map[string]string)
+	for _, prefix := range prefixes {
+		if err := s.loadWithPrefix(ctx, prefix, rev, kvs); err != nil {
+			return nil, err
+		}
+	}
+
+	instance, metaPath := splitMetaRootPath(s.metaRootPath)
+	header := &BackupHeader{
+		Version:   BackupHeaderVersionV2,
+		Instance:  instance,
+		MetaPath:  metaPath,
+		Component: strings.Join(components, metaSnapshotComponentSep),
+		Extra: newBackupHeaderExtra(
+			setEntryIncludeRootPath(false),
+			setChecksum(ChecksumEntries(kvs)),
+			setSnapshotTs(tsoutil.ComposeTSByTime(physical, 0)),
+			setAllocators(allocators),
+		).ToJSONBytes(),
+	}
+	return NewBackupCodec().Serialize(header, kvs)
+}
+
+// LoadMetaSnapshot decodes a snapshot file and verifies the checksum of its entries.
+func LoadMetaSnapshot(file BackupFile) (*BackupHeader, *BackupHeaderExtra, map[string]string, error) {
+	header, kvs, err := NewBackupCodec().DeSerialize(file)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	if header.Version < BackupHeaderVersionV2 {
+		return nil, nil, nil, fmt.Errorf("backup version %d has no checksum, not a metadata snapshot", header.Version)
+	}
+	if header.Entries != int64(len(kvs)) {
+		return nil, nil, nil, fmt.Errorf("backup entries mismatch, header: %d, actual: %d", header.Entries, len(kvs))
+	}
+	extra := GetExtra(header.Extra)
+	if checksum := ChecksumEntries(kvs); checksum != extra.Checksum {
+		return nil, nil, nil, fmt.Errorf("backup checksum mismatch, header: %s, actual: %s", extra.Checksum, checksum)
+	}
+	return header, extra, kvs, nil
+}
+
+// Restore writes the snapshot entries back to etcd. All the coordinators must be stopped, and the catalogs
+// of the snapshot components must be empty unless overwrite is set, in which case the existing catalogs are
+// dumped to backupFile before they are removed, so they can be restored again if the restore fails halfway.
+// The allocators never go backwards, so timestamps and ids allocated after the snapshot are not reused.
+func (s *MetaSnapshotter) Restore(ctx context.Context, header *BackupHeader, extra *BackupHeaderExtra, kvs map[string]string, overwrite bool, backupFile string) error {
+	resp, err := s.etcdCli.Get(ctx, s.metaKey(sessionutil.DefaultServiceRoot)+"/", clientv3.WithPrefix(), clientv3.WithCountOnly())
+	if err != nil {
+		return err
+	}
+	if resp.Count > 0 {
+		return fmt.Errorf("there are still sessions alive, num of alive sessions: %d", resp.Count)
+	}
+
+	components := strings.Split(header.Component, metaSnapshotComponentSep)
+	prefixes, err := componentPrefixes(components)
+	if err != nil {
+		return err
+	}
+	var existPrefixes []string
+	for _, prefix := range prefixes {
+		fullPrefix := s.metaKey(prefix) + "/"
+		resp, err := s.etcdCli.Get(ctx, fullPrefix, clientv3.WithPrefix(), clientv3.WithCountOnly())
+		if err != nil {
+			return err
+		}
+		if resp.Count == 0 {
+			continue
+		}
+		if !overwrite {
+			return fmt.Errorf("meta already exists under %s, num of keys: %d", fullPrefix, resp.Count)
+		}
+		existPrefixes = append(existPrefixes, fullPrefix)
+	}
+
+	if len(existPrefixes) > 0 {
+		if err := s.backup(ctx, components, backupFile); err != nil {
+			return fmt.Errorf("failed to backup the existing meta before overwriting: %w", err)
+		}
+		for _, fullPrefix := range existPrefixes {
+			if _, err := s.etcdCli.Delete(ctx, fullPrefix, clientv3.WithPrefix()); err != nil {
+				return err
+			}
+		}
+	}
+
+	err = etcd.SaveByBatch(kvs, func(partialKvs map[string]string) error {
+		ops := make([]clientv3.Op, 0, len(partialKvs))
+		for k, v := range partialKvs {
+			ops = append(ops, clientv3.OpPut(s.metaKey(k), v))
+		}
+		_, err := s.etcdCli.Txn(ctx).If().Then(ops...).Commit()
+		return err
+	})
+	if err != nil {
+		return err
+	}
+
+	for key, value := range extra.Allocators {
+		fullKey := path.Join(s.kvRootPath, key)
+		// the allocators persist big endian integers, so byte order is numeric order
+		put := clientv3.OpPut(fullKey, string(value))
+		_, err := s.etcdCli.Txn(ctx).
+			If(clientv3.Compare(clientv3.CreateRevision(fullKey), "=", 0)).
+			Then(put).
+			Else(clientv3.OpTxn([]clientv3.Cmp{clientv3.Compare(clientv3.Value(fullKey), "<", string(value))}, []clientv3.Op{put}, nil)).
+			Commit()
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// backup dumps the existing meta of the components to backupFile, an existing file is never replaced,
+// since it may be the backup of a previous restore which failed halfway.
+func (s *MetaSnapshotter) backup(ctx context.Context, components []string, backupFile string) error {
+	if backupFile == "" {
+		return errors.New("backup file is not specified")
+	}
+	file, err := s.Snapshot(ctx, components)
+	if err != nil {
+		return err
+	}
+	f, err := os.OpenFile(backupFile, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0600)
+	if err != nil {
+		return err
+	}
+	if _, err := f.Write(file); err != nil {
+		f.Close()
+		return err
+	}
+	if err := f.Sync(); err != nil {
+		f.Close()
+		return err
+	}
+	if err := f.Close(); err != nil {
+		return err
+	}
+	console.Warning(fmt.Sprintf("backup to: %s", backupFile))
+	return nil
+}
+
+func splitMetaRootPath(metaRootPath string) (instance, metaPath string) {
+	parts := strings.Split(metaRootPath, "/")
+	if len(parts) > 1 {
+		return path.Join(parts[:len(parts)-1]...), parts[len(parts)-1]
+	}
+	return metaRootPath, ""
+}
+
+// ChecksumEntries returns the hex encoded sha256 of the entries in key order.
+func ChecksumEntries(kvs map[string]string) string {
+	keys := make([]string, 0, len(kvs))
+	for k := range kvs {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	h := sha256.New()
+	lengthBytes := make([]byte, 8)
+	for _, k := range keys {
+		for _, s := range []string{k, kvs[k]} {
+			binary.LittleEndian.PutUint64(lengthBytes, uint64(len(s)))
+			h.Write(lengthBytes)
+			h.Write([]byte(s))
+		}
+	}
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// parseSegmentKey parses collection, partition and segment ids from keys like prefix/collID/partID/segID[/fieldID].
+func parseSegmentKey(key, prefix string) (collID, partID, segID, fieldID typeutil.UniqueID, err error) {
+	parts := strings.Split(strings.TrimPrefix(key, prefix+"/"), "/")
+	if len(parts) < 3 {
+		return 0, 0, 0, 0, fmt.Errorf("invalid segment key: %s", key)
+	}
+	ids := make([]typeutil.UniqueID, 4)
+	for i := 0; i < len(parts) && i < len(ids); i++ {
+		ids[i], err = strconv.ParseInt(parts[i], 10, 64)
+		if err != nil {
+			return 0, 0, 0, 0, fmt.Errorf("invalid segment key: %s", key)
+		}
+	}
+	return ids[0], ids[1], ids[2], ids[3], nil
+}
+
+// ReferencedFiles returns the binlog and index files referenced by the segments not dropped in the snapshot entries.
+func ReferencedFiles(kvs map[string]string, rootPath string) ([]string, error) {
+	alive := make(map[typeutil.UniqueID]bool)
+	files := make(map[string]struct{})
+	addLogs := func(fieldBinlogs []*datapb.FieldBinlog, buildPath func(fieldID, logID typeutil.UniqueID) string) {
+		for _, fieldBinlog := range fieldBinlogs {
+			for _, binlog := range fieldBinlog.GetBinlogs() {
+				if binlog.GetLogPath() != "" {
+					files[binlog.GetLogPath()] = struct{}{}
+				} else {
+					files[buildPath(fieldBinlog.GetFieldID(), binlog.GetLogID())] = struct{}{}
+				}
+			}
+		}
+	}
+
+	for key, value := range kvs {
+		if !strings.HasPrefix(key, datacoordSegmentPrefix+"/") {
+			continue
+		}
+		segment := &datapb.SegmentInfo{}
+		if err := proto.Unmarshal([]byte(value), segment); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal segment %s: %w", key, err)
+		}
+		if segment.GetState() == commonpb.SegmentState_Dropped || segment.GetState() == commonpb.SegmentState_NotExist {
+			continue
+		}
+		alive[segment.GetID()] = true
+		collID, partID, segID := segment.GetCollectionID(), segment.GetPartitionID(), segment.GetID()
+		addLogs(segment.GetBinlogs(), func(fieldID, logID typeutil.UniqueID) string {
+			return metautil.BuildInsertLogPath(rootPath, collID, partID, segID, fieldID, logID)
+		})
+		addLogs(segment.GetDeltalogs(), func(fieldID, logID typeutil.UniqueID) string {
+			return metautil.BuildDeltaLogPath(rootPath, collID, partID, segID, logID)
+		})
+		addLogs(segment.GetStatslogs(), func(fieldID, logID typeutil.UniqueID) string {
+			return metautil.BuildStatsLogPath(rootPath, collID, partID, segID, fieldID, logID)
+		})
+	}
+
+	for key, value := range kvs {
+		var prefix string
+		switch {
+		case strings.HasPrefix(key, datacoordBinlogPrefix+"/"):
+			prefix = datacoordBinlogPrefix
+		case strings.HasPrefix(key, datacoordDeltalogPrefix+"/"):
+			prefix = datacoordDeltalogPrefix
+		case strings.HasPrefix(key, datacoordStatslogPrefix+"/"):
+			prefix = datacoordStatslogPrefix
+		case strings.HasPrefix(key, util.SegmentIndexPrefix+"/"):
+			segIdx := &indexpb.SegmentIndex{}
+			if err := proto.Unmarshal([]byte(value), segIdx); err != nil {
+				return nil, fmt.Errorf("failed to unmarshal segment index %s: %w", key, err)
+			}
+			if !alive[segIdx.GetSegmentID()] || segIdx.GetDeleted() || segIdx.GetState() != commonpb.IndexState_Finished {
+				continue
+			}
+			for _, file := range metautil.BuildSegmentIndexFilePaths(rootPath, segIdx.GetBuildID(), segIdx.GetIndexVersion(),
+				segIdx.GetPartitionID(), segIdx.GetSegmentID(), segIdx.GetIndexFileKeys()) {
+				files[file] = struct{}{}
+			}
+			continue
+		default:
+			continue
+		}
+
+		collID, partID, segID, _, err := parseSegmentKey(key, prefix)
+		if err != nil {
+			return nil, err
+		}
+		if !alive[segID] {
+			continue
+		}
+		fieldBinlog := &datapb.FieldBinlog{}
+		if err := proto.Unmarshal([]byte(value), fieldBinlog); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal binlog %s: %w", key, err)
+		}
+		addLogs([]*datapb.FieldBinlog{fieldBinlog}, func(fieldID, logID typeutil.UniqueID) string {
+			switch prefix {
+			case datacoordDeltalogPrefix:
+				return metautil.BuildDeltaLogPath(rootPath, collID, partID, segID, logID)
+			case datacoordStatslogPrefix:
+				return metautil.BuildStatsLogPath(rootPath, collID, partID, segID, fieldID, logID)
+			default:
+				return metautil.BuildInsertLogPath(rootPath, collID, partID, segID, fieldID, logID)
+			}
+		})
+	}
+
+	ret := make([]string, 0, len(files))
+	for file := range files {
+		ret = append(ret, file)
+	}
+	sort.Strings(ret)
+	return ret, nil
+}
+
+// CheckReferencedFiles returns the files referenced by the snapshot entries but missing in the storage.
+func CheckReferencedFiles(ctx context.Context, checker FileChecker, kvs map[string]string) ([]string, error) {
+	files, err := ReferencedFiles(kvs, checker.RootPath())
+	if err != nil {
+		return nil, err
+	}
+	var missing []string
+	for _, file := range files {
+		exist, err := checker.Exist(ctx, file)
+		if err != nil {
+			return nil, err
+		}
+		if !exist {
+			missing = append(missing, file)
+		}
+	}
+	return missing, nil
+}
@@ -0,0 +1,201 @@
+package backend
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path"
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	clientv3 "go.etcd.io/etcd/client/v3"
+	"go.etcd.io/etcd/server/v3/embed"
+	"go.etcd.io/etcd/server/v3/etcdserver/api/v3client"
+
+	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/proto/indexpb"
+	"github.com/milvus-io/milvus/internal/util/tsoutil"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
+)
+
+type mockFileChecker struct {
+	files map[string]struct{}
+}
+
+func (c *mockFileChecker) RootPath() string {
+	return "files"
+}
+
+func (c *mockFileChecker) Exist(ctx context.Context, filePath string) (bool, error) {
+	_, ok := c.files[filePath]
+	return ok, nil
+}
+
+func marshalString(t *testing.T, msg proto.Message) string {
+	bs, err := proto.Marshal(msg)
+	require.NoError(t, err)
+	return string(bs)
+}
+
+func TestMetaSnapshotter(t *testing.T) {
+	cfg, _ := embed.ConfigFromFile("../../../../configs/advanced/etcd.yaml")
+	cfg.Dir = t.TempDir()
+	e, err := embed.StartEtcd(cfg)
+	require.NoError(t, err)
+	defer e.Close()
+	defer os.RemoveAll(cfg.Dir)
+	cli := v3client.New(e.Server)
+	ctx := context.Background()
+
+	now := time.Now()
+	tsoValue := string(typeutil.Uint64ToBytesBigEndian(uint64(now.UnixNano())))
+	metas := map[string]string{
+		"by-dev/kv/tso/timestamp":                             tsoValue,
+		"by-dev/kv/gid/idTimestamp":                           tsoValue,
+		"by-dev/meta/root-coord/collection/100":               "collection",
+		"by-dev/meta/snapshots/root-coord/collection/100_ts1": "collection",
+		"by-dev/meta/datacoord-meta/s/100/101/1": marshalString(t, &datapb.SegmentInfo{
+			ID: 1, CollectionID: 100, PartitionID: 101, State: commonpb.SegmentState_Flushed,
+		}),
+		"by-dev/meta/datacoord-meta/s/100/101/2": marshalString(t, &datapb.SegmentInfo{
+			ID: 2, CollectionID: 100, PartitionID: 101, State: commonpb.SegmentState_Dropped,
+		}),
+		"by-dev/meta/datacoord-meta/binlog/100/101/1/0": marshalString(t, &datapb.FieldBinlog{
+			FieldID: 0, Binlogs: []*datapb.Binlog{{LogID: 10}},
+		}),
+		"by-dev/meta/datacoord-meta/deltalog/100/101/1/0": marshalString(t, &datapb.FieldBinlog{
+			Binlogs: []*datapb.Binlog{{LogID: 11}},
+		}),
+		"by-dev/meta/datacoord-meta/binlog/100/101/2/0": marshalString(t, &datapb.FieldBinlog{
+			FieldID: 0, Binlogs: []*datapb.Binlog{{LogID: 12}},
+		}),
+		"by-dev/meta/segment-index/100/101/1/1000": marshalString(t, &indexpb.SegmentIndex{
+			CollectionID: 100, PartitionID: 101, SegmentID: 1, BuildID: 1000, IndexVersion: 1,
+			State: commonpb.IndexState_Finished, IndexFileKeys: []string{"index"},
+		}),
+		"by-dev/meta/querycoord-replica/100/1": "replica",
+		"by-dev/meta/datacoord-metaX":          "not included",
+		"by-dev/meta/channelwatch/1":           "not included",
+	}
+	for k, v := range metas {
+		_, err := cli.Put(ctx, k, v)
+		require.NoError(t, err)
+	}
+	lease, err := cli.Grant(ctx, 60)
+	require.NoError(t, err)
+	_, err = cli.Put(ctx, "by-dev/meta/session/rootcoord", "session", clientv3.WithLease(lease.ID))
+	require.NoError(t, err)
+
+	snapshotter := NewMetaSnapshotter(cli, "by-dev/meta", "by-dev/kv")
+	_, err = snapshotter.Snapshot(ctx, []string{"unknown"})
+	assert.Error(t, err)
+	file, err := snapshotter.Snapshot(ctx, MetaSnapshotAllComponents())
+	require.NoError(t, err)
+
+	header, extra, kvs, err := LoadMetaSnapshot(file)
+	require.NoError(t, err)
+	assert.Equal(t, BackupHeaderVersionV2, header.Version)
+	assert.Equal(t, "by-dev", header.Instance)
+	assert.Equal(t, "meta", header.MetaPath)
+	assert.Equal(t, "rootcoord,datacoord,indexcoord,querycoord", header.Component)
+	assert.Equal(t, tsoutil.ComposeTSByTime(time.Unix(0, now.UnixNano()), 0), extra.SnapshotTs)
+	assert.Equal(t, []byte(tsoValue), extra.Allocators[tsoAllocatorKey])
+	assert.Len(t, kvs, 9)
+	assert.Equal(t, "collection", kvs["root-coord/collection/100"])
+	assert.NotContains(t, kvs, "datacoord-metaX")
+	assert.NotContains(t, kvs, "channelwatch/1")
+
+	files, err := ReferencedFiles(kvs, "files")
+	require.NoError(t, err)
+	assert.Equal(t, []string{
+		"files/delta_log/100/101/1/11",
+		"files/index_files/1000/1/101/1/index",
+		"files/insert_log/100/101/1/0/10",
+	}, files)
+
+	checker := &mockFileChecker{files: map[string]struct{}{
+		"files/delta_log/100/101/1/11":    {},
+		"files/insert_log/100/101/1/0/10": {},
+	}}
+	missing, err := CheckReferencedFiles(ctx, checker, kvs)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"files/index_files/1000/1/101/1/index"}, missing)
+
+	t.Run("tampered snapshot", func(t *testing.T) {
+		tampered, err := NewBackupCodec().Serialize(header, map[string]string{"root-coord/collection/100": "tampered"})
+		require.NoError(t, err)
+		_, _, _, err = LoadMetaSnapshot(tampered)
+		assert.Error(t, err)
+
+		old, err := NewBackupCodec().Serialize(&BackupHeader{Version: BackupHeaderVersionV1}, kvs)
+		require.NoError(t, err)
+		_, _, _, err = LoadMetaSnapshot(old)
+		assert.Error(t, err)
+	})
+
+	t.Run("restore", func(t *testing.T) {
+		backupFile := path.Join(t.TempDir(), "backup")
+		// sessions alive
+		err := snapshotter.Restore(ctx, header, extra, kvs, true, backupFile)
+		assert.Error(t, err)
+		_, err = cli.Revoke(ctx, lease.ID)
+		require.NoError(t, err)
+
+		// meta exists
+		err = snapshotter.Restore(ctx, header, extra, kvs, false, backupFile)
+		assert.Error(t, err)
+		assert.NoFileExists(t, backupFile)
+
+		// backup file exists, the meta is kept
+		existFile := path.Join(t.TempDir(), "exist")
+		require.NoError(t, ioutil.WriteFile(existFile, []byte("previous backup"), 0600))
+		err = snapshotter.Restore(ctx, header, extra, kvs, true, existFile)
+		assert.Error(t, err)
+		resp, err := cli.Get(ctx, "by-dev/meta/root-coord/collection/100")
+		require.NoError(t, err)
+		assert.Len(t, resp.Kvs, 1)
+
+		_, err = cli.Put(ctx, "by-dev/meta/root-coord/collection/200", "created after snapshot")
+		require.NoError(t, err)
+		later := string(typeutil.Uint64ToBytesBigEndian(uint64(now.Add(time.Hour).UnixNano())))
+		_, err = cli.Put(ctx, "by-dev/kv/tso/timestamp", later)
+		require.NoError(t, err)
+		_, err = cli.Delete(ctx, "by-dev/kv/gid/idTimestamp")
+		require.NoError(t, err)
+
+		err = snapshotter.Restore(ctx, header, extra, kvs, true, backupFile)
+		assert.NoError(t, err)
+
+		// the overwritten meta is in the backup file
+		backup, err := ioutil.ReadFile(backupFile)
+		require.NoError(t, err)
+		_, _, backupKvs, err := LoadMetaSnapshot(backup)
+		require.NoError(t, err)
+		assert.Len(t, backupKvs, len(kvs)+1)
+		assert.Equal(t, "created after snapshot", backupKvs["root-coord/collection/200"])
+
+		resp, err = cli.Get(ctx, "by-dev/meta/root-coord/collection/200")
+		require.NoError(t, err)
+		assert.Empty(t, resp.Kvs)
+		resp, err = cli.Get(ctx, "by-dev/meta/datacoord-metaX")
+		require.NoError(t, err)
+		assert.Len(t, resp.Kvs, 1)
+		// timestamp allocator never goes backwards
+		resp, err = cli.Get(ctx, "by-dev/kv/tso/timestamp")
+		require.NoError(t, err)
+		assert.Equal(t, later, string(resp.Kvs[0].Value))
+		resp, err = cli.Get(ctx, "by-dev/kv/gid/idTimestamp")
+		require.NoError(t, err)
+		assert.Equal(t, tsoValue, string(resp.Kvs[0].Value))
+
+		restored, err := snapshotter.Snapshot(ctx, MetaSnapshotAllComponents())
+		require.NoError(t, err)
+		_, _, restoredKvs, err := LoadMetaSnapshot(restored)
+		require.NoError(t, err)
+		assert.Equal(t, kvs, restoredKvs)
+	})
+}
@@ -82,6 +82,12 @@ const (
 	DimKey         = "dim"
 )
 
+// Range search keys, carried inside the search params
+const (
+	RadiusKey      = "radius"
+	RangeFilterKey = "range_filter"
+)
+
 //  Collection properties key
 
 const (
@@ -25,6 +25,7 @@ var (
 	ErrNotInitial   = errors.New("config is not initialized")
 	ErrIgnoreChange = errors.New("ignore change")
 	ErrKeyNotFound  = errors.New("key not found")
+	ErrNotWritable  = errors.New("source is not writable")
 )
 
 func Init(opts ...Option) (*Manager, error) {
@@ -44,13 +45,19 @@ func Init(opts ...Option) (*Manager, error) {
 		if err != nil {
 			return nil, err
 		}
-		s.eh = sourceManager
 		sourceManager.AddSource(s)
+		// set the handler after the initial pull, which holds the lock of manager
+		s.SetEventHandler(sourceManager)
 	}
 	return sourceManager, nil
 
 }
 
+// FormatKey returns the normalized key which configs are stored with
+func FormatKey(key string) string {
+	return formatKey(key)
+}
+
 func formatKey(key string) string {
 	ret := strings.ToLower(key)
 	ret = strings.ReplaceAll(ret, "/", "")
@@ -58,3 +65,17 @@ func formatKey(key string) string {
 	ret = strings.ReplaceAll(ret, ".", "")
 	return ret
 }
+
+// formatPrefixKey normalizes key like formatKey but keeps the separators, "/" is taken as "."
+func formatPrefixKey(key string) string {
+	ret := strings.ToLower(key)
+	ret = strings.ReplaceAll(ret, "/", ".")
+	ret = strings.ReplaceAll(ret, "_", "")
+	return ret
+}
+
+// HasKeyPrefix returns whether key starts with prefix, they are compared with the separators kept,
+// so "dataCoord.gc." matches "dataCoord.gc.interval" but not "dataCoord.gcInterval"
+func HasKeyPrefix(key, prefix string) bool {
+	return strings.HasPrefix(formatPrefixKey(key), formatPrefixKey(prefix))
+}
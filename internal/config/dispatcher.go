@@ -0,0 +1,152 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/internal/log"
+)
+
+// Watcher receives the events of the keys it watches, the event value is the one
+// effective after the source priority is resolved
+type Watcher func(event *Event)
+
+type watcherEntry struct {
+	id       int64
+	key      string
+	isPrefix bool
+	watcher  Watcher
+}
+
+// match returns whether the watcher watches key, prefixes are matched on the keys written with separators,
+// sources keep both them and the normalized keys, which exact keys are matched on
+func (w *watcherEntry) match(key string) bool {
+	if w.isPrefix {
+		return key != formatKey(key) && strings.HasPrefix(formatPrefixKey(key), w.key)
+	}
+	return key == w.key
+}
+
+// EventDispatcher dispatches events to the watchers in the order they are registered
+type EventDispatcher struct {
+	mu       sync.RWMutex
+	nextID   int64
+	watchers []*watcherEntry
+}
+
+func NewEventDispatcher() *EventDispatcher {
+	return &EventDispatcher{}
+}
+
+func (d *EventDispatcher) register(key string, isPrefix bool, watcher Watcher) func() {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.nextID++
+	id := d.nextID
+	entry := &watcherEntry{id: id, key: formatKey(key), isPrefix: isPrefix, watcher: watcher}
+	if isPrefix {
+		entry.key = formatPrefixKey(key)
+	}
+	d.watchers = append(d.watchers, entry)
+	return func() {
+		d.mu.Lock()
+		defer d.mu.Unlock()
+		for i, w := range d.watchers {
+			if w.id == id {
+				d.watchers = append(d.watchers[:i:i], d.watchers[i+1:]...)
+				return
+			}
+		}
+	}
+}
+
+// DispatchEvent calls the watchers matching the key of event, it must not be called with the manager locked
+// since watchers usually read configs
+func (d *EventDispatcher) DispatchEvent(events ...*Event) {
+	d.mu.RLock()
+	watchers := d.watchers
+	d.mu.RUnlock()
+	for _, event := range events {
+		for _, w := range watchers {
+			if w.match(event.Key) {
+				w.watcher(event)
+			}
+		}
+	}
+}
+
+// Watch registers watcher for the events of key, the returned func cancels it
+func (m *Manager) Watch(key string, watcher Watcher) func() {
+	return m.dispatcher.register(key, false, watcher)
+}
+
+// WatchPrefix registers watcher for the events of the keys starting with prefix, the returned func cancels it
+func (m *Manager) WatchPrefix(prefix string, watcher Watcher) func() {
+	return m.dispatcher.register(prefix, true, watcher)
+}
+
+// typedWatcher parses the value of create and update events, deleted keys and invalid values are ignored
+func typedWatcher[T any](key string, parse func(string) (T, error), fn func(T)) Watcher {
+	return func(event *Event) {
+		if event.EventType == DeleteType {
+			return
+		}
+		v, err := parse(event.Value)
+		if err != nil {
+			log.Warn("ignore invalid config value", zap.String("key", key), zap.String("value", event.Value), zap.Error(err))
+			return
+		}
+		fn(v)
+	}
+}
+
+// WatchString calls fn with the new value of key
+func (m *Manager) WatchString(key string, fn func(string)) func() {
+	return m.Watch(key, typedWatcher(key, func(s string) (string, error) { return s, nil }, fn))
+}
+
+// WatchBool calls fn with the new value of key
+func (m *Manager) WatchBool(key string, fn func(bool)) func() {
+	return m.Watch(key, typedWatcher(key, strconv.ParseBool, fn))
+}
+
+// WatchInt64 calls fn with the new value of key
+func (m *Manager) WatchInt64(key string, fn func(int64)) func() {
+	return m.Watch(key, typedWatcher(key, func(s string) (int64, error) { return strconv.ParseInt(s, 10, 64) }, fn))
+}
+
+// WatchFloat64 calls fn with the new value of key
+func (m *Manager) WatchFloat64(key string, fn func(float64)) func() {
+	return m.Watch(key, typedWatcher(key, func(s string) (float64, error) { return strconv.ParseFloat(s, 64) }, fn))
+}
+
+// WatchDuration calls fn with the new value of key, which is either a duration string like "10s"
+// or a number in unit
+func (m *Manager) WatchDuration(key string, unit time.Duration, fn func(time.Duration)) func() {
+	return m.Watch(key, typedWatcher(key, func(s string) (time.Duration, error) {
+		if v, err := strconv.ParseInt(s, 10, 64); err == nil {
+			return time.Duration(v) * unit, nil
+		}
+		return time.ParseDuration(s)
+	}, fn))
+}
@@ -0,0 +1,212 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// mapSource is a source backed by a map, changes are fired to the manager like EtcdSource
+type mapSource struct {
+	sync.RWMutex
+	name     string
+	priority int
+	configs  map[string]string
+	eh       EventHandler
+}
+
+func (s *mapSource) GetConfigurations() (map[string]string, error) {
+	s.RLock()
+	defer s.RUnlock()
+	configs := make(map[string]string)
+	for k, v := range s.configs {
+		configs[k] = v
+	}
+	return configs, nil
+}
+
+func (s *mapSource) GetConfigurationByKey(key string) (string, error) {
+	s.RLock()
+	defer s.RUnlock()
+	v, ok := s.configs[key]
+	if !ok {
+		return "", fmt.Errorf("key not found: %s", key)
+	}
+	return v, nil
+}
+
+func (s *mapSource) GetPriority() int      { return s.priority }
+func (s *mapSource) GetSourceName() string { return s.name }
+func (s *mapSource) Close()                {}
+
+func (s *mapSource) set(key, value string) {
+	s.Lock()
+	_, ok := s.configs[key]
+	s.configs[key] = value
+	s.Unlock()
+	eventType := CreateType
+	if ok {
+		eventType = UpdateType
+	}
+	s.eh.OnEvent(newEvent(s.name, eventType, key, value))
+}
+
+func (s *mapSource) delete(key string) {
+	s.Lock()
+	value := s.configs[key]
+	delete(s.configs, key)
+	s.Unlock()
+	s.eh.OnEvent(newEvent(s.name, DeleteType, key, value))
+}
+
+func newTestManager(t *testing.T) (*Manager, *mapSource, *mapSource) {
+	mgr := NewManager()
+	low := &mapSource{name: "low", priority: LowPriority, configs: map[string]string{"quotaandlimitsdmlmaxinsertrate": "10"}}
+	high := &mapSource{name: "high", priority: HighPriority, configs: map[string]string{}}
+	require.NoError(t, mgr.AddSource(low))
+	require.NoError(t, mgr.AddSource(high))
+	low.eh = mgr
+	high.eh = mgr
+	return mgr, low, high
+}
+
+func TestManager_Watch(t *testing.T) {
+	mgr, low, high := newTestManager(t)
+
+	var events []*Event
+	cancel := mgr.Watch("quotaAndLimits.dml.maxInsertRate", func(e *Event) {
+		// watchers can read configs
+		v, _ := mgr.GetConfig(e.Key)
+		assert.Equal(t, e.Value, v)
+		events = append(events, e)
+	})
+	var prefixKeys []string
+	mgr.WatchPrefix("quotaAndLimits.", func(e *Event) {
+		prefixKeys = append(prefixKeys, e.Key)
+	})
+	var rate float64
+	mgr.WatchFloat64("quotaAndLimits.dml.maxInsertRate", func(v float64) { rate = v })
+
+	high.set("quotaandlimitsdmlmaxinsertrate", "20")
+	require.Equal(t, 1, len(events))
+	assert.Equal(t, "20", events[0].Value)
+	assert.Equal(t, 20.0, rate)
+
+	// the lower priority source is shadowed
+	low.set("quotaandlimitsdmlmaxinsertrate", "30")
+	assert.Equal(t, 1, len(events))
+	assert.Equal(t, 20.0, rate)
+
+	// deleting from the higher priority source falls back to the lower one
+	high.delete("quotaandlimitsdmlmaxinsertrate")
+	require.Equal(t, 2, len(events))
+	assert.Equal(t, UpdateType, events[1].EventType)
+	assert.Equal(t, "30", events[1].Value)
+	assert.Equal(t, 30.0, rate)
+
+	// invalid values are ignored by typed watchers
+	low.set("quotaandlimitsdmlmaxinsertrate", "invalid")
+	assert.Equal(t, 3, len(events))
+	assert.Equal(t, 30.0, rate)
+
+	// keys as written in sources are only dispatched to prefix watchers
+	low.set("quotaAndLimits/dml/maxInsertRate", "40")
+	assert.Equal(t, 3, len(events))
+
+	// prefixes are matched with the separators kept
+	high.set("quotaandlimitsddlenabled", "true")
+	high.set("quotaAndLimits.ddl.enabled", "true")
+	high.set("quotaAndLimitsX.ddl.enabled", "true")
+	assert.Equal(t, 3, len(events))
+	assert.Equal(t, []string{
+		"quotaAndLimits/dml/maxInsertRate",
+		"quotaAndLimits.ddl.enabled",
+	}, prefixKeys)
+
+	cancel()
+	high.set("quotaandlimitsdmlmaxinsertrate", "50")
+	assert.Equal(t, 3, len(events))
+	assert.Equal(t, 50.0, rate)
+}
+
+func TestManager_WatchOverlay(t *testing.T) {
+	mgr, low, _ := newTestManager(t)
+
+	var value string
+	deleted := false
+	mgr.Watch("quotaAndLimits.dml.maxInsertRate", func(e *Event) {
+		value = e.Value
+		deleted = e.EventType == DeleteType
+	})
+	var enabled bool
+	mgr.WatchBool("common.security.authorizationEnabled", func(v bool) { enabled = v })
+	var num int64
+	mgr.WatchInt64("common.session.ttl", func(v int64) { num = v })
+	var name string
+	mgr.WatchString("common.cluster.name", func(v string) { name = v })
+	var interval time.Duration
+	mgr.WatchDuration("dataCoord.gc.interval", time.Second, func(v time.Duration) { interval = v })
+
+	mgr.SetConfig("quotaAndLimits.dml.maxInsertRate", "100")
+	assert.Equal(t, "100", value)
+
+	// the overlay shadows the sources
+	low.set("quotaandlimitsdmlmaxinsertrate", "30")
+	assert.Equal(t, "100", value)
+
+	mgr.DeleteConfig("quotaAndLimits.dml.maxInsertRate")
+	assert.True(t, deleted)
+
+	mgr.SetConfig("common.security.authorizationEnabled", "true")
+	mgr.SetConfig("common.session.ttl", "30")
+	mgr.SetConfig("common.cluster.name", "c1")
+	mgr.SetConfig("dataCoord.gc.interval", "60")
+	assert.True(t, enabled)
+	assert.Equal(t, int64(30), num)
+	assert.Equal(t, "c1", name)
+	assert.Equal(t, time.Minute, interval)
+	mgr.SetConfig("dataCoord.gc.interval", "2m")
+	assert.Equal(t, 2*time.Minute, interval)
+}
+
+func TestManager_WatchPrefixOverlay(t *testing.T) {
+	mgr, _, _ := newTestManager(t)
+
+	var keys []string
+	mgr.WatchPrefix("dataCoord.gc.", func(e *Event) {
+		keys = append(keys, e.Key)
+	})
+	mgr.SetConfig("dataCoord.gc.interval", "60")
+	mgr.SetConfig("dataCoord.gcInterval", "60")
+	mgr.SetConfig("datacoordgcinterval", "60")
+	mgr.DeleteConfig("dataCoord.gc.interval")
+	assert.Equal(t, []string{"dataCoord.gc.interval", "dataCoord.gc.interval"}, keys)
+}
+
+func TestHasKeyPrefix(t *testing.T) {
+	assert.True(t, HasKeyPrefix("dataCoord.gc.interval", "dataCoord.gc."))
+	assert.True(t, HasKeyPrefix("datacoord/gc/interval", "dataCoord.gc."))
+	assert.True(t, HasKeyPrefix("quotaAndLimits.dml.max_insert_rate", "quotaandlimits.dml."))
+	assert.False(t, HasKeyPrefix("dataCoord.gcInterval", "dataCoord.gc."))
+	assert.False(t, HasKeyPrefix("datacoordgcinterval", "dataCoord.gc."))
+}
@@ -33,6 +33,9 @@ const (
 	ModeInterval
 )
 
+// EtcdSourceName is the name of EtcdSource
+const EtcdSourceName = "EtcdSource"
+
 type EtcdSource struct {
 	sync.RWMutex
 	etcdCli          *clientv3.Client
@@ -44,6 +47,8 @@ type EtcdSource struct {
 	intervalDone     chan bool
 	intervalInitOnce sync.Once
 	eh               EventHandler
+	// refreshMu keeps the refreshes in order, updates must not be overwritten by an older pull
+	refreshMu sync.Mutex
 }
 
 func NewEtcdSource(remoteInfo *EtcdInfo) (*EtcdSource, error) {
@@ -105,7 +110,28 @@ func (es *EtcdSource) GetPriority() int {
 
 // GetSourceName implements ConfigSource
 func (es *EtcdSource) GetSourceName() string {
-	return "EtcdSource"
+	return EtcdSourceName
+}
+
+// SetConfig puts the config to etcd, it is applied to this node at once
+// and to the other nodes at their next refresh
+func (es *EtcdSource) SetConfig(key, value string) error {
+	if _, err := es.etcdCli.Put(es.ctx, es.configKey(key), value); err != nil {
+		return err
+	}
+	return es.refreshConfigurations()
+}
+
+// DeleteConfig removes the config from etcd
+func (es *EtcdSource) DeleteConfig(key string) error {
+	if _, err := es.etcdCli.Delete(es.ctx, es.configKey(key)); err != nil {
+		return err
+	}
+	return es.refreshConfigurations()
+}
+
+func (es *EtcdSource) configKey(key string) string {
+	return es.keyPrefix + "/config/" + key
 }
 
 func (es *EtcdSource) Close() {
@@ -113,6 +139,8 @@ func (es *EtcdSource) Close() {
 }
 
 func (es *EtcdSource) refreshConfigurations() error {
+	es.refreshMu.Lock()
+	defer es.refreshMu.Unlock()
 	prefix := es.keyPrefix + "/config"
 	response, err := es.etcdCli.Get(es.ctx, prefix, clientv3.WithPrefix())
 	if err != nil {
@@ -148,19 +176,29 @@ func (es *EtcdSource) refreshConfigurationsPeriodically() {
 
 func (es *EtcdSource) updateConfigurationAndFireEvent(config map[string]string) error {
 	es.Lock()
-	defer es.Unlock()
 	//Populate the events based on the changed value between current config and newly received Config
 	events, err := PopulateEvents(es.GetSourceName(), es.currentConfig, config)
 	if err != nil {
+		es.Unlock()
 		log.Warn("generating event error", zap.Error(err))
 		return err
 	}
 	es.currentConfig = config
-	//Generate OnEvent Callback based on the events created
-	if es.eh != nil {
+	eh := es.eh
+	es.Unlock()
+
+	//Generate OnEvent Callback based on the events created, without the lock since handlers read configs
+	if eh != nil {
 		for _, e := range events {
-			es.eh.OnEvent(e)
+			eh.OnEvent(e)
 		}
 	}
 	return nil
 }
+
+// SetEventHandler sets the handler of the events fired when configurations change
+func (es *EtcdSource) SetEventHandler(eh EventHandler) {
+	es.Lock()
+	defer es.Unlock()
+	es.eh = eh
+}
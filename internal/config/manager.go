@@ -18,6 +18,7 @@ package config
 import (
 	"errors"
 	"fmt"
+	"sort"
 	"strings"
 	"sync"
 
@@ -35,6 +36,7 @@ type Manager struct {
 	sources        map[string]Source
 	keySourceMap   map[string]string
 	overlayConfigs map[string]string // store the configs setted or deleted by user
+	dispatcher     *EventDispatcher
 }
 
 func NewManager() *Manager {
@@ -42,6 +44,7 @@ func NewManager() *Manager {
 		sources:        make(map[string]Source),
 		keySourceMap:   make(map[string]string),
 		overlayConfigs: make(map[string]string),
+		dispatcher:     NewEventDispatcher(),
 	}
 }
 
@@ -114,19 +117,34 @@ func (m *Manager) Configs() map[string]string {
 // For compatible reason, only visiable for Test
 func (m *Manager) SetConfig(key, value string) {
 	m.Lock()
-	defer m.Unlock()
 	realKey := formatKey(key)
 	m.overlayConfigs[realKey] = value
 	m.updateEvent(newEvent(CustomSourceName, CreateType, realKey, value))
+	m.Unlock()
+
+	m.dispatcher.DispatchEvent(overlayEvents(UpdateType, key, value)...)
 }
 
 // For compatible reason, only visiable for Test
 func (m *Manager) DeleteConfig(key string) {
 	m.Lock()
-	defer m.Unlock()
 	realKey := formatKey(key)
 	m.overlayConfigs[realKey] = TombValue
 	m.updateEvent(newEvent(realKey, DeleteType, realKey, ""))
+	m.Unlock()
+
+	m.dispatcher.DispatchEvent(overlayEvents(DeleteType, key, "")...)
+}
+
+// overlayEvents returns the events of the overlay config of key, which are fired with both the
+// normalized key and the key as written, like the events of sources
+func overlayEvents(eventType, key, value string) []*Event {
+	realKey := formatKey(key)
+	events := []*Event{newEvent(CustomSourceName, eventType, realKey, value)}
+	if key != realKey {
+		events = append(events, newEvent(CustomSourceName, eventType, key, value))
+	}
+	return events
 }
 
 func (m *Manager) Close() {
@@ -253,14 +271,39 @@ func (m *Manager) updateEvent(e *Event) error {
 // OnEvent Triggers actions when an event is generated
 func (m *Manager) OnEvent(event *Event) {
 	m.Lock()
-	defer m.Unlock()
 	err := m.updateEvent(event)
 	if err != nil {
+		m.Unlock()
 		log.Warn("failed in updating event with error", zap.Error(err), zap.Any("event", event))
 		return
 	}
+	dispatched := m.effectiveEvent(event)
+	m.Unlock()
+
+	m.dispatcher.DispatchEvent(dispatched)
+}
 
-	// m.dispatcher.DispatchEvent(event)
+// effectiveEvent converts an updated event to the one watchers receive, the value of a key
+// deleted from one source falls back to the next best source, and overlay configs shadow all sources
+func (m *Manager) effectiveEvent(event *Event) *Event {
+	if v, ok := m.overlayConfigs[formatKey(event.Key)]; ok {
+		if v == TombValue {
+			return newEvent(CustomSourceName, DeleteType, event.Key, "")
+		}
+		return newEvent(CustomSourceName, UpdateType, event.Key, v)
+	}
+	if event.EventType != DeleteType {
+		return event
+	}
+	sourceName, ok := m.keySourceMap[event.Key]
+	if !ok {
+		return event
+	}
+	v, err := m.getConfigValueBySource(event.Key, sourceName)
+	if err != nil {
+		return event
+	}
+	return newEvent(sourceName, UpdateType, event.Key, v)
 }
 
 func (m *Manager) findNextBestSource(key string, sourceName string) Source {
@@ -303,3 +346,97 @@ func (m *Manager) getHighPrioritySource(srcNameA, srcNameB string) Source {
 
 	return sourceB
 }
+
+// ConfigWithSource is the effective value of a config and the source it comes from
+type ConfigWithSource struct {
+	Key    string `json:"key"`
+	Value  string `json:"value"`
+	Source string `json:"source"`
+}
+
+// ConfigsWithSource returns the effective configs sorted by key, keys are shown as written in their
+// sources, e.g. "etcd.endpoints" rather than the normalized "etcdendpoints"
+func (m *Manager) ConfigsWithSource() []ConfigWithSource {
+	m.RLock()
+	defer m.RUnlock()
+
+	// sources keep both the raw key and the normalized key of a config, show the raw key
+	// from the effective source, or the smallest one if there are several
+	rawKeys := make(map[string]string)
+	fromEffective := func(key, realKey string) bool {
+		return m.keySourceMap[key] == m.keySourceMap[realKey]
+	}
+	for key := range m.keySourceMap {
+		realKey := formatKey(key)
+		if key == realKey {
+			continue
+		}
+		current, ok := rawKeys[realKey]
+		if !ok || fromEffective(key, realKey) && !fromEffective(current, realKey) ||
+			fromEffective(key, realKey) == fromEffective(current, realKey) && key < current {
+			rawKeys[realKey] = key
+		}
+	}
+
+	configs := make([]ConfigWithSource, 0, len(m.keySourceMap))
+	add := func(realKey, value, sourceName string) {
+		key, ok := rawKeys[realKey]
+		if !ok {
+			key = realKey
+		}
+		configs = append(configs, ConfigWithSource{Key: key, Value: value, Source: sourceName})
+	}
+	for key, sourceName := range m.keySourceMap {
+		if key != formatKey(key) {
+			continue
+		}
+		if _, ok := m.overlayConfigs[key]; ok {
+			continue
+		}
+		value, err := m.getConfigValueBySource(key, sourceName)
+		if err != nil {
+			continue
+		}
+		add(key, value, sourceName)
+	}
+	for key, value := range m.overlayConfigs {
+		if value != TombValue {
+			add(key, value, CustomSourceName)
+		}
+	}
+	sort.Slice(configs, func(i, j int) bool { return configs[i].Key < configs[j].Key })
+	return configs
+}
+
+// SetSourceConfig writes the config to a writable source, the change is dispatched like the other
+// changes of the source
+func (m *Manager) SetSourceConfig(sourceName, key, value string) error {
+	source, err := m.getWritableSource(sourceName)
+	if err != nil {
+		return err
+	}
+	return source.SetConfig(key, value)
+}
+
+// DeleteSourceConfig removes the config from a writable source
+func (m *Manager) DeleteSourceConfig(sourceName, key string) error {
+	source, err := m.getWritableSource(sourceName)
+	if err != nil {
+		return err
+	}
+	return source.DeleteConfig(key)
+}
+
+func (m *Manager) getWritableSource(sourceName string) (WritableSource, error) {
+	m.RLock()
+	defer m.RUnlock()
+	source, ok := m.sources[sourceName]
+	if !ok {
+		return nil, fmt.Errorf("%w: source %s not found", ErrNotWritable, sourceName)
+	}
+	writable, ok := source.(WritableSource)
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrNotWritable, sourceName)
+	}
+	return writable, nil
+}
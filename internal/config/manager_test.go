@@ -71,3 +71,25 @@ func (ErrSource) GetPriority() int {
 func (ErrSource) GetSourceName() string {
 	return "ErrSource"
 }
+
+func TestConfigsWithSource(t *testing.T) {
+	mgr := NewManager()
+	low := &mapSource{name: "low", priority: LowPriority, configs: map[string]string{
+		"etcd.endpoints": "localhost:2379", "etcdendpoints": "localhost:2379", "common.retentionDuration": "10", "commonretentionduration": "10"}}
+	high := &mapSource{name: "high", priority: HighPriority, configs: map[string]string{
+		"ETCD_ENDPOINTS": "etcd:2379", "etcdendpoints": "etcd:2379"}}
+	assert.NoError(t, mgr.AddSource(low))
+	assert.NoError(t, mgr.AddSource(high))
+	mgr.SetConfig("a.b", "c")
+
+	assert.Equal(t, []ConfigWithSource{
+		{Key: "ETCD_ENDPOINTS", Value: "etcd:2379", Source: "high"},
+		{Key: "ab", Value: "c", Source: CustomSourceName},
+		{Key: "common.retentionDuration", Value: "10", Source: "low"},
+	}, mgr.ConfigsWithSource())
+
+	err := mgr.SetSourceConfig("low", "a.b", "d")
+	assert.True(t, errors.Is(err, ErrNotWritable))
+	err = mgr.DeleteSourceConfig(EtcdSourceName, "a.b")
+	assert.True(t, errors.Is(err, ErrNotWritable))
+}
@@ -36,6 +36,13 @@ type EventHandler interface {
 	OnEvent(event *Event)
 }
 
+// WritableSource is a source accepting config changes
+type WritableSource interface {
+	Source
+	SetConfig(key, value string) error
+	DeleteConfig(key string) error
+}
+
 // EtcdInfo has attribute for config center source initialization
 type EtcdInfo struct {
 	Endpoints []string
@@ -26,6 +26,10 @@ const milvus::PkType INVALID_PK;  // of std::monostate if not set.
 const int64_t START_USER_FIELDID = 100;
 const char MAX_LENGTH[] = "max_length";
 
+// search params of range search
+const char RADIUS[] = "radius";
+const char RANGE_FILTER[] = "range_filter";
+
 // const fieldID (rowID and timestamp)
 const milvus::FieldId RowFieldID = milvus::FieldId(0);
 const milvus::FieldId TimestampFieldID = milvus::FieldId(1);
@@ -17,16 +17,25 @@
 #pragma once
 
 #include <memory>
+#include <optional>
 
 #include "common/Types.h"
 
 namespace milvus {
+// RangeSearchInfo holds the bounds of a range search. For IP, a hit is in range if
+// radius < distance <= range_filter; for other metrics, if range_filter <= distance < radius.
+struct RangeSearchInfo {
+    float radius_;
+    std::optional<float> range_filter_;
+};
+
 struct SearchInfo {
     int64_t topk_;
     int64_t round_decimal_;
     FieldId field_id_;
     MetricType metric_type_;
     Config search_params_;
+    std::optional<RangeSearchInfo> range_search_;
 };
 
 using SearchInfoPtr = std::shared_ptr<SearchInfo>;
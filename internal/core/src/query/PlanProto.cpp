@@ -17,6 +17,7 @@
 #include "PlanProto.h"
 #include "generated/ExtractInfoExprVisitor.h"
 #include "generated/ExtractInfoPlanNodeVisitor.h"
+#include "common/Consts.h"
 #include "common/VectorTrait.h"
 
 namespace milvus::query {
@@ -126,6 +127,30 @@ ExtractBinaryArithOpEvalRangeExprImpl(FieldId field_id,
         static_cast<OpType>(expr_proto.op()), getValue(expr_proto.value()));
 }
 
+// ExtractRangeSearchInfo takes radius and range_filter out of the search params,
+// they are handled by segcore and not passed to the index.
+static std::optional<RangeSearchInfo>
+ExtractRangeSearchInfo(Config& search_params) {
+    if (!search_params.is_object() || !search_params.contains(RADIUS)) {
+        return std::nullopt;
+    }
+    // the proxy accepts both numbers and numeric strings
+    auto to_float = [](const Config& value) -> float {
+        if (value.is_string()) {
+            return std::stof(value.get<std::string>());
+        }
+        return value.get<float>();
+    };
+    RangeSearchInfo info;
+    info.radius_ = to_float(search_params[RADIUS]);
+    search_params.erase(RADIUS);
+    if (search_params.contains(RANGE_FILTER)) {
+        info.range_filter_ = to_float(search_params[RANGE_FILTER]);
+        search_params.erase(RANGE_FILTER);
+    }
+    return info;
+}
+
 std::unique_ptr<VectorPlanNode>
 ProtoParser::PlanNodeFromProto(const planpb::PlanNode& plan_node_proto) {
     // TODO: add more buffs
@@ -149,6 +174,7 @@ ProtoParser::PlanNodeFromProto(const planpb::PlanNode& plan_node_proto) {
     search_info.topk_ = query_info_proto.topk();
     search_info.round_decimal_ = query_info_proto.round_decimal();
     search_info.search_params_ = json::parse(query_info_proto.search_params());
+    search_info.range_search_ = ExtractRangeSearchInfo(search_info.search_params_);
 
     auto plan_node = [&]() -> std::unique_ptr<VectorPlanNode> {
         if (anns_proto.is_binary()) {
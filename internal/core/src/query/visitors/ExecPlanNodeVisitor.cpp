@@ -9,8 +9,11 @@
 // is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
 // or implied. See the License for the specific language governing permissions and limitations under the License
 
+#include <algorithm>
 #include <utility>
 
+#include "common/Consts.h"
+#include "index/Meta.h"
 #include "query/PlanImpl.h"
 #include "query/generated/ExecPlanNodeVisitor.h"
 #include "query/generated/ExecExprVisitor.h"
@@ -66,6 +69,119 @@ empty_search_result(int64_t num_queries, SearchInfo& search_info) {
     return final_result;
 }
 
+// the max number of candidates a range search explores for a query in a segment
+constexpr int64_t RANGE_SEARCH_MAX_CANDIDATES = 16384;
+
+// max_search_list of DiskANN, see VectorDiskIndex::Query
+constexpr int64_t DISK_ANN_MAX_SEARCH_LIST = 65535;
+
+// RaiseSearchParam raises the search param to min_value if it's given and less than min_value.
+static void
+RaiseSearchParam(Config& params, const std::string& key, int64_t min_value, int64_t max_value) {
+    if (!params.contains(key)) {
+        return;
+    }
+    auto& value = params[key];
+    int64_t current = value.is_string() ? std::stoll(value.get<std::string>()) : value.get<int64_t>();
+    if (current < min_value) {
+        params[key] = std::min(min_value, max_value);
+    }
+}
+
+// RangeSearch searches the candidates nearest to the queries and keeps the ones in range.
+// The candidates are searched with a growing topk until every query gets topk hits in range
+// or runs out of candidates within the radius, so hits filtered by range_filter don't take
+// the places of the ones in range.
+static void
+RangeSearch(const segcore::SegmentInternalInterface& segment,
+            const SearchInfo& search_info,
+            const void* query_data,
+            int64_t num_queries,
+            Timestamp timestamp,
+            const BitsetView& bitset,
+            int64_t valid_count,
+            SearchResult& result) {
+    auto topk = search_info.topk_;
+    auto& range = search_info.range_search_.value();
+    auto is_desc = PositivelyRelated(search_info.metric_type_);
+    auto in_radius = [&](float distance) { return is_desc ? distance > range.radius_ : distance < range.radius_; };
+    auto beyond_range_filter = [&](float distance) {
+        if (!range.range_filter_.has_value()) {
+            return false;
+        }
+        auto range_filter = range.range_filter_.value();
+        return is_desc ? distance > range_filter : distance < range_filter;
+    };
+
+    auto max_candidates = std::max(topk, std::min(valid_count, RANGE_SEARCH_MAX_CANDIDATES));
+    auto candidates = topk;
+    SearchInfo candidate_info = search_info;
+    SearchResult candidate_result;
+    while (true) {
+        candidate_info.topk_ = candidates;
+        // the search lists of graph indexes must not be shorter than topk
+        RaiseSearchParam(candidate_info.search_params_, knowhere::indexparam::EF, candidates,
+                         RANGE_SEARCH_MAX_CANDIDATES);
+        RaiseSearchParam(candidate_info.search_params_, index::DISK_ANN_QUERY_LIST, candidates + 1,
+                         DISK_ANN_MAX_SEARCH_LIST);
+        candidate_result = SearchResult();
+        segment.vector_search(candidate_info, query_data, num_queries, timestamp, bitset, candidate_result);
+        if (candidates >= max_candidates) {
+            break;
+        }
+
+        // more candidates are needed if a query doesn't get enough hits in range, and its
+        // last candidate is still within the radius
+        bool exhausted = true;
+        for (int64_t i = 0; i < num_queries && exhausted; ++i) {
+            int64_t hits = 0;
+            for (int64_t j = 0; j < candidates; ++j) {
+                auto index = i * candidates + j;
+                if (candidate_result.seg_offsets_[index] == INVALID_SEG_OFFSET ||
+                    !in_radius(candidate_result.distances_[index])) {
+                    break;
+                }
+                if (!beyond_range_filter(candidate_result.distances_[index])) {
+                    hits++;
+                }
+            }
+            auto last = (i + 1) * candidates - 1;
+            if (hits < topk && candidate_result.seg_offsets_[last] != INVALID_SEG_OFFSET &&
+                in_radius(candidate_result.distances_[last])) {
+                exhausted = false;
+            }
+        }
+        if (exhausted) {
+            break;
+        }
+        candidates = std::min(candidates * 2, max_candidates);
+    }
+
+    SubSearchResult final_result(num_queries, topk, search_info.metric_type_, search_info.round_decimal_);
+    auto& seg_offsets = final_result.mutable_seg_offsets();
+    auto& distances = final_result.mutable_distances();
+    for (int64_t i = 0; i < num_queries; ++i) {
+        int64_t hits = 0;
+        for (int64_t j = 0; j < candidates && hits < topk; ++j) {
+            auto index = i * candidates + j;
+            auto distance = candidate_result.distances_[index];
+            if (candidate_result.seg_offsets_[index] == INVALID_SEG_OFFSET || !in_radius(distance)) {
+                break;
+            }
+            if (beyond_range_filter(distance)) {
+                continue;
+            }
+            seg_offsets[i * topk + hits] = candidate_result.seg_offsets_[index];
+            distances[i * topk + hits] = distance;
+            hits++;
+        }
+    }
+    result.total_nq_ = num_queries;
+    result.unity_topK_ = topk;
+    result.seg_offsets_ = std::move(seg_offsets);
+    result.distances_ = std::move(distances);
+}
+
 template <typename VectorType>
 void
 ExecPlanNodeVisitor::VectorVisitorImpl(VectorPlanNode& node) {
@@ -105,7 +221,13 @@ ExecPlanNodeVisitor::VectorVisitorImpl(VectorPlanNode& node) {
         return;
     }
     BitsetView final_view = *bitset_holder;
-    segment->vector_search(node.search_info_, src_data, num_queries, timestamp_, final_view, search_result);
+    if (node.search_info_.range_search_.has_value()) {
+        auto valid_count = int64_t(bitset_holder->size() - bitset_holder->count());
+        RangeSearch(*segment, node.search_info_, src_data, num_queries, timestamp_, final_view, valid_count,
+                    search_result);
+    } else {
+        segment->vector_search(node.search_info_, src_data, num_queries, timestamp_, final_view, search_result);
+    }
 
     search_result_opt_ = std::move(search_result);
 }
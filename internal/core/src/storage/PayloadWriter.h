@@ -35,6 +35,9 @@ class PayloadWriter {
     void
     add_one_string_payload(const char* str, int str_size);
 
+    void
+    add_one_binary_payload(const uint8_t* data, int length);
+
     void
     finish();
 
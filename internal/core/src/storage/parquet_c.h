@@ -53,6 +53,10 @@ AddDoubleToPayload(CPayloadWriter payloadWriter, double* values, int length);
 CStatus
 AddOneStringToPayload(CPayloadWriter payloadWriter, char* cstr, int str_size);
 CStatus
+AddOneJSONToPayload(CPayloadWriter payloadWriter, uint8_t* values, int length);
+CStatus
+AddOneArrayToPayload(CPayloadWriter payloadWriter, uint8_t* values, int length);
+CStatus
 AddBinaryVectorToPayload(CPayloadWriter payloadWriter, uint8_t* values, int dimension, int length);
 CStatus
 AddFloatVectorToPayload(CPayloadWriter payloadWriter, float* values, int dimension, int length);
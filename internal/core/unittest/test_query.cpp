@@ -9,7 +9,10 @@
 // is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
 // or implied. See the License for the specific language governing permissions and limitations under the License
 
+#include <boost/format.hpp>
 #include <gtest/gtest.h>
+#include <iomanip>
+#include <sstream>
 
 #include "pb/schema.pb.h"
 #include "query/Expr.h"
@@ -562,6 +565,73 @@ TEST(Query, ExecWithoutPredicate) {
     ASSERT_EQ(json.dump(2), ref.dump(2));
 }
 
+TEST(Query, ExecRangeSearch) {
+    using namespace milvus::query;
+    using namespace milvus::segcore;
+    auto schema = std::make_shared<Schema>();
+    auto vec_fid = schema->AddDebugField("fakevec", DataType::VECTOR_FLOAT, 16, knowhere::metric::L2);
+    auto i64_fid = schema->AddDebugField("counter", DataType::INT64);
+    schema->set_primary_field_id(i64_fid);
+
+    int64_t N = 10000;
+    auto dataset = DataGen(schema, N);
+    auto segment = CreateGrowingSegment(schema);
+    segment->PreInsert(N);
+    segment->Insert(0, N, dataset.row_ids_.data(), dataset.timestamps_.data(), dataset.raw_);
+
+    auto create_plan = [&](int64_t topk, const std::string& search_params) {
+        auto text_plan = boost::format(R"(vector_anns: <
+                                            field_id: %1%
+                                            query_info: <
+                                                topk: %2%
+                                                round_decimal: -1
+                                                metric_type: "L2"
+                                                search_params: "%3%"
+                                            >
+                                            placeholder_tag: "$0"
+                                         >)") %
+                         vec_fid.get() % topk % search_params;
+        auto binary_plan = translate_text_plan_to_binary_plan(text_plan.str().data());
+        return CreateSearchPlanByExpr(*schema, binary_plan.data(), binary_plan.size());
+    };
+    auto num_queries = 1;
+    auto ph_group_raw = CreatePlaceholderGroup(num_queries, 16, 1024);
+    Timestamp time = 1000000;
+
+    auto plan = create_plan(100, R"({\"nprobe\": 10})");
+    auto ph_group = ParsePlaceholderGroup(plan.get(), ph_group_raw.SerializeAsString());
+    auto ref = segment->Search(plan.get(), ph_group.get(), time);
+
+    // the hits in range are ranked from 20 to 59, they are out of the top 10 hits
+    std::ostringstream search_params;
+    search_params << std::setprecision(9) << R"({\"nprobe\": 10, \"radius\": )"
+                  << (ref->distances_[59] + ref->distances_[60]) / 2 << R"(, \"range_filter\": )"
+                  << (ref->distances_[19] + ref->distances_[20]) / 2 << "}";
+    auto range_plan = create_plan(10, search_params.str());
+    auto range_ph_group = ParsePlaceholderGroup(range_plan.get(), ph_group_raw.SerializeAsString());
+    auto sr = segment->Search(range_plan.get(), range_ph_group.get(), time);
+    ASSERT_EQ(sr->seg_offsets_.size(), 10);
+    for (int i = 0; i < 10; ++i) {
+        ASSERT_EQ(sr->seg_offsets_[i], ref->seg_offsets_[20 + i]);
+        ASSERT_EQ(sr->distances_[i], ref->distances_[20 + i]);
+    }
+
+    // only the hits within the radius are returned
+    std::ostringstream radius_params;
+    radius_params << std::setprecision(9) << R"({\"nprobe\": 10, \"radius\": )"
+                  << (ref->distances_[4] + ref->distances_[5]) / 2 << "}";
+    range_plan = create_plan(10, radius_params.str());
+    range_ph_group = ParsePlaceholderGroup(range_plan.get(), ph_group_raw.SerializeAsString());
+    sr = segment->Search(range_plan.get(), range_ph_group.get(), time);
+    for (int i = 0; i < 10; ++i) {
+        if (i < 5) {
+            ASSERT_EQ(sr->seg_offsets_[i], ref->seg_offsets_[i]);
+        } else {
+            ASSERT_EQ(sr->seg_offsets_[i], INVALID_SEG_OFFSET);
+        }
+    }
+}
+
 TEST(Query, InnerProduct) {
     int64_t N = 100000;
     constexpr auto dim = 16;
@@ -120,6 +120,11 @@ func (c *Cluster) Import(ctx context.Context, nodeID int64, it *datapb.ImportTas
 	c.sessionManager.Import(ctx, nodeID, it)
 }
 
+// Export sends export requests to DataNodes whose ID==nodeID.
+func (c *Cluster) Export(ctx context.Context, nodeID int64, et *datapb.ExportTaskRequest) {
+	c.sessionManager.Export(ctx, nodeID, et)
+}
+
 // ReCollectSegmentStats triggers a ReCollectSegmentStats call from session manager.
 func (c *Cluster) ReCollectSegmentStats(ctx context.Context, nodeID int64) {
 	c.sessionManager.ReCollectSegmentStats(ctx, nodeID)
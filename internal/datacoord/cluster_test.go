@@ -585,6 +585,38 @@ func TestCluster_Import(t *testing.T) {
 	time.Sleep(500 * time.Millisecond)
 }
 
+func TestCluster_Export(t *testing.T) {
+	kv := getMetaKv(t)
+	defer func() {
+		kv.RemoveWithPrefix("")
+		kv.Close()
+	}()
+
+	ctx, cancel := context.WithTimeout(context.TODO(), 100*time.Millisecond)
+	defer cancel()
+	sessionManager := NewSessionManager()
+	channelManager, err := NewChannelManager(kv, newMockHandler())
+	assert.Nil(t, err)
+	cluster := NewCluster(sessionManager, channelManager)
+	defer cluster.Close()
+	addr := "localhost:8080"
+	info := &NodeInfo{
+		Address: addr,
+		NodeID:  1,
+	}
+	nodes := []*NodeInfo{info}
+	err = cluster.Startup(ctx, nodes)
+	assert.Nil(t, err)
+
+	err = cluster.Watch("chan-1", 1)
+	assert.NoError(t, err)
+
+	assert.NotPanics(t, func() {
+		cluster.Export(ctx, 1, &datapb.ExportTaskRequest{})
+	})
+	time.Sleep(500 * time.Millisecond)
+}
+
 func TestCluster_ReCollectSegmentStats(t *testing.T) {
 	kv := getMetaKv(t)
 	defer func() {
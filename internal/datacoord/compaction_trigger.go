@@ -520,7 +520,7 @@ func (t *compactionTrigger) generatePlans(segments []*SegmentInfo, force bool, c
 		if segment.GetNumOfRows() < segment.GetMaxRowNum() {
 			var result []*SegmentInfo
 			free := segment.GetMaxRowNum() - segment.GetNumOfRows()
-			maxNum := Params.DataCoordCfg.MaxSegmentToMerge - 1
+			maxNum := Params.DataCoordCfg.GetMaxSegmentToMerge() - 1
 			prioritizedCandidates, result, free = greedySelect(prioritizedCandidates, free, maxNum)
 			bucket = append(bucket, result...)
 			maxNum -= len(result)
@@ -555,7 +555,7 @@ func (t *compactionTrigger) generatePlans(segments []*SegmentInfo, force bool, c
 		// for small segment merge, we pick one largest segment and merge as much as small segment together with it
 		// Why reverse?	 try to merge as many segments as expected.
 		// for instance, if a 255M and 255M is the largest small candidates, they will never be merged because of the MinSegmentToMerge limit.
-		smallCandidates, result, _ = reverseGreedySelect(smallCandidates, free, Params.DataCoordCfg.MaxSegmentToMerge-1)
+		smallCandidates, result, _ = reverseGreedySelect(smallCandidates, free, Params.DataCoordCfg.GetMaxSegmentToMerge()-1)
 		bucket = append(bucket, result...)
 
 		var size int64
@@ -565,7 +565,7 @@ func (t *compactionTrigger) generatePlans(segments []*SegmentInfo, force bool, c
 			targetRow += s.GetNumOfRows()
 		}
 		// only merge if candidate number is large than MinSegmentToMerge or if target row is large enough
-		if len(bucket) >= Params.DataCoordCfg.MinSegmentToMerge || targetRow > int64(float64(segment.GetMaxRowNum())*Params.DataCoordCfg.SegmentSmallProportion) {
+		if len(bucket) >= Params.DataCoordCfg.GetMinSegmentToMerge() || targetRow > int64(float64(segment.GetMaxRowNum())*Params.DataCoordCfg.GetSegmentSmallProportion()) {
 			plan := segmentsToPlan(bucket, compactTime)
 			log.Info("generate a plan for small candidates", zap.Any("plan", plan),
 				zap.Int64("target segment row", targetRow), zap.Int64("target segment size", size))
@@ -648,7 +648,7 @@ func (t *compactionTrigger) getCandidateSegments(channel string, partitionID Uni
 }
 
 func (t *compactionTrigger) isSmallSegment(segment *SegmentInfo) bool {
-	return segment.GetNumOfRows() < int64(float64(segment.GetMaxRowNum())*Params.DataCoordCfg.SegmentSmallProportion)
+	return segment.GetNumOfRows() < int64(float64(segment.GetMaxRowNum())*Params.DataCoordCfg.GetSegmentSmallProportion())
 }
 
 func (t *compactionTrigger) fillOriginPlan(plan *datapb.CompactionPlan) error {
@@ -658,7 +658,7 @@ func (t *compactionTrigger) fillOriginPlan(plan *datapb.CompactionPlan) error {
 		return err
 	}
 	plan.PlanID = id
-	plan.TimeoutInSeconds = Params.DataCoordCfg.CompactionTimeoutInSeconds
+	plan.TimeoutInSeconds = Params.DataCoordCfg.GetCompactionTimeoutInSeconds()
 	return nil
 }
 
@@ -681,7 +681,7 @@ func (t *compactionTrigger) ShouldDoSingleCompaction(segment *SegmentInfo, compa
 		totalLogNum += len(statsLogs.GetBinlogs())
 	}
 	// avoid segment has too many bin logs and the etcd meta is too large, force trigger compaction
-	if totalLogNum > int(Params.DataCoordCfg.SingleCompactionBinlogMaxNum) {
+	if totalLogNum > int(Params.DataCoordCfg.GetSingleCompactionBinlogMaxNum()) {
 		log.Info("total binlog number is too much, trigger compaction", zap.Int64("segment", segment.ID),
 			zap.Int("Delta logs", len(segment.GetDeltalogs())), zap.Int("Bin Logs", len(segment.GetBinlogs())), zap.Int("Stat logs", len(segment.GetStatslogs())))
 		return true
@@ -700,7 +700,7 @@ func (t *compactionTrigger) ShouldDoSingleCompaction(segment *SegmentInfo, compa
 		}
 	}
 
-	if float32(totalExpiredRows)/float32(segment.GetNumOfRows()) >= Params.DataCoordCfg.SingleCompactionRatioThreshold || totalExpiredSize > Params.DataCoordCfg.SingleCompactionExpiredLogMaxSize {
+	if float32(totalExpiredRows)/float32(segment.GetNumOfRows()) >= Params.DataCoordCfg.GetSingleCompactionRatioThreshold() || totalExpiredSize > Params.DataCoordCfg.GetSingleCompactionExpiredLogMaxSize() {
 		log.Info("total expired entities is too much, trigger compation", zap.Int64("segment", segment.ID),
 			zap.Int("expired rows", totalExpiredRows), zap.Int64("expired log size", totalExpiredSize))
 		return true
@@ -726,7 +726,7 @@ func (t *compactionTrigger) ShouldDoSingleCompaction(segment *SegmentInfo, compa
 	}
 
 	// currently delta log size and delete ratio policy is applied
-	if float32(totalDeletedRows)/float32(segment.GetNumOfRows()) >= Params.DataCoordCfg.SingleCompactionRatioThreshold || totalDeleteLogSize > Params.DataCoordCfg.SingleCompactionDeltaLogMaxSize {
+	if float32(totalDeletedRows)/float32(segment.GetNumOfRows()) >= Params.DataCoordCfg.GetSingleCompactionRatioThreshold() || totalDeleteLogSize > Params.DataCoordCfg.GetSingleCompactionDeltaLogMaxSize() {
 		log.Info("total delete entities is too much, trigger compation", zap.Int64("segment", segment.ID),
 			zap.Int("deleted rows", totalDeletedRows), zap.Int64("delete log size", totalDeleteLogSize))
 		return true
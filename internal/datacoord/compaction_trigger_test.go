@@ -331,7 +331,7 @@ func Test_compactionTrigger_force(t *testing.T) {
 						},
 					},
 					StartTime:        0,
-					TimeoutInSeconds: Params.DataCoordCfg.CompactionTimeoutInSeconds,
+					TimeoutInSeconds: Params.DataCoordCfg.GetCompactionTimeoutInSeconds(),
 					Type:             datapb.CompactionType_MixCompaction,
 					Timetravel:       timeTravel,
 					Channel:          "ch1",
@@ -766,7 +766,7 @@ func Test_compactionTrigger_force_maxSegmentLimit(t *testing.T) {
 						},
 					},
 					StartTime:        3,
-					TimeoutInSeconds: Params.DataCoordCfg.CompactionTimeoutInSeconds,
+					TimeoutInSeconds: Params.DataCoordCfg.GetCompactionTimeoutInSeconds(),
 					Type:             datapb.CompactionType_MixCompaction,
 					Timetravel:       200,
 					Channel:          "ch1",
@@ -48,6 +48,7 @@ type GcOption struct {
 	checkInterval    time.Duration        // each interval
 	missingTolerance time.Duration        // key missing in meta tolerance time
 	dropTolerance    time.Duration        // dropped segment related key tolerance time
+	restoreWindow    time.Duration        // dropped segments are kept for point-in-time restore within the window
 }
 
 // garbageCollector handles garbage files in object storage
@@ -63,12 +64,17 @@ type garbageCollector struct {
 	stopOnce  sync.Once
 	wg        sync.WaitGroup
 	closeCh   chan struct{}
+
+	// optionMu protects the intervals and tolerances of option, which are refreshed live
+	optionMu  sync.RWMutex
+	refreshCh chan struct{}
 }
 
 // newGarbageCollector create garbage collector with meta and option
 func newGarbageCollector(meta *meta, handler Handler, segRefer *SegmentReferenceManager, indexCoord types.IndexCoord, opt GcOption) *garbageCollector {
 	log.Info("GC with option", zap.Bool("enabled", opt.enabled), zap.Duration("interval", opt.checkInterval),
-		zap.Duration("missingTolerance", opt.missingTolerance), zap.Duration("dropTolerance", opt.dropTolerance))
+		zap.Duration("missingTolerance", opt.missingTolerance), zap.Duration("dropTolerance", opt.dropTolerance),
+		zap.Duration("restoreWindow", opt.restoreWindow))
 	return &garbageCollector{
 		meta:       meta,
 		handler:    handler,
@@ -76,6 +82,7 @@ func newGarbageCollector(meta *meta, handler Handler, segRefer *SegmentReference
 		indexCoord: indexCoord,
 		option:     opt,
 		closeCh:    make(chan struct{}),
+		refreshCh:  make(chan struct{}, 1),
 	}
 }
 
@@ -96,12 +103,15 @@ func (gc *garbageCollector) start() {
 // work contains actual looping check logic
 func (gc *garbageCollector) work() {
 	defer gc.wg.Done()
-	ticker := time.Tick(gc.option.checkInterval)
+	ticker := time.NewTicker(gc.getCheckInterval())
+	defer ticker.Stop()
 	for {
 		select {
-		case <-ticker:
+		case <-ticker.C:
 			gc.clearEtcd()
 			gc.scan()
+		case <-gc.refreshCh:
+			ticker.Reset(gc.getCheckInterval())
 		case <-gc.closeCh:
 			log.Warn("garbage collector quit")
 			return
@@ -109,6 +119,59 @@ func (gc *garbageCollector) work() {
 	}
 }
 
+// refreshOption applies the intervals and tolerances refreshed in paramtable to the running gc
+func (gc *garbageCollector) refreshOption(checkInterval, missingTolerance, dropTolerance, restoreWindow time.Duration) {
+	gc.optionMu.Lock()
+	gc.option.checkInterval = checkInterval
+	gc.option.missingTolerance = missingTolerance
+	gc.option.dropTolerance = dropTolerance
+	gc.option.restoreWindow = restoreWindow
+	gc.optionMu.Unlock()
+	log.Info("GC option refreshed", zap.Duration("interval", checkInterval),
+		zap.Duration("missingTolerance", missingTolerance), zap.Duration("dropTolerance", dropTolerance),
+		zap.Duration("restoreWindow", restoreWindow))
+
+	select {
+	case gc.refreshCh <- struct{}{}:
+	default:
+	}
+}
+
+func (gc *garbageCollector) getCheckInterval() time.Duration {
+	gc.optionMu.RLock()
+	defer gc.optionMu.RUnlock()
+	return gc.option.checkInterval
+}
+
+func (gc *garbageCollector) getMissingTolerance() time.Duration {
+	gc.optionMu.RLock()
+	defer gc.optionMu.RUnlock()
+	return gc.option.missingTolerance
+}
+
+func (gc *garbageCollector) getDropTolerance() time.Duration {
+	gc.optionMu.RLock()
+	defer gc.optionMu.RUnlock()
+	return gc.option.dropTolerance
+}
+
+func (gc *garbageCollector) getRestoreWindow() time.Duration {
+	gc.optionMu.RLock()
+	defer gc.optionMu.RUnlock()
+	return gc.option.restoreWindow
+}
+
+// getRetention returns how long a dropped segment is kept, segments dropped within the retention
+// are still available for point-in-time restore
+func (gc *garbageCollector) getRetention() time.Duration {
+	gc.optionMu.RLock()
+	defer gc.optionMu.RUnlock()
+	if gc.option.restoreWindow > gc.option.dropTolerance {
+		return gc.option.restoreWindow
+	}
+	return gc.option.dropTolerance
+}
+
 func (gc *garbageCollector) close() {
 	gc.stopOnce.Do(func() {
 		close(gc.closeCh)
@@ -182,7 +245,7 @@ func (gc *garbageCollector) scan() {
 			}
 
 			// not found in meta, check last modified time exceeds tolerance duration
-			if time.Since(modTimes[i]) > gc.option.missingTolerance {
+			if time.Since(modTimes[i]) > gc.getMissingTolerance() {
 				// ignore error since it could be cleaned up next time
 				removedKeys = append(removedKeys, infoKey)
 				err = gc.option.cli.Remove(ctx, infoKey)
@@ -248,7 +311,7 @@ func (gc *garbageCollector) clearEtcd() {
 
 func (gc *garbageCollector) isExpire(dropts Timestamp) bool {
 	droptime := time.Unix(0, int64(dropts))
-	return time.Since(droptime) > gc.option.dropTolerance
+	return time.Since(droptime) > gc.getRetention()
 }
 
 func getLogs(sinfo *SegmentInfo) []*datapb.Binlog {
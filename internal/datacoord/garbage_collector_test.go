@@ -90,6 +90,31 @@ func Test_garbageCollector_basic(t *testing.T) {
 		})
 	})
 
+	t.Run("refresh option", func(t *testing.T) {
+		gc := newGarbageCollector(meta, newMockHandler(), segRefer, indexCoord, GcOption{
+			cli:              cli,
+			enabled:          true,
+			checkInterval:    time.Hour,
+			missingTolerance: time.Hour * 24,
+			dropTolerance:    time.Hour * 24,
+		})
+		gc.start()
+
+		gc.refreshOption(time.Millisecond*10, time.Hour, time.Minute, 0)
+		assert.Equal(t, time.Millisecond*10, gc.getCheckInterval())
+		assert.Equal(t, time.Hour, gc.getMissingTolerance())
+		assert.Equal(t, time.Minute, gc.getDropTolerance())
+		assert.Equal(t, time.Minute, gc.getRetention())
+		// does not block when the refresh is not consumed yet
+		gc.refreshOption(time.Millisecond*10, time.Hour, time.Minute, time.Hour)
+		assert.Equal(t, time.Hour, gc.getRestoreWindow())
+		assert.Equal(t, time.Hour, gc.getRetention())
+
+		time.Sleep(time.Millisecond * 20)
+		assert.NotPanics(t, func() {
+			gc.close()
+		})
+	})
 }
 
 func validateMinioPrefixElements(t *testing.T, cli *minio.Client, bucketName string, prefix string, elements []string) {
@@ -218,7 +243,14 @@ func Test_garbageCollector_scan(t *testing.T) {
 			checkInterval:    time.Minute * 30,
 			missingTolerance: time.Hour * 24,
 			dropTolerance:    0,
+			restoreWindow:    time.Hour * 2,
 		})
+		// kept for restore within the restore window
+		gc.clearEtcd()
+		validateMinioPrefixElements(t, cli.Client, bucketName, path.Join(rootPath, insertLogPrefix), inserts)
+		assert.NotNil(t, meta.GetSegment(segment.GetID()))
+
+		gc.refreshOption(time.Minute*30, time.Hour*24, 0, 0)
 		gc.clearEtcd()
 		validateMinioPrefixElements(t, cli.Client, bucketName, path.Join(rootPath, insertLogPrefix), inserts[1:])
 		validateMinioPrefixElements(t, cli.Client, bucketName, path.Join(rootPath, statsLogPrefix), stats[1:])
@@ -61,9 +61,14 @@ type collectionInfo struct {
 
 // NewMeta creates meta from provided `kv.TxnKV`
 func newMeta(ctx context.Context, kv kv.TxnKV, chunkManagerRootPath string) (*meta, error) {
+	return newMetaWithCatalog(ctx, &datacoord.Catalog{Txn: kv, ChunkManagerRootPath: chunkManagerRootPath})
+}
+
+// newMetaWithCatalog creates meta from the provided catalog
+func newMetaWithCatalog(ctx context.Context, catalog metastore.DataCoordCatalog) (*meta, error) {
 	mt := &meta{
 		ctx:         ctx,
-		catalog:     &datacoord.Catalog{Txn: kv, ChunkManagerRootPath: chunkManagerRootPath},
+		catalog:     catalog,
 		collections: make(map[UniqueID]*collectionInfo),
 		segments:    NewSegmentsInfo(),
 		channelCPs:  make(map[string]*internalpb.MsgPosition),
@@ -318,6 +323,9 @@ func (m *meta) SetState(segmentID UniqueID, targetState commonpb.SegmentState) e
 	// Persist segment updates first.
 	clonedSegment := curSegInfo.Clone()
 	clonedSegment.State = targetState
+	if targetState == commonpb.SegmentState_Dropped && isSegmentHealthy(curSegInfo) {
+		clonedSegment.DroppedAt = uint64(time.Now().UnixNano())
+	}
 	oldState := curSegInfo.GetState()
 	if clonedSegment != nil && isSegmentHealthy(clonedSegment) {
 		if err := m.catalog.AlterSegment(m.ctx, clonedSegment.SegmentInfo, curSegInfo.SegmentInfo); err != nil {
@@ -337,7 +345,7 @@ func (m *meta) SetState(segmentID UniqueID, targetState commonpb.SegmentState) e
 		}
 	}
 	// Update in-memory meta.
-	m.segments.SetState(segmentID, targetState)
+	m.segments.SetSegment(segmentID, clonedSegment)
 	log.Info("meta update: setting segment state - complete",
 		zap.Int64("segment ID", segmentID),
 		zap.String("target state", targetState.String()))
@@ -554,6 +562,9 @@ func (m *meta) UpdateDropChannelSegmentInfo(channel string, segments []*SegmentI
 		// seg inf mod segments are all in dropped state
 		if !ok {
 			clonedSeg := seg.Clone()
+			if isSegmentHealthy(seg) {
+				clonedSeg.DroppedAt = uint64(time.Now().UnixNano())
+			}
 			clonedSeg.State = commonpb.SegmentState_Dropped
 			modSegments[seg.ID] = clonedSeg
 			originSegments[seg.GetID()] = seg
@@ -592,6 +603,7 @@ func (m *meta) mergeDropSegment(seg2Drop *SegmentInfo) *SegmentInfo {
 
 	clonedSegment := segment.Clone()
 	clonedSegment.State = commonpb.SegmentState_Dropped
+	clonedSegment.DroppedAt = uint64(time.Now().UnixNano())
 
 	currBinlogs := clonedSegment.GetBinlogs()
 
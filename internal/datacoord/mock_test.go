@@ -259,6 +259,10 @@ func (c *mockDataNodeClient) Import(ctx context.Context, in *datapb.ImportTaskRe
 	return &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success}, nil
 }
 
+func (c *mockDataNodeClient) Export(ctx context.Context, in *datapb.ExportTaskRequest) (*commonpb.Status, error) {
+	return &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success}, nil
+}
+
 func (c *mockDataNodeClient) AddImportSegment(ctx context.Context, req *datapb.AddImportSegmentRequest) (*datapb.AddImportSegmentResponse, error) {
 	return c.addImportSegmentResp, nil
 }
@@ -543,6 +547,32 @@ func (m *mockRootCoordService) ReportImport(ctx context.Context, req *rootcoordp
 	}, nil
 }
 
+func (m *mockRootCoordService) Export(ctx context.Context, req *rootcoordpb.ExportRequest) (*rootcoordpb.ExportResponse, error) {
+	panic("not implemented") // TODO: Implement
+}
+
+func (m *mockRootCoordService) GetExportState(ctx context.Context, req *rootcoordpb.GetExportStateRequest) (*rootcoordpb.GetExportStateResponse, error) {
+	panic("not implemented") // TODO: Implement
+}
+
+func (m *mockRootCoordService) ListExportTasks(ctx context.Context, req *rootcoordpb.ListExportTasksRequest) (*rootcoordpb.ListExportTasksResponse, error) {
+	panic("not implemented") // TODO: Implement
+}
+
+func (m *mockRootCoordService) ReportExport(ctx context.Context, req *rootcoordpb.ExportResult) (*commonpb.Status, error) {
+	return &commonpb.Status{
+		ErrorCode: commonpb.ErrorCode_Success,
+	}, nil
+}
+
+func (m *mockRootCoordService) RestoreCollection(ctx context.Context, req *rootcoordpb.RestoreCollectionRequest) (*rootcoordpb.RestoreCollectionResponse, error) {
+	panic("not implemented") // TODO: Implement
+}
+
+func (m *mockRootCoordService) GetRestoreState(ctx context.Context, req *rootcoordpb.GetRestoreStateRequest) (*rootcoordpb.GetRestoreStateResponse, error) {
+	panic("not implemented") // TODO: Implement
+}
+
 type mockCompactionHandler struct {
 	methods map[string]interface{}
 }
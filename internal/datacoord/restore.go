@@ -0,0 +1,588 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datacoord
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+	"github.com/milvus-io/milvus/internal/common"
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/proto/etcdpb"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/internal/util/commonpbutil"
+	"github.com/milvus-io/milvus/internal/util/compressor"
+	"github.com/milvus-io/milvus/internal/util/metautil"
+	"github.com/milvus-io/milvus/internal/util/paramtable"
+	"github.com/milvus-io/milvus/internal/util/tsoutil"
+)
+
+// isSegmentValidAt returns whether the segment held data of the collection at the timestamp.
+// A segment dropped after the timestamp is still valid, unless it is replaced by the segments it was compacted from,
+// which are valid at the timestamp as well.
+func isSegmentValidAt(segment *SegmentInfo, segments map[UniqueID]*SegmentInfo, ts Timestamp) bool {
+	if segment.GetIsImporting() || segment.GetIsFake() {
+		return false
+	}
+	dropTs := uint64(tsoutil.PhysicalTime(ts).UnixNano())
+	switch segment.GetState() {
+	case commonpb.SegmentState_Growing, commonpb.SegmentState_Sealed,
+		commonpb.SegmentState_Flushing, commonpb.SegmentState_Flushed:
+	case commonpb.SegmentState_Dropped:
+		if segment.GetDroppedAt() <= dropTs {
+			return false
+		}
+	default:
+		return false
+	}
+	// the segment is compacted after the timestamp, if any segment it was compacted from is dropped after the timestamp
+	for _, from := range segment.GetCompactionFrom() {
+		if source, ok := segments[from]; ok && source.GetDroppedAt() > dropTs {
+			return false
+		}
+	}
+	return true
+}
+
+// selectRestoreSegments returns the segments of the collection valid at the timestamp.
+func selectRestoreSegments(segments []*SegmentInfo, ts Timestamp) []*SegmentInfo {
+	segmentMap := make(map[UniqueID]*SegmentInfo, len(segments))
+	for _, segment := range segments {
+		segmentMap[segment.GetID()] = segment
+	}
+	ret := make([]*SegmentInfo, 0, len(segments))
+	for _, segment := range segments {
+		if isSegmentValidAt(segment, segmentMap, ts) {
+			ret = append(ret, segment)
+		}
+	}
+	return ret
+}
+
+// filterInsertBinlogs returns the insert binlogs of the batches flushed with any row inserted before the timestamp.
+// Binlogs of all fields are flushed in batches, the batches after the timestamp are filtered out, while a batch
+// straddling the timestamp is kept, the rows inserted after the timestamp are filtered out when it is rewritten.
+func filterInsertBinlogs(fieldBinlogs []*datapb.FieldBinlog, ts Timestamp) ([]*datapb.FieldBinlog, error) {
+	ret := make([]*datapb.FieldBinlog, 0, len(fieldBinlogs))
+	batches := -1
+	for _, fieldBinlog := range fieldBinlogs {
+		binlogs := make([]*datapb.Binlog, 0, len(fieldBinlog.GetBinlogs()))
+		for _, binlog := range fieldBinlog.GetBinlogs() {
+			if binlog.GetTimestampFrom() <= ts {
+				binlogs = append(binlogs, binlog)
+			}
+		}
+		if batches >= 0 && len(binlogs) != batches {
+			return nil, fmt.Errorf("field %d has %d binlogs before the timestamp, expected %d",
+				fieldBinlog.GetFieldID(), len(binlogs), batches)
+		}
+		batches = len(binlogs)
+		ret = append(ret, &datapb.FieldBinlog{
+			FieldID: fieldBinlog.GetFieldID(),
+			Binlogs: binlogs,
+		})
+	}
+	return ret, nil
+}
+
+// filterRows returns the rows at the offsets, each row takes width elements of data.
+func filterRows[T any](data []T, offsets []int, width int) []T {
+	ret := make([]T, 0, len(offsets)*width)
+	for _, offset := range offsets {
+		ret = append(ret, data[offset*width:(offset+1)*width]...)
+	}
+	return ret
+}
+
+// filterFieldData returns the rows of the field data at the offsets.
+func filterFieldData(field storage.FieldData, offsets []int) (storage.FieldData, error) {
+	numRows := []int64{int64(len(offsets))}
+	switch field := field.(type) {
+	case *storage.BoolFieldData:
+		return &storage.BoolFieldData{NumRows: numRows, Data: filterRows(field.Data, offsets, 1)}, nil
+	case *storage.Int8FieldData:
+		return &storage.Int8FieldData{NumRows: numRows, Data: filterRows(field.Data, offsets, 1)}, nil
+	case *storage.Int16FieldData:
+		return &storage.Int16FieldData{NumRows: numRows, Data: filterRows(field.Data, offsets, 1)}, nil
+	case *storage.Int32FieldData:
+		return &storage.Int32FieldData{NumRows: numRows, Data: filterRows(field.Data, offsets, 1)}, nil
+	case *storage.Int64FieldData:
+		return &storage.Int64FieldData{NumRows: numRows, Data: filterRows(field.Data, offsets, 1)}, nil
+	case *storage.FloatFieldData:
+		return &storage.FloatFieldData{NumRows: numRows, Data: filterRows(field.Data, offsets, 1)}, nil
+	case *storage.DoubleFieldData:
+		return &storage.DoubleFieldData{NumRows: numRows, Data: filterRows(field.Data, offsets, 1)}, nil
+	case *storage.StringFieldData:
+		return &storage.StringFieldData{NumRows: numRows, Data: filterRows(field.Data, offsets, 1)}, nil
+	case *storage.JSONFieldData:
+		return &storage.JSONFieldData{NumRows: numRows, Data: filterRows(field.Data, offsets, 1)}, nil
+	case *storage.ArrayFieldData:
+		return &storage.ArrayFieldData{ElementType: field.ElementType, NumRows: numRows, Data: filterRows(field.Data, offsets, 1)}, nil
+	case *storage.BinaryVectorFieldData:
+		return &storage.BinaryVectorFieldData{NumRows: numRows, Data: filterRows(field.Data, offsets, field.Dim/8), Dim: field.Dim}, nil
+	case *storage.FloatVectorFieldData:
+		return &storage.FloatVectorFieldData{NumRows: numRows, Data: filterRows(field.Data, offsets, field.Dim), Dim: field.Dim}, nil
+	default:
+		return nil, fmt.Errorf("unsupported field data type %T", field)
+	}
+}
+
+// filterInsertData returns the rows inserted before the timestamp, and the range of their timestamps.
+func filterInsertData(data *storage.InsertData, ts Timestamp) (*storage.InsertData, Timestamp, Timestamp, error) {
+	tsField, ok := data.Data[common.TimeStampField].(*storage.Int64FieldData)
+	if !ok {
+		return nil, 0, 0, errors.New("timestamp field is not found in insert data")
+	}
+	offsets := make([]int, 0, len(tsField.Data))
+	tsFrom, tsTo := Timestamp(math.MaxUint64), Timestamp(0)
+	for offset, v := range tsField.Data {
+		rowTs := Timestamp(v)
+		if rowTs > ts {
+			continue
+		}
+		offsets = append(offsets, offset)
+		if rowTs < tsFrom {
+			tsFrom = rowTs
+		}
+		if rowTs > tsTo {
+			tsTo = rowTs
+		}
+	}
+	if len(offsets) == len(tsField.Data) {
+		return data, tsFrom, tsTo, nil
+	}
+
+	ret := &storage.InsertData{Data: make(map[storage.FieldID]storage.FieldData, len(data.Data)), Infos: data.Infos}
+	for fieldID, field := range data.Data {
+		filtered, err := filterFieldData(field, offsets)
+		if err != nil {
+			return nil, 0, 0, fmt.Errorf("failed to filter field %d: %w", fieldID, err)
+		}
+		ret.Data[fieldID] = filtered
+	}
+	return ret, tsFrom, tsTo, nil
+}
+
+// segmentRestorer writes the data of a segment valid at a timestamp into a new segment of another collection.
+// The insert and delete binlogs are rewritten since the ids of the segment are encoded in them.
+type segmentRestorer struct {
+	chunkManager storage.ChunkManager
+	allocator    allocator
+	insertCodec  *storage.InsertCodec
+	deleteCodec  *storage.DeleteCodec
+	ts           Timestamp
+}
+
+func newSegmentRestorer(chunkManager storage.ChunkManager, alloc allocator, collection *collectionInfo, ts Timestamp) *segmentRestorer {
+	compression := storage.WithCompression(compressor.CompressType(Params.CommonCfg.StorageCompression))
+	return &segmentRestorer{
+		chunkManager: chunkManager,
+		allocator:    alloc,
+		insertCodec:  storage.NewInsertCodec(&etcdpb.CollectionMeta{ID: collection.ID, Schema: collection.Schema}, compression),
+		deleteCodec:  storage.NewDeleteCodec(compression),
+		ts:           ts,
+	}
+}
+
+// isEmpty returns whether the segment has no row inserted before the timestamp.
+func (r *segmentRestorer) isEmpty(segment *SegmentInfo) (bool, error) {
+	binlogs, err := filterInsertBinlogs(segment.GetBinlogs(), r.ts)
+	if err != nil {
+		return false, err
+	}
+	return len(binlogs) == 0 || len(binlogs[0].GetBinlogs()) == 0, nil
+}
+
+// restore writes the binlogs of the segment into the target segment,
+// the returned segment info only carries the ids, the number of rows and the binlogs.
+func (r *segmentRestorer) restore(ctx context.Context, segment *SegmentInfo, partitionID, segmentID UniqueID) (*datapb.SegmentInfo, error) {
+	collectionID := r.insertCodec.Schema.GetID()
+	binlogs, err := filterInsertBinlogs(segment.GetBinlogs(), r.ts)
+	if err != nil {
+		return nil, err
+	}
+	restored := &datapb.SegmentInfo{
+		ID:           segmentID,
+		CollectionID: collectionID,
+		PartitionID:  partitionID,
+	}
+	if len(binlogs) == 0 || len(binlogs[0].GetBinlogs()) == 0 {
+		return restored, nil
+	}
+	if restored.Binlogs, restored.NumOfRows, err = r.restoreInsertBinlogs(ctx, binlogs, partitionID, segmentID); err != nil {
+		return nil, err
+	}
+	if restored.NumOfRows == 0 {
+		return restored, nil
+	}
+	if restored.Statslogs, err = r.restoreStatslogs(ctx, segment.GetStatslogs(), partitionID, segmentID); err != nil {
+		return nil, err
+	}
+	if restored.Deltalogs, err = r.restoreDeltalogs(ctx, segment.GetDeltalogs(), partitionID, segmentID); err != nil {
+		return nil, err
+	}
+	return restored, nil
+}
+
+// restoreInsertBinlogs rewrites the insert binlogs batch by batch with the rows inserted before the timestamp,
+// and returns the rewritten binlogs and the number of rows in them.
+func (r *segmentRestorer) restoreInsertBinlogs(ctx context.Context, fieldBinlogs []*datapb.FieldBinlog, partitionID, segmentID UniqueID) ([]*datapb.FieldBinlog, int64, error) {
+	collectionID := r.insertCodec.Schema.GetID()
+	ret := make([]*datapb.FieldBinlog, 0, len(fieldBinlogs))
+	fieldIdx := make(map[UniqueID]int, len(fieldBinlogs))
+	for idx, fieldBinlog := range fieldBinlogs {
+		fieldIdx[fieldBinlog.GetFieldID()] = idx
+		ret = append(ret, &datapb.FieldBinlog{FieldID: fieldBinlog.GetFieldID()})
+	}
+	batches := len(fieldBinlogs[0].GetBinlogs())
+	for _, fieldBinlog := range fieldBinlogs {
+		if len(fieldBinlog.GetBinlogs()) != batches {
+			return nil, 0, fmt.Errorf("field %d has %d binlogs, expected %d", fieldBinlog.GetFieldID(), len(fieldBinlog.GetBinlogs()), batches)
+		}
+	}
+
+	var rows int64
+	for i := 0; i < batches; i++ {
+		// the binlogs of all fields in a batch are serialized together
+		paths := make([]string, 0, len(fieldBinlogs))
+		for _, fieldBinlog := range fieldBinlogs {
+			paths = append(paths, fieldBinlog.GetBinlogs()[i].GetLogPath())
+		}
+		values, err := r.chunkManager.MultiRead(ctx, paths)
+		if err != nil {
+			return nil, 0, err
+		}
+		blobs := make([]*storage.Blob, 0, len(paths))
+		for idx, value := range values {
+			blobs = append(blobs, &storage.Blob{Key: paths[idx], Value: value})
+		}
+		_, _, _, data, err := r.insertCodec.DeserializeAll(blobs)
+		if err != nil {
+			return nil, 0, err
+		}
+		for _, field := range r.insertCodec.Schema.GetSchema().GetFields() {
+			if _, ok := data.Data[field.GetFieldID()]; !ok {
+				return nil, 0, fmt.Errorf("field %d is not found in binlog %s", field.GetFieldID(), paths[0])
+			}
+		}
+		// a batch straddling the timestamp keeps the rows inserted before the timestamp only
+		data, tsFrom, tsTo, err := filterInsertData(data, r.ts)
+		if err != nil {
+			return nil, 0, err
+		}
+		batchRows := int64(data.Data[common.TimeStampField].RowNum())
+		if batchRows == 0 {
+			continue
+		}
+		serialized, _, err := r.insertCodec.Serialize(partitionID, segmentID, data)
+		if err != nil {
+			return nil, 0, err
+		}
+
+		kvs := make(map[string][]byte, len(serialized))
+		for _, blob := range serialized {
+			fieldID, err := strconv.ParseInt(blob.GetKey(), 10, 64)
+			if err != nil {
+				return nil, 0, err
+			}
+			idx, ok := fieldIdx[fieldID]
+			if !ok {
+				continue
+			}
+			logID, err := r.allocator.allocID(ctx)
+			if err != nil {
+				return nil, 0, err
+			}
+			logPath := metautil.BuildInsertLogPath(r.chunkManager.RootPath(), collectionID, partitionID, segmentID, fieldID, logID)
+			kvs[logPath] = blob.GetValue()
+			ret[idx].Binlogs = append(ret[idx].Binlogs, &datapb.Binlog{
+				EntriesNum:    batchRows,
+				TimestampFrom: tsFrom,
+				TimestampTo:   tsTo,
+				LogPath:       logPath,
+				LogSize:       int64(len(blob.GetValue())),
+			})
+		}
+		if err := r.chunkManager.MultiWrite(ctx, kvs); err != nil {
+			return nil, 0, err
+		}
+		rows += batchRows
+	}
+	return ret, rows, nil
+}
+
+// restoreStatslogs copies the stats logs, stats of rows filtered out only make the bloom filters less selective.
+func (r *segmentRestorer) restoreStatslogs(ctx context.Context, fieldBinlogs []*datapb.FieldBinlog, partitionID, segmentID UniqueID) ([]*datapb.FieldBinlog, error) {
+	collectionID := r.insertCodec.Schema.GetID()
+	ret := make([]*datapb.FieldBinlog, 0, len(fieldBinlogs))
+	for _, fieldBinlog := range fieldBinlogs {
+		restored := &datapb.FieldBinlog{FieldID: fieldBinlog.GetFieldID()}
+		for _, binlog := range fieldBinlog.GetBinlogs() {
+			value, err := r.chunkManager.Read(ctx, binlog.GetLogPath())
+			if err != nil {
+				return nil, err
+			}
+			logID, err := r.allocator.allocID(ctx)
+			if err != nil {
+				return nil, err
+			}
+			logPath := metautil.BuildStatsLogPath(r.chunkManager.RootPath(), collectionID, partitionID, segmentID, fieldBinlog.GetFieldID(), logID)
+			if err := r.chunkManager.Write(ctx, logPath, value); err != nil {
+				return nil, err
+			}
+			restored.Binlogs = append(restored.Binlogs, &datapb.Binlog{
+				EntriesNum:    binlog.GetEntriesNum(),
+				TimestampFrom: binlog.GetTimestampFrom(),
+				TimestampTo:   binlog.GetTimestampTo(),
+				LogPath:       logPath,
+				LogSize:       int64(len(value)),
+			})
+		}
+		ret = append(ret, restored)
+	}
+	return ret, nil
+}
+
+// restoreDeltalogs merges the deletes before the timestamp into one delta log.
+func (r *segmentRestorer) restoreDeltalogs(ctx context.Context, fieldBinlogs []*datapb.FieldBinlog, partitionID, segmentID UniqueID) ([]*datapb.FieldBinlog, error) {
+	deleteData := &storage.DeleteData{}
+	tsFrom, tsTo := uint64(math.MaxUint64), uint64(0)
+	for _, fieldBinlog := range fieldBinlogs {
+		for _, binlog := range fieldBinlog.GetBinlogs() {
+			if binlog.GetTimestampFrom() > r.ts {
+				continue
+			}
+			value, err := r.chunkManager.Read(ctx, binlog.GetLogPath())
+			if err != nil {
+				return nil, err
+			}
+			_, _, data, err := r.deleteCodec.Deserialize([]*storage.Blob{{Key: binlog.GetLogPath(), Value: value}})
+			if err != nil {
+				return nil, err
+			}
+			for i, pk := range data.Pks {
+				if data.Tss[i] > r.ts {
+					continue
+				}
+				deleteData.Append(pk, data.Tss[i])
+				if data.Tss[i] < tsFrom {
+					tsFrom = data.Tss[i]
+				}
+				if data.Tss[i] > tsTo {
+					tsTo = data.Tss[i]
+				}
+			}
+		}
+	}
+	if deleteData.RowCount == 0 {
+		return nil, nil
+	}
+
+	collectionID := r.insertCodec.Schema.GetID()
+	blob, err := r.deleteCodec.Serialize(collectionID, partitionID, segmentID, deleteData)
+	if err != nil {
+		return nil, err
+	}
+	logID, err := r.allocator.allocID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	logPath := metautil.BuildDeltaLogPath(r.chunkManager.RootPath(), collectionID, partitionID, segmentID, logID)
+	if err := r.chunkManager.Write(ctx, logPath, blob.GetValue()); err != nil {
+		return nil, err
+	}
+	return []*datapb.FieldBinlog{{
+		Binlogs: []*datapb.Binlog{{
+			EntriesNum:    deleteData.RowCount,
+			TimestampFrom: tsFrom,
+			TimestampTo:   tsTo,
+			LogPath:       logPath,
+			LogSize:       int64(len(blob.GetValue())),
+		}},
+	}}, nil
+}
+
+// checkRestoreTs checks that the data valid at the timestamp is not garbage collected yet.
+func (s *Server) checkRestoreTs(ts Timestamp) error {
+	if s.garbageCollector == nil || !s.garbageCollector.option.enabled {
+		return nil
+	}
+	retention := s.garbageCollector.getRetention()
+	if physical := tsoutil.PhysicalTime(ts); time.Since(physical) > retention {
+		return fmt.Errorf("timestamp %d(%s) is out of the restore window %s", ts, physical, retention)
+	}
+	return nil
+}
+
+// restoreSegments writes the segments of the source collection valid at the timestamp into the target collection,
+// the restored segments are invisible until all of them are added.
+func (s *Server) restoreSegments(ctx context.Context, req *datapb.RestoreSegmentsRequest) ([]UniqueID, int64, error) {
+	ts := req.GetTimestamp()
+	if err := s.checkRestoreTs(ts); err != nil {
+		return nil, 0, err
+	}
+	collection, err := s.handler.GetCollection(ctx, req.GetCollectionID())
+	if err != nil {
+		return nil, 0, err
+	}
+	if collection == nil {
+		return nil, 0, fmt.Errorf("collection %d not found", req.GetCollectionID())
+	}
+
+	segments := selectRestoreSegments(s.meta.SelectSegments(func(segment *SegmentInfo) bool {
+		return segment.GetCollectionID() == req.GetSourceCollectionID()
+	}), ts)
+	sourceIDs := make([]UniqueID, 0, len(segments))
+	for _, segment := range segments {
+		sourceIDs = append(sourceIDs, segment.GetID())
+	}
+	log := log.Ctx(ctx).With(zap.Int64("source collection ID", req.GetSourceCollectionID()),
+		zap.Int64("collection ID", req.GetCollectionID()), zap.Uint64("timestamp", ts))
+	log.Info("restoring segments", zap.Int64s("source segment IDs", sourceIDs))
+
+	// keep the source segments from being garbage collected while they are copied
+	taskID, err := s.allocator.allocID(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+	if err := s.segReferManager.AddSegmentsLock(taskID, sourceIDs, paramtable.GetNodeID()); err != nil {
+		return nil, 0, err
+	}
+	defer func() {
+		if err := s.segReferManager.ReleaseSegmentsLock(taskID, paramtable.GetNodeID()); err != nil {
+			log.Warn("failed to release reference lock of restored segments", zap.Int64("task ID", taskID), zap.Error(err))
+		}
+	}()
+
+	restorer := newSegmentRestorer(s.chunkManager, s.allocator, collection, ts)
+	restoredIDs := make([]UniqueID, 0, len(segments))
+	var rowCount int64
+	for _, segment := range segments {
+		restored, err := s.restoreSegment(ctx, restorer, segment, req)
+		if err == nil && restored == nil {
+			continue
+		}
+		if err != nil {
+			// the added segments are garbage collected, and so are the files by the missing tolerance
+			for _, id := range restoredIDs {
+				if err := s.meta.SetState(id, commonpb.SegmentState_Dropped); err != nil {
+					log.Warn("failed to drop restored segment", zap.Int64("segment ID", id), zap.Error(err))
+				}
+			}
+			return nil, 0, fmt.Errorf("failed to restore segment %d: %w", segment.GetID(), err)
+		}
+		restoredIDs = append(restoredIDs, restored.GetID())
+		rowCount += restored.GetNumOfRows()
+	}
+
+	for _, id := range restoredIDs {
+		if err := s.meta.UnsetIsImporting(id); err != nil {
+			return nil, 0, err
+		}
+	}
+	log.Info("segments restored", zap.Int64s("segment IDs", restoredIDs), zap.Int64("row count", rowCount))
+	return restoredIDs, rowCount, nil
+}
+
+// restoreSegment writes a source segment into a new segment and adds it to the DataNode watching its channel,
+// nil is returned if the segment has no data before the timestamp.
+func (s *Server) restoreSegment(ctx context.Context, restorer *segmentRestorer, segment *SegmentInfo, req *datapb.RestoreSegmentsRequest) (*SegmentInfo, error) {
+	// the partitions and channels created after the timestamp are not restored, neither are their segments
+	empty, err := restorer.isEmpty(segment)
+	if err != nil {
+		return nil, err
+	}
+	if empty {
+		return nil, nil
+	}
+	partitionID, ok := req.GetPartitionIDs()[segment.GetPartitionID()]
+	if !ok {
+		return nil, fmt.Errorf("partition %d is not restored", segment.GetPartitionID())
+	}
+	channel, ok := req.GetVchannels()[segment.GetInsertChannel()]
+	if !ok {
+		return nil, fmt.Errorf("channel %s is not restored", segment.GetInsertChannel())
+	}
+	segmentID, err := s.allocator.allocID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	info, err := restorer.restore(ctx, segment, partitionID, segmentID)
+	if err != nil {
+		return nil, err
+	}
+	if info.GetNumOfRows() == 0 {
+		return nil, nil
+	}
+
+	ok, nodeID := s.channelManager.getNodeIDByChannelName(channel)
+	if !ok {
+		return nil, fmt.Errorf("no DataNode found for channel %s", channel)
+	}
+	cli, err := s.sessionManager.getClient(ctx, nodeID)
+	if err != nil {
+		return nil, err
+	}
+	ts, err := s.allocator.allocTimestamp(ctx)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := cli.AddImportSegment(ctx, &datapb.AddImportSegmentRequest{
+		Base: commonpbutil.NewMsgBase(
+			commonpbutil.WithTimeStamp(ts),
+			commonpbutil.WithSourceID(paramtable.GetNodeID()),
+		),
+		SegmentId:    segmentID,
+		ChannelName:  channel,
+		CollectionId: info.GetCollectionID(),
+		PartitionId:  partitionID,
+		RowNum:       info.GetNumOfRows(),
+		StatsLog:     info.GetStatslogs(),
+	})
+	if err := VerifyResponse(resp.GetStatus(), err); err != nil {
+		return nil, err
+	}
+
+	position := &internalpb.MsgPosition{
+		ChannelName: channel,
+		MsgID:       resp.GetChannelPos(),
+		Timestamp:   ts,
+	}
+	info.InsertChannel = channel
+	info.State = commonpb.SegmentState_Flushed
+	info.MaxRowNum = segment.GetMaxRowNum()
+	info.LastExpireTime = ts
+	info.StartPosition = position
+	info.DmlPosition = position
+	info.IsImporting = true
+	restored := NewSegmentInfo(info)
+	if err := s.meta.AddSegment(restored); err != nil {
+		return nil, err
+	}
+	log.Info("segment restored", zap.Int64("source segment ID", segment.GetID()),
+		zap.Int64("segment ID", segmentID), zap.Int64("DataNode ID", nodeID), zap.Int64("row count", info.GetNumOfRows()))
+	return restored, nil
+}
@@ -0,0 +1,326 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datacoord
+
+import (
+	"context"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/schemapb"
+	"github.com/milvus-io/milvus/internal/common"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/proto/etcdpb"
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/internal/util/metautil"
+	"github.com/milvus-io/milvus/internal/util/tsoutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSelectRestoreSegments(t *testing.T) {
+	now := time.Now()
+	ts := tsoutil.ComposeTSByTime(now, 0)
+	before := uint64(now.Add(-time.Minute).UnixNano())
+	after := uint64(now.Add(time.Minute).UnixNano())
+
+	segments := []*SegmentInfo{
+		NewSegmentInfo(&datapb.SegmentInfo{ID: 1, State: commonpb.SegmentState_Flushed}),
+		NewSegmentInfo(&datapb.SegmentInfo{ID: 2, State: commonpb.SegmentState_Dropped, DroppedAt: before}),
+		NewSegmentInfo(&datapb.SegmentInfo{ID: 3, State: commonpb.SegmentState_Dropped, DroppedAt: after}),
+		NewSegmentInfo(&datapb.SegmentInfo{ID: 4, State: commonpb.SegmentState_Flushed, CompactionFrom: []int64{3}}),
+		NewSegmentInfo(&datapb.SegmentInfo{ID: 5, State: commonpb.SegmentState_Flushed, IsImporting: true}),
+		NewSegmentInfo(&datapb.SegmentInfo{ID: 6, State: commonpb.SegmentState_NotExist}),
+	}
+	selected := selectRestoreSegments(segments, ts)
+	ids := make([]UniqueID, 0, len(selected))
+	for _, segment := range selected {
+		ids = append(ids, segment.GetID())
+	}
+	assert.ElementsMatch(t, []UniqueID{1, 3}, ids)
+}
+
+func TestFilterInsertBinlogs(t *testing.T) {
+	fieldBinlogs := []*datapb.FieldBinlog{
+		{FieldID: 0, Binlogs: []*datapb.Binlog{{EntriesNum: 10, TimestampFrom: 50, TimestampTo: 100}, {EntriesNum: 5, TimestampFrom: 120, TimestampTo: 200}}},
+		{FieldID: 1, Binlogs: []*datapb.Binlog{{EntriesNum: 10, TimestampFrom: 50, TimestampTo: 100}, {EntriesNum: 5, TimestampFrom: 120, TimestampTo: 200}}},
+	}
+	ret, err := filterInsertBinlogs(fieldBinlogs, 110)
+	assert.NoError(t, err)
+	assert.Len(t, ret, 2)
+	assert.Len(t, ret[1].GetBinlogs(), 1)
+
+	// the batch straddling the timestamp is kept
+	ret, err = filterInsertBinlogs(fieldBinlogs, 150)
+	assert.NoError(t, err)
+	assert.Len(t, ret[0].GetBinlogs(), 2)
+	assert.Len(t, ret[1].GetBinlogs(), 2)
+
+	ret, err = filterInsertBinlogs(fieldBinlogs, 10)
+	assert.NoError(t, err)
+	assert.Len(t, ret[0].GetBinlogs(), 0)
+
+	ret, err = filterInsertBinlogs(nil, 200)
+	assert.NoError(t, err)
+	assert.Len(t, ret, 0)
+
+	fieldBinlogs[1].Binlogs[1].TimestampFrom = 300
+	_, err = filterInsertBinlogs(fieldBinlogs, 200)
+	assert.Error(t, err)
+}
+
+func TestFilterInsertData(t *testing.T) {
+	data := &storage.InsertData{Data: map[storage.FieldID]storage.FieldData{
+		common.RowIDField:     &storage.Int64FieldData{NumRows: []int64{4}, Data: []int64{1, 2, 3, 4}},
+		common.TimeStampField: &storage.Int64FieldData{NumRows: []int64{4}, Data: []int64{10, 40, 20, 30}},
+		100:                   &storage.StringFieldData{NumRows: []int64{4}, Data: []string{"a", "b", "c", "d"}},
+		101:                   &storage.FloatVectorFieldData{NumRows: []int64{4}, Data: []float32{1, 1, 2, 2, 3, 3, 4, 4}, Dim: 2},
+		102:                   &storage.BinaryVectorFieldData{NumRows: []int64{4}, Data: []byte{1, 2, 3, 4}, Dim: 8},
+	}}
+
+	filtered, tsFrom, tsTo, err := filterInsertData(data, 25)
+	assert.NoError(t, err)
+	assert.EqualValues(t, 10, tsFrom)
+	assert.EqualValues(t, 20, tsTo)
+	assert.Equal(t, []int64{1, 3}, filtered.Data[common.RowIDField].(*storage.Int64FieldData).Data)
+	assert.Equal(t, []string{"a", "c"}, filtered.Data[100].(*storage.StringFieldData).Data)
+	assert.Equal(t, []float32{1, 1, 3, 3}, filtered.Data[101].(*storage.FloatVectorFieldData).Data)
+	assert.Equal(t, []byte{1, 3}, filtered.Data[102].(*storage.BinaryVectorFieldData).Data)
+	assert.Equal(t, 2, filtered.Data[102].RowNum())
+
+	filtered, tsFrom, tsTo, err = filterInsertData(data, 40)
+	assert.NoError(t, err)
+	assert.Same(t, data, filtered)
+	assert.EqualValues(t, 10, tsFrom)
+	assert.EqualValues(t, 40, tsTo)
+
+	filtered, _, _, err = filterInsertData(data, 5)
+	assert.NoError(t, err)
+	assert.Equal(t, 0, filtered.Data[common.TimeStampField].RowNum())
+
+	_, _, _, err = filterInsertData(&storage.InsertData{Data: map[storage.FieldID]storage.FieldData{}}, 5)
+	assert.Error(t, err)
+}
+
+func TestSegmentRestorer_StraddlingBatch(t *testing.T) {
+	ctx := context.Background()
+	chunkManager := storage.NewLocalChunkManager(storage.RootPath(t.TempDir()))
+	schema := &schemapb.CollectionSchema{
+		Fields: []*schemapb.FieldSchema{
+			{FieldID: common.RowIDField, Name: common.RowIDFieldName, DataType: schemapb.DataType_Int64},
+			{FieldID: common.TimeStampField, Name: common.TimeStampFieldName, DataType: schemapb.DataType_Int64},
+			{FieldID: 100, Name: "pk", IsPrimaryKey: true, DataType: schemapb.DataType_Int64},
+			{FieldID: 101, Name: "vec", DataType: schemapb.DataType_FloatVector,
+				TypeParams: []*commonpb.KeyValuePair{{Key: "dim", Value: "2"}}},
+		},
+	}
+	collection := &collectionInfo{ID: 1, Schema: schema}
+
+	// two batches, the second one straddles the restore timestamp
+	batches := []*storage.InsertData{
+		{Data: map[storage.FieldID]storage.FieldData{
+			common.RowIDField:     &storage.Int64FieldData{NumRows: []int64{2}, Data: []int64{1, 2}},
+			common.TimeStampField: &storage.Int64FieldData{NumRows: []int64{2}, Data: []int64{10, 20}},
+			100:                   &storage.Int64FieldData{NumRows: []int64{2}, Data: []int64{1, 2}},
+			101:                   &storage.FloatVectorFieldData{NumRows: []int64{2}, Data: []float32{1, 1, 2, 2}, Dim: 2},
+		}},
+		{Data: map[storage.FieldID]storage.FieldData{
+			common.RowIDField:     &storage.Int64FieldData{NumRows: []int64{3}, Data: []int64{3, 4, 5}},
+			common.TimeStampField: &storage.Int64FieldData{NumRows: []int64{3}, Data: []int64{30, 40, 50}},
+			100:                   &storage.Int64FieldData{NumRows: []int64{3}, Data: []int64{3, 4, 5}},
+			101:                   &storage.FloatVectorFieldData{NumRows: []int64{3}, Data: []float32{3, 3, 4, 4, 5, 5}, Dim: 2},
+		}},
+	}
+	codec := storage.NewInsertCodec(&etcdpb.CollectionMeta{ID: collection.ID, Schema: schema})
+	sourceBinlogs := writeRestoreSourceBinlogs(t, chunkManager, codec, 2, 3, batches)
+	source := NewSegmentInfo(&datapb.SegmentInfo{ID: 3, CollectionID: 1, PartitionID: 2, Binlogs: sourceBinlogs})
+
+	restorer := newSegmentRestorer(chunkManager, newMockAllocator(), &collectionInfo{ID: 10, Schema: schema}, 45)
+	restored, err := restorer.restore(ctx, source, 20, 30)
+	require.NoError(t, err)
+	assert.EqualValues(t, 4, restored.GetNumOfRows())
+	assert.Len(t, restored.GetBinlogs(), len(sourceBinlogs))
+	for _, fieldBinlog := range restored.GetBinlogs() {
+		require.Len(t, fieldBinlog.GetBinlogs(), 2)
+		straddling := fieldBinlog.GetBinlogs()[1]
+		assert.EqualValues(t, 2, straddling.GetEntriesNum())
+		assert.EqualValues(t, 30, straddling.GetTimestampFrom())
+		assert.EqualValues(t, 40, straddling.GetTimestampTo())
+		value, err := chunkManager.Read(ctx, straddling.GetLogPath())
+		require.NoError(t, err)
+		assert.EqualValues(t, len(value), straddling.GetLogSize())
+	}
+
+	// the rows after the timestamp are not restored
+	var blobs []*storage.Blob
+	for _, fieldBinlog := range restored.GetBinlogs() {
+		for _, binlog := range fieldBinlog.GetBinlogs() {
+			value, err := chunkManager.Read(ctx, binlog.GetLogPath())
+			require.NoError(t, err)
+			blobs = append(blobs, &storage.Blob{Key: binlog.GetLogPath(), Value: value})
+		}
+	}
+	_, _, _, data, err := codec.DeserializeAll(blobs)
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []int64{1, 2, 3, 4}, data.Data[100].(*storage.Int64FieldData).Data)
+
+	// no row before the timestamp
+	restorer = newSegmentRestorer(chunkManager, newMockAllocator(), &collectionInfo{ID: 10, Schema: schema}, 5)
+	restored, err = restorer.restore(ctx, source, 20, 31)
+	require.NoError(t, err)
+	assert.EqualValues(t, 0, restored.GetNumOfRows())
+}
+
+// writeRestoreSourceBinlogs writes the batches as the insert binlogs of the source segment.
+func writeRestoreSourceBinlogs(t *testing.T, chunkManager storage.ChunkManager, codec *storage.InsertCodec,
+	partitionID, segmentID UniqueID, batches []*storage.InsertData) []*datapb.FieldBinlog {
+	ctx := context.Background()
+	collectionID := codec.Schema.GetID()
+	fieldBinlogs := make(map[int64]*datapb.FieldBinlog)
+	var sourceBinlogs []*datapb.FieldBinlog
+	for i, batch := range batches {
+		blobs, _, err := codec.Serialize(partitionID, segmentID, batch)
+		require.NoError(t, err)
+		tss := batch.Data[common.TimeStampField].(*storage.Int64FieldData).Data
+		for _, blob := range blobs {
+			fieldID, err := strconv.ParseInt(blob.GetKey(), 10, 64)
+			require.NoError(t, err)
+			logPath := metautil.BuildInsertLogPath(chunkManager.RootPath(), collectionID, partitionID, segmentID, fieldID, int64(i))
+			require.NoError(t, chunkManager.Write(ctx, logPath, blob.GetValue()))
+			if _, ok := fieldBinlogs[fieldID]; !ok {
+				fieldBinlogs[fieldID] = &datapb.FieldBinlog{FieldID: fieldID}
+				sourceBinlogs = append(sourceBinlogs, fieldBinlogs[fieldID])
+			}
+			fieldBinlogs[fieldID].Binlogs = append(fieldBinlogs[fieldID].Binlogs, &datapb.Binlog{
+				EntriesNum:    int64(len(tss)),
+				TimestampFrom: uint64(tss[0]),
+				TimestampTo:   uint64(tss[len(tss)-1]),
+				LogPath:       logPath,
+				LogSize:       int64(len(blob.GetValue())),
+			})
+		}
+	}
+	return sourceBinlogs
+}
+
+func TestServer_RestoreSegments(t *testing.T) {
+	ctx := context.Background()
+	schema := &schemapb.CollectionSchema{
+		Fields: []*schemapb.FieldSchema{
+			{FieldID: common.RowIDField, Name: common.RowIDFieldName, DataType: schemapb.DataType_Int64},
+			{FieldID: common.TimeStampField, Name: common.TimeStampFieldName, DataType: schemapb.DataType_Int64},
+			{FieldID: 100, Name: "pk", IsPrimaryKey: true, DataType: schemapb.DataType_Int64},
+			{FieldID: 101, Name: "vec", DataType: schemapb.DataType_FloatVector,
+				TypeParams: []*commonpb.KeyValuePair{{Key: "dim", Value: "2"}}},
+		},
+	}
+	ts := tsoutil.ComposeTSByTime(time.Now(), 0)
+	newBatch := func(pks ...int64) *storage.InsertData {
+		tss := make([]int64, 0, len(pks))
+		vectors := make([]float32, 0, len(pks)*2)
+		for _, pk := range pks {
+			tss = append(tss, int64(ts)-100+pk)
+			vectors = append(vectors, float32(pk), float32(pk))
+		}
+		numRows := []int64{int64(len(pks))}
+		return &storage.InsertData{Data: map[storage.FieldID]storage.FieldData{
+			common.RowIDField:     &storage.Int64FieldData{NumRows: numRows, Data: pks},
+			common.TimeStampField: &storage.Int64FieldData{NumRows: numRows, Data: tss},
+			100:                   &storage.Int64FieldData{NumRows: numRows, Data: pks},
+			101:                   &storage.FloatVectorFieldData{NumRows: numRows, Data: vectors, Dim: 2},
+		}}
+	}
+
+	setup := func(t *testing.T) (*Server, *storage.InsertCodec) {
+		svr := newTestServer(t, nil)
+		svr.chunkManager = storage.NewLocalChunkManager(storage.RootPath(t.TempDir()))
+		svr.meta.AddCollection(&collectionInfo{ID: 1, Schema: schema})
+		svr.meta.AddCollection(&collectionInfo{ID: 10, Schema: schema})
+		svr.sessionManager.AddSession(&NodeInfo{
+			NodeID:  110,
+			Address: "localhost:8080",
+		})
+		require.NoError(t, svr.channelManager.AddNode(110))
+		require.NoError(t, svr.channelManager.Watch(&channel{Name: "ch1", CollectionID: 10}))
+		return svr, storage.NewInsertCodec(&etcdpb.CollectionMeta{ID: 1, Schema: schema})
+	}
+	addSegment := func(t *testing.T, svr *Server, codec *storage.InsertCodec, segmentID, partitionID UniqueID, channel string, pks ...int64) {
+		binlogs := writeRestoreSourceBinlogs(t, svr.chunkManager, codec, partitionID, segmentID, []*storage.InsertData{newBatch(pks...)})
+		err := svr.meta.AddSegment(NewSegmentInfo(&datapb.SegmentInfo{
+			ID:            segmentID,
+			CollectionID:  1,
+			PartitionID:   partitionID,
+			InsertChannel: channel,
+			State:         commonpb.SegmentState_Flushed,
+			NumOfRows:     int64(len(pks)),
+			Binlogs:       binlogs,
+		}))
+		require.NoError(t, err)
+	}
+	restoredSegments := func(svr *Server) []*SegmentInfo {
+		return svr.meta.SelectSegments(func(segment *SegmentInfo) bool {
+			return segment.GetCollectionID() == 10
+		})
+	}
+
+	t.Run("partitions and channels created after the timestamp", func(t *testing.T) {
+		svr, codec := setup(t)
+		defer closeTestServer(t, svr)
+		addSegment(t, svr, codec, 3, 2, "src-ch1", 1, 2, 3)
+		// the segment of a partition and a channel created after the timestamp isn't mapped
+		addSegment(t, svr, codec, 4, 5, "src-ch2", 101, 102)
+
+		segmentIDs, rowCount, err := svr.restoreSegments(ctx, &datapb.RestoreSegmentsRequest{
+			SourceCollectionID: 1,
+			CollectionID:       10,
+			Timestamp:          ts,
+			PartitionIDs:       map[int64]int64{2: 20},
+			Vchannels:          map[string]string{"src-ch1": "ch1"},
+		})
+		require.NoError(t, err)
+		assert.Len(t, segmentIDs, 1)
+		assert.EqualValues(t, 3, rowCount)
+		restored := svr.meta.GetSegment(segmentIDs[0])
+		require.NotNil(t, restored)
+		assert.EqualValues(t, 20, restored.GetPartitionID())
+		assert.Equal(t, "ch1", restored.GetInsertChannel())
+		assert.Equal(t, commonpb.SegmentState_Flushed, restored.GetState())
+		assert.False(t, restored.GetIsImporting())
+	})
+
+	t.Run("segments before the timestamp not mapped", func(t *testing.T) {
+		svr, codec := setup(t)
+		defer closeTestServer(t, svr)
+		addSegment(t, svr, codec, 3, 2, "src-ch1", 1, 2, 3)
+		addSegment(t, svr, codec, 4, 5, "src-ch1", 4, 5)
+
+		_, _, err := svr.restoreSegments(ctx, &datapb.RestoreSegmentsRequest{
+			SourceCollectionID: 1,
+			CollectionID:       10,
+			Timestamp:          ts,
+			PartitionIDs:       map[int64]int64{2: 20},
+			Vchannels:          map[string]string{"src-ch1": "ch1"},
+		})
+		assert.Error(t, err)
+		// the segments restored before the failure are dropped
+		for _, segment := range restoredSegments(svr) {
+			assert.Equal(t, commonpb.SegmentState_Dropped, segment.GetState())
+		}
+	})
+}
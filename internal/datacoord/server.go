@@ -33,16 +33,21 @@ import (
 
 	"github.com/milvus-io/milvus-proto/go-api/commonpb"
 	"github.com/milvus-io/milvus-proto/go-api/milvuspb"
+	"github.com/milvus-io/milvus/internal/config"
 	datanodeclient "github.com/milvus-io/milvus/internal/distributed/datanode/client"
 	rootcoordclient "github.com/milvus-io/milvus/internal/distributed/rootcoord/client"
 	etcdkv "github.com/milvus-io/milvus/internal/kv/etcd"
 	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/metastore/db/dao"
+	dbdatacoord "github.com/milvus-io/milvus/internal/metastore/db/datacoord"
+	"github.com/milvus-io/milvus/internal/metastore/db/dbcore"
 	"github.com/milvus-io/milvus/internal/metrics"
 	"github.com/milvus-io/milvus/internal/mq/msgstream"
 	"github.com/milvus-io/milvus/internal/mq/msgstream/mqwrapper"
 	"github.com/milvus-io/milvus/internal/proto/datapb"
 	"github.com/milvus-io/milvus/internal/storage"
 	"github.com/milvus-io/milvus/internal/types"
+	"github.com/milvus-io/milvus/internal/util"
 	"github.com/milvus-io/milvus/internal/util/commonpbutil"
 	"github.com/milvus-io/milvus/internal/util/dependency"
 	"github.com/milvus-io/milvus/internal/util/funcutil"
@@ -108,6 +113,8 @@ type Server struct {
 	rootCoordClient  types.RootCoord
 	garbageCollector *garbageCollector
 	gcOpt            GcOption
+	chunkManager     storage.ChunkManager
+	stopGCWatch      func()
 	handler          Handler
 
 	compactionTrigger trigger
@@ -262,6 +269,7 @@ func (s *Server) Init() error {
 	if err != nil {
 		return err
 	}
+	s.chunkManager = storageCli
 
 	if err = s.initMeta(storageCli.RootPath()); err != nil {
 		return err
@@ -394,9 +402,16 @@ func (s *Server) initGarbageCollection(cli storage.ChunkManager) {
 	s.garbageCollector = newGarbageCollector(s.meta, s.handler, s.segReferManager, s.indexCoord, GcOption{
 		cli:              cli,
 		enabled:          Params.DataCoordCfg.EnableGarbageCollection,
-		checkInterval:    Params.DataCoordCfg.GCInterval,
-		missingTolerance: Params.DataCoordCfg.GCMissingTolerance,
-		dropTolerance:    Params.DataCoordCfg.GCDropTolerance,
+		checkInterval:    Params.DataCoordCfg.GetGCInterval(),
+		missingTolerance: Params.DataCoordCfg.GetGCMissingTolerance(),
+		dropTolerance:    Params.DataCoordCfg.GetGCDropTolerance(),
+		restoreWindow:    Params.DataCoordCfg.GetGCRestoreWindow(),
+	})
+	// the gc params are refreshed by paramtable before this watcher is called
+	gc := s.garbageCollector
+	s.stopGCWatch = Params.WatchKeyPrefix("dataCoord.gc.", func(*config.Event) {
+		gc.refreshOption(Params.DataCoordCfg.GetGCInterval(), Params.DataCoordCfg.GetGCMissingTolerance(),
+			Params.DataCoordCfg.GetGCDropTolerance(), Params.DataCoordCfg.GetGCRestoreWindow())
 	})
 }
 
@@ -439,10 +454,14 @@ func (s *Server) initServiceDiscovery() error {
 		log.Error("DataCoord get QueryCoord session failed", zap.Error(err))
 		return err
 	}
-	serverIDs := make([]UniqueID, 0, len(qcSessions))
+	serverIDs := make([]UniqueID, 0, len(qcSessions)+len(datanodes))
 	for _, session := range qcSessions {
 		serverIDs = append(serverIDs, session.ServerID)
 	}
+	// DataNodes hold the reference locks of export tasks
+	for _, node := range datanodes {
+		serverIDs = append(serverIDs, node.NodeID)
+	}
 	s.qcEventCh = s.session.WatchServices(typeutil.QueryCoordRole, qcRevision+1, nil)
 
 	s.segReferManager, err = NewSegmentReferenceManager(s.kvClient, serverIDs)
@@ -460,7 +479,18 @@ func (s *Server) initMeta(chunkManagerRootPath string) error {
 	s.kvClient = etcdKV
 	reloadEtcdFn := func() error {
 		var err error
-		s.meta, err = newMeta(s.ctx, s.kvClient, chunkManagerRootPath)
+		switch Params.MetaStoreCfg.MetaStoreType {
+		case util.MetaStoreTypeEtcd:
+			s.meta, err = newMeta(s.ctx, s.kvClient, chunkManagerRootPath)
+		case util.MetaStoreTypeMysql:
+			if err = dbcore.Connect(&Params.DBCfg); err != nil {
+				return err
+			}
+			catalog := dbdatacoord.NewTableCatalog(dbcore.NewTxImpl(), dao.NewMetaDomain(), s.kvClient)
+			s.meta, err = newMetaWithCatalog(s.ctx, catalog)
+		default:
+			return retry.Unrecoverable(fmt.Errorf("not supported meta store: %s", Params.MetaStoreCfg.MetaStoreType))
+		}
 		if err != nil {
 			return err
 		}
@@ -475,9 +505,27 @@ func (s *Server) startServerLoop() {
 	s.startDataNodeTtLoop(s.serverLoopCtx)
 	s.startWatchService(s.serverLoopCtx)
 	s.startFlushLoop(s.serverLoopCtx)
+	s.startKeyRotationLoop(s.serverLoopCtx)
 	s.garbageCollector.start()
 }
 
+// startKeyRotationLoop starts a goroutine re-wrapping data keys of encrypted objects
+// with the current master key, if client side encryption is enabled.
+func (s *Server) startKeyRotationLoop(ctx context.Context) {
+	if s.garbageCollector == nil {
+		return
+	}
+	ecm, ok := s.garbageCollector.option.cli.(*storage.EncryptedChunkManager)
+	if !ok {
+		return
+	}
+	s.serverLoopWg.Add(1)
+	go func() {
+		defer s.serverLoopWg.Done()
+		ecm.RunKeyRotation(ctx, ecm.RootPath(), Params.CommonCfg.StorageEncryptionRewrapInterval)
+	}()
+}
+
 // startDataNodeTtLoop start a goroutine to recv data node tt msg from msgstream
 // tt msg stands for the currently consumed timestamp for each channel
 func (s *Server) startDataNodeTtLoop(ctx context.Context) {
@@ -737,6 +785,12 @@ func (s *Server) handleSessionEvent(ctx context.Context, event *sessionutil.Sess
 			log.Warn("failed to deregister node", zap.Int64("id", node.NodeID), zap.String("address", node.Address), zap.Error(err))
 			return err
 		}
+		// release the reference locks of the export tasks on the node
+		if err := retry.Do(ctx, func() error {
+			return s.segReferManager.ReleaseSegmentsLockByNodeID(node.NodeID)
+		}, retry.Attempts(100)); err != nil {
+			return err
+		}
 		s.metricsCacheManager.InvalidateSystemInfoMetrics()
 	default:
 		log.Warn("receive unknown service event type",
@@ -824,6 +878,9 @@ func (s *Server) Stop() error {
 	}
 	logutil.Logger(s.ctx).Info("server shutdown")
 	s.cluster.Close()
+	if s.stopGCWatch != nil {
+		s.stopGCWatch()
+	}
 	s.garbageCollector.close()
 	s.stopServerLoop()
 	s.session.Revoke(time.Second)
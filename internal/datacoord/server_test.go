@@ -3162,6 +3162,173 @@ func TestDataCoord_Import(t *testing.T) {
 	})
 }
 
+func TestDataCoord_Export(t *testing.T) {
+	addSegments := func(t *testing.T, svr *Server) {
+		segments := []*datapb.SegmentInfo{
+			{ID: 1, CollectionID: 100, PartitionID: 10, InsertChannel: "ch1", State: commonpb.SegmentState_Flushed},
+			{ID: 2, CollectionID: 100, PartitionID: 11, InsertChannel: "ch1", State: commonpb.SegmentState_Flushed},
+			{ID: 3, CollectionID: 100, PartitionID: 10, InsertChannel: "ch1", State: commonpb.SegmentState_Growing},
+			{ID: 4, CollectionID: 100, PartitionID: 10, InsertChannel: "ch2", State: commonpb.SegmentState_Dropped},
+			{ID: 5, CollectionID: 100, PartitionID: 10, InsertChannel: "ch1", State: commonpb.SegmentState_Flushed, IsImporting: true},
+			{ID: 6, CollectionID: 101, PartitionID: 12, InsertChannel: "ch3", State: commonpb.SegmentState_Flushed},
+		}
+		for _, segment := range segments {
+			err := svr.meta.AddSegment(NewSegmentInfo(segment))
+			assert.Nil(t, err)
+		}
+	}
+	updateCheckpoint := func(t *testing.T, svr *Server, ts Timestamp) {
+		err := svr.meta.UpdateChannelCheckpoint("ch1", &internalpb.MsgPosition{
+			ChannelName: "ch1",
+			Timestamp:   ts,
+		})
+		assert.Nil(t, err)
+	}
+	segmentIDs := func(segments []*datapb.SegmentInfo) []int64 {
+		ids := make([]int64, 0, len(segments))
+		for _, segment := range segments {
+			ids = append(ids, segment.GetID())
+		}
+		return ids
+	}
+
+	t.Run("normal case", func(t *testing.T) {
+		svr := newTestServer(t, nil)
+		defer closeTestServer(t, svr)
+		svr.sessionManager.AddSession(&NodeInfo{
+			NodeID:  0,
+			Address: "localhost:8080",
+		})
+		addSegments(t, svr)
+		updateCheckpoint(t, svr, 1000)
+
+		req := &datapb.ExportTaskRequest{
+			ExportTask: &datapb.ExportTask{
+				TaskId:       1,
+				CollectionId: 100,
+				Timestamp:    1000,
+			},
+		}
+		resp, err := svr.Export(svr.ctx, req)
+		assert.Nil(t, err)
+		assert.EqualValues(t, commonpb.ErrorCode_Success, resp.Status.GetErrorCode())
+		assert.EqualValues(t, 0, resp.GetDatanodeId())
+		assert.ElementsMatch(t, []int64{1, 2}, segmentIDs(req.GetExportTask().GetSegments()))
+		// the exported segments are locked until the DataNode releases them
+		assert.True(t, svr.segReferManager.HasSegmentLock(1))
+		assert.True(t, svr.segReferManager.HasSegmentLock(2))
+		assert.False(t, svr.segReferManager.HasSegmentLock(3))
+		err = svr.segReferManager.ReleaseSegmentsLock(1, 0)
+		assert.Nil(t, err)
+		assert.False(t, svr.segReferManager.HasSegmentLock(1))
+
+		req.ExportTask.TaskId = 2
+		req.ExportTask.PartitionIds = []int64{11}
+		resp, err = svr.Export(svr.ctx, req)
+		assert.Nil(t, err)
+		assert.EqualValues(t, commonpb.ErrorCode_Success, resp.Status.GetErrorCode())
+		assert.ElementsMatch(t, []int64{2}, segmentIDs(req.GetExportTask().GetSegments()))
+
+		// the locks of the tasks on an offline DataNode are released
+		err = svr.segReferManager.ReleaseSegmentsLockByNodeID(0)
+		assert.Nil(t, err)
+		assert.False(t, svr.segReferManager.HasSegmentLock(2))
+	})
+
+	t.Run("not flushed", func(t *testing.T) {
+		timeout := exportFlushTimeout
+		defer func() {
+			exportFlushTimeout = timeout
+		}()
+		exportFlushTimeout = 500 * time.Millisecond
+
+		svr := newTestServer(t, nil)
+		defer closeTestServer(t, svr)
+		svr.sessionManager.AddSession(&NodeInfo{
+			NodeID:  0,
+			Address: "localhost:8080",
+		})
+		addSegments(t, svr)
+
+		req := &datapb.ExportTaskRequest{
+			ExportTask: &datapb.ExportTask{
+				TaskId:       1,
+				CollectionId: 100,
+				Timestamp:    1000,
+			},
+		}
+		// the checkpoint of the channel is behind the export timestamp
+		updateCheckpoint(t, svr, 999)
+		resp, err := svr.Export(svr.ctx, req)
+		assert.Nil(t, err)
+		assert.EqualValues(t, commonpb.ErrorCode_UnexpectedError, resp.Status.GetErrorCode())
+		assert.Empty(t, req.GetExportTask().GetSegments())
+		assert.False(t, svr.segReferManager.HasSegmentLock(1))
+
+		// the task is accepted once the channel checkpoint passes the timestamp
+		go func() {
+			time.Sleep(100 * time.Millisecond)
+			updateCheckpoint(t, svr, 1001)
+		}()
+		resp, err = svr.Export(svr.ctx, req)
+		assert.Nil(t, err)
+		assert.EqualValues(t, commonpb.ErrorCode_Success, resp.Status.GetErrorCode())
+		assert.ElementsMatch(t, []int64{1, 2}, segmentIDs(req.GetExportTask().GetSegments()))
+	})
+
+	t.Run("no free node", func(t *testing.T) {
+		svr := newTestServer(t, nil)
+		defer closeTestServer(t, svr)
+		svr.sessionManager.AddSession(&NodeInfo{
+			NodeID:  0,
+			Address: "localhost:8080",
+		})
+
+		resp, err := svr.Export(svr.ctx, &datapb.ExportTaskRequest{
+			ExportTask: &datapb.ExportTask{
+				CollectionId: 100,
+			},
+			WorkingNodes: []int64{0},
+		})
+		assert.Nil(t, err)
+		assert.EqualValues(t, commonpb.ErrorCode_UnexpectedError, resp.Status.GetErrorCode())
+	})
+
+	t.Run("no datanode available", func(t *testing.T) {
+		svr := newTestServer(t, nil)
+		defer closeTestServer(t, svr)
+		resp, err := svr.Export(svr.ctx, &datapb.ExportTaskRequest{
+			ExportTask: &datapb.ExportTask{
+				CollectionId: 100,
+			},
+		})
+		assert.Nil(t, err)
+		assert.EqualValues(t, commonpb.ErrorCode_UnexpectedError, resp.Status.GetErrorCode())
+	})
+
+	t.Run("empty task", func(t *testing.T) {
+		svr := newTestServer(t, nil)
+		defer closeTestServer(t, svr)
+		resp, err := svr.Export(svr.ctx, &datapb.ExportTaskRequest{})
+		assert.Nil(t, err)
+		assert.EqualValues(t, commonpb.ErrorCode_UnexpectedError, resp.Status.GetErrorCode())
+	})
+
+	t.Run("with closed server", func(t *testing.T) {
+		svr := newTestServer(t, nil)
+		closeTestServer(t, svr)
+
+		resp, err := svr.Export(svr.ctx, &datapb.ExportTaskRequest{
+			ExportTask: &datapb.ExportTask{
+				CollectionId: 100,
+			},
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_UnexpectedError, resp.Status.GetErrorCode())
+		assert.Equal(t, msgDataCoordIsUnhealthy(paramtable.GetNodeID()), resp.Status.GetReason())
+	})
+}
+
 func TestDataCoord_SaveImportSegment(t *testing.T) {
 	t.Run("test add segment", func(t *testing.T) {
 		svr := newTestServer(t, nil)
@@ -22,6 +22,7 @@ import (
 	"math/rand"
 	"strconv"
 	"sync"
+	"time"
 
 	"github.com/milvus-io/milvus/internal/util/commonpbutil"
 	"github.com/milvus-io/milvus/internal/util/errorutil"
@@ -1155,6 +1156,194 @@ func (s *Server) Import(ctx context.Context, itr *datapb.ImportTaskRequest) (*da
 	return resp, nil
 }
 
+// Export flushes the collection, fills the flushed segments of the collection into the export task, and distributes
+// the task to an available DataNode. The task is rejected if the data before the export timestamp is not flushed in time.
+// Growing segments are not exported, the deltalogs of the segments up to the timestamp are applied by the DataNode.
+func (s *Server) Export(ctx context.Context, etr *datapb.ExportTaskRequest) (*datapb.ExportTaskResponse, error) {
+	log.Info("DataCoord receives export request", zap.Int64("task ID", etr.GetExportTask().GetTaskId()),
+		zap.Int64("collection ID", etr.GetExportTask().GetCollectionId()),
+		zap.Int64s("partition IDs", etr.GetExportTask().GetPartitionIds()))
+	resp := &datapb.ExportTaskResponse{
+		Status: &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+		},
+	}
+
+	if s.isClosed() {
+		log.Error("failed to export for closed DataCoord service")
+		resp.Status.Reason = msgDataCoordIsUnhealthy(paramtable.GetNodeID())
+		return resp, nil
+	}
+
+	task := etr.GetExportTask()
+	if task == nil {
+		resp.Status.Reason = "export task is empty"
+		return resp, nil
+	}
+
+	nodes := s.sessionManager.getLiveNodeIDs()
+	if len(nodes) == 0 {
+		log.Error("export failed as all DataNodes are offline")
+		resp.Status.Reason = "all DataNodes are offline"
+		return resp, nil
+	}
+	log.Info("available DataNodes are", zap.Int64s("node ID", nodes))
+
+	avaNodes := getDiff(nodes, etr.GetWorkingNodes())
+	if len(avaNodes) == 0 {
+		// No dataNode is available, reject the export request.
+		msg := "all DataNodes are busy working on data export, the task has been rejected and wait for idle datanode"
+		log.Info(msg, zap.Int64("task ID", task.GetTaskId()))
+		resp.Status.Reason = msg
+		return resp, nil
+	}
+
+	// the inserts and deletes before the timestamp may still be buffered in DataNodes
+	if err := s.flushForExport(ctx, task.GetCollectionId(), task.GetTimestamp()); err != nil {
+		log.Warn("export task is rejected as the collection is not flushed", zap.Int64("task ID", task.GetTaskId()), zap.Error(err))
+		resp.Status.Reason = err.Error()
+		return resp, nil
+	}
+	task.Segments = s.getExportSegments(task.GetCollectionId(), task.GetPartitionIds())
+	// If there exists available DataNodes, pick one at random.
+	resp.DatanodeId = avaNodes[rand.Intn(len(avaNodes))]
+	// keep the exported segments from being compacted and garbage collected until the DataNode finishes the task,
+	// the lock is released by the DataNode, or when the DataNode goes offline
+	segmentIDs := make([]UniqueID, 0, len(task.GetSegments()))
+	for _, segment := range task.GetSegments() {
+		segmentIDs = append(segmentIDs, segment.GetID())
+	}
+	if err := s.segReferManager.AddSegmentsLock(task.GetTaskId(), segmentIDs, resp.GetDatanodeId()); err != nil {
+		log.Warn("failed to add reference lock on exported segments", zap.Int64("task ID", task.GetTaskId()), zap.Error(err))
+		resp.Status.Reason = err.Error()
+		return resp, nil
+	}
+	log.Info("picking a free dataNode for export",
+		zap.Any("all dataNodes", nodes),
+		zap.Int64("picking free dataNode with ID", resp.GetDatanodeId()),
+		zap.Int("segment num", len(task.GetSegments())))
+	s.cluster.Export(s.ctx, resp.GetDatanodeId(), etr)
+
+	resp.Status.ErrorCode = commonpb.ErrorCode_Success
+	return resp, nil
+}
+
+// RestoreSegments writes the segments of the source collection valid at the timestamp into the target collection.
+// The timestamp must be within the restore window of the garbage collector.
+func (s *Server) RestoreSegments(ctx context.Context, req *datapb.RestoreSegmentsRequest) (*datapb.RestoreSegmentsResponse, error) {
+	log.Info("DataCoord receives restore segments request",
+		zap.Int64("source collection ID", req.GetSourceCollectionID()),
+		zap.Int64("collection ID", req.GetCollectionID()),
+		zap.Uint64("timestamp", req.GetTimestamp()))
+	resp := &datapb.RestoreSegmentsResponse{
+		Status: &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+		},
+	}
+
+	if s.isClosed() {
+		log.Error("failed to restore segments for closed DataCoord service")
+		resp.Status.Reason = msgDataCoordIsUnhealthy(paramtable.GetNodeID())
+		return resp, nil
+	}
+
+	segmentIDs, rowCount, err := s.restoreSegments(ctx, req)
+	if err != nil {
+		log.Error("failed to restore segments",
+			zap.Int64("source collection ID", req.GetSourceCollectionID()),
+			zap.Int64("collection ID", req.GetCollectionID()),
+			zap.Error(err))
+		resp.Status.Reason = err.Error()
+		return resp, nil
+	}
+
+	resp.Status.ErrorCode = commonpb.ErrorCode_Success
+	resp.SegmentIDs = segmentIDs
+	resp.RowCount = rowCount
+	return resp, nil
+}
+
+// getExportSegments returns the flushed segments of the collection, if partitionIDs is not empty,
+// only segments of these partitions are returned.
+func (s *Server) getExportSegments(collectionID UniqueID, partitionIDs []UniqueID) []*datapb.SegmentInfo {
+	partitions := make(map[UniqueID]struct{}, len(partitionIDs))
+	for _, id := range partitionIDs {
+		partitions[id] = struct{}{}
+	}
+	segments := s.meta.SelectSegments(func(info *SegmentInfo) bool {
+		if info.GetCollectionID() != collectionID || info.GetState() != commonpb.SegmentState_Flushed || info.GetIsImporting() {
+			return false
+		}
+		if len(partitions) > 0 {
+			if _, ok := partitions[info.GetPartitionID()]; !ok {
+				return false
+			}
+		}
+		return true
+	})
+	infos := make([]*datapb.SegmentInfo, 0, len(segments))
+	for _, segment := range segments {
+		infos = append(infos, segment.SegmentInfo)
+	}
+	return infos
+}
+
+var (
+	// exportFlushTimeout is the max time an export request waits for the collection to be flushed,
+	// the request is rejected and retried by RootCoord later if the collection is not flushed in time.
+	exportFlushTimeout = 10 * time.Second
+	// exportFlushCheckInterval is the interval to check whether the collection is flushed for an export request.
+	exportFlushCheckInterval = 200 * time.Millisecond
+)
+
+// flushForExport seals the segments of the collection and waits until the sealed segments are flushed and
+// the checkpoints of the channels pass the timestamp, then the inserts and deletes before the timestamp
+// are all in the binlogs and delta logs of the flushed segments.
+func (s *Server) flushForExport(ctx context.Context, collectionID UniqueID, ts Timestamp) error {
+	sealed, err := s.segmentManager.SealAllSegments(ctx, collectionID, nil)
+	if err != nil {
+		return fmt.Errorf("failed to flush collection %d, %w", collectionID, err)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, exportFlushTimeout)
+	defer cancel()
+	ticker := time.NewTicker(exportFlushCheckInterval)
+	defer ticker.Stop()
+	for !s.isFlushedForExport(collectionID, sealed, ts) {
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("data of collection %d before timestamp %d is not flushed yet, %w", collectionID, ts, ctx.Err())
+		case <-ticker.C:
+		}
+	}
+	return nil
+}
+
+// isFlushedForExport returns whether the sealed segments are flushed and the checkpoints of all channels of the collection
+// pass the timestamp.
+func (s *Server) isFlushedForExport(collectionID UniqueID, sealed []UniqueID, ts Timestamp) bool {
+	for _, id := range sealed {
+		segment := s.meta.GetSegment(id)
+		// segment is nil if it was compacted or it's a empty segment and is set to dropped
+		if segment != nil && segment.GetState() != commonpb.SegmentState_Flushed && segment.GetState() != commonpb.SegmentState_Dropped {
+			return false
+		}
+	}
+	channels := make(map[string]struct{})
+	for _, segment := range s.meta.GetSegmentsOfCollection(collectionID) {
+		if segment.GetState() != commonpb.SegmentState_Dropped {
+			channels[segment.GetInsertChannel()] = struct{}{}
+		}
+	}
+	for channel := range channels {
+		// deletes of the flushed segments are buffered in DataNodes as well, they are flushed once the checkpoint passes them
+		if cp := s.meta.GetChannelCheckpoint(channel); cp == nil || cp.GetTimestamp() < ts {
+			return false
+		}
+	}
+	return true
+}
+
 // UpdateSegmentStatistics updates a segment's stats.
 func (s *Server) UpdateSegmentStatistics(ctx context.Context, req *datapb.UpdateSegmentStatisticsRequest) (*commonpb.Status, error) {
 	resp := &commonpb.Status{
@@ -36,6 +36,7 @@ const (
 	flushTimeout = 15 * time.Second
 	// TODO: evaluate and update import timeout.
 	importTimeout     = 3 * time.Hour
+	exportTimeout     = 3 * time.Hour
 	reCollectTimeout  = 5 * time.Second
 	addSegmentTimeout = 30 * time.Second
 )
@@ -206,6 +207,29 @@ func (c *SessionManager) execImport(ctx context.Context, nodeID int64, itr *data
 	log.Info("success to import", zap.Int64("node", nodeID), zap.Any("import task", itr))
 }
 
+// Export is a grpc interface. It will send request to DataNode with provided `nodeID` asynchronously.
+func (c *SessionManager) Export(ctx context.Context, nodeID int64, etr *datapb.ExportTaskRequest) {
+	go c.execExport(ctx, nodeID, etr)
+}
+
+// execExport gets the corresponding DataNode with its ID and calls its Export method.
+func (c *SessionManager) execExport(ctx context.Context, nodeID int64, etr *datapb.ExportTaskRequest) {
+	cli, err := c.getClient(ctx, nodeID)
+	if err != nil {
+		log.Warn("failed to get client for export", zap.Int64("nodeID", nodeID), zap.Error(err))
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, exportTimeout)
+	defer cancel()
+	resp, err := cli.Export(ctx, etr)
+	if err := VerifyResponse(resp, err); err != nil {
+		log.Warn("failed to export", zap.Int64("node", nodeID), zap.Error(err))
+		return
+	}
+
+	log.Info("success to export", zap.Int64("node", nodeID), zap.Int64("task ID", etr.GetExportTask().GetTaskId()))
+}
+
 // ReCollectSegmentStats collects segment stats info from DataNodes, after DataCoord reboots.
 func (c *SessionManager) ReCollectSegmentStats(ctx context.Context, nodeID int64) {
 	go c.execReCollectSegmentStats(ctx, nodeID)
@@ -28,6 +28,7 @@ import (
 	"github.com/milvus-io/milvus/internal/proto/datapb"
 	"github.com/milvus-io/milvus/internal/proto/etcdpb"
 	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/internal/util/compressor"
 	"github.com/milvus-io/milvus/internal/util/metautil"
 	"go.uber.org/zap"
 	"golang.org/x/sync/errgroup"
@@ -232,9 +233,16 @@ func (b *binlogIO) upload(
 	return p, nil
 }
 
+// codecOptions returns the storage codec options configured for datanode.
+func codecOptions() []storage.CodecOption {
+	return []storage.CodecOption{
+		storage.WithCompression(compressor.CompressType(Params.CommonCfg.StorageCompression)),
+	}
+}
+
 // genDeltaBlobs returns key, value
 func (b *binlogIO) genDeltaBlobs(data *DeleteData, collID, partID, segID UniqueID) (string, []byte, error) {
-	dCodec := storage.NewDeleteCodec()
+	dCodec := storage.NewDeleteCodec(codecOptions()...)
 
 	blob, err := dCodec.Serialize(collID, partID, segID, data)
 	if err != nil {
@@ -253,7 +261,7 @@ func (b *binlogIO) genDeltaBlobs(data *DeleteData, collID, partID, segID UniqueI
 
 // genInsertBlobs returns kvs, insert-paths, stats-paths
 func (b *binlogIO) genInsertBlobs(data *InsertData, partID, segID UniqueID, meta *etcdpb.CollectionMeta) (map[string][]byte, map[UniqueID]*datapb.FieldBinlog, map[UniqueID]*datapb.FieldBinlog, error) {
-	inCodec := storage.NewInsertCodec(meta)
+	inCodec := storage.NewInsertCodec(meta, codecOptions()...)
 	inlogs, statslogs, err := inCodec.Serialize(partID, segID, data)
 	if err != nil {
 		return nil, nil, nil, err
@@ -256,7 +256,9 @@ func (t *compactionTask) merge(
 
 	isDeletedValue := func(v *storage.Value) bool {
 		ts, ok := delta[v.PK.GetValue()]
-		if ok && uint64(v.Timestamp) <= ts {
+		// upsert produces the delete and the insert of a pk with the same timestamp,
+		// a delete only takes effect on the rows inserted strictly before it
+		if ok && uint64(v.Timestamp) < ts {
 			return true
 		}
 		return false
@@ -720,6 +722,36 @@ func interface2FieldData(schemaDataType schemapb.DataType, content []interface{}
 		}
 		rst = data
 
+	case typeutil.DataTypeJSON:
+		var data = &storage.JSONFieldData{
+			NumRows: numOfRows,
+			Data:    make([][]byte, 0, len(content)),
+		}
+
+		for _, c := range content {
+			r, ok := c.([]byte)
+			if !ok {
+				return nil, errTransferType
+			}
+			data.Data = append(data.Data, r)
+		}
+		rst = data
+
+	case typeutil.DataTypeArray:
+		var data = &storage.ArrayFieldData{
+			NumRows: numOfRows,
+			Data:    make([]*schemapb.ScalarField, 0, len(content)),
+		}
+
+		for _, c := range content {
+			r, ok := c.(*schemapb.ScalarField)
+			if !ok {
+				return nil, errTransferType
+			}
+			data.Data = append(data.Data, r)
+		}
+		rst = data
+
 	case schemapb.DataType_FloatVector:
 		var data = &storage.FloatVectorFieldData{
 			NumRows: numOfRows,
@@ -52,6 +52,7 @@ import (
 	"github.com/milvus-io/milvus/internal/types"
 	"github.com/milvus-io/milvus/internal/util/commonpbutil"
 	"github.com/milvus-io/milvus/internal/util/dependency"
+	"github.com/milvus-io/milvus/internal/util/exportutil"
 	"github.com/milvus-io/milvus/internal/util/importutil"
 	"github.com/milvus-io/milvus/internal/util/logutil"
 	"github.com/milvus-io/milvus/internal/util/metautil"
@@ -1106,6 +1107,126 @@ func (node *DataNode) Import(ctx context.Context, req *datapb.ImportTaskRequest)
 	return resp, nil
 }
 
+// Export reads the flushed segments of an export task from MinIO/S3 storage, and writes the rows visible at the
+// snapshot timestamp into files(parquet, json or numpy) under the output prefix
+func (node *DataNode) Export(ctx context.Context, req *datapb.ExportTaskRequest) (*commonpb.Status, error) {
+	task := req.GetExportTask()
+	log.Info("DataNode receive export request",
+		zap.Int64("task ID", task.GetTaskId()),
+		zap.Int64("collection ID", task.GetCollectionId()),
+		zap.Int64s("partition IDs", task.GetPartitionIds()),
+		zap.Int("segment count", len(task.GetSegments())),
+		zap.Uint64("timestamp", task.GetTimestamp()),
+		zap.String("format", task.GetFormat()),
+		zap.Int64s("working dataNodes", req.WorkingNodes))
+	defer func() {
+		log.Info("DataNode finish export request", zap.Int64("task ID", task.GetTaskId()))
+	}()
+
+	exportResult := &rootcoordpb.ExportResult{
+		Status: &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_Success,
+		},
+		TaskId:     task.GetTaskId(),
+		DatanodeId: paramtable.GetNodeID(),
+		State:      rootcoordpb.ExportState_ExportStarted,
+		Files:      make([]string, 0),
+		RowCount:   0,
+	}
+	// DataCoord locks the exported segments before assigning the task, release the lock whatever the result is
+	defer node.releaseExportSegmentLock(task.GetTaskId())
+
+	// Spawn a new context to ignore cancellation from parental context.
+	newCtx, cancel := context.WithTimeout(context.TODO(), ImportCallTimeout)
+	defer cancel()
+	// func to report export state to RootCoord.
+	reportFunc := func(res *rootcoordpb.ExportResult) error {
+		status, err := node.rootCoord.ReportExport(newCtx, res)
+		if err != nil {
+			log.Error("fail to report export state to RootCoord", zap.Error(err))
+			return err
+		}
+		if status != nil && status.ErrorCode != commonpb.ErrorCode_Success {
+			return errors.New(status.GetReason())
+		}
+		return nil
+	}
+
+	if !node.isHealthy() {
+		log.Warn("DataNode export failed",
+			zap.Int64("collection ID", task.GetCollectionId()),
+			zap.Int64("task ID", task.GetTaskId()),
+			zap.Error(errDataNodeIsUnhealthy(paramtable.GetNodeID())))
+
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    msgDataNodeIsUnhealthy(paramtable.GetNodeID()),
+		}, nil
+	}
+
+	returnFailFunc := func(inputErr error) (*commonpb.Status, error) {
+		log.Warn("export wrapper failed to export data",
+			zap.Int64("task ID", task.GetTaskId()),
+			zap.Error(inputErr))
+		exportResult.State = rootcoordpb.ExportState_ExportFailed
+		exportResult.Infos = append(exportResult.Infos, &commonpb.KeyValuePair{Key: "failed_reason", Value: inputErr.Error()})
+		reportErr := reportFunc(exportResult)
+		if reportErr != nil {
+			log.Warn("fail to report export state to RootCoord", zap.Error(reportErr))
+		}
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    inputErr.Error(),
+		}, nil
+	}
+
+	// get collection schema
+	metaService := newMetaService(node.rootCoord, task.GetCollectionId())
+	colInfo, err := metaService.getCollectionInfo(newCtx, task.GetCollectionId(), 0)
+	if err != nil {
+		log.Warn("failed to get collection info for collection ID",
+			zap.Int64("task ID", task.GetTaskId()),
+			zap.Int64("collection ID", task.GetCollectionId()),
+			zap.Error(err))
+		return returnFailFunc(err)
+	}
+
+	// the export process is not bounded by the timeout of the rpc calls
+	exportWrapper := exportutil.NewExportWrapper(node.ctx, task.GetCollectionId(), colInfo.GetSchema(),
+		node.chunkManager, exportResult, reportFunc)
+	if err = exportWrapper.Export(task); err != nil {
+		return returnFailFunc(err)
+	}
+
+	return &commonpb.Status{
+		ErrorCode: commonpb.ErrorCode_Success,
+	}, nil
+}
+
+// releaseExportSegmentLock releases the reference lock DataCoord holds on the segments of an export task,
+// DataCoord releases it as well when the DataNode goes offline.
+func (node *DataNode) releaseExportSegmentLock(taskID UniqueID) {
+	releaseLock := func() error {
+		ctx, cancel := context.WithTimeout(node.ctx, ImportCallTimeout)
+		defer cancel()
+		status, err := node.dataCoord.ReleaseSegmentLock(ctx, &datapb.ReleaseSegmentLockRequest{
+			TaskID: taskID,
+			NodeID: paramtable.GetNodeID(),
+		})
+		if err != nil {
+			return err
+		}
+		if status.GetErrorCode() != commonpb.ErrorCode_Success {
+			return errors.New(status.GetReason())
+		}
+		return nil
+	}
+	if err := retry.Do(node.ctx, releaseLock, retry.Attempts(100)); err != nil {
+		log.Warn("failed to release the segment reference lock of export task",
+			zap.Int64("task ID", taskID), zap.Error(err))
+	}
+}
+
 // AddImportSegment adds the import segment to the current DataNode.
 func (node *DataNode) AddImportSegment(ctx context.Context, req *datapb.AddImportSegmentRequest) (*datapb.AddImportSegmentResponse, error) {
 	log.Info("adding segment to DataNode flow graph",
@@ -1407,7 +1528,7 @@ func createBinLogs(rowNum int, schema *schemapb.CollectionSchema, ts Timestamp,
 		ID:     colID,
 		Schema: schema,
 	}
-	binLogs, statsBinLogs, err := storage.NewInsertCodec(meta).Serialize(partID, segmentID, data.buffer)
+	binLogs, statsBinLogs, err := storage.NewInsertCodec(meta, codecOptions()...).Serialize(partID, segmentID, data.buffer)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -42,6 +42,7 @@ import (
 	"github.com/milvus-io/milvus/internal/types"
 	"github.com/milvus-io/milvus/internal/util/dependency"
 	"github.com/milvus-io/milvus/internal/util/etcd"
+	"github.com/milvus-io/milvus/internal/util/exportutil"
 	"github.com/milvus-io/milvus/internal/util/importutil"
 	"github.com/milvus-io/milvus/internal/util/metricsinfo"
 	"github.com/milvus-io/milvus/internal/util/paramtable"
@@ -598,6 +599,55 @@ func TestDataNode(t *testing.T) {
 		assert.Equal(t, commonpb.ErrorCode_UnexpectedError, stat.GetErrorCode())
 	})
 
+	t.Run("Test Export", func(t *testing.T) {
+		node.rootCoord = &RootCoordFactory{
+			collectionID: 100,
+			pkType:       schemapb.DataType_Int64,
+		}
+		req := &datapb.ExportTaskRequest{
+			ExportTask: &datapb.ExportTask{
+				TaskId:       1,
+				CollectionId: 100,
+				OutputPrefix: "export",
+				Format:       "json",
+			},
+		}
+		// no segment to export
+		stat, err := node.Export(node.ctx, req)
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, stat.GetErrorCode())
+
+		// unsupported format
+		req.ExportTask.Format = "csv"
+		stat, err = node.Export(node.ctx, req)
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_UnexpectedError, stat.GetErrorCode())
+
+		// failed to report
+		req.ExportTask.Format = "json"
+		node.rootCoord.(*RootCoordFactory).ReportExportErr = true
+		reportAttempts := exportutil.ReportExportAttempts
+		exportutil.ReportExportAttempts = 1
+		stat, err = node.Export(node.ctx, req)
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_UnexpectedError, stat.GetErrorCode())
+		node.rootCoord.(*RootCoordFactory).ReportExportErr = false
+		exportutil.ReportExportAttempts = reportAttempts
+
+		// failed to describe collection
+		node.rootCoord = &RootCoordFactory{collectionID: -1}
+		stat, err = node.Export(node.ctx, req)
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_UnexpectedError, stat.GetErrorCode())
+
+		// unhealthy
+		node.stateCode.Store(commonpb.StateCode_Abnormal)
+		stat, err = node.Export(node.ctx, req)
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_UnexpectedError, stat.GetErrorCode())
+		node.stateCode.Store(commonpb.StateCode_Healthy)
+	})
+
 	t.Run("Test Import error", func(t *testing.T) {
 		node.rootCoord = &RootCoordFactory{collectionID: -1}
 		req := &datapb.ImportTaskRequest{
@@ -221,6 +221,8 @@ func (dn *deleteNode) bufferDeleteMsg(msg *msgstream.DeleteMsg, tr TimeRange, st
 // filterSegmentByPK returns the bloom filter check result.
 // If the key may exist in the segment, returns it in map.
 // If the key not exist in the segment, the segment is filter out.
+// The insertBufferNode updates pk stats before deleteNode, so the delete of an upsert
+// also hits the segment holding the new row, which is kept since it shares the delete timestamp.
 func (dn *deleteNode) filterSegmentByPK(partID UniqueID, pks []primaryKey, tss []Timestamp) (
 	map[UniqueID][]primaryKey, map[UniqueID][]uint64) {
 	segID2Pks := make(map[UniqueID][]primaryKey)
@@ -362,7 +362,7 @@ func (m *rendezvousFlushManager) flushBufferData(data *BufferData, segmentID Uni
 	}
 
 	// encode data and convert output data
-	inCodec := storage.NewInsertCodec(meta)
+	inCodec := storage.NewInsertCodec(meta, codecOptions()...)
 
 	binLogs, statsBinlogs, err := inCodec.Serialize(partID, segmentID, data.buffer)
 	if err != nil {
@@ -450,7 +450,7 @@ func (m *rendezvousFlushManager) flushDelData(data *DelDataBuf, segmentID Unique
 		return err
 	}
 
-	delCodec := storage.NewDeleteCodec()
+	delCodec := storage.NewDeleteCodec(codecOptions()...)
 
 	blob, err := delCodec.Serialize(collID, partID, segmentID, data.delData)
 	if err != nil {
@@ -146,6 +146,12 @@ func Test_NewClient(t *testing.T) {
 		r31, err := client.ShowConfigurations(ctx, nil)
 		retCheck(retNotNil, r31, err)
 
+		r32, err := client.Export(ctx, nil)
+		retCheck(retNotNil, r32, err)
+
+		r33, err := client.RestoreSegments(ctx, nil)
+		retCheck(retNotNil, r33, err)
+
 		{
 			ret, err := client.BroadcastAlteredCollection(ctx, nil)
 			retCheck(retNotNil, ret, err)
@@ -364,6 +364,16 @@ func (s *Server) Import(ctx context.Context, req *datapb.ImportTaskRequest) (*da
 	return s.dataCoord.Import(ctx, req)
 }
 
+// Export fills the flushed segments into the export task and sends it to an idle DataNode
+func (s *Server) Export(ctx context.Context, req *datapb.ExportTaskRequest) (*datapb.ExportTaskResponse, error) {
+	return s.dataCoord.Export(ctx, req)
+}
+
+// RestoreSegments writes the segments of a collection valid at a timestamp into another collection
+func (s *Server) RestoreSegments(ctx context.Context, req *datapb.RestoreSegmentsRequest) (*datapb.RestoreSegmentsResponse, error) {
+	return s.dataCoord.RestoreSegments(ctx, req)
+}
+
 // UpdateSegmentStatistics is the dataCoord service caller of UpdateSegmentStatistics.
 func (s *Server) UpdateSegmentStatistics(ctx context.Context, req *datapb.UpdateSegmentStatisticsRequest) (*commonpb.Status, error) {
 	return s.dataCoord.UpdateSegmentStatistics(ctx, req)
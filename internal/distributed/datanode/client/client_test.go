@@ -92,6 +92,9 @@ func Test_NewClient(t *testing.T) {
 
 		r11, err := client.GetCompactionState(ctx, nil)
 		retCheck(retNotNil, r11, err)
+
+		r12, err := client.Export(ctx, nil)
+		retCheck(retNotNil, r12, err)
 	}
 
 	client.grpcClient = &mock.GRPCClientBase[datapb.DataNodeClient]{
@@ -6,6 +6,8 @@ import (
 	"github.com/gin-gonic/gin"
 	"github.com/golang/protobuf/proto"
 	"github.com/milvus-io/milvus-proto/go-api/milvuspb"
+	"github.com/milvus-io/milvus/internal/proto/proxypb"
+	"github.com/milvus-io/milvus/internal/proto/rootcoordpb"
 	"github.com/milvus-io/milvus/internal/types"
 )
 
@@ -54,9 +56,13 @@ func (h *Handlers) RegisterRoutesTo(router gin.IRouter) {
 	router.DELETE("/index", wrapHandler(h.handleDropIndex))
 
 	router.POST("/entities", wrapHandler(h.handleInsert))
+	router.PUT("/entities", wrapHandler(h.handleUpsert))
 	router.DELETE("/entities", wrapHandler(h.handleDelete))
 	router.POST("/search", wrapHandler(h.handleSearch))
+	router.POST("/hybrid-search", wrapHandler(h.handleHybridSearch))
+	router.POST("/search-iterator", wrapHandler(h.handleSearchIterator))
 	router.POST("/query", wrapHandler(h.handleQuery))
+	router.POST("/query-iterator", wrapHandler(h.handleQueryIterator))
 
 	router.POST("/persist", wrapHandler(h.handleFlush))
 	router.GET("/distance", wrapHandler(h.handleCalcDistance))
@@ -75,6 +81,13 @@ func (h *Handlers) RegisterRoutesTo(router gin.IRouter) {
 	router.GET("/import/state", wrapHandler(h.handleGetImportState))
 	router.GET("/import/tasks", wrapHandler(h.handleListImportTasks))
 
+	router.POST("/export", wrapHandler(h.handleExport))
+	router.GET("/export/state", wrapHandler(h.handleGetExportState))
+	router.GET("/export/tasks", wrapHandler(h.handleListExportTasks))
+
+	router.POST("/collection/restore", wrapHandler(h.handleRestoreCollection))
+	router.GET("/collection/restore/state", wrapHandler(h.handleGetRestoreState))
+
 	router.POST("/credential", wrapHandler(h.handleCreateCredential))
 	router.PATCH("/credential", wrapHandler(h.handleUpdateCredential))
 	router.DELETE("/credential", wrapHandler(h.handleDeleteCredential))
@@ -338,6 +351,28 @@ func (h *Handlers) handleInsert(c *gin.Context) (interface{}, error) {
 	return h.proxy.Insert(c, &req)
 }
 
+func (h *Handlers) handleUpsert(c *gin.Context) (interface{}, error) {
+	wrappedReq := WrappedInsertRequest{}
+	err := shouldBind(c, &wrappedReq)
+	if err != nil {
+		return nil, fmt.Errorf("%w: parse body failed: %v", errBadRequest, err)
+	}
+	fieldData, err := convertFieldDataArray(wrappedReq.FieldsData)
+	if err != nil {
+		return nil, fmt.Errorf("%w: convert field data failed: %v", errBadRequest, err)
+	}
+	req := milvuspb.InsertRequest{
+		Base:           wrappedReq.Base,
+		DbName:         wrappedReq.DbName,
+		CollectionName: wrappedReq.CollectionName,
+		PartitionName:  wrappedReq.PartitionName,
+		FieldsData:     fieldData,
+		HashKeys:       wrappedReq.HashKeys,
+		NumRows:        wrappedReq.NumRows,
+	}
+	return h.proxy.Upsert(c, &req)
+}
+
 func (h *Handlers) handleDelete(c *gin.Context) (interface{}, error) {
 	req := milvuspb.DeleteRequest{}
 	err := shouldBind(c, &req)
@@ -353,25 +388,44 @@ func (h *Handlers) handleSearch(c *gin.Context) (interface{}, error) {
 	if err != nil {
 		return nil, fmt.Errorf("%w: parse body failed: %v", errBadRequest, err)
 	}
-	req := milvuspb.SearchRequest{
+	return h.proxy.Search(c, wrappedReq.AsMilvuspb())
+}
+
+func (h *Handlers) handleHybridSearch(c *gin.Context) (interface{}, error) {
+	wrappedReq := HybridSearchRequest{}
+	err := shouldBind(c, &wrappedReq)
+	if err != nil {
+		return nil, fmt.Errorf("%w: parse body failed: %v", errBadRequest, err)
+	}
+	req := proxypb.HybridSearchRequest{
 		Base:               wrappedReq.Base,
 		DbName:             wrappedReq.DbName,
 		CollectionName:     wrappedReq.CollectionName,
 		PartitionNames:     wrappedReq.PartitionNames,
-		Dsl:                wrappedReq.Dsl,
-		DslType:            wrappedReq.DslType,
+		Requests:           make([]*milvuspb.SearchRequest, 0, len(wrappedReq.Requests)),
 		OutputFields:       wrappedReq.OutputFields,
-		SearchParams:       wrappedReq.SearchParams,
+		RankParams:         wrappedReq.RankParams,
 		TravelTimestamp:    wrappedReq.TravelTimestamp,
 		GuaranteeTimestamp: wrappedReq.GuaranteeTimestamp,
-		Nq:                 wrappedReq.Nq,
 	}
-	if len(wrappedReq.BinaryVectors) > 0 {
-		req.PlaceholderGroup = binaryVector2Bytes(wrappedReq.BinaryVectors)
-	} else {
-		req.PlaceholderGroup = vector2Bytes(wrappedReq.Vectors)
+	for _, subReq := range wrappedReq.Requests {
+		req.Requests = append(req.Requests, subReq.AsMilvuspb())
 	}
-	return h.proxy.Search(c, &req)
+	return h.proxy.HybridSearch(c, &req)
+}
+
+func (h *Handlers) handleSearchIterator(c *gin.Context) (interface{}, error) {
+	wrappedReq := SearchIteratorRequest{}
+	err := shouldBind(c, &wrappedReq)
+	if err != nil {
+		return nil, fmt.Errorf("%w: parse body failed: %v", errBadRequest, err)
+	}
+	req := proxypb.SearchIteratorRequest{
+		Request:   wrappedReq.Request.AsMilvuspb(),
+		Cursor:    wrappedReq.Cursor,
+		BatchSize: wrappedReq.BatchSize,
+	}
+	return h.proxy.SearchIterator(c, &req)
 }
 
 func (h *Handlers) handleQuery(c *gin.Context) (interface{}, error) {
@@ -383,6 +437,15 @@ func (h *Handlers) handleQuery(c *gin.Context) (interface{}, error) {
 	return h.proxy.Query(c, &req)
 }
 
+func (h *Handlers) handleQueryIterator(c *gin.Context) (interface{}, error) {
+	req := proxypb.QueryIteratorRequest{}
+	err := shouldBind(c, &req)
+	if err != nil {
+		return nil, fmt.Errorf("%w: parse body failed: %v", errBadRequest, err)
+	}
+	return h.proxy.QueryIterator(c, &req)
+}
+
 func (h *Handlers) handleFlush(c *gin.Context) (interface{}, error) {
 	req := milvuspb.FlushRequest{}
 	err := shouldBind(c, &req)
@@ -516,6 +579,51 @@ func (h *Handlers) handleListImportTasks(c *gin.Context) (interface{}, error) {
 	return h.proxy.ListImportTasks(c, &req)
 }
 
+func (h *Handlers) handleExport(c *gin.Context) (interface{}, error) {
+	req := rootcoordpb.ExportRequest{}
+	err := shouldBind(c, &req)
+	if err != nil {
+		return nil, fmt.Errorf("%w: parse body failed: %v", errBadRequest, err)
+	}
+	return h.proxy.Export(c, &req)
+}
+
+func (h *Handlers) handleGetExportState(c *gin.Context) (interface{}, error) {
+	req := rootcoordpb.GetExportStateRequest{}
+	err := shouldBind(c, &req)
+	if err != nil {
+		return nil, fmt.Errorf("%w: parse body failed: %v", errBadRequest, err)
+	}
+	return h.proxy.GetExportState(c, &req)
+}
+
+func (h *Handlers) handleListExportTasks(c *gin.Context) (interface{}, error) {
+	req := rootcoordpb.ListExportTasksRequest{}
+	err := shouldBind(c, &req)
+	if err != nil {
+		return nil, fmt.Errorf("%w: parse body failed: %v", errBadRequest, err)
+	}
+	return h.proxy.ListExportTasks(c, &req)
+}
+
+func (h *Handlers) handleRestoreCollection(c *gin.Context) (interface{}, error) {
+	req := rootcoordpb.RestoreCollectionRequest{}
+	err := shouldBind(c, &req)
+	if err != nil {
+		return nil, fmt.Errorf("%w: parse body failed: %v", errBadRequest, err)
+	}
+	return h.proxy.RestoreCollection(c, &req)
+}
+
+func (h *Handlers) handleGetRestoreState(c *gin.Context) (interface{}, error) {
+	req := rootcoordpb.GetRestoreStateRequest{}
+	err := shouldBind(c, &req)
+	if err != nil {
+		return nil, fmt.Errorf("%w: parse body failed: %v", errBadRequest, err)
+	}
+	return h.proxy.GetRestoreState(c, &req)
+}
+
 func (h *Handlers) handleCreateCredential(c *gin.Context) (interface{}, error) {
 	req := milvuspb.CreateCredentialRequest{}
 	err := shouldBind(c, &req)
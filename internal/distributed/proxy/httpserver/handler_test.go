@@ -15,6 +15,8 @@ import (
 	"github.com/milvus-io/milvus-proto/go-api/commonpb"
 	"github.com/milvus-io/milvus-proto/go-api/milvuspb"
 	"github.com/milvus-io/milvus-proto/go-api/schemapb"
+	"github.com/milvus-io/milvus/internal/proto/proxypb"
+	"github.com/milvus-io/milvus/internal/proto/rootcoordpb"
 	"github.com/milvus-io/milvus/internal/types"
 	"github.com/stretchr/testify/assert"
 )
@@ -134,6 +136,13 @@ func (m *mockProxyComponent) Insert(ctx context.Context, request *milvuspb.Inser
 	return &milvuspb.MutationResult{Acknowledged: true}, nil
 }
 
+func (m *mockProxyComponent) Upsert(ctx context.Context, request *milvuspb.InsertRequest) (*milvuspb.MutationResult, error) {
+	if request.CollectionName == "" {
+		return nil, errors.New("body parse err")
+	}
+	return &milvuspb.MutationResult{Acknowledged: true}, nil
+}
+
 func (m *mockProxyComponent) Delete(ctx context.Context, request *milvuspb.DeleteRequest) (*milvuspb.MutationResult, error) {
 	if request.Expr == "" {
 		return nil, errors.New("body parse err")
@@ -154,6 +163,25 @@ func (m *mockProxyComponent) Search(ctx context.Context, request *milvuspb.Searc
 	return &searchResult, nil
 }
 
+func (m *mockProxyComponent) HybridSearch(ctx context.Context, request *proxypb.HybridSearchRequest) (*milvuspb.SearchResults, error) {
+	if len(request.Requests) == 0 {
+		return nil, errors.New("body parse err")
+	}
+	return &searchResult, nil
+}
+
+var searchIteratorResult = proxypb.SearchIteratorResults{
+	Results: &searchResult,
+	Cursor:  &proxypb.IteratorCursor{MvccTimestamp: 1, LastScore: 0.5, Returned: 10},
+}
+
+func (m *mockProxyComponent) SearchIterator(ctx context.Context, request *proxypb.SearchIteratorRequest) (*proxypb.SearchIteratorResults, error) {
+	if request.GetRequest().GetDsl() == "" {
+		return nil, errors.New("body parse err")
+	}
+	return &searchIteratorResult, nil
+}
+
 var queryResult = milvuspb.QueryResults{
 	CollectionName: "test",
 }
@@ -165,6 +193,18 @@ func (m *mockProxyComponent) Query(ctx context.Context, request *milvuspb.QueryR
 	return &queryResult, nil
 }
 
+var queryIteratorResult = proxypb.QueryIteratorResults{
+	Results: &queryResult,
+	Cursor:  &proxypb.IteratorCursor{MvccTimestamp: 1},
+}
+
+func (m *mockProxyComponent) QueryIterator(ctx context.Context, request *proxypb.QueryIteratorRequest) (*proxypb.QueryIteratorResults, error) {
+	if request.GetRequest().GetExpr() == "" {
+		return nil, errors.New("body parse err")
+	}
+	return &queryIteratorResult, nil
+}
+
 var flushResult = milvuspb.FlushResponse{
 	DbName: "default",
 }
@@ -239,6 +279,26 @@ func (m *mockProxyComponent) ListImportTasks(ctx context.Context, request *milvu
 	return &milvuspb.ListImportTasksResponse{Status: testStatus}, nil
 }
 
+func (m *mockProxyComponent) Export(ctx context.Context, request *rootcoordpb.ExportRequest) (*rootcoordpb.ExportResponse, error) {
+	return &rootcoordpb.ExportResponse{Status: testStatus}, nil
+}
+
+func (m *mockProxyComponent) GetExportState(ctx context.Context, request *rootcoordpb.GetExportStateRequest) (*rootcoordpb.GetExportStateResponse, error) {
+	return &rootcoordpb.GetExportStateResponse{Status: testStatus}, nil
+}
+
+func (m *mockProxyComponent) ListExportTasks(ctx context.Context, request *rootcoordpb.ListExportTasksRequest) (*rootcoordpb.ListExportTasksResponse, error) {
+	return &rootcoordpb.ListExportTasksResponse{Status: testStatus}, nil
+}
+
+func (m *mockProxyComponent) RestoreCollection(ctx context.Context, request *rootcoordpb.RestoreCollectionRequest) (*rootcoordpb.RestoreCollectionResponse, error) {
+	return &rootcoordpb.RestoreCollectionResponse{Status: testStatus}, nil
+}
+
+func (m *mockProxyComponent) GetRestoreState(ctx context.Context, request *rootcoordpb.GetRestoreStateRequest) (*rootcoordpb.GetRestoreStateResponse, error) {
+	return &rootcoordpb.GetRestoreStateResponse{Status: testStatus}, nil
+}
+
 func (m *mockProxyComponent) CreateCredential(ctx context.Context, request *milvuspb.CreateCredentialRequest) (*commonpb.Status, error) {
 	return testStatus, nil
 }
@@ -423,6 +483,10 @@ func TestHandlers(t *testing.T) {
 			http.MethodPost, "/entities", &milvuspb.InsertRequest{CollectionName: "c1"},
 			http.StatusOK, &milvuspb.MutationResult{Acknowledged: true},
 		},
+		{
+			http.MethodPut, "/entities", &milvuspb.InsertRequest{CollectionName: "c1"},
+			http.StatusOK, &milvuspb.MutationResult{Acknowledged: true},
+		},
 		{
 			http.MethodDelete, "/entities", milvuspb.DeleteRequest{Expr: "some expr"},
 			http.StatusOK, &milvuspb.MutationResult{Acknowledged: true},
@@ -431,10 +495,22 @@ func TestHandlers(t *testing.T) {
 			http.MethodPost, "/search", milvuspb.SearchRequest{Dsl: "some dsl"},
 			http.StatusOK, &searchResult,
 		},
+		{
+			http.MethodPost, "/hybrid-search", HybridSearchRequest{Requests: []*SearchRequest{{Dsl: "some dsl"}}},
+			http.StatusOK, &searchResult,
+		},
+		{
+			http.MethodPost, "/search-iterator", SearchIteratorRequest{Request: SearchRequest{Dsl: "some dsl"}, BatchSize: 10},
+			http.StatusOK, &searchIteratorResult,
+		},
 		{
 			http.MethodPost, "/query", milvuspb.QueryRequest{Expr: "some expr"},
 			http.StatusOK, &queryResult,
 		},
+		{
+			http.MethodPost, "/query-iterator", proxypb.QueryIteratorRequest{Request: &milvuspb.QueryRequest{Expr: "some expr"}, BatchSize: 10},
+			http.StatusOK, &queryIteratorResult,
+		},
 		{
 			http.MethodPost, "/persist", milvuspb.FlushRequest{CollectionNames: []string{"c1"}},
 			http.StatusOK, flushResult,
@@ -494,6 +570,26 @@ func TestHandlers(t *testing.T) {
 			http.MethodGet, "/import/tasks", emptyBody,
 			http.StatusOK, &milvuspb.ListImportTasksResponse{Status: testStatus},
 		},
+		{
+			http.MethodPost, "/export", emptyBody,
+			http.StatusOK, &rootcoordpb.ExportResponse{Status: testStatus},
+		},
+		{
+			http.MethodGet, "/export/state", emptyBody,
+			http.StatusOK, &rootcoordpb.GetExportStateResponse{Status: testStatus},
+		},
+		{
+			http.MethodGet, "/export/tasks", emptyBody,
+			http.StatusOK, &rootcoordpb.ListExportTasksResponse{Status: testStatus},
+		},
+		{
+			http.MethodPost, "/collection/restore", emptyBody,
+			http.StatusOK, &rootcoordpb.RestoreCollectionResponse{Status: testStatus},
+		},
+		{
+			http.MethodGet, "/collection/restore/state", emptyBody,
+			http.StatusOK, &rootcoordpb.GetRestoreStateResponse{Status: testStatus},
+		},
 		{
 			http.MethodPost, "/credential", emptyBody,
 			http.StatusOK, testStatus,
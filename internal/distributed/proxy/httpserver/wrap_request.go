@@ -11,6 +11,7 @@ import (
 	"github.com/milvus-io/milvus-proto/go-api/commonpb"
 	"github.com/milvus-io/milvus-proto/go-api/milvuspb"
 	"github.com/milvus-io/milvus-proto/go-api/schemapb"
+	"github.com/milvus-io/milvus/internal/proto/proxypb"
 )
 
 // We wrap original protobuf structure for 2 reasons:
@@ -267,6 +268,49 @@ type SearchRequest struct {
 	Nq                 int64                    `protobuf:"varint,12,opt,name=nq,proto3" json:"nq,omitempty"`
 }
 
+// HybridSearchRequest is the RESTful request body for hybrid search
+type HybridSearchRequest struct {
+	Base               *commonpb.MsgBase        `json:"base,omitempty"`
+	DbName             string                   `json:"db_name,omitempty"`
+	CollectionName     string                   `json:"collection_name,omitempty"`
+	PartitionNames     []string                 `json:"partition_names,omitempty"`
+	Requests           []*SearchRequest         `json:"requests,omitempty"`
+	OutputFields       []string                 `json:"output_fields,omitempty"`
+	RankParams         []*commonpb.KeyValuePair `json:"rank_params,omitempty"`
+	TravelTimestamp    uint64                   `json:"travel_timestamp,omitempty"`
+	GuaranteeTimestamp uint64                   `json:"guarantee_timestamp,omitempty"`
+}
+
+// SearchIteratorRequest is the RESTful request body for search iterator
+type SearchIteratorRequest struct {
+	Request   SearchRequest           `json:"request"`
+	Cursor    *proxypb.IteratorCursor `json:"cursor,omitempty"`
+	BatchSize int64                   `json:"batch_size,omitempty"`
+}
+
+// AsMilvuspb converts the RESTful search request to milvuspb.SearchRequest
+func (req *SearchRequest) AsMilvuspb() *milvuspb.SearchRequest {
+	ret := &milvuspb.SearchRequest{
+		Base:               req.Base,
+		DbName:             req.DbName,
+		CollectionName:     req.CollectionName,
+		PartitionNames:     req.PartitionNames,
+		Dsl:                req.Dsl,
+		DslType:            req.DslType,
+		OutputFields:       req.OutputFields,
+		SearchParams:       req.SearchParams,
+		TravelTimestamp:    req.TravelTimestamp,
+		GuaranteeTimestamp: req.GuaranteeTimestamp,
+		Nq:                 req.Nq,
+	}
+	if len(req.BinaryVectors) > 0 {
+		ret.PlaceholderGroup = binaryVector2Bytes(req.BinaryVectors)
+	} else {
+		ret.PlaceholderGroup = vector2Bytes(req.Vectors)
+	}
+	return ret
+}
+
 func binaryVector2Bytes(vectors [][]byte) []byte {
 	ph := &commonpb.PlaceholderValue{
 		Tag:    "$0",
@@ -217,6 +217,30 @@ func (m *MockRootCoord) ReportImport(ctx context.Context, req *rootcoordpb.Impor
 	return nil, nil
 }
 
+func (m *MockRootCoord) Export(ctx context.Context, req *rootcoordpb.ExportRequest) (*rootcoordpb.ExportResponse, error) {
+	return nil, nil
+}
+
+func (m *MockRootCoord) GetExportState(ctx context.Context, req *rootcoordpb.GetExportStateRequest) (*rootcoordpb.GetExportStateResponse, error) {
+	return nil, nil
+}
+
+func (m *MockRootCoord) ListExportTasks(ctx context.Context, req *rootcoordpb.ListExportTasksRequest) (*rootcoordpb.ListExportTasksResponse, error) {
+	return nil, nil
+}
+
+func (m *MockRootCoord) ReportExport(ctx context.Context, req *rootcoordpb.ExportResult) (*commonpb.Status, error) {
+	return nil, nil
+}
+
+func (m *MockRootCoord) RestoreCollection(ctx context.Context, req *rootcoordpb.RestoreCollectionRequest) (*rootcoordpb.RestoreCollectionResponse, error) {
+	return nil, nil
+}
+
+func (m *MockRootCoord) GetRestoreState(ctx context.Context, req *rootcoordpb.GetRestoreStateRequest) (*rootcoordpb.GetRestoreStateResponse, error) {
+	return nil, nil
+}
+
 func (m *MockRootCoord) CreateCredential(ctx context.Context, req *internalpb.CredentialInfo) (*commonpb.Status, error) {
 	return nil, nil
 }
@@ -589,6 +613,14 @@ func (m *MockDataCoord) Import(ctx context.Context, req *datapb.ImportTaskReques
 	return nil, nil
 }
 
+func (m *MockDataCoord) Export(ctx context.Context, req *datapb.ExportTaskRequest) (*datapb.ExportTaskResponse, error) {
+	return nil, nil
+}
+
+func (m *MockDataCoord) RestoreSegments(ctx context.Context, req *datapb.RestoreSegmentsRequest) (*datapb.RestoreSegmentsResponse, error) {
+	return nil, nil
+}
+
 func (m *MockDataCoord) UpdateSegmentStatistics(ctx context.Context, req *datapb.UpdateSegmentStatisticsRequest) (*commonpb.Status, error) {
 	return nil, nil
 }
@@ -736,6 +768,10 @@ func (m *MockProxy) Insert(ctx context.Context, request *milvuspb.InsertRequest)
 	return nil, nil
 }
 
+func (m *MockProxy) Upsert(ctx context.Context, request *milvuspb.InsertRequest) (*milvuspb.MutationResult, error) {
+	return nil, nil
+}
+
 func (m *MockProxy) Delete(ctx context.Context, request *milvuspb.DeleteRequest) (*milvuspb.MutationResult, error) {
 	return nil, nil
 }
@@ -744,6 +780,18 @@ func (m *MockProxy) Search(ctx context.Context, request *milvuspb.SearchRequest)
 	return nil, nil
 }
 
+func (m *MockProxy) HybridSearch(ctx context.Context, request *proxypb.HybridSearchRequest) (*milvuspb.SearchResults, error) {
+	return nil, nil
+}
+
+func (m *MockProxy) SearchIterator(ctx context.Context, request *proxypb.SearchIteratorRequest) (*proxypb.SearchIteratorResults, error) {
+	return nil, nil
+}
+
+func (m *MockProxy) QueryIterator(ctx context.Context, request *proxypb.QueryIteratorRequest) (*proxypb.QueryIteratorResults, error) {
+	return nil, nil
+}
+
 func (m *MockProxy) Flush(ctx context.Context, request *milvuspb.FlushRequest) (*milvuspb.FlushResponse, error) {
 	return nil, nil
 }
@@ -862,6 +910,26 @@ func (m *MockProxy) ListImportTasks(ctx context.Context, in *milvuspb.ListImport
 	return nil, nil
 }
 
+func (m *MockProxy) Export(ctx context.Context, req *rootcoordpb.ExportRequest) (*rootcoordpb.ExportResponse, error) {
+	return nil, nil
+}
+
+func (m *MockProxy) RestoreCollection(ctx context.Context, req *rootcoordpb.RestoreCollectionRequest) (*rootcoordpb.RestoreCollectionResponse, error) {
+	return nil, nil
+}
+
+func (m *MockProxy) GetRestoreState(ctx context.Context, req *rootcoordpb.GetRestoreStateRequest) (*rootcoordpb.GetRestoreStateResponse, error) {
+	return nil, nil
+}
+
+func (m *MockProxy) GetExportState(ctx context.Context, req *rootcoordpb.GetExportStateRequest) (*rootcoordpb.GetExportStateResponse, error) {
+	return nil, nil
+}
+
+func (m *MockProxy) ListExportTasks(ctx context.Context, req *rootcoordpb.ListExportTasksRequest) (*rootcoordpb.ListExportTasksResponse, error) {
+	return nil, nil
+}
+
 func (m *MockProxy) GetReplicas(ctx context.Context, req *milvuspb.GetReplicasRequest) (*milvuspb.GetReplicasResponse, error) {
 	return nil, nil
 }
@@ -1187,6 +1255,11 @@ func Test_NewServer(t *testing.T) {
 		assert.Nil(t, err)
 	})
 
+	t.Run("Upsert", func(t *testing.T) {
+		_, err := server.Upsert(ctx, nil)
+		assert.Nil(t, err)
+	})
+
 	t.Run("Delete", func(t *testing.T) {
 		_, err := server.Delete(ctx, nil)
 		assert.Nil(t, err)
@@ -153,6 +153,30 @@ func Test_NewClient(t *testing.T) {
 			r, err := client.ReportImport(ctx, nil)
 			retCheck(retNotNil, r, err)
 		}
+		{
+			r, err := client.Export(ctx, nil)
+			retCheck(retNotNil, r, err)
+		}
+		{
+			r, err := client.GetExportState(ctx, nil)
+			retCheck(retNotNil, r, err)
+		}
+		{
+			r, err := client.ListExportTasks(ctx, nil)
+			retCheck(retNotNil, r, err)
+		}
+		{
+			r, err := client.ReportExport(ctx, nil)
+			retCheck(retNotNil, r, err)
+		}
+		{
+			r, err := client.RestoreCollection(ctx, nil)
+			retCheck(retNotNil, r, err)
+		}
+		{
+			r, err := client.GetRestoreState(ctx, nil)
+			retCheck(retNotNil, r, err)
+		}
 		{
 			r, err := client.CreateCredential(ctx, nil)
 			retCheck(retNotNil, r, err)
@@ -355,6 +379,30 @@ func Test_NewClient(t *testing.T) {
 		rTimeout, err := client.ReportImport(shortCtx, nil)
 		retCheck(rTimeout, err)
 	}
+	{
+		rTimeout, err := client.Export(shortCtx, nil)
+		retCheck(rTimeout, err)
+	}
+	{
+		rTimeout, err := client.GetExportState(shortCtx, nil)
+		retCheck(rTimeout, err)
+	}
+	{
+		rTimeout, err := client.ListExportTasks(shortCtx, nil)
+		retCheck(rTimeout, err)
+	}
+	{
+		rTimeout, err := client.ReportExport(shortCtx, nil)
+		retCheck(rTimeout, err)
+	}
+	{
+		rTimeout, err := client.RestoreCollection(shortCtx, nil)
+		retCheck(rTimeout, err)
+	}
+	{
+		rTimeout, err := client.GetRestoreState(shortCtx, nil)
+		retCheck(rTimeout, err)
+	}
 	{
 		rTimeout, err := client.CreateCredential(shortCtx, nil)
 		retCheck(rTimeout, err)
@@ -425,6 +425,36 @@ func (s *Server) ReportImport(ctx context.Context, in *rootcoordpb.ImportResult)
 	return s.rootCoord.ReportImport(ctx, in)
 }
 
+// Export data of a collection into object storage
+func (s *Server) Export(ctx context.Context, in *rootcoordpb.ExportRequest) (*rootcoordpb.ExportResponse, error) {
+	return s.rootCoord.Export(ctx, in)
+}
+
+// Check export task state
+func (s *Server) GetExportState(ctx context.Context, in *rootcoordpb.GetExportStateRequest) (*rootcoordpb.GetExportStateResponse, error) {
+	return s.rootCoord.GetExportState(ctx, in)
+}
+
+// Returns information of export tasks
+func (s *Server) ListExportTasks(ctx context.Context, in *rootcoordpb.ListExportTasksRequest) (*rootcoordpb.ListExportTasksResponse, error) {
+	return s.rootCoord.ListExportTasks(ctx, in)
+}
+
+// Report export task state to rootcoord
+func (s *Server) ReportExport(ctx context.Context, in *rootcoordpb.ExportResult) (*commonpb.Status, error) {
+	return s.rootCoord.ReportExport(ctx, in)
+}
+
+// RestoreCollection restores a collection as it was at a timestamp into a new collection
+func (s *Server) RestoreCollection(ctx context.Context, in *rootcoordpb.RestoreCollectionRequest) (*rootcoordpb.RestoreCollectionResponse, error) {
+	return s.rootCoord.RestoreCollection(ctx, in)
+}
+
+// GetRestoreState checks the state of a restore task
+func (s *Server) GetRestoreState(ctx context.Context, in *rootcoordpb.GetRestoreStateRequest) (*rootcoordpb.GetRestoreStateResponse, error) {
+	return s.rootCoord.GetRestoreState(ctx, in)
+}
+
 func (s *Server) CreateCredential(ctx context.Context, request *internalpb.CredentialInfo) (*commonpb.Status, error) {
 	return s.rootCoord.CreateCredential(ctx, request)
 }
@@ -274,7 +274,7 @@ func (ib *indexBuilder) process(buildID UniqueID) bool {
 		typeParams := ib.meta.GetTypeParams(meta.CollectionID, meta.IndexID)
 
 		var storageConfig *indexpb.StorageConfig
-		if Params.CommonCfg.StorageType == "local" {
+		if Params.CommonCfg.StorageType == "local" || Params.CommonCfg.StorageType == "durable" {
 			storageConfig = &indexpb.StorageConfig{
 				RootPath:    Params.LocalStorageCfg.Path,
 				StorageType: Params.CommonCfg.StorageType,
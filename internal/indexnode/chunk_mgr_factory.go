@@ -3,11 +3,14 @@ package indexnode
 import (
 	"context"
 	"fmt"
+	"path"
 	"sync"
 
 	"github.com/milvus-io/milvus/internal/log"
 	"github.com/milvus-io/milvus/internal/proto/indexpb"
 	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/internal/util/paramtable"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
 )
 
 type StorageFactory interface {
@@ -16,6 +19,8 @@ type StorageFactory interface {
 
 type chunkMgr struct {
 	cached sync.Map
+	// mu serializes the creation of chunk managers sharing the disk cache path
+	mu sync.Mutex
 }
 
 func (m *chunkMgr) NewChunkManager(ctx context.Context, config *indexpb.StorageConfig) (storage.ChunkManager, error) {
@@ -24,11 +29,23 @@ func (m *chunkMgr) NewChunkManager(ctx context.Context, config *indexpb.StorageC
 		return v.(storage.ChunkManager), nil
 	}
 
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if v, ok := m.cached.Load(key); ok {
+		return v.(storage.ChunkManager), nil
+	}
 	chunkManagerFactory := storage.NewChunkManagerFactoryWithParam(Params)
 	mgr, err := chunkManagerFactory.NewPersistentStorageChunkManager(ctx)
 	if err != nil {
 		return nil, err
 	}
+	if Params.IndexNodeCfg.DiskCacheCapacity > 0 {
+		cachePath := path.Join(Params.LocalStorageCfg.Path, "cache", typeutil.IndexNodeRole, fmt.Sprint(paramtable.GetNodeID()), key)
+		mgr, _, err = storage.NewDiskCachedChunkManager(mgr, cachePath, Params.IndexNodeCfg.DiskCacheCapacity, typeutil.IndexNodeRole)
+		if err != nil {
+			return nil, err
+		}
+	}
 	v, _ := m.cached.LoadOrStore(key, mgr)
 	log.Ctx(ctx).Info("index node successfully init chunk manager")
 	return v.(storage.ChunkManager), nil
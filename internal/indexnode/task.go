@@ -34,6 +34,7 @@ import (
 	"github.com/milvus-io/milvus/internal/metrics"
 	"github.com/milvus-io/milvus/internal/proto/indexpb"
 	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/internal/util/compressor"
 	"github.com/milvus-io/milvus/internal/util/concurrency"
 	"github.com/milvus-io/milvus/internal/util/funcutil"
 	"github.com/milvus-io/milvus/internal/util/indexcgowrapper"
@@ -289,7 +290,7 @@ func (it *indexBuildTask) BuildIndex(ctx context.Context) error {
 	}
 
 	var serializedIndexBlobs []*storage.Blob
-	codec := storage.NewIndexFileBinlogCodec()
+	codec := storage.NewIndexFileBinlogCodec(storage.WithCompression(compressor.CompressType(Params.CommonCfg.StorageCompression)))
 	serializedIndexBlobs, err = codec.Serialize(
 		it.req.BuildID,
 		it.req.IndexVersion,
@@ -481,7 +482,7 @@ func (it *indexBuildTask) SaveDiskAnnIndexFiles(ctx context.Context) error {
 	}
 
 	// add indexparams file
-	codec := storage.NewIndexFileBinlogCodec()
+	codec := storage.NewIndexFileBinlogCodec(storage.WithCompression(compressor.CompressType(Params.CommonCfg.StorageCompression)))
 	indexParamBlob, err := codec.SerializeIndexParams(
 		it.req.GetBuildID(),
 		it.req.GetIndexVersion(),
@@ -21,3 +21,9 @@ const HealthzRouterPath = "/healthz"
 
 // LogLevelRouterPath is path for Get and Update log level at runtime.
 const LogLevelRouterPath = "/log/level"
+
+// ConfigRouterPath is path for getting the effective configs and updating configs in etcd.
+const ConfigRouterPath = "/config"
+
+// RocksmqStatsRouterPath is path for getting the stats of topics and consumer groups in rocksmq.
+const RocksmqStatsRouterPath = "/rocksmq/stats"
@@ -0,0 +1,90 @@
+package dao
+
+import (
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/metastore/db/dbmodel"
+)
+
+type channelCheckpointDb struct {
+	db *gorm.DB
+}
+
+func (s *channelCheckpointDb) List(tenantID string) ([]*dbmodel.ChannelCheckpoint, error) {
+	var r []*dbmodel.ChannelCheckpoint
+
+	err := s.db.Model(&dbmodel.ChannelCheckpoint{}).Where("tenant_id = ?", tenantID).Find(&r).Error
+	if err != nil {
+		log.Error("list channel checkpoints failed", zap.String("tenant", tenantID), zap.Error(err))
+		return nil, err
+	}
+
+	return r, nil
+}
+
+func (s *channelCheckpointDb) Upsert(in *dbmodel.ChannelCheckpoint) error {
+	err := s.db.Clauses(clause.OnConflict{
+		// constraint UNIQUE (tenant_id, vchannel)
+		Columns:   []clause.Column{{Name: "tenant_id"}, {Name: "vchannel"}},
+		UpdateAll: true,
+	}).Create(in).Error
+	if err != nil {
+		log.Error("upsert channel checkpoint failed", zap.String("tenant", in.TenantID), zap.String("vChannel", in.VChannel), zap.Error(err))
+		return err
+	}
+
+	return nil
+}
+
+func (s *channelCheckpointDb) Delete(tenantID string, vChannel string) error {
+	err := s.db.Where("tenant_id = ? AND vchannel = ?", tenantID, vChannel).Delete(&dbmodel.ChannelCheckpoint{}).Error
+	if err != nil {
+		log.Error("delete channel checkpoint failed", zap.String("tenant", tenantID), zap.String("vChannel", vChannel), zap.Error(err))
+		return err
+	}
+
+	return nil
+}
+
+type droppedChannelDb struct {
+	db *gorm.DB
+}
+
+func (s *droppedChannelDb) Insert(in *dbmodel.DroppedChannel) error {
+	err := s.db.Clauses(clause.OnConflict{
+		// constraint UNIQUE (tenant_id, channel)
+		Columns:   []clause.Column{{Name: "tenant_id"}, {Name: "channel"}},
+		DoNothing: true,
+	}).Create(in).Error
+	if err != nil {
+		log.Error("insert dropped channel failed", zap.String("tenant", in.TenantID), zap.String("channel", in.Channel), zap.Error(err))
+		return err
+	}
+
+	return nil
+}
+
+func (s *droppedChannelDb) Exist(tenantID string, channel string) (bool, error) {
+	var count int64
+
+	err := s.db.Model(&dbmodel.DroppedChannel{}).Where("tenant_id = ? AND channel = ?", tenantID, channel).Count(&count).Error
+	if err != nil {
+		log.Error("get dropped channel failed", zap.String("tenant", tenantID), zap.String("channel", channel), zap.Error(err))
+		return false, err
+	}
+
+	return count > 0, nil
+}
+
+func (s *droppedChannelDb) Delete(tenantID string, channel string) error {
+	err := s.db.Where("tenant_id = ? AND channel = ?", tenantID, channel).Delete(&dbmodel.DroppedChannel{}).Error
+	if err != nil {
+		log.Error("delete dropped channel failed", zap.String("tenant", tenantID), zap.String("channel", channel), zap.Error(err))
+		return err
+	}
+
+	return nil
+}
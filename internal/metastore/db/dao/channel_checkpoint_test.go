@@ -0,0 +1,54 @@
+package dao
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/milvus-io/milvus/internal/metastore/db/dbmodel"
+)
+
+func TestChannelCheckpoint_Sqlite(t *testing.T) {
+	cpDb := &channelCheckpointDb{newSqliteTestDB(t)}
+
+	require.NoError(t, cpDb.Upsert(&dbmodel.ChannelCheckpoint{TenantID: tenantID, VChannel: "ch1", MsgID: []byte{1}, Timestamp: 100}))
+	require.NoError(t, cpDb.Upsert(&dbmodel.ChannelCheckpoint{TenantID: tenantID, VChannel: "ch1", MsgID: []byte{2}, Timestamp: 200}))
+	require.NoError(t, cpDb.Upsert(&dbmodel.ChannelCheckpoint{TenantID: tenantID, VChannel: "ch2", Timestamp: 300}))
+
+	res, err := cpDb.List(tenantID)
+	require.NoError(t, err)
+	require.Equal(t, 2, len(res))
+	cps := make(map[string]*dbmodel.ChannelCheckpoint)
+	for _, cp := range res {
+		cps[cp.VChannel] = cp
+	}
+	assert.Equal(t, []byte{2}, cps["ch1"].MsgID)
+	assert.Equal(t, uint64(200), cps["ch1"].Timestamp)
+
+	require.NoError(t, cpDb.Delete(tenantID, "ch1"))
+	res, err = cpDb.List(tenantID)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(res))
+	assert.Equal(t, "ch2", res[0].VChannel)
+}
+
+func TestDroppedChannel_Sqlite(t *testing.T) {
+	droppedDb := &droppedChannelDb{newSqliteTestDB(t)}
+
+	dropped, err := droppedDb.Exist(tenantID, "ch1")
+	require.NoError(t, err)
+	assert.False(t, dropped)
+
+	require.NoError(t, droppedDb.Insert(&dbmodel.DroppedChannel{TenantID: tenantID, Channel: "ch1"}))
+	// marking twice is allowed
+	require.NoError(t, droppedDb.Insert(&dbmodel.DroppedChannel{TenantID: tenantID, Channel: "ch1"}))
+	dropped, err = droppedDb.Exist(tenantID, "ch1")
+	require.NoError(t, err)
+	assert.True(t, dropped)
+
+	require.NoError(t, droppedDb.Delete(tenantID, "ch1"))
+	dropped, err = droppedDb.Exist(tenantID, "ch1")
+	require.NoError(t, err)
+	assert.False(t, dropped)
+}
@@ -33,6 +33,7 @@ const (
 	segmentID1    = typeutil.UniqueID(2001)
 	segmentID2    = typeutil.UniqueID(2002)
 	partitionID1  = typeutil.UniqueID(3001)
+	partitionID2  = typeutil.UniqueID(3002)
 	indexBuildID1 = typeutil.UniqueID(5001)
 	NumRows       = 1025
 )
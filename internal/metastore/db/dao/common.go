@@ -60,3 +60,31 @@ func (d *metaDomain) GrantDb(ctx context.Context) dbmodel.IGrantDb {
 func (d *metaDomain) GrantIDDb(ctx context.Context) dbmodel.IGrantIDDb {
 	return &grantIDDb{dbcore.GetDB(ctx)}
 }
+
+func (d *metaDomain) SegmentDb(ctx context.Context) dbmodel.ISegmentDb {
+	return &segmentDb{dbcore.GetDB(ctx)}
+}
+
+func (d *metaDomain) SegmentBinlogDb(ctx context.Context) dbmodel.ISegmentBinlogDb {
+	return &segmentBinlogDb{dbcore.GetDB(ctx)}
+}
+
+func (d *metaDomain) ChannelCheckpointDb(ctx context.Context) dbmodel.IChannelCheckpointDb {
+	return &channelCheckpointDb{dbcore.GetDB(ctx)}
+}
+
+func (d *metaDomain) DroppedChannelDb(ctx context.Context) dbmodel.IDroppedChannelDb {
+	return &droppedChannelDb{dbcore.GetDB(ctx)}
+}
+
+func (d *metaDomain) CollectionLoadInfoDb(ctx context.Context) dbmodel.ICollectionLoadInfoDb {
+	return &collectionLoadInfoDb{dbcore.GetDB(ctx)}
+}
+
+func (d *metaDomain) PartitionLoadInfoDb(ctx context.Context) dbmodel.IPartitionLoadInfoDb {
+	return &partitionLoadInfoDb{dbcore.GetDB(ctx)}
+}
+
+func (d *metaDomain) ReplicaDb(ctx context.Context) dbmodel.IReplicaDb {
+	return &replicaDb{dbcore.GetDB(ctx)}
+}
@@ -0,0 +1,98 @@
+package dao
+
+import (
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/metastore/db/dbmodel"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
+)
+
+type collectionLoadInfoDb struct {
+	db *gorm.DB
+}
+
+func (s *collectionLoadInfoDb) List(tenantID string) ([]*dbmodel.CollectionLoadInfo, error) {
+	var r []*dbmodel.CollectionLoadInfo
+
+	err := s.db.Model(&dbmodel.CollectionLoadInfo{}).Where("tenant_id = ?", tenantID).Find(&r).Error
+	if err != nil {
+		log.Error("list collection load infos failed", zap.String("tenant", tenantID), zap.Error(err))
+		return nil, err
+	}
+
+	return r, nil
+}
+
+func (s *collectionLoadInfoDb) Upsert(in *dbmodel.CollectionLoadInfo) error {
+	err := s.db.Clauses(clause.OnConflict{
+		// constraint UNIQUE (tenant_id, collection_id)
+		Columns:   []clause.Column{{Name: "tenant_id"}, {Name: "collection_id"}},
+		UpdateAll: true,
+	}).Create(in).Error
+	if err != nil {
+		log.Error("upsert collection load info failed", zap.String("tenant", in.TenantID), zap.Int64("collID", in.CollectionID), zap.Error(err))
+		return err
+	}
+
+	return nil
+}
+
+func (s *collectionLoadInfoDb) Delete(tenantID string, collectionID typeutil.UniqueID) error {
+	err := s.db.Where("tenant_id = ? AND collection_id = ?", tenantID, collectionID).Delete(&dbmodel.CollectionLoadInfo{}).Error
+	if err != nil {
+		log.Error("delete collection load info failed", zap.String("tenant", tenantID), zap.Int64("collID", collectionID), zap.Error(err))
+		return err
+	}
+
+	return nil
+}
+
+type partitionLoadInfoDb struct {
+	db *gorm.DB
+}
+
+func (s *partitionLoadInfoDb) List(tenantID string) ([]*dbmodel.PartitionLoadInfo, error) {
+	var r []*dbmodel.PartitionLoadInfo
+
+	err := s.db.Model(&dbmodel.PartitionLoadInfo{}).Where("tenant_id = ?", tenantID).Find(&r).Error
+	if err != nil {
+		log.Error("list partition load infos failed", zap.String("tenant", tenantID), zap.Error(err))
+		return nil, err
+	}
+
+	return r, nil
+}
+
+func (s *partitionLoadInfoDb) Upsert(in []*dbmodel.PartitionLoadInfo) error {
+	if len(in) == 0 {
+		return nil
+	}
+	err := s.db.Clauses(clause.OnConflict{
+		// constraint UNIQUE (tenant_id, collection_id, partition_id)
+		Columns:   []clause.Column{{Name: "tenant_id"}, {Name: "collection_id"}, {Name: "partition_id"}},
+		UpdateAll: true,
+	}).CreateInBatches(in, 100).Error
+	if err != nil {
+		log.Error("upsert partition load infos failed", zap.Error(err))
+		return err
+	}
+
+	return nil
+}
+
+func (s *partitionLoadInfoDb) Delete(tenantID string, collectionID typeutil.UniqueID, partitionIDs []typeutil.UniqueID) error {
+	if len(partitionIDs) == 0 {
+		return nil
+	}
+	err := s.db.Where("tenant_id = ? AND collection_id = ? AND partition_id IN ?", tenantID, collectionID, partitionIDs).Delete(&dbmodel.PartitionLoadInfo{}).Error
+	if err != nil {
+		log.Error("delete partition load infos failed", zap.String("tenant", tenantID), zap.Int64("collID", collectionID),
+			zap.Int64s("partitionIDs", partitionIDs), zap.Error(err))
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,55 @@
+package dao
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/milvus-io/milvus/internal/metastore/db/dbmodel"
+)
+
+func TestCollectionLoadInfo_Sqlite(t *testing.T) {
+	infoDb := &collectionLoadInfoDb{newSqliteTestDB(t)}
+
+	require.NoError(t, infoDb.Upsert(&dbmodel.CollectionLoadInfo{TenantID: tenantID, CollectionID: collID1, ReplicaNumber: 1, Status: 1}))
+	require.NoError(t, infoDb.Upsert(&dbmodel.CollectionLoadInfo{TenantID: tenantID, CollectionID: collID1, ReplicaNumber: 2, Status: 2}))
+
+	res, err := infoDb.List(tenantID)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(res))
+	assert.Equal(t, int32(2), res[0].ReplicaNumber)
+	assert.Equal(t, int32(2), res[0].Status)
+
+	require.NoError(t, infoDb.Delete(tenantID, collID1))
+	res, err = infoDb.List(tenantID)
+	require.NoError(t, err)
+	assert.Equal(t, 0, len(res))
+}
+
+func TestPartitionLoadInfo_Sqlite(t *testing.T) {
+	infoDb := &partitionLoadInfoDb{newSqliteTestDB(t)}
+
+	require.NoError(t, infoDb.Upsert([]*dbmodel.PartitionLoadInfo{
+		{TenantID: tenantID, CollectionID: collID1, PartitionID: partitionID1, Status: 1},
+		{TenantID: tenantID, CollectionID: collID1, PartitionID: partitionID2, Status: 1},
+	}))
+	require.NoError(t, infoDb.Upsert([]*dbmodel.PartitionLoadInfo{
+		{TenantID: tenantID, CollectionID: collID1, PartitionID: partitionID1, Status: 2},
+	}))
+
+	res, err := infoDb.List(tenantID)
+	require.NoError(t, err)
+	require.Equal(t, 2, len(res))
+	for _, info := range res {
+		if info.PartitionID == partitionID1 {
+			assert.Equal(t, int32(2), info.Status)
+		}
+	}
+
+	require.NoError(t, infoDb.Delete(tenantID, collID1, []int64{partitionID1}))
+	res, err = infoDb.List(tenantID)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(res))
+	assert.Equal(t, partitionID2, res[0].PartitionID)
+}
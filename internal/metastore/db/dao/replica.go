@@ -0,0 +1,63 @@
+package dao
+
+import (
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/metastore/db/dbmodel"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
+)
+
+type replicaDb struct {
+	db *gorm.DB
+}
+
+func (s *replicaDb) List(tenantID string) ([]*dbmodel.Replica, error) {
+	var r []*dbmodel.Replica
+
+	err := s.db.Model(&dbmodel.Replica{}).Where("tenant_id = ?", tenantID).Find(&r).Error
+	if err != nil {
+		log.Error("list replicas failed", zap.String("tenant", tenantID), zap.Error(err))
+		return nil, err
+	}
+
+	return r, nil
+}
+
+func (s *replicaDb) Upsert(in *dbmodel.Replica) error {
+	err := s.db.Clauses(clause.OnConflict{
+		// constraint UNIQUE (tenant_id, collection_id, replica_id)
+		Columns:   []clause.Column{{Name: "tenant_id"}, {Name: "collection_id"}, {Name: "replica_id"}},
+		UpdateAll: true,
+	}).Create(in).Error
+	if err != nil {
+		log.Error("upsert replica failed", zap.String("tenant", in.TenantID), zap.Int64("collID", in.CollectionID),
+			zap.Int64("replicaID", in.ReplicaID), zap.Error(err))
+		return err
+	}
+
+	return nil
+}
+
+func (s *replicaDb) DeleteByCollectionID(tenantID string, collectionID typeutil.UniqueID) error {
+	err := s.db.Where("tenant_id = ? AND collection_id = ?", tenantID, collectionID).Delete(&dbmodel.Replica{}).Error
+	if err != nil {
+		log.Error("delete replicas by collection id failed", zap.String("tenant", tenantID), zap.Int64("collID", collectionID), zap.Error(err))
+		return err
+	}
+
+	return nil
+}
+
+func (s *replicaDb) Delete(tenantID string, collectionID typeutil.UniqueID, replicaID typeutil.UniqueID) error {
+	err := s.db.Where("tenant_id = ? AND collection_id = ? AND replica_id = ?", tenantID, collectionID, replicaID).Delete(&dbmodel.Replica{}).Error
+	if err != nil {
+		log.Error("delete replica failed", zap.String("tenant", tenantID), zap.Int64("collID", collectionID),
+			zap.Int64("replicaID", replicaID), zap.Error(err))
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,35 @@
+package dao
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/milvus-io/milvus/internal/metastore/db/dbmodel"
+)
+
+func TestReplica_Sqlite(t *testing.T) {
+	replicaDb := &replicaDb{newSqliteTestDB(t)}
+
+	require.NoError(t, replicaDb.Upsert(&dbmodel.Replica{TenantID: tenantID, CollectionID: collID1, ReplicaID: 1, Nodes: "[1]"}))
+	require.NoError(t, replicaDb.Upsert(&dbmodel.Replica{TenantID: tenantID, CollectionID: collID1, ReplicaID: 1, Nodes: "[1,2]"}))
+	require.NoError(t, replicaDb.Upsert(&dbmodel.Replica{TenantID: tenantID, CollectionID: collID1, ReplicaID: 2, Nodes: "[3]"}))
+	require.NoError(t, replicaDb.Upsert(&dbmodel.Replica{TenantID: tenantID, CollectionID: collID2, ReplicaID: 3, Nodes: "[4]"}))
+
+	res, err := replicaDb.List(tenantID)
+	require.NoError(t, err)
+	require.Equal(t, 3, len(res))
+	assert.Equal(t, "[1,2]", res[0].Nodes)
+
+	require.NoError(t, replicaDb.Delete(tenantID, collID1, 2))
+	res, err = replicaDb.List(tenantID)
+	require.NoError(t, err)
+	assert.Equal(t, 2, len(res))
+
+	require.NoError(t, replicaDb.DeleteByCollectionID(tenantID, collID1))
+	res, err = replicaDb.List(tenantID)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(res))
+	assert.Equal(t, collID2, res[0].CollectionID)
+}
@@ -0,0 +1,57 @@
+package dao
+
+import (
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/metastore/db/dbmodel"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
+)
+
+type segmentDb struct {
+	db *gorm.DB
+}
+
+func (s *segmentDb) List(tenantID string) ([]*dbmodel.Segment, error) {
+	var r []*dbmodel.Segment
+
+	err := s.db.Model(&dbmodel.Segment{}).Where("tenant_id = ?", tenantID).Order("segment_id").Find(&r).Error
+	if err != nil {
+		log.Error("list segments failed", zap.String("tenant", tenantID), zap.Error(err))
+		return nil, err
+	}
+
+	return r, nil
+}
+
+func (s *segmentDb) Upsert(in []*dbmodel.Segment) error {
+	if len(in) == 0 {
+		return nil
+	}
+	err := s.db.Clauses(clause.OnConflict{
+		// constraint UNIQUE (tenant_id, segment_id)
+		Columns:   []clause.Column{{Name: "tenant_id"}, {Name: "segment_id"}},
+		UpdateAll: true,
+	}).CreateInBatches(in, 100).Error
+	if err != nil {
+		log.Error("upsert segments failed", zap.Error(err))
+		return err
+	}
+
+	return nil
+}
+
+func (s *segmentDb) Delete(tenantID string, segmentIDs []typeutil.UniqueID) error {
+	if len(segmentIDs) == 0 {
+		return nil
+	}
+	err := s.db.Where("tenant_id = ? AND segment_id IN ?", tenantID, segmentIDs).Delete(&dbmodel.Segment{}).Error
+	if err != nil {
+		log.Error("delete segments failed", zap.String("tenant", tenantID), zap.Int64s("segmentIDs", segmentIDs), zap.Error(err))
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,57 @@
+package dao
+
+import (
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/metastore/db/dbmodel"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
+)
+
+type segmentBinlogDb struct {
+	db *gorm.DB
+}
+
+func (s *segmentBinlogDb) List(tenantID string) ([]*dbmodel.SegmentBinlog, error) {
+	var r []*dbmodel.SegmentBinlog
+
+	err := s.db.Model(&dbmodel.SegmentBinlog{}).Where("tenant_id = ?", tenantID).Order("segment_id, binlog_type, field_id").Find(&r).Error
+	if err != nil {
+		log.Error("list segment binlogs failed", zap.String("tenant", tenantID), zap.Error(err))
+		return nil, err
+	}
+
+	return r, nil
+}
+
+func (s *segmentBinlogDb) Upsert(in []*dbmodel.SegmentBinlog) error {
+	if len(in) == 0 {
+		return nil
+	}
+	err := s.db.Clauses(clause.OnConflict{
+		// constraint UNIQUE (tenant_id, segment_id, field_id, binlog_type)
+		Columns:   []clause.Column{{Name: "tenant_id"}, {Name: "segment_id"}, {Name: "field_id"}, {Name: "binlog_type"}},
+		UpdateAll: true,
+	}).CreateInBatches(in, 100).Error
+	if err != nil {
+		log.Error("upsert segment binlogs failed", zap.Error(err))
+		return err
+	}
+
+	return nil
+}
+
+func (s *segmentBinlogDb) DeleteBySegmentIDs(tenantID string, segmentIDs []typeutil.UniqueID) error {
+	if len(segmentIDs) == 0 {
+		return nil
+	}
+	err := s.db.Where("tenant_id = ? AND segment_id IN ?", tenantID, segmentIDs).Delete(&dbmodel.SegmentBinlog{}).Error
+	if err != nil {
+		log.Error("delete segment binlogs failed", zap.String("tenant", tenantID), zap.Int64s("segmentIDs", segmentIDs), zap.Error(err))
+		return err
+	}
+
+	return nil
+}
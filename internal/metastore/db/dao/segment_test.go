@@ -0,0 +1,82 @@
+package dao
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+
+	"github.com/milvus-io/milvus/internal/metastore/db/dbcore"
+	"github.com/milvus-io/milvus/internal/metastore/db/dbmodel"
+)
+
+func newSqliteTestDB(t *testing.T) *gorm.DB {
+	db, err := dbcore.OpenSqlite(":memory:")
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		idb, err := db.DB()
+		if err == nil {
+			idb.Close()
+		}
+	})
+	return db
+}
+
+func TestSegment_Sqlite(t *testing.T) {
+	segDb := &segmentDb{newSqliteTestDB(t)}
+
+	segs := []*dbmodel.Segment{
+		{TenantID: tenantID, SegmentID: segmentID1, CollectionID: collID1, PartitionID: partitionID1, NumOfRows: NumRows, State: 1},
+		{TenantID: tenantID, SegmentID: segmentID2, CollectionID: collID1, PartitionID: partitionID1, NumOfRows: NumRows, State: 1},
+		{TenantID: "other", SegmentID: segmentID1, CollectionID: collID1, PartitionID: partitionID1},
+	}
+	require.NoError(t, segDb.Upsert(segs))
+
+	// upsert updates the existing row
+	require.NoError(t, segDb.Upsert([]*dbmodel.Segment{
+		{TenantID: tenantID, SegmentID: segmentID1, CollectionID: collID1, PartitionID: partitionID1, NumOfRows: 2 * NumRows, State: 3},
+	}))
+	res, err := segDb.List(tenantID)
+	require.NoError(t, err)
+	require.Equal(t, 2, len(res))
+	assert.Equal(t, segmentID1, res[0].SegmentID)
+	assert.Equal(t, int64(2*NumRows), res[0].NumOfRows)
+	assert.Equal(t, int32(3), res[0].State)
+	assert.Equal(t, segmentID2, res[1].SegmentID)
+
+	require.NoError(t, segDb.Delete(tenantID, []int64{segmentID1}))
+	res, err = segDb.List(tenantID)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(res))
+	assert.Equal(t, segmentID2, res[0].SegmentID)
+	res, err = segDb.List("other")
+	require.NoError(t, err)
+	assert.Equal(t, 1, len(res))
+}
+
+func TestSegmentBinlog_Sqlite(t *testing.T) {
+	binlogDb := &segmentBinlogDb{newSqliteTestDB(t)}
+
+	binlogs := []*dbmodel.SegmentBinlog{
+		{TenantID: tenantID, SegmentID: segmentID1, FieldID: fieldID1, BinlogType: dbmodel.InsertBinlog, Binlogs: "[]"},
+		{TenantID: tenantID, SegmentID: segmentID1, FieldID: fieldID1, BinlogType: dbmodel.StatsBinlog, Binlogs: "[]"},
+		{TenantID: tenantID, SegmentID: segmentID2, FieldID: fieldID1, BinlogType: dbmodel.InsertBinlog, Binlogs: "[]"},
+	}
+	require.NoError(t, binlogDb.Upsert(binlogs))
+	require.NoError(t, binlogDb.Upsert([]*dbmodel.SegmentBinlog{
+		{TenantID: tenantID, SegmentID: segmentID1, FieldID: fieldID1, BinlogType: dbmodel.InsertBinlog, Binlogs: `[{"log_id":1}]`},
+	}))
+
+	res, err := binlogDb.List(tenantID)
+	require.NoError(t, err)
+	require.Equal(t, 3, len(res))
+	assert.Equal(t, `[{"log_id":1}]`, res[0].Binlogs)
+	assert.Equal(t, dbmodel.StatsBinlog, res[1].BinlogType)
+
+	require.NoError(t, binlogDb.DeleteBySegmentIDs(tenantID, []int64{segmentID1}))
+	res, err = binlogDb.List(tenantID)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(res))
+	assert.Equal(t, segmentID2, res[0].SegmentID)
+}
@@ -0,0 +1,250 @@
+package datacoord
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/golang/protobuf/proto"
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+	"github.com/milvus-io/milvus/internal/kv"
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/metastore/db/dbmodel"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/internal/util"
+	"github.com/milvus-io/milvus/internal/util/contextutil"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
+)
+
+type Catalog struct {
+	metaDomain dbmodel.IMetaDomain
+	txImpl     dbmodel.ITransaction
+	// IndexCoord watches flushed segments in etcd, so they are still written to the kv after the table is updated
+	flushedSegmentKV kv.TxnKV
+}
+
+func NewTableCatalog(txImpl dbmodel.ITransaction, metaDomain dbmodel.IMetaDomain, flushedSegmentKV kv.TxnKV) *Catalog {
+	return &Catalog{
+		txImpl:           txImpl,
+		metaDomain:       metaDomain,
+		flushedSegmentKV: flushedSegmentKV,
+	}
+}
+
+func (tc *Catalog) ListSegments(ctx context.Context) ([]*datapb.SegmentInfo, error) {
+	tenantID := contextutil.TenantID(ctx)
+
+	segments, err := tc.metaDomain.SegmentDb(ctx).List(tenantID)
+	if err != nil {
+		return nil, err
+	}
+	binlogs, err := tc.metaDomain.SegmentBinlogDb(ctx).List(tenantID)
+	if err != nil {
+		return nil, err
+	}
+	binlogsBySegment := make(map[typeutil.UniqueID][]*dbmodel.SegmentBinlog)
+	for _, binlog := range binlogs {
+		binlogsBySegment[binlog.SegmentID] = append(binlogsBySegment[binlog.SegmentID], binlog)
+	}
+
+	result := make([]*datapb.SegmentInfo, 0, len(segments))
+	for _, seg := range segments {
+		segment, err := dbmodel.UnmarshalSegmentModel(seg)
+		if err != nil {
+			return nil, err
+		}
+		if err := dbmodel.FillSegmentBinlogs(segment, binlogsBySegment[seg.SegmentID]); err != nil {
+			return nil, err
+		}
+		result = append(result, segment)
+	}
+	return result, nil
+}
+
+func (tc *Catalog) AddSegment(ctx context.Context, segment *datapb.SegmentInfo) error {
+	return tc.upsertSegments(ctx, []*datapb.SegmentInfo{segment}, true)
+}
+
+func (tc *Catalog) AlterSegments(ctx context.Context, newSegments []*datapb.SegmentInfo) error {
+	return tc.upsertSegments(ctx, newSegments, true)
+}
+
+func (tc *Catalog) AlterSegment(ctx context.Context, newSegment *datapb.SegmentInfo, oldSegment *datapb.SegmentInfo) error {
+	if err := tc.upsertSegments(ctx, []*datapb.SegmentInfo{newSegment}, true); err != nil {
+		return err
+	}
+	if newSegment.GetState() == commonpb.SegmentState_Flushed && oldSegment.GetState() != commonpb.SegmentState_Flushed {
+		return tc.notifyFlushedSegment(newSegment, &datapb.SegmentInfo{ID: newSegment.GetID()})
+	}
+	return nil
+}
+
+func (tc *Catalog) AlterSegmentsAndAddNewSegment(ctx context.Context, segments []*datapb.SegmentInfo, newSegment *datapb.SegmentInfo) error {
+	toSave := segments
+	// a new segment without rows is a faked one, it's only notified to IndexCoord
+	if newSegment != nil && newSegment.GetNumOfRows() > 0 {
+		toSave = append(append([]*datapb.SegmentInfo{}, segments...), newSegment)
+	}
+	if err := tc.upsertSegments(ctx, toSave, true); err != nil {
+		return err
+	}
+
+	if newSegment != nil && newSegment.GetNumOfRows() <= 0 {
+		fakeSegment := proto.Clone(newSegment).(*datapb.SegmentInfo)
+		fakeSegment.IsFake = true
+		return tc.notifyFlushedSegment(newSegment, fakeSegment)
+	}
+	return nil
+}
+
+// RevertAlterSegmentsAndAddNewSegment reverts the metastore operation of AlterSegmentsAndAddNewSegment
+func (tc *Catalog) RevertAlterSegmentsAndAddNewSegment(ctx context.Context, oldSegments []*datapb.SegmentInfo, removeSegment *datapb.SegmentInfo) error {
+	tenantID := contextutil.TenantID(ctx)
+
+	return tc.txImpl.Transaction(ctx, func(txCtx context.Context) error {
+		if err := tc.saveSegments(txCtx, tenantID, oldSegments, true); err != nil {
+			return err
+		}
+		if removeSegment != nil {
+			return tc.deleteSegments(txCtx, tenantID, []typeutil.UniqueID{removeSegment.GetID()})
+		}
+		return nil
+	})
+}
+
+func (tc *Catalog) SaveDroppedSegmentsInBatch(ctx context.Context, segments []*datapb.SegmentInfo) error {
+	return tc.upsertSegments(ctx, segments, false)
+}
+
+func (tc *Catalog) DropSegment(ctx context.Context, segment *datapb.SegmentInfo) error {
+	tenantID := contextutil.TenantID(ctx)
+
+	return tc.txImpl.Transaction(ctx, func(txCtx context.Context) error {
+		return tc.deleteSegments(txCtx, tenantID, []typeutil.UniqueID{segment.GetID()})
+	})
+}
+
+func (tc *Catalog) MarkChannelDeleted(ctx context.Context, channel string) error {
+	tenantID := contextutil.TenantID(ctx)
+
+	err := tc.metaDomain.DroppedChannelDb(ctx).Insert(&dbmodel.DroppedChannel{
+		TenantID: tenantID,
+		Channel:  channel,
+	})
+	if err != nil {
+		log.Error("Failed to mark channel dropped", zap.String("channel", channel), zap.Error(err))
+		return err
+	}
+
+	return nil
+}
+
+func (tc *Catalog) IsChannelDropped(ctx context.Context, channel string) bool {
+	tenantID := contextutil.TenantID(ctx)
+
+	dropped, err := tc.metaDomain.DroppedChannelDb(ctx).Exist(tenantID, channel)
+	if err != nil {
+		return false
+	}
+	return dropped
+}
+
+// DropChannel removes channel remove flag after whole procedure is finished
+func (tc *Catalog) DropChannel(ctx context.Context, channel string) error {
+	tenantID := contextutil.TenantID(ctx)
+
+	return tc.metaDomain.DroppedChannelDb(ctx).Delete(tenantID, channel)
+}
+
+func (tc *Catalog) ListChannelCheckpoint(ctx context.Context) (map[string]*internalpb.MsgPosition, error) {
+	tenantID := contextutil.TenantID(ctx)
+
+	cps, err := tc.metaDomain.ChannelCheckpointDb(ctx).List(tenantID)
+	if err != nil {
+		return nil, err
+	}
+
+	channelCPs := make(map[string]*internalpb.MsgPosition, len(cps))
+	for _, cp := range cps {
+		channelCPs[cp.VChannel] = dbmodel.UnmarshalChannelCheckpointModel(cp)
+	}
+	return channelCPs, nil
+}
+
+func (tc *Catalog) SaveChannelCheckpoint(ctx context.Context, vChannel string, pos *internalpb.MsgPosition) error {
+	tenantID := contextutil.TenantID(ctx)
+
+	return tc.metaDomain.ChannelCheckpointDb(ctx).Upsert(dbmodel.MarshalChannelCheckpointModel(tenantID, vChannel, pos))
+}
+
+func (tc *Catalog) DropChannelCheckpoint(ctx context.Context, vChannel string) error {
+	tenantID := contextutil.TenantID(ctx)
+
+	return tc.metaDomain.ChannelCheckpointDb(ctx).Delete(tenantID, vChannel)
+}
+
+func (tc *Catalog) upsertSegments(ctx context.Context, segments []*datapb.SegmentInfo, withBinlogs bool) error {
+	if len(segments) == 0 {
+		return nil
+	}
+	tenantID := contextutil.TenantID(ctx)
+
+	return tc.txImpl.Transaction(ctx, func(txCtx context.Context) error {
+		return tc.saveSegments(txCtx, tenantID, segments, withBinlogs)
+	})
+}
+
+func (tc *Catalog) saveSegments(ctx context.Context, tenantID string, segments []*datapb.SegmentInfo, withBinlogs bool) error {
+	segs := make([]*dbmodel.Segment, 0, len(segments))
+	var binlogs []*dbmodel.SegmentBinlog
+	for _, segment := range segments {
+		seg, err := dbmodel.MarshalSegmentModel(tenantID, segment)
+		if err != nil {
+			return err
+		}
+		segs = append(segs, seg)
+
+		if withBinlogs {
+			segBinlogs, err := dbmodel.MarshalSegmentBinlogModel(tenantID, segment)
+			if err != nil {
+				return err
+			}
+			binlogs = append(binlogs, segBinlogs...)
+		}
+	}
+
+	if err := tc.metaDomain.SegmentDb(ctx).Upsert(segs); err != nil {
+		return err
+	}
+	return tc.metaDomain.SegmentBinlogDb(ctx).Upsert(binlogs)
+}
+
+func (tc *Catalog) deleteSegments(ctx context.Context, tenantID string, segmentIDs []typeutil.UniqueID) error {
+	if err := tc.metaDomain.SegmentDb(ctx).Delete(tenantID, segmentIDs); err != nil {
+		return err
+	}
+	return tc.metaDomain.SegmentBinlogDb(ctx).DeleteBySegmentIDs(tenantID, segmentIDs)
+}
+
+func (tc *Catalog) notifyFlushedSegment(segment *datapb.SegmentInfo, value *datapb.SegmentInfo) error {
+	if tc.flushedSegmentKV == nil {
+		return nil
+	}
+	segBytes, err := proto.Marshal(value)
+	if err != nil {
+		return fmt.Errorf("failed to marshal segment: %d, err: %w", segment.GetID(), err)
+	}
+	key := buildFlushedSegmentPath(segment.GetCollectionID(), segment.GetPartitionID(), segment.GetID())
+	if err := tc.flushedSegmentKV.Save(key, string(segBytes)); err != nil {
+		log.Error("failed to save flushed segment", zap.Int64("segmentID", segment.GetID()), zap.Error(err))
+		return err
+	}
+	return nil
+}
+
+// buildFlushedSegmentPath common logic mapping segment info to corresponding key of IndexCoord in kv store
+func buildFlushedSegmentPath(collectionID typeutil.UniqueID, partitionID typeutil.UniqueID, segmentID typeutil.UniqueID) string {
+	return fmt.Sprintf("%s/%d/%d/%d", util.FlushedSegmentPrefix, collectionID, partitionID, segmentID)
+}
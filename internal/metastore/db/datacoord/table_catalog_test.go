@@ -0,0 +1,162 @@
+package datacoord
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+	memkv "github.com/milvus-io/milvus/internal/kv/mem"
+	"github.com/milvus-io/milvus/internal/metastore"
+	"github.com/milvus-io/milvus/internal/metastore/db/dao"
+	"github.com/milvus-io/milvus/internal/metastore/db/dbcore"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
+)
+
+var _ metastore.DataCoordCatalog = (*Catalog)(nil)
+
+func newTestCatalog(t *testing.T) (*Catalog, *memkv.MemoryKV) {
+	db, err := dbcore.OpenSqlite(":memory:")
+	require.NoError(t, err)
+	dbcore.SetGlobalDB(db)
+	t.Cleanup(func() {
+		idb, err := db.DB()
+		if err == nil {
+			idb.Close()
+		}
+	})
+	kv := memkv.NewMemoryKV()
+	return NewTableCatalog(dbcore.NewTxImpl(), dao.NewMetaDomain(), kv), kv
+}
+
+func newTestSegment(id int64, state commonpb.SegmentState) *datapb.SegmentInfo {
+	return &datapb.SegmentInfo{
+		ID:            id,
+		CollectionID:  1,
+		PartitionID:   2,
+		InsertChannel: "ch1",
+		NumOfRows:     100,
+		State:         state,
+		MaxRowNum:     1000,
+		StartPosition: &internalpb.MsgPosition{ChannelName: "ch1", MsgID: []byte{1, 2}, Timestamp: 10},
+		DmlPosition:   &internalpb.MsgPosition{ChannelName: "ch1", MsgID: []byte{3}, Timestamp: 20},
+		Binlogs: []*datapb.FieldBinlog{
+			{FieldID: 100, Binlogs: []*datapb.Binlog{{EntriesNum: 100, LogID: 1, LogPath: "insert_log/1/2/3/100/1", LogSize: 1024}}},
+			{FieldID: 101, Binlogs: []*datapb.Binlog{{EntriesNum: 100, LogID: 2, LogPath: "insert_log/1/2/3/101/2"}}},
+		},
+		Deltalogs: []*datapb.FieldBinlog{
+			{FieldID: 0, Binlogs: []*datapb.Binlog{{EntriesNum: 5, LogID: 3, LogPath: "delta_log/1/2/3/3"}}},
+		},
+		Statslogs: []*datapb.FieldBinlog{
+			{FieldID: 100, Binlogs: []*datapb.Binlog{{EntriesNum: 100, LogID: 4, LogPath: "stats_log/1/2/3/100/4"}}},
+		},
+		CompactionFrom: []int64{7, 8},
+	}
+}
+
+func TestCatalog_Segments(t *testing.T) {
+	ctx := context.Background()
+	catalog, kv := newTestCatalog(t)
+
+	segment := newTestSegment(3, commonpb.SegmentState_Growing)
+	require.NoError(t, catalog.AddSegment(ctx, segment))
+	segments, err := catalog.ListSegments(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(segments))
+	assert.True(t, proto.Equal(segment, segments[0]))
+
+	// flushing notifies IndexCoord
+	flushed := proto.Clone(segment).(*datapb.SegmentInfo)
+	flushed.State = commonpb.SegmentState_Flushed
+	flushed.Binlogs[0].Binlogs = append(flushed.Binlogs[0].Binlogs, &datapb.Binlog{LogID: 5, LogPath: "insert_log/1/2/3/100/5"})
+	require.NoError(t, catalog.AlterSegment(ctx, flushed, segment))
+	segments, err = catalog.ListSegments(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(segments))
+	assert.True(t, proto.Equal(flushed, segments[0]))
+	_, err = kv.Load(buildFlushedSegmentPath(1, 2, 3))
+	assert.NoError(t, err)
+
+	// compaction
+	other := newTestSegment(4, commonpb.SegmentState_Flushed)
+	require.NoError(t, catalog.AlterSegments(ctx, []*datapb.SegmentInfo{other}))
+	compactTo := newTestSegment(5, commonpb.SegmentState_Flushed)
+	compactFrom := []*datapb.SegmentInfo{proto.Clone(flushed).(*datapb.SegmentInfo), proto.Clone(other).(*datapb.SegmentInfo)}
+	for _, s := range compactFrom {
+		s.State = commonpb.SegmentState_Dropped
+	}
+	require.NoError(t, catalog.AlterSegmentsAndAddNewSegment(ctx, compactFrom, compactTo))
+	segments, err = catalog.ListSegments(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 3, len(segments))
+	assert.Equal(t, commonpb.SegmentState_Dropped, segments[0].GetState())
+	assert.Equal(t, commonpb.SegmentState_Dropped, segments[1].GetState())
+	assert.True(t, proto.Equal(compactTo, segments[2]))
+
+	require.NoError(t, catalog.RevertAlterSegmentsAndAddNewSegment(ctx, []*datapb.SegmentInfo{flushed, other}, compactTo))
+	segments, err = catalog.ListSegments(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 2, len(segments))
+	assert.True(t, proto.Equal(flushed, segments[0]))
+	assert.True(t, proto.Equal(other, segments[1]))
+
+	// a compaction to an empty segment only notifies IndexCoord
+	fake := newTestSegment(6, commonpb.SegmentState_Flushed)
+	fake.NumOfRows = 0
+	require.NoError(t, catalog.AlterSegmentsAndAddNewSegment(ctx, nil, fake))
+	value, err := kv.Load(buildFlushedSegmentPath(1, 2, 6))
+	require.NoError(t, err)
+	fakeInfo := &datapb.SegmentInfo{}
+	require.NoError(t, proto.Unmarshal([]byte(value), fakeInfo))
+	assert.True(t, fakeInfo.GetIsFake())
+	segments, err = catalog.ListSegments(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 2, len(segments))
+
+	// saving dropped segments keeps the binlogs for GC
+	dropped := proto.Clone(other).(*datapb.SegmentInfo)
+	dropped.State = commonpb.SegmentState_Dropped
+	dropped.Binlogs, dropped.Deltalogs, dropped.Statslogs = nil, nil, nil
+	require.NoError(t, catalog.SaveDroppedSegmentsInBatch(ctx, []*datapb.SegmentInfo{dropped}))
+	segments, err = catalog.ListSegments(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 2, len(segments))
+	assert.Equal(t, commonpb.SegmentState_Dropped, segments[1].GetState())
+	assert.Equal(t, other.GetBinlogs(), segments[1].GetBinlogs())
+
+	require.NoError(t, catalog.DropSegment(ctx, dropped))
+	segments, err = catalog.ListSegments(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(segments))
+	assert.Equal(t, int64(3), segments[0].GetID())
+}
+
+func TestCatalog_Channels(t *testing.T) {
+	ctx := context.Background()
+	catalog, _ := newTestCatalog(t)
+
+	assert.False(t, catalog.IsChannelDropped(ctx, "ch1"))
+	require.NoError(t, catalog.MarkChannelDeleted(ctx, "ch1"))
+	assert.True(t, catalog.IsChannelDropped(ctx, "ch1"))
+	require.NoError(t, catalog.DropChannel(ctx, "ch1"))
+	assert.False(t, catalog.IsChannelDropped(ctx, "ch1"))
+
+	pos := &internalpb.MsgPosition{ChannelName: "ch1", MsgID: []byte{1}, MsgGroup: "group", Timestamp: 100}
+	require.NoError(t, catalog.SaveChannelCheckpoint(ctx, "ch1", pos))
+	pos.Timestamp = 200
+	require.NoError(t, catalog.SaveChannelCheckpoint(ctx, "ch1", pos))
+	require.NoError(t, catalog.SaveChannelCheckpoint(ctx, "ch2", &internalpb.MsgPosition{ChannelName: "ch2", Timestamp: 10}))
+	cps, err := catalog.ListChannelCheckpoint(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 2, len(cps))
+	assert.True(t, proto.Equal(pos, cps["ch1"]))
+
+	require.NoError(t, catalog.DropChannelCheckpoint(ctx, "ch1"))
+	cps, err = catalog.ListChannelCheckpoint(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 1, len(cps))
+}
@@ -6,9 +6,12 @@ import (
 	"reflect"
 
 	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/metastore/db/dbmodel"
+	"github.com/milvus-io/milvus/internal/util"
 	"github.com/milvus-io/milvus/internal/util/paramtable"
 	"go.uber.org/zap"
 	"gorm.io/driver/mysql"
+	"gorm.io/driver/sqlite"
 	"gorm.io/gorm"
 	"gorm.io/gorm/logger"
 )
@@ -17,7 +20,44 @@ var (
 	globalDB *gorm.DB
 )
 
+// tables created on connecting to sqlite, tables of mysql are created by scripts/sql/meta.sql
+var sqliteTables = []interface{}{
+	&dbmodel.Collection{},
+	&dbmodel.CollectionAlias{},
+	&dbmodel.CollectionChannel{},
+	&dbmodel.Field{},
+	&dbmodel.Partition{},
+	&dbmodel.Index{},
+	&dbmodel.SegmentIndex{},
+	&dbmodel.User{},
+	&dbmodel.Role{},
+	&dbmodel.UserRole{},
+	&dbmodel.Grant{},
+	&dbmodel.GrantID{},
+	&dbmodel.Segment{},
+	&dbmodel.SegmentBinlog{},
+	&dbmodel.ChannelCheckpoint{},
+	&dbmodel.DroppedChannel{},
+	&dbmodel.CollectionLoadInfo{},
+	&dbmodel.PartitionLoadInfo{},
+	&dbmodel.Replica{},
+}
+
 func Connect(cfg *paramtable.MetaDBConfig) error {
+	if cfg.DriverName == util.MetaDBDriverSqlite {
+		db, err := OpenSqlite(cfg.DBName)
+		if err != nil {
+			log.Error("fail to open sqlite db", zap.String("path", cfg.DBName), zap.Error(err))
+			return err
+		}
+		globalDB = db
+		log.Info("sqlite db opened", zap.String("path", cfg.DBName))
+		return nil
+	}
+	if cfg.DriverName != util.MetaDBDriverMysql {
+		return fmt.Errorf("not supported meta db driver: %s", cfg.DriverName)
+	}
+
 	// load config
 	dsn := fmt.Sprintf("%s:%s@tcp(%s:%d)/%s?charset=utf8mb4&parseTime=True&loc=Local", cfg.Username, cfg.Password, cfg.Address, cfg.Port, cfg.DBName)
 
@@ -52,6 +92,31 @@ func Connect(cfg *paramtable.MetaDBConfig) error {
 	return nil
 }
 
+// OpenSqlite opens the sqlite database at path and creates the meta tables, ":memory:" opens an in-memory database.
+// Sqlite allows a single writer, so the connection pool is limited to one connection, which also keeps an
+// in-memory database alive.
+func OpenSqlite(path string) (*gorm.DB, error) {
+	db, err := gorm.Open(sqlite.Open(path), &gorm.Config{
+		Logger:          logger.Default.LogMode(logger.Silent),
+		CreateBatchSize: 100,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	idb, err := db.DB()
+	if err != nil {
+		return nil, err
+	}
+	idb.SetMaxOpenConns(1)
+
+	if err := db.AutoMigrate(sqliteTables...); err != nil {
+		idb.Close()
+		return nil, err
+	}
+	return db, nil
+}
+
 // SetGlobalDB Only for test
 func SetGlobalDB(db *gorm.DB) {
 	globalDB = db
@@ -0,0 +1,70 @@
+package dbmodel
+
+import (
+	"time"
+
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
+)
+
+type ChannelCheckpoint struct {
+	ID          int64     `gorm:"id"`
+	TenantID    string    `gorm:"tenant_id;uniqueIndex:idx_channel_checkpoints_tenant_vchannel"`
+	VChannel    string    `gorm:"column:vchannel;uniqueIndex:idx_channel_checkpoints_tenant_vchannel"`
+	ChannelName string    `gorm:"channel_name"`
+	MsgID       []byte    `gorm:"msg_id"`
+	MsgGroup    string    `gorm:"msg_group"`
+	Timestamp   uint64    `gorm:"timestamp"`
+	CreatedAt   time.Time `gorm:"created_at"`
+	UpdatedAt   time.Time `gorm:"updated_at"`
+}
+
+func (v ChannelCheckpoint) TableName() string {
+	return "channel_checkpoints"
+}
+
+//go:generate mockery --name=IChannelCheckpointDb
+type IChannelCheckpointDb interface {
+	List(tenantID string) ([]*ChannelCheckpoint, error)
+	Upsert(in *ChannelCheckpoint) error
+	Delete(tenantID string, vChannel string) error
+}
+
+func MarshalChannelCheckpointModel(tenantID string, vChannel string, pos *internalpb.MsgPosition) *ChannelCheckpoint {
+	return &ChannelCheckpoint{
+		TenantID:    tenantID,
+		VChannel:    vChannel,
+		ChannelName: pos.GetChannelName(),
+		MsgID:       pos.GetMsgID(),
+		MsgGroup:    pos.GetMsgGroup(),
+		Timestamp:   pos.GetTimestamp(),
+	}
+}
+
+func UnmarshalChannelCheckpointModel(cp *ChannelCheckpoint) *internalpb.MsgPosition {
+	return &internalpb.MsgPosition{
+		ChannelName: cp.ChannelName,
+		MsgID:       cp.MsgID,
+		MsgGroup:    cp.MsgGroup,
+		Timestamp:   cp.Timestamp,
+	}
+}
+
+// DroppedChannel is the flag of a vchannel which is dropped but whose segments are not yet cleaned
+type DroppedChannel struct {
+	ID        int64     `gorm:"id"`
+	TenantID  string    `gorm:"tenant_id;uniqueIndex:idx_dropped_channels_tenant_channel"`
+	Channel   string    `gorm:"channel;uniqueIndex:idx_dropped_channels_tenant_channel"`
+	CreatedAt time.Time `gorm:"created_at"`
+	UpdatedAt time.Time `gorm:"updated_at"`
+}
+
+func (v DroppedChannel) TableName() string {
+	return "dropped_channels"
+}
+
+//go:generate mockery --name=IDroppedChannelDb
+type IDroppedChannelDb interface {
+	Insert(in *DroppedChannel) error
+	Exist(tenantID string, channel string) (bool, error)
+	Delete(tenantID string, channel string) error
+}
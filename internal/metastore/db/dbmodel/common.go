@@ -16,6 +16,13 @@ type IMetaDomain interface {
 	UserRoleDb(ctx context.Context) IUserRoleDb
 	GrantDb(ctx context.Context) IGrantDb
 	GrantIDDb(ctx context.Context) IGrantIDDb
+	SegmentDb(ctx context.Context) ISegmentDb
+	SegmentBinlogDb(ctx context.Context) ISegmentBinlogDb
+	ChannelCheckpointDb(ctx context.Context) IChannelCheckpointDb
+	DroppedChannelDb(ctx context.Context) IDroppedChannelDb
+	CollectionLoadInfoDb(ctx context.Context) ICollectionLoadInfoDb
+	PartitionLoadInfoDb(ctx context.Context) IPartitionLoadInfoDb
+	ReplicaDb(ctx context.Context) IReplicaDb
 }
 
 type ITransaction interface {
@@ -0,0 +1,149 @@
+package dbmodel
+
+import (
+	"encoding/json"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/proto/querypb"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
+)
+
+type CollectionLoadInfo struct {
+	ID                 int64     `gorm:"id"`
+	TenantID           string    `gorm:"tenant_id;uniqueIndex:idx_collection_load_infos_tenant_collection"`
+	CollectionID       int64     `gorm:"collection_id;uniqueIndex:idx_collection_load_infos_tenant_collection"`
+	ReleasedPartitions string    `gorm:"released_partitions"`
+	ReplicaNumber      int32     `gorm:"replica_number"`
+	Status             int32     `gorm:"status"`
+	FieldIndexID       string    `gorm:"field_index_id"`
+	CreatedAt          time.Time `gorm:"created_at"`
+	UpdatedAt          time.Time `gorm:"updated_at"`
+}
+
+func (v CollectionLoadInfo) TableName() string {
+	return "collection_load_infos"
+}
+
+//go:generate mockery --name=ICollectionLoadInfoDb
+type ICollectionLoadInfoDb interface {
+	List(tenantID string) ([]*CollectionLoadInfo, error)
+	Upsert(in *CollectionLoadInfo) error
+	Delete(tenantID string, collectionID typeutil.UniqueID) error
+}
+
+type PartitionLoadInfo struct {
+	ID            int64     `gorm:"id"`
+	TenantID      string    `gorm:"tenant_id;uniqueIndex:idx_partition_load_infos_tenant_collection_partition"`
+	CollectionID  int64     `gorm:"collection_id;uniqueIndex:idx_partition_load_infos_tenant_collection_partition"`
+	PartitionID   int64     `gorm:"partition_id;uniqueIndex:idx_partition_load_infos_tenant_collection_partition"`
+	ReplicaNumber int32     `gorm:"replica_number"`
+	Status        int32     `gorm:"status"`
+	FieldIndexID  string    `gorm:"field_index_id"`
+	CreatedAt     time.Time `gorm:"created_at"`
+	UpdatedAt     time.Time `gorm:"updated_at"`
+}
+
+func (v PartitionLoadInfo) TableName() string {
+	return "partition_load_infos"
+}
+
+//go:generate mockery --name=IPartitionLoadInfoDb
+type IPartitionLoadInfoDb interface {
+	List(tenantID string) ([]*PartitionLoadInfo, error)
+	Upsert(in []*PartitionLoadInfo) error
+	Delete(tenantID string, collectionID typeutil.UniqueID, partitionIDs []typeutil.UniqueID) error
+}
+
+func MarshalCollectionLoadInfoModel(tenantID string, info *querypb.CollectionLoadInfo) (*CollectionLoadInfo, error) {
+	releasedPartitions, err := json.Marshal(info.GetReleasedPartitions())
+	if err != nil {
+		log.Error("marshal released partitions of collection load info error", zap.Int64("collID", info.GetCollectionID()), zap.Error(err))
+		return nil, err
+	}
+	fieldIndexID, err := json.Marshal(info.GetFieldIndexID())
+	if err != nil {
+		log.Error("marshal field index ids of collection load info error", zap.Int64("collID", info.GetCollectionID()), zap.Error(err))
+		return nil, err
+	}
+
+	return &CollectionLoadInfo{
+		TenantID:           tenantID,
+		CollectionID:       info.GetCollectionID(),
+		ReleasedPartitions: string(releasedPartitions),
+		ReplicaNumber:      info.GetReplicaNumber(),
+		Status:             int32(info.GetStatus()),
+		FieldIndexID:       string(fieldIndexID),
+	}, nil
+}
+
+func UnmarshalCollectionLoadInfoModel(info *CollectionLoadInfo) (*querypb.CollectionLoadInfo, error) {
+	var releasedPartitions []int64
+	if info.ReleasedPartitions != "" {
+		if err := json.Unmarshal([]byte(info.ReleasedPartitions), &releasedPartitions); err != nil {
+			log.Error("unmarshal released partitions of collection load info error", zap.Int64("collID", info.CollectionID), zap.Error(err))
+			return nil, err
+		}
+	}
+	fieldIndexID, err := unmarshalFieldIndexID(info.FieldIndexID)
+	if err != nil {
+		log.Error("unmarshal field index ids of collection load info error", zap.Int64("collID", info.CollectionID), zap.Error(err))
+		return nil, err
+	}
+
+	return &querypb.CollectionLoadInfo{
+		CollectionID:       info.CollectionID,
+		ReleasedPartitions: releasedPartitions,
+		ReplicaNumber:      info.ReplicaNumber,
+		Status:             querypb.LoadStatus(info.Status),
+		FieldIndexID:       fieldIndexID,
+	}, nil
+}
+
+func MarshalPartitionLoadInfoModel(tenantID string, info *querypb.PartitionLoadInfo) (*PartitionLoadInfo, error) {
+	fieldIndexID, err := json.Marshal(info.GetFieldIndexID())
+	if err != nil {
+		log.Error("marshal field index ids of partition load info error", zap.Int64("collID", info.GetCollectionID()),
+			zap.Int64("partitionID", info.GetPartitionID()), zap.Error(err))
+		return nil, err
+	}
+
+	return &PartitionLoadInfo{
+		TenantID:      tenantID,
+		CollectionID:  info.GetCollectionID(),
+		PartitionID:   info.GetPartitionID(),
+		ReplicaNumber: info.GetReplicaNumber(),
+		Status:        int32(info.GetStatus()),
+		FieldIndexID:  string(fieldIndexID),
+	}, nil
+}
+
+func UnmarshalPartitionLoadInfoModel(info *PartitionLoadInfo) (*querypb.PartitionLoadInfo, error) {
+	fieldIndexID, err := unmarshalFieldIndexID(info.FieldIndexID)
+	if err != nil {
+		log.Error("unmarshal field index ids of partition load info error", zap.Int64("collID", info.CollectionID),
+			zap.Int64("partitionID", info.PartitionID), zap.Error(err))
+		return nil, err
+	}
+
+	return &querypb.PartitionLoadInfo{
+		CollectionID:  info.CollectionID,
+		PartitionID:   info.PartitionID,
+		ReplicaNumber: info.ReplicaNumber,
+		Status:        querypb.LoadStatus(info.Status),
+		FieldIndexID:  fieldIndexID,
+	}, nil
+}
+
+func unmarshalFieldIndexID(fieldIndexIDStr string) (map[int64]int64, error) {
+	if fieldIndexIDStr == "" {
+		return nil, nil
+	}
+	var fieldIndexID map[int64]int64
+	if err := json.Unmarshal([]byte(fieldIndexIDStr), &fieldIndexID); err != nil {
+		return nil, err
+	}
+	return fieldIndexID, nil
+}
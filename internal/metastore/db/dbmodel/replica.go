@@ -0,0 +1,67 @@
+package dbmodel
+
+import (
+	"encoding/json"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/proto/querypb"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
+)
+
+type Replica struct {
+	ID           int64     `gorm:"id"`
+	TenantID     string    `gorm:"tenant_id;uniqueIndex:idx_replicas_tenant_collection_replica"`
+	CollectionID int64     `gorm:"collection_id;uniqueIndex:idx_replicas_tenant_collection_replica"`
+	ReplicaID    int64     `gorm:"replica_id;uniqueIndex:idx_replicas_tenant_collection_replica"`
+	Nodes        string    `gorm:"nodes"`
+	CreatedAt    time.Time `gorm:"created_at"`
+	UpdatedAt    time.Time `gorm:"updated_at"`
+}
+
+func (v Replica) TableName() string {
+	return "replicas"
+}
+
+//go:generate mockery --name=IReplicaDb
+type IReplicaDb interface {
+	List(tenantID string) ([]*Replica, error)
+	Upsert(in *Replica) error
+	DeleteByCollectionID(tenantID string, collectionID typeutil.UniqueID) error
+	Delete(tenantID string, collectionID typeutil.UniqueID, replicaID typeutil.UniqueID) error
+}
+
+func MarshalReplicaModel(tenantID string, replica *querypb.Replica) (*Replica, error) {
+	nodes, err := json.Marshal(replica.GetNodes())
+	if err != nil {
+		log.Error("marshal nodes of replica error", zap.Int64("collID", replica.GetCollectionID()),
+			zap.Int64("replicaID", replica.GetID()), zap.Error(err))
+		return nil, err
+	}
+
+	return &Replica{
+		TenantID:     tenantID,
+		CollectionID: replica.GetCollectionID(),
+		ReplicaID:    replica.GetID(),
+		Nodes:        string(nodes),
+	}, nil
+}
+
+func UnmarshalReplicaModel(replica *Replica) (*querypb.Replica, error) {
+	var nodes []int64
+	if replica.Nodes != "" {
+		if err := json.Unmarshal([]byte(replica.Nodes), &nodes); err != nil {
+			log.Error("unmarshal nodes of replica error", zap.Int64("collID", replica.CollectionID),
+				zap.Int64("replicaID", replica.ReplicaID), zap.Error(err))
+			return nil, err
+		}
+	}
+
+	return &querypb.Replica{
+		ID:           replica.ReplicaID,
+		CollectionID: replica.CollectionID,
+		Nodes:        nodes,
+	}, nil
+}
@@ -0,0 +1,146 @@
+package dbmodel
+
+import (
+	"encoding/json"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
+)
+
+// Segment is the row of a segment, binlogs of the segment are kept in SegmentBinlog
+type Segment struct {
+	ID                  int64     `gorm:"id"`
+	TenantID            string    `gorm:"tenant_id;uniqueIndex:idx_segments_tenant_segment"`
+	SegmentID           int64     `gorm:"segment_id;uniqueIndex:idx_segments_tenant_segment"`
+	CollectionID        int64     `gorm:"collection_id"`
+	PartitionID         int64     `gorm:"partition_id"`
+	InsertChannel       string    `gorm:"column:dm_channel"`
+	NumOfRows           int64     `gorm:"column:num_rows"`
+	State               int32     `gorm:"column:segment_state"`
+	MaxRowNum           int64     `gorm:"max_row_num"`
+	LastExpireTime      uint64    `gorm:"last_expire_time"`
+	StartPosition       string    `gorm:"start_position"`
+	DmlPosition         string    `gorm:"dml_position"`
+	CreatedByCompaction bool      `gorm:"created_by_compaction"`
+	CompactionFrom      string    `gorm:"compaction_from"`
+	DroppedAt           uint64    `gorm:"dropped_at"`
+	IsImporting         bool      `gorm:"is_importing"`
+	IsFake              bool      `gorm:"is_fake"`
+	CreatedAt           time.Time `gorm:"created_at"`
+	UpdatedAt           time.Time `gorm:"updated_at"`
+}
+
+func (v Segment) TableName() string {
+	return "segments"
+}
+
+//go:generate mockery --name=ISegmentDb
+type ISegmentDb interface {
+	List(tenantID string) ([]*Segment, error)
+	Upsert(in []*Segment) error
+	Delete(tenantID string, segmentIDs []typeutil.UniqueID) error
+}
+
+func MarshalSegmentModel(tenantID string, segment *datapb.SegmentInfo) (*Segment, error) {
+	startPosition, err := marshalPosition(segment.GetStartPosition())
+	if err != nil {
+		log.Error("marshal segment start position error", zap.Int64("segmentID", segment.GetID()), zap.Error(err))
+		return nil, err
+	}
+	dmlPosition, err := marshalPosition(segment.GetDmlPosition())
+	if err != nil {
+		log.Error("marshal segment dml position error", zap.Int64("segmentID", segment.GetID()), zap.Error(err))
+		return nil, err
+	}
+	compactionFrom, err := json.Marshal(segment.GetCompactionFrom())
+	if err != nil {
+		log.Error("marshal segment compaction from error", zap.Int64("segmentID", segment.GetID()), zap.Error(err))
+		return nil, err
+	}
+
+	return &Segment{
+		TenantID:            tenantID,
+		SegmentID:           segment.GetID(),
+		CollectionID:        segment.GetCollectionID(),
+		PartitionID:         segment.GetPartitionID(),
+		InsertChannel:       segment.GetInsertChannel(),
+		NumOfRows:           segment.GetNumOfRows(),
+		State:               int32(segment.GetState()),
+		MaxRowNum:           segment.GetMaxRowNum(),
+		LastExpireTime:      segment.GetLastExpireTime(),
+		StartPosition:       startPosition,
+		DmlPosition:         dmlPosition,
+		CreatedByCompaction: segment.GetCreatedByCompaction(),
+		CompactionFrom:      string(compactionFrom),
+		DroppedAt:           segment.GetDroppedAt(),
+		IsImporting:         segment.GetIsImporting(),
+		IsFake:              segment.GetIsFake(),
+	}, nil
+}
+
+// UnmarshalSegmentModel converts the row to a segment without binlogs
+func UnmarshalSegmentModel(seg *Segment) (*datapb.SegmentInfo, error) {
+	startPosition, err := unmarshalPosition(seg.StartPosition)
+	if err != nil {
+		log.Error("unmarshal segment start position error", zap.Int64("segmentID", seg.SegmentID), zap.Error(err))
+		return nil, err
+	}
+	dmlPosition, err := unmarshalPosition(seg.DmlPosition)
+	if err != nil {
+		log.Error("unmarshal segment dml position error", zap.Int64("segmentID", seg.SegmentID), zap.Error(err))
+		return nil, err
+	}
+	var compactionFrom []int64
+	if seg.CompactionFrom != "" {
+		if err := json.Unmarshal([]byte(seg.CompactionFrom), &compactionFrom); err != nil {
+			log.Error("unmarshal segment compaction from error", zap.Int64("segmentID", seg.SegmentID), zap.Error(err))
+			return nil, err
+		}
+	}
+
+	return &datapb.SegmentInfo{
+		ID:                  seg.SegmentID,
+		CollectionID:        seg.CollectionID,
+		PartitionID:         seg.PartitionID,
+		InsertChannel:       seg.InsertChannel,
+		NumOfRows:           seg.NumOfRows,
+		State:               commonpb.SegmentState(seg.State),
+		MaxRowNum:           seg.MaxRowNum,
+		LastExpireTime:      seg.LastExpireTime,
+		StartPosition:       startPosition,
+		DmlPosition:         dmlPosition,
+		CreatedByCompaction: seg.CreatedByCompaction,
+		CompactionFrom:      compactionFrom,
+		DroppedAt:           seg.DroppedAt,
+		IsImporting:         seg.IsImporting,
+		IsFake:              seg.IsFake,
+	}, nil
+}
+
+func marshalPosition(pos *internalpb.MsgPosition) (string, error) {
+	if pos == nil {
+		return "", nil
+	}
+	posBytes, err := json.Marshal(pos)
+	if err != nil {
+		return "", err
+	}
+	return string(posBytes), nil
+}
+
+func unmarshalPosition(posStr string) (*internalpb.MsgPosition, error) {
+	if posStr == "" {
+		return nil, nil
+	}
+	pos := &internalpb.MsgPosition{}
+	if err := json.Unmarshal([]byte(posStr), pos); err != nil {
+		return nil, err
+	}
+	return pos, nil
+}
@@ -0,0 +1,104 @@
+package dbmodel
+
+import (
+	"encoding/json"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
+)
+
+// BinlogType is the kind of logs kept in a SegmentBinlog row
+type BinlogType int32
+
+const (
+	InsertBinlog BinlogType = iota + 1
+	DeleteBinlog
+	StatsBinlog
+)
+
+// SegmentBinlog is the row of binlog paths of a field in a segment
+type SegmentBinlog struct {
+	ID           int64      `gorm:"id"`
+	TenantID     string     `gorm:"tenant_id;uniqueIndex:idx_segment_binlogs_tenant_segment_field_type"`
+	CollectionID int64      `gorm:"collection_id"`
+	PartitionID  int64      `gorm:"partition_id"`
+	SegmentID    int64      `gorm:"segment_id;uniqueIndex:idx_segment_binlogs_tenant_segment_field_type"`
+	FieldID      int64      `gorm:"field_id;uniqueIndex:idx_segment_binlogs_tenant_segment_field_type"`
+	BinlogType   BinlogType `gorm:"binlog_type;uniqueIndex:idx_segment_binlogs_tenant_segment_field_type"`
+	Binlogs      string     `gorm:"binlogs"`
+	CreatedAt    time.Time  `gorm:"created_at"`
+	UpdatedAt    time.Time  `gorm:"updated_at"`
+}
+
+func (v SegmentBinlog) TableName() string {
+	return "segment_binlogs"
+}
+
+//go:generate mockery --name=ISegmentBinlogDb
+type ISegmentBinlogDb interface {
+	List(tenantID string) ([]*SegmentBinlog, error)
+	Upsert(in []*SegmentBinlog) error
+	DeleteBySegmentIDs(tenantID string, segmentIDs []typeutil.UniqueID) error
+}
+
+// MarshalSegmentBinlogModel converts insert binlogs, delta logs and stats logs of the segment to rows
+func MarshalSegmentBinlogModel(tenantID string, segment *datapb.SegmentInfo) ([]*SegmentBinlog, error) {
+	var result []*SegmentBinlog
+	add := func(binlogType BinlogType, fieldBinlogs []*datapb.FieldBinlog) error {
+		for _, fieldBinlog := range fieldBinlogs {
+			binlogs, err := json.Marshal(fieldBinlog.GetBinlogs())
+			if err != nil {
+				log.Error("marshal binlogs of segment error", zap.Int64("segmentID", segment.GetID()),
+					zap.Int64("fieldID", fieldBinlog.GetFieldID()), zap.Int32("binlogType", int32(binlogType)), zap.Error(err))
+				return err
+			}
+			result = append(result, &SegmentBinlog{
+				TenantID:     tenantID,
+				CollectionID: segment.GetCollectionID(),
+				PartitionID:  segment.GetPartitionID(),
+				SegmentID:    segment.GetID(),
+				FieldID:      fieldBinlog.GetFieldID(),
+				BinlogType:   binlogType,
+				Binlogs:      string(binlogs),
+			})
+		}
+		return nil
+	}
+
+	if err := add(InsertBinlog, segment.GetBinlogs()); err != nil {
+		return nil, err
+	}
+	if err := add(DeleteBinlog, segment.GetDeltalogs()); err != nil {
+		return nil, err
+	}
+	if err := add(StatsBinlog, segment.GetStatslogs()); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// FillSegmentBinlogs sets the binlogs in rows to the segment
+func FillSegmentBinlogs(segment *datapb.SegmentInfo, rows []*SegmentBinlog) error {
+	for _, row := range rows {
+		var binlogs []*datapb.Binlog
+		if err := json.Unmarshal([]byte(row.Binlogs), &binlogs); err != nil {
+			log.Error("unmarshal binlogs of segment error", zap.Int64("segmentID", row.SegmentID),
+				zap.Int64("fieldID", row.FieldID), zap.Int32("binlogType", int32(row.BinlogType)), zap.Error(err))
+			return err
+		}
+		fieldBinlog := &datapb.FieldBinlog{FieldID: row.FieldID, Binlogs: binlogs}
+		switch row.BinlogType {
+		case InsertBinlog:
+			segment.Binlogs = append(segment.Binlogs, fieldBinlog)
+		case DeleteBinlog:
+			segment.Deltalogs = append(segment.Deltalogs, fieldBinlog)
+		case StatsBinlog:
+			segment.Statslogs = append(segment.Statslogs, fieldBinlog)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,120 @@
+package querycoord
+
+import (
+	"context"
+
+	"github.com/milvus-io/milvus/internal/metastore/db/dbmodel"
+	"github.com/milvus-io/milvus/internal/proto/querypb"
+	"github.com/milvus-io/milvus/internal/util/contextutil"
+)
+
+// Catalog keeps the load infos and replicas of QueryCoord in tables. QueryCoordCatalog has no context in
+// its methods, so the catalog is bound to the context given at creation and the tenant of it.
+type Catalog struct {
+	ctx        context.Context
+	metaDomain dbmodel.IMetaDomain
+	tenantID   string
+}
+
+func NewTableCatalog(ctx context.Context, metaDomain dbmodel.IMetaDomain) *Catalog {
+	return &Catalog{
+		ctx:        ctx,
+		metaDomain: metaDomain,
+		tenantID:   contextutil.TenantID(ctx),
+	}
+}
+
+func (tc *Catalog) SaveCollection(info *querypb.CollectionLoadInfo) error {
+	collection, err := dbmodel.MarshalCollectionLoadInfoModel(tc.tenantID, info)
+	if err != nil {
+		return err
+	}
+	return tc.metaDomain.CollectionLoadInfoDb(tc.ctx).Upsert(collection)
+}
+
+func (tc *Catalog) SavePartition(info ...*querypb.PartitionLoadInfo) error {
+	partitions := make([]*dbmodel.PartitionLoadInfo, 0, len(info))
+	for _, partition := range info {
+		p, err := dbmodel.MarshalPartitionLoadInfoModel(tc.tenantID, partition)
+		if err != nil {
+			return err
+		}
+		partitions = append(partitions, p)
+	}
+	return tc.metaDomain.PartitionLoadInfoDb(tc.ctx).Upsert(partitions)
+}
+
+func (tc *Catalog) SaveReplica(replica *querypb.Replica) error {
+	r, err := dbmodel.MarshalReplicaModel(tc.tenantID, replica)
+	if err != nil {
+		return err
+	}
+	return tc.metaDomain.ReplicaDb(tc.ctx).Upsert(r)
+}
+
+func (tc *Catalog) GetCollections() ([]*querypb.CollectionLoadInfo, error) {
+	collections, err := tc.metaDomain.CollectionLoadInfoDb(tc.ctx).List(tc.tenantID)
+	if err != nil {
+		return nil, err
+	}
+
+	ret := make([]*querypb.CollectionLoadInfo, 0, len(collections))
+	for _, collection := range collections {
+		info, err := dbmodel.UnmarshalCollectionLoadInfoModel(collection)
+		if err != nil {
+			return nil, err
+		}
+		ret = append(ret, info)
+	}
+	return ret, nil
+}
+
+func (tc *Catalog) GetPartitions() (map[int64][]*querypb.PartitionLoadInfo, error) {
+	partitions, err := tc.metaDomain.PartitionLoadInfoDb(tc.ctx).List(tc.tenantID)
+	if err != nil {
+		return nil, err
+	}
+
+	ret := make(map[int64][]*querypb.PartitionLoadInfo)
+	for _, partition := range partitions {
+		info, err := dbmodel.UnmarshalPartitionLoadInfoModel(partition)
+		if err != nil {
+			return nil, err
+		}
+		ret[info.GetCollectionID()] = append(ret[info.GetCollectionID()], info)
+	}
+	return ret, nil
+}
+
+func (tc *Catalog) GetReplicas() ([]*querypb.Replica, error) {
+	replicas, err := tc.metaDomain.ReplicaDb(tc.ctx).List(tc.tenantID)
+	if err != nil {
+		return nil, err
+	}
+
+	ret := make([]*querypb.Replica, 0, len(replicas))
+	for _, replica := range replicas {
+		info, err := dbmodel.UnmarshalReplicaModel(replica)
+		if err != nil {
+			return nil, err
+		}
+		ret = append(ret, info)
+	}
+	return ret, nil
+}
+
+func (tc *Catalog) ReleaseCollection(id int64) error {
+	return tc.metaDomain.CollectionLoadInfoDb(tc.ctx).Delete(tc.tenantID, id)
+}
+
+func (tc *Catalog) ReleasePartition(collection int64, partitions ...int64) error {
+	return tc.metaDomain.PartitionLoadInfoDb(tc.ctx).Delete(tc.tenantID, collection, partitions)
+}
+
+func (tc *Catalog) ReleaseReplicas(collectionID int64) error {
+	return tc.metaDomain.ReplicaDb(tc.ctx).DeleteByCollectionID(tc.tenantID, collectionID)
+}
+
+func (tc *Catalog) ReleaseReplica(collection, replica int64) error {
+	return tc.metaDomain.ReplicaDb(tc.ctx).Delete(tc.tenantID, collection, replica)
+}
@@ -0,0 +1,94 @@
+package querycoord
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/milvus-io/milvus/internal/metastore"
+	"github.com/milvus-io/milvus/internal/metastore/db/dao"
+	"github.com/milvus-io/milvus/internal/metastore/db/dbcore"
+	"github.com/milvus-io/milvus/internal/proto/querypb"
+)
+
+var _ metastore.QueryCoordCatalog = (*Catalog)(nil)
+
+func TestCatalog(t *testing.T) {
+	db, err := dbcore.OpenSqlite(":memory:")
+	require.NoError(t, err)
+	dbcore.SetGlobalDB(db)
+	catalog := NewTableCatalog(context.Background(), dao.NewMetaDomain())
+
+	t.Run("test collection", func(t *testing.T) {
+		info := &querypb.CollectionLoadInfo{
+			CollectionID:       1,
+			ReleasedPartitions: []int64{3},
+			ReplicaNumber:      2,
+			Status:             querypb.LoadStatus_Loading,
+			FieldIndexID:       map[int64]int64{100: 1000},
+		}
+		require.NoError(t, catalog.SaveCollection(info))
+		require.NoError(t, catalog.SaveCollection(&querypb.CollectionLoadInfo{CollectionID: 2}))
+		info.Status = querypb.LoadStatus_Loaded
+		require.NoError(t, catalog.SaveCollection(info))
+
+		collections, err := catalog.GetCollections()
+		require.NoError(t, err)
+		require.Equal(t, 2, len(collections))
+		assert.True(t, proto.Equal(info, collections[0]))
+
+		require.NoError(t, catalog.ReleaseCollection(1))
+		collections, err = catalog.GetCollections()
+		require.NoError(t, err)
+		require.Equal(t, 1, len(collections))
+		assert.Equal(t, int64(2), collections[0].GetCollectionID())
+	})
+
+	t.Run("test partition", func(t *testing.T) {
+		p1 := &querypb.PartitionLoadInfo{CollectionID: 1, PartitionID: 10, ReplicaNumber: 1, Status: querypb.LoadStatus_Loaded,
+			FieldIndexID: map[int64]int64{100: 1000}}
+		p2 := &querypb.PartitionLoadInfo{CollectionID: 1, PartitionID: 11, ReplicaNumber: 1, Status: querypb.LoadStatus_Loading}
+		p3 := &querypb.PartitionLoadInfo{CollectionID: 2, PartitionID: 20, ReplicaNumber: 1}
+		require.NoError(t, catalog.SavePartition(p1, p2, p3))
+
+		partitions, err := catalog.GetPartitions()
+		require.NoError(t, err)
+		require.Equal(t, 2, len(partitions[1]))
+		require.Equal(t, 1, len(partitions[2]))
+		assert.True(t, proto.Equal(p1, partitions[1][0]))
+
+		require.NoError(t, catalog.ReleasePartition(1, 10, 11))
+		partitions, err = catalog.GetPartitions()
+		require.NoError(t, err)
+		assert.Equal(t, 0, len(partitions[1]))
+		assert.Equal(t, 1, len(partitions[2]))
+	})
+
+	t.Run("test replica", func(t *testing.T) {
+		r1 := &querypb.Replica{ID: 100, CollectionID: 1, Nodes: []int64{1, 2}}
+		require.NoError(t, catalog.SaveReplica(r1))
+		require.NoError(t, catalog.SaveReplica(&querypb.Replica{ID: 101, CollectionID: 1, Nodes: []int64{3}}))
+		require.NoError(t, catalog.SaveReplica(&querypb.Replica{ID: 200, CollectionID: 2, Nodes: []int64{4}}))
+		r1.Nodes = []int64{1, 2, 5}
+		require.NoError(t, catalog.SaveReplica(r1))
+
+		replicas, err := catalog.GetReplicas()
+		require.NoError(t, err)
+		require.Equal(t, 3, len(replicas))
+		assert.True(t, proto.Equal(r1, replicas[0]))
+
+		require.NoError(t, catalog.ReleaseReplica(1, 101))
+		replicas, err = catalog.GetReplicas()
+		require.NoError(t, err)
+		assert.Equal(t, 2, len(replicas))
+
+		require.NoError(t, catalog.ReleaseReplicas(1))
+		replicas, err = catalog.GetReplicas()
+		require.NoError(t, err)
+		require.Equal(t, 1, len(replicas))
+		assert.Equal(t, int64(200), replicas[0].GetID())
+	})
+}
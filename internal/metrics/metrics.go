@@ -36,6 +36,7 @@ const (
 
 	InsertLabel    = "insert"
 	DeleteLabel    = "delete"
+	UpsertLabel    = "upsert"
 	SearchLabel    = "search"
 	QueryLabel     = "query"
 	CacheHitLabel  = "hit"
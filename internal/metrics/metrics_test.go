@@ -34,5 +34,7 @@ func TestRegisterMetrics(t *testing.T) {
 	RegisterQueryNode(r)
 	RegisterQueryCoord(r)
 	RegisterEtcdMetrics(r)
+	RegisterStorageMetrics(r)
+	RegisterMsgStreamMetrics(r)
 	Register(r)
 }
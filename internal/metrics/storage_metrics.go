@@ -0,0 +1,56 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metrics
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+const storageSubsystem = "storage"
+
+var (
+	StorageCacheAccessCounter = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: milvusNamespace,
+			Subsystem: storageSubsystem,
+			Name:      "cache_access_count",
+			Help:      "number of reads through the local disk cache of remote objects",
+		}, []string{cacheNameLabelName, cacheStateLabelName})
+
+	StorageCacheEvictionCounter = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: milvusNamespace,
+			Subsystem: storageSubsystem,
+			Name:      "cache_eviction_count",
+			Help:      "number of objects evicted from the local disk cache",
+		}, []string{cacheNameLabelName})
+
+	StorageCacheUsedBytes = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: milvusNamespace,
+			Subsystem: storageSubsystem,
+			Name:      "cache_used_bytes",
+			Help:      "bytes of objects cached on local disk",
+		}, []string{cacheNameLabelName})
+)
+
+// RegisterStorageMetrics registers storage metrics
+func RegisterStorageMetrics(registry *prometheus.Registry) {
+	registry.MustRegister(StorageCacheAccessCounter)
+	registry.MustRegister(StorageCacheEvictionCounter)
+	registry.MustRegister(StorageCacheUsedBytes)
+}
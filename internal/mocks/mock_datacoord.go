@@ -264,6 +264,53 @@ func (_c *DataCoord_DropVirtualChannel_Call) Return(_a0 *datapb.DropVirtualChann
 	return _c
 }
 
+// Export provides a mock function with given fields: ctx, req
+func (_m *DataCoord) Export(ctx context.Context, req *datapb.ExportTaskRequest) (*datapb.ExportTaskResponse, error) {
+	ret := _m.Called(ctx, req)
+
+	var r0 *datapb.ExportTaskResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.ExportTaskRequest) *datapb.ExportTaskResponse); ok {
+		r0 = rf(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*datapb.ExportTaskResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *datapb.ExportTaskRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DataCoord_Export_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Export'
+type DataCoord_Export_Call struct {
+	*mock.Call
+}
+
+// Export is a helper method to define mock.On call
+//  - ctx context.Context
+//  - req *datapb.ExportTaskRequest
+func (_e *DataCoord_Expecter) Export(ctx interface{}, req interface{}) *DataCoord_Export_Call {
+	return &DataCoord_Export_Call{Call: _e.mock.On("Export", ctx, req)}
+}
+
+func (_c *DataCoord_Export_Call) Run(run func(ctx context.Context, req *datapb.ExportTaskRequest)) *DataCoord_Export_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*datapb.ExportTaskRequest))
+	})
+	return _c
+}
+
+func (_c *DataCoord_Export_Call) Return(_a0 *datapb.ExportTaskResponse, _a1 error) *DataCoord_Export_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
 // Flush provides a mock function with given fields: ctx, req
 func (_m *DataCoord) Flush(ctx context.Context, req *datapb.FlushRequest) (*datapb.FlushResponse, error) {
 	ret := _m.Called(ctx, req)
@@ -1319,6 +1366,54 @@ func (_c *DataCoord_ReleaseSegmentLock_Call) Return(_a0 *commonpb.Status, _a1 er
 	return _c
 }
 
+// RestoreSegments provides a mock function with given fields: ctx, req
+func (_m *DataCoord) RestoreSegments(ctx context.Context, req *datapb.RestoreSegmentsRequest) (*datapb.RestoreSegmentsResponse, error) {
+	ret := _m.Called(ctx, req)
+
+	var r0 *datapb.RestoreSegmentsResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.RestoreSegmentsRequest) *datapb.RestoreSegmentsResponse); ok {
+		r0 = rf(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*datapb.RestoreSegmentsResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *datapb.RestoreSegmentsRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DataCoord_RestoreSegments_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RestoreSegments'
+type DataCoord_RestoreSegments_Call struct {
+	*mock.Call
+}
+
+// RestoreSegments is a helper method to define mock.On call
+//  - ctx context.Context
+//  - req *datapb.RestoreSegmentsRequest
+func (_e *DataCoord_Expecter) RestoreSegments(ctx interface{}, req interface{}) *DataCoord_RestoreSegments_Call {
+	return &DataCoord_RestoreSegments_Call{Call: _e.mock.On("RestoreSegments", ctx, req)}
+}
+
+func (_c *DataCoord_RestoreSegments_Call) Run(run func(ctx context.Context, req *datapb.RestoreSegmentsRequest)) *DataCoord_RestoreSegments_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*datapb.RestoreSegmentsRequest))
+	})
+	return _c
+}
+
+func (_c *DataCoord_RestoreSegments_Call) Return(_a0 *datapb.RestoreSegmentsResponse, _a1 error) *DataCoord_RestoreSegments_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+
 // SaveBinlogPaths provides a mock function with given fields: ctx, req
 func (_m *DataCoord) SaveBinlogPaths(ctx context.Context, req *datapb.SaveBinlogPathsRequest) (*commonpb.Status, error) {
 	ret := _m.Called(ctx, req)
@@ -123,6 +123,53 @@ func (_c *DataNode_Compaction_Call) Return(_a0 *commonpb.Status, _a1 error) *Dat
 	return _c
 }
 
+// Export provides a mock function with given fields: ctx, req
+func (_m *DataNode) Export(ctx context.Context, req *datapb.ExportTaskRequest) (*commonpb.Status, error) {
+	ret := _m.Called(ctx, req)
+
+	var r0 *commonpb.Status
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.ExportTaskRequest) *commonpb.Status); ok {
+		r0 = rf(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*commonpb.Status)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *datapb.ExportTaskRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DataNode_Export_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Export'
+type DataNode_Export_Call struct {
+	*mock.Call
+}
+
+// Export is a helper method to define mock.On call
+//  - ctx context.Context
+//  - req *datapb.ExportTaskRequest
+func (_e *DataNode_Expecter) Export(ctx interface{}, req interface{}) *DataNode_Export_Call {
+	return &DataNode_Export_Call{Call: _e.mock.On("Export", ctx, req)}
+}
+
+func (_c *DataNode_Export_Call) Run(run func(ctx context.Context, req *datapb.ExportTaskRequest)) *DataNode_Export_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*datapb.ExportTaskRequest))
+	})
+	return _c
+}
+
+func (_c *DataNode_Export_Call) Return(_a0 *commonpb.Status, _a1 error) *DataNode_Export_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
 // FlushSegments provides a mock function with given fields: ctx, req
 func (_m *DataNode) FlushSegments(ctx context.Context, req *datapb.FlushSegmentsRequest) (*commonpb.Status, error) {
 	ret := _m.Called(ctx, req)
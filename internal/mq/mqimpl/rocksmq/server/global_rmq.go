@@ -90,3 +90,17 @@ func CloseRocksMQ() {
 		Rmq.Close()
 	}
 }
+
+// GetRocksMQTopicsSize returns the payload size of retained messages of each topic in the global rocksmq,
+// nil is returned if the global rocksmq is not serving
+func GetRocksMQTopicsSize() map[string]int64 {
+	if Rmq == nil || Rmq.isClosed() {
+		return nil
+	}
+	sizes, err := Rmq.GetTopicsSize()
+	if err != nil {
+		log.Warn("failed to get size of rocksmq topics", zap.Error(err))
+		return nil
+	}
+	return sizes
+}
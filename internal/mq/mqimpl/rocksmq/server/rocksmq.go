@@ -29,6 +29,33 @@ type ConsumerMessage struct {
 	Payload []byte
 }
 
+// TopicRetention overrides the global retention policy of a topic,
+// 0 means following the global policy and -1 means unlimited.
+type TopicRetention struct {
+	TimeInMinutes int64 `json:"time_in_minutes"`
+	SizeInMB      int64 `json:"size_in_mb"`
+}
+
+// GroupStats is the consume position and lag of a consumer group
+type GroupStats struct {
+	GroupName string `json:"group_name"`
+	// CurrentID is the id of the next message to consume, DefaultMessageID means the earliest position
+	CurrentID UniqueID `json:"current_id"`
+	// Lag is the span of message ids from the consume position to the last message, message ids are
+	// allocated across topics, so it's an upper bound of the number of retained messages not consumed yet
+	Lag int64 `json:"lag"`
+}
+
+// TopicStats is the stats of retained messages and consumer groups of a topic,
+// FirstMsgID and LastMsgID are DefaultMessageID if the topic is empty
+type TopicStats struct {
+	Topic      string       `json:"topic"`
+	FirstMsgID UniqueID     `json:"first_msg_id"`
+	LastMsgID  UniqueID     `json:"last_msg_id"`
+	Size       int64        `json:"size"`
+	Groups     []GroupStats `json:"groups"`
+}
+
 // RocksMQ is an interface thatmay be implemented by the application
 // to do message queue operations based on rocksdb
 type RocksMQ interface {
@@ -48,4 +75,11 @@ type RocksMQ interface {
 	ExistConsumerGroup(topicName string, groupName string) (bool, *Consumer, error)
 
 	Notify(topicName, groupName string)
+
+	SetTopicRetention(topicName string, retention TopicRetention) error
+	GetTopicRetention(topicName string) (TopicRetention, error)
+	GetTopicSize(topicName string) (int64, error)
+	CompactTopic(topicName string) error
+	TruncateBefore(topicName string, msgID UniqueID) error
+	GetTopicStats(topicName string) (*TopicStats, error)
 }
@@ -12,8 +12,10 @@
 package server
 
 import (
+	"encoding/json"
 	"errors"
 	"fmt"
+	"math"
 	"path"
 	"runtime"
 	"strconv"
@@ -72,6 +74,9 @@ const (
 	// acked_ts/topicName/pageId, record the latest ack ts of each page, will be purged on retention or destroy of the topic
 	AckedTsTitle = "acked_ts/"
 
+	// topic_retention/topicName, record the retention policy overriding the global one, cleaned up on destroy topic
+	TopicRetentionTitle = "topic_retention/"
+
 	RmqNotServingErrMsg = "Rocksmq is not serving"
 )
 
@@ -429,8 +434,10 @@ func (rmq *rocksmq) DestroyTopic(topicName string) error {
 	topicIDKey := TopicIDTitle + topicName
 	// message size of this topic
 	msgSizeKey := MessageSizeTitle + topicName
+	// retention policy of this topic
+	retentionKey := TopicRetentionTitle + topicName
 	var removedKeys []string
-	removedKeys = append(removedKeys, topicIDKey, msgSizeKey)
+	removedKeys = append(removedKeys, topicIDKey, msgSizeKey, retentionKey)
 	// Batch remove, atomic operation
 	err = rmq.kv.MultiRemove(removedKeys)
 	if err != nil {
@@ -440,6 +447,7 @@ func (rmq *rocksmq) DestroyTopic(topicName string) error {
 	// clean up retention info
 	topicMu.Delete(topicName)
 	rmq.retentionInfo.topicRetetionTime.Delete(topicName)
+	rmq.retentionInfo.topicRetention.Delete(topicName)
 
 	log.Debug("Rocksmq destroy topic successfully ", zap.String("topic", topicName), zap.Int64("elapsed", time.Since(start).Milliseconds()))
 	return nil
@@ -1042,3 +1050,217 @@ func (rmq *rocksmq) updateAckedInfo(topicName, groupName string, firstID UniqueI
 	}
 	return nil
 }
+
+func (rmq *rocksmq) getTopicLock(topicName string) (*sync.Mutex, error) {
+	ll, ok := topicMu.Load(topicName)
+	if !ok {
+		return nil, fmt.Errorf("Topic %s not exist, %w", topicName, mqwrapper.ErrTopicNotExist)
+	}
+	lock, ok := ll.(*sync.Mutex)
+	if !ok {
+		return nil, fmt.Errorf("get mutex failed, topic name = %s", topicName)
+	}
+	return lock, nil
+}
+
+// SetTopicRetention persists the retention policy of the topic, which overrides the global one
+func (rmq *rocksmq) SetTopicRetention(topicName string, retention TopicRetention) error {
+	if rmq.isClosed() {
+		return errors.New(RmqNotServingErrMsg)
+	}
+	if retention.TimeInMinutes < -1 || retention.SizeInMB < -1 {
+		return fmt.Errorf("invalid retention of topic %s, time = %d minutes, size = %d MB", topicName, retention.TimeInMinutes, retention.SizeInMB)
+	}
+	lock, err := rmq.getTopicLock(topicName)
+	if err != nil {
+		return err
+	}
+	lock.Lock()
+	defer lock.Unlock()
+
+	val, err := json.Marshal(retention)
+	if err != nil {
+		return err
+	}
+	if err := rmq.kv.Save(TopicRetentionTitle+topicName, string(val)); err != nil {
+		return err
+	}
+	rmq.retentionInfo.topicRetention.Store(topicName, retention)
+	// the retention goroutine is not started if the global policy is unlimited
+	if timeInSecs, sizeInMB := rmq.retentionInfo.getRetention(topicName); timeInSecs != -1 || sizeInMB != -1 {
+		rmq.retentionInfo.startRetentionInfo()
+	}
+	log.Info("Rocksmq set topic retention", zap.String("topic", topicName),
+		zap.Int64("timeInMinutes", retention.TimeInMinutes), zap.Int64("sizeInMB", retention.SizeInMB))
+	return nil
+}
+
+// GetTopicRetention returns the retention policy overriding the global one, fields are 0 if not overridden
+func (rmq *rocksmq) GetTopicRetention(topicName string) (TopicRetention, error) {
+	if rmq.isClosed() {
+		return TopicRetention{}, errors.New(RmqNotServingErrMsg)
+	}
+	if _, err := rmq.getTopicLock(topicName); err != nil {
+		return TopicRetention{}, err
+	}
+	if v, ok := rmq.retentionInfo.topicRetention.Load(topicName); ok {
+		return v.(TopicRetention), nil
+	}
+	return TopicRetention{}, nil
+}
+
+// GetTopicSize returns the payload size of retained messages in the topic
+func (rmq *rocksmq) GetTopicSize(topicName string) (int64, error) {
+	if rmq.isClosed() {
+		return 0, errors.New(RmqNotServingErrMsg)
+	}
+	if _, err := rmq.getTopicLock(topicName); err != nil {
+		return 0, err
+	}
+	// sizes of the full pages
+	_, pageSizes, err := rmq.kv.LoadWithPrefix(constructKey(PageMsgSizeTitle, topicName) + "/")
+	if err != nil {
+		return 0, err
+	}
+	// size of the current page
+	msgSizeVal, err := rmq.kv.Load(MessageSizeTitle + topicName)
+	if err != nil {
+		return 0, err
+	}
+	if msgSizeVal != "" {
+		pageSizes = append(pageSizes, msgSizeVal)
+	}
+	var size int64
+	for _, val := range pageSizes {
+		pageSize, err := strconv.ParseInt(val, 10, 64)
+		if err != nil {
+			return 0, err
+		}
+		size += pageSize
+	}
+	return size, nil
+}
+
+// GetTopicsSize returns the payload size of retained messages of all topics
+func (rmq *rocksmq) GetTopicsSize() (map[string]int64, error) {
+	if rmq.isClosed() {
+		return nil, errors.New(RmqNotServingErrMsg)
+	}
+	topicKeys, _, err := rmq.kv.LoadWithPrefix(TopicIDTitle)
+	if err != nil {
+		return nil, err
+	}
+	sizes := make(map[string]int64, len(topicKeys))
+	for _, key := range topicKeys {
+		topic := key[len(TopicIDTitle):]
+		size, err := rmq.GetTopicSize(topic)
+		if err != nil {
+			return nil, err
+		}
+		sizes[topic] = size
+	}
+	return sizes, nil
+}
+
+// CompactTopic purges the expired messages of the topic by its retention policy right now,
+// and compacts the range of the topic in rocksdb to release the disk space.
+func (rmq *rocksmq) CompactTopic(topicName string) error {
+	if rmq.isClosed() {
+		return errors.New(RmqNotServingErrMsg)
+	}
+	start := time.Now()
+	if _, err := rmq.getTopicLock(topicName); err != nil {
+		return err
+	}
+	rmq.retentionInfo.mutex.RLock()
+	defer rmq.retentionInfo.mutex.RUnlock()
+	if err := rmq.retentionInfo.expiredCleanUp(topicName); err != nil {
+		return err
+	}
+	rmq.retentionInfo.topicRetetionTime.Store(topicName, time.Now().Unix())
+
+	prefix := topicName + "/"
+	rmq.store.CompactRange(gorocksdb.Range{Start: []byte(prefix), Limit: []byte(typeutil.AddOne(prefix))})
+	log.Info("Rocksmq compact topic successfully", zap.String("topic", topicName), zap.Int64("elapsed", time.Since(start).Milliseconds()))
+	return nil
+}
+
+// TruncateBefore removes the messages of the topic whose id is less than msgID regardless of the retention policy,
+// consumer groups positioned before msgID continue from the first remaining message.
+func (rmq *rocksmq) TruncateBefore(topicName string, msgID UniqueID) error {
+	if rmq.isClosed() {
+		return errors.New(RmqNotServingErrMsg)
+	}
+	lock, err := rmq.getTopicLock(topicName)
+	if err != nil {
+		return err
+	}
+	lock.Lock()
+	defer lock.Unlock()
+	if msgID <= 0 {
+		return nil
+	}
+
+	// the pages ending before msgID are removed, the size of the page containing msgID is reduced
+	// by the messages truncated from it, all in the same batch with the ack infos of the removed pages
+	pageMsgPrefix := constructKey(PageMsgSizeTitle, topicName) + "/"
+	pageKeys, pageVals, err := rmq.kv.LoadWithPrefix(pageMsgPrefix)
+	if err != nil {
+		return err
+	}
+	var pageEndID UniqueID
+	// the size key of the page containing msgID, the current page if all full pages end before msgID
+	pageSizeKey, pageSizeVal := MessageSizeTitle+topicName, ""
+	var pageID UniqueID = math.MaxInt64
+	for i, key := range pageKeys {
+		id, err := parsePageID(key)
+		if err != nil {
+			return err
+		}
+		if id < msgID && id > pageEndID {
+			pageEndID = id
+		}
+		if id >= msgID && id < pageID {
+			pageID = id
+			pageSizeKey, pageSizeVal = key, pageVals[i]
+		}
+	}
+	if pageID == math.MaxInt64 {
+		if pageSizeVal, err = rmq.kv.Load(pageSizeKey); err != nil {
+			return err
+		}
+	}
+	pageSize, err := strconv.ParseInt(pageSizeVal, 10, 64)
+	if err != nil {
+		return err
+	}
+	truncatedSize, err := rmq.getMessagesSize(topicName, pageEndID+1, msgID)
+	if err != nil {
+		return err
+	}
+	pageKvs := map[string]string{pageSizeKey: strconv.FormatInt(pageSize-truncatedSize, 10)}
+	if err := rmq.retentionInfo.cleanDataLocked(topicName, pageEndID, msgID-1, pageKvs); err != nil {
+		return err
+	}
+	log.Info("Rocksmq truncate topic successfully", zap.String("topic", topicName),
+		zap.Int64("msgID", msgID), zap.Int64("pageEndID", pageEndID), zap.Int64("truncatedSize", truncatedSize))
+	return nil
+}
+
+// getMessagesSize returns the payload size of the retained messages of the topic in [startID, endID)
+func (rmq *rocksmq) getMessagesSize(topicName string, startID, endID UniqueID) (int64, error) {
+	readOpts := gorocksdb.NewDefaultReadOptions()
+	defer readOpts.Destroy()
+	iter := rocksdbkv.NewRocksIteratorWithUpperBound(rmq.store, path.Join(topicName, strconv.FormatInt(endID, 10)), readOpts)
+	defer iter.Close()
+	var size int64
+	for iter.Seek([]byte(path.Join(topicName, strconv.FormatInt(startID, 10)))); iter.Valid(); iter.Next() {
+		val := iter.Value()
+		size += int64(val.Size())
+		val.Free()
+	}
+	if err := iter.Err(); err != nil {
+		return 0, err
+	}
+	return size, nil
+}
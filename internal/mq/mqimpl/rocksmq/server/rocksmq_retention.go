@@ -12,6 +12,7 @@
 package server
 
 import (
+	"encoding/json"
 	"fmt"
 	"path"
 	"strconv"
@@ -50,11 +51,14 @@ var TickerTimeInSeconds int64 = 600
 type retentionInfo struct {
 	// key is topic name, value is last retention time
 	topicRetetionTime sync.Map
-	mutex             sync.RWMutex
+	// key is topic name, value is the TopicRetention overriding the global policy
+	topicRetention sync.Map
+	mutex          sync.RWMutex
 
 	kv *rocksdbkv.RocksdbKV
 	db *gorocksdb.DB
 
+	startOnce sync.Once
 	closeCh   chan struct{}
 	closeWg   sync.WaitGroup
 	closeOnce sync.Once
@@ -83,15 +87,48 @@ func initRetentionInfo(params paramtable.BaseTable, kv *rocksdbkv.RocksdbKV, db
 		ri.topicRetetionTime.Store(topic, time.Now().Unix())
 		topicMu.Store(topic, new(sync.Mutex))
 	}
+	// Get the retention overrides of topics
+	retentionKeys, retentionVals, err := ri.kv.LoadWithPrefix(TopicRetentionTitle)
+	if err != nil {
+		return nil, err
+	}
+	for i, key := range retentionKeys {
+		retention := TopicRetention{}
+		if err := json.Unmarshal([]byte(retentionVals[i]), &retention); err != nil {
+			return nil, err
+		}
+		ri.topicRetention.Store(key[len(TopicRetentionTitle):], retention)
+	}
 	return ri, nil
 }
 
+// getRetention returns the retention time in seconds and size in MB of the topic,
+// the override of the topic takes precedence over the global policy.
+func (ri *retentionInfo) getRetention(topic string) (int64, int64) {
+	timeInSecs := atomic.LoadInt64(&RocksmqRetentionTimeInSecs)
+	sizeInMB := atomic.LoadInt64(&RocksmqRetentionSizeInMB)
+	if v, ok := ri.topicRetention.Load(topic); ok {
+		retention := v.(TopicRetention)
+		if retention.TimeInMinutes != 0 {
+			timeInSecs = retention.TimeInMinutes * 60
+			if retention.TimeInMinutes < 0 {
+				timeInSecs = -1
+			}
+		}
+		if retention.SizeInMB != 0 {
+			sizeInMB = retention.SizeInMB
+		}
+	}
+	return timeInSecs, sizeInMB
+}
+
 // Before do retention, load retention info from rocksdb to retention info structure in goroutines.
 // Because loadRetentionInfo may need some time, so do this asynchronously. Finally start retention goroutine.
 func (ri *retentionInfo) startRetentionInfo() {
-	// var wg sync.WaitGroup
-	ri.closeWg.Add(1)
-	go ri.retention()
+	ri.startOnce.Do(func() {
+		ri.closeWg.Add(1)
+		go ri.retention()
+	})
 }
 
 // retention do time ticker and trigger retention check and operation for each topic
@@ -115,7 +152,6 @@ func (ri *retentionInfo) retention() error {
 			go ri.kv.DB.CompactRange(gorocksdb.Range{Start: nil, Limit: nil})
 		case t := <-ticker.C:
 			timeNow := t.Unix()
-			checkTime := atomic.LoadInt64(&RocksmqRetentionTimeInSecs) / 10
 			ri.mutex.RLock()
 			ri.topicRetetionTime.Range(func(k, v interface{}) bool {
 				topic, _ := k.(string)
@@ -124,6 +160,8 @@ func (ri *retentionInfo) retention() error {
 					log.Warn("Can't parse lastRetention to int64", zap.String("topic", topic), zap.Any("value", v))
 					return true
 				}
+				retentionTimeInSecs, _ := ri.getRetention(topic)
+				checkTime := retentionTimeInSecs / 10
 				if lastRetentionTs+checkTime < timeNow {
 					err := ri.expiredCleanUp(topic)
 					if err != nil {
@@ -158,6 +196,7 @@ func (ri *retentionInfo) expiredCleanUp(topic string) error {
 	var pageEndID UniqueID
 	var err error
 
+	retentionTimeInSecs, retentionSizeInMB := ri.getRetention(topic)
 	fixedAckedTsKey := constructKey(AckedTsTitle, topic)
 	// calculate total acked size, simply add all page info
 	totalAckedSize, err := ri.calculateTopicAckedSize(topic)
@@ -199,7 +238,7 @@ func (ri *retentionInfo) expiredCleanUp(topic string) error {
 		if err != nil {
 			return err
 		}
-		if msgTimeExpiredCheck(ackedTs) {
+		if msgTimeExpiredCheck(ackedTs, retentionTimeInSecs) {
 			pageEndID = pageID
 			pValue := pageIter.Value()
 			size, err := strconv.ParseInt(string(pValue.Data()), 10, 64)
@@ -238,7 +277,7 @@ func (ri *retentionInfo) expiredCleanUp(topic string) error {
 			return err
 		}
 		curDeleteSize := deletedAckedSize + size
-		if msgSizeExpiredCheck(curDeleteSize, totalAckedSize) {
+		if msgSizeExpiredCheck(curDeleteSize, totalAckedSize, retentionSizeInMB) {
 			pageEndID, err = parsePageID(pKeyStr)
 			if err != nil {
 				return err
@@ -315,6 +354,23 @@ func (ri *retentionInfo) calculateTopicAckedSize(topic string) (int64, error) {
 }
 
 func (ri *retentionInfo) cleanData(topic string, pageEndID UniqueID) error {
+	ll, ok := topicMu.Load(topic)
+	if !ok {
+		return fmt.Errorf("topic name = %s not exist", topic)
+	}
+	lock, ok := ll.(*sync.Mutex)
+	if !ok {
+		return fmt.Errorf("get mutex failed, topic name = %s", topic)
+	}
+	lock.Lock()
+	defer lock.Unlock()
+
+	return ri.cleanDataLocked(topic, pageEndID, pageEndID, nil)
+}
+
+// cleanDataLocked removes the page infos until pageEndID and the messages until msgEndID,
+// the page infos recomputed in pageKvs are saved in the same batch. The caller must hold the mutex of the topic.
+func (ri *retentionInfo) cleanDataLocked(topic string, pageEndID UniqueID, msgEndID UniqueID, pageKvs map[string]string) error {
 	writeBatch := gorocksdb.NewWriteBatch()
 	defer writeBatch.Destroy()
 
@@ -332,19 +388,11 @@ func (ri *retentionInfo) cleanData(topic string, pageEndID UniqueID) error {
 	ackedStartIDKey := fixedAckedTsKey + "/"
 	ackedEndIDKey := fixedAckedTsKey + "/" + strconv.FormatInt(pageEndID+1, 10)
 	writeBatch.DeleteRange([]byte(ackedStartIDKey), []byte(ackedEndIDKey))
-
-	ll, ok := topicMu.Load(topic)
-	if !ok {
-		return fmt.Errorf("topic name = %s not exist", topic)
-	}
-	lock, ok := ll.(*sync.Mutex)
-	if !ok {
-		return fmt.Errorf("get mutex failed, topic name = %s", topic)
+	for key, value := range pageKvs {
+		writeBatch.Put([]byte(key), []byte(value))
 	}
-	lock.Lock()
-	defer lock.Unlock()
 
-	err := DeleteMessages(ri.db, topic, 0, pageEndID)
+	err := DeleteMessages(ri.db, topic, 0, msgEndID)
 	if err != nil {
 		return err
 	}
@@ -376,16 +424,16 @@ func DeleteMessages(db *gorocksdb.DB, topic string, startID, endID UniqueID) err
 	return nil
 }
 
-func msgTimeExpiredCheck(ackedTs int64) bool {
-	if RocksmqRetentionTimeInSecs < 0 {
+func msgTimeExpiredCheck(ackedTs int64, retentionTimeInSecs int64) bool {
+	if retentionTimeInSecs < 0 {
 		return false
 	}
-	return ackedTs+atomic.LoadInt64(&RocksmqRetentionTimeInSecs) < time.Now().Unix()
+	return ackedTs+retentionTimeInSecs < time.Now().Unix()
 }
 
-func msgSizeExpiredCheck(deletedAckedSize, ackedSize int64) bool {
-	if RocksmqRetentionSizeInMB < 0 {
+func msgSizeExpiredCheck(deletedAckedSize, ackedSize int64, retentionSizeInMB int64) bool {
+	if retentionSizeInMB < 0 {
 		return false
 	}
-	return ackedSize-deletedAckedSize > atomic.LoadInt64(&RocksmqRetentionSizeInMB)*MB
+	return ackedSize-deletedAckedSize > retentionSizeInMB*MB
 }
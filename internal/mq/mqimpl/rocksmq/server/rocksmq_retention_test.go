@@ -651,3 +651,139 @@ func TestRmqRetention_PageSizeExpire(t *testing.T) {
 	// make sure clean up happens
 	assert.True(t, newRes[0].MsgID > ids[0])
 }
+
+func TestRmqRetention_TopicOverride(t *testing.T) {
+	err := os.MkdirAll(retentionPath, os.ModePerm)
+	if err != nil {
+		log.Error("MkdirAll error for path", zap.Any("path", retentionPath))
+	}
+	defer os.RemoveAll(retentionPath)
+	kvPath := retentionPath + "kv_override"
+	os.RemoveAll(kvPath)
+	idAllocator := InitIDAllocator(kvPath)
+
+	rocksdbPath := retentionPath + "db_override"
+	os.RemoveAll(rocksdbPath)
+	metaPath := retentionPath + "db_override" + kvSuffix
+	os.RemoveAll(metaPath)
+
+	var params paramtable.BaseTable
+	params.Init()
+	atomic.StoreInt64(&RocksmqPageSize, 10)
+	atomic.StoreInt64(&TickerTimeInSeconds, 3600)
+	rmq, err := NewRocksMQ(params, rocksdbPath, idAllocator)
+	assert.Nil(t, err)
+
+	// all the acked messages are expired by the global policy
+	atomic.StoreInt64(&RocksmqRetentionSizeInMB, -1)
+	atomic.StoreInt64(&RocksmqRetentionTimeInSecs, 0)
+
+	msgNum := 100
+	pMsgs := make([]ProducerMessage, msgNum)
+	for i := 0; i < msgNum; i++ {
+		pMsgs[i] = ProducerMessage{Payload: []byte("message_" + strconv.Itoa(i))}
+	}
+	produceAndConsume := func(topicName string) []UniqueID {
+		err := rmq.CreateTopic(topicName)
+		assert.NoError(t, err)
+		ids, err := rmq.Produce(topicName, pMsgs)
+		assert.NoError(t, err)
+		groupName := "test_group"
+		err = rmq.CreateConsumerGroup(topicName, groupName)
+		assert.NoError(t, err)
+		err = rmq.RegisterConsumer(&Consumer{Topic: topicName, GroupName: groupName})
+		assert.NoError(t, err)
+		cMsgs, err := rmq.Consume(topicName, groupName, msgNum)
+		assert.NoError(t, err)
+		assert.Equal(t, msgNum, len(cMsgs))
+		return ids
+	}
+	firstMsgID := func(topicName string, seekID UniqueID) UniqueID {
+		err := rmq.ForceSeek(topicName, "test_group", seekID)
+		assert.NoError(t, err)
+		cMsgs, err := rmq.Consume(topicName, "test_group", 1)
+		assert.NoError(t, err)
+		if len(cMsgs) == 0 {
+			return DefaultMessageID
+		}
+		return cMsgs[0].MsgID
+	}
+
+	expiredIDs := produceAndConsume("topic_expired")
+	keptIDs := produceAndConsume("topic_kept")
+	size, err := rmq.GetTopicSize("topic_kept")
+	assert.NoError(t, err)
+	assert.True(t, size > 0)
+	sizes, err := rmq.GetTopicsSize()
+	assert.NoError(t, err)
+	assert.Equal(t, size, sizes["topic_kept"])
+	assert.Equal(t, sizes["topic_expired"], sizes["topic_kept"])
+
+	retention, err := rmq.GetTopicRetention("topic_kept")
+	assert.NoError(t, err)
+	assert.Equal(t, TopicRetention{}, retention)
+	err = rmq.SetTopicRetention("topic_kept", TopicRetention{TimeInMinutes: -1, SizeInMB: -1})
+	assert.NoError(t, err)
+	err = rmq.SetTopicRetention("topic_kept", TopicRetention{TimeInMinutes: -2})
+	assert.Error(t, err)
+	err = rmq.SetTopicRetention("topic_not_exist", TopicRetention{})
+	assert.Error(t, err)
+	_, err = rmq.GetTopicRetention("topic_not_exist")
+	assert.Error(t, err)
+	_, err = rmq.GetTopicSize("topic_not_exist")
+	assert.Error(t, err)
+
+	// the override keeps the messages of topic_kept, wait for the acked ts to be expired
+	time.Sleep(1100 * time.Millisecond)
+	assert.NoError(t, rmq.CompactTopic("topic_expired"))
+	assert.NoError(t, rmq.CompactTopic("topic_kept"))
+	assert.Error(t, rmq.CompactTopic("topic_not_exist"))
+	assert.Equal(t, DefaultMessageID, firstMsgID("topic_expired", expiredIDs[0]))
+	assert.Equal(t, keptIDs[0], firstMsgID("topic_kept", keptIDs[0]))
+	expiredSize, err := rmq.GetTopicSize("topic_expired")
+	assert.NoError(t, err)
+	assert.True(t, expiredSize < size)
+	keptSize, err := rmq.GetTopicSize("topic_kept")
+	assert.NoError(t, err)
+	assert.Equal(t, size, keptSize)
+
+	// truncate regardless of the retention
+	assert.NoError(t, rmq.TruncateBefore("topic_kept", keptIDs[msgNum/2]))
+	assert.NoError(t, rmq.TruncateBefore("topic_kept", 0))
+	assert.Error(t, rmq.TruncateBefore("topic_not_exist", keptIDs[0]))
+	assert.Equal(t, keptIDs[msgNum/2], firstMsgID("topic_kept", keptIDs[0]))
+	// the size of the page containing the first retained message is reduced by its truncated messages
+	var retainedSize int64
+	for _, msg := range pMsgs[msgNum/2:] {
+		retainedSize += int64(len(msg.Payload))
+	}
+	keptSize, err = rmq.GetTopicSize("topic_kept")
+	assert.NoError(t, err)
+	assert.Equal(t, retainedSize, keptSize)
+	sizes, err = rmq.GetTopicsSize()
+	assert.NoError(t, err)
+	assert.Equal(t, retainedSize, sizes["topic_kept"])
+
+	// the override is persisted
+	rmq.Close()
+	rmq, err = NewRocksMQ(params, rocksdbPath, idAllocator)
+	assert.NoError(t, err)
+	retention, err = rmq.GetTopicRetention("topic_kept")
+	assert.NoError(t, err)
+	assert.Equal(t, TopicRetention{TimeInMinutes: -1, SizeInMB: -1}, retention)
+
+	// the override is removed with the topic
+	assert.NoError(t, rmq.DestroyTopic("topic_kept"))
+	assert.NoError(t, rmq.CreateTopic("topic_kept"))
+	retention, err = rmq.GetTopicRetention("topic_kept")
+	assert.NoError(t, err)
+	assert.Equal(t, TopicRetention{}, retention)
+	assert.NoError(t, rmq.DestroyTopic("topic_kept"))
+	assert.NoError(t, rmq.DestroyTopic("topic_expired"))
+	rmq.Close()
+
+	_, err = rmq.GetTopicSize("topic_kept")
+	assert.Error(t, err)
+	assert.Error(t, rmq.CompactTopic("topic_kept"))
+	assert.Error(t, rmq.TruncateBefore("topic_kept", 1))
+}
@@ -0,0 +1,168 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"sort"
+	"strconv"
+
+	"github.com/tecbot/gorocksdb"
+	"go.uber.org/zap"
+
+	rocksdbkv "github.com/milvus-io/milvus/internal/kv/rocksdb"
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/mq/msgstream/mqwrapper"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
+)
+
+// GetTopicStats returns the stats of the topic, the size is summed from the page metadata and the lag of
+// groups is derived from message ids, so retained messages are never scanned
+func (rmq *rocksmq) GetTopicStats(topicName string) (*TopicStats, error) {
+	if rmq.isClosed() {
+		return nil, errors.New(RmqNotServingErrMsg)
+	}
+	if _, err := rmq.getTopicLock(topicName); err != nil {
+		return nil, err
+	}
+	size, err := rmq.GetTopicSize(topicName)
+	if err != nil {
+		return nil, err
+	}
+	firstID, err := rmq.getFirstMsg(topicName)
+	if err != nil {
+		return nil, err
+	}
+	lastID, err := rmq.getLatestMsg(topicName)
+	if err != nil {
+		return nil, err
+	}
+	stats := &TopicStats{
+		Topic:      topicName,
+		FirstMsgID: firstID,
+		LastMsgID:  lastID,
+		Size:       size,
+		Groups:     make([]GroupStats, 0),
+	}
+	if vals, ok := rmq.consumers.Load(topicName); ok {
+		for _, consumer := range vals.([]*Consumer) {
+			currentID, ok := rmq.consumersID.Load(constructCurrentID(topicName, consumer.GroupName))
+			if !ok {
+				continue
+			}
+			group := GroupStats{GroupName: consumer.GroupName, CurrentID: currentID.(UniqueID)}
+			// the group consumes from the first retained message if its position has been truncated
+			startID := group.CurrentID
+			if startID < firstID {
+				startID = firstID
+			}
+			if lastID != DefaultMessageID && lastID >= startID {
+				group.Lag = lastID - startID + 1
+			}
+			stats.Groups = append(stats.Groups, group)
+		}
+	}
+	sort.Slice(stats.Groups, func(i, j int) bool {
+		return stats.Groups[i].GroupName < stats.Groups[j].GroupName
+	})
+	return stats, nil
+}
+
+// getFirstMsg returns the id of the first retained message of the topic, DefaultMessageID if the topic is empty
+func (rmq *rocksmq) getFirstMsg(topicName string) (UniqueID, error) {
+	readOpts := gorocksdb.NewDefaultReadOptions()
+	defer readOpts.Destroy()
+	prefix := topicName + "/"
+	iter := rocksdbkv.NewRocksIteratorWithUpperBound(rmq.store, typeutil.AddOne(prefix), readOpts)
+	defer iter.Close()
+	iter.Seek([]byte(prefix))
+	if err := iter.Err(); err != nil {
+		return DefaultMessageID, err
+	}
+	if !iter.Valid() {
+		return DefaultMessageID, nil
+	}
+	key := iter.Key()
+	defer key.Free()
+	return strconv.ParseInt(string(key.Data())[len(prefix):], 10, 64)
+}
+
+// GetAllTopicStats returns the stats of all topics sorted by topic name
+func (rmq *rocksmq) GetAllTopicStats() ([]*TopicStats, error) {
+	if rmq.isClosed() {
+		return nil, errors.New(RmqNotServingErrMsg)
+	}
+	topicKeys, _, err := rmq.kv.LoadWithPrefix(TopicIDTitle)
+	if err != nil {
+		return nil, err
+	}
+	sort.Strings(topicKeys)
+	allStats := make([]*TopicStats, 0, len(topicKeys))
+	for _, key := range topicKeys {
+		stats, err := rmq.GetTopicStats(key[len(TopicIDTitle):])
+		if err != nil {
+			return nil, err
+		}
+		allStats = append(allStats, stats)
+	}
+	return allStats, nil
+}
+
+// GetRocksMQStats returns the stats of all topics in the global rocksmq
+func GetRocksMQStats() ([]*TopicStats, error) {
+	if Rmq == nil || Rmq.isClosed() {
+		return nil, errors.New(RmqNotServingErrMsg)
+	}
+	return Rmq.GetAllTopicStats()
+}
+
+// StatsHandler serves the stats of topics in the global rocksmq as json,
+// the stats of a single topic is returned if the topic is specified in the query.
+func StatsHandler(w http.ResponseWriter, req *http.Request) {
+	if req.Method != http.MethodGet {
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+	var (
+		body interface{}
+		err  error
+	)
+	if topic := req.URL.Query().Get("topic"); topic != "" {
+		if Rmq == nil || Rmq.isClosed() {
+			err = errors.New(RmqNotServingErrMsg)
+		} else {
+			body, err = Rmq.GetTopicStats(topic)
+		}
+	} else {
+		body, err = GetRocksMQStats()
+	}
+	w.Header().Set("Content-Type", "application/json")
+	if err != nil {
+		log.Warn("failed to get rocksmq stats", zap.Error(err))
+		if errors.Is(err, mqwrapper.ErrTopicNotExist) {
+			w.WriteHeader(http.StatusNotFound)
+		} else {
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}
+		body = map[string]string{"error": err.Error()}
+	}
+	if err := json.NewEncoder(w).Encode(body); err != nil {
+		log.Warn("failed to write rocksmq stats", zap.Error(err))
+	}
+}
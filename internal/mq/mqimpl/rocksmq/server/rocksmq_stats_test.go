@@ -0,0 +1,164 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/milvus-io/milvus/internal/util/paramtable"
+)
+
+func TestRocksmq_TopicStats(t *testing.T) {
+	suffix := "_stats"
+	kvPath := rmqPath + kvPathSuffix + suffix
+	defer os.RemoveAll(kvPath)
+	idAllocator := InitIDAllocator(kvPath)
+
+	rocksdbPath := rmqPath + suffix
+	defer os.RemoveAll(rocksdbPath + kvSuffix)
+	defer os.RemoveAll(rocksdbPath)
+
+	var params paramtable.BaseTable
+	params.Init()
+	rmq, err := NewRocksMQ(params, rocksdbPath, idAllocator)
+	require.NoError(t, err)
+	defer rmq.Close()
+
+	topicName := "topic_stats"
+	require.NoError(t, rmq.CreateTopic(topicName))
+	defer rmq.DestroyTopic(topicName)
+	require.NoError(t, rmq.CreateTopic("topic_empty"))
+	defer rmq.DestroyTopic("topic_empty")
+
+	stats, err := rmq.GetTopicStats("topic_empty")
+	assert.NoError(t, err)
+	assert.Equal(t, &TopicStats{
+		Topic:      "topic_empty",
+		FirstMsgID: DefaultMessageID,
+		LastMsgID:  DefaultMessageID,
+		Groups:     []GroupStats{},
+	}, stats)
+
+	pMsgs := make([]ProducerMessage, 10)
+	for i := range pMsgs {
+		pMsgs[i] = ProducerMessage{Payload: []byte("message")}
+	}
+	ids, err := rmq.Produce(topicName, pMsgs)
+	require.NoError(t, err)
+
+	for _, groupName := range []string{"group_b", "group_a"} {
+		require.NoError(t, rmq.CreateConsumerGroup(topicName, groupName))
+		require.NoError(t, rmq.RegisterConsumer(&Consumer{Topic: topicName, GroupName: groupName, MsgMutex: make(chan struct{}, 1)}))
+	}
+	_, err = rmq.Consume(topicName, "group_b", 4)
+	require.NoError(t, err)
+
+	stats, err = rmq.GetTopicStats(topicName)
+	assert.NoError(t, err)
+	assert.Equal(t, topicName, stats.Topic)
+	assert.Equal(t, ids[0], stats.FirstMsgID)
+	assert.Equal(t, ids[9], stats.LastMsgID)
+	assert.Equal(t, int64(70), stats.Size)
+	assert.Equal(t, []GroupStats{
+		{GroupName: "group_a", CurrentID: DefaultMessageID, Lag: 10},
+		{GroupName: "group_b", CurrentID: ids[4], Lag: 6},
+	}, stats.Groups)
+
+	// truncated messages are not counted
+	require.NoError(t, rmq.TruncateBefore(topicName, ids[2]))
+	stats, err = rmq.GetTopicStats(topicName)
+	assert.NoError(t, err)
+	assert.Equal(t, ids[2], stats.FirstMsgID)
+	assert.Equal(t, int64(56), stats.Size)
+	assert.Equal(t, int64(8), stats.Groups[0].Lag)
+	assert.Equal(t, int64(6), stats.Groups[1].Lag)
+
+	// the lag of a group positioned before the first retained message counts from the first message
+	require.NoError(t, rmq.TruncateBefore(topicName, ids[6]))
+	stats, err = rmq.GetTopicStats(topicName)
+	assert.NoError(t, err)
+	assert.Equal(t, ids[6], stats.FirstMsgID)
+	assert.Equal(t, int64(28), stats.Size)
+	assert.Equal(t, int64(4), stats.Groups[0].Lag)
+	assert.Equal(t, int64(4), stats.Groups[1].Lag)
+
+	allStats, err := rmq.GetAllTopicStats()
+	assert.NoError(t, err)
+	assert.Equal(t, 2, len(allStats))
+	assert.Equal(t, "topic_empty", allStats[0].Topic)
+	assert.Equal(t, stats, allStats[1])
+
+	_, err = rmq.GetTopicStats("topic_not_exist")
+	assert.Error(t, err)
+}
+
+func TestRocksmq_StatsHandler(t *testing.T) {
+	serve := func(method string, url string) *httptest.ResponseRecorder {
+		w := httptest.NewRecorder()
+		StatsHandler(w, httptest.NewRequest(method, url, nil))
+		return w
+	}
+
+	rmqBackup := Rmq
+	defer func() { Rmq = rmqBackup }()
+	Rmq = nil
+	assert.Equal(t, http.StatusServiceUnavailable, serve(http.MethodGet, "/rocksmq/stats").Code)
+	assert.Equal(t, http.StatusServiceUnavailable, serve(http.MethodGet, "/rocksmq/stats?topic=topic_handler").Code)
+	assert.Equal(t, http.StatusMethodNotAllowed, serve(http.MethodPost, "/rocksmq/stats").Code)
+
+	suffix := "_stats_handler"
+	kvPath := rmqPath + kvPathSuffix + suffix
+	defer os.RemoveAll(kvPath)
+	idAllocator := InitIDAllocator(kvPath)
+	rocksdbPath := rmqPath + suffix
+	defer os.RemoveAll(rocksdbPath + kvSuffix)
+	defer os.RemoveAll(rocksdbPath)
+	var params paramtable.BaseTable
+	params.Init()
+	rmq, err := NewRocksMQ(params, rocksdbPath, idAllocator)
+	require.NoError(t, err)
+	defer rmq.Close()
+	Rmq = rmq
+
+	require.NoError(t, rmq.CreateTopic("topic_handler"))
+	defer rmq.DestroyTopic("topic_handler")
+	ids, err := rmq.Produce("topic_handler", []ProducerMessage{{Payload: []byte("a")}})
+	require.NoError(t, err)
+
+	w := serve(http.MethodGet, "/rocksmq/stats")
+	assert.Equal(t, http.StatusOK, w.Code)
+	var allStats []*TopicStats
+	assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &allStats))
+	assert.Equal(t, 1, len(allStats))
+	assert.Equal(t, ids[0], allStats[0].LastMsgID)
+
+	w = serve(http.MethodGet, "/rocksmq/stats?topic=topic_handler")
+	assert.Equal(t, http.StatusOK, w.Code)
+	stats := &TopicStats{}
+	assert.NoError(t, json.Unmarshal(w.Body.Bytes(), stats))
+	assert.Equal(t, "topic_handler", stats.Topic)
+	assert.Equal(t, int64(1), stats.Size)
+
+	assert.Equal(t, http.StatusNotFound, serve(http.MethodGet, "/rocksmq/stats?topic=topic_not_exist").Code)
+}
@@ -0,0 +1,342 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package msgstream
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/metrics"
+	"github.com/milvus-io/milvus/internal/mq/msgstream/mqwrapper"
+)
+
+// DeadLetterPolicy decides what a consumer does with a message it fails to unmarshal
+type DeadLetterPolicy string
+
+const (
+	// DeadLetterPolicyDrop skips the message, only logs and counts it
+	DeadLetterPolicyDrop DeadLetterPolicy = "drop"
+	// DeadLetterPolicyTopic skips the message and produces it to the dead letter topic
+	DeadLetterPolicyTopic DeadLetterPolicy = "topic"
+	// DeadLetterPolicyFile skips the message and appends it to the dead letter file
+	DeadLetterPolicyFile DeadLetterPolicy = "file"
+)
+
+const (
+	deadLetterReasonHeader = "header"
+	deadLetterReasonBody   = "body"
+	deadLetterReasonBatch  = "batch"
+)
+
+// DeadLetterConfig is the dead letter setting shared by all msgstream consumers of the process
+type DeadLetterConfig struct {
+	Policy   DeadLetterPolicy
+	Topic    string
+	FilePath string
+}
+
+var (
+	deadLetterConfigMu sync.RWMutex
+	deadLetterConfig   = DeadLetterConfig{Policy: DeadLetterPolicyDrop}
+)
+
+// SetDeadLetterConfig sets the dead letter config used by msgstreams created afterwards
+func SetDeadLetterConfig(cfg DeadLetterConfig) error {
+	switch cfg.Policy {
+	case "", DeadLetterPolicyDrop:
+		cfg.Policy = DeadLetterPolicyDrop
+	case DeadLetterPolicyTopic:
+		if cfg.Topic == "" {
+			return fmt.Errorf("dead letter topic is empty")
+		}
+	case DeadLetterPolicyFile:
+		if cfg.FilePath == "" {
+			return fmt.Errorf("dead letter file path is empty")
+		}
+	default:
+		return fmt.Errorf("unknown dead letter policy %s", cfg.Policy)
+	}
+	deadLetterConfigMu.Lock()
+	defer deadLetterConfigMu.Unlock()
+	deadLetterConfig = cfg
+	return nil
+}
+
+func getDeadLetterConfig() DeadLetterConfig {
+	deadLetterConfigMu.RLock()
+	defer deadLetterConfigMu.RUnlock()
+	return deadLetterConfig
+}
+
+// DeadLetter is a message skipped by a consumer, with its raw payload and position
+type DeadLetter struct {
+	Channel    string            `json:"channel"`
+	MsgGroup   string            `json:"msg_group"`
+	MsgID      []byte            `json:"msg_id"`
+	Payload    []byte            `json:"payload"`
+	Properties map[string]string `json:"properties,omitempty"`
+	Reason     string            `json:"reason"`
+	RecordTime time.Time         `json:"record_time"`
+}
+
+// DeadLetterRecorder stores dead letters for later inspection and replay
+type DeadLetterRecorder interface {
+	Record(letter *DeadLetter) error
+	Close()
+}
+
+func newDeadLetterRecorder(cfg DeadLetterConfig, client mqwrapper.Client) DeadLetterRecorder {
+	switch cfg.Policy {
+	case DeadLetterPolicyTopic:
+		return &topicDeadLetterRecorder{client: client, topic: cfg.Topic}
+	case DeadLetterPolicyFile:
+		return NewFileDeadLetterRecorder(cfg.FilePath)
+	default:
+		return nil
+	}
+}
+
+var _ DeadLetterRecorder = (*fileDeadLetterRecorder)(nil)
+
+type fileDeadLetterRecorder struct {
+	path string
+	mu   sync.Mutex
+	file *os.File
+}
+
+// NewFileDeadLetterRecorder creates a recorder appending dead letters to path as json lines,
+// the file is opened on the first record
+func NewFileDeadLetterRecorder(path string) DeadLetterRecorder {
+	return &fileDeadLetterRecorder{path: path}
+}
+
+func (r *fileDeadLetterRecorder) Record(letter *DeadLetter) error {
+	data, err := json.Marshal(letter)
+	if err != nil {
+		return err
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.file == nil {
+		if err := os.MkdirAll(filepath.Dir(r.path), os.ModePerm); err != nil {
+			return err
+		}
+		file, err := os.OpenFile(r.path, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0644)
+		if err != nil {
+			return err
+		}
+		r.file = file
+	}
+	// one write per line, appends of several streams do not interleave
+	_, err = r.file.Write(append(data, '\n'))
+	return err
+}
+
+func (r *fileDeadLetterRecorder) Close() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.file != nil {
+		r.file.Close()
+		r.file = nil
+	}
+}
+
+var _ DeadLetterRecorder = (*topicDeadLetterRecorder)(nil)
+
+type topicDeadLetterRecorder struct {
+	client   mqwrapper.Client
+	topic    string
+	mu       sync.Mutex
+	producer mqwrapper.Producer
+}
+
+func (r *topicDeadLetterRecorder) Record(letter *DeadLetter) error {
+	data, err := json.Marshal(letter)
+	if err != nil {
+		return err
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.producer == nil {
+		producer, err := r.client.CreateProducer(mqwrapper.ProducerOptions{Topic: r.topic})
+		if err != nil {
+			return err
+		}
+		r.producer = producer
+	}
+	_, err = r.producer.Send(context.TODO(), &mqwrapper.ProducerMessage{Payload: data})
+	return err
+}
+
+func (r *topicDeadLetterRecorder) Close() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.producer != nil {
+		r.producer.Close()
+		r.producer = nil
+	}
+}
+
+// handleDeadLetter skips a message which failed to unmarshal, the message is counted
+// and recorded according to the dead letter policy
+func (ms *mqMsgStream) handleDeadLetter(msg mqwrapper.Message, msgGroup string, reason string, cause error) {
+	channel := filepath.Base(msg.Topic())
+	metrics.MsgStreamDeadLetterCounter.WithLabelValues(channel, reason).Inc()
+	log.Warn("skip message failed to unmarshal",
+		zap.String("channel", channel),
+		zap.String("msgGroup", msgGroup),
+		zap.Binary("msgID", msg.ID().Serialize()),
+		zap.String("policy", string(ms.deadLetterPolicy)),
+		zap.Error(cause))
+	if ms.deadLetter == nil {
+		return
+	}
+	letter := &DeadLetter{
+		Channel:    channel,
+		MsgGroup:   msgGroup,
+		MsgID:      msg.ID().Serialize(),
+		Payload:    msg.Payload(),
+		Properties: msg.Properties(),
+		Reason:     fmt.Sprintf("%s: %s", reason, cause.Error()),
+		RecordTime: time.Now(),
+	}
+	if err := ms.deadLetter.Record(letter); err != nil {
+		log.Error("failed to record dead letter", zap.String("channel", channel), zap.Error(err))
+	}
+}
+
+// ReadDeadLettersFromFile reads the dead letters recorded with the file policy
+func ReadDeadLettersFromFile(path string) ([]*DeadLetter, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	letters := make([]*DeadLetter, 0)
+	scanner := bufio.NewScanner(file)
+	scanner.Buffer(make([]byte, 0, 64*1024), 1<<30)
+	for line := 1; scanner.Scan(); line++ {
+		if len(scanner.Bytes()) == 0 {
+			continue
+		}
+		letter := &DeadLetter{}
+		if err := json.Unmarshal(scanner.Bytes(), letter); err != nil {
+			return nil, fmt.Errorf("failed to parse dead letter at line %d, err %s", line, err.Error())
+		}
+		letters = append(letters, letter)
+	}
+	return letters, scanner.Err()
+}
+
+// ReadDeadLettersFromTopic reads the dead letters recorded with the topic policy, it returns
+// when no more message arrives within waitTime
+func ReadDeadLettersFromTopic(ctx context.Context, factory Factory, topic string, waitTime time.Duration) ([]*DeadLetter, error) {
+	client, release, err := getFactoryClient(ctx, factory)
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+
+	consumer, err := client.Subscribe(mqwrapper.ConsumerOptions{
+		Topic:                       topic,
+		SubscriptionName:            fmt.Sprintf("dead-letter-reader-%d", time.Now().UnixNano()),
+		SubscriptionInitialPosition: mqwrapper.SubscriptionPositionEarliest,
+		BufSize:                     1024,
+	})
+	if err != nil {
+		return nil, err
+	}
+	defer consumer.Close()
+
+	letters := make([]*DeadLetter, 0)
+	for {
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(waitTime):
+			return letters, nil
+		case msg, ok := <-consumer.Chan():
+			if !ok {
+				return letters, nil
+			}
+			consumer.Ack(msg)
+			letter := &DeadLetter{}
+			if err := json.Unmarshal(msg.Payload(), letter); err != nil {
+				log.Warn("skip invalid dead letter", zap.String("topic", topic), zap.Error(err))
+				continue
+			}
+			letters = append(letters, letter)
+		}
+	}
+}
+
+// ReplayDeadLetters produces the raw payload of dead letters to channel for inspection or repair.
+// The messages keep their original timestamps, which are behind the time ticks already produced to their own
+// channels, so replaying to the channel a letter was skipped from is rejected as consumers would take them out of order.
+func ReplayDeadLetters(ctx context.Context, factory Factory, letters []*DeadLetter, channel string) error {
+	if channel == "" {
+		return fmt.Errorf("channel to replay dead letters to is empty")
+	}
+	for _, letter := range letters {
+		if filepath.Base(channel) == letter.Channel {
+			return fmt.Errorf("dead letter %v can't be replayed to its own channel %s", letter.MsgID, letter.Channel)
+		}
+	}
+
+	client, release, err := getFactoryClient(ctx, factory)
+	if err != nil {
+		return err
+	}
+	defer release()
+
+	producer, err := client.CreateProducer(mqwrapper.ProducerOptions{Topic: channel, EnableCompression: true})
+	if err != nil {
+		return err
+	}
+	defer producer.Close()
+	for _, letter := range letters {
+		if _, err := producer.Send(ctx, &mqwrapper.ProducerMessage{Payload: letter.Payload, Properties: letter.Properties}); err != nil {
+			return fmt.Errorf("failed to replay dead letter %v to %s, err %s", letter.MsgID, channel, err.Error())
+		}
+	}
+	return nil
+}
+
+// getFactoryClient borrows the mq client of a stream created by factory,
+// release closes the stream and the client
+func getFactoryClient(ctx context.Context, factory Factory) (mqwrapper.Client, func(), error) {
+	stream, err := factory.NewMsgStream(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	mqStream, ok := stream.(*mqMsgStream)
+	if !ok {
+		stream.Close()
+		return nil, nil, fmt.Errorf("unsupported msgstream type %T", stream)
+	}
+	return mqStream.client, stream.Close, nil
+}
@@ -0,0 +1,153 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package msgstream
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+	"github.com/milvus-io/milvus/internal/mq/msgstream/mqwrapper"
+	"github.com/milvus-io/milvus/internal/util/funcutil"
+	"github.com/milvus-io/milvus/internal/util/paramtable"
+)
+
+func produceRaw(t *testing.T, factory Factory, channel string, payloads ...[]byte) {
+	client, release, err := getFactoryClient(context.Background(), factory)
+	require.NoError(t, err)
+	defer release()
+	producer, err := client.CreateProducer(mqwrapper.ProducerOptions{Topic: channel})
+	require.NoError(t, err)
+	defer producer.Close()
+	for _, payload := range payloads {
+		_, err = producer.Send(context.Background(), &mqwrapper.ProducerMessage{Payload: payload, Properties: map[string]string{"k": "v"}})
+		require.NoError(t, err)
+	}
+}
+
+func TestSetDeadLetterConfig(t *testing.T) {
+	defer SetDeadLetterConfig(DeadLetterConfig{})
+
+	assert.NoError(t, SetDeadLetterConfig(DeadLetterConfig{}))
+	assert.Equal(t, DeadLetterPolicyDrop, getDeadLetterConfig().Policy)
+	assert.Error(t, SetDeadLetterConfig(DeadLetterConfig{Policy: DeadLetterPolicyTopic}))
+	assert.Error(t, SetDeadLetterConfig(DeadLetterConfig{Policy: DeadLetterPolicyFile}))
+	assert.Error(t, SetDeadLetterConfig(DeadLetterConfig{Policy: "unknown"}))
+	assert.NoError(t, SetDeadLetterConfig(DeadLetterConfig{Policy: DeadLetterPolicyTopic, Topic: "dlq"}))
+	assert.Equal(t, "dlq", getDeadLetterConfig().Topic)
+}
+
+func TestDeadLetter_File(t *testing.T) {
+	defer SetDeadLetterConfig(DeadLetterConfig{})
+	ctx := context.Background()
+	path := filepath.Join(t.TempDir(), "dlq", "dead_letter.jsonl")
+	require.NoError(t, SetDeadLetterConfig(DeadLetterConfig{Policy: DeadLetterPolicyFile, FilePath: path}))
+
+	factory := NewMmsFactory(&paramtable.MemmqConfig{})
+	channel := funcutil.RandomString(8)
+	valid := getTimeTickMsg(1)
+	validBytes, err := valid.Marshal(valid)
+	require.NoError(t, err)
+	// the header is valid but no unmarshal func is set for the msg type
+	bodyBytes, err := proto.Marshal(&commonpb.MsgHeader{Base: &commonpb.MsgBase{MsgType: commonpb.MsgType_Undefined}})
+	require.NoError(t, err)
+	produceRaw(t, factory, channel, []byte("bad header"), bodyBytes, validBytes.([]byte))
+
+	stream, err := factory.NewMsgStream(ctx)
+	require.NoError(t, err)
+	stream.AsConsumer([]string{channel}, "dead-letter-sub", mqwrapper.SubscriptionPositionEarliest)
+	select {
+	case pack := <-stream.Chan():
+		require.Equal(t, 1, len(pack.Msgs))
+		assert.Equal(t, valid.ID(), pack.Msgs[0].ID())
+	case <-time.After(5 * time.Second):
+		t.Fatal("valid message not received")
+	}
+	stream.Close()
+
+	letters, err := ReadDeadLettersFromFile(path)
+	require.NoError(t, err)
+	require.Equal(t, 2, len(letters))
+	assert.Equal(t, channel, letters[0].Channel)
+	assert.Equal(t, "dead-letter-sub", letters[0].MsgGroup)
+	assert.Equal(t, []byte("bad header"), letters[0].Payload)
+	assert.Equal(t, "v", letters[0].Properties["k"])
+	assert.Contains(t, letters[0].Reason, deadLetterReasonHeader)
+	assert.Contains(t, letters[1].Reason, deadLetterReasonBody)
+
+	// replaying to their own channel or without a channel is rejected
+	assert.Error(t, ReplayDeadLetters(ctx, factory, letters, channel))
+	assert.Error(t, ReplayDeadLetters(ctx, factory, letters, ""))
+
+	// replay to another channel
+	replayChannel := funcutil.RandomString(8)
+	require.NoError(t, ReplayDeadLetters(ctx, factory, letters, replayChannel))
+	client, release, err := getFactoryClient(ctx, factory)
+	require.NoError(t, err)
+	defer release()
+	consumer, err := client.Subscribe(mqwrapper.ConsumerOptions{
+		Topic:                       replayChannel,
+		SubscriptionName:            "raw-sub",
+		SubscriptionInitialPosition: mqwrapper.SubscriptionPositionEarliest,
+	})
+	require.NoError(t, err)
+	defer consumer.Close()
+	for i := 0; i < len(letters); i++ {
+		msg := <-consumer.Chan()
+		assert.Equal(t, letters[i].Payload, msg.Payload())
+	}
+
+	_, err = ReadDeadLettersFromFile(filepath.Join(t.TempDir(), "not_exist"))
+	assert.Error(t, err)
+}
+
+func TestDeadLetter_Topic(t *testing.T) {
+	defer SetDeadLetterConfig(DeadLetterConfig{})
+	ctx := context.Background()
+	dlq := funcutil.RandomString(8)
+	require.NoError(t, SetDeadLetterConfig(DeadLetterConfig{Policy: DeadLetterPolicyTopic, Topic: dlq}))
+
+	factory := NewMmsFactory(&paramtable.MemmqConfig{})
+	channel := funcutil.RandomString(8)
+	produceRaw(t, factory, channel, []byte("bad header"))
+	tt := getTimeTickMsg(2)
+	ttBytes, err := tt.Marshal(tt)
+	require.NoError(t, err)
+	produceRaw(t, factory, channel, ttBytes.([]byte))
+
+	stream, err := factory.NewTtMsgStream(ctx)
+	require.NoError(t, err)
+	stream.AsConsumer([]string{channel}, "dead-letter-sub", mqwrapper.SubscriptionPositionEarliest)
+	select {
+	case <-stream.Chan():
+	case <-time.After(5 * time.Second):
+		t.Fatal("time tick not received")
+	}
+	stream.Close()
+
+	letters, err := ReadDeadLettersFromTopic(ctx, factory, dlq, time.Second)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(letters))
+	assert.Equal(t, channel, letters[0].Channel)
+	assert.Equal(t, []byte("bad header"), letters[0].Payload)
+}
@@ -20,16 +20,21 @@ import (
 	"context"
 	"errors"
 	"strings"
+	"sync"
+	"time"
 
 	"github.com/apache/pulsar-client-go/pulsar"
 	"github.com/milvus-io/milvus/internal/log"
 	rmqimplserver "github.com/milvus-io/milvus/internal/mq/mqimpl/rocksmq/server"
 	"github.com/milvus-io/milvus/internal/mq/msgstream/mqwrapper"
 	kafkawrapper "github.com/milvus-io/milvus/internal/mq/msgstream/mqwrapper/kafka"
+	memwrapper "github.com/milvus-io/milvus/internal/mq/msgstream/mqwrapper/mem"
+	natswrapper "github.com/milvus-io/milvus/internal/mq/msgstream/mqwrapper/nats"
 	pulsarmqwrapper "github.com/milvus-io/milvus/internal/mq/msgstream/mqwrapper/pulsar"
 	rmqwrapper "github.com/milvus-io/milvus/internal/mq/msgstream/mqwrapper/rmq"
 	"github.com/milvus-io/milvus/internal/util/paramtable"
 	"github.com/milvus-io/milvus/internal/util/retry"
+	"github.com/nats-io/nats.go"
 	"github.com/streamnative/pulsarctl/pkg/cli"
 	"github.com/streamnative/pulsarctl/pkg/pulsar/utils"
 	"go.uber.org/zap"
@@ -258,3 +263,137 @@ func NewKmsFactory(config *paramtable.KafkaConfig) Factory {
 	}
 	return f
 }
+
+// NmsFactory is a NATS JetStream msgstream factory that implemented Factory interface(msgstream.go)
+type NmsFactory struct {
+	dispatcherFactory ProtoUDFactory
+	config            *paramtable.NatsConfig
+	ReceiveBufSize    int64
+
+	// conn is the connection shared by the msgstreams of the factory
+	mu   sync.Mutex
+	conn *nats.Conn
+}
+
+// connect returns the shared connection, which is connected on first use or if it's closed.
+func (f *NmsFactory) connect() (*nats.Conn, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if f.conn == nil || f.conn.IsClosed() {
+		conn, err := natswrapper.Connect(f.config.Address)
+		if err != nil {
+			return nil, err
+		}
+		f.conn = conn
+	}
+	return f.conn, nil
+}
+
+// newClient creates a nats client on the shared connection.
+func (f *NmsFactory) newClient() (mqwrapper.Client, error) {
+	conn, err := f.connect()
+	if err != nil {
+		return nil, err
+	}
+	client, err := natswrapper.NewClientWithConn(conn, time.Duration(f.config.RetentionTimeInMinutes)*time.Minute)
+	if err != nil {
+		return nil, err
+	}
+	return client, nil
+}
+
+func (f *NmsFactory) NewMsgStream(ctx context.Context) (MsgStream, error) {
+	natsClient, err := f.newClient()
+	if err != nil {
+		return nil, err
+	}
+	return NewMqMsgStream(ctx, f.ReceiveBufSize, -1, natsClient, f.dispatcherFactory.NewUnmarshalDispatcher())
+}
+
+func (f *NmsFactory) NewTtMsgStream(ctx context.Context) (MsgStream, error) {
+	natsClient, err := f.newClient()
+	if err != nil {
+		return nil, err
+	}
+	return NewMqTtMsgStream(ctx, f.ReceiveBufSize, -1, natsClient, f.dispatcherFactory.NewUnmarshalDispatcher())
+}
+
+func (f *NmsFactory) NewQueryMsgStream(ctx context.Context) (MsgStream, error) {
+	return f.NewMsgStream(ctx)
+}
+
+// NewMsgStreamDisposer deletes the durable JetStream consumers of the subscription on the channels.
+func (f *NmsFactory) NewMsgStreamDisposer(ctx context.Context) func([]string, string) error {
+	return func(channels []string, subname string) error {
+		conn, err := f.connect()
+		if err != nil {
+			return err
+		}
+		client, err := natswrapper.NewClientWithConn(conn, 0)
+		if err != nil {
+			return err
+		}
+		defer client.Close()
+		for _, channel := range channels {
+			if err := client.DeleteConsumer(channel, subname); err != nil {
+				log.Warn("failed to delete nats consumer", zap.String("channel", channel), zap.String("subname", subname), zap.Error(err))
+				return err
+			}
+		}
+		return nil
+	}
+}
+
+func NewNmsFactory(config *paramtable.NatsConfig) Factory {
+	return &NmsFactory{
+		dispatcherFactory: ProtoUDFactory{},
+		ReceiveBufSize:    1024,
+		config:            config,
+	}
+}
+
+// MmsFactory is an in-process memory msgstream factory that implemented Factory interface(msgstream.go)
+type MmsFactory struct {
+	dispatcherFactory ProtoUDFactory
+	config            *paramtable.MemmqConfig
+	ReceiveBufSize    int64
+	MmqBufSize        int64
+}
+
+func (f *MmsFactory) NewMsgStream(ctx context.Context) (MsgStream, error) {
+	memClient, err := memwrapper.NewClientWithConfig(f.config)
+	if err != nil {
+		return nil, err
+	}
+	return NewMqMsgStream(ctx, f.ReceiveBufSize, f.MmqBufSize, memClient, f.dispatcherFactory.NewUnmarshalDispatcher())
+}
+
+func (f *MmsFactory) NewTtMsgStream(ctx context.Context) (MsgStream, error) {
+	memClient, err := memwrapper.NewClientWithConfig(f.config)
+	if err != nil {
+		return nil, err
+	}
+	return NewMqTtMsgStream(ctx, f.ReceiveBufSize, f.MmqBufSize, memClient, f.dispatcherFactory.NewUnmarshalDispatcher())
+}
+
+func (f *MmsFactory) NewQueryMsgStream(ctx context.Context) (MsgStream, error) {
+	return f.NewMsgStream(ctx)
+}
+
+// NewMsgStreamDisposer does nothing since consumer groups of the memory mq
+// are removed once their last consumer is closed.
+func (f *MmsFactory) NewMsgStreamDisposer(ctx context.Context) func([]string, string) error {
+	return func(channels []string, subname string) error {
+		return nil
+	}
+}
+
+// NewMmsFactory is used to generate a new MmsFactory object, all the msgstreams share the memory mq of the process.
+func NewMmsFactory(config *paramtable.MemmqConfig) *MmsFactory {
+	return &MmsFactory{
+		dispatcherFactory: ProtoUDFactory{},
+		ReceiveBufSize:    1024,
+		MmqBufSize:        1024,
+		config:            config,
+	}
+}
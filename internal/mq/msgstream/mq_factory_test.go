@@ -115,3 +115,37 @@ func TestKafkaFactory(t *testing.T) {
 	// err = kmsFactory.NewMsgStreamDisposer(ctx)([]string{"hello"}, "xx")
 	// assert.Nil(t, err)
 }
+
+func TestNatsFactory(t *testing.T) {
+	nmsFactory := NewNmsFactory(&paramtable.NatsConfig{Address: "nats://127.0.0.1:1"})
+
+	ctx := context.Background()
+	_, err := nmsFactory.NewMsgStream(ctx)
+	assert.Error(t, err)
+
+	_, err = nmsFactory.NewTtMsgStream(ctx)
+	assert.Error(t, err)
+
+	_, err = nmsFactory.NewQueryMsgStream(ctx)
+	assert.Error(t, err)
+
+	err = nmsFactory.NewMsgStreamDisposer(ctx)([]string{"hello"}, "xx")
+	assert.Error(t, err)
+}
+
+func TestMemFactory(t *testing.T) {
+	mmsFactory := NewMmsFactory(&paramtable.MemmqConfig{})
+
+	ctx := context.Background()
+	_, err := mmsFactory.NewMsgStream(ctx)
+	assert.NoError(t, err)
+
+	_, err = mmsFactory.NewTtMsgStream(ctx)
+	assert.NoError(t, err)
+
+	_, err = mmsFactory.NewQueryMsgStream(ctx)
+	assert.NoError(t, err)
+
+	err = mmsFactory.NewMsgStreamDisposer(ctx)([]string{"hello"}, "xx")
+	assert.NoError(t, err)
+}
@@ -58,6 +58,10 @@ type mqMsgStream struct {
 	consumerLock *sync.Mutex
 	closed       int32
 	onceChan     sync.Once
+
+	deadLetterPolicy DeadLetterPolicy
+	deadLetter       DeadLetterRecorder
+	produceBatchCfg  ProduceBatchConfig
 }
 
 // NewMqMsgStream is used to generate a new mqMsgStream object
@@ -73,6 +77,7 @@ func NewMqMsgStream(ctx context.Context,
 	producerChannels := make([]string, 0)
 	consumerChannels := make([]string, 0)
 	receiveBuf := make(chan *MsgPack, receiveBufSize)
+	deadLetterCfg := getDeadLetterConfig()
 
 	stream := &mqMsgStream{
 		ctx:              streamCtx,
@@ -90,6 +95,10 @@ func NewMqMsgStream(ctx context.Context,
 		consumerLock: &sync.Mutex{},
 		closeRWMutex: &sync.RWMutex{},
 		closed:       0,
+
+		deadLetterPolicy: deadLetterCfg.Policy,
+		deadLetter:       newDeadLetterRecorder(deadLetterCfg, client),
+		produceBatchCfg:  getProduceBatchConfig(),
 	}
 
 	return stream, nil
@@ -198,6 +207,9 @@ func (ms *mqMsgStream) Close() {
 			consumer.Close()
 		}
 	}
+	if ms.deadLetter != nil {
+		ms.deadLetter.Close()
+	}
 
 	ms.client.Close()
 
@@ -260,6 +272,12 @@ func (ms *mqMsgStream) Produce(msgPack *MsgPack) error {
 	}
 	for k, v := range result {
 		channel := ms.producerChannels[k]
+		if ms.produceBatchCfg.Enable {
+			if err := ms.produceBatch(channel, v.Msgs); err != nil {
+				return err
+			}
+			continue
+		}
 		for i := 0; i < len(v.Msgs); i++ {
 			sp, spanCtx := MsgSpanFromCtx(v.Msgs[i].TraceCtx(), v.Msgs[i])
 
@@ -445,22 +463,44 @@ func (ms *mqMsgStream) BroadcastMark(msgPack *MsgPack) (map[string][]MessageID,
 	return ids, nil
 }
 
-func (ms *mqMsgStream) getTsMsgFromConsumerMsg(msg mqwrapper.Message) (TsMsg, error) {
+// errBadMsgHeader means the header of a consumed message can not be decoded
+var errBadMsgHeader = errors.New("bad message header")
+
+func (ms *mqMsgStream) unmarshalConsumerMsg(payload []byte) (TsMsg, error) {
 	header := commonpb.MsgHeader{}
-	if msg.Payload() == nil {
-		return nil, fmt.Errorf("failed to unmarshal message header, payload is empty")
+	if payload == nil {
+		return nil, fmt.Errorf("failed to unmarshal message header, payload is empty: %w", errBadMsgHeader)
 	}
-	err := proto.Unmarshal(msg.Payload(), &header)
+	err := proto.Unmarshal(payload, &header)
 	if err != nil {
-		return nil, fmt.Errorf("failed to unmarshal message header, err %s", err.Error())
+		return nil, fmt.Errorf("failed to unmarshal message header, err %s: %w", err.Error(), errBadMsgHeader)
 	}
 	if header.Base == nil {
-		return nil, fmt.Errorf("failed to unmarshal message, header is uncomplete")
+		return nil, fmt.Errorf("failed to unmarshal message, header is uncomplete: %w", errBadMsgHeader)
 	}
-	tsMsg, err := ms.unmarshal.Unmarshal(msg.Payload(), header.Base.MsgType)
+	tsMsg, err := ms.unmarshal.Unmarshal(payload, header.Base.MsgType)
 	if err != nil {
 		return nil, fmt.Errorf("failed to unmarshal tsMsg, err %s", err.Error())
 	}
+	return tsMsg, nil
+}
+
+// deadLetterReason returns the reason label of an error returned by getTsMsgsFromConsumerMsg
+func deadLetterReason(err error) string {
+	if errors.Is(err, errBadMsgHeader) {
+		return deadLetterReasonHeader
+	}
+	if errors.Is(err, errBadMsgBatch) {
+		return deadLetterReasonBatch
+	}
+	return deadLetterReasonBody
+}
+
+func (ms *mqMsgStream) getTsMsgFromConsumerMsg(msg mqwrapper.Message) (TsMsg, error) {
+	tsMsg, err := ms.unmarshalConsumerMsg(msg.Payload())
+	if err != nil {
+		return nil, err
+	}
 
 	// set msg info to tsMsg
 	tsMsg.SetPosition(&MsgPosition{
@@ -491,28 +531,37 @@ func (ms *mqMsgStream) receiveMsg(consumer mqwrapper.Consumer) {
 				log.Warn("MqMsgStream get msg whose payload is nil")
 				continue
 			}
-			tsMsg, err := ms.getTsMsgFromConsumerMsg(msg)
+			tsMsgs, err := ms.getTsMsgsFromConsumerMsg(msg)
 			if err != nil {
-				log.Error("Failed to getTsMsgFromConsumerMsg", zap.Error(err))
+				ms.handleDeadLetter(msg, consumer.Subscription(), deadLetterReason(err), err)
 				continue
 			}
-			pos := tsMsg.Position()
-			tsMsg.SetPosition(&MsgPosition{
-				ChannelName: pos.ChannelName,
-				MsgID:       pos.MsgID,
-				MsgGroup:    consumer.Subscription(),
-				Timestamp:   tsMsg.BeginTs(),
-			})
-
-			sp, ok := ExtractFromPulsarMsgProperties(tsMsg, msg.Properties())
-			if ok {
-				tsMsg.SetTraceCtx(opentracing.ContextWithSpan(context.Background(), sp))
+			if len(tsMsgs) == 0 {
+				log.Warn("MqMsgStream get msg without any TsMsg", zap.String("topic", msg.Topic()))
+				continue
+			}
+			spans := make([]opentracing.Span, 0, len(tsMsgs))
+			for _, tsMsg := range tsMsgs {
+				pos := tsMsg.Position()
+				tsMsg.SetPosition(&MsgPosition{
+					ChannelName: pos.ChannelName,
+					MsgID:       pos.MsgID,
+					MsgGroup:    consumer.Subscription(),
+					Timestamp:   tsMsg.BeginTs(),
+				})
+
+				sp, ok := ExtractFromPulsarMsgProperties(tsMsg, msg.Properties())
+				if ok {
+					tsMsg.SetTraceCtx(opentracing.ContextWithSpan(context.Background(), sp))
+				}
+				spans = append(spans, sp)
 			}
 
+			// all msgs of a batch are delivered in one pack, seeking to its position skips the whole batch
 			msgPack := MsgPack{
-				Msgs:           []TsMsg{tsMsg},
-				StartPositions: []*internalpb.MsgPosition{tsMsg.Position()},
-				EndPositions:   []*internalpb.MsgPosition{tsMsg.Position()},
+				Msgs:           tsMsgs,
+				StartPositions: []*internalpb.MsgPosition{tsMsgs[0].Position()},
+				EndPositions:   []*internalpb.MsgPosition{tsMsgs[len(tsMsgs)-1].Position()},
 			}
 			select {
 			case ms.receiveBuf <- &msgPack:
@@ -520,7 +569,9 @@ func (ms *mqMsgStream) receiveMsg(consumer mqwrapper.Consumer) {
 				return
 			}
 
-			sp.Finish()
+			for _, sp := range spans {
+				sp.Finish()
+			}
 		}
 	}
 }
@@ -804,29 +855,32 @@ func (ms *MqTtMsgStream) consumeToTtMsg(consumer mqwrapper.Consumer) {
 				log.Warn("MqTtMsgStream get msg whose payload is nil")
 				continue
 			}
-			tsMsg, err := ms.getTsMsgFromConsumerMsg(msg)
+			tsMsgs, err := ms.getTsMsgsFromConsumerMsg(msg)
 			if err != nil {
-				log.Error("Failed to getTsMsgFromConsumerMsg", zap.Error(err))
+				ms.handleDeadLetter(msg, consumer.Subscription(), deadLetterReason(err), err)
 				continue
 			}
 
-			sp, ok := ExtractFromPulsarMsgProperties(tsMsg, msg.Properties())
-			if ok {
-				tsMsg.SetTraceCtx(opentracing.ContextWithSpan(context.Background(), sp))
-			}
+			// a time tick is never batched, so it is always the only msg
+			for _, tsMsg := range tsMsgs {
+				sp, ok := ExtractFromPulsarMsgProperties(tsMsg, msg.Properties())
+				if ok {
+					tsMsg.SetTraceCtx(opentracing.ContextWithSpan(context.Background(), sp))
+				}
 
-			ms.chanMsgBufMutex.Lock()
-			ms.chanMsgBuf[consumer] = append(ms.chanMsgBuf[consumer], tsMsg)
-			ms.chanMsgBufMutex.Unlock()
+				ms.chanMsgBufMutex.Lock()
+				ms.chanMsgBuf[consumer] = append(ms.chanMsgBuf[consumer], tsMsg)
+				ms.chanMsgBufMutex.Unlock()
 
-			if tsMsg.Type() == commonpb.MsgType_TimeTick {
-				ms.chanTtMsgTimeMutex.Lock()
-				ms.chanTtMsgTime[consumer] = tsMsg.(*TimeTickMsg).Base.Timestamp
-				ms.chanTtMsgTimeMutex.Unlock()
+				if tsMsg.Type() == commonpb.MsgType_TimeTick {
+					ms.chanTtMsgTimeMutex.Lock()
+					ms.chanTtMsgTime[consumer] = tsMsg.(*TimeTickMsg).Base.Timestamp
+					ms.chanTtMsgTimeMutex.Unlock()
+					sp.Finish()
+					return
+				}
 				sp.Finish()
-				return
 			}
-			sp.Finish()
 		}
 	}
 }
@@ -919,24 +973,20 @@ func (ms *MqTtMsgStream) Seek(msgPositions []*internalpb.MsgPosition) error {
 				}
 				consumer.Ack(msg)
 
-				headerMsg := commonpb.MsgHeader{}
-				err := proto.Unmarshal(msg.Payload(), &headerMsg)
+				// skip the message which can not be unmarshaled rather than failing the seek
+				tsMsgs, err := ms.getTsMsgsFromConsumerMsg(msg)
 				if err != nil {
-					return fmt.Errorf("failed to unmarshal message header, err %s", err.Error())
-				}
-				tsMsg, err := ms.unmarshal.Unmarshal(msg.Payload(), headerMsg.Base.MsgType)
-				if err != nil {
-					return fmt.Errorf("failed to unmarshal tsMsg, err %s", err.Error())
+					ms.handleDeadLetter(msg, consumer.Subscription(), deadLetterReason(err), err)
+					continue
 				}
-				if tsMsg.Type() == commonpb.MsgType_TimeTick && tsMsg.BeginTs() >= mp.Timestamp {
-					runLoop = false
-					break
-				} else if tsMsg.BeginTs() > mp.Timestamp {
-					tsMsg.SetPosition(&MsgPosition{
-						ChannelName: filepath.Base(msg.Topic()),
-						MsgID:       msg.ID().Serialize(),
-					})
-					ms.chanMsgBuf[consumer] = append(ms.chanMsgBuf[consumer], tsMsg)
+				// msgs of a batch share the position, the ones consumed before are skipped by timestamp
+				for _, tsMsg := range tsMsgs {
+					if tsMsg.Type() == commonpb.MsgType_TimeTick && tsMsg.BeginTs() >= mp.Timestamp {
+						runLoop = false
+						break
+					} else if tsMsg.BeginTs() > mp.Timestamp {
+						ms.chanMsgBuf[consumer] = append(ms.chanMsgBuf[consumer], tsMsg)
+					}
 				}
 			}
 		}
@@ -0,0 +1,101 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mem
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/milvus-io/milvus/internal/mq/msgstream/mqwrapper"
+	"github.com/milvus-io/milvus/internal/util/paramtable"
+)
+
+// Check memClient implements Client interface
+var _ mqwrapper.Client = (*memClient)(nil)
+
+// memClient implements the mqwrapper Client on an in-process Server,
+// it's used by tests and the embedded mode to run without any external message queue.
+type memClient struct {
+	server *Server
+}
+
+// NewClient creates a client of @server.
+func NewClient(server *Server) (*memClient, error) {
+	if server == nil {
+		return nil, fmt.Errorf("memory mq server is nil")
+	}
+	return &memClient{server: server}, nil
+}
+
+// NewClientWithConfig creates a client of the default server, which is created with the memmq config of milvus.
+func NewClientWithConfig(config *paramtable.MemmqConfig) (*memClient, error) {
+	return NewClient(DefaultServer(RetentionOptions{
+		MaxAge:   time.Duration(config.RetentionTimeInMinutes) * time.Minute,
+		MaxBytes: config.RetentionSizeInMB * 1024 * 1024,
+	}))
+}
+
+// CreateProducer creates a producer of the topic, the topic is created if not exists.
+func (mc *memClient) CreateProducer(options mqwrapper.ProducerOptions) (mqwrapper.Producer, error) {
+	t, err := mc.server.getTopic(options.Topic)
+	if err != nil {
+		return nil, err
+	}
+	return &memProducer{topic: t}, nil
+}
+
+// Subscribe creates a consumer of the topic, the topic is created if not exists.
+func (mc *memClient) Subscribe(options mqwrapper.ConsumerOptions) (mqwrapper.Consumer, error) {
+	if options.SubscriptionName == "" {
+		return nil, fmt.Errorf("subscription name is empty")
+	}
+	t, err := mc.server.getTopic(options.Topic)
+	if err != nil {
+		return nil, err
+	}
+	if err := t.subscribe(options.SubscriptionName, options.SubscriptionInitialPosition); err != nil {
+		return nil, err
+	}
+	return newMemConsumer(t, options), nil
+}
+
+// EarliestMessageID returns the id before the first message of topics.
+func (mc *memClient) EarliestMessageID() mqwrapper.MessageID {
+	return &memID{messageID: 0}
+}
+
+// StringToMsgID converts the string of message id to MessageID.
+func (mc *memClient) StringToMsgID(id string) (mqwrapper.MessageID, error) {
+	messageID, err := strconv.ParseInt(id, 10, 64)
+	if err != nil {
+		return nil, err
+	}
+	return &memID{messageID: messageID}, nil
+}
+
+// BytesToMsgID converts the serialized message id to MessageID.
+func (mc *memClient) BytesToMsgID(id []byte) (mqwrapper.MessageID, error) {
+	if len(id) != 8 {
+		return nil, fmt.Errorf("invalid memory mq message id length: %d", len(id))
+	}
+	return &memID{messageID: DeserializeMemID(id)}, nil
+}
+
+// Close does nothing, the server outlives its clients.
+func (mc *memClient) Close() {
+}
@@ -0,0 +1,287 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mem
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/milvus-io/milvus/internal/mq/msgstream/mqwrapper"
+	"github.com/milvus-io/milvus/internal/util/paramtable"
+)
+
+func createMemClient(t *testing.T, retention RetentionOptions) *memClient {
+	client, err := NewClient(NewServer(retention))
+	require.NoError(t, err)
+	return client
+}
+
+func produceData(ctx context.Context, t *testing.T, producer mqwrapper.Producer, arr []int) []mqwrapper.MessageID {
+	var ids []mqwrapper.MessageID
+	for _, v := range arr {
+		id, err := producer.Send(ctx, &mqwrapper.ProducerMessage{
+			Payload:    []byte(fmt.Sprint(v)),
+			Properties: map[string]string{"key": fmt.Sprint(v)},
+		})
+		require.NoError(t, err)
+		ids = append(ids, id)
+	}
+	return ids
+}
+
+func consumeData(t *testing.T, consumer mqwrapper.Consumer, n int) []string {
+	var payloads []string
+	for i := 0; i < n; i++ {
+		select {
+		case msg := <-consumer.Chan():
+			payloads = append(payloads, string(msg.Payload()))
+			consumer.Ack(msg)
+		case <-time.After(10 * time.Second):
+			t.Fatal("consume timeout")
+		}
+	}
+	return payloads
+}
+
+func assertNoMessage(t *testing.T, consumer mqwrapper.Consumer) {
+	select {
+	case msg := <-consumer.Chan():
+		t.Fatalf("unexpected message %s", msg.Payload())
+	case <-time.After(100 * time.Millisecond):
+	}
+}
+
+func subscribe(t *testing.T, client *memClient, topic string, subName string, position mqwrapper.SubscriptionInitialPosition) mqwrapper.Consumer {
+	consumer, err := client.Subscribe(mqwrapper.ConsumerOptions{
+		Topic:                       topic,
+		SubscriptionName:            subName,
+		SubscriptionInitialPosition: position,
+		BufSize:                     16,
+	})
+	require.NoError(t, err)
+	return consumer
+}
+
+func TestMemClient_ProduceConsume(t *testing.T) {
+	ctx := context.Background()
+	client := createMemClient(t, RetentionOptions{})
+	defer client.Close()
+
+	producer, err := client.CreateProducer(mqwrapper.ProducerOptions{Topic: "topic"})
+	require.NoError(t, err)
+	defer producer.Close()
+	assert.Equal(t, "topic", producer.(*memProducer).Topic())
+	ids := produceData(ctx, t, producer, []int{1, 2, 3})
+	assert.Equal(t, int64(1), ids[0].(*memID).messageID)
+	assert.Equal(t, int64(3), ids[2].(*memID).messageID)
+
+	consumer := subscribe(t, client, "topic", "sub", mqwrapper.SubscriptionPositionEarliest)
+	defer consumer.Close()
+	assert.Equal(t, "sub", consumer.Subscription())
+	select {
+	case msg := <-consumer.Chan():
+		assert.Equal(t, "topic", msg.Topic())
+		assert.Equal(t, "1", string(msg.Payload()))
+		assert.Equal(t, map[string]string{"key": "1"}, msg.Properties())
+		assert.Equal(t, int64(1), msg.ID().(*memID).messageID)
+	case <-time.After(10 * time.Second):
+		t.Fatal("consume timeout")
+	}
+	assert.Equal(t, []string{"2", "3"}, consumeData(t, consumer, 2))
+
+	// messages produced later are delivered as well
+	produceData(ctx, t, producer, []int{4})
+	assert.Equal(t, []string{"4"}, consumeData(t, consumer, 1))
+
+	latest, err := consumer.GetLatestMsgID()
+	assert.NoError(t, err)
+	assert.Equal(t, int64(4), latest.(*memID).messageID)
+
+	_, err = producer.Send(ctx, &mqwrapper.ProducerMessage{})
+	assert.NoError(t, err)
+	canceled, cancel := context.WithCancel(ctx)
+	cancel()
+	_, err = producer.Send(canceled, &mqwrapper.ProducerMessage{})
+	assert.Error(t, err)
+	// msgstream sends msgs without trace context with a nil ctx
+	_, err = producer.Send(nil, &mqwrapper.ProducerMessage{}) //nolint:staticcheck
+	assert.NoError(t, err)
+}
+
+func TestMemClient_SubscriptionPosition(t *testing.T) {
+	ctx := context.Background()
+	client := createMemClient(t, RetentionOptions{})
+	producer, err := client.CreateProducer(mqwrapper.ProducerOptions{Topic: "topic"})
+	require.NoError(t, err)
+	produceData(ctx, t, producer, []int{1, 2})
+
+	latest := subscribe(t, client, "topic", "latest", mqwrapper.SubscriptionPositionLatest)
+	defer latest.Close()
+	assertNoMessage(t, latest)
+	produceData(ctx, t, producer, []int{3})
+	assert.Equal(t, []string{"3"}, consumeData(t, latest, 1))
+
+	// consumers of the same group share the position
+	earliest := subscribe(t, client, "topic", "earliest", mqwrapper.SubscriptionPositionEarliest)
+	assert.Equal(t, []string{"1", "2", "3"}, consumeData(t, earliest, 3))
+	another := subscribe(t, client, "topic", "earliest", mqwrapper.SubscriptionPositionUnknown)
+	earliest.Close()
+	produceData(ctx, t, producer, []int{4})
+	assert.Equal(t, []string{"4"}, consumeData(t, another, 1))
+	another.Close()
+
+	// the group is removed with its last consumer
+	renewed := subscribe(t, client, "topic", "earliest", mqwrapper.SubscriptionPositionUnknown)
+	defer renewed.Close()
+	assert.Equal(t, []string{"1", "2", "3", "4"}, consumeData(t, renewed, 4))
+
+	_, err = client.Subscribe(mqwrapper.ConsumerOptions{Topic: "topic"})
+	assert.Error(t, err)
+	_, err = client.Subscribe(mqwrapper.ConsumerOptions{SubscriptionName: "sub"})
+	assert.Error(t, err)
+}
+
+func TestMemClient_Seek(t *testing.T) {
+	ctx := context.Background()
+	client := createMemClient(t, RetentionOptions{})
+	producer, err := client.CreateProducer(mqwrapper.ProducerOptions{Topic: "topic"})
+	require.NoError(t, err)
+	ids := produceData(ctx, t, producer, []int{1, 2, 3, 4})
+
+	consumer := subscribe(t, client, "topic", "inclusive", mqwrapper.SubscriptionPositionUnknown)
+	assert.NoError(t, consumer.Seek(ids[1], true))
+	assert.Equal(t, []string{"2", "3", "4"}, consumeData(t, consumer, 3))
+	consumer.Close()
+
+	consumer = subscribe(t, client, "topic", "exclusive", mqwrapper.SubscriptionPositionUnknown)
+	assert.NoError(t, consumer.Seek(ids[1], false))
+	assert.Equal(t, []string{"3", "4"}, consumeData(t, consumer, 2))
+	// seek again while consuming
+	assert.NoError(t, consumer.Seek(ids[0], true))
+	assert.Equal(t, []string{"1", "2", "3", "4"}, consumeData(t, consumer, 4))
+	consumer.Close()
+
+	consumer = subscribe(t, client, "topic", "earliest", mqwrapper.SubscriptionPositionUnknown)
+	assert.NoError(t, consumer.Seek(client.EarliestMessageID(), false))
+	assert.Equal(t, []string{"1", "2", "3", "4"}, consumeData(t, consumer, 4))
+	consumer.Close()
+	assert.Error(t, consumer.Seek(ids[0], true))
+}
+
+func TestMemClient_SeekInFlight(t *testing.T) {
+	ctx := context.Background()
+	client := createMemClient(t, RetentionOptions{})
+	defer client.Close()
+	producer, err := client.CreateProducer(mqwrapper.ProducerOptions{Topic: "topic"})
+	require.NoError(t, err)
+	ids := produceData(ctx, t, producer, []int{1, 2, 3, 4, 5, 6})
+
+	consumer, err := client.Subscribe(mqwrapper.ConsumerOptions{
+		Topic:                       "topic",
+		SubscriptionName:            "sub",
+		SubscriptionInitialPosition: mqwrapper.SubscriptionPositionEarliest,
+		BufSize:                     1,
+	})
+	require.NoError(t, err)
+	defer consumer.Close()
+	assert.Equal(t, []string{"1"}, consumeData(t, consumer, 1))
+	// the deliverer blocks on the rest of the fetched batch once the buffer is full
+	assert.Eventually(t, func() bool { return len(consumer.Chan()) == 1 }, time.Second, 10*time.Millisecond)
+
+	assert.NoError(t, consumer.Seek(ids[4], true))
+	// the buffered message is delivered, the rest of the batch is dropped
+	assert.Equal(t, []string{"2", "5", "6"}, consumeData(t, consumer, 3))
+	assertNoMessage(t, consumer)
+}
+
+func TestMemClient_Retention(t *testing.T) {
+	ctx := context.Background()
+	client := createMemClient(t, RetentionOptions{MaxBytes: 2})
+	producer, err := client.CreateProducer(mqwrapper.ProducerOptions{Topic: "topic"})
+	require.NoError(t, err)
+	produceData(ctx, t, producer, []int{1, 2, 3, 4})
+	consumer := subscribe(t, client, "topic", "sub", mqwrapper.SubscriptionPositionEarliest)
+	assert.Equal(t, []string{"3", "4"}, consumeData(t, consumer, 2))
+	consumer.Close()
+
+	client = createMemClient(t, RetentionOptions{MaxAge: 50 * time.Millisecond})
+	producer, err = client.CreateProducer(mqwrapper.ProducerOptions{Topic: "topic"})
+	require.NoError(t, err)
+	produceData(ctx, t, producer, []int{1, 2})
+	time.Sleep(100 * time.Millisecond)
+	produceData(ctx, t, producer, []int{3})
+	consumer = subscribe(t, client, "topic", "sub", mqwrapper.SubscriptionPositionEarliest)
+	defer consumer.Close()
+	// a seek position out of retention starts from the first retained message
+	assert.NoError(t, consumer.Seek(&memID{messageID: 1}, true))
+	assert.Equal(t, []string{"3"}, consumeData(t, consumer, 1))
+	latest, err := consumer.GetLatestMsgID()
+	assert.NoError(t, err)
+	assert.Equal(t, int64(3), latest.(*memID).messageID)
+}
+
+func TestMemClient_DestroyTopic(t *testing.T) {
+	ctx := context.Background()
+	server := NewServer(RetentionOptions{})
+	client, err := NewClient(server)
+	require.NoError(t, err)
+	producer, err := client.CreateProducer(mqwrapper.ProducerOptions{Topic: "topic"})
+	require.NoError(t, err)
+	consumer := subscribe(t, client, "topic", "sub", mqwrapper.SubscriptionPositionEarliest)
+	consumer.Chan()
+	assert.ElementsMatch(t, []string{"topic"}, server.Topics())
+
+	server.DestroyTopic("topic")
+	assert.Empty(t, server.Topics())
+	_, err = producer.Send(ctx, &mqwrapper.ProducerMessage{Payload: []byte("1")})
+	assert.Error(t, err)
+	consumer.Close()
+	_, ok := <-consumer.Chan()
+	assert.False(t, ok)
+
+	server.Close()
+	_, err = client.CreateProducer(mqwrapper.ProducerOptions{Topic: "topic"})
+	assert.ErrorIs(t, err, ErrServerClosed)
+	_, err = client.CreateProducer(mqwrapper.ProducerOptions{})
+	assert.Error(t, err)
+	_, err = NewClient(nil)
+	assert.Error(t, err)
+}
+
+func TestMemClient_MsgID(t *testing.T) {
+	client, err := NewClientWithConfig(&paramtable.MemmqConfig{RetentionTimeInMinutes: 1})
+	require.NoError(t, err)
+	assert.Equal(t, DefaultServer(RetentionOptions{}), client.server)
+	assert.True(t, client.EarliestMessageID().AtEarliestPosition())
+
+	id, err := client.StringToMsgID("10")
+	assert.NoError(t, err)
+	assert.Equal(t, int64(10), id.(*memID).messageID)
+	_, err = client.StringToMsgID("x")
+	assert.Error(t, err)
+
+	id, err = client.BytesToMsgID(SerializeMemID(20))
+	assert.NoError(t, err)
+	assert.Equal(t, int64(20), id.(*memID).messageID)
+	_, err = client.BytesToMsgID([]byte{1})
+	assert.Error(t, err)
+}
@@ -0,0 +1,137 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mem
+
+import (
+	"sync"
+
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/mq/msgstream/mqwrapper"
+)
+
+// Check memConsumer implements Consumer
+var _ mqwrapper.Consumer = (*memConsumer)(nil)
+
+// fetchBatchSize is the max number of messages fetched from the topic at once
+const fetchBatchSize = 128
+
+// memConsumer consumes the topic from the position of its consumer group,
+// consumers of the same group share the position so each message is delivered to one of them.
+type memConsumer struct {
+	topic   *topic
+	subName string
+
+	msgChannel chan mqwrapper.Message
+	chanOnce   sync.Once
+	closeOnce  sync.Once
+	closeCh    chan struct{}
+	wg         sync.WaitGroup
+}
+
+func newMemConsumer(t *topic, options mqwrapper.ConsumerOptions) *memConsumer {
+	bufSize := options.BufSize
+	if bufSize <= 0 {
+		bufSize = 1024
+	}
+	return &memConsumer{
+		topic:      t,
+		subName:    options.SubscriptionName,
+		msgChannel: make(chan mqwrapper.Message, bufSize),
+		closeCh:    make(chan struct{}),
+	}
+}
+
+// Subscription returns the subscription name.
+func (mc *memConsumer) Subscription() string {
+	return mc.subName
+}
+
+func (mc *memConsumer) deliver() {
+	defer mc.wg.Done()
+	for {
+		msgs, notify, seeked, err := mc.topic.fetch(mc.subName, fetchBatchSize)
+		if err != nil {
+			log.Warn("memory mq consumer stops delivering", zap.String("topic", mc.topic.name),
+				zap.String("subName", mc.subName), zap.Error(err))
+			return
+		}
+	deliverLoop:
+		for _, msg := range msgs {
+			// drop the rest of the batch once the group is seeked, the group is fetched again from the new position
+			select {
+			case <-seeked:
+				break deliverLoop
+			default:
+			}
+			select {
+			case mc.msgChannel <- &memMessage{topic: mc.topic.name, msg: msg}:
+			case <-seeked:
+				break deliverLoop
+			case <-mc.closeCh:
+				return
+			}
+		}
+		if len(msgs) > 0 {
+			continue
+		}
+		select {
+		case <-notify:
+		case <-mc.closeCh:
+			return
+		}
+	}
+}
+
+// Chan starts delivering and returns the message channel.
+func (mc *memConsumer) Chan() <-chan mqwrapper.Message {
+	mc.chanOnce.Do(func() {
+		mc.wg.Add(1)
+		go mc.deliver()
+	})
+	return mc.msgChannel
+}
+
+// Seek sets the position of the consumer group.
+func (mc *memConsumer) Seek(id mqwrapper.MessageID, inclusive bool) error {
+	messageID := id.(*memID).messageID
+	if !inclusive {
+		messageID++
+	}
+	log.Info("memory mq consumer seek", zap.String("topic", mc.topic.name), zap.Int64("start id", messageID), zap.Bool("inclusive", inclusive))
+	return mc.topic.seek(mc.subName, messageID)
+}
+
+// Ack does nothing, messages are retained by the retention of the server.
+func (mc *memConsumer) Ack(message mqwrapper.Message) {
+}
+
+// GetLatestMsgID returns the id of the last message in the topic.
+func (mc *memConsumer) GetLatestMsgID() (mqwrapper.MessageID, error) {
+	return &memID{messageID: mc.topic.latestID()}, nil
+}
+
+// Close stops delivering and leaves the consumer group, the group is removed with its last consumer.
+func (mc *memConsumer) Close() {
+	mc.closeOnce.Do(func() {
+		close(mc.closeCh)
+		mc.wg.Wait()
+		mc.topic.unsubscribe(mc.subName)
+		close(mc.msgChannel)
+	})
+}
@@ -0,0 +1,63 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mem
+
+import (
+	"github.com/milvus-io/milvus/internal/common"
+	"github.com/milvus-io/milvus/internal/mq/msgstream/mqwrapper"
+)
+
+// memID wraps the id of a message in the memory mq, ids start from 1
+// and 0 means the position before the first message.
+type memID struct {
+	messageID int64
+}
+
+// Check memID implements MessageID interface
+var _ mqwrapper.MessageID = &memID{}
+
+// Serialize convert memID to a byte slice
+func (mid *memID) Serialize() []byte {
+	return SerializeMemID(mid.messageID)
+}
+
+// AtEarliestPosition returns true if the id is before the first message
+func (mid *memID) AtEarliestPosition() bool {
+	return mid.messageID <= 0
+}
+
+// LessOrEqualThan compares the message ids
+func (mid *memID) LessOrEqualThan(msgID []byte) (bool, error) {
+	return mid.messageID <= DeserializeMemID(msgID), nil
+}
+
+// Equal compares the message ids
+func (mid *memID) Equal(msgID []byte) (bool, error) {
+	return mid.messageID == DeserializeMemID(msgID), nil
+}
+
+// SerializeMemID encodes the message id to a byte slice
+func SerializeMemID(messageID int64) []byte {
+	b := make([]byte, 8)
+	common.Endian.PutUint64(b, uint64(messageID))
+	return b
+}
+
+// DeserializeMemID decodes the message id from a byte slice
+func DeserializeMemID(messageID []byte) int64 {
+	return int64(common.Endian.Uint64(messageID))
+}
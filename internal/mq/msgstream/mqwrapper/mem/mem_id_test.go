@@ -0,0 +1,44 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mem
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMemID(t *testing.T) {
+	id := &memID{messageID: 5}
+	assert.Equal(t, int64(5), DeserializeMemID(id.Serialize()))
+	assert.False(t, id.AtEarliestPosition())
+	assert.True(t, (&memID{}).AtEarliestPosition())
+
+	ret, err := id.LessOrEqualThan(SerializeMemID(5))
+	assert.NoError(t, err)
+	assert.True(t, ret)
+	ret, err = id.LessOrEqualThan(SerializeMemID(4))
+	assert.NoError(t, err)
+	assert.False(t, ret)
+
+	ret, err = id.Equal(SerializeMemID(5))
+	assert.NoError(t, err)
+	assert.True(t, ret)
+	ret, err = id.Equal(SerializeMemID(6))
+	assert.NoError(t, err)
+	assert.False(t, ret)
+}
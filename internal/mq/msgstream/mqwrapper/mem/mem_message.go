@@ -0,0 +1,50 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mem
+
+import (
+	"github.com/milvus-io/milvus/internal/mq/msgstream/mqwrapper"
+)
+
+// Check memMessage implements ConsumerMessage
+var _ mqwrapper.Message = (*memMessage)(nil)
+
+// memMessage wraps the message stored in the memory mq
+type memMessage struct {
+	topic string
+	msg   *message
+}
+
+// Topic returns the topic name of the message
+func (mm *memMessage) Topic() string {
+	return mm.topic
+}
+
+// Properties returns the properties of the message
+func (mm *memMessage) Properties() map[string]string {
+	return mm.msg.properties
+}
+
+// Payload returns the payload of the message
+func (mm *memMessage) Payload() []byte {
+	return mm.msg.payload
+}
+
+// ID returns the id of the message
+func (mm *memMessage) ID() mqwrapper.MessageID {
+	return &memID{messageID: mm.msg.id}
+}
@@ -0,0 +1,62 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mem
+
+import (
+	"context"
+
+	"github.com/milvus-io/milvus/internal/mq/msgstream/mqwrapper"
+)
+
+// Check memProducer implements Producer
+var _ mqwrapper.Producer = (*memProducer)(nil)
+
+// memProducer appends messages to the topic in the memory mq
+type memProducer struct {
+	topic *topic
+}
+
+// Topic returns the topic of the producer
+func (mp *memProducer) Topic() string {
+	return mp.topic.name
+}
+
+// Send appends the message to the topic, the payload and properties are copied.
+func (mp *memProducer) Send(ctx context.Context, message *mqwrapper.ProducerMessage) (mqwrapper.MessageID, error) {
+	// msgstream passes a nil ctx for msgs without trace context
+	if ctx != nil && ctx.Err() != nil {
+		return nil, ctx.Err()
+	}
+	payload := make([]byte, len(message.Payload))
+	copy(payload, message.Payload)
+	var properties map[string]string
+	if len(message.Properties) > 0 {
+		properties = make(map[string]string, len(message.Properties))
+		for k, v := range message.Properties {
+			properties[k] = v
+		}
+	}
+	id, err := mp.topic.produce(payload, properties)
+	if err != nil {
+		return nil, err
+	}
+	return &memID{messageID: id}, nil
+}
+
+// Close does nothing, the topic is owned by the server
+func (mp *memProducer) Close() {
+}
@@ -0,0 +1,298 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mem
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/milvus-io/milvus/internal/mq/msgstream/mqwrapper"
+)
+
+// ErrServerClosed is returned when operating a closed Server.
+var ErrServerClosed = errors.New("memory mq server is closed")
+
+// RetentionOptions bounds the messages retained by each topic, zero means unlimited.
+type RetentionOptions struct {
+	MaxAge   time.Duration
+	MaxBytes int64
+}
+
+// Server is an in-process message broker keeping messages in memory.
+// Message ids of a topic start from 1 and increase by one, 0 is the position before the first message.
+type Server struct {
+	retention RetentionOptions
+
+	mu     sync.Mutex
+	topics map[string]*topic
+	closed bool
+}
+
+var (
+	defaultServer     *Server
+	defaultServerOnce sync.Once
+)
+
+// DefaultServer returns the Server shared in the process, it's created with @retention on first call.
+func DefaultServer(retention RetentionOptions) *Server {
+	defaultServerOnce.Do(func() {
+		defaultServer = NewServer(retention)
+	})
+	return defaultServer
+}
+
+// NewServer creates a Server.
+func NewServer(retention RetentionOptions) *Server {
+	return &Server{
+		retention: retention,
+		topics:    make(map[string]*topic),
+	}
+}
+
+// getTopic returns the topic of @name, the topic is created if not exists.
+func (s *Server) getTopic(name string) (*topic, error) {
+	if name == "" {
+		return nil, errors.New("topic name is empty")
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.closed {
+		return nil, ErrServerClosed
+	}
+	t, ok := s.topics[name]
+	if !ok {
+		t = newTopic(name, s.retention)
+		s.topics[name] = t
+	}
+	return t, nil
+}
+
+// DestroyTopic removes the topic with all its messages and consumer groups.
+func (s *Server) DestroyTopic(name string) {
+	s.mu.Lock()
+	t, ok := s.topics[name]
+	delete(s.topics, name)
+	s.mu.Unlock()
+	if ok {
+		t.close()
+	}
+}
+
+// Topics returns the names of existing topics.
+func (s *Server) Topics() []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	names := make([]string, 0, len(s.topics))
+	for name := range s.topics {
+		names = append(names, name)
+	}
+	return names
+}
+
+// Close removes all topics, consumers of the server are closed.
+func (s *Server) Close() {
+	s.mu.Lock()
+	topics := s.topics
+	s.topics = make(map[string]*topic)
+	s.closed = true
+	s.mu.Unlock()
+	for _, t := range topics {
+		t.close()
+	}
+}
+
+type message struct {
+	id          int64
+	payload     []byte
+	properties  map[string]string
+	publishTime time.Time
+}
+
+type group struct {
+	// next is the id of the next message to deliver
+	next int64
+	// refs counts the consumers of the group, the group is removed when the last consumer is closed
+	refs int
+	// seeked is closed and replaced when the group is seeked, the fetched messages not delivered yet are dropped
+	seeked chan struct{}
+}
+
+type topic struct {
+	name      string
+	retention RetentionOptions
+
+	mu       sync.Mutex
+	messages []*message // retained messages, sorted by id
+	bytes    int64
+	lastID   int64
+	groups   map[string]*group
+	// notify is closed and replaced when messages are produced
+	notify chan struct{}
+	closed bool
+}
+
+func newTopic(name string, retention RetentionOptions) *topic {
+	return &topic{
+		name:      name,
+		retention: retention,
+		groups:    make(map[string]*group),
+		notify:    make(chan struct{}),
+	}
+}
+
+func (t *topic) produce(payload []byte, properties map[string]string) (int64, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if t.closed {
+		return 0, fmt.Errorf("topic %s is destroyed", t.name)
+	}
+	t.lastID++
+	t.messages = append(t.messages, &message{
+		id:          t.lastID,
+		payload:     payload,
+		properties:  properties,
+		publishTime: time.Now(),
+	})
+	t.bytes += int64(len(payload))
+	t.expireLocked(time.Now())
+	t.notifyLocked()
+	return t.lastID, nil
+}
+
+// notifyLocked wakes up the consumers waiting for messages.
+func (t *topic) notifyLocked() {
+	close(t.notify)
+	t.notify = make(chan struct{})
+}
+
+// expireLocked drops the messages out of retention, the last message is always kept.
+func (t *topic) expireLocked(now time.Time) {
+	n := 0
+	for n < len(t.messages)-1 {
+		m := t.messages[n]
+		expired := t.retention.MaxAge > 0 && now.Sub(m.publishTime) > t.retention.MaxAge
+		oversize := t.retention.MaxBytes > 0 && t.bytes > t.retention.MaxBytes
+		if !expired && !oversize {
+			break
+		}
+		t.bytes -= int64(len(m.payload))
+		t.messages[n] = nil
+		n++
+	}
+	if n > 0 {
+		t.messages = t.messages[n:]
+	}
+}
+
+// subscribe adds a consumer to @groupName, the group is created at @position if not exists.
+// An existing group keeps its position unless @position is latest.
+func (t *topic) subscribe(groupName string, position mqwrapper.SubscriptionInitialPosition) error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if t.closed {
+		return fmt.Errorf("topic %s is destroyed", t.name)
+	}
+	g, ok := t.groups[groupName]
+	if !ok {
+		g = &group{seeked: make(chan struct{})}
+		t.groups[groupName] = g
+	}
+	g.refs++
+	if position == mqwrapper.SubscriptionPositionLatest {
+		g.next = t.lastID + 1
+	}
+	return nil
+}
+
+func (t *topic) unsubscribe(groupName string) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	g, ok := t.groups[groupName]
+	if !ok {
+		return
+	}
+	g.refs--
+	if g.refs <= 0 {
+		delete(t.groups, groupName)
+	}
+}
+
+func (t *topic) seek(groupName string, id int64) error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	g, ok := t.groups[groupName]
+	if !ok {
+		return fmt.Errorf("consumer group %s of topic %s not exist", groupName, t.name)
+	}
+	g.next = id
+	close(g.seeked)
+	g.seeked = make(chan struct{})
+	// consumers of the group may be waiting at the old position
+	t.notifyLocked()
+	return nil
+}
+
+// fetch returns at most @limit messages from the position of @groupName and moves the position forward,
+// the returned notify channel is closed when new messages are produced or the group is seeked,
+// the returned seeked channel is closed when the group is seeked, the messages are stale since then.
+func (t *topic) fetch(groupName string, limit int) (msgs []*message, notify <-chan struct{}, seeked <-chan struct{}, err error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if t.closed {
+		return nil, nil, nil, fmt.Errorf("topic %s is destroyed", t.name)
+	}
+	g, ok := t.groups[groupName]
+	if !ok {
+		return nil, nil, nil, fmt.Errorf("consumer group %s of topic %s not exist", groupName, t.name)
+	}
+	t.expireLocked(time.Now())
+	if len(t.messages) == 0 || g.next > t.lastID {
+		return nil, t.notify, g.seeked, nil
+	}
+	start := int64(0)
+	if first := t.messages[0].id; g.next > first {
+		start = g.next - first
+	}
+	end := start + int64(limit)
+	if end > int64(len(t.messages)) {
+		end = int64(len(t.messages))
+	}
+	msgs = make([]*message, end-start)
+	copy(msgs, t.messages[start:end])
+	g.next = msgs[len(msgs)-1].id + 1
+	return msgs, t.notify, g.seeked, nil
+}
+
+func (t *topic) latestID() int64 {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return t.lastID
+}
+
+func (t *topic) close() {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if t.closed {
+		return
+	}
+	t.closed = true
+	t.messages = nil
+	t.groups = make(map[string]*group)
+	close(t.notify)
+}
@@ -0,0 +1,178 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package nats
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/nats-io/nats.go"
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/mq/msgstream/mqwrapper"
+	"github.com/milvus-io/milvus/internal/util/paramtable"
+)
+
+// Check natsClient implements Client interface
+var _ mqwrapper.Client = (*natsClient)(nil)
+
+// natsClient implements the mqwrapper Client on NATS JetStream,
+// every topic is stored in a stream with the same name, which has the topic as its only subject.
+type natsClient struct {
+	conn *nats.Conn
+	js   nats.JetStreamContext
+	// maxAge is the retention of the created streams, 0 means unlimited
+	maxAge time.Duration
+	// shared is true if the connection is owned by the caller, and kept after the client is closed
+	shared bool
+}
+
+// Connect connects to the NATS server at @address.
+func Connect(address string, opts ...nats.Option) (*nats.Conn, error) {
+	conn, err := nats.Connect(address, append([]nats.Option{nats.MaxReconnects(-1)}, opts...)...)
+	if err != nil {
+		log.Error("failed to connect to nats", zap.String("address", address), zap.Error(err))
+		return nil, err
+	}
+	return conn, nil
+}
+
+// NewClient connects to the NATS server at @address.
+func NewClient(address string, maxAge time.Duration, opts ...nats.Option) (*natsClient, error) {
+	conn, err := Connect(address, opts...)
+	if err != nil {
+		return nil, err
+	}
+	nc, err := NewClientWithConn(conn, maxAge)
+	if err != nil {
+		conn.Close()
+		return nil, err
+	}
+	nc.shared = false
+	return nc, nil
+}
+
+// NewClientWithConn creates a natsClient on the shared connection @conn, which is not closed with the client.
+func NewClientWithConn(conn *nats.Conn, maxAge time.Duration) (*natsClient, error) {
+	js, err := conn.JetStream()
+	if err != nil {
+		return nil, err
+	}
+	return &natsClient{conn: conn, js: js, maxAge: maxAge, shared: true}, nil
+}
+
+// NewClientWithConfig creates a natsClient with the nats config of milvus.
+func NewClientWithConfig(config *paramtable.NatsConfig) (*natsClient, error) {
+	return NewClient(config.Address, time.Duration(config.RetentionTimeInMinutes)*time.Minute)
+}
+
+// invalidNameChars are the characters not allowed in the names of streams and consumers.
+const invalidNameChars = " \t\r\n.*>/\\"
+
+// validateTopic checks the topic could be used as stream name and subject.
+func validateTopic(topic string) error {
+	if topic == "" || strings.ContainsAny(topic, invalidNameChars) {
+		return fmt.Errorf("invalid nats topic name: %q", topic)
+	}
+	return nil
+}
+
+// ensureStream creates the stream of @topic if it doesn't exist.
+func (nc *natsClient) ensureStream(topic string) error {
+	if err := validateTopic(topic); err != nil {
+		return err
+	}
+	_, err := nc.js.StreamInfo(topic)
+	if err == nil {
+		return nil
+	}
+	if !errors.Is(err, nats.ErrStreamNotFound) {
+		return err
+	}
+	// adding a stream with identical config is idempotent
+	_, err = nc.js.AddStream(&nats.StreamConfig{
+		Name:      topic,
+		Subjects:  []string{topic},
+		Retention: nats.LimitsPolicy,
+		Storage:   nats.FileStorage,
+		MaxAge:    nc.maxAge,
+	})
+	if err != nil {
+		log.Warn("failed to create nats stream", zap.String("topic", topic), zap.Error(err))
+		return err
+	}
+	return nil
+}
+
+// CreateProducer creates a producer of the topic, the stream is created if not exists.
+func (nc *natsClient) CreateProducer(options mqwrapper.ProducerOptions) (mqwrapper.Producer, error) {
+	if err := nc.ensureStream(options.Topic); err != nil {
+		return nil, err
+	}
+	return &natsProducer{js: nc.js, topic: options.Topic}, nil
+}
+
+// Subscribe creates a consumer of the topic, the stream is created if not exists.
+func (nc *natsClient) Subscribe(options mqwrapper.ConsumerOptions) (mqwrapper.Consumer, error) {
+	if err := nc.ensureStream(options.Topic); err != nil {
+		return nil, err
+	}
+	return newNatsConsumer(nc.js, options)
+}
+
+// EarliestMessageID returns the id before the first message of streams.
+func (nc *natsClient) EarliestMessageID() mqwrapper.MessageID {
+	return &natsID{messageID: 0}
+}
+
+// StringToMsgID converts the string of stream sequence to MessageID.
+func (nc *natsClient) StringToMsgID(id string) (mqwrapper.MessageID, error) {
+	seq, err := strconv.ParseUint(id, 10, 64)
+	if err != nil {
+		return nil, err
+	}
+	return &natsID{messageID: seq}, nil
+}
+
+// BytesToMsgID converts the serialized stream sequence to MessageID.
+func (nc *natsClient) BytesToMsgID(id []byte) (mqwrapper.MessageID, error) {
+	if len(id) != 8 {
+		return nil, fmt.Errorf("invalid nats message id length: %d", len(id))
+	}
+	return &natsID{messageID: DeserializeNatsID(id)}, nil
+}
+
+// DeleteConsumer deletes the durable consumer of the subscription @subName on @topic,
+// it's not an error if the stream or the consumer doesn't exist.
+func (nc *natsClient) DeleteConsumer(topic string, subName string) error {
+	err := nc.js.DeleteConsumer(topic, durableName(subName))
+	if err != nil && !errors.Is(err, nats.ErrConsumerNotFound) && !errors.Is(err, nats.ErrStreamNotFound) {
+		return err
+	}
+	return nil
+}
+
+// Close closes the connection unless it's shared.
+func (nc *natsClient) Close() {
+	if !nc.shared {
+		nc.conn.Close()
+	}
+}
@@ -0,0 +1,332 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package nats
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/nats-io/nats-server/v2/server"
+	"github.com/nats-io/nats.go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/milvus-io/milvus/internal/mq/msgstream/mqwrapper"
+	"github.com/milvus-io/milvus/internal/util/paramtable"
+)
+
+var natsURL string
+
+func TestMain(m *testing.M) {
+	storeDir, err := os.MkdirTemp("", "nats_test")
+	if err != nil {
+		panic(err)
+	}
+	defer os.RemoveAll(storeDir)
+	s, err := server.NewServer(&server.Options{
+		Host:      "127.0.0.1",
+		Port:      server.RANDOM_PORT,
+		JetStream: true,
+		StoreDir:  storeDir,
+		NoLog:     true,
+		NoSigs:    true,
+	})
+	if err != nil {
+		panic(err)
+	}
+	go s.Start()
+	if !s.ReadyForConnections(10 * time.Second) {
+		panic("nats server is not ready")
+	}
+	natsURL = s.ClientURL()
+	exitCode := m.Run()
+	s.Shutdown()
+	os.Exit(exitCode)
+}
+
+func createNatsClient(t *testing.T) *natsClient {
+	client, err := NewClient(natsURL, time.Hour)
+	require.NoError(t, err)
+	return client
+}
+
+// newTopic returns an unused topic, streams are kept across test runs.
+func newTopic(t *testing.T) string {
+	return fmt.Sprintf("%s_%d", t.Name(), time.Now().UnixNano())
+}
+
+func produceData(ctx context.Context, t *testing.T, producer mqwrapper.Producer, arr []int) []mqwrapper.MessageID {
+	var ids []mqwrapper.MessageID
+	for _, v := range arr {
+		id, err := producer.Send(ctx, &mqwrapper.ProducerMessage{
+			Payload:    []byte(fmt.Sprint(v)),
+			Properties: map[string]string{"key": fmt.Sprint(v)},
+		})
+		require.NoError(t, err)
+		ids = append(ids, id)
+	}
+	return ids
+}
+
+func consumeData(t *testing.T, consumer mqwrapper.Consumer, n int) []string {
+	var payloads []string
+	for i := 0; i < n; i++ {
+		select {
+		case msg := <-consumer.Chan():
+			payloads = append(payloads, string(msg.Payload()))
+			consumer.Ack(msg)
+		case <-time.After(10 * time.Second):
+			t.Fatal("consume timeout")
+		}
+	}
+	return payloads
+}
+
+func TestNatsClient_ProduceConsume(t *testing.T) {
+	ctx := context.Background()
+	client := createNatsClient(t)
+	defer client.Close()
+
+	topic := newTopic(t)
+	producer, err := client.CreateProducer(mqwrapper.ProducerOptions{Topic: topic})
+	require.NoError(t, err)
+	defer producer.Close()
+	assert.Equal(t, topic, producer.(*natsProducer).Topic())
+	ids := produceData(ctx, t, producer, []int{1, 2, 3})
+	assert.Equal(t, uint64(1), ids[0].(*natsID).messageID)
+	assert.Equal(t, uint64(3), ids[2].(*natsID).messageID)
+
+	consumer, err := client.Subscribe(mqwrapper.ConsumerOptions{
+		Topic:                       topic,
+		SubscriptionName:            "sub",
+		SubscriptionInitialPosition: mqwrapper.SubscriptionPositionEarliest,
+		BufSize:                     16,
+	})
+	require.NoError(t, err)
+	defer consumer.Close()
+	assert.Equal(t, "sub", consumer.Subscription())
+
+	msg := <-consumer.Chan()
+	assert.Equal(t, topic, msg.Topic())
+	assert.Equal(t, "1", string(msg.Payload()))
+	assert.Equal(t, map[string]string{"key": "1"}, msg.Properties())
+	equal, err := msg.ID().Equal(ids[0].Serialize())
+	assert.NoError(t, err)
+	assert.True(t, equal)
+	assert.Equal(t, []string{"2", "3"}, consumeData(t, consumer, 2))
+
+	latest, err := consumer.GetLatestMsgID()
+	assert.NoError(t, err)
+	equal, err = latest.Equal(ids[2].Serialize())
+	assert.NoError(t, err)
+	assert.True(t, equal)
+	// seek after consuming is not allowed
+	assert.Error(t, consumer.Seek(ids[0], true))
+}
+
+func TestNatsClient_SeekPosition(t *testing.T) {
+	ctx := context.Background()
+	client := createNatsClient(t)
+	defer client.Close()
+
+	topic := newTopic(t)
+	producer, err := client.CreateProducer(mqwrapper.ProducerOptions{Topic: topic})
+	require.NoError(t, err)
+	ids := produceData(ctx, t, producer, []int{1, 2, 3, 4})
+
+	for _, inclusive := range []bool{true, false} {
+		consumer, err := client.Subscribe(mqwrapper.ConsumerOptions{
+			Topic:                       topic,
+			SubscriptionName:            fmt.Sprint("seek", inclusive),
+			SubscriptionInitialPosition: mqwrapper.SubscriptionPositionUnknown,
+		})
+		require.NoError(t, err)
+		assert.Panics(t, func() { consumer.Chan() })
+		require.NoError(t, consumer.Seek(ids[1], inclusive))
+		if inclusive {
+			assert.Equal(t, []string{"2", "3", "4"}, consumeData(t, consumer, 3))
+		} else {
+			assert.Equal(t, []string{"3", "4"}, consumeData(t, consumer, 2))
+		}
+		consumer.Close()
+	}
+
+	// seek to the earliest position
+	consumer, err := client.Subscribe(mqwrapper.ConsumerOptions{
+		Topic:                       topic,
+		SubscriptionName:            "earliest",
+		SubscriptionInitialPosition: mqwrapper.SubscriptionPositionUnknown,
+	})
+	require.NoError(t, err)
+	defer consumer.Close()
+	require.NoError(t, consumer.Seek(client.EarliestMessageID(), false))
+	assert.Equal(t, []string{"1", "2"}, consumeData(t, consumer, 2))
+}
+
+func TestNatsClient_DurableConsumer(t *testing.T) {
+	ctx := context.Background()
+	client := createNatsClient(t)
+	defer client.Close()
+
+	topic := newTopic(t)
+	producer, err := client.CreateProducer(mqwrapper.ProducerOptions{Topic: topic})
+	require.NoError(t, err)
+	ids := produceData(ctx, t, producer, []int{1, 2})
+
+	subscribe := func() mqwrapper.Consumer {
+		consumer, err := client.Subscribe(mqwrapper.ConsumerOptions{
+			Topic:                       topic,
+			SubscriptionName:            "durable.sub",
+			SubscriptionInitialPosition: mqwrapper.SubscriptionPositionUnknown,
+		})
+		require.NoError(t, err)
+		return consumer
+	}
+	consumer := subscribe()
+	require.NoError(t, consumer.Seek(client.EarliestMessageID(), false))
+	assert.Equal(t, []string{"1", "2"}, consumeData(t, consumer, 2))
+	consumer.Close()
+
+	// the consumer of the same subscription resumes from the durable consumer
+	produceData(ctx, t, producer, []int{3, 4})
+	consumer = subscribe()
+	assert.Equal(t, []string{"3", "4"}, consumeData(t, consumer, 2))
+	consumer.Close()
+
+	// seek recreates the durable consumer at the sought position
+	consumer = subscribe()
+	require.NoError(t, consumer.Seek(ids[1], true))
+	assert.Equal(t, []string{"2", "3"}, consumeData(t, consumer, 2))
+	consumer.Close()
+
+	// the durable consumer is removed by DeleteConsumer
+	require.NoError(t, client.DeleteConsumer(topic, "durable.sub"))
+	require.NoError(t, client.DeleteConsumer(topic, "durable.sub"))
+	_, err = client.js.ConsumerInfo(topic, durableName("durable.sub"))
+	assert.ErrorIs(t, err, nats.ErrConsumerNotFound)
+	consumer = subscribe()
+	defer consumer.Close()
+	assert.Panics(t, func() { consumer.Chan() })
+}
+
+func TestNatsClient_SharedConn(t *testing.T) {
+	conn, err := Connect(natsURL)
+	require.NoError(t, err)
+	defer conn.Close()
+
+	client, err := NewClientWithConn(conn, time.Hour)
+	require.NoError(t, err)
+	producer, err := client.CreateProducer(mqwrapper.ProducerOptions{Topic: newTopic(t)})
+	require.NoError(t, err)
+	client.Close()
+	assert.False(t, conn.IsClosed())
+	produceData(context.Background(), t, producer, []int{1})
+
+	_, err = Connect("nats://127.0.0.1:1")
+	assert.Error(t, err)
+}
+
+func TestNatsClient_ConsumeFromLatest(t *testing.T) {
+	ctx := context.Background()
+	client := createNatsClient(t)
+	defer client.Close()
+
+	topic := newTopic(t)
+	consumer, err := client.Subscribe(mqwrapper.ConsumerOptions{
+		Topic:                       topic,
+		SubscriptionName:            "latest",
+		SubscriptionInitialPosition: mqwrapper.SubscriptionPositionLatest,
+	})
+	require.NoError(t, err)
+	defer consumer.Close()
+	latest, err := consumer.GetLatestMsgID()
+	assert.NoError(t, err)
+	assert.True(t, latest.AtEarliestPosition())
+
+	producer, err := client.CreateProducer(mqwrapper.ProducerOptions{Topic: topic})
+	require.NoError(t, err)
+	produceData(ctx, t, producer, []int{1, 2})
+
+	latestConsumer, err := client.Subscribe(mqwrapper.ConsumerOptions{
+		Topic:                       topic,
+		SubscriptionName:            "latest2",
+		SubscriptionInitialPosition: mqwrapper.SubscriptionPositionLatest,
+	})
+	require.NoError(t, err)
+	defer latestConsumer.Close()
+	// only messages produced after subscribing are consumed
+	latestConsumer.Chan()
+	time.Sleep(100 * time.Millisecond)
+	produceData(ctx, t, producer, []int{3})
+	assert.Equal(t, []string{"3"}, consumeData(t, latestConsumer, 1))
+}
+
+func TestNatsClient_Close(t *testing.T) {
+	client := createNatsClient(t)
+	defer client.Close()
+	consumer, err := client.Subscribe(mqwrapper.ConsumerOptions{
+		Topic:                       newTopic(t),
+		SubscriptionName:            "close",
+		SubscriptionInitialPosition: mqwrapper.SubscriptionPositionEarliest,
+	})
+	require.NoError(t, err)
+	ch := consumer.Chan()
+	consumer.Close()
+	consumer.Close()
+	_, ok := <-ch
+	assert.False(t, ok)
+}
+
+func TestNatsClient_MsgID(t *testing.T) {
+	client := createNatsClient(t)
+	defer client.Close()
+
+	assert.True(t, client.EarliestMessageID().AtEarliestPosition())
+	id, err := client.StringToMsgID("10")
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(10), id.(*natsID).messageID)
+	_, err = client.StringToMsgID("a")
+	assert.Error(t, err)
+
+	id, err = client.BytesToMsgID(id.Serialize())
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(10), id.(*natsID).messageID)
+	_, err = client.BytesToMsgID([]byte{1})
+	assert.Error(t, err)
+}
+
+func TestNatsClient_InvalidTopic(t *testing.T) {
+	client := createNatsClient(t)
+	defer client.Close()
+	_, err := client.CreateProducer(mqwrapper.ProducerOptions{Topic: "a.b"})
+	assert.Error(t, err)
+	_, err = client.Subscribe(mqwrapper.ConsumerOptions{Topic: ""})
+	assert.Error(t, err)
+}
+
+func TestNatsClient_NewClientWithConfig(t *testing.T) {
+	client, err := NewClientWithConfig(&paramtable.NatsConfig{Address: natsURL, RetentionTimeInMinutes: 10})
+	assert.NoError(t, err)
+	assert.Equal(t, 10*time.Minute, client.maxAge)
+	client.Close()
+
+	_, err = NewClientWithConfig(&paramtable.NatsConfig{Address: "nats://127.0.0.1:1"})
+	assert.Error(t, err)
+}
@@ -0,0 +1,226 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package nats
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/nats-io/nats.go"
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/mq/msgstream/mqwrapper"
+)
+
+// Check natsConsumer implements Consumer
+var _ mqwrapper.Consumer = (*natsConsumer)(nil)
+
+// natsConsumer consumes a stream through the durable JetStream consumer named by the subscription,
+// so that a consumer of the same subscription resumes after the last delivered message,
+// an exact position is restored by Seek from the checkpoints of msgstream.
+type natsConsumer struct {
+	js      nats.JetStreamContext
+	topic   string
+	subName string
+
+	// deliverPolicy and startSeq are the position of the durable consumer if it's created,
+	// positioned is false until the position is known
+	deliverPolicy nats.DeliverPolicy
+	startSeq      uint64
+	positioned    bool
+	// sought is set by Seek, the durable consumer is recreated to start from the sought position
+	sought bool
+	// subMu guards sub, which is nil until Chan subscribes successfully
+	subMu sync.Mutex
+	sub   *nats.Subscription
+
+	msgChannel chan mqwrapper.Message
+	closeOnce  sync.Once
+	closeCh    chan struct{}
+	// mu guards msgChannel from being closed while the handler is sending
+	mu     sync.RWMutex
+	closed bool
+}
+
+func newNatsConsumer(js nats.JetStreamContext, options mqwrapper.ConsumerOptions) (*natsConsumer, error) {
+	bufSize := options.BufSize
+	if bufSize <= 0 {
+		bufSize = 1024
+	}
+	nc := &natsConsumer{
+		js:         js,
+		topic:      options.Topic,
+		subName:    options.SubscriptionName,
+		msgChannel: make(chan mqwrapper.Message, bufSize),
+		closeCh:    make(chan struct{}),
+	}
+	switch options.SubscriptionInitialPosition {
+	case mqwrapper.SubscriptionPositionEarliest:
+		nc.deliverPolicy, nc.positioned = nats.DeliverAllPolicy, true
+	case mqwrapper.SubscriptionPositionLatest:
+		nc.deliverPolicy, nc.positioned = nats.DeliverNewPolicy, true
+	}
+	return nc, nil
+}
+
+// durableName returns the name of the durable JetStream consumer of @subName,
+// the characters not allowed in consumer names are replaced.
+func durableName(subName string) string {
+	return strings.Map(func(r rune) rune {
+		if strings.ContainsRune(invalidNameChars, r) {
+			return '_'
+		}
+		return r
+	}, subName)
+}
+
+// Subscription returns the subscription name.
+func (nc *natsConsumer) Subscription() string {
+	return nc.subName
+}
+
+func (nc *natsConsumer) handle(msg *nats.Msg) {
+	meta, err := msg.Metadata()
+	if err != nil {
+		log.Warn("failed to get nats message metadata", zap.String("topic", nc.topic), zap.Error(err))
+		return
+	}
+	nc.mu.RLock()
+	defer nc.mu.RUnlock()
+	if nc.closed {
+		return
+	}
+	select {
+	case nc.msgChannel <- &natsMessage{topic: nc.topic, msg: msg, seq: meta.Sequence.Stream}:
+	case <-nc.closeCh:
+	}
+}
+
+// subscribe binds to the durable consumer of the subscription, the durable consumer is created at the
+// known position if it doesn't exist, or recreated if the consumer has sought to another position.
+func (nc *natsConsumer) subscribe() (*nats.Subscription, error) {
+	durable := durableName(nc.subName)
+	_, err := nc.js.ConsumerInfo(nc.topic, durable)
+	if err != nil && !errors.Is(err, nats.ErrConsumerNotFound) {
+		return nil, err
+	}
+	exist := err == nil
+	if exist && nc.sought {
+		if err := nc.js.DeleteConsumer(nc.topic, durable); err != nil {
+			return nil, err
+		}
+		exist = false
+	}
+	if !exist {
+		if !nc.positioned {
+			return nil, fmt.Errorf("nats consumer %s of %s has no position to start from", nc.subName, nc.topic)
+		}
+		// positions are tracked by the checkpoints of msgstream, messages are not redelivered
+		// since redelivery would break their order, flow control keeps the server from
+		// overrunning a consumer blocked on a full msgChannel
+		_, err := nc.js.AddConsumer(nc.topic, &nats.ConsumerConfig{
+			Durable:        durable,
+			DeliverSubject: nats.NewInbox(),
+			DeliverPolicy:  nc.deliverPolicy,
+			OptStartSeq:    nc.startSeq,
+			AckPolicy:      nats.AckNonePolicy,
+			FlowControl:    true,
+			Heartbeat:      5 * time.Second,
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+	// the consumer is bound rather than created by the subscription, so it's kept after unsubscribing
+	sub, err := nc.js.Subscribe("", nc.handle, nats.Bind(nc.topic, durable))
+	if err != nil {
+		return nil, err
+	}
+	// the handler blocks while msgChannel is full, pending messages must not be dropped
+	if err := sub.SetPendingLimits(-1, -1); err != nil {
+		sub.Unsubscribe()
+		return nil, err
+	}
+	return sub, nil
+}
+
+// Chan starts consuming and returns the message channel.
+func (nc *natsConsumer) Chan() <-chan mqwrapper.Message {
+	nc.subMu.Lock()
+	defer nc.subMu.Unlock()
+	if nc.sub == nil {
+		sub, err := nc.subscribe()
+		if err != nil {
+			log.Error("failed to subscribe nats stream", zap.String("topic", nc.topic), zap.String("subName", nc.subName), zap.Error(err))
+			panic(err)
+		}
+		nc.sub = sub
+	}
+	return nc.msgChannel
+}
+
+// Seek sets the consume position, must be called before Chan.
+func (nc *natsConsumer) Seek(id mqwrapper.MessageID, inclusive bool) error {
+	nc.subMu.Lock()
+	defer nc.subMu.Unlock()
+	if nc.sub != nil {
+		return errors.New("nats consumer is already consuming, can not seek again")
+	}
+	seq := id.(*natsID).messageID
+	if !inclusive || seq == 0 {
+		seq++
+	}
+	log.Info("nats consumer seek", zap.String("topic", nc.topic), zap.Uint64("start sequence", seq), zap.Bool("inclusive", inclusive))
+	nc.deliverPolicy, nc.startSeq = nats.DeliverByStartSequencePolicy, seq
+	nc.positioned, nc.sought = true, true
+	return nil
+}
+
+func (nc *natsConsumer) Ack(message mqwrapper.Message) {
+}
+
+// GetLatestMsgID returns the sequence of the last message in the stream.
+func (nc *natsConsumer) GetLatestMsgID() (mqwrapper.MessageID, error) {
+	info, err := nc.js.StreamInfo(nc.topic)
+	if err != nil {
+		return nil, err
+	}
+	return &natsID{messageID: info.State.LastSeq}, nil
+}
+
+// Close unsubscribes the stream and closes the message channel.
+func (nc *natsConsumer) Close() {
+	nc.closeOnce.Do(func() {
+		close(nc.closeCh)
+		nc.subMu.Lock()
+		// the durable consumer is kept, a consumer of the same subscription resumes from it
+		if nc.sub != nil {
+			if err := nc.sub.Unsubscribe(); err != nil {
+				log.Warn("failed to unsubscribe nats stream", zap.String("topic", nc.topic), zap.Error(err))
+			}
+		}
+		nc.subMu.Unlock()
+		nc.mu.Lock()
+		nc.closed = true
+		close(nc.msgChannel)
+		nc.mu.Unlock()
+	})
+}
@@ -0,0 +1,63 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package nats
+
+import (
+	"github.com/milvus-io/milvus/internal/common"
+	"github.com/milvus-io/milvus/internal/mq/msgstream/mqwrapper"
+)
+
+// natsID wraps the stream sequence of a JetStream message, sequences start from 1
+// and 0 means the position before the first message.
+type natsID struct {
+	messageID uint64
+}
+
+// Check natsID implements MessageID interface
+var _ mqwrapper.MessageID = &natsID{}
+
+// Serialize convert natsID to a byte slice
+func (nid *natsID) Serialize() []byte {
+	return SerializeNatsID(nid.messageID)
+}
+
+// AtEarliestPosition returns true if the id is before the first message
+func (nid *natsID) AtEarliestPosition() bool {
+	return nid.messageID == 0
+}
+
+// LessOrEqualThan compares the stream sequences
+func (nid *natsID) LessOrEqualThan(msgID []byte) (bool, error) {
+	return nid.messageID <= DeserializeNatsID(msgID), nil
+}
+
+// Equal compares the stream sequences
+func (nid *natsID) Equal(msgID []byte) (bool, error) {
+	return nid.messageID == DeserializeNatsID(msgID), nil
+}
+
+// SerializeNatsID encodes the stream sequence to a byte slice
+func SerializeNatsID(messageID uint64) []byte {
+	b := make([]byte, 8)
+	common.Endian.PutUint64(b, messageID)
+	return b
+}
+
+// DeserializeNatsID decodes the stream sequence from a byte slice
+func DeserializeNatsID(messageID []byte) uint64 {
+	return common.Endian.Uint64(messageID)
+}
@@ -0,0 +1,60 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package nats
+
+import (
+	"github.com/nats-io/nats.go"
+
+	"github.com/milvus-io/milvus/internal/mq/msgstream/mqwrapper"
+)
+
+// Check natsMessage implements ConsumerMessage
+var _ mqwrapper.Message = (*natsMessage)(nil)
+
+// natsMessage wraps the message received from JetStream
+type natsMessage struct {
+	topic string
+	msg   *nats.Msg
+	seq   uint64
+}
+
+// Topic returns the topic name of the message
+func (nm *natsMessage) Topic() string {
+	return nm.topic
+}
+
+// Properties returns the properties carried by the message headers
+func (nm *natsMessage) Properties() map[string]string {
+	if len(nm.msg.Header) == 0 {
+		return nil
+	}
+	properties := make(map[string]string, len(nm.msg.Header))
+	for k := range nm.msg.Header {
+		properties[k] = nm.msg.Header.Get(k)
+	}
+	return properties
+}
+
+// Payload returns the payload of the message
+func (nm *natsMessage) Payload() []byte {
+	return nm.msg.Data
+}
+
+// ID returns the stream sequence of the message
+func (nm *natsMessage) ID() mqwrapper.MessageID {
+	return &natsID{messageID: nm.seq}
+}
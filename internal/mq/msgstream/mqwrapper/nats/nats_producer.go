@@ -0,0 +1,57 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package nats
+
+import (
+	"context"
+
+	"github.com/nats-io/nats.go"
+
+	"github.com/milvus-io/milvus/internal/mq/msgstream/mqwrapper"
+)
+
+// Check natsProducer implements Producer
+var _ mqwrapper.Producer = (*natsProducer)(nil)
+
+// natsProducer publishes messages to the JetStream stream of the topic
+type natsProducer struct {
+	js    nats.JetStreamContext
+	topic string
+}
+
+// Topic returns the topic of the producer
+func (np *natsProducer) Topic() string {
+	return np.topic
+}
+
+// Send publishes the message and waits for the ack of the stream
+func (np *natsProducer) Send(ctx context.Context, message *mqwrapper.ProducerMessage) (mqwrapper.MessageID, error) {
+	msg := nats.NewMsg(np.topic)
+	msg.Data = message.Payload
+	for k, v := range message.Properties {
+		msg.Header.Set(k, v)
+	}
+	ack, err := np.js.PublishMsg(msg, nats.Context(ctx))
+	if err != nil {
+		return nil, err
+	}
+	return &natsID{messageID: ack.Sequence}, nil
+}
+
+// Close does nothing, the connection is owned by the client
+func (np *natsProducer) Close() {
+}
@@ -0,0 +1,258 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package msgstream
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"path/filepath"
+	"sync"
+
+	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+	"github.com/milvus-io/milvus/internal/mq/msgstream/mqwrapper"
+	"github.com/milvus-io/milvus/internal/util/compressor"
+	"github.com/milvus-io/milvus/internal/util/trace"
+)
+
+// A batch packs the marshaled TsMsgs produced to the same channel into one mq message:
+//
+//	magic(4) | version(1) | compression(1) | body
+//	body: uvarint(count) | [uvarint(len) | TsMsg]...
+//
+// the magic can not be the beginning of a TsMsg, whose first field is always the MsgBase tag 0x0a
+const (
+	msgBatchMagic          = "\xffMSB"
+	msgBatchVersion   byte = 1
+	msgBatchHeaderLen      = len(msgBatchMagic) + 2
+
+	msgBatchCompressionNone byte = 0
+	msgBatchCompressionZstd byte = 1
+)
+
+var errBadMsgBatch = errors.New("bad message batch")
+
+// ProduceBatchConfig is the batching setting of Produce shared by all msgstreams of the process
+type ProduceBatchConfig struct {
+	Enable bool
+	// MaxSize is the max bytes of marshaled TsMsgs in one batch, a larger TsMsg is sent alone
+	MaxSize int64
+	// MaxNum is the max number of TsMsgs in one batch
+	MaxNum int
+	// Compression of the batch body, empty means no compression
+	Compression compressor.CompressType
+}
+
+var (
+	produceBatchConfigMu sync.RWMutex
+	produceBatchConfig   = ProduceBatchConfig{}
+)
+
+// SetProduceBatchConfig sets the batching config used by msgstreams created afterwards
+func SetProduceBatchConfig(cfg ProduceBatchConfig) error {
+	if cfg.Enable {
+		if cfg.MaxSize <= 0 || cfg.MaxNum <= 0 {
+			return fmt.Errorf("invalid produce batch max size %d or max num %d", cfg.MaxSize, cfg.MaxNum)
+		}
+		if cfg.Compression != "" && cfg.Compression != compressor.CompressTypeZstd {
+			return fmt.Errorf("unsupported produce batch compression %s", cfg.Compression)
+		}
+	}
+	produceBatchConfigMu.Lock()
+	defer produceBatchConfigMu.Unlock()
+	produceBatchConfig = cfg
+	return nil
+}
+
+func getProduceBatchConfig() ProduceBatchConfig {
+	produceBatchConfigMu.RLock()
+	defer produceBatchConfigMu.RUnlock()
+	return produceBatchConfig
+}
+
+func isMsgBatch(payload []byte) bool {
+	return bytes.HasPrefix(payload, []byte(msgBatchMagic))
+}
+
+func packMsgBatch(payloads [][]byte, compression compressor.CompressType) []byte {
+	size := binary.MaxVarintLen64
+	for _, payload := range payloads {
+		size += binary.MaxVarintLen64 + len(payload)
+	}
+	body := make([]byte, 0, size)
+	buf := make([]byte, binary.MaxVarintLen64)
+	body = append(body, buf[:binary.PutUvarint(buf, uint64(len(payloads)))]...)
+	for _, payload := range payloads {
+		body = append(body, buf[:binary.PutUvarint(buf, uint64(len(payload)))]...)
+		body = append(body, payload...)
+	}
+
+	batch := make([]byte, 0, msgBatchHeaderLen+len(body))
+	batch = append(batch, msgBatchMagic...)
+	batch = append(batch, msgBatchVersion)
+	if compression == compressor.CompressTypeZstd {
+		batch = append(batch, msgBatchCompressionZstd)
+		return compressor.ZstdCompressBytes(body, batch)
+	}
+	batch = append(batch, msgBatchCompressionNone)
+	return append(batch, body...)
+}
+
+func unpackMsgBatch(batch []byte) ([][]byte, error) {
+	if len(batch) < msgBatchHeaderLen || !isMsgBatch(batch) {
+		return nil, fmt.Errorf("message is not a batch: %w", errBadMsgBatch)
+	}
+	if version := batch[len(msgBatchMagic)]; version != msgBatchVersion {
+		return nil, fmt.Errorf("unknown batch version %d: %w", version, errBadMsgBatch)
+	}
+	body := batch[msgBatchHeaderLen:]
+	switch compression := batch[len(msgBatchMagic)+1]; compression {
+	case msgBatchCompressionNone:
+	case msgBatchCompressionZstd:
+		var err error
+		body, err = compressor.ZstdDecompressBytes(body, nil)
+		if err != nil {
+			return nil, fmt.Errorf("failed to decompress batch, err %s: %w", err.Error(), errBadMsgBatch)
+		}
+	default:
+		return nil, fmt.Errorf("unknown batch compression %d: %w", compression, errBadMsgBatch)
+	}
+
+	count, n := binary.Uvarint(body)
+	if n <= 0 || count > uint64(len(body)) {
+		return nil, fmt.Errorf("invalid batch size: %w", errBadMsgBatch)
+	}
+	// a single message is never packed, an empty batch would be delivered as an empty pack
+	if count < 2 {
+		return nil, fmt.Errorf("batch of %d messages: %w", count, errBadMsgBatch)
+	}
+	body = body[n:]
+	payloads := make([][]byte, 0, count)
+	for i := uint64(0); i < count; i++ {
+		size, n := binary.Uvarint(body)
+		if n <= 0 || size > uint64(len(body)-n) {
+			return nil, fmt.Errorf("invalid size of the %dth message in batch: %w", i, errBadMsgBatch)
+		}
+		payloads = append(payloads, body[n:n+int(size)])
+		body = body[n+int(size):]
+	}
+	if len(body) != 0 {
+		return nil, fmt.Errorf("%d trailing bytes in batch: %w", len(body), errBadMsgBatch)
+	}
+	return payloads, nil
+}
+
+// produceBatch sends msgs to channel in batches, a TimeTickMsg is always sent alone
+// so that consumers of MqTtMsgStream never find a time tick inside a batch
+func (ms *mqMsgStream) produceBatch(channel string, msgs []TsMsg) error {
+	payloads := make([][]byte, 0)
+	var batchSize int64
+	var first TsMsg
+	flush := func() error {
+		if len(payloads) == 0 {
+			return nil
+		}
+		payload := payloads[0]
+		if len(payloads) > 1 {
+			payload = packMsgBatch(payloads, ms.produceBatchCfg.Compression)
+		}
+		err := ms.sendPayload(channel, first, payload)
+		payloads = payloads[:0]
+		batchSize = 0
+		first = nil
+		return err
+	}
+
+	for _, msg := range msgs {
+		mb, err := msg.Marshal(msg)
+		if err != nil {
+			return err
+		}
+		m, err := convertToByteArray(mb)
+		if err != nil {
+			return err
+		}
+		if msg.Type() == commonpb.MsgType_TimeTick {
+			if err := flush(); err != nil {
+				return err
+			}
+			if err := ms.sendPayload(channel, msg, m); err != nil {
+				return err
+			}
+			continue
+		}
+		if len(payloads) > 0 && (batchSize+int64(len(m)) > ms.produceBatchCfg.MaxSize || len(payloads) >= ms.produceBatchCfg.MaxNum) {
+			if err := flush(); err != nil {
+				return err
+			}
+		}
+		if first == nil {
+			first = msg
+		}
+		payloads = append(payloads, m)
+		batchSize += int64(len(m))
+	}
+	return flush()
+}
+
+// sendPayload sends one mq message, the trace context of msg is injected into its properties
+func (ms *mqMsgStream) sendPayload(channel string, msg TsMsg, payload []byte) error {
+	sp, spanCtx := MsgSpanFromCtx(msg.TraceCtx(), msg)
+	defer sp.Finish()
+
+	mqMsg := &mqwrapper.ProducerMessage{Payload: payload, Properties: map[string]string{}}
+	trace.InjectContextToPulsarMsgProperties(sp.Context(), mqMsg.Properties)
+
+	ms.producerLock.Lock()
+	defer ms.producerLock.Unlock()
+	if _, err := ms.producers[channel].Send(spanCtx, mqMsg); err != nil {
+		trace.LogError(sp, err)
+		return err
+	}
+	return nil
+}
+
+// getTsMsgsFromConsumerMsg unmarshals a consumed mq message, which is either a single TsMsg or a batch,
+// all TsMsgs of a batch share the position of the mq message
+func (ms *mqMsgStream) getTsMsgsFromConsumerMsg(msg mqwrapper.Message) ([]TsMsg, error) {
+	if !isMsgBatch(msg.Payload()) {
+		tsMsg, err := ms.getTsMsgFromConsumerMsg(msg)
+		if err != nil {
+			return nil, err
+		}
+		return []TsMsg{tsMsg}, nil
+	}
+
+	payloads, err := unpackMsgBatch(msg.Payload())
+	if err != nil {
+		return nil, err
+	}
+	tsMsgs := make([]TsMsg, 0, len(payloads))
+	for _, payload := range payloads {
+		tsMsg, err := ms.unmarshalConsumerMsg(payload)
+		if err != nil {
+			return nil, err
+		}
+		tsMsg.SetPosition(&MsgPosition{
+			ChannelName: filepath.Base(msg.Topic()),
+			MsgID:       msg.ID().Serialize(),
+		})
+		tsMsgs = append(tsMsgs, tsMsg)
+	}
+	return tsMsgs, nil
+}
@@ -0,0 +1,210 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package msgstream
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+	"github.com/milvus-io/milvus/internal/mq/msgstream/mqwrapper"
+	"github.com/milvus-io/milvus/internal/util/compressor"
+	"github.com/milvus-io/milvus/internal/util/funcutil"
+	"github.com/milvus-io/milvus/internal/util/paramtable"
+)
+
+func TestMsgBatch_PackUnpack(t *testing.T) {
+	tt := getTimeTickMsg(1)
+	mb, err := tt.Marshal(tt)
+	require.NoError(t, err)
+	assert.False(t, isMsgBatch(mb.([]byte)))
+
+	payloads := [][]byte{mb.([]byte), {}, []byte("hello")}
+	for _, compression := range []compressor.CompressType{"", compressor.CompressTypeZstd} {
+		batch := packMsgBatch(payloads, compression)
+		assert.True(t, isMsgBatch(batch))
+		unpacked, err := unpackMsgBatch(batch)
+		assert.NoError(t, err)
+		require.Equal(t, len(payloads), len(unpacked))
+		for i := range payloads {
+			assert.Equal(t, len(payloads[i]), len(unpacked[i]))
+			assert.Equal(t, string(payloads[i]), string(unpacked[i]))
+		}
+	}
+
+	batch := packMsgBatch(payloads, "")
+	_, err = unpackMsgBatch(batch[:len(batch)-1])
+	assert.ErrorIs(t, err, errBadMsgBatch)
+	_, err = unpackMsgBatch(append(batch, 0))
+	assert.ErrorIs(t, err, errBadMsgBatch)
+	_, err = unpackMsgBatch(batch[:msgBatchHeaderLen-1])
+	assert.ErrorIs(t, err, errBadMsgBatch)
+
+	badVersion := append([]byte{}, batch...)
+	badVersion[len(msgBatchMagic)] = 2
+	_, err = unpackMsgBatch(badVersion)
+	assert.ErrorIs(t, err, errBadMsgBatch)
+
+	badCompression := append([]byte{}, batch...)
+	badCompression[len(msgBatchMagic)+1] = msgBatchCompressionZstd
+	_, err = unpackMsgBatch(badCompression)
+	assert.ErrorIs(t, err, errBadMsgBatch)
+	badCompression[len(msgBatchMagic)+1] = 9
+	_, err = unpackMsgBatch(badCompression)
+	assert.ErrorIs(t, err, errBadMsgBatch)
+
+	// batches of less than 2 messages are never packed
+	_, err = unpackMsgBatch(packMsgBatch(nil, ""))
+	assert.ErrorIs(t, err, errBadMsgBatch)
+	_, err = unpackMsgBatch(packMsgBatch(payloads[:1], compressor.CompressTypeZstd))
+	assert.ErrorIs(t, err, errBadMsgBatch)
+}
+
+func TestMsgBatch_ReceiveBadBatch(t *testing.T) {
+	ctx := context.Background()
+	factory := NewMmsFactory(&paramtable.MemmqConfig{})
+	channel := funcutil.RandomString(8)
+
+	client, release, err := getFactoryClient(ctx, factory)
+	require.NoError(t, err)
+	defer release()
+	rawProducer, err := client.CreateProducer(mqwrapper.ProducerOptions{Topic: channel})
+	require.NoError(t, err)
+	defer rawProducer.Close()
+	tt := getTimeTickMsg(1)
+	mb, err := tt.Marshal(tt)
+	require.NoError(t, err)
+	for _, batch := range [][]byte{packMsgBatch(nil, ""), packMsgBatch([][]byte{mb.([]byte)}, "")} {
+		_, err = rawProducer.Send(ctx, &mqwrapper.ProducerMessage{Payload: batch})
+		require.NoError(t, err)
+	}
+
+	producer, err := factory.NewMsgStream(ctx)
+	require.NoError(t, err)
+	defer producer.Close()
+	producer.AsProducer([]string{channel})
+	require.NoError(t, producer.Produce(getInsertMsgPack([]int{1})))
+
+	// the bad batches are skipped rather than delivered as packs
+	consumer, err := factory.NewMsgStream(ctx)
+	require.NoError(t, err)
+	defer consumer.Close()
+	consumer.AsConsumer([]string{channel}, funcutil.RandomString(8), mqwrapper.SubscriptionPositionEarliest)
+	select {
+	case pack := <-consumer.Chan():
+		require.Equal(t, 1, len(pack.Msgs))
+		assert.Equal(t, commonpb.MsgType_Insert, pack.Msgs[0].Type())
+	case <-time.After(5 * time.Second):
+		t.Fatal("msg pack not received")
+	}
+}
+
+func TestSetProduceBatchConfig(t *testing.T) {
+	defer SetProduceBatchConfig(ProduceBatchConfig{})
+
+	assert.NoError(t, SetProduceBatchConfig(ProduceBatchConfig{}))
+	assert.Error(t, SetProduceBatchConfig(ProduceBatchConfig{Enable: true, MaxNum: 1}))
+	assert.Error(t, SetProduceBatchConfig(ProduceBatchConfig{Enable: true, MaxSize: 1}))
+	assert.Error(t, SetProduceBatchConfig(ProduceBatchConfig{Enable: true, MaxSize: 1, MaxNum: 1, Compression: "lz4"}))
+	assert.NoError(t, SetProduceBatchConfig(ProduceBatchConfig{Enable: true, MaxSize: 1, MaxNum: 1, Compression: compressor.CompressTypeZstd}))
+	assert.True(t, getProduceBatchConfig().Enable)
+}
+
+func TestMsgBatch_Produce(t *testing.T) {
+	defer SetProduceBatchConfig(ProduceBatchConfig{})
+	require.NoError(t, SetProduceBatchConfig(ProduceBatchConfig{Enable: true, MaxSize: 1024 * 1024, MaxNum: 3, Compression: compressor.CompressTypeZstd}))
+
+	ctx := context.Background()
+	factory := NewMmsFactory(&paramtable.MemmqConfig{})
+	channel := funcutil.RandomString(8)
+
+	producer, err := factory.NewMsgStream(ctx)
+	require.NoError(t, err)
+	defer producer.Close()
+	producer.AsProducer([]string{channel})
+	// inserts are packed into 3 batches [1, 2, 3], [4, 5, 6], [7], followed by the time tick
+	require.NoError(t, producer.Produce(getInsertMsgPack([]int{1, 2, 3, 4, 5, 6, 7})))
+	require.NoError(t, producer.Produce(getTimeTickMsgPack(5)))
+
+	t.Run("test raw mq messages", func(t *testing.T) {
+		client, release, err := getFactoryClient(ctx, factory)
+		require.NoError(t, err)
+		defer release()
+		consumer, err := client.Subscribe(mqwrapper.ConsumerOptions{
+			Topic:                       channel,
+			SubscriptionName:            funcutil.RandomString(8),
+			SubscriptionInitialPosition: mqwrapper.SubscriptionPositionEarliest,
+		})
+		require.NoError(t, err)
+		defer consumer.Close()
+		batched := []bool{true, true, false, false}
+		for _, isBatch := range batched {
+			msg := <-consumer.Chan()
+			assert.Equal(t, isBatch, isMsgBatch(msg.Payload()))
+		}
+	})
+
+	t.Run("test msgstream", func(t *testing.T) {
+		consumer, err := factory.NewMsgStream(ctx)
+		require.NoError(t, err)
+		defer consumer.Close()
+		consumer.AsConsumer([]string{channel}, funcutil.RandomString(8), mqwrapper.SubscriptionPositionEarliest)
+		packSizes := []int{3, 3, 1, 1}
+		for i, size := range packSizes {
+			pack := <-consumer.Chan()
+			require.Equal(t, size, len(pack.Msgs))
+			assert.Equal(t, pack.StartPositions[0].MsgID, pack.EndPositions[0].MsgID)
+			if i < 3 {
+				assert.Equal(t, pack.Msgs[0].BeginTs(), pack.StartPositions[0].Timestamp)
+				assert.Equal(t, pack.Msgs[size-1].BeginTs(), pack.EndPositions[0].Timestamp)
+			}
+		}
+	})
+
+	t.Run("test tt msgstream seek", func(t *testing.T) {
+		subName := funcutil.RandomString(8)
+		consumer, err := factory.NewTtMsgStream(ctx)
+		require.NoError(t, err)
+		consumer.AsConsumer([]string{channel}, subName, mqwrapper.SubscriptionPositionEarliest)
+		pack := <-consumer.Chan()
+		require.Equal(t, 5, len(pack.Msgs))
+		for i, msg := range pack.Msgs {
+			assert.Equal(t, Timestamp(i+1), msg.BeginTs())
+		}
+		consumer.Close()
+
+		// the position is the batch of [4, 5, 6] at time 5, only 6 and 7 are consumed after seek
+		consumer, err = factory.NewTtMsgStream(ctx)
+		require.NoError(t, err)
+		defer consumer.Close()
+		consumer.AsConsumer([]string{channel}, subName, mqwrapper.SubscriptionPositionUnknown)
+		require.NoError(t, consumer.Seek(pack.EndPositions))
+		require.NoError(t, producer.Produce(getTimeTickMsgPack(10)))
+		select {
+		case pack = <-consumer.Chan():
+		case <-time.After(5 * time.Second):
+			t.Fatal("msg pack not received after seek")
+		}
+		require.Equal(t, 2, len(pack.Msgs))
+		assert.Equal(t, Timestamp(6), pack.Msgs[0].BeginTs())
+		assert.Equal(t, Timestamp(7), pack.Msgs[1].BeginTs())
+	})
+}
@@ -6,7 +6,10 @@ expr:
 	| BooleanConstant										                # Boolean
 	| StringLiteral											                # String
 	| Identifier											                # Identifier
+	| JSONIdentifier										                # JSONIdentifier
 	| '(' expr ')'											                # Parens
+	| '[' expr (',' expr)* ','? ']'                                         # Array
+	| op = (ArrayContains | ArrayContainsAll | ArrayContainsAny) '(' expr ',' expr ')'  # ArrayContains
 	| expr LIKE StringLiteral                                               # Like
 	| expr POW expr											                # Power
 	| op = (ADD | SUB | BNOT | NOT) expr					                # Unary
@@ -79,7 +82,12 @@ FloatingConstant:
 	DecimalFloatingConstant
 	| HexadecimalFloatingConstant;
 
+ArrayContains: 'array_contains' | 'ARRAY_CONTAINS';
+ArrayContainsAll: 'array_contains_all' | 'ARRAY_CONTAINS_ALL';
+ArrayContainsAny: 'array_contains_any' | 'ARRAY_CONTAINS_ANY';
+
 Identifier: Nondigit (Nondigit | Digit)*;
+JSONIdentifier: Identifier ('[' (StringLiteral | IntegerConstant) ']')+;
 
 StringLiteral: EncodingPrefix? '"' SCharSequence? '"';
 
@@ -0,0 +1,60 @@
+package planparserv2
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/milvus-io/milvus/internal/proto/planpb"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
+)
+
+// checkExecutable checks that segcore is able to evaluate the expr, the parser accepts
+// some expressions that the query nodes can't execute yet.
+func checkExecutable(schema *typeutil.SchemaHelper, expr *planpb.Expr) error {
+	switch e := expr.GetExpr().(type) {
+	case *planpb.Expr_TermExpr:
+		return checkColumnExecutable(schema, e.TermExpr.GetColumnInfo())
+	case *planpb.Expr_UnaryExpr:
+		return checkExecutable(schema, e.UnaryExpr.GetChild())
+	case *planpb.Expr_BinaryExpr:
+		if err := checkExecutable(schema, e.BinaryExpr.GetLeft()); err != nil {
+			return err
+		}
+		return checkExecutable(schema, e.BinaryExpr.GetRight())
+	case *planpb.Expr_CompareExpr:
+		if err := checkColumnExecutable(schema, e.CompareExpr.GetLeftColumnInfo()); err != nil {
+			return err
+		}
+		return checkColumnExecutable(schema, e.CompareExpr.GetRightColumnInfo())
+	case *planpb.Expr_UnaryRangeExpr:
+		return checkColumnExecutable(schema, e.UnaryRangeExpr.GetColumnInfo())
+	case *planpb.Expr_BinaryRangeExpr:
+		return checkColumnExecutable(schema, e.BinaryRangeExpr.GetColumnInfo())
+	case *planpb.Expr_BinaryArithOpEvalRangeExpr:
+		return checkColumnExecutable(schema, e.BinaryArithOpEvalRangeExpr.GetColumnInfo())
+	case *planpb.Expr_BinaryArithExpr:
+		if err := checkExecutable(schema, e.BinaryArithExpr.GetLeft()); err != nil {
+			return err
+		}
+		return checkExecutable(schema, e.BinaryArithExpr.GetRight())
+	case *planpb.Expr_ColumnExpr:
+		return checkColumnExecutable(schema, e.ColumnExpr.GetInfo())
+	case *planpb.Expr_ArrayContainsExpr:
+		// segcore has no executor of array_contains for any kind of field
+		return fmt.Errorf("array_contains expressions are not supported by the query nodes yet")
+	}
+	return nil
+}
+
+func checkColumnExecutable(schema *typeutil.SchemaHelper, info *planpb.ColumnInfo) error {
+	if typeutil.IsJSONType(info.GetDataType()) || typeutil.IsArrayType(info.GetDataType()) {
+		fieldName := fmt.Sprint(info.GetFieldId())
+		if field, err := schema.GetFieldFromID(info.GetFieldId()); err == nil {
+			fieldName = field.GetName()
+		}
+		dataTypeName, _ := typeutil.GetDataTypeName(info.GetDataType())
+		return fmt.Errorf("filtering on %s field %s is not supported by the query nodes yet",
+			strings.ToLower(dataTypeName), fieldName)
+	}
+	return nil
+}
@@ -28,6 +28,9 @@ type PlanVisitor interface {
 	// Visit a parse tree produced by PlanParser#String.
 	VisitString(ctx *StringContext) interface{}
 
+	// Visit a parse tree produced by PlanParser#Array.
+	VisitArray(ctx *ArrayContext) interface{}
+
 	// Visit a parse tree produced by PlanParser#Term.
 	VisitTerm(ctx *TermContext) interface{}
 
@@ -52,6 +55,12 @@ type PlanVisitor interface {
 	// Visit a parse tree produced by PlanParser#Identifier.
 	VisitIdentifier(ctx *IdentifierContext) interface{}
 
+	// Visit a parse tree produced by PlanParser#ArrayContains.
+	VisitArrayContains(ctx *ArrayContainsContext) interface{}
+
+	// Visit a parse tree produced by PlanParser#JSONIdentifier.
+	VisitJSONIdentifier(ctx *JSONIdentifierContext) interface{}
+
 	// Visit a parse tree produced by PlanParser#BitXor.
 	VisitBitXor(ctx *BitXorContext) interface{}
 
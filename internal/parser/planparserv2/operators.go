@@ -78,6 +78,10 @@ func Add(a, b *planpb.GenericValue) *ExprWithType {
 		return nil
 	}
 
+	if IsArray(a) || IsArray(b) {
+		return nil
+	}
+
 	aFloat, bFloat, aInt, bInt := IsFloating(a), IsFloating(b), IsInteger(a), IsInteger(b)
 
 	if aFloat && bFloat {
@@ -115,6 +119,10 @@ func Subtract(a, b *planpb.GenericValue) *ExprWithType {
 		return nil
 	}
 
+	if IsArray(a) || IsArray(b) {
+		return nil
+	}
+
 	aFloat, bFloat, aInt, bInt := IsFloating(a), IsFloating(b), IsInteger(a), IsInteger(b)
 
 	if aFloat && bFloat {
@@ -152,6 +160,10 @@ func Multiply(a, b *planpb.GenericValue) *ExprWithType {
 		return nil
 	}
 
+	if IsArray(a) || IsArray(b) {
+		return nil
+	}
+
 	aFloat, bFloat, aInt, bInt := IsFloating(a), IsFloating(b), IsInteger(a), IsInteger(b)
 
 	if aFloat && bFloat {
@@ -189,6 +201,10 @@ func Divide(a, b *planpb.GenericValue) (*ExprWithType, error) {
 		return nil, fmt.Errorf("divide cannot apply on string field")
 	}
 
+	if IsArray(a) || IsArray(b) {
+		return nil, fmt.Errorf("divide cannot apply on array field")
+	}
+
 	aFloat, bFloat, aInt, bInt := IsFloating(a), IsFloating(b), IsInteger(a), IsInteger(b)
 
 	if bFloat && b.GetFloatVal() == 0 {
@@ -259,6 +275,10 @@ func Power(a, b *planpb.GenericValue) *ExprWithType {
 		return nil
 	}
 
+	if IsArray(a) || IsArray(b) {
+		return nil
+	}
+
 	aFloat, bFloat, aInt, bInt := IsFloating(a), IsFloating(b), IsInteger(a), IsInteger(b)
 
 	if aFloat && bFloat {
@@ -29,6 +29,13 @@ func (v *ParserVisitor) translateIdentifier(identifier string) (*ExprWithType, e
 	if err != nil {
 		return nil, err
 	}
+	elementType := schemapb.DataType_None
+	if typeutil.IsArrayType(field.DataType) {
+		elementType, err = typeutil.GetElementType(field)
+		if err != nil {
+			return nil, err
+		}
+	}
 	return &ExprWithType{
 		expr: &planpb.Expr{
 			Expr: &planpb.Expr_ColumnExpr{
@@ -38,6 +45,7 @@ func (v *ParserVisitor) translateIdentifier(identifier string) (*ExprWithType, e
 						DataType:     field.DataType,
 						IsPrimaryKey: field.IsPrimaryKey,
 						IsAutoID:     field.AutoID,
+						ElementType:  elementType,
 					},
 				},
 			},
@@ -56,6 +64,38 @@ func (v *ParserVisitor) VisitIdentifier(ctx *parser.IdentifierContext) interface
 	return expr
 }
 
+// VisitJSONIdentifier translates expr to column plan with the nested path of the json field.
+func (v *ParserVisitor) VisitJSONIdentifier(ctx *parser.JSONIdentifierContext) interface{} {
+	identifier := ctx.JSONIdentifier().GetText()
+	fieldName, nestedPath, err := parseJSONIdentifier(identifier)
+	if err != nil {
+		return err
+	}
+	field, err := v.schema.GetFieldFromName(fieldName)
+	if err != nil {
+		return err
+	}
+	if !typeutil.IsJSONType(field.DataType) {
+		return fmt.Errorf("%s is not a json field, path access is unsupported: %s", fieldName, identifier)
+	}
+	return &ExprWithType{
+		expr: &planpb.Expr{
+			Expr: &planpb.Expr_ColumnExpr{
+				ColumnExpr: &planpb.ColumnExpr{
+					Info: &planpb.ColumnInfo{
+						FieldId:      field.FieldID,
+						DataType:     field.DataType,
+						IsPrimaryKey: field.IsPrimaryKey,
+						IsAutoID:     field.AutoID,
+						NestedPath:   nestedPath,
+					},
+				},
+			},
+		},
+		dataType: field.DataType,
+	}
+}
+
 // VisitBoolean translates expr to GenericValue.
 func (v *ParserVisitor) VisitBoolean(ctx *parser.BooleanContext) interface{} {
 	literal := ctx.BooleanConstant().GetText()
@@ -131,6 +171,117 @@ func (v *ParserVisitor) VisitString(ctx *parser.StringContext) interface{} {
 	}
 }
 
+// VisitArray translates expr to GenericValue of array.
+func (v *ParserVisitor) VisitArray(ctx *parser.ArrayContext) interface{} {
+	allExpr := ctx.AllExpr()
+	values := make([]*planpb.GenericValue, 0, len(allExpr))
+	for i, e := range allExpr {
+		element := e.Accept(v)
+		if err := getError(element); err != nil {
+			return err
+		}
+		n := getGenericValue(element)
+		if n == nil {
+			return fmt.Errorf("value '%s' in array cannot be a non-const expression", ctx.Expr(i).GetText())
+		}
+		if IsArray(n) {
+			return fmt.Errorf("nested array is not supported: %s", ctx.Expr(i).GetText())
+		}
+		values = append(values, n)
+	}
+	return &ExprWithType{
+		dataType: typeutil.DataTypeArray,
+		expr: &planpb.Expr{
+			Expr: &planpb.Expr_ValueExpr{
+				ValueExpr: &planpb.ValueExpr{
+					Value: NewArray(values),
+				},
+			},
+		},
+	}
+}
+
+// VisitArrayContains translates expr to array contains plan.
+func (v *ParserVisitor) VisitArrayContains(ctx *parser.ArrayContainsContext) interface{} {
+	funcName := ctx.GetOp().GetText()
+	child := ctx.Expr(0).Accept(v)
+	if err := getError(child); err != nil {
+		return err
+	}
+
+	childExpr := getExpr(child)
+	if childExpr == nil || getGenericValue(child) != nil {
+		return fmt.Errorf("'%s' can only be used on non-const expression, but got: %s", funcName, ctx.Expr(0).GetText())
+	}
+	columnInfo := toColumnInfo(childExpr)
+	if columnInfo == nil {
+		return fmt.Errorf("'%s' can only be used on single field, but got: %s", funcName, ctx.Expr(0).GetText())
+	}
+	if !typeutil.IsArrayType(columnInfo.GetDataType()) && !typeutil.IsJSONType(columnInfo.GetDataType()) {
+		return fmt.Errorf("'%s' can only be used on array or json field, but got: %s", funcName, ctx.Expr(0).GetText())
+	}
+	elementType := columnInfo.GetElementType()
+	if typeutil.IsJSONType(columnInfo.GetDataType()) {
+		elementType = columnInfo.GetDataType()
+	}
+
+	value := ctx.Expr(1).Accept(v)
+	if err := getError(value); err != nil {
+		return err
+	}
+	n := getGenericValue(value)
+	if n == nil {
+		return fmt.Errorf("'%s' can only be used with const value, but got: %s", funcName, ctx.Expr(1).GetText())
+	}
+
+	var op planpb.ArrayContainsExpr_ArrayOp
+	var candidates []*planpb.GenericValue
+	switch ctx.GetOp().GetTokenType() {
+	case parser.PlanParserArrayContains:
+		if IsArray(n) {
+			return fmt.Errorf("'%s' expects a single value, but got: %s", funcName, ctx.Expr(1).GetText())
+		}
+		op = planpb.ArrayContainsExpr_Contains
+		candidates = []*planpb.GenericValue{n}
+	case parser.PlanParserArrayContainsAll, parser.PlanParserArrayContainsAny:
+		if !IsArray(n) {
+			return fmt.Errorf("'%s' expects an array value, but got: %s", funcName, ctx.Expr(1).GetText())
+		}
+		op = planpb.ArrayContainsExpr_ContainsAll
+		if ctx.GetOp().GetTokenType() == parser.PlanParserArrayContainsAny {
+			op = planpb.ArrayContainsExpr_ContainsAny
+		}
+		candidates = n.GetArrayVal().GetArray()
+	default:
+		return fmt.Errorf("unsupported array operator: %s", funcName)
+	}
+	if len(candidates) <= 0 {
+		return fmt.Errorf("'%s' has empty value list", funcName)
+	}
+
+	elements := make([]*planpb.GenericValue, 0, len(candidates))
+	for _, candidate := range candidates {
+		castedValue, err := castValue(elementType, candidate)
+		if err != nil {
+			return fmt.Errorf("value '%s' in '%s' cannot be casted to %s", ctx.Expr(1).GetText(), funcName, elementType.String())
+		}
+		elements = append(elements, castedValue)
+	}
+
+	return &ExprWithType{
+		expr: &planpb.Expr{
+			Expr: &planpb.Expr_ArrayContainsExpr{
+				ArrayContainsExpr: &planpb.ArrayContainsExpr{
+					ColumnInfo: columnInfo,
+					Elements:   elements,
+					Op:         op,
+				},
+			},
+		},
+		dataType: schemapb.DataType_Bool,
+	}
+}
+
 // VisitAddSub translates expr to arithmetic plan.
 func (v *ParserVisitor) VisitAddSub(ctx *parser.AddSubContext) interface{} {
 	left := ctx.Expr(0).Accept(v)
@@ -395,7 +546,7 @@ func (v *ParserVisitor) VisitLike(ctx *parser.LikeContext) interface{} {
 		return fmt.Errorf("the left operand of like is invalid")
 	}
 
-	if !typeutil.IsStringType(leftExpr.dataType) {
+	if !typeutil.IsStringType(leftExpr.dataType) && !typeutil.IsJSONType(leftExpr.dataType) {
 		return fmt.Errorf("like operation on non-string field is unsupported")
 	}
 
@@ -74,6 +74,9 @@ func CreateRetrievePlan(schemaPb *schemapb.CollectionSchema, exprStr string) (*p
 	if err != nil {
 		return nil, err
 	}
+	if err := checkExecutable(schema, expr); err != nil {
+		return nil, err
+	}
 
 	planNode := &planpb.PlanNode{
 		Node: &planpb.PlanNode_Predicates{
@@ -93,6 +96,9 @@ func CreateSearchPlan(schemaPb *schemapb.CollectionSchema, exprStr string, vecto
 	if err != nil {
 		return nil, err
 	}
+	if err := checkExecutable(schema, expr); err != nil {
+		return nil, err
+	}
 	vectorField, err := schema.GetFieldFromName(vectorFieldName)
 	if err != nil {
 		return nil, err
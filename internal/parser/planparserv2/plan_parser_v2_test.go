@@ -8,6 +8,7 @@ import (
 
 	"github.com/stretchr/testify/assert"
 
+	"github.com/milvus-io/milvus-proto/go-api/commonpb"
 	"github.com/milvus-io/milvus-proto/go-api/schemapb"
 	"github.com/milvus-io/milvus/internal/util/typeutil"
 )
@@ -24,6 +25,15 @@ func newTestSchema() *schemapb.CollectionSchema {
 		}
 		fields = append(fields, newField)
 	}
+	// json and array fields are not defined by milvus-proto yet
+	fields = append(fields, &schemapb.FieldSchema{
+		FieldID: int64(100 + typeutil.DataTypeJSON), Name: "JSONField", DataType: typeutil.DataTypeJSON,
+	}, &schemapb.FieldSchema{
+		FieldID: int64(100 + typeutil.DataTypeArray), Name: "ArrayField", DataType: typeutil.DataTypeArray,
+		TypeParams: []*commonpb.KeyValuePair{
+			{Key: typeutil.ElementTypeKey, Value: schemapb.DataType_Int64.String()},
+		},
+	})
 
 	return &schemapb.CollectionSchema{
 		Name:        "test",
@@ -236,6 +246,92 @@ func TestExpr_Identifier(t *testing.T) {
 	}
 }
 
+func TestExpr_JSONIdentifier(t *testing.T) {
+	schema := newTestSchema()
+	helper, err := typeutil.CreateSchemaHelper(schema)
+	assert.NoError(t, err)
+
+	expr, err := ParseExpr(helper, `JSONField["brand"]["name"] == "x"`)
+	assert.NoError(t, err)
+	unaryRange := expr.GetUnaryRangeExpr()
+	assert.NotNil(t, unaryRange)
+	assert.Equal(t, typeutil.DataTypeJSON, unaryRange.GetColumnInfo().GetDataType())
+	assert.Equal(t, []string{"brand", "name"}, unaryRange.GetColumnInfo().GetNestedPath())
+	assert.Equal(t, planpb.OpType_Equal, unaryRange.GetOp())
+	assert.Equal(t, "x", unaryRange.GetValue().GetStringVal())
+
+	exprStrs := []string{
+		`JSONField["a"] > 1`,
+		`JSONField["a"][0] <= 2.5`,
+		`JSONField["a"]["b"] != true`,
+		`JSONField["a"] in [1, "b", false]`,
+		`JSONField["a"] like "abc%"`,
+		`JSONField["a\"b"] == 1`,
+		`JSONField["a"] > 1 && Int64Field < 2`,
+	}
+	for _, exprStr := range exprStrs {
+		assertValidExpr(t, helper, exprStr)
+	}
+
+	invalidExprs := []string{
+		`Int64Field["a"] > 1`,
+		`NotExistField["a"] > 1`,
+		`JSONField["a"] + 1 == 2`,
+	}
+	for _, exprStr := range invalidExprs {
+		assertInvalidExpr(t, helper, exprStr)
+	}
+}
+
+func TestExpr_ArrayContains(t *testing.T) {
+	schema := newTestSchema()
+	helper, err := typeutil.CreateSchemaHelper(schema)
+	assert.NoError(t, err)
+
+	expr, err := ParseExpr(helper, `array_contains(ArrayField, 1)`)
+	assert.NoError(t, err)
+	arrayContains := expr.GetArrayContainsExpr()
+	assert.NotNil(t, arrayContains)
+	assert.Equal(t, planpb.ArrayContainsExpr_Contains, arrayContains.GetOp())
+	assert.Equal(t, typeutil.DataTypeArray, arrayContains.GetColumnInfo().GetDataType())
+	assert.Equal(t, schemapb.DataType_Int64, arrayContains.GetColumnInfo().GetElementType())
+	assert.Equal(t, 1, len(arrayContains.GetElements()))
+	assert.Equal(t, int64(1), arrayContains.GetElements()[0].GetInt64Val())
+
+	expr, err = ParseExpr(helper, `ARRAY_CONTAINS_ANY(ArrayField, [1, 2, 3,])`)
+	assert.NoError(t, err)
+	arrayContains = expr.GetArrayContainsExpr()
+	assert.NotNil(t, arrayContains)
+	assert.Equal(t, planpb.ArrayContainsExpr_ContainsAny, arrayContains.GetOp())
+	assert.Equal(t, 3, len(arrayContains.GetElements()))
+
+	exprStrs := []string{
+		`array_contains_all(ArrayField, [1, 2])`,
+		`array_contains(JSONField["tags"], "a")`,
+		`array_contains_any(JSONField["tags"], ["a", 1, true])`,
+		`not array_contains(ArrayField, 1) && Int64Field > 1`,
+	}
+	for _, exprStr := range exprStrs {
+		assertValidExpr(t, helper, exprStr)
+	}
+
+	invalidExprs := []string{
+		`array_contains(Int64Field, 1)`,
+		`array_contains(ArrayField, "a")`,
+		`array_contains(ArrayField, [1, 2])`,
+		`array_contains_all(ArrayField, 1)`,
+		`array_contains_any(ArrayField, [Int64Field])`,
+		`array_contains_any(ArrayField, [[1], [2]])`,
+		`array_contains(1, 1)`,
+		`array_contains(ArrayField + 1, 1)`,
+		`ArrayField == [1, 2]`,
+		`[1] + 1 == 2`,
+	}
+	for _, exprStr := range invalidExprs {
+		assertInvalidExpr(t, helper, exprStr)
+	}
+}
+
 func TestExpr_Constant(t *testing.T) {
 	schema := newTestSchema()
 	helper, err := typeutil.CreateSchemaHelper(schema)
@@ -465,6 +561,22 @@ func TestCreateRetrievePlan_Invalid(t *testing.T) {
 		_, err := CreateRetrievePlan(schema, "invalid expression")
 		assert.Error(t, err)
 	})
+
+	t.Run("not executable", func(t *testing.T) {
+		schema := newTestSchema()
+		exprStrs := []string{
+			`JSONField["a"] > 1`,
+			`JSONField["a"] in [1, 2]`,
+			`JSONField["a"] like "abc%"`,
+			`Int64Field > 0 || 1 < JSONField["a"] < 2`,
+			`array_contains(ArrayField, 1)`,
+			`Int64Field > 0 && not array_contains_any(ArrayField, [1, 2])`,
+		}
+		for _, exprStr := range exprStrs {
+			_, err := CreateRetrievePlan(schema, exprStr)
+			assert.Error(t, err, exprStr)
+		}
+	})
 }
 
 func TestCreateSearchPlan_Invalid(t *testing.T) {
@@ -492,6 +604,12 @@ func TestCreateSearchPlan_Invalid(t *testing.T) {
 		_, err := CreateSearchPlan(schema, "Int64Field > 0", "VarCharField", nil)
 		assert.Error(t, err)
 	})
+
+	t.Run("not executable", func(t *testing.T) {
+		schema := newTestSchema()
+		_, err := CreateSearchPlan(schema, `Int64Field > 0 && not (JSONField["a"] == 1)`, "FloatVectorField", nil)
+		assert.Error(t, err)
+	})
 }
 
 func Test_handleExpr(t *testing.T) {
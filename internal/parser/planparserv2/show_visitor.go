@@ -16,6 +16,12 @@ func extractColumnInfo(info *planpb.ColumnInfo) interface{} {
 	js["data_type"] = info.GetDataType().String()
 	js["auto_id"] = info.GetIsAutoID()
 	js["is_pk"] = info.GetIsPrimaryKey()
+	if len(info.GetNestedPath()) > 0 {
+		js["nested_path"] = info.GetNestedPath()
+	}
+	if info.GetElementType() != 0 {
+		js["element_type"] = info.GetElementType().String()
+	}
 	return js
 }
 
@@ -31,6 +37,12 @@ func extractGenericValue(value *planpb.GenericValue) interface{} {
 		return realValue.FloatVal
 	case *planpb.GenericValue_StringVal:
 		return realValue.StringVal
+	case *planpb.GenericValue_ArrayVal:
+		values := make([]interface{}, 0, len(realValue.ArrayVal.GetArray()))
+		for _, v := range realValue.ArrayVal.GetArray() {
+			values = append(values, extractGenericValue(v))
+		}
+		return values
 	default:
 		return nil
 	}
@@ -59,6 +71,8 @@ func (v *ShowExprVisitor) VisitExpr(expr *planpb.Expr) interface{} {
 		js["expr"] = v.VisitValueExpr(realExpr.ValueExpr)
 	case *planpb.Expr_ColumnExpr:
 		js["expr"] = v.VisitColumnExpr(realExpr.ColumnExpr)
+	case *planpb.Expr_ArrayContainsExpr:
+		js["expr"] = v.VisitArrayContainsExpr(realExpr.ArrayContainsExpr)
 	default:
 		js["expr"] = ""
 	}
@@ -155,6 +169,19 @@ func (v *ShowExprVisitor) VisitColumnExpr(expr *planpb.ColumnExpr) interface{} {
 	return js
 }
 
+func (v *ShowExprVisitor) VisitArrayContainsExpr(expr *planpb.ArrayContainsExpr) interface{} {
+	js := make(map[string]interface{})
+	js["expr_type"] = "array_contains"
+	js["op"] = expr.Op.String()
+	js["column_info"] = extractColumnInfo(expr.GetColumnInfo())
+	elements := make([]interface{}, 0, len(expr.GetElements()))
+	for _, e := range expr.GetElements() {
+		elements = append(elements, extractGenericValue(e))
+	}
+	js["elements"] = elements
+	return js
+}
+
 func NewShowExprVisitor() LogicalExprVisitor {
 	return &ShowExprVisitor{}
 }
@@ -2,6 +2,8 @@ package planparserv2
 
 import (
 	"fmt"
+	"strconv"
+	"strings"
 
 	"github.com/milvus-io/milvus/internal/util/typeutil"
 
@@ -45,6 +47,14 @@ func IsString(n *planpb.GenericValue) bool {
 	return false
 }
 
+func IsArray(n *planpb.GenericValue) bool {
+	switch n.GetVal().(type) {
+	case *planpb.GenericValue_ArrayVal:
+		return true
+	}
+	return false
+}
+
 func NewBool(value bool) *planpb.GenericValue {
 	return &planpb.GenericValue{
 		Val: &planpb.GenericValue_BoolVal{
@@ -77,6 +87,16 @@ func NewString(value string) *planpb.GenericValue {
 	}
 }
 
+func NewArray(values []*planpb.GenericValue) *planpb.GenericValue {
+	return &planpb.GenericValue{
+		Val: &planpb.GenericValue_ArrayVal{
+			ArrayVal: &planpb.Array{
+				Array: values,
+			},
+		},
+	}
+}
+
 func toValueExpr(n *planpb.GenericValue) *ExprWithType {
 	expr := &planpb.Expr{
 		Expr: &planpb.Expr_ValueExpr{
@@ -107,6 +127,11 @@ func toValueExpr(n *planpb.GenericValue) *ExprWithType {
 			expr:     expr,
 			dataType: schemapb.DataType_VarChar,
 		}
+	case *planpb.GenericValue_ArrayVal:
+		return &ExprWithType{
+			expr:     expr,
+			dataType: typeutil.DataTypeArray,
+		}
 	default:
 		return nil
 	}
@@ -154,7 +179,58 @@ func toColumnInfo(left *ExprWithType) *planpb.ColumnInfo {
 	return left.expr.GetColumnExpr().GetInfo()
 }
 
+// parseJSONIdentifier splits a json identifier like `meta["brand"]["name"]` into
+// the field name and the nested path.
+func parseJSONIdentifier(identifier string) (string, []string, error) {
+	start := strings.Index(identifier, "[")
+	if start <= 0 {
+		return "", nil, fmt.Errorf("invalid json identifier: %s", identifier)
+	}
+	fieldName := identifier[:start]
+	var nestedPath []string
+	rest := identifier[start:]
+	for len(rest) > 0 {
+		if rest[0] != '[' {
+			return "", nil, fmt.Errorf("invalid json identifier: %s", identifier)
+		}
+		rest = rest[1:]
+		var key string
+		if strings.HasPrefix(rest, "\"") {
+			quoted, err := strconv.QuotedPrefix(rest)
+			if err != nil {
+				return "", nil, fmt.Errorf("invalid json identifier: %s, %w", identifier, err)
+			}
+			if key, err = strconv.Unquote(quoted); err != nil {
+				return "", nil, fmt.Errorf("invalid json identifier: %s, %w", identifier, err)
+			}
+			rest = rest[len(quoted):]
+		} else {
+			end := strings.Index(rest, "]")
+			if end < 0 {
+				return "", nil, fmt.Errorf("invalid json identifier: %s", identifier)
+			}
+			index, err := strconv.ParseInt(rest[:end], 0, 64)
+			if err != nil {
+				return "", nil, fmt.Errorf("invalid json identifier: %s, %w", identifier, err)
+			}
+			key = strconv.FormatInt(index, 10)
+			rest = rest[end:]
+		}
+		if !strings.HasPrefix(rest, "]") {
+			return "", nil, fmt.Errorf("invalid json identifier: %s", identifier)
+		}
+		rest = rest[1:]
+		nestedPath = append(nestedPath, key)
+	}
+	return fieldName, nestedPath, nil
+}
+
 func castValue(dataType schemapb.DataType, value *planpb.GenericValue) (*planpb.GenericValue, error) {
+	// the type of value inside a json field is only known at execution time
+	if typeutil.IsJSONType(dataType) {
+		return value, nil
+	}
+
 	if typeutil.IsStringType(dataType) && IsString(value) {
 		return value, nil
 	}
@@ -307,12 +383,19 @@ func handleCompare(op planpb.OpType, left *ExprWithType, right *ExprWithType) (*
 }
 
 func relationalCompatible(t1, t2 schemapb.DataType) bool {
+	if typeutil.IsJSONType(t1) || typeutil.IsJSONType(t2) {
+		return true
+	}
 	both := typeutil.IsStringType(t1) && typeutil.IsStringType(t2)
 	neither := !typeutil.IsStringType(t1) && !typeutil.IsStringType(t2)
 	return both || neither
 }
 
 func HandleCompare(op int, left, right *ExprWithType) (*planpb.Expr, error) {
+	if left == nil || right == nil {
+		return nil, fmt.Errorf("invalid comparison between non-comparable expressions")
+	}
+
 	if !relationalCompatible(left.dataType, right.dataType) {
 		return nil, fmt.Errorf("comparisons between string and non-string are not supported")
 	}
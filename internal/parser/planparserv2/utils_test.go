@@ -4,6 +4,8 @@ import (
 	"testing"
 
 	"github.com/milvus-io/milvus-proto/go-api/schemapb"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
+	"github.com/stretchr/testify/assert"
 )
 
 func Test_relationalCompatible(t *testing.T) {
@@ -48,6 +50,14 @@ func Test_relationalCompatible(t *testing.T) {
 			},
 			want: false,
 		},
+		{
+			// json values are typed at execution time.
+			args: args{
+				t1: typeutil.DataTypeJSON,
+				t2: schemapb.DataType_VarChar,
+			},
+			want: true,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -57,3 +67,21 @@ func Test_relationalCompatible(t *testing.T) {
 		})
 	}
 }
+
+func Test_parseJSONIdentifier(t *testing.T) {
+	fieldName, nestedPath, err := parseJSONIdentifier(`meta["brand"]["name"]`)
+	assert.NoError(t, err)
+	assert.Equal(t, "meta", fieldName)
+	assert.Equal(t, []string{"brand", "name"}, nestedPath)
+
+	fieldName, nestedPath, err = parseJSONIdentifier(`meta["tags"][0]["a]b"]`)
+	assert.NoError(t, err)
+	assert.Equal(t, "meta", fieldName)
+	assert.Equal(t, []string{"tags", "0", "a]b"}, nestedPath)
+
+	invalids := []string{`meta`, `["a"]`, `meta["a"`, `meta[a]`, `meta["a"]b`}
+	for _, identifier := range invalids {
+		_, _, err = parseJSONIdentifier(identifier)
+		assert.Error(t, err, identifier)
+	}
+}
@@ -4237,6 +4237,337 @@ func (m *ImportTaskRequest) GetWorkingNodes() []int64 {
 	return nil
 }
 
+type ExportTask struct {
+	TaskId               int64                    `protobuf:"varint,1,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	CollectionId         int64                    `protobuf:"varint,2,opt,name=collection_id,json=collectionId,proto3" json:"collection_id,omitempty"`
+	PartitionIds         []int64                  `protobuf:"varint,3,rep,packed,name=partition_ids,json=partitionIds,proto3" json:"partition_ids,omitempty"`
+	Timestamp            uint64                   `protobuf:"varint,4,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	OutputPrefix         string                   `protobuf:"bytes,5,opt,name=output_prefix,json=outputPrefix,proto3" json:"output_prefix,omitempty"`
+	Format               string                   `protobuf:"bytes,6,opt,name=format,proto3" json:"format,omitempty"`
+	Segments             []*SegmentInfo           `protobuf:"bytes,7,rep,name=segments,proto3" json:"segments,omitempty"`
+	Infos                []*commonpb.KeyValuePair `protobuf:"bytes,8,rep,name=infos,proto3" json:"infos,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
+	XXX_unrecognized     []byte                   `json:"-"`
+	XXX_sizecache        int32                    `json:"-"`
+}
+
+func (m *ExportTask) Reset()         { *m = ExportTask{} }
+func (m *ExportTask) String() string { return proto.CompactTextString(m) }
+func (*ExportTask) ProtoMessage()    {}
+func (*ExportTask) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82cd95f524594f49, []int{65}
+}
+
+func (m *ExportTask) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ExportTask.Unmarshal(m, b)
+}
+func (m *ExportTask) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ExportTask.Marshal(b, m, deterministic)
+}
+func (m *ExportTask) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExportTask.Merge(m, src)
+}
+func (m *ExportTask) XXX_Size() int {
+	return xxx_messageInfo_ExportTask.Size(m)
+}
+func (m *ExportTask) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExportTask.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExportTask proto.InternalMessageInfo
+
+func (m *ExportTask) GetTaskId() int64 {
+	if m != nil {
+		return m.TaskId
+	}
+	return 0
+}
+
+func (m *ExportTask) GetCollectionId() int64 {
+	if m != nil {
+		return m.CollectionId
+	}
+	return 0
+}
+
+func (m *ExportTask) GetPartitionIds() []int64 {
+	if m != nil {
+		return m.PartitionIds
+	}
+	return nil
+}
+
+func (m *ExportTask) GetTimestamp() uint64 {
+	if m != nil {
+		return m.Timestamp
+	}
+	return 0
+}
+
+func (m *ExportTask) GetOutputPrefix() string {
+	if m != nil {
+		return m.OutputPrefix
+	}
+	return ""
+}
+
+func (m *ExportTask) GetFormat() string {
+	if m != nil {
+		return m.Format
+	}
+	return ""
+}
+
+func (m *ExportTask) GetSegments() []*SegmentInfo {
+	if m != nil {
+		return m.Segments
+	}
+	return nil
+}
+
+func (m *ExportTask) GetInfos() []*commonpb.KeyValuePair {
+	if m != nil {
+		return m.Infos
+	}
+	return nil
+}
+
+type ExportTaskResponse struct {
+	Status               *commonpb.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	DatanodeId           int64            `protobuf:"varint,2,opt,name=datanode_id,json=datanodeId,proto3" json:"datanode_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *ExportTaskResponse) Reset()         { *m = ExportTaskResponse{} }
+func (m *ExportTaskResponse) String() string { return proto.CompactTextString(m) }
+func (*ExportTaskResponse) ProtoMessage()    {}
+func (*ExportTaskResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82cd95f524594f49, []int{66}
+}
+
+func (m *ExportTaskResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ExportTaskResponse.Unmarshal(m, b)
+}
+func (m *ExportTaskResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ExportTaskResponse.Marshal(b, m, deterministic)
+}
+func (m *ExportTaskResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExportTaskResponse.Merge(m, src)
+}
+func (m *ExportTaskResponse) XXX_Size() int {
+	return xxx_messageInfo_ExportTaskResponse.Size(m)
+}
+func (m *ExportTaskResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExportTaskResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExportTaskResponse proto.InternalMessageInfo
+
+func (m *ExportTaskResponse) GetStatus() *commonpb.Status {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+func (m *ExportTaskResponse) GetDatanodeId() int64 {
+	if m != nil {
+		return m.DatanodeId
+	}
+	return 0
+}
+
+type ExportTaskRequest struct {
+	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	ExportTask           *ExportTask       `protobuf:"bytes,2,opt,name=export_task,json=exportTask,proto3" json:"export_task,omitempty"`
+	WorkingNodes         []int64           `protobuf:"varint,3,rep,packed,name=working_nodes,json=workingNodes,proto3" json:"working_nodes,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *ExportTaskRequest) Reset()         { *m = ExportTaskRequest{} }
+func (m *ExportTaskRequest) String() string { return proto.CompactTextString(m) }
+func (*ExportTaskRequest) ProtoMessage()    {}
+func (*ExportTaskRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82cd95f524594f49, []int{67}
+}
+
+func (m *ExportTaskRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ExportTaskRequest.Unmarshal(m, b)
+}
+func (m *ExportTaskRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ExportTaskRequest.Marshal(b, m, deterministic)
+}
+func (m *ExportTaskRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExportTaskRequest.Merge(m, src)
+}
+func (m *ExportTaskRequest) XXX_Size() int {
+	return xxx_messageInfo_ExportTaskRequest.Size(m)
+}
+func (m *ExportTaskRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExportTaskRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExportTaskRequest proto.InternalMessageInfo
+
+func (m *ExportTaskRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *ExportTaskRequest) GetExportTask() *ExportTask {
+	if m != nil {
+		return m.ExportTask
+	}
+	return nil
+}
+
+func (m *ExportTaskRequest) GetWorkingNodes() []int64 {
+	if m != nil {
+		return m.WorkingNodes
+	}
+	return nil
+}
+
+type RestoreSegmentsRequest struct {
+	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	SourceCollectionID   int64             `protobuf:"varint,2,opt,name=source_collectionID,json=sourceCollectionID,proto3" json:"source_collectionID,omitempty"`
+	Timestamp            uint64            `protobuf:"varint,3,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	CollectionID         int64             `protobuf:"varint,4,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
+	PartitionIDs         map[int64]int64   `protobuf:"bytes,5,rep,name=partitionIDs,proto3" json:"partitionIDs,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	Vchannels            map[string]string `protobuf:"bytes,6,rep,name=vchannels,proto3" json:"vchannels,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *RestoreSegmentsRequest) Reset()         { *m = RestoreSegmentsRequest{} }
+func (m *RestoreSegmentsRequest) String() string { return proto.CompactTextString(m) }
+func (*RestoreSegmentsRequest) ProtoMessage()    {}
+func (*RestoreSegmentsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82cd95f524594f49, []int{68}
+}
+
+func (m *RestoreSegmentsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RestoreSegmentsRequest.Unmarshal(m, b)
+}
+func (m *RestoreSegmentsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RestoreSegmentsRequest.Marshal(b, m, deterministic)
+}
+func (m *RestoreSegmentsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RestoreSegmentsRequest.Merge(m, src)
+}
+func (m *RestoreSegmentsRequest) XXX_Size() int {
+	return xxx_messageInfo_RestoreSegmentsRequest.Size(m)
+}
+func (m *RestoreSegmentsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RestoreSegmentsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RestoreSegmentsRequest proto.InternalMessageInfo
+
+func (m *RestoreSegmentsRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *RestoreSegmentsRequest) GetSourceCollectionID() int64 {
+	if m != nil {
+		return m.SourceCollectionID
+	}
+	return 0
+}
+
+func (m *RestoreSegmentsRequest) GetTimestamp() uint64 {
+	if m != nil {
+		return m.Timestamp
+	}
+	return 0
+}
+
+func (m *RestoreSegmentsRequest) GetCollectionID() int64 {
+	if m != nil {
+		return m.CollectionID
+	}
+	return 0
+}
+
+func (m *RestoreSegmentsRequest) GetPartitionIDs() map[int64]int64 {
+	if m != nil {
+		return m.PartitionIDs
+	}
+	return nil
+}
+
+func (m *RestoreSegmentsRequest) GetVchannels() map[string]string {
+	if m != nil {
+		return m.Vchannels
+	}
+	return nil
+}
+
+type RestoreSegmentsResponse struct {
+	Status               *commonpb.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	SegmentIDs           []int64          `protobuf:"varint,2,rep,packed,name=segmentIDs,proto3" json:"segmentIDs,omitempty"`
+	RowCount             int64            `protobuf:"varint,3,opt,name=row_count,json=rowCount,proto3" json:"row_count,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *RestoreSegmentsResponse) Reset()         { *m = RestoreSegmentsResponse{} }
+func (m *RestoreSegmentsResponse) String() string { return proto.CompactTextString(m) }
+func (*RestoreSegmentsResponse) ProtoMessage()    {}
+func (*RestoreSegmentsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82cd95f524594f49, []int{69}
+}
+
+func (m *RestoreSegmentsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RestoreSegmentsResponse.Unmarshal(m, b)
+}
+func (m *RestoreSegmentsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RestoreSegmentsResponse.Marshal(b, m, deterministic)
+}
+func (m *RestoreSegmentsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RestoreSegmentsResponse.Merge(m, src)
+}
+func (m *RestoreSegmentsResponse) XXX_Size() int {
+	return xxx_messageInfo_RestoreSegmentsResponse.Size(m)
+}
+func (m *RestoreSegmentsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RestoreSegmentsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RestoreSegmentsResponse proto.InternalMessageInfo
+
+func (m *RestoreSegmentsResponse) GetStatus() *commonpb.Status {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+func (m *RestoreSegmentsResponse) GetSegmentIDs() []int64 {
+	if m != nil {
+		return m.SegmentIDs
+	}
+	return nil
+}
+
+func (m *RestoreSegmentsResponse) GetRowCount() int64 {
+	if m != nil {
+		return m.RowCount
+	}
+	return 0
+}
+
 type UpdateSegmentStatisticsRequest struct {
 	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
 	Stats                []*SegmentStats   `protobuf:"bytes,2,rep,name=stats,proto3" json:"stats,omitempty"`
@@ -4249,7 +4580,7 @@ func (m *UpdateSegmentStatisticsRequest) Reset()         { *m = UpdateSegmentSta
 func (m *UpdateSegmentStatisticsRequest) String() string { return proto.CompactTextString(m) }
 func (*UpdateSegmentStatisticsRequest) ProtoMessage()    {}
 func (*UpdateSegmentStatisticsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_82cd95f524594f49, []int{65}
+	return fileDescriptor_82cd95f524594f49, []int{70}
 }
 
 func (m *UpdateSegmentStatisticsRequest) XXX_Unmarshal(b []byte) error {
@@ -4297,7 +4628,7 @@ func (m *UpdateChannelCheckpointRequest) Reset()         { *m = UpdateChannelChe
 func (m *UpdateChannelCheckpointRequest) String() string { return proto.CompactTextString(m) }
 func (*UpdateChannelCheckpointRequest) ProtoMessage()    {}
 func (*UpdateChannelCheckpointRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_82cd95f524594f49, []int{66}
+	return fileDescriptor_82cd95f524594f49, []int{71}
 }
 
 func (m *UpdateChannelCheckpointRequest) XXX_Unmarshal(b []byte) error {
@@ -4350,7 +4681,7 @@ func (m *ResendSegmentStatsRequest) Reset()         { *m = ResendSegmentStatsReq
 func (m *ResendSegmentStatsRequest) String() string { return proto.CompactTextString(m) }
 func (*ResendSegmentStatsRequest) ProtoMessage()    {}
 func (*ResendSegmentStatsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_82cd95f524594f49, []int{67}
+	return fileDescriptor_82cd95f524594f49, []int{72}
 }
 
 func (m *ResendSegmentStatsRequest) XXX_Unmarshal(b []byte) error {
@@ -4390,7 +4721,7 @@ func (m *ResendSegmentStatsResponse) Reset()         { *m = ResendSegmentStatsRe
 func (m *ResendSegmentStatsResponse) String() string { return proto.CompactTextString(m) }
 func (*ResendSegmentStatsResponse) ProtoMessage()    {}
 func (*ResendSegmentStatsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_82cd95f524594f49, []int{68}
+	return fileDescriptor_82cd95f524594f49, []int{73}
 }
 
 func (m *ResendSegmentStatsResponse) XXX_Unmarshal(b []byte) error {
@@ -4442,7 +4773,7 @@ func (m *AddImportSegmentRequest) Reset()         { *m = AddImportSegmentRequest
 func (m *AddImportSegmentRequest) String() string { return proto.CompactTextString(m) }
 func (*AddImportSegmentRequest) ProtoMessage()    {}
 func (*AddImportSegmentRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_82cd95f524594f49, []int{69}
+	return fileDescriptor_82cd95f524594f49, []int{74}
 }
 
 func (m *AddImportSegmentRequest) XXX_Unmarshal(b []byte) error {
@@ -4524,7 +4855,7 @@ func (m *AddImportSegmentResponse) Reset()         { *m = AddImportSegmentRespon
 func (m *AddImportSegmentResponse) String() string { return proto.CompactTextString(m) }
 func (*AddImportSegmentResponse) ProtoMessage()    {}
 func (*AddImportSegmentResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_82cd95f524594f49, []int{70}
+	return fileDescriptor_82cd95f524594f49, []int{75}
 }
 
 func (m *AddImportSegmentResponse) XXX_Unmarshal(b []byte) error {
@@ -4577,7 +4908,7 @@ func (m *SaveImportSegmentRequest) Reset()         { *m = SaveImportSegmentReque
 func (m *SaveImportSegmentRequest) String() string { return proto.CompactTextString(m) }
 func (*SaveImportSegmentRequest) ProtoMessage()    {}
 func (*SaveImportSegmentRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_82cd95f524594f49, []int{71}
+	return fileDescriptor_82cd95f524594f49, []int{76}
 }
 
 func (m *SaveImportSegmentRequest) XXX_Unmarshal(b []byte) error {
@@ -4666,7 +4997,7 @@ func (m *UnsetIsImportingStateRequest) Reset()         { *m = UnsetIsImportingSt
 func (m *UnsetIsImportingStateRequest) String() string { return proto.CompactTextString(m) }
 func (*UnsetIsImportingStateRequest) ProtoMessage()    {}
 func (*UnsetIsImportingStateRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_82cd95f524594f49, []int{72}
+	return fileDescriptor_82cd95f524594f49, []int{77}
 }
 
 func (m *UnsetIsImportingStateRequest) XXX_Unmarshal(b []byte) error {
@@ -4713,7 +5044,7 @@ func (m *MarkSegmentsDroppedRequest) Reset()         { *m = MarkSegmentsDroppedR
 func (m *MarkSegmentsDroppedRequest) String() string { return proto.CompactTextString(m) }
 func (*MarkSegmentsDroppedRequest) ProtoMessage()    {}
 func (*MarkSegmentsDroppedRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_82cd95f524594f49, []int{73}
+	return fileDescriptor_82cd95f524594f49, []int{78}
 }
 
 func (m *MarkSegmentsDroppedRequest) XXX_Unmarshal(b []byte) error {
@@ -4761,7 +5092,7 @@ func (m *SegmentReferenceLock) Reset()         { *m = SegmentReferenceLock{} }
 func (m *SegmentReferenceLock) String() string { return proto.CompactTextString(m) }
 func (*SegmentReferenceLock) ProtoMessage()    {}
 func (*SegmentReferenceLock) Descriptor() ([]byte, []int) {
-	return fileDescriptor_82cd95f524594f49, []int{74}
+	return fileDescriptor_82cd95f524594f49, []int{79}
 }
 
 func (m *SegmentReferenceLock) XXX_Unmarshal(b []byte) error {
@@ -4872,6 +5203,13 @@ func init() {
 	proto.RegisterType((*ImportTaskInfo)(nil), "milvus.proto.data.ImportTaskInfo")
 	proto.RegisterType((*ImportTaskResponse)(nil), "milvus.proto.data.ImportTaskResponse")
 	proto.RegisterType((*ImportTaskRequest)(nil), "milvus.proto.data.ImportTaskRequest")
+	proto.RegisterType((*ExportTask)(nil), "milvus.proto.data.ExportTask")
+	proto.RegisterType((*ExportTaskResponse)(nil), "milvus.proto.data.ExportTaskResponse")
+	proto.RegisterType((*ExportTaskRequest)(nil), "milvus.proto.data.ExportTaskRequest")
+	proto.RegisterType((*RestoreSegmentsRequest)(nil), "milvus.proto.data.RestoreSegmentsRequest")
+	proto.RegisterMapType((map[int64]int64)(nil), "milvus.proto.data.RestoreSegmentsRequest.PartitionIDsEntry")
+	proto.RegisterMapType((map[string]string)(nil), "milvus.proto.data.RestoreSegmentsRequest.VchannelsEntry")
+	proto.RegisterType((*RestoreSegmentsResponse)(nil), "milvus.proto.data.RestoreSegmentsResponse")
 	proto.RegisterType((*UpdateSegmentStatisticsRequest)(nil), "milvus.proto.data.UpdateSegmentStatisticsRequest")
 	proto.RegisterType((*UpdateChannelCheckpointRequest)(nil), "milvus.proto.data.UpdateChannelCheckpointRequest")
 	proto.RegisterType((*ResendSegmentStatsRequest)(nil), "milvus.proto.data.ResendSegmentStatsRequest")
@@ -4887,280 +5225,296 @@ func init() {
 func init() { proto.RegisterFile("data_coord.proto", fileDescriptor_82cd95f524594f49) }
 
 var fileDescriptor_82cd95f524594f49 = []byte{
-	// 4364 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x3c, 0x4b, 0x6f, 0x24, 0x49,
-	0x5a, 0x9d, 0xf5, 0x72, 0xd5, 0x57, 0x0f, 0x97, 0xa3, 0x7b, 0xec, 0xea, 0xea, 0xe7, 0x64, 0x4f,
-	0xcf, 0xf4, 0xf4, 0xf4, 0x74, 0xcf, 0x78, 0x18, 0xed, 0x40, 0xef, 0xcc, 0xaa, 0x6d, 0x8f, 0xbb,
-	0x0b, 0x6c, 0xaf, 0x37, 0xed, 0x9e, 0x96, 0x76, 0x91, 0x4a, 0xe9, 0xca, 0x70, 0x39, 0xd7, 0xf9,
-	0xa8, 0xce, 0xcc, 0xb2, 0xdb, 0xcb, 0x61, 0x47, 0xac, 0x84, 0x34, 0x08, 0xb1, 0x08, 0x09, 0x01,
-	0x07, 0x24, 0xc4, 0x69, 0x59, 0x04, 0x42, 0x5a, 0x71, 0xe1, 0xc2, 0x75, 0x04, 0x87, 0x15, 0x42,
-	0xe2, 0x07, 0x70, 0x00, 0xee, 0x5c, 0x39, 0xa0, 0x78, 0x64, 0xe4, 0xbb, 0x2a, 0x5d, 0xd5, 0x3d,
-	0x8d, 0xe0, 0x56, 0x11, 0xf9, 0x45, 0x7c, 0x11, 0x5f, 0x7c, 0xef, 0xf8, 0xa2, 0xa0, 0xad, 0xa9,
-	0x9e, 0xda, 0x1f, 0xd8, 0xb6, 0xa3, 0xdd, 0x1f, 0x39, 0xb6, 0x67, 0xa3, 0x25, 0x53, 0x37, 0x4e,
-	0xc6, 0x2e, 0x6b, 0xdd, 0x27, 0x9f, 0xbb, 0x8d, 0x81, 0x6d, 0x9a, 0xb6, 0xc5, 0xba, 0xba, 0x2d,
-	0xdd, 0xf2, 0xb0, 0x63, 0xa9, 0x06, 0x6f, 0x37, 0xc2, 0x03, 0xba, 0x0d, 0x77, 0x70, 0x84, 0x4d,
-	0x95, 0xb5, 0xe4, 0x05, 0x28, 0x7f, 0x6e, 0x8e, 0xbc, 0x33, 0xf9, 0x4f, 0x24, 0x68, 0x6c, 0x1a,
-	0x63, 0xf7, 0x48, 0xc1, 0xcf, 0xc7, 0xd8, 0xf5, 0xd0, 0x07, 0x50, 0x3a, 0x50, 0x5d, 0xdc, 0x91,
-	0x6e, 0x4a, 0x77, 0xea, 0xab, 0x57, 0xef, 0x47, 0xb0, 0x72, 0x7c, 0xdb, 0xee, 0x70, 0x4d, 0x75,
-	0xb1, 0x42, 0x21, 0x11, 0x82, 0x92, 0x76, 0xd0, 0xdb, 0xe8, 0x14, 0x6e, 0x4a, 0x77, 0x8a, 0x0a,
-	0xfd, 0x8d, 0xae, 0x03, 0xb8, 0x78, 0x68, 0x62, 0xcb, 0xeb, 0x6d, 0xb8, 0x9d, 0xe2, 0xcd, 0xe2,
-	0x9d, 0xa2, 0x12, 0xea, 0x41, 0x32, 0x34, 0x06, 0xb6, 0x61, 0xe0, 0x81, 0xa7, 0xdb, 0x56, 0x6f,
-	0xa3, 0x53, 0xa2, 0x63, 0x23, 0x7d, 0xf2, 0xbf, 0x4b, 0xd0, 0xe4, 0x4b, 0x73, 0x47, 0xb6, 0xe5,
-	0x62, 0xf4, 0x11, 0x54, 0x5c, 0x4f, 0xf5, 0xc6, 0x2e, 0x5f, 0xdd, 0x95, 0xd4, 0xd5, 0xed, 0x51,
-	0x10, 0x85, 0x83, 0xa6, 0x2e, 0x2f, 0x8e, 0xbe, 0x98, 0x44, 0x1f, 0xdb, 0x42, 0x29, 0xb1, 0x85,
-	0x3b, 0xb0, 0x78, 0x48, 0x56, 0xb7, 0x17, 0x00, 0x95, 0x29, 0x50, 0xbc, 0x9b, 0xcc, 0xe4, 0xe9,
-	0x26, 0xfe, 0xee, 0xe1, 0x1e, 0x56, 0x8d, 0x4e, 0x85, 0xe2, 0x0a, 0xf5, 0xc8, 0xff, 0x2c, 0x41,
-	0x5b, 0x80, 0xfb, 0xe7, 0x70, 0x09, 0xca, 0x03, 0x7b, 0x6c, 0x79, 0x74, 0xab, 0x4d, 0x85, 0x35,
-	0xd0, 0x9b, 0xd0, 0x18, 0x1c, 0xa9, 0x96, 0x85, 0x8d, 0xbe, 0xa5, 0x9a, 0x98, 0x6e, 0xaa, 0xa6,
-	0xd4, 0x79, 0xdf, 0x8e, 0x6a, 0xe2, 0x5c, 0x7b, 0xbb, 0x09, 0xf5, 0x91, 0xea, 0x78, 0x7a, 0x84,
-	0xfa, 0xe1, 0x2e, 0xd4, 0x85, 0xaa, 0xee, 0xf6, 0xcc, 0x91, 0xed, 0x78, 0x9d, 0xf2, 0x4d, 0xe9,
-	0x4e, 0x55, 0x11, 0x6d, 0x82, 0x41, 0xa7, 0xbf, 0xf6, 0x55, 0xf7, 0xb8, 0xb7, 0xc1, 0x77, 0x14,
-	0xe9, 0x93, 0xff, 0x5c, 0x82, 0xe5, 0x47, 0xae, 0xab, 0x0f, 0xad, 0xc4, 0xce, 0x96, 0xa1, 0x62,
-	0xd9, 0x1a, 0xee, 0x6d, 0xd0, 0xad, 0x15, 0x15, 0xde, 0x42, 0x57, 0xa0, 0x36, 0xc2, 0xd8, 0xe9,
-	0x3b, 0xb6, 0xe1, 0x6f, 0xac, 0x4a, 0x3a, 0x14, 0xdb, 0xc0, 0xe8, 0x7b, 0xb0, 0xe4, 0xc6, 0x26,
-	0x62, 0x7c, 0x55, 0x5f, 0xbd, 0x75, 0x3f, 0x21, 0x19, 0xf7, 0xe3, 0x48, 0x95, 0xe4, 0x68, 0xf9,
-	0xcb, 0x02, 0x5c, 0x14, 0x70, 0x6c, 0xad, 0xe4, 0x37, 0xa1, 0xbc, 0x8b, 0x87, 0x62, 0x79, 0xac,
-	0x91, 0x87, 0xf2, 0xe2, 0xc8, 0x8a, 0xe1, 0x23, 0xcb, 0xc1, 0xea, 0xf1, 0xf3, 0x28, 0x27, 0xcf,
-	0xe3, 0x06, 0xd4, 0xf1, 0x8b, 0x91, 0xee, 0xe0, 0x3e, 0x61, 0x1c, 0x4a, 0xf2, 0x92, 0x02, 0xac,
-	0x6b, 0x5f, 0x37, 0xc3, 0xb2, 0xb1, 0x90, 0x5b, 0x36, 0xe4, 0xbf, 0x90, 0x60, 0x25, 0x71, 0x4a,
-	0x5c, 0xd8, 0x14, 0x68, 0xd3, 0x9d, 0x07, 0x94, 0x21, 0x62, 0x47, 0x08, 0xfe, 0xf6, 0x24, 0x82,
-	0x07, 0xe0, 0x4a, 0x62, 0x7c, 0x68, 0x91, 0x85, 0xfc, 0x8b, 0x3c, 0x86, 0x95, 0xc7, 0xd8, 0xe3,
-	0x08, 0xc8, 0x37, 0xec, 0xce, 0xae, 0xac, 0xa2, 0x52, 0x5d, 0x88, 0x4b, 0xb5, 0xfc, 0xb7, 0x05,
-	0x21, 0x8b, 0x14, 0x55, 0xcf, 0x3a, 0xb4, 0xd1, 0x55, 0xa8, 0x09, 0x10, 0xce, 0x15, 0x41, 0x07,
-	0xfa, 0x16, 0x94, 0xc9, 0x4a, 0x19, 0x4b, 0xb4, 0x56, 0xdf, 0x4c, 0xdf, 0x53, 0x68, 0x4e, 0x85,
-	0xc1, 0xa3, 0x1e, 0xb4, 0x5c, 0x4f, 0x75, 0xbc, 0xfe, 0xc8, 0x76, 0xe9, 0x39, 0x53, 0xc6, 0xa9,
-	0xaf, 0xca, 0xd1, 0x19, 0x84, 0x5a, 0xdf, 0x76, 0x87, 0xbb, 0x1c, 0x52, 0x69, 0xd2, 0x91, 0x7e,
-	0x13, 0x7d, 0x0e, 0x0d, 0x6c, 0x69, 0xc1, 0x44, 0xa5, 0xdc, 0x13, 0xd5, 0xb1, 0xa5, 0x89, 0x69,
-	0x82, 0xf3, 0x29, 0xe7, 0x3f, 0x9f, 0xdf, 0x93, 0xa0, 0x93, 0x3c, 0xa0, 0x79, 0x54, 0xf6, 0x43,
-	0x36, 0x08, 0xb3, 0x03, 0x9a, 0x28, 0xe1, 0xe2, 0x90, 0x14, 0x3e, 0x44, 0xfe, 0x23, 0x09, 0xde,
-	0x08, 0x96, 0x43, 0x3f, 0xbd, 0x2a, 0x6e, 0x41, 0x77, 0xa1, 0xad, 0x5b, 0x03, 0x63, 0xac, 0xe1,
-	0xa7, 0xd6, 0x13, 0xac, 0x1a, 0xde, 0xd1, 0x19, 0x3d, 0xc3, 0xaa, 0x92, 0xe8, 0x97, 0x7f, 0x22,
-	0xc1, 0x72, 0x7c, 0x5d, 0xf3, 0x10, 0xe9, 0x57, 0xa0, 0xac, 0x5b, 0x87, 0xb6, 0x4f, 0xa3, 0xeb,
-	0x13, 0x84, 0x92, 0xe0, 0x62, 0xc0, 0xb2, 0x09, 0x57, 0x1e, 0x63, 0xaf, 0x67, 0xb9, 0xd8, 0xf1,
-	0xd6, 0x74, 0xcb, 0xb0, 0x87, 0xbb, 0xaa, 0x77, 0x34, 0x87, 0x40, 0x45, 0x64, 0xa3, 0x10, 0x93,
-	0x0d, 0xf9, 0x67, 0x12, 0x5c, 0x4d, 0xc7, 0xc7, 0xb7, 0xde, 0x85, 0xea, 0xa1, 0x8e, 0x0d, 0x8d,
-	0xd0, 0x57, 0xa2, 0xf4, 0x15, 0x6d, 0x22, 0x58, 0x23, 0x02, 0xcc, 0x77, 0xf8, 0x66, 0x06, 0x37,
-	0xef, 0x79, 0x8e, 0x6e, 0x0d, 0xb7, 0x74, 0xd7, 0x53, 0x18, 0x7c, 0x88, 0x9e, 0xc5, 0xfc, 0x6c,
-	0xfc, 0xbb, 0x12, 0x5c, 0x7f, 0x8c, 0xbd, 0x75, 0xa1, 0x97, 0xc9, 0x77, 0xdd, 0xf5, 0xf4, 0x81,
-	0xfb, 0x72, 0x7d, 0xa3, 0x1c, 0x06, 0x5a, 0xfe, 0xa9, 0x04, 0x37, 0x32, 0x17, 0xc3, 0x49, 0xc7,
-	0xf5, 0x8e, 0xaf, 0x95, 0xd3, 0xf5, 0xce, 0x6f, 0xe0, 0xb3, 0x2f, 0x54, 0x63, 0x8c, 0x77, 0x55,
-	0xdd, 0x61, 0x7a, 0x67, 0x46, 0x2d, 0xfc, 0xd7, 0x12, 0x5c, 0x7b, 0x8c, 0xbd, 0x5d, 0xdf, 0x26,
-	0xbd, 0x46, 0xea, 0x10, 0x98, 0x90, 0x6d, 0xf4, 0x9d, 0xb3, 0x48, 0x9f, 0xfc, 0xfb, 0xec, 0x38,
-	0x53, 0xd7, 0xfb, 0x5a, 0x08, 0x78, 0x9d, 0x4a, 0x42, 0x48, 0x24, 0xd7, 0x99, 0xeb, 0xc0, 0xc9,
-	0x27, 0xff, 0x99, 0x04, 0x97, 0x1f, 0x0d, 0x9e, 0x8f, 0x75, 0x07, 0x73, 0xa0, 0x2d, 0x7b, 0x70,
-	0x3c, 0x3b, 0x71, 0x03, 0x37, 0xab, 0x10, 0x71, 0xb3, 0xa6, 0xb9, 0xe6, 0xcb, 0x50, 0xf1, 0x98,
-	0x5f, 0xc7, 0x3c, 0x15, 0xde, 0xa2, 0xeb, 0x53, 0xb0, 0x81, 0x55, 0xf7, 0x7f, 0xe7, 0xfa, 0x7e,
-	0x5a, 0x82, 0xc6, 0x17, 0xdc, 0x1d, 0xa3, 0x56, 0x3b, 0xce, 0x49, 0x52, 0xba, 0xe3, 0x15, 0xf2,
-	0xe0, 0xd2, 0x9c, 0xba, 0xc7, 0xd0, 0x74, 0x31, 0x3e, 0x9e, 0xc5, 0x46, 0x37, 0xc8, 0x40, 0x61,
-	0x5b, 0xb7, 0x60, 0x69, 0x6c, 0xd1, 0xd0, 0x00, 0x6b, 0x9c, 0x80, 0x8c, 0x73, 0xa7, 0xeb, 0xee,
-	0xe4, 0x40, 0xf4, 0x84, 0x47, 0x1f, 0xa1, 0xb9, 0xca, 0xb9, 0xe6, 0x8a, 0x0f, 0x43, 0x3d, 0x68,
-	0x6b, 0x8e, 0x3d, 0x1a, 0x61, 0xad, 0xef, 0xfa, 0x53, 0x55, 0xf2, 0x4d, 0xc5, 0xc7, 0x89, 0xa9,
-	0x3e, 0x80, 0x8b, 0xf1, 0x95, 0xf6, 0x34, 0xe2, 0x90, 0x92, 0x33, 0x4c, 0xfb, 0x84, 0xee, 0xc1,
-	0x52, 0x12, 0xbe, 0x4a, 0xe1, 0x93, 0x1f, 0xd0, 0xfb, 0x80, 0x62, 0x4b, 0x25, 0xe0, 0x35, 0x06,
-	0x1e, 0x5d, 0x4c, 0x4f, 0x73, 0xe5, 0xaf, 0x24, 0x58, 0x7e, 0xa6, 0x7a, 0x83, 0xa3, 0x0d, 0x93,
-	0xcb, 0xda, 0x1c, 0xba, 0xea, 0x53, 0xa8, 0x9d, 0x70, 0xbe, 0xf0, 0x0d, 0xd2, 0x8d, 0x14, 0xfa,
-	0x84, 0x39, 0x50, 0x09, 0x46, 0x90, 0x78, 0xe8, 0xd2, 0x66, 0x28, 0x2e, 0x7c, 0x0d, 0x5a, 0x73,
-	0x4a, 0x40, 0x2b, 0xbf, 0x00, 0xe0, 0x8b, 0xdb, 0x76, 0x87, 0x33, 0xac, 0xeb, 0x13, 0x58, 0xe0,
-	0xb3, 0x71, 0xb5, 0x38, 0x8d, 0x7f, 0x7c, 0x70, 0xf9, 0xe7, 0x15, 0xa8, 0x87, 0x3e, 0xa0, 0x16,
-	0x14, 0x84, 0xbc, 0x16, 0x52, 0x76, 0x57, 0x98, 0x1e, 0x42, 0x15, 0x93, 0x21, 0xd4, 0x6d, 0x68,
-	0xe9, 0xd4, 0x0f, 0xe9, 0xf3, 0x53, 0xa1, 0x0a, 0xa4, 0xa6, 0x34, 0x59, 0x2f, 0x67, 0x11, 0x74,
-	0x1d, 0xea, 0xd6, 0xd8, 0xec, 0xdb, 0x87, 0x7d, 0xc7, 0x3e, 0x75, 0x79, 0x2c, 0x56, 0xb3, 0xc6,
-	0xe6, 0x77, 0x0f, 0x15, 0xfb, 0xd4, 0x0d, 0xdc, 0xfd, 0xca, 0x39, 0xdd, 0xfd, 0xeb, 0x50, 0x37,
-	0xd5, 0x17, 0x64, 0xd6, 0xbe, 0x35, 0x36, 0x69, 0x98, 0x56, 0x54, 0x6a, 0xa6, 0xfa, 0x42, 0xb1,
-	0x4f, 0x77, 0xc6, 0x26, 0xba, 0x03, 0x6d, 0x43, 0x75, 0xbd, 0x7e, 0x38, 0xce, 0xab, 0xd2, 0x38,
-	0xaf, 0x45, 0xfa, 0x3f, 0x0f, 0x62, 0xbd, 0x64, 0xe0, 0x50, 0x9b, 0x23, 0x70, 0xd0, 0x4c, 0x23,
-	0x98, 0x08, 0xf2, 0x07, 0x0e, 0x9a, 0x69, 0x88, 0x69, 0x3e, 0x81, 0x85, 0x03, 0xea, 0xdd, 0xb9,
-	0x9d, 0x7a, 0xa6, 0xee, 0xd8, 0x24, 0x8e, 0x1d, 0x73, 0x02, 0x15, 0x1f, 0x1c, 0x7d, 0x1b, 0x6a,
-	0xd4, 0xa8, 0xd2, 0xb1, 0x8d, 0x5c, 0x63, 0x83, 0x01, 0x64, 0xb4, 0x86, 0x0d, 0x4f, 0xa5, 0xa3,
-	0x9b, 0xf9, 0x46, 0x8b, 0x01, 0x44, 0x5f, 0x0d, 0x1c, 0xac, 0x7a, 0x58, 0x5b, 0x3b, 0x5b, 0xb7,
-	0xcd, 0x91, 0x4a, 0x99, 0xa9, 0xd3, 0xa2, 0x1e, 0x7c, 0xda, 0x27, 0xf4, 0x36, 0xb4, 0x06, 0xa2,
-	0xb5, 0xe9, 0xd8, 0x66, 0x67, 0x91, 0xca, 0x51, 0xac, 0x17, 0x5d, 0x03, 0xf0, 0x35, 0x95, 0xea,
-	0x75, 0xda, 0xf4, 0x14, 0x6b, 0xbc, 0xe7, 0x11, 0x4d, 0xe3, 0xe8, 0x6e, 0x9f, 0x25, 0x4c, 0x74,
-	0x6b, 0xd8, 0x59, 0xa2, 0x18, 0xeb, 0x7e, 0x86, 0x45, 0xb7, 0x86, 0x68, 0x05, 0x16, 0x74, 0xb7,
-	0x7f, 0xa8, 0x1e, 0xe3, 0x0e, 0xa2, 0x5f, 0x2b, 0xba, 0xbb, 0xa9, 0x1e, 0x63, 0xf9, 0xc7, 0x70,
-	0x29, 0xe0, 0xae, 0xd0, 0x49, 0x26, 0x99, 0x42, 0x9a, 0x95, 0x29, 0x26, 0xfb, 0xf4, 0xbf, 0x2c,
-	0xc1, 0xf2, 0x9e, 0x7a, 0x82, 0x5f, 0x7d, 0xf8, 0x90, 0x4b, 0xad, 0x6d, 0xc1, 0x12, 0x8d, 0x18,
-	0x56, 0x43, 0xeb, 0x99, 0x60, 0x57, 0xc3, 0xac, 0x90, 0x1c, 0x88, 0xbe, 0x43, 0x1c, 0x02, 0x3c,
-	0x38, 0xde, 0xb5, 0xf5, 0xc0, 0xa6, 0x5e, 0x4b, 0x99, 0x67, 0x5d, 0x40, 0x29, 0xe1, 0x11, 0x68,
-	0x17, 0x16, 0xa3, 0xc7, 0xe0, 0x5b, 0xd3, 0x77, 0x26, 0x06, 0xb1, 0x01, 0xf5, 0x95, 0x56, 0xe4,
-	0x30, 0x5c, 0xd4, 0x81, 0x05, 0x6e, 0x0a, 0xa9, 0xce, 0xa8, 0x2a, 0x7e, 0x13, 0xed, 0xc2, 0x45,
-	0xb6, 0x83, 0x3d, 0x2e, 0x10, 0x6c, 0xf3, 0xd5, 0x5c, 0x9b, 0x4f, 0x1b, 0x1a, 0x95, 0xa7, 0xda,
-	0x79, 0xe5, 0xa9, 0x03, 0x0b, 0x9c, 0xc7, 0xa9, 0x1e, 0xa9, 0x2a, 0x7e, 0x93, 0x1c, 0x73, 0xc0,
-	0xed, 0x75, 0xfa, 0x2d, 0xe8, 0x20, 0xa1, 0x17, 0x04, 0xf4, 0x9c, 0x92, 0x6e, 0xf9, 0x0c, 0xaa,
-	0x82, 0xc3, 0x0b, 0xb9, 0x39, 0x5c, 0x8c, 0x89, 0xeb, 0xf7, 0x62, 0x4c, 0xbf, 0xcb, 0xff, 0x24,
-	0x41, 0x63, 0x83, 0x6c, 0x69, 0xcb, 0x1e, 0x52, 0x6b, 0x74, 0x1b, 0x5a, 0x0e, 0x1e, 0xd8, 0x8e,
-	0xd6, 0xc7, 0x96, 0xe7, 0xe8, 0x98, 0x45, 0xe9, 0x25, 0xa5, 0xc9, 0x7a, 0x3f, 0x67, 0x9d, 0x04,
-	0x8c, 0xa8, 0x6c, 0xd7, 0x53, 0xcd, 0x51, 0xff, 0x90, 0xa8, 0x86, 0x02, 0x03, 0x13, 0xbd, 0x54,
-	0x33, 0xbc, 0x09, 0x8d, 0x00, 0xcc, 0xb3, 0x29, 0xfe, 0x92, 0x52, 0x17, 0x7d, 0xfb, 0x36, 0x7a,
-	0x0b, 0x5a, 0x94, 0xa6, 0x7d, 0xc3, 0x1e, 0xf6, 0x49, 0x44, 0xcb, 0x0d, 0x55, 0x43, 0xe3, 0xcb,
-	0x22, 0x67, 0x15, 0x85, 0x72, 0xf5, 0x1f, 0x61, 0x6e, 0xaa, 0x04, 0xd4, 0x9e, 0xfe, 0x23, 0x2c,
-	0xff, 0xa3, 0x04, 0xcd, 0x0d, 0xd5, 0x53, 0x77, 0x6c, 0x0d, 0xef, 0xcf, 0x68, 0xd8, 0x73, 0xa4,
-	0x3e, 0xaf, 0x42, 0x4d, 0xec, 0x80, 0x6f, 0x29, 0xe8, 0x40, 0x9b, 0xd0, 0xf2, 0x5d, 0xcb, 0x3e,
-	0x8b, 0xb8, 0x4a, 0x99, 0x0e, 0x54, 0xc8, 0x72, 0xba, 0x4a, 0xd3, 0x1f, 0x46, 0x9b, 0xf2, 0x26,
-	0x34, 0xc2, 0x9f, 0x09, 0xd6, 0xbd, 0x38, 0xa3, 0x88, 0x0e, 0xc2, 0x8d, 0x3b, 0x63, 0x93, 0x9c,
-	0x29, 0x57, 0x2c, 0x7e, 0x53, 0xfe, 0x89, 0x04, 0x4d, 0x6e, 0xee, 0xf7, 0xc4, 0x25, 0x01, 0xdd,
-	0x9a, 0x44, 0xb7, 0x46, 0x7f, 0xa3, 0x5f, 0x8b, 0xe6, 0xf5, 0xde, 0x4a, 0x55, 0x02, 0x74, 0x12,
-	0xea, 0x64, 0x46, 0x6c, 0x7d, 0x9e, 0x18, 0xff, 0x4b, 0xc2, 0x68, 0xfc, 0x68, 0x28, 0xa3, 0x75,
-	0x60, 0x41, 0xd5, 0x34, 0x07, 0xbb, 0x2e, 0x5f, 0x87, 0xdf, 0x24, 0x5f, 0x4e, 0xb0, 0xe3, 0xfa,
-	0x2c, 0x5f, 0x54, 0xfc, 0x26, 0xfa, 0x36, 0x54, 0x85, 0x57, 0xca, 0xd2, 0xe1, 0x37, 0xb3, 0xd7,
-	0xc9, 0x23, 0x52, 0x31, 0x42, 0xfe, 0xbb, 0x02, 0xb4, 0x38, 0xc1, 0xd6, 0xb8, 0x3d, 0x9e, 0x2c,
-	0x7c, 0x6b, 0xd0, 0x38, 0x0c, 0x64, 0x7f, 0x52, 0xee, 0x29, 0xac, 0x22, 0x22, 0x63, 0xa6, 0x09,
-	0x60, 0xd4, 0x23, 0x28, 0xcd, 0xe5, 0x11, 0x94, 0xcf, 0xab, 0xc1, 0x92, 0x3e, 0x62, 0x25, 0xc5,
-	0x47, 0x94, 0x7f, 0x13, 0xea, 0xa1, 0x09, 0xa8, 0x86, 0x66, 0x49, 0x2b, 0x4e, 0x31, 0xbf, 0x89,
-	0x3e, 0x0a, 0xfc, 0x22, 0x46, 0xaa, 0xcb, 0x29, 0x6b, 0x89, 0xb9, 0x44, 0xf2, 0x3f, 0x48, 0x50,
-	0xe1, 0x33, 0xdf, 0x80, 0x3a, 0x57, 0x3a, 0xd4, 0x67, 0x64, 0xb3, 0x03, 0xef, 0x22, 0x4e, 0xe3,
-	0xcb, 0xd3, 0x3a, 0x97, 0xa1, 0x1a, 0xd3, 0x37, 0x0b, 0xdc, 0x2c, 0xf8, 0x9f, 0x42, 0x4a, 0x86,
-	0x7c, 0x22, 0xfa, 0x05, 0x5d, 0x82, 0xb2, 0x61, 0x0f, 0xc5, 0x25, 0x10, 0x6b, 0xc8, 0x5f, 0x4b,
-	0x34, 0x67, 0xaf, 0xe0, 0x81, 0x7d, 0x82, 0x9d, 0xb3, 0xf9, 0x93, 0x9d, 0x0f, 0x43, 0x6c, 0x9e,
-	0x33, 0xf8, 0x12, 0x03, 0xd0, 0xc3, 0xe0, 0x10, 0x8a, 0x69, 0x99, 0x9e, 0xb0, 0xde, 0xe1, 0x4c,
-	0x1a, 0x1c, 0xc6, 0x1f, 0xb0, 0xb4, 0x6d, 0x74, 0x2b, 0xb3, 0x7a, 0x3b, 0x2f, 0x25, 0x90, 0x91,
-	0x7f, 0x29, 0x41, 0x37, 0x48, 0x25, 0xb9, 0x6b, 0x67, 0xf3, 0x5e, 0x8a, 0xbc, 0x9c, 0xf8, 0xea,
-	0x57, 0x45, 0xd6, 0x9e, 0x08, 0x6d, 0xae, 0xc8, 0xc8, 0xcf, 0xd9, 0x5b, 0x34, 0x2b, 0x9d, 0xdc,
-	0xd0, 0x3c, 0x2c, 0xd3, 0x85, 0xaa, 0xc8, 0x67, 0xb0, 0xcc, 0xbd, 0x68, 0x13, 0x09, 0xbb, 0xfc,
-	0x18, 0x7b, 0x9b, 0xd1, 0x54, 0xc8, 0xeb, 0x26, 0x60, 0xf8, 0x36, 0xe1, 0x88, 0xdf, 0x26, 0x94,
-	0x62, 0xb7, 0x09, 0xbc, 0x5f, 0x36, 0x29, 0x0b, 0x24, 0x36, 0xf0, 0xaa, 0x08, 0xf6, 0x3b, 0x12,
-	0x74, 0x38, 0x16, 0x8a, 0x93, 0x84, 0x44, 0x06, 0xf6, 0xb0, 0xf6, 0x4d, 0xa7, 0x0a, 0xfe, 0x5b,
-	0x82, 0x76, 0xd8, 0xea, 0x52, 0xc3, 0xf9, 0x31, 0x94, 0x69, 0xa6, 0x85, 0xaf, 0x60, 0xaa, 0x6a,
-	0x60, 0xd0, 0x44, 0x6d, 0x53, 0x57, 0x7b, 0x5f, 0x38, 0x08, 0xbc, 0x19, 0x98, 0xfe, 0xe2, 0xf9,
-	0x4d, 0x3f, 0x77, 0x85, 0xec, 0x31, 0x99, 0x97, 0xa5, 0x28, 0x83, 0x0e, 0xf4, 0x29, 0x54, 0x58,
-	0x21, 0x06, 0xbf, 0x61, 0xbb, 0x1d, 0x9d, 0x9a, 0x17, 0x69, 0x84, 0xf2, 0xfe, 0xb4, 0x43, 0xe1,
-	0x83, 0xe4, 0x5f, 0x87, 0xe5, 0x20, 0x1a, 0x65, 0x68, 0x67, 0x65, 0x5a, 0xf9, 0x5f, 0x25, 0xb8,
-	0xb8, 0x77, 0x66, 0x0d, 0xe2, 0xec, 0xbf, 0x0c, 0x95, 0x91, 0xa1, 0x06, 0x19, 0x53, 0xde, 0xa2,
-	0x6e, 0x20, 0xc3, 0x8d, 0x35, 0x62, 0x43, 0x18, 0xcd, 0xea, 0xa2, 0x6f, 0xdf, 0x9e, 0x6a, 0xda,
-	0x6f, 0x8b, 0xf0, 0x19, 0x6b, 0xcc, 0x5a, 0xb1, 0x34, 0x54, 0x53, 0xf4, 0x52, 0x6b, 0xf5, 0x29,
-	0x00, 0x35, 0xe8, 0xfd, 0xf3, 0x18, 0x71, 0x3a, 0x62, 0x8b, 0xa8, 0xec, 0x5f, 0x14, 0xa0, 0x13,
-	0xa2, 0xd2, 0x37, 0xed, 0xdf, 0x64, 0x44, 0x65, 0xc5, 0x97, 0x14, 0x95, 0x95, 0xe6, 0xf7, 0x69,
-	0xca, 0x69, 0x3e, 0xcd, 0xbf, 0x15, 0xa0, 0x15, 0x50, 0x6d, 0xd7, 0x50, 0xad, 0x4c, 0x4e, 0xd8,
-	0x13, 0xfe, 0x7c, 0x94, 0x4e, 0xef, 0xa5, 0xc9, 0x49, 0xc6, 0x41, 0x28, 0xb1, 0x29, 0xd0, 0x35,
-	0x7a, 0xe8, 0x8e, 0xc7, 0x12, 0x5f, 0x3c, 0x86, 0x60, 0x02, 0xa9, 0x9b, 0x18, 0xdd, 0x03, 0xc4,
-	0xa5, 0xa8, 0xaf, 0x5b, 0x7d, 0x17, 0x0f, 0x6c, 0x4b, 0x63, 0xf2, 0x55, 0x56, 0xda, 0xfc, 0x4b,
-	0xcf, 0xda, 0x63, 0xfd, 0xe8, 0x63, 0x28, 0x79, 0x67, 0x23, 0xe6, 0xad, 0xb4, 0x52, 0xed, 0x7d,
-	0xb0, 0xae, 0xfd, 0xb3, 0x11, 0x56, 0x28, 0xb8, 0x5f, 0xa9, 0xe3, 0x39, 0xea, 0x09, 0x77, 0xfd,
-	0x4a, 0x4a, 0xa8, 0x87, 0x68, 0x0c, 0x9f, 0x86, 0x0b, 0xcc, 0x45, 0xe2, 0x4d, 0xc6, 0xd9, 0xbe,
-	0xd0, 0xf6, 0x3d, 0xcf, 0xa0, 0xa9, 0x3b, 0xca, 0xd9, 0x7e, 0xef, 0xbe, 0x67, 0xc8, 0xff, 0x52,
-	0x80, 0x76, 0x80, 0x59, 0xc1, 0xee, 0xd8, 0xc8, 0x16, 0xb8, 0xc9, 0xb9, 0x91, 0x69, 0xb2, 0xf6,
-	0x1d, 0xa8, 0xf3, 0x63, 0x3f, 0x07, 0xdb, 0x00, 0x1b, 0xb2, 0x35, 0x81, 0x8f, 0xcb, 0x2f, 0x89,
-	0x8f, 0x2b, 0x33, 0x64, 0x17, 0xd2, 0x89, 0x2f, 0xff, 0x4c, 0x82, 0x37, 0x12, 0x6a, 0x71, 0x22,
-	0x69, 0x27, 0xc7, 0x76, 0x5c, 0x5d, 0xc6, 0xa7, 0xe4, 0x0a, 0xfe, 0x21, 0x54, 0x1c, 0x3a, 0x3b,
-	0xbf, 0x0a, 0xba, 0x35, 0x91, 0xbb, 0xd8, 0x42, 0x14, 0x3e, 0x44, 0xfe, 0x43, 0x09, 0x56, 0x92,
-	0x4b, 0x9d, 0xc3, 0x6a, 0xaf, 0xc1, 0x02, 0x9b, 0xda, 0x17, 0xc2, 0x3b, 0x93, 0x85, 0x30, 0x20,
-	0x8e, 0xe2, 0x0f, 0x94, 0xf7, 0x60, 0xd9, 0x37, 0xee, 0x01, 0xe9, 0xb7, 0xb1, 0xa7, 0x4e, 0x88,
-	0x6c, 0x6e, 0x40, 0x9d, 0xb9, 0xc8, 0x2c, 0x62, 0x60, 0x39, 0x01, 0x38, 0x10, 0xa9, 0x34, 0xf9,
-	0x3f, 0x25, 0xb8, 0x44, 0xad, 0x63, 0xfc, 0xee, 0x25, 0xcf, 0xbd, 0x9c, 0x2c, 0x52, 0x0e, 0x3b,
-	0xaa, 0xc9, 0xeb, 0x40, 0x6a, 0x4a, 0xa4, 0x0f, 0xf5, 0x92, 0x99, 0xb6, 0xd4, 0x08, 0x38, 0xb8,
-	0xc8, 0x25, 0xd1, 0x36, 0xbd, 0xc7, 0x8d, 0xa7, 0xd8, 0x02, 0xab, 0x5c, 0x9a, 0xc5, 0x2a, 0x6f,
-	0xc1, 0x1b, 0xb1, 0x9d, 0xce, 0x71, 0xa2, 0xf2, 0x5f, 0x4a, 0xe4, 0x38, 0x22, 0xf5, 0x34, 0xb3,
-	0x7b, 0xa6, 0xd7, 0xc4, 0xa5, 0x4f, 0x5f, 0xd7, 0xe2, 0x4a, 0x44, 0x43, 0x9f, 0x41, 0xcd, 0xc2,
-	0xa7, 0xfd, 0xb0, 0xb3, 0x93, 0xc3, 0x6d, 0xaf, 0x5a, 0xf8, 0x94, 0xfe, 0x92, 0x77, 0x60, 0x25,
-	0xb1, 0xd4, 0x79, 0xf6, 0xfe, 0xf7, 0x12, 0x5c, 0xde, 0x70, 0xec, 0xd1, 0x17, 0xba, 0xe3, 0x8d,
-	0x55, 0x23, 0x7a, 0x45, 0xfe, 0x6a, 0x52, 0x57, 0x4f, 0x42, 0x6e, 0x2f, 0xe3, 0x9f, 0x7b, 0x29,
-	0x12, 0x94, 0x5c, 0x14, 0xdf, 0x74, 0xc8, 0x49, 0xfe, 0x8f, 0x62, 0xda, 0xe2, 0x39, 0xdc, 0x14,
-	0xc7, 0x23, 0x4f, 0x04, 0x91, 0x9a, 0xe9, 0x2e, 0xce, 0x9a, 0xe9, 0xce, 0x50, 0xef, 0xa5, 0x97,
-	0xa4, 0xde, 0xcf, 0x9d, 0x7a, 0x79, 0x02, 0xd1, 0x5b, 0x08, 0x6a, 0x7e, 0x67, 0xba, 0xbe, 0x58,
-	0x03, 0x08, 0x32, 0xf2, 0xbc, 0x1c, 0x32, 0xcf, 0x34, 0xa1, 0x51, 0xe4, 0xb4, 0x84, 0x29, 0xe5,
-	0xa6, 0x3c, 0x94, 0x23, 0xfe, 0x1e, 0x74, 0xd3, 0xb8, 0x74, 0x1e, 0xce, 0xff, 0x45, 0x01, 0xa0,
-	0x27, 0x2a, 0x68, 0x67, 0xb3, 0x05, 0xb7, 0x20, 0xe4, 0x6e, 0x04, 0xf2, 0x1e, 0xe6, 0x22, 0x8d,
-	0x88, 0x84, 0x08, 0x3a, 0x09, 0x4c, 0x22, 0x10, 0xd5, 0xe8, 0x3c, 0x21, 0xa9, 0x61, 0x4c, 0x11,
-	0x57, 0xbf, 0x57, 0xa0, 0xe6, 0xd8, 0xa7, 0x7d, 0x22, 0x66, 0x9a, 0x5f, 0x22, 0xec, 0xd8, 0xa7,
-	0x44, 0xf8, 0x34, 0xb4, 0x02, 0x0b, 0x9e, 0xea, 0x1e, 0x93, 0xf9, 0x2b, 0xa1, 0x2a, 0x0d, 0x0d,
-	0x5d, 0x82, 0xf2, 0xa1, 0x6e, 0x60, 0x56, 0x14, 0x50, 0x53, 0x58, 0x03, 0x7d, 0xcb, 0xaf, 0x65,
-	0xab, 0xe6, 0xae, 0xc4, 0x61, 0xe5, 0x6c, 0x5f, 0x4b, 0xb0, 0x18, 0x50, 0x8d, 0x2a, 0x20, 0xa2,
-	0xd3, 0xa8, 0x3e, 0x5b, 0xb7, 0x35, 0xa6, 0x2a, 0x5a, 0x19, 0x16, 0x81, 0x0d, 0x64, 0x5a, 0x2b,
-	0x18, 0x32, 0x29, 0x0e, 0x26, 0xfb, 0x22, 0x9b, 0xd6, 0x35, 0xbf, 0x32, 0xa5, 0xe2, 0xd8, 0xa7,
-	0x3d, 0x4d, 0x50, 0x83, 0xd5, 0xff, 0xb2, 0xa8, 0x8f, 0x50, 0x63, 0x9d, 0x96, 0x00, 0xdf, 0x82,
-	0x26, 0x76, 0x1c, 0xdb, 0xe9, 0x9b, 0xd8, 0x75, 0xd5, 0x21, 0xe6, 0x0e, 0x78, 0x83, 0x76, 0x6e,
-	0xb3, 0x3e, 0xf9, 0x8f, 0x4b, 0xd0, 0x0a, 0xb6, 0xe2, 0xdf, 0x83, 0xeb, 0x9a, 0x7f, 0x0f, 0xae,
-	0x93, 0xa3, 0x03, 0x87, 0xa9, 0x42, 0x71, 0xb8, 0x6b, 0x85, 0x8e, 0xa4, 0xd4, 0x78, 0x6f, 0x4f,
-	0x23, 0x66, 0x99, 0x08, 0x99, 0x65, 0x6b, 0x38, 0x38, 0x5c, 0xf0, 0xbb, 0xf8, 0xd9, 0x46, 0x78,
-	0xa4, 0x94, 0x83, 0x47, 0xca, 0x39, 0x78, 0xa4, 0x92, 0xc2, 0x23, 0xcb, 0x50, 0x39, 0x18, 0x0f,
-	0x8e, 0xb1, 0xc7, 0x3d, 0x36, 0xde, 0x8a, 0xf2, 0x4e, 0x35, 0xc6, 0x3b, 0x82, 0x45, 0x6a, 0x61,
-	0x16, 0xb9, 0x02, 0x35, 0x76, 0x21, 0xdb, 0xf7, 0x5c, 0x7a, 0xbb, 0x54, 0x54, 0xaa, 0xac, 0x63,
-	0xdf, 0x45, 0x9f, 0xf8, 0xee, 0x5c, 0x3d, 0x4d, 0xd8, 0xa9, 0xd6, 0x89, 0x71, 0x89, 0xef, 0xcc,
-	0xbd, 0x03, 0x8b, 0x21, 0x72, 0x50, 0x1b, 0xd1, 0xa0, 0x4b, 0x0d, 0xb9, 0xf3, 0xd4, 0x4c, 0xdc,
-	0x86, 0x56, 0x40, 0x12, 0x0a, 0xd7, 0x64, 0x51, 0x94, 0xe8, 0xa5, 0x60, 0x82, 0x93, 0x5b, 0xe7,
-	0xe3, 0x64, 0x74, 0x19, 0xaa, 0x3c, 0xfc, 0x71, 0x3b, 0x8b, 0x91, 0x6c, 0x84, 0xfc, 0x43, 0x40,
-	0xc1, 0xea, 0xe7, 0xf3, 0x16, 0x63, 0xec, 0x51, 0x88, 0xb3, 0x87, 0xfc, 0x73, 0x09, 0x96, 0xc2,
-	0xc8, 0x66, 0x35, 0xbc, 0x9f, 0x41, 0x9d, 0xdd, 0xef, 0xf5, 0x89, 0xe0, 0xf3, 0x2c, 0xcf, 0xb5,
-	0x89, 0xe7, 0xa2, 0x40, 0xf0, 0x82, 0x80, 0xb0, 0xd7, 0xa9, 0xed, 0x1c, 0xeb, 0xd6, 0xb0, 0x4f,
-	0x56, 0xe6, 0x8b, 0x5b, 0x83, 0x77, 0xee, 0x90, 0x3e, 0xf9, 0x2b, 0x09, 0xae, 0x3f, 0x1d, 0x69,
-	0xaa, 0x87, 0x43, 0x1e, 0xc8, 0xbc, 0x45, 0x89, 0x1f, 0xfb, 0x55, 0x81, 0x85, 0x7c, 0x77, 0x54,
-	0x0c, 0x5a, 0xfe, 0x1b, 0xb1, 0x16, 0x6e, 0x0e, 0xe8, 0x85, 0xe6, 0x88, 0x5e, 0x10, 0xcf, 0xbc,
-	0x96, 0x2e, 0x54, 0x4f, 0xf8, 0x74, 0xfe, 0x8b, 0x08, 0xbf, 0x1d, 0xb9, 0x07, 0x2d, 0x9e, 0xff,
-	0x1e, 0x54, 0xde, 0x86, 0xcb, 0x0a, 0x76, 0xb1, 0xa5, 0x45, 0x76, 0x33, 0x73, 0x36, 0x69, 0x04,
-	0xdd, 0xb4, 0xe9, 0xe6, 0x61, 0x56, 0xe6, 0xbb, 0xf6, 0x1d, 0x32, 0xad, 0xc7, 0x55, 0x31, 0x71,
-	0x99, 0x28, 0x1e, 0x4f, 0xfe, 0xab, 0x02, 0xac, 0x3c, 0xd2, 0x34, 0xae, 0xc5, 0xb9, 0x37, 0xf6,
-	0xaa, 0x1c, 0xe5, 0xb8, 0x23, 0x59, 0x4c, 0x3a, 0x92, 0x2f, 0x4b, 0xb3, 0x72, 0x1b, 0x63, 0x8d,
-	0x4d, 0xdf, 0x76, 0x3a, 0xac, 0x40, 0xe8, 0x21, 0xbf, 0x18, 0x23, 0x01, 0x3d, 0xb5, 0x9f, 0xd3,
-	0xfd, 0xab, 0xaa, 0x9f, 0x15, 0x93, 0x47, 0xd0, 0x49, 0x12, 0x6b, 0x4e, 0x55, 0xe2, 0x53, 0x64,
-	0x64, 0xb3, 0x0c, 0x6a, 0x83, 0xb8, 0x50, 0xb4, 0x6b, 0xd7, 0x76, 0xe5, 0xff, 0x2a, 0x40, 0x67,
-	0x4f, 0x3d, 0xc1, 0xff, 0x7f, 0x0e, 0xe8, 0xfb, 0x70, 0xc9, 0x55, 0x4f, 0x70, 0x3f, 0x14, 0x18,
-	0xf7, 0x1d, 0xfc, 0x9c, 0xbb, 0xa0, 0xef, 0xa6, 0x69, 0x92, 0xd4, 0x3a, 0x1a, 0x65, 0xc9, 0x8d,
-	0xf4, 0x2b, 0xf8, 0x39, 0x7a, 0x1b, 0x16, 0xc3, 0x85, 0x5a, 0x64, 0x69, 0x55, 0x4a, 0xf2, 0x66,
-	0xa8, 0x0e, 0xab, 0xa7, 0xc9, 0xcf, 0xe1, 0xea, 0x53, 0xcb, 0xc5, 0x5e, 0x2f, 0xa8, 0x25, 0x9a,
-	0x33, 0x84, 0xbc, 0x01, 0xf5, 0x80, 0xf0, 0x89, 0x57, 0x10, 0x9a, 0x2b, 0xdb, 0xd0, 0xdd, 0x56,
-	0x9d, 0x63, 0x3f, 0x8f, 0xbc, 0xc1, 0x6a, 0x3e, 0x5e, 0x21, 0xc2, 0x43, 0x51, 0x02, 0xa5, 0xe0,
-	0x43, 0xec, 0x60, 0x6b, 0x80, 0xb7, 0xec, 0xc1, 0x71, 0xa8, 0x34, 0x58, 0x0a, 0x97, 0x06, 0xcf,
-	0x5a, 0x6a, 0x7c, 0xf7, 0x33, 0x51, 0x96, 0xb8, 0x7f, 0x36, 0xc2, 0x68, 0x01, 0x8a, 0x3b, 0xf8,
-	0xb4, 0x7d, 0x01, 0x01, 0x54, 0x76, 0x6c, 0xc7, 0x54, 0x8d, 0xb6, 0x84, 0xea, 0xb0, 0xc0, 0x6f,
-	0x61, 0xda, 0x05, 0xd4, 0x84, 0xda, 0xba, 0x9f, 0xc9, 0x6e, 0x17, 0xef, 0xfe, 0xa9, 0x04, 0x4b,
-	0x89, 0x7b, 0x02, 0xd4, 0x02, 0x78, 0x6a, 0x0d, 0xf8, 0x05, 0x4a, 0xfb, 0x02, 0x6a, 0x40, 0xd5,
-	0xbf, 0x4e, 0x61, 0xf3, 0xed, 0xdb, 0x14, 0xba, 0x5d, 0x40, 0x6d, 0x68, 0xb0, 0x81, 0xe3, 0xc1,
-	0x00, 0xbb, 0x6e, 0xbb, 0x28, 0x7a, 0x36, 0x55, 0xdd, 0x18, 0x3b, 0xb8, 0x5d, 0x22, 0x38, 0xf7,
-	0x6d, 0x5e, 0x98, 0xdd, 0x2e, 0x23, 0x04, 0x2d, 0xbf, 0x4a, 0x9b, 0x0f, 0xaa, 0x84, 0xfa, 0xfc,
-	0x61, 0x0b, 0x77, 0x9f, 0x85, 0xb3, 0xbd, 0x74, 0x7b, 0x2b, 0x70, 0xf1, 0xa9, 0xa5, 0xe1, 0x43,
-	0xdd, 0xc2, 0x5a, 0xf0, 0xa9, 0x7d, 0x01, 0x5d, 0x84, 0xc5, 0x6d, 0xec, 0x0c, 0x71, 0xa8, 0xb3,
-	0x80, 0x96, 0xa0, 0xb9, 0xad, 0xbf, 0x08, 0x75, 0x15, 0xe5, 0x52, 0x55, 0x6a, 0x4b, 0xab, 0x5f,
-	0x5d, 0x83, 0xda, 0x86, 0xea, 0xa9, 0xeb, 0xb6, 0xed, 0x68, 0xc8, 0x00, 0x44, 0xdf, 0x31, 0x98,
-	0x23, 0xdb, 0x12, 0xaf, 0x83, 0xd0, 0xfd, 0x28, 0x17, 0xf0, 0x46, 0x12, 0x90, 0xf3, 0x50, 0xf7,
-	0xad, 0x54, 0xf8, 0x18, 0xb0, 0x7c, 0x01, 0x99, 0x14, 0xdb, 0xbe, 0x6e, 0xe2, 0x7d, 0x7d, 0x70,
-	0xec, 0x5b, 0xca, 0x0f, 0x32, 0xec, 0x62, 0x12, 0xd4, 0xc7, 0x77, 0x2b, 0x15, 0x1f, 0x7b, 0x68,
-	0xe2, 0x6b, 0x4d, 0xf9, 0x02, 0x7a, 0x0e, 0x97, 0x1e, 0xe3, 0x90, 0xd3, 0xe1, 0x23, 0x5c, 0xcd,
-	0x46, 0x98, 0x00, 0x3e, 0x27, 0xca, 0x2d, 0x28, 0x53, 0x76, 0x43, 0x69, 0x7e, 0x49, 0xf8, 0x21,
-	0x6f, 0xf7, 0x66, 0x36, 0x80, 0x98, 0xed, 0x87, 0xb0, 0x18, 0x7b, 0xfe, 0x87, 0xd2, 0xb4, 0x54,
-	0xfa, 0x43, 0xce, 0xee, 0xdd, 0x3c, 0xa0, 0x02, 0xd7, 0x10, 0x5a, 0xd1, 0xf7, 0x0f, 0x28, 0x2d,
-	0x53, 0x99, 0xfa, 0x72, 0xab, 0xfb, 0x6e, 0x0e, 0x48, 0x81, 0xc8, 0x84, 0x76, 0xfc, 0x39, 0x1a,
-	0xba, 0x3b, 0x71, 0x82, 0x28, 0xb3, 0xbd, 0x97, 0x0b, 0x56, 0xa0, 0x3b, 0xa3, 0x4c, 0x90, 0x78,
-	0xe1, 0x14, 0xe7, 0x71, 0x7f, 0x9a, 0xac, 0xa7, 0x57, 0xdd, 0x07, 0xb9, 0xe1, 0x05, 0xea, 0xdf,
-	0x66, 0x65, 0x16, 0x69, 0xaf, 0x84, 0xd0, 0x87, 0xe9, 0xd3, 0x4d, 0x78, 0xde, 0xd4, 0x5d, 0x3d,
-	0xcf, 0x10, 0xb1, 0x88, 0x1f, 0xd3, 0xfa, 0x88, 0x94, 0x77, 0x36, 0x71, 0xb9, 0xf3, 0xe7, 0xcb,
-	0x7e, 0x42, 0xd4, 0xfd, 0xf0, 0x1c, 0x23, 0xc4, 0x02, 0xec, 0xf8, 0x7b, 0x3f, 0x5f, 0x0c, 0x1f,
-	0x4c, 0xe5, 0x9a, 0xd9, 0x64, 0xf0, 0x07, 0xb0, 0x18, 0xb3, 0xdb, 0x28, 0xbf, 0x6d, 0xef, 0x4e,
-	0x72, 0xae, 0x98, 0x48, 0xc6, 0xca, 0x4d, 0x50, 0x06, 0xf7, 0xa7, 0x94, 0xa4, 0x74, 0xef, 0xe6,
-	0x01, 0x15, 0x1b, 0x71, 0xa9, 0xba, 0x8c, 0x15, 0x11, 0xa0, 0x7b, 0xe9, 0x73, 0xa4, 0x17, 0x4b,
-	0x74, 0xdf, 0xcf, 0x09, 0x2d, 0x90, 0x9e, 0xc0, 0xc5, 0x94, 0x5a, 0x0f, 0xf4, 0xfe, 0xc4, 0xc3,
-	0x8a, 0x17, 0xb9, 0x74, 0xef, 0xe7, 0x05, 0x17, 0x78, 0x7f, 0x0b, 0xd0, 0xde, 0x91, 0x7d, 0xba,
-	0x6e, 0x5b, 0x87, 0xfa, 0x70, 0xec, 0xa8, 0x2c, 0xf3, 0x9f, 0x65, 0x1b, 0x92, 0xa0, 0x19, 0x3c,
-	0x3a, 0x71, 0x84, 0x40, 0xde, 0x07, 0x78, 0x8c, 0xbd, 0x6d, 0xec, 0x39, 0x44, 0x30, 0xde, 0xce,
-	0x32, 0x7f, 0x1c, 0xc0, 0x47, 0xf5, 0xce, 0x54, 0xb8, 0x90, 0x29, 0x6a, 0x6f, 0xab, 0xd6, 0x58,
-	0x35, 0x42, 0xc5, 0xea, 0xf7, 0x52, 0x87, 0xc7, 0xc1, 0x32, 0x0e, 0x32, 0x13, 0x5a, 0xa0, 0x3c,
-	0x15, 0xa6, 0x3d, 0x74, 0xb5, 0x34, 0xd9, 0xb4, 0x27, 0xeb, 0x16, 0xe2, 0x6a, 0x6f, 0x02, 0xbc,
-	0x40, 0xfc, 0xa5, 0x44, 0xcb, 0x85, 0x62, 0x00, 0xcf, 0x74, 0xef, 0x68, 0xd7, 0x50, 0x2d, 0x37,
-	0xcf, 0x12, 0x28, 0xe0, 0x39, 0x96, 0xc0, 0xe1, 0xc5, 0x12, 0x34, 0x68, 0x46, 0x6e, 0x7c, 0x50,
-	0x5a, 0x75, 0x77, 0xda, 0xed, 0x57, 0xf7, 0xce, 0x74, 0x40, 0x81, 0xe5, 0x08, 0x9a, 0xbe, 0x28,
-	0x31, 0xe2, 0xbe, 0x9b, 0xb5, 0xd2, 0x00, 0x26, 0x43, 0x13, 0xa4, 0x83, 0x86, 0x35, 0x41, 0x32,
-	0xa1, 0x8d, 0xf2, 0x5d, 0x84, 0x4c, 0xd2, 0x04, 0xd9, 0x59, 0x72, 0xa6, 0xea, 0x62, 0x97, 0x47,
-	0xe9, 0x7a, 0x34, 0xf5, 0x2e, 0x2c, 0x55, 0xd5, 0x65, 0xdc, 0x45, 0xc9, 0x17, 0xd0, 0x33, 0xa8,
-	0xf0, 0x7f, 0xaf, 0x78, 0x6b, 0x72, 0x12, 0x8a, 0xcf, 0x7e, 0x7b, 0x0a, 0x94, 0x98, 0xf8, 0x18,
-	0x56, 0x32, 0x52, 0x50, 0xa9, 0x26, 0x78, 0x72, 0xba, 0x6a, 0x9a, 0x71, 0x10, 0xc8, 0x12, 0x39,
-	0xa6, 0x09, 0xc8, 0xb2, 0xf2, 0x51, 0xd3, 0x90, 0xa9, 0x80, 0x92, 0xef, 0x51, 0x53, 0x79, 0x22,
-	0xf3, 0xd9, 0x6a, 0x0e, 0x14, 0xc9, 0x27, 0xa5, 0xa9, 0x28, 0x32, 0x5f, 0x9e, 0x4e, 0x43, 0xd1,
-	0x87, 0xa5, 0x44, 0x12, 0x02, 0xbd, 0x97, 0x61, 0xae, 0xd3, 0x52, 0x15, 0xd3, 0x10, 0x0c, 0xe1,
-	0x8d, 0xd4, 0x80, 0x3b, 0xd5, 0xfd, 0x98, 0x14, 0x9a, 0x4f, 0x43, 0x34, 0x80, 0x8b, 0x29, 0x61,
-	0x76, 0xaa, 0xe1, 0xcc, 0x0e, 0xc7, 0xa7, 0x21, 0x39, 0x82, 0xee, 0x9a, 0x63, 0xab, 0xda, 0x40,
-	0x75, 0xbd, 0x47, 0x86, 0x87, 0x1d, 0x12, 0x0b, 0xfa, 0xfe, 0x5f, 0x9c, 0x6e, 0xbc, 0x41, 0xe1,
-	0x02, 0xa8, 0x9c, 0x98, 0x0e, 0xa0, 0x4e, 0x59, 0x92, 0xfd, 0x3f, 0x02, 0x4a, 0xb7, 0x75, 0x21,
-	0x88, 0x0c, 0x05, 0x9a, 0x06, 0xe8, 0x0b, 0xe7, 0xea, 0xd7, 0x35, 0xa8, 0xfa, 0x25, 0xf6, 0xdf,
-	0x70, 0x28, 0xfa, 0x1a, 0x62, 0xc3, 0x1f, 0xc0, 0x62, 0xec, 0xb9, 0x6b, 0xaa, 0x3e, 0x4d, 0x7f,
-	0x12, 0x3b, 0xed, 0xb8, 0x9e, 0xf1, 0x3f, 0x63, 0x12, 0x6e, 0xe2, 0x3b, 0x59, 0xf1, 0x65, 0xdc,
-	0x43, 0x9c, 0x32, 0xf1, 0xff, 0x6d, 0xbf, 0x6c, 0x07, 0x20, 0xe4, 0x91, 0x4d, 0x2e, 0x44, 0x23,
-	0x4e, 0xc6, 0x34, 0x6a, 0x99, 0xa9, 0x4e, 0xd7, 0xbb, 0x79, 0x6a, 0x7e, 0xb2, 0xcd, 0x66, 0xb6,
-	0xab, 0xf5, 0x14, 0x1a, 0xe1, 0x12, 0x51, 0x94, 0xfa, 0xd7, 0x3f, 0xc9, 0x1a, 0xd2, 0x69, 0xbb,
-	0xd8, 0x3e, 0xa7, 0x35, 0x9e, 0x32, 0x9d, 0x4b, 0xcc, 0x48, 0xfc, 0xee, 0x21, 0xc3, 0x8c, 0x64,
-	0xdc, 0x78, 0xa4, 0x7a, 0x2f, 0xd9, 0x17, 0x1a, 0x2c, 0xcd, 0x10, 0x4f, 0xa8, 0xa7, 0xa6, 0x19,
-	0x32, 0xae, 0x28, 0x52, 0xd3, 0x0c, 0x59, 0x19, 0x7a, 0xf9, 0xc2, 0xda, 0x47, 0xdf, 0xff, 0x70,
-	0xa8, 0x7b, 0x47, 0xe3, 0x03, 0xb2, 0xfb, 0x07, 0x6c, 0xe8, 0xfb, 0xba, 0xcd, 0x7f, 0x3d, 0xf0,
-	0xd9, 0xfd, 0x01, 0x9d, 0xed, 0x01, 0x99, 0x6d, 0x74, 0x70, 0x50, 0xa1, 0xad, 0x8f, 0xfe, 0x27,
-	0x00, 0x00, 0xff, 0xff, 0x36, 0x4c, 0xc5, 0x32, 0x4e, 0x4e, 0x00, 0x00,
+	// 4610 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x7c, 0xdb, 0x8f, 0x1b, 0x59,
+	0x5a, 0x78, 0xca, 0x76, 0xbb, 0xed, 0xcf, 0x97, 0x76, 0x9f, 0x64, 0x3a, 0x8e, 0x73, 0xaf, 0x4c,
+	0x66, 0x32, 0x99, 0xa4, 0x33, 0xd3, 0xf3, 0x1b, 0xfd, 0xc2, 0x64, 0x2e, 0x4a, 0xa7, 0x93, 0x8c,
+	0x21, 0x9d, 0xed, 0xad, 0xee, 0x4c, 0xa4, 0x5d, 0xa4, 0x52, 0xc5, 0x75, 0xda, 0x5d, 0xd3, 0x76,
+	0x95, 0x53, 0x55, 0xee, 0xcb, 0xf2, 0xb0, 0x23, 0x90, 0x90, 0xb8, 0x88, 0x45, 0x48, 0x68, 0xe1,
+	0x01, 0x09, 0xf1, 0x04, 0x8b, 0x40, 0x48, 0x2b, 0x5e, 0x78, 0xd9, 0x57, 0x04, 0x12, 0x2b, 0x84,
+	0xc4, 0x1f, 0xc0, 0x03, 0xf0, 0x8e, 0x78, 0xe3, 0x01, 0x9d, 0x4b, 0x55, 0x9d, 0xaa, 0x3a, 0x65,
+	0x57, 0xdb, 0xc9, 0x04, 0xc1, 0x9b, 0xcf, 0xa9, 0xef, 0x7c, 0xdf, 0xb9, 0x7c, 0xf7, 0xef, 0x1c,
+	0x43, 0xcb, 0x34, 0x7c, 0x43, 0xef, 0x39, 0x8e, 0x6b, 0xae, 0x8e, 0x5c, 0xc7, 0x77, 0xd0, 0xf2,
+	0xd0, 0x1a, 0x1c, 0x8c, 0x3d, 0xd6, 0x5a, 0x25, 0x9f, 0x3b, 0xf5, 0x9e, 0x33, 0x1c, 0x3a, 0x36,
+	0xeb, 0xea, 0x34, 0x2d, 0xdb, 0xc7, 0xae, 0x6d, 0x0c, 0x78, 0xbb, 0x2e, 0x0e, 0xe8, 0xd4, 0xbd,
+	0xde, 0x1e, 0x1e, 0x1a, 0xac, 0xa5, 0x2e, 0xc2, 0xc2, 0xc3, 0xe1, 0xc8, 0x3f, 0x56, 0xff, 0x40,
+	0x81, 0xfa, 0xa3, 0xc1, 0xd8, 0xdb, 0xd3, 0xf0, 0xcb, 0x31, 0xf6, 0x7c, 0xf4, 0x01, 0x94, 0x5e,
+	0x18, 0x1e, 0x6e, 0x2b, 0x57, 0x94, 0x1b, 0xb5, 0xb5, 0x0b, 0xab, 0x31, 0xaa, 0x9c, 0xde, 0xa6,
+	0xd7, 0x5f, 0x37, 0x3c, 0xac, 0x51, 0x48, 0x84, 0xa0, 0x64, 0xbe, 0xe8, 0x6e, 0xb4, 0x0b, 0x57,
+	0x94, 0x1b, 0x45, 0x8d, 0xfe, 0x46, 0x97, 0x00, 0x3c, 0xdc, 0x1f, 0x62, 0xdb, 0xef, 0x6e, 0x78,
+	0xed, 0xe2, 0x95, 0xe2, 0x8d, 0xa2, 0x26, 0xf4, 0x20, 0x15, 0xea, 0x3d, 0x67, 0x30, 0xc0, 0x3d,
+	0xdf, 0x72, 0xec, 0xee, 0x46, 0xbb, 0x44, 0xc7, 0xc6, 0xfa, 0xd4, 0x7f, 0x55, 0xa0, 0xc1, 0xa7,
+	0xe6, 0x8d, 0x1c, 0xdb, 0xc3, 0xe8, 0x23, 0x28, 0x7b, 0xbe, 0xe1, 0x8f, 0x3d, 0x3e, 0xbb, 0xf3,
+	0xd2, 0xd9, 0x6d, 0x53, 0x10, 0x8d, 0x83, 0x4a, 0xa7, 0x97, 0x24, 0x5f, 0x4c, 0x93, 0x4f, 0x2c,
+	0xa1, 0x94, 0x5a, 0xc2, 0x0d, 0x58, 0xda, 0x25, 0xb3, 0xdb, 0x8e, 0x80, 0x16, 0x28, 0x50, 0xb2,
+	0x9b, 0x60, 0xf2, 0xad, 0x21, 0xfe, 0xce, 0xee, 0x36, 0x36, 0x06, 0xed, 0x32, 0xa5, 0x25, 0xf4,
+	0xa8, 0xff, 0xa8, 0x40, 0x2b, 0x04, 0x0f, 0xce, 0xe1, 0x0c, 0x2c, 0xf4, 0x9c, 0xb1, 0xed, 0xd3,
+	0xa5, 0x36, 0x34, 0xd6, 0x40, 0x57, 0xa1, 0xde, 0xdb, 0x33, 0x6c, 0x1b, 0x0f, 0x74, 0xdb, 0x18,
+	0x62, 0xba, 0xa8, 0xaa, 0x56, 0xe3, 0x7d, 0x4f, 0x8d, 0x21, 0xce, 0xb5, 0xb6, 0x2b, 0x50, 0x1b,
+	0x19, 0xae, 0x6f, 0xc5, 0x76, 0x5f, 0xec, 0x42, 0x1d, 0xa8, 0x58, 0x5e, 0x77, 0x38, 0x72, 0x5c,
+	0xbf, 0xbd, 0x70, 0x45, 0xb9, 0x51, 0xd1, 0xc2, 0x36, 0xa1, 0x60, 0xd1, 0x5f, 0x3b, 0x86, 0xb7,
+	0xdf, 0xdd, 0xe0, 0x2b, 0x8a, 0xf5, 0xa9, 0x7f, 0xac, 0xc0, 0xca, 0x7d, 0xcf, 0xb3, 0xfa, 0x76,
+	0x6a, 0x65, 0x2b, 0x50, 0xb6, 0x1d, 0x13, 0x77, 0x37, 0xe8, 0xd2, 0x8a, 0x1a, 0x6f, 0xa1, 0xf3,
+	0x50, 0x1d, 0x61, 0xec, 0xea, 0xae, 0x33, 0x08, 0x16, 0x56, 0x21, 0x1d, 0x9a, 0x33, 0xc0, 0xe8,
+	0xbb, 0xb0, 0xec, 0x25, 0x10, 0x31, 0xbe, 0xaa, 0xad, 0x5d, 0x5b, 0x4d, 0x49, 0xc6, 0x6a, 0x92,
+	0xa8, 0x96, 0x1e, 0xad, 0x7e, 0x53, 0x80, 0xd3, 0x21, 0x1c, 0x9b, 0x2b, 0xf9, 0x4d, 0x76, 0xde,
+	0xc3, 0xfd, 0x70, 0x7a, 0xac, 0x91, 0x67, 0xe7, 0xc3, 0x23, 0x2b, 0x8a, 0x47, 0x96, 0x83, 0xd5,
+	0x93, 0xe7, 0xb1, 0x90, 0x3e, 0x8f, 0xcb, 0x50, 0xc3, 0x47, 0x23, 0xcb, 0xc5, 0x3a, 0x61, 0x1c,
+	0xba, 0xe5, 0x25, 0x0d, 0x58, 0xd7, 0x8e, 0x35, 0x14, 0x65, 0x63, 0x31, 0xb7, 0x6c, 0xa8, 0x7f,
+	0xa2, 0xc0, 0xd9, 0xd4, 0x29, 0x71, 0x61, 0xd3, 0xa0, 0x45, 0x57, 0x1e, 0xed, 0x0c, 0x11, 0x3b,
+	0xb2, 0xe1, 0xef, 0x4c, 0xda, 0xf0, 0x08, 0x5c, 0x4b, 0x8d, 0x17, 0x26, 0x59, 0xc8, 0x3f, 0xc9,
+	0x7d, 0x38, 0xfb, 0x18, 0xfb, 0x9c, 0x00, 0xf9, 0x86, 0xbd, 0xd9, 0x95, 0x55, 0x5c, 0xaa, 0x0b,
+	0x49, 0xa9, 0x56, 0xff, 0xaa, 0x00, 0x2d, 0x91, 0x54, 0xd7, 0xde, 0x75, 0xd0, 0x05, 0xa8, 0x86,
+	0x20, 0x9c, 0x2b, 0xa2, 0x0e, 0xf4, 0xff, 0x61, 0x81, 0xcc, 0x94, 0xb1, 0x44, 0x73, 0xed, 0xaa,
+	0x7c, 0x4d, 0x02, 0x4e, 0x8d, 0xc1, 0xa3, 0x2e, 0x34, 0x3d, 0xdf, 0x70, 0x7d, 0x7d, 0xe4, 0x78,
+	0xf4, 0x9c, 0x29, 0xe3, 0xd4, 0xd6, 0xd4, 0x38, 0x86, 0x50, 0xad, 0x6f, 0x7a, 0xfd, 0x2d, 0x0e,
+	0xa9, 0x35, 0xe8, 0xc8, 0xa0, 0x89, 0x1e, 0x42, 0x1d, 0xdb, 0x66, 0x84, 0xa8, 0x94, 0x1b, 0x51,
+	0x0d, 0xdb, 0x66, 0x88, 0x26, 0x3a, 0x9f, 0x85, 0xfc, 0xe7, 0xf3, 0xdb, 0x0a, 0xb4, 0xd3, 0x07,
+	0x34, 0x8f, 0xca, 0xbe, 0xc7, 0x06, 0x61, 0x76, 0x40, 0x13, 0x25, 0x3c, 0x3c, 0x24, 0x8d, 0x0f,
+	0x51, 0x7f, 0x5f, 0x81, 0xb7, 0xa2, 0xe9, 0xd0, 0x4f, 0xaf, 0x8b, 0x5b, 0xd0, 0x4d, 0x68, 0x59,
+	0x76, 0x6f, 0x30, 0x36, 0xf1, 0x33, 0xfb, 0x4b, 0x6c, 0x0c, 0xfc, 0xbd, 0x63, 0x7a, 0x86, 0x15,
+	0x2d, 0xd5, 0xaf, 0xfe, 0x9a, 0x02, 0x2b, 0xc9, 0x79, 0xcd, 0xb3, 0x49, 0xff, 0x0f, 0x16, 0x2c,
+	0x7b, 0xd7, 0x09, 0xf6, 0xe8, 0xd2, 0x04, 0xa1, 0x24, 0xb4, 0x18, 0xb0, 0x3a, 0x84, 0xf3, 0x8f,
+	0xb1, 0xdf, 0xb5, 0x3d, 0xec, 0xfa, 0xeb, 0x96, 0x3d, 0x70, 0xfa, 0x5b, 0x86, 0xbf, 0x37, 0x87,
+	0x40, 0xc5, 0x64, 0xa3, 0x90, 0x90, 0x0d, 0xf5, 0x4f, 0x15, 0xb8, 0x20, 0xa7, 0xc7, 0x97, 0xde,
+	0x81, 0xca, 0xae, 0x85, 0x07, 0x66, 0x77, 0x83, 0x69, 0x97, 0xa2, 0x16, 0xb6, 0x89, 0x60, 0x8d,
+	0x08, 0x30, 0x5f, 0xe1, 0xd5, 0x0c, 0x6e, 0xde, 0xf6, 0x5d, 0xcb, 0xee, 0x3f, 0xb1, 0x3c, 0x5f,
+	0x63, 0xf0, 0xc2, 0x7e, 0x16, 0xf3, 0xb3, 0xf1, 0x6f, 0x2a, 0x70, 0xe9, 0x31, 0xf6, 0x1f, 0x84,
+	0x7a, 0x99, 0x7c, 0xb7, 0x3c, 0xdf, 0xea, 0x79, 0xaf, 0xd6, 0x37, 0xca, 0x61, 0xa0, 0xd5, 0x1f,
+	0x29, 0x70, 0x39, 0x73, 0x32, 0x7c, 0xeb, 0xb8, 0xde, 0x09, 0xb4, 0xb2, 0x5c, 0xef, 0xfc, 0x12,
+	0x3e, 0xfe, 0xca, 0x18, 0x8c, 0xf1, 0x96, 0x61, 0xb9, 0x4c, 0xef, 0xcc, 0xa8, 0x85, 0xff, 0x42,
+	0x81, 0x8b, 0x8f, 0xb1, 0xbf, 0x15, 0xd8, 0xa4, 0x37, 0xb8, 0x3b, 0x04, 0x46, 0xb0, 0x8d, 0x81,
+	0x73, 0x16, 0xeb, 0x53, 0x7f, 0x87, 0x1d, 0xa7, 0x74, 0xbe, 0x6f, 0x64, 0x03, 0x2f, 0x51, 0x49,
+	0x10, 0x44, 0xf2, 0x01, 0x73, 0x1d, 0xf8, 0xf6, 0xa9, 0x7f, 0xa4, 0xc0, 0xb9, 0xfb, 0xbd, 0x97,
+	0x63, 0xcb, 0xc5, 0x1c, 0xe8, 0x89, 0xd3, 0xdb, 0x9f, 0x7d, 0x73, 0x23, 0x37, 0xab, 0x10, 0x73,
+	0xb3, 0xa6, 0xb9, 0xe6, 0x2b, 0x50, 0xf6, 0x99, 0x5f, 0xc7, 0x3c, 0x15, 0xde, 0xa2, 0xf3, 0xd3,
+	0xf0, 0x00, 0x1b, 0xde, 0xff, 0xcc, 0xf9, 0xfd, 0xa8, 0x04, 0xf5, 0xaf, 0xb8, 0x3b, 0x46, 0xad,
+	0x76, 0x92, 0x93, 0x14, 0xb9, 0xe3, 0x25, 0x78, 0x70, 0x32, 0xa7, 0xee, 0x31, 0x34, 0x3c, 0x8c,
+	0xf7, 0x67, 0xb1, 0xd1, 0x75, 0x32, 0x30, 0x68, 0xa1, 0x27, 0xb0, 0x3c, 0xb6, 0x69, 0x68, 0x80,
+	0x4d, 0xbe, 0x81, 0x8c, 0x73, 0xa7, 0xeb, 0xee, 0xf4, 0x40, 0xf4, 0x25, 0x2c, 0x25, 0xba, 0xda,
+	0x0b, 0xb9, 0x70, 0x25, 0x87, 0xa1, 0x2e, 0xb4, 0x4c, 0xd7, 0x19, 0x8d, 0xb0, 0xa9, 0x7b, 0x01,
+	0xaa, 0x72, 0x3e, 0x54, 0x7c, 0x5c, 0x88, 0xea, 0x03, 0x38, 0x9d, 0x9c, 0x69, 0xd7, 0x24, 0x0e,
+	0x29, 0x39, 0x43, 0xd9, 0x27, 0x74, 0x0b, 0x96, 0xd3, 0xf0, 0x15, 0x0a, 0x9f, 0xfe, 0x80, 0x6e,
+	0x03, 0x4a, 0x4c, 0x95, 0x80, 0x57, 0x19, 0x78, 0x7c, 0x32, 0x5d, 0xd3, 0x53, 0x7f, 0x43, 0x81,
+	0x95, 0xe7, 0x86, 0xdf, 0xdb, 0xdb, 0x18, 0x72, 0x59, 0x9b, 0x43, 0x57, 0x7d, 0x06, 0xd5, 0x03,
+	0xce, 0x17, 0x81, 0x41, 0xba, 0x2c, 0xd9, 0x1f, 0x91, 0x03, 0xb5, 0x68, 0x04, 0x89, 0x87, 0xce,
+	0x3c, 0x12, 0xe2, 0xc2, 0x37, 0xa0, 0x35, 0xa7, 0x04, 0xb4, 0xea, 0x11, 0x00, 0x9f, 0xdc, 0xa6,
+	0xd7, 0x9f, 0x61, 0x5e, 0x77, 0x61, 0x91, 0x63, 0xe3, 0x6a, 0x71, 0x1a, 0xff, 0x04, 0xe0, 0xea,
+	0x4f, 0xca, 0x50, 0x13, 0x3e, 0xa0, 0x26, 0x14, 0x42, 0x79, 0x2d, 0x48, 0x56, 0x57, 0x98, 0x1e,
+	0x42, 0x15, 0xd3, 0x21, 0xd4, 0x75, 0x68, 0x5a, 0xd4, 0x0f, 0xd1, 0xf9, 0xa9, 0x50, 0x05, 0x52,
+	0xd5, 0x1a, 0xac, 0x97, 0xb3, 0x08, 0xba, 0x04, 0x35, 0x7b, 0x3c, 0xd4, 0x9d, 0x5d, 0xdd, 0x75,
+	0x0e, 0x3d, 0x1e, 0x8b, 0x55, 0xed, 0xf1, 0xf0, 0x3b, 0xbb, 0x9a, 0x73, 0xe8, 0x45, 0xee, 0x7e,
+	0xf9, 0x84, 0xee, 0xfe, 0x25, 0xa8, 0x0d, 0x8d, 0x23, 0x82, 0x55, 0xb7, 0xc7, 0x43, 0x1a, 0xa6,
+	0x15, 0xb5, 0xea, 0xd0, 0x38, 0xd2, 0x9c, 0xc3, 0xa7, 0xe3, 0x21, 0xba, 0x01, 0xad, 0x81, 0xe1,
+	0xf9, 0xba, 0x18, 0xe7, 0x55, 0x68, 0x9c, 0xd7, 0x24, 0xfd, 0x0f, 0xa3, 0x58, 0x2f, 0x1d, 0x38,
+	0x54, 0xe7, 0x08, 0x1c, 0xcc, 0xe1, 0x20, 0x42, 0x04, 0xf9, 0x03, 0x07, 0x73, 0x38, 0x08, 0xd1,
+	0xdc, 0x85, 0xc5, 0x17, 0xd4, 0xbb, 0xf3, 0xda, 0xb5, 0x4c, 0xdd, 0xf1, 0x88, 0x38, 0x76, 0xcc,
+	0x09, 0xd4, 0x02, 0x70, 0xf4, 0x29, 0x54, 0xa9, 0x51, 0xa5, 0x63, 0xeb, 0xb9, 0xc6, 0x46, 0x03,
+	0xc8, 0x68, 0x13, 0x0f, 0x7c, 0x83, 0x8e, 0x6e, 0xe4, 0x1b, 0x1d, 0x0e, 0x20, 0xfa, 0xaa, 0xe7,
+	0x62, 0xc3, 0xc7, 0xe6, 0xfa, 0xf1, 0x03, 0x67, 0x38, 0x32, 0x28, 0x33, 0xb5, 0x9b, 0xd4, 0x83,
+	0x97, 0x7d, 0x42, 0xef, 0x40, 0xb3, 0x17, 0xb6, 0x1e, 0xb9, 0xce, 0xb0, 0xbd, 0x44, 0xe5, 0x28,
+	0xd1, 0x8b, 0x2e, 0x02, 0x04, 0x9a, 0xca, 0xf0, 0xdb, 0x2d, 0x7a, 0x8a, 0x55, 0xde, 0x73, 0x9f,
+	0xa6, 0x71, 0x2c, 0x4f, 0x67, 0x09, 0x13, 0xcb, 0xee, 0xb7, 0x97, 0x29, 0xc5, 0x5a, 0x90, 0x61,
+	0xb1, 0xec, 0x3e, 0x3a, 0x0b, 0x8b, 0x96, 0xa7, 0xef, 0x1a, 0xfb, 0xb8, 0x8d, 0xe8, 0xd7, 0xb2,
+	0xe5, 0x3d, 0x32, 0xf6, 0xb1, 0xfa, 0x43, 0x38, 0x13, 0x71, 0x97, 0x70, 0x92, 0x69, 0xa6, 0x50,
+	0x66, 0x65, 0x8a, 0xc9, 0x3e, 0xfd, 0xcf, 0x4b, 0xb0, 0xb2, 0x6d, 0x1c, 0xe0, 0xd7, 0x1f, 0x3e,
+	0xe4, 0x52, 0x6b, 0x4f, 0x60, 0x99, 0x46, 0x0c, 0x6b, 0xc2, 0x7c, 0xda, 0xa5, 0x5c, 0xac, 0x90,
+	0x1e, 0x88, 0xbe, 0x20, 0x0e, 0x01, 0xee, 0xed, 0x6f, 0x39, 0x56, 0x64, 0x53, 0x2f, 0x4a, 0xf0,
+	0x3c, 0x08, 0xa1, 0x34, 0x71, 0x04, 0xda, 0x82, 0xa5, 0xf8, 0x31, 0x04, 0xd6, 0xf4, 0xdd, 0x89,
+	0x41, 0x6c, 0xb4, 0xfb, 0x5a, 0x33, 0x76, 0x18, 0x1e, 0x6a, 0xc3, 0x22, 0x37, 0x85, 0x54, 0x67,
+	0x54, 0xb4, 0xa0, 0x89, 0xb6, 0xe0, 0x34, 0x5b, 0xc1, 0x36, 0x17, 0x08, 0xb6, 0xf8, 0x4a, 0xae,
+	0xc5, 0xcb, 0x86, 0xc6, 0xe5, 0xa9, 0x7a, 0x52, 0x79, 0x6a, 0xc3, 0x22, 0xe7, 0x71, 0xaa, 0x47,
+	0x2a, 0x5a, 0xd0, 0x24, 0xc7, 0x1c, 0x71, 0x7b, 0x8d, 0x7e, 0x8b, 0x3a, 0x48, 0xe8, 0x05, 0xd1,
+	0x7e, 0x4e, 0x49, 0xb7, 0x7c, 0x0e, 0x95, 0x90, 0xc3, 0x0b, 0xb9, 0x39, 0x3c, 0x1c, 0x93, 0xd4,
+	0xef, 0xc5, 0x84, 0x7e, 0x57, 0xff, 0x5e, 0x81, 0xfa, 0x06, 0x59, 0xd2, 0x13, 0xa7, 0x4f, 0xad,
+	0xd1, 0x75, 0x68, 0xba, 0xb8, 0xe7, 0xb8, 0xa6, 0x8e, 0x6d, 0xdf, 0xb5, 0x30, 0x8b, 0xd2, 0x4b,
+	0x5a, 0x83, 0xf5, 0x3e, 0x64, 0x9d, 0x04, 0x8c, 0xa8, 0x6c, 0xcf, 0x37, 0x86, 0x23, 0x7d, 0x97,
+	0xa8, 0x86, 0x02, 0x03, 0x0b, 0x7b, 0xa9, 0x66, 0xb8, 0x0a, 0xf5, 0x08, 0xcc, 0x77, 0x28, 0xfd,
+	0x92, 0x56, 0x0b, 0xfb, 0x76, 0x1c, 0xf4, 0x36, 0x34, 0xe9, 0x9e, 0xea, 0x03, 0xa7, 0xaf, 0x93,
+	0x88, 0x96, 0x1b, 0xaa, 0xba, 0xc9, 0xa7, 0x45, 0xce, 0x2a, 0x0e, 0xe5, 0x59, 0x3f, 0xc0, 0xdc,
+	0x54, 0x85, 0x50, 0xdb, 0xd6, 0x0f, 0xb0, 0xfa, 0x77, 0x0a, 0x34, 0x36, 0x0c, 0xdf, 0x78, 0xea,
+	0x98, 0x78, 0x67, 0x46, 0xc3, 0x9e, 0x23, 0xf5, 0x79, 0x01, 0xaa, 0xe1, 0x0a, 0xf8, 0x92, 0xa2,
+	0x0e, 0xf4, 0x08, 0x9a, 0x81, 0x6b, 0xa9, 0xb3, 0x88, 0xab, 0x94, 0xe9, 0x40, 0x09, 0x96, 0xd3,
+	0xd3, 0x1a, 0xc1, 0x30, 0xda, 0x54, 0x1f, 0x41, 0x5d, 0xfc, 0x4c, 0xa8, 0x6e, 0x27, 0x19, 0x25,
+	0xec, 0x20, 0xdc, 0xf8, 0x74, 0x3c, 0x24, 0x67, 0xca, 0x15, 0x4b, 0xd0, 0x24, 0xa9, 0x98, 0x06,
+	0x37, 0xf7, 0xdb, 0x61, 0x91, 0x80, 0x2e, 0x4d, 0xa1, 0x4b, 0xa3, 0xbf, 0xd1, 0x27, 0xf1, 0xbc,
+	0xde, 0xdb, 0x52, 0x25, 0x40, 0x91, 0x50, 0x27, 0x33, 0x66, 0xeb, 0xf3, 0xc4, 0xf8, 0xdf, 0x10,
+	0x46, 0xe3, 0x47, 0x43, 0x19, 0xad, 0x0d, 0x8b, 0x86, 0x69, 0xba, 0xd8, 0xf3, 0xf8, 0x3c, 0x82,
+	0x26, 0xf9, 0x72, 0x80, 0x5d, 0x2f, 0x60, 0xf9, 0xa2, 0x16, 0x34, 0xd1, 0xa7, 0x50, 0x09, 0xbd,
+	0x52, 0x96, 0x0e, 0xbf, 0x92, 0x3d, 0x4f, 0x1e, 0x91, 0x86, 0x23, 0xd4, 0xbf, 0x2e, 0x40, 0x93,
+	0x6f, 0xd8, 0x3a, 0xb7, 0xc7, 0x93, 0x85, 0x6f, 0x1d, 0xea, 0xbb, 0x91, 0xec, 0x4f, 0xca, 0x3d,
+	0x89, 0x2a, 0x22, 0x36, 0x66, 0x9a, 0x00, 0xc6, 0x3d, 0x82, 0xd2, 0x5c, 0x1e, 0xc1, 0xc2, 0x49,
+	0x35, 0x58, 0xda, 0x47, 0x2c, 0x4b, 0x7c, 0x44, 0xf5, 0x97, 0xa1, 0x26, 0x20, 0xa0, 0x1a, 0x9a,
+	0x25, 0xad, 0xf8, 0x8e, 0x05, 0x4d, 0xf4, 0x51, 0xe4, 0x17, 0xb1, 0xad, 0x3a, 0x27, 0x99, 0x4b,
+	0xc2, 0x25, 0x52, 0x7f, 0xa6, 0x40, 0x99, 0x63, 0x26, 0x69, 0x7f, 0xa6, 0x5f, 0xa8, 0xcf, 0xc8,
+	0xb0, 0x03, 0xef, 0x22, 0x4e, 0xe3, 0xab, 0xd3, 0x3a, 0xe7, 0xa0, 0x92, 0xd0, 0x37, 0x8b, 0xdc,
+	0x2c, 0x04, 0x9f, 0x04, 0x25, 0xb3, 0x38, 0x60, 0xfa, 0x85, 0xd4, 0x3c, 0x06, 0x4e, 0x3f, 0x2c,
+	0x02, 0xb1, 0x86, 0xfa, 0xb7, 0x0a, 0xcd, 0xd9, 0x6b, 0xb8, 0xe7, 0x1c, 0x60, 0xf7, 0x78, 0xfe,
+	0x64, 0xe7, 0x3d, 0x81, 0xcd, 0x73, 0x06, 0x5f, 0xe1, 0x00, 0x74, 0x2f, 0x3a, 0x84, 0xa2, 0x2c,
+	0xd3, 0x23, 0xea, 0x1d, 0xce, 0xa4, 0xd1, 0x61, 0xfc, 0x2e, 0x4b, 0xdb, 0xc6, 0x97, 0x32, 0xab,
+	0xb7, 0xf3, 0x4a, 0x02, 0x19, 0xf5, 0xe7, 0x0a, 0x74, 0xa2, 0x54, 0x92, 0xb7, 0x7e, 0x3c, 0x6f,
+	0x51, 0xe4, 0xd5, 0xc4, 0x57, 0xbf, 0x10, 0x66, 0xed, 0x89, 0xd0, 0xe6, 0x8a, 0x8c, 0xf8, 0x00,
+	0xd5, 0xa6, 0x59, 0xe9, 0xf4, 0x82, 0xe6, 0x61, 0x99, 0x0e, 0x54, 0xc2, 0x7c, 0x06, 0xcb, 0xdc,
+	0x87, 0x6d, 0x22, 0x61, 0xe7, 0x1e, 0x63, 0xff, 0x51, 0x3c, 0x15, 0xf2, 0xa6, 0x37, 0x50, 0xac,
+	0x26, 0xec, 0xf1, 0x6a, 0x42, 0x29, 0x51, 0x4d, 0xe0, 0xfd, 0xea, 0x10, 0x3a, 0xb2, 0x05, 0xbc,
+	0xae, 0x0d, 0xfb, 0x75, 0x05, 0xda, 0x9c, 0x0a, 0xa5, 0x49, 0x42, 0xa2, 0x01, 0xf6, 0xb1, 0xf9,
+	0x6d, 0xa7, 0x0a, 0xfe, 0x4b, 0x81, 0x96, 0x68, 0x75, 0xc9, 0x57, 0xf4, 0x31, 0x2c, 0xd0, 0x4c,
+	0x0b, 0x9f, 0xc1, 0x54, 0xd5, 0xc0, 0xa0, 0x89, 0xda, 0xa6, 0xae, 0xf6, 0x4e, 0xe8, 0x20, 0xf0,
+	0x66, 0x64, 0xfa, 0x8b, 0x27, 0x37, 0xfd, 0xdc, 0x15, 0x72, 0xc6, 0x04, 0x2f, 0x4b, 0x51, 0x46,
+	0x1d, 0xe8, 0x33, 0x28, 0xb3, 0x8b, 0x18, 0xbc, 0xc2, 0x76, 0x3d, 0x8e, 0x9a, 0x7d, 0x5b, 0x15,
+	0xf2, 0xfe, 0xb4, 0x43, 0xe3, 0x83, 0xd4, 0x5f, 0x84, 0x95, 0x28, 0x1a, 0x65, 0x64, 0x67, 0x65,
+	0x5a, 0xf5, 0x9f, 0x15, 0x38, 0xbd, 0x7d, 0x6c, 0xf7, 0x92, 0xec, 0xbf, 0x02, 0xe5, 0xd1, 0xc0,
+	0x88, 0x32, 0xa6, 0xbc, 0x45, 0xdd, 0x40, 0x46, 0x1b, 0x9b, 0xc4, 0x86, 0xb0, 0x3d, 0xab, 0x85,
+	0x7d, 0x3b, 0xce, 0x54, 0xd3, 0x7e, 0x3d, 0x0c, 0x9f, 0xb1, 0xc9, 0xac, 0x15, 0x4b, 0x43, 0x35,
+	0xc2, 0x5e, 0x6a, 0xad, 0x3e, 0x03, 0xa0, 0x06, 0x5d, 0x3f, 0x89, 0x11, 0xa7, 0x23, 0x9e, 0x10,
+	0x95, 0xfd, 0xd3, 0x02, 0xb4, 0x85, 0x5d, 0xfa, 0xb6, 0xfd, 0x9b, 0x8c, 0xa8, 0xac, 0xf8, 0x8a,
+	0xa2, 0xb2, 0xd2, 0xfc, 0x3e, 0xcd, 0x82, 0xcc, 0xa7, 0xf9, 0x97, 0x02, 0x34, 0xa3, 0x5d, 0xdb,
+	0x1a, 0x18, 0x76, 0x26, 0x27, 0x6c, 0x87, 0xfe, 0x7c, 0x7c, 0x9f, 0xde, 0x97, 0xc9, 0x49, 0xc6,
+	0x41, 0x68, 0x09, 0x14, 0x24, 0x65, 0xc2, 0x02, 0x67, 0x9a, 0xf8, 0xe2, 0x31, 0x04, 0x13, 0x48,
+	0x92, 0xf3, 0xba, 0x05, 0x88, 0x4b, 0x91, 0x6e, 0xd9, 0xba, 0x87, 0x7b, 0x8e, 0x6d, 0x32, 0xf9,
+	0x5a, 0xd0, 0x5a, 0xfc, 0x4b, 0xd7, 0xde, 0x66, 0xfd, 0xe8, 0x63, 0x28, 0xf9, 0xc7, 0x23, 0xe6,
+	0xad, 0x34, 0xd7, 0xae, 0x4e, 0x9c, 0xd7, 0xce, 0xf1, 0x08, 0x6b, 0x14, 0x3c, 0xb8, 0xa9, 0xe3,
+	0xbb, 0xc6, 0x01, 0x77, 0xfd, 0x4a, 0x9a, 0xd0, 0x43, 0x34, 0x46, 0xb0, 0x87, 0x8b, 0xcc, 0x45,
+	0xe2, 0x4d, 0xc6, 0xd9, 0x81, 0xd0, 0xea, 0xbe, 0x3f, 0xa0, 0xa9, 0x3b, 0xca, 0xd9, 0x41, 0xef,
+	0x8e, 0x3f, 0x50, 0xff, 0xa9, 0x00, 0xad, 0x88, 0xb2, 0x86, 0xbd, 0xf1, 0x20, 0x5b, 0xe0, 0x26,
+	0xe7, 0x46, 0xa6, 0xc9, 0xda, 0x17, 0x50, 0xe3, 0xc7, 0x7e, 0x02, 0xb6, 0x01, 0x36, 0xe4, 0xc9,
+	0x04, 0x3e, 0x5e, 0x78, 0x45, 0x7c, 0x5c, 0x9e, 0x21, 0xbb, 0x20, 0xdf, 0x7c, 0x52, 0x65, 0x7e,
+	0x2b, 0xa5, 0x16, 0x27, 0x6e, 0xed, 0xe4, 0xd8, 0x8e, 0xab, 0xcb, 0x24, 0x4a, 0xae, 0xe0, 0xef,
+	0x41, 0xd9, 0xa5, 0xd8, 0x79, 0x29, 0xe8, 0xda, 0x44, 0xee, 0x62, 0x13, 0xd1, 0xf8, 0x10, 0xf5,
+	0xf7, 0x14, 0x38, 0x9b, 0x9e, 0xea, 0x1c, 0x56, 0x7b, 0x1d, 0x16, 0x19, 0xea, 0x40, 0x08, 0x6f,
+	0x4c, 0x16, 0xc2, 0x68, 0x73, 0xb4, 0x60, 0xa0, 0xba, 0x0d, 0x2b, 0x81, 0x71, 0x8f, 0xb6, 0x7e,
+	0x13, 0xfb, 0xc6, 0x84, 0xc8, 0xe6, 0x32, 0xd4, 0x98, 0x8b, 0xcc, 0x22, 0x06, 0x96, 0x13, 0x80,
+	0x17, 0x61, 0x2a, 0x4d, 0xfd, 0x77, 0x05, 0xce, 0x50, 0xeb, 0x98, 0xac, 0xbd, 0xe4, 0xa9, 0xcb,
+	0xa9, 0x50, 0x17, 0xd2, 0x0b, 0x6c, 0x69, 0x55, 0x2d, 0xd6, 0x87, 0xba, 0xe9, 0x4c, 0x9b, 0x34,
+	0x02, 0x8e, 0x0a, 0xb9, 0x24, 0xda, 0xa6, 0x75, 0xdc, 0x64, 0x8a, 0x2d, 0xb2, 0xca, 0xa5, 0x59,
+	0xac, 0xf2, 0x13, 0x78, 0x2b, 0xb1, 0xd2, 0x39, 0x4e, 0x54, 0xfd, 0x33, 0x85, 0x1c, 0x47, 0xec,
+	0x3e, 0xcd, 0xec, 0x9e, 0xe9, 0xc5, 0xb0, 0xe8, 0xa3, 0x5b, 0x66, 0x52, 0x89, 0x98, 0xe8, 0x73,
+	0xa8, 0xda, 0xf8, 0x50, 0x17, 0x9d, 0x9d, 0x1c, 0x6e, 0x7b, 0xc5, 0xc6, 0x87, 0xf4, 0x97, 0xfa,
+	0x14, 0xce, 0xa6, 0xa6, 0x3a, 0xcf, 0xda, 0xff, 0x46, 0x81, 0x73, 0x1b, 0xae, 0x33, 0xfa, 0xca,
+	0x72, 0xfd, 0xb1, 0x31, 0x88, 0x97, 0xc8, 0x5f, 0x4f, 0xea, 0xea, 0x4b, 0xc1, 0xed, 0x65, 0xfc,
+	0x73, 0x4b, 0x22, 0x41, 0xe9, 0x49, 0xf1, 0x45, 0x0b, 0x4e, 0xf2, 0xbf, 0x15, 0xe1, 0x5c, 0x26,
+	0xdc, 0x14, 0xc7, 0x23, 0x4f, 0x04, 0x21, 0xcd, 0x74, 0x17, 0x67, 0xcd, 0x74, 0x67, 0xa8, 0xf7,
+	0xd2, 0x2b, 0x52, 0xef, 0x27, 0x4e, 0xbd, 0x7c, 0x09, 0xf1, 0x2a, 0x44, 0xbb, 0x9c, 0x3b, 0xb9,
+	0x1b, 0x1f, 0x88, 0xd6, 0x01, 0xa2, 0x8c, 0x7c, 0x7b, 0x31, 0x37, 0x1a, 0x61, 0x14, 0x39, 0xad,
+	0xd0, 0x94, 0x72, 0x53, 0x1e, 0x75, 0xa8, 0xdf, 0x85, 0x8e, 0x8c, 0x4b, 0xe7, 0xe1, 0xfc, 0x9f,
+	0x16, 0x00, 0xba, 0xe1, 0x0d, 0xda, 0xd9, 0x6c, 0xc1, 0x35, 0x10, 0xdc, 0x8d, 0x48, 0xde, 0x45,
+	0x2e, 0x32, 0x89, 0x48, 0x84, 0x41, 0x27, 0x81, 0x49, 0x05, 0xa2, 0x26, 0xc5, 0x23, 0x48, 0x0d,
+	0x63, 0x8a, 0xa4, 0xfa, 0x3d, 0x0f, 0x55, 0x52, 0xca, 0x24, 0x62, 0x66, 0x06, 0x57, 0x84, 0x5d,
+	0xe7, 0x90, 0x08, 0x9f, 0x49, 0xaa, 0x57, 0xe4, 0x5a, 0x06, 0xc1, 0x5f, 0x16, 0x6e, 0x69, 0x98,
+	0x24, 0x5f, 0xb4, 0x6b, 0x0d, 0x30, 0xbb, 0x14, 0x50, 0xd5, 0x58, 0x83, 0xd4, 0x54, 0xd9, 0x5d,
+	0xb6, 0x4a, 0xee, 0x9b, 0x38, 0x14, 0x9e, 0x24, 0x9a, 0x96, 0xa2, 0x5d, 0xa3, 0x0a, 0x88, 0xe8,
+	0x34, 0xaa, 0xcf, 0x1e, 0x38, 0x26, 0x53, 0x15, 0xcd, 0x0c, 0x8b, 0xc0, 0x06, 0xd2, 0x41, 0x5a,
+	0x34, 0x64, 0x52, 0x1c, 0x4c, 0xd6, 0x45, 0x16, 0x6d, 0x99, 0xc1, 0xcd, 0x94, 0xb2, 0xeb, 0x1c,
+	0x76, 0xcd, 0x70, 0x37, 0xd8, 0xfd, 0x5f, 0x16, 0xf5, 0x91, 0xdd, 0x78, 0x40, 0xda, 0x64, 0x3f,
+	0xb1, 0xeb, 0x3a, 0xae, 0x3e, 0xc4, 0x9e, 0x67, 0xf4, 0x31, 0x77, 0xc0, 0xeb, 0xb4, 0x73, 0x93,
+	0xf5, 0xa9, 0x3f, 0x2e, 0x41, 0x33, 0x5a, 0x4a, 0x50, 0x07, 0xb7, 0xcc, 0xa0, 0x0e, 0x6e, 0x91,
+	0xa3, 0x03, 0x97, 0xa9, 0xc2, 0xf0, 0x70, 0xd7, 0x0b, 0x6d, 0x45, 0xab, 0xf2, 0xde, 0xae, 0x49,
+	0xcc, 0x32, 0x11, 0x32, 0xdb, 0x31, 0x71, 0x74, 0xb8, 0x10, 0x74, 0xf1, 0xb3, 0x8d, 0xf1, 0x48,
+	0x29, 0x07, 0x8f, 0x2c, 0xe4, 0xe0, 0x91, 0xb2, 0x84, 0x47, 0x56, 0xa0, 0xfc, 0x62, 0xdc, 0xdb,
+	0xc7, 0x3e, 0xf7, 0xd8, 0x78, 0x2b, 0xce, 0x3b, 0x95, 0x04, 0xef, 0x84, 0x2c, 0x52, 0x15, 0x59,
+	0xe4, 0x3c, 0x54, 0x59, 0x41, 0x56, 0xf7, 0x3d, 0x5a, 0x5d, 0x2a, 0x6a, 0x15, 0xd6, 0xb1, 0xe3,
+	0xa1, 0xbb, 0x81, 0x3b, 0x57, 0x93, 0x09, 0x3b, 0xd5, 0x3a, 0x09, 0x2e, 0x09, 0x9c, 0xb9, 0x77,
+	0x61, 0x49, 0xd8, 0x0e, 0x6a, 0x23, 0xea, 0x74, 0xaa, 0x82, 0x3b, 0x4f, 0xcd, 0xc4, 0x75, 0x68,
+	0x46, 0x5b, 0x42, 0xe1, 0x1a, 0x2c, 0x8a, 0x0a, 0x7b, 0x29, 0x58, 0xc8, 0xc9, 0xcd, 0x93, 0x71,
+	0x32, 0xc9, 0xb1, 0xf2, 0xf0, 0xc7, 0x6b, 0x2f, 0xc5, 0xb2, 0x11, 0xea, 0xd7, 0x80, 0xa2, 0xd9,
+	0xcf, 0xe7, 0x2d, 0x26, 0xd8, 0xa3, 0x90, 0x64, 0x0f, 0xf5, 0x27, 0x0a, 0x2c, 0x8b, 0xc4, 0x66,
+	0x35, 0xbc, 0x9f, 0x43, 0x8d, 0xd5, 0xf7, 0x74, 0x22, 0xf8, 0x3c, 0xcb, 0x73, 0x71, 0xe2, 0xb9,
+	0x68, 0x10, 0xbd, 0x20, 0x20, 0xec, 0x75, 0xe8, 0xb8, 0xfb, 0x96, 0xdd, 0xd7, 0xc9, 0xcc, 0x02,
+	0x71, 0xab, 0xf3, 0x4e, 0x52, 0x33, 0xf1, 0xd4, 0x9f, 0x15, 0x00, 0x1e, 0x1e, 0x85, 0x63, 0x04,
+	0xa5, 0xa3, 0xc4, 0x94, 0x4e, 0x2e, 0xbd, 0x78, 0x0d, 0x1a, 0x22, 0xcf, 0x87, 0x14, 0x05, 0xa6,
+	0xf7, 0xe2, 0x75, 0xae, 0x52, 0xb2, 0xce, 0x75, 0x0d, 0x1a, 0xce, 0xd8, 0x1f, 0x8d, 0x7d, 0x7d,
+	0xe4, 0xe2, 0x5d, 0xeb, 0x28, 0x90, 0x73, 0xd6, 0xb9, 0x45, 0xfb, 0x88, 0x4c, 0xec, 0x3a, 0xee,
+	0xd0, 0xf0, 0x79, 0x69, 0x81, 0xb7, 0xd0, 0x27, 0x82, 0xda, 0x59, 0xcc, 0x75, 0xff, 0x2a, 0x52,
+	0x4b, 0x33, 0xeb, 0xcf, 0xaf, 0x01, 0x3d, 0x3c, 0xfa, 0x16, 0x59, 0xeb, 0xe1, 0xd1, 0x2b, 0x61,
+	0x2d, 0x7c, 0x94, 0x87, 0xb5, 0x04, 0x62, 0x80, 0x8f, 0x4e, 0xc6, 0x5a, 0xff, 0x59, 0x84, 0x15,
+	0x0d, 0x7b, 0xbe, 0x13, 0xde, 0xc6, 0x9c, 0x23, 0x3d, 0x7c, 0x07, 0x4e, 0x7b, 0xce, 0xd8, 0xed,
+	0x61, 0x5d, 0xe2, 0xe3, 0x21, 0xf6, 0xe9, 0x81, 0xf0, 0x65, 0x4a, 0x39, 0x35, 0xcf, 0x8b, 0x12,
+	0x3d, 0x71, 0x45, 0x96, 0xb9, 0x63, 0xf7, 0x24, 0xbb, 0x24, 0x5f, 0xe5, 0xea, 0x96, 0x30, 0x9a,
+	0x94, 0xb8, 0x8f, 0xe3, 0xf7, 0x6b, 0xd1, 0x57, 0xe2, 0x7d, 0x38, 0x16, 0xcb, 0xdf, 0xcd, 0x8f,
+	0x3d, 0x48, 0xc7, 0x72, 0xd4, 0x11, 0xaa, 0xce, 0x17, 0xb0, 0x9c, 0x22, 0x8d, 0x5a, 0x50, 0xdc,
+	0xc7, 0xc7, 0x5c, 0xaa, 0xc9, 0x4f, 0x62, 0x24, 0x0e, 0x08, 0x33, 0xf3, 0x4d, 0x64, 0x8d, 0x4f,
+	0x0a, 0x77, 0x95, 0xce, 0xa7, 0xd0, 0x8c, 0x63, 0x17, 0x47, 0x57, 0x25, 0xa3, 0xab, 0xc2, 0x68,
+	0xf5, 0xb7, 0x14, 0x38, 0x9b, 0x9a, 0xf3, 0x3c, 0x62, 0x31, 0xed, 0x09, 0x41, 0xcc, 0x71, 0x28,
+	0xc6, 0x1d, 0x07, 0x72, 0x83, 0xf1, 0xd2, 0xb3, 0x91, 0x69, 0xf8, 0x58, 0x08, 0xb1, 0xe6, 0xbd,
+	0x75, 0xfd, 0x71, 0x70, 0xed, 0xb9, 0x90, 0xaf, 0x08, 0xcf, 0xa0, 0xd5, 0xbf, 0x0c, 0xe7, 0xc2,
+	0xfd, 0x5d, 0x7a, 0x63, 0x63, 0x44, 0x6f, 0xc0, 0xcc, 0x3c, 0x97, 0x0e, 0x54, 0x0e, 0x38, 0xba,
+	0xe0, 0xc9, 0x57, 0xd0, 0x8e, 0x5d, 0xf4, 0x28, 0x9e, 0xfc, 0xa2, 0x87, 0xba, 0x49, 0xee, 0x2b,
+	0x7b, 0xd8, 0x36, 0x63, 0xab, 0x99, 0x39, 0x5d, 0x3e, 0x82, 0x8e, 0x0c, 0xdd, 0x3c, 0xbc, 0xc1,
+	0x82, 0x73, 0xdd, 0xc5, 0x1e, 0xab, 0x84, 0x14, 0x79, 0x4c, 0x48, 0xe9, 0xf8, 0xea, 0x9f, 0x17,
+	0xe0, 0xec, 0x7d, 0xd3, 0xe4, 0x6e, 0x2a, 0xa3, 0xfa, 0xda, 0x32, 0x01, 0xc9, 0x48, 0xb9, 0x98,
+	0x8e, 0x94, 0x5f, 0x95, 0xeb, 0xc8, 0x9d, 0x68, 0x52, 0xd0, 0xe6, 0xc1, 0x81, 0xcb, 0x6e, 0x40,
+	0xde, 0xe3, 0x95, 0x7f, 0x92, 0xb1, 0x6c, 0x2f, 0xe6, 0x0a, 0x20, 0x2b, 0x41, 0xda, 0x5f, 0x1d,
+	0x41, 0x3b, 0xbd, 0x59, 0x73, 0x1a, 0xb4, 0x60, 0x47, 0x46, 0x0e, 0x2b, 0x11, 0xd5, 0x35, 0xe0,
+	0x5d, 0x5b, 0x8e, 0xa7, 0xfe, 0x47, 0x01, 0xda, 0xe4, 0x22, 0xdc, 0xff, 0x9d, 0x03, 0xfa, 0x1e,
+	0x9c, 0xf1, 0x8c, 0x03, 0xac, 0x0b, 0x99, 0x3f, 0xdd, 0xc5, 0x2f, 0x79, 0x8c, 0xfd, 0x9e, 0x4c,
+	0x93, 0x48, 0x2f, 0x0a, 0x6a, 0xcb, 0x5e, 0xac, 0x5f, 0xc3, 0x2f, 0xd1, 0x3b, 0xb0, 0x24, 0xde,
+	0x44, 0xd5, 0x2d, 0x16, 0x19, 0xd4, 0xb5, 0x86, 0x70, 0xd1, 0xb4, 0x6b, 0xaa, 0x2f, 0xe1, 0xc2,
+	0x33, 0xdb, 0xc3, 0x7e, 0x37, 0xba, 0x2c, 0x39, 0x67, 0x8e, 0xec, 0x32, 0xd4, 0xa2, 0x8d, 0x4f,
+	0xe9, 0x68, 0xd3, 0x53, 0x1d, 0xe8, 0x6c, 0x1a, 0xee, 0x7e, 0x60, 0x10, 0x36, 0xd8, 0xa5, 0xb6,
+	0xd7, 0x48, 0x70, 0x37, 0xbc, 0xe3, 0xa9, 0xe1, 0x5d, 0xec, 0x62, 0xbb, 0x87, 0xc9, 0x63, 0x0b,
+	0xe1, 0xed, 0x83, 0xe8, 0xe0, 0x6e, 0xcc, 0xfa, 0x96, 0xe2, 0xe6, 0xe7, 0xe1, 0xbd, 0x6b, 0x52,
+	0x04, 0x41, 0x8b, 0x50, 0x7c, 0x8a, 0x0f, 0x5b, 0xa7, 0x10, 0x40, 0xf9, 0x29, 0xf1, 0x4a, 0x07,
+	0x2d, 0x05, 0xd5, 0x60, 0x91, 0x97, 0x99, 0x5b, 0x05, 0xd4, 0x80, 0xea, 0x83, 0xa0, 0x54, 0xd7,
+	0x2a, 0xde, 0xfc, 0x43, 0x05, 0x96, 0x53, 0x85, 0x50, 0xd4, 0x04, 0x78, 0x66, 0xf7, 0x78, 0x85,
+	0xb8, 0x75, 0x0a, 0xd5, 0xa1, 0x12, 0xd4, 0x8b, 0x19, 0xbe, 0x1d, 0x87, 0x42, 0xb7, 0x0a, 0xa8,
+	0x05, 0x75, 0x36, 0x70, 0xdc, 0xeb, 0x61, 0xcf, 0x6b, 0x15, 0xc3, 0x9e, 0x47, 0x86, 0x35, 0x18,
+	0xbb, 0xb8, 0x55, 0x22, 0x34, 0x77, 0x1c, 0xfe, 0xf2, 0xa4, 0xb5, 0x80, 0x10, 0x34, 0x79, 0x23,
+	0x18, 0x54, 0x16, 0xfa, 0x82, 0x61, 0x8b, 0x37, 0x9f, 0x8b, 0xe5, 0x2c, 0xba, 0xbc, 0xb3, 0x70,
+	0xfa, 0x99, 0x6d, 0xe2, 0x5d, 0xcb, 0xc6, 0x66, 0xf4, 0xa9, 0x75, 0x0a, 0x9d, 0x86, 0xa5, 0x4d,
+	0xec, 0xf6, 0xb1, 0xd0, 0x59, 0x40, 0xcb, 0xd0, 0xd8, 0xb4, 0x8e, 0x84, 0xae, 0xa2, 0x5a, 0xaa,
+	0x28, 0x2d, 0x65, 0xed, 0x1f, 0x2e, 0x41, 0x95, 0x64, 0x92, 0x1f, 0x38, 0x8e, 0x6b, 0xa2, 0x01,
+	0x20, 0xfa, 0x50, 0x6b, 0x38, 0x72, 0xec, 0xf0, 0xf9, 0x23, 0x5a, 0x8d, 0x73, 0x01, 0x6f, 0xa4,
+	0x01, 0x39, 0x0f, 0x75, 0xde, 0x96, 0xc2, 0x27, 0x80, 0xd5, 0x53, 0x68, 0x48, 0xa9, 0x91, 0x82,
+	0xd8, 0x8e, 0xd5, 0xdb, 0x0f, 0x2c, 0xe5, 0x07, 0x19, 0x76, 0x31, 0x0d, 0x1a, 0xd0, 0xbb, 0x26,
+	0xa5, 0xc7, 0x5e, 0xd2, 0x05, 0x5a, 0x53, 0x3d, 0x85, 0x5e, 0xc2, 0x99, 0xc7, 0x58, 0x70, 0x3a,
+	0x02, 0x82, 0x6b, 0xd9, 0x04, 0x53, 0xc0, 0x27, 0x24, 0xf9, 0x04, 0x16, 0x28, 0xbb, 0x21, 0x99,
+	0x5f, 0x22, 0xfe, 0x53, 0x41, 0xe7, 0x4a, 0x36, 0x40, 0x88, 0xed, 0x6b, 0x58, 0x4a, 0xbc, 0x6f,
+	0x46, 0x32, 0x2d, 0x25, 0x7f, 0xa9, 0xde, 0xb9, 0x99, 0x07, 0x34, 0xa4, 0xd5, 0x87, 0x66, 0xfc,
+	0x81, 0x17, 0x92, 0x95, 0x62, 0xa4, 0x4f, 0x53, 0x3b, 0xef, 0xe5, 0x80, 0x0c, 0x09, 0x0d, 0xa1,
+	0x95, 0x7c, 0x6f, 0x8b, 0x6e, 0x4e, 0x44, 0x10, 0x67, 0xb6, 0xf7, 0x73, 0xc1, 0x86, 0xe4, 0x8e,
+	0xe1, 0x8c, 0xec, 0x09, 0x27, 0x5a, 0x95, 0xa3, 0xc9, 0x7a, 0x5b, 0xda, 0xb9, 0x93, 0x1b, 0x3e,
+	0x24, 0xfd, 0xab, 0xec, 0x1e, 0x99, 0xec, 0x19, 0x24, 0xfa, 0x50, 0x8e, 0x6e, 0xc2, 0xfb, 0xcd,
+	0xce, 0xda, 0x49, 0x86, 0x84, 0x93, 0xf8, 0x21, 0xbd, 0x00, 0x26, 0x79, 0x48, 0x88, 0x3e, 0x90,
+	0xe3, 0xcb, 0x7e, 0x23, 0xd9, 0xf9, 0xf0, 0x04, 0x23, 0xc2, 0x09, 0x38, 0xc9, 0x07, 0xcd, 0x81,
+	0x18, 0xde, 0x99, 0xca, 0x35, 0xb3, 0xc9, 0xe0, 0xf7, 0x61, 0x29, 0x61, 0xb7, 0x51, 0x7e, 0xdb,
+	0xde, 0x99, 0xe4, 0x5c, 0x31, 0x91, 0x4c, 0xdc, 0xa7, 0x43, 0x19, 0xdc, 0x2f, 0xb9, 0x73, 0xd7,
+	0xb9, 0x99, 0x07, 0x34, 0x5c, 0x88, 0x47, 0xd5, 0x65, 0xe2, 0x96, 0x14, 0xba, 0x25, 0xc7, 0x21,
+	0xbf, 0x0d, 0xd6, 0xb9, 0x9d, 0x13, 0x3a, 0x24, 0x7a, 0x00, 0xa7, 0x25, 0x97, 0xd9, 0xd0, 0xed,
+	0x89, 0x87, 0x95, 0xbc, 0xc5, 0xd7, 0x59, 0xcd, 0x0b, 0x1e, 0xd2, 0xfd, 0x15, 0x40, 0xdb, 0x7b,
+	0x24, 0x72, 0xb4, 0x77, 0xad, 0xfe, 0xd8, 0x35, 0x58, 0x69, 0x33, 0xcb, 0x36, 0xa4, 0x41, 0x33,
+	0x78, 0x74, 0xe2, 0x88, 0x90, 0xb8, 0x0e, 0xf0, 0x18, 0xfb, 0x9b, 0xd8, 0x77, 0x89, 0x60, 0xbc,
+	0x93, 0x65, 0xfe, 0x38, 0x40, 0x40, 0xea, 0xdd, 0xa9, 0x70, 0x82, 0x29, 0x6a, 0x6d, 0x1a, 0x36,
+	0xa9, 0xb6, 0x44, 0xaf, 0x71, 0x6e, 0x49, 0x87, 0x27, 0xc1, 0x32, 0x0e, 0x32, 0x13, 0x3a, 0x24,
+	0x79, 0x18, 0x9a, 0x76, 0xa1, 0x76, 0x3e, 0xd9, 0xb4, 0xa7, 0x2f, 0x66, 0x75, 0xee, 0xe4, 0x86,
+	0x0f, 0x09, 0x7f, 0xa3, 0xc0, 0xf9, 0x34, 0xc0, 0x73, 0xcb, 0xdf, 0x23, 0xd7, 0x72, 0xbc, 0x3c,
+	0x53, 0xa0, 0x80, 0x27, 0x98, 0x02, 0x87, 0x0f, 0xa7, 0x60, 0x42, 0x23, 0x56, 0xd2, 0x46, 0xb2,
+	0xe7, 0x2b, 0xb2, 0xf2, 0x7e, 0xe7, 0xc6, 0x74, 0xc0, 0x90, 0xca, 0x1e, 0x34, 0x02, 0x51, 0x62,
+	0x9b, 0xfb, 0x5e, 0xd6, 0x4c, 0x23, 0x98, 0x0c, 0x4d, 0x20, 0x07, 0x15, 0x35, 0x41, 0xba, 0x62,
+	0x87, 0xf2, 0x55, 0x7a, 0x27, 0x69, 0x82, 0xec, 0x32, 0x20, 0x53, 0x75, 0x89, 0xea, 0xb8, 0x5c,
+	0x8f, 0x4a, 0x8b, 0xfd, 0x9d, 0x9b, 0x79, 0x40, 0x43, 0x5a, 0xcf, 0xa1, 0xcc, 0xff, 0x9e, 0xe7,
+	0xed, 0xc9, 0x59, 0x76, 0x8e, 0xfd, 0xfa, 0x14, 0x28, 0x11, 0xf1, 0xc3, 0xa3, 0x4c, 0xc4, 0x0f,
+	0x8f, 0xf2, 0x20, 0x4e, 0xe7, 0x98, 0xd9, 0xee, 0x24, 0x32, 0x6d, 0xd2, 0xdd, 0x91, 0x67, 0x10,
+	0x3b, 0x37, 0xf3, 0x80, 0x86, 0xb4, 0xf6, 0xe1, 0x6c, 0x46, 0x1e, 0x4d, 0xea, 0x47, 0x4c, 0xce,
+	0xb9, 0x4d, 0xb3, 0x70, 0x21, 0xb1, 0x54, 0xa2, 0x6c, 0x02, 0xb1, 0xac, 0xa4, 0xda, 0x34, 0x62,
+	0x06, 0xa0, 0xf4, 0xbf, 0x06, 0x48, 0x19, 0x3b, 0xf3, 0xcf, 0x05, 0x72, 0x90, 0x48, 0x3f, 0xfc,
+	0x97, 0x92, 0xc8, 0xfc, 0x7f, 0x80, 0x69, 0x24, 0x74, 0x58, 0x4e, 0x65, 0x52, 0xd0, 0xfb, 0x19,
+	0x3e, 0x87, 0x2c, 0xdf, 0x32, 0x8d, 0x40, 0x1f, 0xde, 0x92, 0x66, 0x0d, 0xa4, 0x3e, 0xd4, 0xa4,
+	0xfc, 0xc2, 0x34, 0x42, 0x3d, 0x38, 0x2d, 0xc9, 0x15, 0x48, 0xad, 0x7f, 0x76, 0x4e, 0x61, 0x1a,
+	0x91, 0x3d, 0xe8, 0xac, 0xbb, 0x8e, 0x61, 0xf6, 0x0c, 0xcf, 0xbf, 0x3f, 0xf0, 0xb1, 0x8b, 0xcd,
+	0xc8, 0x89, 0x4d, 0xee, 0x1b, 0x6f, 0x50, 0xb8, 0x08, 0x2a, 0x27, 0xa5, 0x17, 0x50, 0xa3, 0x2c,
+	0xc9, 0xfe, 0xc5, 0x06, 0xc9, 0x0d, 0xb6, 0x00, 0x91, 0x61, 0x05, 0x64, 0x80, 0x81, 0x70, 0xae,
+	0xfd, 0x18, 0xa0, 0x12, 0x3c, 0x84, 0xfa, 0x96, 0xe3, 0xe9, 0x37, 0x10, 0xe0, 0x7e, 0x1f, 0x96,
+	0x12, 0x7f, 0x4a, 0x20, 0x55, 0x7b, 0xf2, 0x3f, 0x2e, 0x98, 0x76, 0x5c, 0xcf, 0xf9, 0x5f, 0xe6,
+	0x85, 0x1a, 0xf5, 0xdd, 0xac, 0x20, 0x39, 0xa9, 0x4f, 0xa7, 0x20, 0xfe, 0xdf, 0xed, 0x5c, 0x3e,
+	0x05, 0x10, 0xdc, 0xca, 0xc9, 0xd7, 0x85, 0x89, 0xa7, 0x34, 0x6d, 0xb7, 0x86, 0x52, 0xcf, 0xf1,
+	0xbd, 0x3c, 0x37, 0x33, 0xb3, 0xad, 0x5b, 0xb6, 0xbf, 0xf8, 0x0c, 0xea, 0xe2, 0x45, 0x7e, 0x24,
+	0xfd, 0x83, 0xb6, 0xf4, 0x4d, 0xff, 0x69, 0xab, 0xd8, 0x3c, 0xa1, 0x4b, 0x31, 0x1d, 0xdd, 0x89,
+	0x1c, 0x89, 0x29, 0xe8, 0x3c, 0x40, 0xe9, 0x7a, 0x4c, 0x86, 0x55, 0xca, 0xa8, 0x02, 0x75, 0x6e,
+	0xe7, 0x84, 0x16, 0x53, 0x2f, 0xc9, 0x22, 0x83, 0x34, 0xf5, 0x92, 0x51, 0xb6, 0xe9, 0xbc, 0x9f,
+	0x0b, 0x36, 0x20, 0xb7, 0xfe, 0xd1, 0xf7, 0x3e, 0xec, 0x5b, 0xfe, 0xde, 0xf8, 0x05, 0x59, 0xfd,
+	0x1d, 0x36, 0xf4, 0xb6, 0xe5, 0xf0, 0x5f, 0x77, 0x02, 0xe9, 0xb9, 0x43, 0xb1, 0xdd, 0x21, 0xd8,
+	0x46, 0x2f, 0x5e, 0x94, 0x69, 0xeb, 0xa3, 0xff, 0x1e, 0x00, 0xcd, 0xd3, 0x15, 0xe0, 0x43, 0x54,
+	0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -5202,6 +5556,8 @@ type DataCoordClient interface {
 	SetSegmentState(ctx context.Context, in *SetSegmentStateRequest, opts ...grpc.CallOption) (*SetSegmentStateResponse, error)
 	// https://wiki.lfaidata.foundation/display/MIL/MEP+24+--+Support+bulk+load
 	Import(ctx context.Context, in *ImportTaskRequest, opts ...grpc.CallOption) (*ImportTaskResponse, error)
+	Export(ctx context.Context, in *ExportTaskRequest, opts ...grpc.CallOption) (*ExportTaskResponse, error)
+	RestoreSegments(ctx context.Context, in *RestoreSegmentsRequest, opts ...grpc.CallOption) (*RestoreSegmentsResponse, error)
 	UpdateSegmentStatistics(ctx context.Context, in *UpdateSegmentStatisticsRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
 	UpdateChannelCheckpoint(ctx context.Context, in *UpdateChannelCheckpointRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
 	AcquireSegmentLock(ctx context.Context, in *AcquireSegmentLockRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
@@ -5446,6 +5802,24 @@ func (c *dataCoordClient) Import(ctx context.Context, in *ImportTaskRequest, opt
 	return out, nil
 }
 
+func (c *dataCoordClient) Export(ctx context.Context, in *ExportTaskRequest, opts ...grpc.CallOption) (*ExportTaskResponse, error) {
+	out := new(ExportTaskResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/Export", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataCoordClient) RestoreSegments(ctx context.Context, in *RestoreSegmentsRequest, opts ...grpc.CallOption) (*RestoreSegmentsResponse, error) {
+	out := new(RestoreSegmentsResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/RestoreSegments", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *dataCoordClient) UpdateSegmentStatistics(ctx context.Context, in *UpdateSegmentStatisticsRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
 	out := new(commonpb.Status)
 	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/UpdateSegmentStatistics", in, out, opts...)
@@ -5556,6 +5930,8 @@ type DataCoordServer interface {
 	SetSegmentState(context.Context, *SetSegmentStateRequest) (*SetSegmentStateResponse, error)
 	// https://wiki.lfaidata.foundation/display/MIL/MEP+24+--+Support+bulk+load
 	Import(context.Context, *ImportTaskRequest) (*ImportTaskResponse, error)
+	Export(context.Context, *ExportTaskRequest) (*ExportTaskResponse, error)
+	RestoreSegments(context.Context, *RestoreSegmentsRequest) (*RestoreSegmentsResponse, error)
 	UpdateSegmentStatistics(context.Context, *UpdateSegmentStatisticsRequest) (*commonpb.Status, error)
 	UpdateChannelCheckpoint(context.Context, *UpdateChannelCheckpointRequest) (*commonpb.Status, error)
 	AcquireSegmentLock(context.Context, *AcquireSegmentLockRequest) (*commonpb.Status, error)
@@ -5646,6 +6022,12 @@ func (*UnimplementedDataCoordServer) SetSegmentState(ctx context.Context, req *S
 func (*UnimplementedDataCoordServer) Import(ctx context.Context, req *ImportTaskRequest) (*ImportTaskResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Import not implemented")
 }
+func (*UnimplementedDataCoordServer) Export(ctx context.Context, req *ExportTaskRequest) (*ExportTaskResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Export not implemented")
+}
+func (*UnimplementedDataCoordServer) RestoreSegments(ctx context.Context, req *RestoreSegmentsRequest) (*RestoreSegmentsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreSegments not implemented")
+}
 func (*UnimplementedDataCoordServer) UpdateSegmentStatistics(ctx context.Context, req *UpdateSegmentStatisticsRequest) (*commonpb.Status, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateSegmentStatistics not implemented")
 }
@@ -6128,6 +6510,42 @@ func _DataCoord_Import_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _DataCoord_Export_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportTaskRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataCoordServer).Export(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.data.DataCoord/Export",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataCoordServer).Export(ctx, req.(*ExportTaskRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DataCoord_RestoreSegments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreSegmentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataCoordServer).RestoreSegments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.data.DataCoord/RestoreSegments",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataCoordServer).RestoreSegments(ctx, req.(*RestoreSegmentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _DataCoord_UpdateSegmentStatistics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(UpdateSegmentStatisticsRequest)
 	if err := dec(in); err != nil {
@@ -6394,6 +6812,14 @@ var _DataCoord_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Import",
 			Handler:    _DataCoord_Import_Handler,
 		},
+		{
+			MethodName: "Export",
+			Handler:    _DataCoord_Export_Handler,
+		},
+		{
+			MethodName: "RestoreSegments",
+			Handler:    _DataCoord_RestoreSegments_Handler,
+		},
 		{
 			MethodName: "UpdateSegmentStatistics",
 			Handler:    _DataCoord_UpdateSegmentStatistics_Handler,
@@ -6451,6 +6877,7 @@ type DataNodeClient interface {
 	SyncSegments(ctx context.Context, in *SyncSegmentsRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
 	// https://wiki.lfaidata.foundation/display/MIL/MEP+24+--+Support+bulk+load
 	Import(ctx context.Context, in *ImportTaskRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	Export(ctx context.Context, in *ExportTaskRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
 	ResendSegmentStats(ctx context.Context, in *ResendSegmentStatsRequest, opts ...grpc.CallOption) (*ResendSegmentStatsResponse, error)
 	AddImportSegment(ctx context.Context, in *AddImportSegmentRequest, opts ...grpc.CallOption) (*AddImportSegmentResponse, error)
 }
@@ -6553,6 +6980,15 @@ func (c *dataNodeClient) Import(ctx context.Context, in *ImportTaskRequest, opts
 	return out, nil
 }
 
+func (c *dataNodeClient) Export(ctx context.Context, in *ExportTaskRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataNode/Export", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *dataNodeClient) ResendSegmentStats(ctx context.Context, in *ResendSegmentStatsRequest, opts ...grpc.CallOption) (*ResendSegmentStatsResponse, error) {
 	out := new(ResendSegmentStatsResponse)
 	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataNode/ResendSegmentStats", in, out, opts...)
@@ -6585,6 +7021,7 @@ type DataNodeServer interface {
 	SyncSegments(context.Context, *SyncSegmentsRequest) (*commonpb.Status, error)
 	// https://wiki.lfaidata.foundation/display/MIL/MEP+24+--+Support+bulk+load
 	Import(context.Context, *ImportTaskRequest) (*commonpb.Status, error)
+	Export(context.Context, *ExportTaskRequest) (*commonpb.Status, error)
 	ResendSegmentStats(context.Context, *ResendSegmentStatsRequest) (*ResendSegmentStatsResponse, error)
 	AddImportSegment(context.Context, *AddImportSegmentRequest) (*AddImportSegmentResponse, error)
 }
@@ -6623,6 +7060,9 @@ func (*UnimplementedDataNodeServer) SyncSegments(ctx context.Context, req *SyncS
 func (*UnimplementedDataNodeServer) Import(ctx context.Context, req *ImportTaskRequest) (*commonpb.Status, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Import not implemented")
 }
+func (*UnimplementedDataNodeServer) Export(ctx context.Context, req *ExportTaskRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Export not implemented")
+}
 func (*UnimplementedDataNodeServer) ResendSegmentStats(ctx context.Context, req *ResendSegmentStatsRequest) (*ResendSegmentStatsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ResendSegmentStats not implemented")
 }
@@ -6814,6 +7254,24 @@ func _DataNode_Import_Handler(srv interface{}, ctx context.Context, dec func(int
 	return interceptor(ctx, in, info, handler)
 }
 
+func _DataNode_Export_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportTaskRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataNodeServer).Export(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.data.DataNode/Export",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataNodeServer).Export(ctx, req.(*ExportTaskRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _DataNode_ResendSegmentStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ResendSegmentStatsRequest)
 	if err := dec(in); err != nil {
@@ -6894,6 +7352,10 @@ var _DataNode_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Import",
 			Handler:    _DataNode_Import_Handler,
 		},
+		{
+			MethodName: "Export",
+			Handler:    _DataNode_Export_Handler,
+		},
 		{
 			MethodName: "ResendSegmentStats",
 			Handler:    _DataNode_ResendSegmentStats_Handler,
@@ -116,6 +116,37 @@ func (ArithOpType) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_2d655ab2f7683c23, []int{1}
 }
 
+type ArrayContainsExpr_ArrayOp int32
+
+const (
+	ArrayContainsExpr_Invalid     ArrayContainsExpr_ArrayOp = 0
+	ArrayContainsExpr_Contains    ArrayContainsExpr_ArrayOp = 1
+	ArrayContainsExpr_ContainsAll ArrayContainsExpr_ArrayOp = 2
+	ArrayContainsExpr_ContainsAny ArrayContainsExpr_ArrayOp = 3
+)
+
+var ArrayContainsExpr_ArrayOp_name = map[int32]string{
+	0: "Invalid",
+	1: "Contains",
+	2: "ContainsAll",
+	3: "ContainsAny",
+}
+
+var ArrayContainsExpr_ArrayOp_value = map[string]int32{
+	"Invalid":     0,
+	"Contains":    1,
+	"ContainsAll": 2,
+	"ContainsAny": 3,
+}
+
+func (x ArrayContainsExpr_ArrayOp) String() string {
+	return proto.EnumName(ArrayContainsExpr_ArrayOp_name, int32(x))
+}
+
+func (ArrayContainsExpr_ArrayOp) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_2d655ab2f7683c23, []int{10, 0}
+}
+
 type UnaryExpr_UnaryOp int32
 
 const (
@@ -138,7 +169,7 @@ func (x UnaryExpr_UnaryOp) String() string {
 }
 
 func (UnaryExpr_UnaryOp) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{9, 0}
+	return fileDescriptor_2d655ab2f7683c23, []int{11, 0}
 }
 
 type BinaryExpr_BinaryOp int32
@@ -166,7 +197,7 @@ func (x BinaryExpr_BinaryOp) String() string {
 }
 
 func (BinaryExpr_BinaryOp) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{10, 0}
+	return fileDescriptor_2d655ab2f7683c23, []int{12, 0}
 }
 
 type GenericValue struct {
@@ -175,6 +206,7 @@ type GenericValue struct {
 	//	*GenericValue_Int64Val
 	//	*GenericValue_FloatVal
 	//	*GenericValue_StringVal
+	//	*GenericValue_ArrayVal
 	Val                  isGenericValue_Val `protobuf_oneof:"val"`
 	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
 	XXX_unrecognized     []byte             `json:"-"`
@@ -226,6 +258,10 @@ type GenericValue_StringVal struct {
 	StringVal string `protobuf:"bytes,4,opt,name=string_val,json=stringVal,proto3,oneof"`
 }
 
+type GenericValue_ArrayVal struct {
+	ArrayVal *Array `protobuf:"bytes,5,opt,name=array_val,json=arrayVal,proto3,oneof"`
+}
+
 func (*GenericValue_BoolVal) isGenericValue_Val() {}
 
 func (*GenericValue_Int64Val) isGenericValue_Val() {}
@@ -234,6 +270,8 @@ func (*GenericValue_FloatVal) isGenericValue_Val() {}
 
 func (*GenericValue_StringVal) isGenericValue_Val() {}
 
+func (*GenericValue_ArrayVal) isGenericValue_Val() {}
+
 func (m *GenericValue) GetVal() isGenericValue_Val {
 	if m != nil {
 		return m.Val
@@ -269,6 +307,13 @@ func (m *GenericValue) GetStringVal() string {
 	return ""
 }
 
+func (m *GenericValue) GetArrayVal() *Array {
+	if x, ok := m.GetVal().(*GenericValue_ArrayVal); ok {
+		return x.ArrayVal
+	}
+	return nil
+}
+
 // XXX_OneofWrappers is for the internal use of the proto package.
 func (*GenericValue) XXX_OneofWrappers() []interface{} {
 	return []interface{}{
@@ -276,14 +321,59 @@ func (*GenericValue) XXX_OneofWrappers() []interface{} {
 		(*GenericValue_Int64Val)(nil),
 		(*GenericValue_FloatVal)(nil),
 		(*GenericValue_StringVal)(nil),
+		(*GenericValue_ArrayVal)(nil),
+	}
+}
+
+type Array struct {
+	Array                []*GenericValue `protobuf:"bytes,1,rep,name=array,proto3" json:"array,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *Array) Reset()         { *m = Array{} }
+func (m *Array) String() string { return proto.CompactTextString(m) }
+func (*Array) ProtoMessage()    {}
+func (*Array) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2d655ab2f7683c23, []int{1}
+}
+
+func (m *Array) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Array.Unmarshal(m, b)
+}
+func (m *Array) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Array.Marshal(b, m, deterministic)
+}
+func (m *Array) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Array.Merge(m, src)
+}
+func (m *Array) XXX_Size() int {
+	return xxx_messageInfo_Array.Size(m)
+}
+func (m *Array) XXX_DiscardUnknown() {
+	xxx_messageInfo_Array.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Array proto.InternalMessageInfo
+
+func (m *Array) GetArray() []*GenericValue {
+	if m != nil {
+		return m.Array
 	}
+	return nil
 }
 
 type QueryInfo struct {
-	Topk                 int64    `protobuf:"varint,1,opt,name=topk,proto3" json:"topk,omitempty"`
-	MetricType           string   `protobuf:"bytes,3,opt,name=metric_type,json=metricType,proto3" json:"metric_type,omitempty"`
-	SearchParams         string   `protobuf:"bytes,4,opt,name=search_params,json=searchParams,proto3" json:"search_params,omitempty"`
-	RoundDecimal         int64    `protobuf:"varint,5,opt,name=round_decimal,json=roundDecimal,proto3" json:"round_decimal,omitempty"`
+	Topk         int64  `protobuf:"varint,1,opt,name=topk,proto3" json:"topk,omitempty"`
+	MetricType   string `protobuf:"bytes,3,opt,name=metric_type,json=metricType,proto3" json:"metric_type,omitempty"`
+	SearchParams string `protobuf:"bytes,4,opt,name=search_params,json=searchParams,proto3" json:"search_params,omitempty"`
+	RoundDecimal int64  `protobuf:"varint,5,opt,name=round_decimal,json=roundDecimal,proto3" json:"round_decimal,omitempty"`
+	// search results are grouped by this field if it's set,
+	// topk is the number of groups multiplied by group_size then.
+	GroupByFieldId int64 `protobuf:"varint,6,opt,name=group_by_field_id,json=groupByFieldId,proto3" json:"group_by_field_id,omitempty"`
+	// max number of hits kept in each group
+	GroupSize            int64    `protobuf:"varint,7,opt,name=group_size,json=groupSize,proto3" json:"group_size,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -293,7 +383,7 @@ func (m *QueryInfo) Reset()         { *m = QueryInfo{} }
 func (m *QueryInfo) String() string { return proto.CompactTextString(m) }
 func (*QueryInfo) ProtoMessage()    {}
 func (*QueryInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{1}
+	return fileDescriptor_2d655ab2f7683c23, []int{2}
 }
 
 func (m *QueryInfo) XXX_Unmarshal(b []byte) error {
@@ -342,11 +432,30 @@ func (m *QueryInfo) GetRoundDecimal() int64 {
 	return 0
 }
 
+func (m *QueryInfo) GetGroupByFieldId() int64 {
+	if m != nil {
+		return m.GroupByFieldId
+	}
+	return 0
+}
+
+func (m *QueryInfo) GetGroupSize() int64 {
+	if m != nil {
+		return m.GroupSize
+	}
+	return 0
+}
+
 type ColumnInfo struct {
-	FieldId              int64             `protobuf:"varint,1,opt,name=field_id,json=fieldId,proto3" json:"field_id,omitempty"`
-	DataType             schemapb.DataType `protobuf:"varint,2,opt,name=data_type,json=dataType,proto3,enum=milvus.proto.schema.DataType" json:"data_type,omitempty"`
-	IsPrimaryKey         bool              `protobuf:"varint,3,opt,name=is_primary_key,json=isPrimaryKey,proto3" json:"is_primary_key,omitempty"`
-	IsAutoID             bool              `protobuf:"varint,4,opt,name=is_autoID,json=isAutoID,proto3" json:"is_autoID,omitempty"`
+	FieldId      int64             `protobuf:"varint,1,opt,name=field_id,json=fieldId,proto3" json:"field_id,omitempty"`
+	DataType     schemapb.DataType `protobuf:"varint,2,opt,name=data_type,json=dataType,proto3,enum=milvus.proto.schema.DataType" json:"data_type,omitempty"`
+	IsPrimaryKey bool              `protobuf:"varint,3,opt,name=is_primary_key,json=isPrimaryKey,proto3" json:"is_primary_key,omitempty"`
+	IsAutoID     bool              `protobuf:"varint,4,opt,name=is_autoID,json=isAutoID,proto3" json:"is_autoID,omitempty"`
+	// nested_path is the path to the accessed value inside a json field,
+	// e.g. meta["brand"]["name"] is ["brand", "name"]
+	NestedPath []string `protobuf:"bytes,5,rep,name=nested_path,json=nestedPath,proto3" json:"nested_path,omitempty"`
+	// element_type is the type of the elements of an array field
+	ElementType          schemapb.DataType `protobuf:"varint,6,opt,name=element_type,json=elementType,proto3,enum=milvus.proto.schema.DataType" json:"element_type,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
 	XXX_unrecognized     []byte            `json:"-"`
 	XXX_sizecache        int32             `json:"-"`
@@ -356,7 +465,7 @@ func (m *ColumnInfo) Reset()         { *m = ColumnInfo{} }
 func (m *ColumnInfo) String() string { return proto.CompactTextString(m) }
 func (*ColumnInfo) ProtoMessage()    {}
 func (*ColumnInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{2}
+	return fileDescriptor_2d655ab2f7683c23, []int{3}
 }
 
 func (m *ColumnInfo) XXX_Unmarshal(b []byte) error {
@@ -405,6 +514,20 @@ func (m *ColumnInfo) GetIsAutoID() bool {
 	return false
 }
 
+func (m *ColumnInfo) GetNestedPath() []string {
+	if m != nil {
+		return m.NestedPath
+	}
+	return nil
+}
+
+func (m *ColumnInfo) GetElementType() schemapb.DataType {
+	if m != nil {
+		return m.ElementType
+	}
+	return schemapb.DataType_None
+}
+
 type ColumnExpr struct {
 	Info                 *ColumnInfo `protobuf:"bytes,1,opt,name=info,proto3" json:"info,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
@@ -416,7 +539,7 @@ func (m *ColumnExpr) Reset()         { *m = ColumnExpr{} }
 func (m *ColumnExpr) String() string { return proto.CompactTextString(m) }
 func (*ColumnExpr) ProtoMessage()    {}
 func (*ColumnExpr) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{3}
+	return fileDescriptor_2d655ab2f7683c23, []int{4}
 }
 
 func (m *ColumnExpr) XXX_Unmarshal(b []byte) error {
@@ -455,7 +578,7 @@ func (m *ValueExpr) Reset()         { *m = ValueExpr{} }
 func (m *ValueExpr) String() string { return proto.CompactTextString(m) }
 func (*ValueExpr) ProtoMessage()    {}
 func (*ValueExpr) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{4}
+	return fileDescriptor_2d655ab2f7683c23, []int{5}
 }
 
 func (m *ValueExpr) XXX_Unmarshal(b []byte) error {
@@ -496,7 +619,7 @@ func (m *UnaryRangeExpr) Reset()         { *m = UnaryRangeExpr{} }
 func (m *UnaryRangeExpr) String() string { return proto.CompactTextString(m) }
 func (*UnaryRangeExpr) ProtoMessage()    {}
 func (*UnaryRangeExpr) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{5}
+	return fileDescriptor_2d655ab2f7683c23, []int{6}
 }
 
 func (m *UnaryRangeExpr) XXX_Unmarshal(b []byte) error {
@@ -553,7 +676,7 @@ func (m *BinaryRangeExpr) Reset()         { *m = BinaryRangeExpr{} }
 func (m *BinaryRangeExpr) String() string { return proto.CompactTextString(m) }
 func (*BinaryRangeExpr) ProtoMessage()    {}
 func (*BinaryRangeExpr) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{6}
+	return fileDescriptor_2d655ab2f7683c23, []int{7}
 }
 
 func (m *BinaryRangeExpr) XXX_Unmarshal(b []byte) error {
@@ -622,7 +745,7 @@ func (m *CompareExpr) Reset()         { *m = CompareExpr{} }
 func (m *CompareExpr) String() string { return proto.CompactTextString(m) }
 func (*CompareExpr) ProtoMessage()    {}
 func (*CompareExpr) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{7}
+	return fileDescriptor_2d655ab2f7683c23, []int{8}
 }
 
 func (m *CompareExpr) XXX_Unmarshal(b []byte) error {
@@ -676,7 +799,7 @@ func (m *TermExpr) Reset()         { *m = TermExpr{} }
 func (m *TermExpr) String() string { return proto.CompactTextString(m) }
 func (*TermExpr) ProtoMessage()    {}
 func (*TermExpr) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{8}
+	return fileDescriptor_2d655ab2f7683c23, []int{9}
 }
 
 func (m *TermExpr) XXX_Unmarshal(b []byte) error {
@@ -711,6 +834,61 @@ func (m *TermExpr) GetValues() []*GenericValue {
 	return nil
 }
 
+type ArrayContainsExpr struct {
+	ColumnInfo           *ColumnInfo               `protobuf:"bytes,1,opt,name=column_info,json=columnInfo,proto3" json:"column_info,omitempty"`
+	Elements             []*GenericValue           `protobuf:"bytes,2,rep,name=elements,proto3" json:"elements,omitempty"`
+	Op                   ArrayContainsExpr_ArrayOp `protobuf:"varint,3,opt,name=op,proto3,enum=milvus.proto.plan.ArrayContainsExpr_ArrayOp" json:"op,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                  `json:"-"`
+	XXX_unrecognized     []byte                    `json:"-"`
+	XXX_sizecache        int32                     `json:"-"`
+}
+
+func (m *ArrayContainsExpr) Reset()         { *m = ArrayContainsExpr{} }
+func (m *ArrayContainsExpr) String() string { return proto.CompactTextString(m) }
+func (*ArrayContainsExpr) ProtoMessage()    {}
+func (*ArrayContainsExpr) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2d655ab2f7683c23, []int{10}
+}
+
+func (m *ArrayContainsExpr) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ArrayContainsExpr.Unmarshal(m, b)
+}
+func (m *ArrayContainsExpr) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ArrayContainsExpr.Marshal(b, m, deterministic)
+}
+func (m *ArrayContainsExpr) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ArrayContainsExpr.Merge(m, src)
+}
+func (m *ArrayContainsExpr) XXX_Size() int {
+	return xxx_messageInfo_ArrayContainsExpr.Size(m)
+}
+func (m *ArrayContainsExpr) XXX_DiscardUnknown() {
+	xxx_messageInfo_ArrayContainsExpr.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ArrayContainsExpr proto.InternalMessageInfo
+
+func (m *ArrayContainsExpr) GetColumnInfo() *ColumnInfo {
+	if m != nil {
+		return m.ColumnInfo
+	}
+	return nil
+}
+
+func (m *ArrayContainsExpr) GetElements() []*GenericValue {
+	if m != nil {
+		return m.Elements
+	}
+	return nil
+}
+
+func (m *ArrayContainsExpr) GetOp() ArrayContainsExpr_ArrayOp {
+	if m != nil {
+		return m.Op
+	}
+	return ArrayContainsExpr_Invalid
+}
+
 type UnaryExpr struct {
 	Op                   UnaryExpr_UnaryOp `protobuf:"varint,1,opt,name=op,proto3,enum=milvus.proto.plan.UnaryExpr_UnaryOp" json:"op,omitempty"`
 	Child                *Expr             `protobuf:"bytes,2,opt,name=child,proto3" json:"child,omitempty"`
@@ -723,7 +901,7 @@ func (m *UnaryExpr) Reset()         { *m = UnaryExpr{} }
 func (m *UnaryExpr) String() string { return proto.CompactTextString(m) }
 func (*UnaryExpr) ProtoMessage()    {}
 func (*UnaryExpr) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{9}
+	return fileDescriptor_2d655ab2f7683c23, []int{11}
 }
 
 func (m *UnaryExpr) XXX_Unmarshal(b []byte) error {
@@ -771,7 +949,7 @@ func (m *BinaryExpr) Reset()         { *m = BinaryExpr{} }
 func (m *BinaryExpr) String() string { return proto.CompactTextString(m) }
 func (*BinaryExpr) ProtoMessage()    {}
 func (*BinaryExpr) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{10}
+	return fileDescriptor_2d655ab2f7683c23, []int{12}
 }
 
 func (m *BinaryExpr) XXX_Unmarshal(b []byte) error {
@@ -826,7 +1004,7 @@ func (m *BinaryArithOp) Reset()         { *m = BinaryArithOp{} }
 func (m *BinaryArithOp) String() string { return proto.CompactTextString(m) }
 func (*BinaryArithOp) ProtoMessage()    {}
 func (*BinaryArithOp) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{11}
+	return fileDescriptor_2d655ab2f7683c23, []int{13}
 }
 
 func (m *BinaryArithOp) XXX_Unmarshal(b []byte) error {
@@ -881,7 +1059,7 @@ func (m *BinaryArithExpr) Reset()         { *m = BinaryArithExpr{} }
 func (m *BinaryArithExpr) String() string { return proto.CompactTextString(m) }
 func (*BinaryArithExpr) ProtoMessage()    {}
 func (*BinaryArithExpr) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{12}
+	return fileDescriptor_2d655ab2f7683c23, []int{14}
 }
 
 func (m *BinaryArithExpr) XXX_Unmarshal(b []byte) error {
@@ -938,7 +1116,7 @@ func (m *BinaryArithOpEvalRangeExpr) Reset()         { *m = BinaryArithOpEvalRan
 func (m *BinaryArithOpEvalRangeExpr) String() string { return proto.CompactTextString(m) }
 func (*BinaryArithOpEvalRangeExpr) ProtoMessage()    {}
 func (*BinaryArithOpEvalRangeExpr) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{13}
+	return fileDescriptor_2d655ab2f7683c23, []int{15}
 }
 
 func (m *BinaryArithOpEvalRangeExpr) XXX_Unmarshal(b []byte) error {
@@ -1006,6 +1184,7 @@ type Expr struct {
 	//	*Expr_BinaryArithExpr
 	//	*Expr_ValueExpr
 	//	*Expr_ColumnExpr
+	//	*Expr_ArrayContainsExpr
 	Expr                 isExpr_Expr `protobuf_oneof:"expr"`
 	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
 	XXX_unrecognized     []byte      `json:"-"`
@@ -1016,7 +1195,7 @@ func (m *Expr) Reset()         { *m = Expr{} }
 func (m *Expr) String() string { return proto.CompactTextString(m) }
 func (*Expr) ProtoMessage()    {}
 func (*Expr) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{14}
+	return fileDescriptor_2d655ab2f7683c23, []int{16}
 }
 
 func (m *Expr) XXX_Unmarshal(b []byte) error {
@@ -1081,6 +1260,10 @@ type Expr_ColumnExpr struct {
 	ColumnExpr *ColumnExpr `protobuf:"bytes,10,opt,name=column_expr,json=columnExpr,proto3,oneof"`
 }
 
+type Expr_ArrayContainsExpr struct {
+	ArrayContainsExpr *ArrayContainsExpr `protobuf:"bytes,11,opt,name=array_contains_expr,json=arrayContainsExpr,proto3,oneof"`
+}
+
 func (*Expr_TermExpr) isExpr_Expr() {}
 
 func (*Expr_UnaryExpr) isExpr_Expr() {}
@@ -1101,6 +1284,8 @@ func (*Expr_ValueExpr) isExpr_Expr() {}
 
 func (*Expr_ColumnExpr) isExpr_Expr() {}
 
+func (*Expr_ArrayContainsExpr) isExpr_Expr() {}
+
 func (m *Expr) GetExpr() isExpr_Expr {
 	if m != nil {
 		return m.Expr
@@ -1178,6 +1363,13 @@ func (m *Expr) GetColumnExpr() *ColumnExpr {
 	return nil
 }
 
+func (m *Expr) GetArrayContainsExpr() *ArrayContainsExpr {
+	if x, ok := m.GetExpr().(*Expr_ArrayContainsExpr); ok {
+		return x.ArrayContainsExpr
+	}
+	return nil
+}
+
 // XXX_OneofWrappers is for the internal use of the proto package.
 func (*Expr) XXX_OneofWrappers() []interface{} {
 	return []interface{}{
@@ -1191,6 +1383,7 @@ func (*Expr) XXX_OneofWrappers() []interface{} {
 		(*Expr_BinaryArithExpr)(nil),
 		(*Expr_ValueExpr)(nil),
 		(*Expr_ColumnExpr)(nil),
+		(*Expr_ArrayContainsExpr)(nil),
 	}
 }
 
@@ -1209,7 +1402,7 @@ func (m *VectorANNS) Reset()         { *m = VectorANNS{} }
 func (m *VectorANNS) String() string { return proto.CompactTextString(m) }
 func (*VectorANNS) ProtoMessage()    {}
 func (*VectorANNS) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{15}
+	return fileDescriptor_2d655ab2f7683c23, []int{17}
 }
 
 func (m *VectorANNS) XXX_Unmarshal(b []byte) error {
@@ -1280,7 +1473,7 @@ func (m *PlanNode) Reset()         { *m = PlanNode{} }
 func (m *PlanNode) String() string { return proto.CompactTextString(m) }
 func (*PlanNode) ProtoMessage()    {}
 func (*PlanNode) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{16}
+	return fileDescriptor_2d655ab2f7683c23, []int{18}
 }
 
 func (m *PlanNode) XXX_Unmarshal(b []byte) error {
@@ -1356,9 +1549,11 @@ func (*PlanNode) XXX_OneofWrappers() []interface{} {
 func init() {
 	proto.RegisterEnum("milvus.proto.plan.OpType", OpType_name, OpType_value)
 	proto.RegisterEnum("milvus.proto.plan.ArithOpType", ArithOpType_name, ArithOpType_value)
+	proto.RegisterEnum("milvus.proto.plan.ArrayContainsExpr_ArrayOp", ArrayContainsExpr_ArrayOp_name, ArrayContainsExpr_ArrayOp_value)
 	proto.RegisterEnum("milvus.proto.plan.UnaryExpr_UnaryOp", UnaryExpr_UnaryOp_name, UnaryExpr_UnaryOp_value)
 	proto.RegisterEnum("milvus.proto.plan.BinaryExpr_BinaryOp", BinaryExpr_BinaryOp_name, BinaryExpr_BinaryOp_value)
 	proto.RegisterType((*GenericValue)(nil), "milvus.proto.plan.GenericValue")
+	proto.RegisterType((*Array)(nil), "milvus.proto.plan.Array")
 	proto.RegisterType((*QueryInfo)(nil), "milvus.proto.plan.QueryInfo")
 	proto.RegisterType((*ColumnInfo)(nil), "milvus.proto.plan.ColumnInfo")
 	proto.RegisterType((*ColumnExpr)(nil), "milvus.proto.plan.ColumnExpr")
@@ -1367,6 +1562,7 @@ func init() {
 	proto.RegisterType((*BinaryRangeExpr)(nil), "milvus.proto.plan.BinaryRangeExpr")
 	proto.RegisterType((*CompareExpr)(nil), "milvus.proto.plan.CompareExpr")
 	proto.RegisterType((*TermExpr)(nil), "milvus.proto.plan.TermExpr")
+	proto.RegisterType((*ArrayContainsExpr)(nil), "milvus.proto.plan.ArrayContainsExpr")
 	proto.RegisterType((*UnaryExpr)(nil), "milvus.proto.plan.UnaryExpr")
 	proto.RegisterType((*BinaryExpr)(nil), "milvus.proto.plan.BinaryExpr")
 	proto.RegisterType((*BinaryArithOp)(nil), "milvus.proto.plan.BinaryArithOp")
@@ -1380,93 +1576,106 @@ func init() {
 func init() { proto.RegisterFile("plan.proto", fileDescriptor_2d655ab2f7683c23) }
 
 var fileDescriptor_2d655ab2f7683c23 = []byte{
-	// 1407 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x57, 0xcd, 0x72, 0xdc, 0x44,
-	0x10, 0x5e, 0xad, 0x76, 0xbd, 0x52, 0xef, 0x7a, 0xad, 0xe8, 0x42, 0x7e, 0x48, 0x6c, 0x44, 0x8a,
-	0x98, 0x50, 0xb1, 0x2b, 0x24, 0x24, 0x95, 0x50, 0x01, 0xff, 0x05, 0x7b, 0x8b, 0xc4, 0x36, 0x8a,
-	0xe3, 0x03, 0x17, 0xd5, 0xac, 0x34, 0xf6, 0x4e, 0x45, 0xab, 0x51, 0x46, 0xa3, 0x4d, 0xf6, 0xcc,
-	0x8d, 0x1b, 0x0f, 0xc0, 0x15, 0xee, 0xdc, 0xe0, 0xc2, 0x0b, 0x70, 0xe0, 0xc8, 0x9d, 0xb7, 0xe0,
-	0x44, 0x4d, 0x8f, 0xf6, 0x2f, 0xb5, 0x1b, 0xaf, 0x8b, 0x54, 0x71, 0x1b, 0xf5, 0x74, 0x7f, 0xd3,
-	0xfd, 0x4d, 0x77, 0x4f, 0x0b, 0x20, 0x8d, 0x49, 0xb2, 0x96, 0x0a, 0x2e, 0xb9, 0x7b, 0xa1, 0xcb,
-	0xe2, 0x5e, 0x9e, 0xe9, 0xaf, 0x35, 0xb5, 0x71, 0xb9, 0x91, 0x85, 0x1d, 0xda, 0x25, 0x5a, 0xe4,
-	0xfd, 0x60, 0x40, 0x63, 0x97, 0x26, 0x54, 0xb0, 0xf0, 0x98, 0xc4, 0x39, 0x75, 0xaf, 0x80, 0xd5,
-	0xe6, 0x3c, 0x0e, 0x7a, 0x24, 0xbe, 0x68, 0xac, 0x18, 0xab, 0xd6, 0x5e, 0xc9, 0xaf, 0x29, 0xc9,
-	0x31, 0x89, 0xdd, 0xab, 0x60, 0xb3, 0x44, 0xde, 0xbb, 0x8b, 0xbb, 0xe5, 0x15, 0x63, 0xd5, 0xdc,
-	0x2b, 0xf9, 0x16, 0x8a, 0x8a, 0xed, 0x93, 0x98, 0x13, 0x89, 0xdb, 0xe6, 0x8a, 0xb1, 0x6a, 0xa8,
-	0x6d, 0x14, 0xa9, 0xed, 0x65, 0x80, 0x4c, 0x0a, 0x96, 0x9c, 0xe2, 0x7e, 0x65, 0xc5, 0x58, 0xb5,
-	0xf7, 0x4a, 0xbe, 0xad, 0x65, 0xc7, 0x24, 0xde, 0xaa, 0x82, 0xd9, 0x23, 0xb1, 0xf7, 0xbd, 0x01,
-	0xf6, 0x37, 0x39, 0x15, 0xfd, 0x56, 0x72, 0xc2, 0x5d, 0x17, 0x2a, 0x92, 0xa7, 0x2f, 0xd0, 0x19,
-	0xd3, 0xc7, 0xb5, 0xbb, 0x0c, 0xf5, 0x2e, 0x95, 0x82, 0x85, 0x81, 0xec, 0xa7, 0x14, 0x8f, 0xb2,
-	0x7d, 0xd0, 0xa2, 0xa3, 0x7e, 0x4a, 0xdd, 0x0f, 0x61, 0x31, 0xa3, 0x44, 0x84, 0x9d, 0x20, 0x25,
-	0x82, 0x74, 0x33, 0x7d, 0x9a, 0xdf, 0xd0, 0xc2, 0x43, 0x94, 0x29, 0x25, 0xc1, 0xf3, 0x24, 0x0a,
-	0x22, 0x1a, 0xb2, 0x2e, 0x89, 0x2f, 0x56, 0xf1, 0x88, 0x06, 0x0a, 0x77, 0xb4, 0xcc, 0xfb, 0xc9,
-	0x00, 0xd8, 0xe6, 0x71, 0xde, 0x4d, 0xd0, 0x9b, 0x4b, 0x60, 0x9d, 0x30, 0x1a, 0x47, 0x01, 0x8b,
-	0x0a, 0x8f, 0x6a, 0xf8, 0xdd, 0x8a, 0xdc, 0x87, 0x60, 0x47, 0x44, 0x12, 0xed, 0x92, 0x22, 0xa7,
-	0xf9, 0xe9, 0xd5, 0xb5, 0x09, 0xfe, 0x0b, 0xe6, 0x77, 0x88, 0x24, 0xca, 0x4b, 0xdf, 0x8a, 0x8a,
-	0x95, 0x7b, 0x1d, 0x9a, 0x2c, 0x0b, 0x52, 0xc1, 0xba, 0x44, 0xf4, 0x83, 0x17, 0xb4, 0x8f, 0x31,
-	0x59, 0x7e, 0x83, 0x65, 0x87, 0x5a, 0xf8, 0x35, 0xed, 0xbb, 0x57, 0xc0, 0x66, 0x59, 0x40, 0x72,
-	0xc9, 0x5b, 0x3b, 0x18, 0x91, 0xe5, 0x5b, 0x2c, 0xdb, 0xc4, 0x6f, 0xef, 0xcb, 0x81, 0x9f, 0x8f,
-	0x5f, 0xa7, 0xc2, 0xbd, 0x0d, 0x15, 0x96, 0x9c, 0x70, 0xf4, 0xb1, 0xfe, 0xa6, 0x1f, 0x98, 0x20,
-	0xa3, 0xa0, 0x7c, 0x54, 0xf5, 0xb6, 0xc0, 0xc6, 0x14, 0x40, 0xfb, 0xcf, 0xa0, 0xda, 0x53, 0x1f,
-	0x05, 0xc0, 0xf2, 0x14, 0x80, 0xf1, 0xb4, 0xf1, 0xb5, 0xb6, 0xf7, 0x8b, 0x01, 0xcd, 0xe7, 0x09,
-	0x11, 0x7d, 0x9f, 0x24, 0xa7, 0x1a, 0xe9, 0x0b, 0xa8, 0x87, 0x78, 0x54, 0x30, 0xbf, 0x43, 0x10,
-	0x8e, 0x18, 0xff, 0x18, 0xca, 0x3c, 0x2d, 0xf8, 0xbc, 0x34, 0xc5, 0xec, 0x20, 0x45, 0x2e, 0xcb,
-	0x3c, 0x1d, 0x39, 0x6d, 0x9e, 0xcb, 0xe9, 0x9f, 0xcb, 0xb0, 0xb4, 0xc5, 0xde, 0xad, 0xd7, 0x37,
-	0x60, 0x29, 0xe6, 0xaf, 0xa8, 0x08, 0x58, 0x12, 0xc6, 0x79, 0xc6, 0x7a, 0x3a, 0x25, 0x2c, 0xbf,
-	0x89, 0xe2, 0xd6, 0x40, 0xaa, 0x14, 0xf3, 0x34, 0x9d, 0x50, 0xd4, 0x57, 0xdf, 0x44, 0xf1, 0x48,
-	0x71, 0x03, 0xea, 0x1a, 0x51, 0x87, 0x58, 0x99, 0x2f, 0x44, 0x40, 0x1b, 0x5d, 0xda, 0x1b, 0x50,
-	0xd7, 0x47, 0x69, 0x84, 0xea, 0x9c, 0x08, 0x68, 0x83, 0x6b, 0xef, 0x0f, 0x03, 0xea, 0xdb, 0xbc,
-	0x9b, 0x12, 0xa1, 0x59, 0xda, 0x05, 0x27, 0xa6, 0x27, 0x32, 0x38, 0x37, 0x55, 0x4d, 0x65, 0x36,
-	0x56, 0x56, 0x2d, 0xb8, 0x20, 0xd8, 0x69, 0x67, 0x12, 0xa9, 0x3c, 0x0f, 0xd2, 0x12, 0xda, 0x6d,
-	0xbf, 0x99, 0x2f, 0xe6, 0x1c, 0xf9, 0xe2, 0x7d, 0x67, 0x80, 0x75, 0x44, 0x45, 0xf7, 0x9d, 0xdc,
-	0xf8, 0x7d, 0x58, 0x40, 0x5e, 0xb3, 0x8b, 0xe5, 0x15, 0x73, 0x1e, 0x62, 0x0b, 0x75, 0xd5, 0x82,
-	0x6d, 0xac, 0x19, 0x74, 0xe3, 0x2e, 0xba, 0x6f, 0xa0, 0xfb, 0xd7, 0xa7, 0x40, 0x0c, 0x35, 0xf5,
-	0xea, 0x20, 0xc5, 0xcc, 0xbf, 0x05, 0xd5, 0xb0, 0xc3, 0xe2, 0xa8, 0xe0, 0xec, 0xbd, 0x29, 0x86,
-	0xca, 0xc6, 0xd7, 0x5a, 0xde, 0x32, 0xd4, 0x0a, 0x6b, 0xb7, 0x0e, 0xb5, 0x56, 0xd2, 0x23, 0x31,
-	0x8b, 0x9c, 0x92, 0x5b, 0x03, 0x73, 0x9f, 0x4b, 0xc7, 0xf0, 0xfe, 0x32, 0x00, 0x74, 0x49, 0xa0,
-	0x53, 0xf7, 0xc6, 0x9c, 0xfa, 0x68, 0x0a, 0xf6, 0x48, 0xb5, 0x58, 0x16, 0x6e, 0x7d, 0x02, 0x15,
-	0x75, 0xd1, 0x67, 0x79, 0x85, 0x4a, 0x2a, 0x06, 0xbc, 0xcb, 0xa2, 0x7a, 0x67, 0xc7, 0x80, 0x5a,
-	0xde, 0x3d, 0xb0, 0x06, 0x67, 0x4d, 0x06, 0xd1, 0x04, 0x78, 0xc2, 0x4f, 0x59, 0x48, 0xe2, 0xcd,
-	0x24, 0x72, 0x0c, 0x77, 0x11, 0xec, 0xe2, 0xfb, 0x40, 0x38, 0x65, 0xef, 0x4f, 0x03, 0x16, 0xb5,
-	0xe1, 0xa6, 0x60, 0xb2, 0x73, 0x90, 0xfe, 0xe7, 0x9b, 0x7f, 0x00, 0x16, 0x51, 0x50, 0xc1, 0xb0,
-	0x4f, 0x5d, 0x9b, 0x62, 0x5c, 0x9c, 0x86, 0xc9, 0x57, 0x23, 0xc5, 0xd1, 0x3b, 0xb0, 0xa8, 0xf3,
-	0x9e, 0xa7, 0x54, 0x90, 0x24, 0x9a, 0xb7, 0x73, 0x35, 0xd0, 0xea, 0x40, 0x1b, 0x79, 0x3f, 0x1a,
-	0x83, 0x06, 0x86, 0x87, 0xe0, 0x95, 0x0d, 0xa8, 0x37, 0xce, 0x45, 0x7d, 0x79, 0x1e, 0xea, 0xdd,
-	0xb5, 0xb1, 0x12, 0x3b, 0x2b, 0x54, 0x55, 0x67, 0xbf, 0x97, 0xe1, 0xf2, 0x04, 0xe5, 0x8f, 0x7b,
-	0x24, 0x7e, 0x77, 0xbd, 0xf6, 0xff, 0xe6, 0xbf, 0x68, 0x39, 0x95, 0x73, 0x3d, 0x51, 0xd5, 0x73,
-	0x3d, 0x51, 0xff, 0x54, 0xa1, 0x82, 0x5c, 0x3d, 0x04, 0x5b, 0x52, 0xd1, 0x0d, 0xe8, 0xeb, 0x54,
-	0x14, 0x4c, 0x5d, 0x99, 0x82, 0x31, 0xe8, 0x6a, 0x6a, 0xfe, 0x92, 0x83, 0x0e, 0xf7, 0x08, 0x20,
-	0x57, 0x97, 0xa0, 0x8d, 0xf5, 0x55, 0xbf, 0xff, 0xb6, 0x16, 0xa3, 0xa6, 0xb3, 0x7c, 0xd8, 0x04,
-	0x36, 0xa0, 0xde, 0x66, 0x23, 0x7b, 0x73, 0xe6, 0x35, 0x8d, 0xba, 0xc1, 0x5e, 0xc9, 0x87, 0xf6,
-	0xa8, 0x8d, 0x6c, 0x43, 0x23, 0xd4, 0xaf, 0x87, 0x86, 0xd0, 0x6f, 0xd8, 0xb5, 0xa9, 0x37, 0x3d,
-	0x7c, 0x64, 0xf6, 0x4a, 0x7e, 0x3d, 0x1c, 0x7b, 0x73, 0x9e, 0x82, 0xa3, 0xa3, 0x10, 0x2a, 0x81,
-	0x34, 0x90, 0x26, 0xf3, 0x83, 0x59, 0xb1, 0x0c, 0x53, 0x6d, 0xaf, 0xe4, 0x37, 0xf3, 0xc9, 0x87,
-	0xfe, 0x10, 0x2e, 0x14, 0x51, 0x8d, 0xe1, 0x2d, 0x20, 0x9e, 0x37, 0x33, 0xb6, 0x71, 0xc0, 0xa5,
-	0xf6, 0x1b, 0xa3, 0x83, 0x84, 0xe5, 0x02, 0x71, 0x90, 0x95, 0x01, 0xed, 0x91, 0x78, 0x1c, 0xbf,
-	0x86, 0xf8, 0xb7, 0x66, 0xe2, 0x4f, 0x2b, 0x93, 0xbd, 0x92, 0x7f, 0xb9, 0x3d, 0xbb, 0x88, 0x46,
-	0x71, 0xe8, 0x53, 0xf1, 0x1c, 0xeb, 0x8c, 0x38, 0x86, 0xed, 0x62, 0x14, 0xc7, 0xa8, 0x83, 0x3c,
-	0x02, 0xc0, 0xe4, 0xd3, 0x50, 0xf6, 0xcc, 0x74, 0x19, 0x0e, 0x8d, 0x2a, 0x5d, 0x7a, 0xc3, 0x09,
-	0x72, 0x63, 0x58, 0xd5, 0x68, 0x0f, 0x67, 0x54, 0xf5, 0x20, 0x5d, 0xc2, 0xe1, 0xd7, 0xd6, 0x02,
-	0x54, 0x94, 0xa9, 0xf7, 0xb7, 0x01, 0x70, 0x4c, 0x43, 0xc9, 0xc5, 0xe6, 0xfe, 0xfe, 0xb3, 0x62,
-	0x0a, 0xd6, 0xde, 0xea, 0x5f, 0x14, 0x35, 0x05, 0xeb, 0x80, 0x26, 0xe6, 0xf3, 0xf2, 0xe4, 0x7c,
-	0x7e, 0x1f, 0x20, 0x15, 0x34, 0x62, 0x21, 0x91, 0x34, 0x3b, 0xeb, 0x91, 0x19, 0x53, 0x75, 0x3f,
-	0x07, 0x78, 0xa9, 0x7e, 0x47, 0x74, 0x7b, 0xaa, 0xcc, 0x24, 0x62, 0xf8, 0xcf, 0xe2, 0xdb, 0x2f,
-	0x87, 0xbf, 0x2f, 0x37, 0x60, 0x29, 0x8d, 0x49, 0x48, 0x3b, 0x3c, 0x8e, 0xa8, 0x08, 0x24, 0x39,
-	0xc5, 0x6c, 0xb5, 0xfd, 0xe6, 0x98, 0xf8, 0x88, 0x9c, 0x7a, 0xbf, 0x1a, 0x60, 0x1d, 0xc6, 0x24,
-	0xd9, 0xe7, 0x11, 0x8e, 0x6a, 0x3d, 0x8c, 0x38, 0x20, 0x49, 0x92, 0xbd, 0xa5, 0x25, 0x8e, 0x78,
-	0x51, 0xe4, 0x69, 0x9b, 0xcd, 0x24, 0xc9, 0xdc, 0x07, 0x13, 0xd1, 0xbe, 0xbd, 0xaf, 0x2b, 0xd3,
-	0xb1, 0x78, 0x57, 0xc1, 0xe1, 0xb9, 0x4c, 0x73, 0x19, 0x0c, 0xa8, 0x54, 0x74, 0x99, 0xab, 0xa6,
-	0xdf, 0xd4, 0xf2, 0xaf, 0x34, 0xa3, 0x99, 0xba, 0xa1, 0x84, 0x47, 0xf4, 0xe6, 0x6f, 0x06, 0x2c,
-	0xe8, 0x26, 0x37, 0xf9, 0x14, 0x2f, 0x41, 0x7d, 0x57, 0x50, 0x22, 0xa9, 0x38, 0xea, 0x90, 0xc4,
-	0x31, 0x5c, 0x07, 0x1a, 0x85, 0xe0, 0xf1, 0xcb, 0x9c, 0xc4, 0x4e, 0xd9, 0x6d, 0x80, 0xf5, 0x84,
-	0x66, 0x19, 0xee, 0x9b, 0xf8, 0x56, 0xd3, 0x2c, 0xd3, 0x9b, 0x15, 0xd7, 0x86, 0xaa, 0x5e, 0x56,
-	0x95, 0xde, 0x3e, 0x97, 0xfa, 0x6b, 0x41, 0x01, 0x1f, 0x0a, 0x7a, 0xc2, 0x5e, 0x3f, 0x25, 0x32,
-	0xec, 0x38, 0x35, 0x05, 0x7c, 0xc8, 0x33, 0x39, 0x94, 0x58, 0xca, 0x56, 0x2f, 0x6d, 0xb5, 0xc4,
-	0x42, 0x71, 0xc0, 0x5d, 0x80, 0x72, 0x2b, 0x71, 0xea, 0x4a, 0xb4, 0xcf, 0x65, 0x2b, 0x71, 0x1a,
-	0x37, 0x77, 0xa1, 0x3e, 0xf6, 0x36, 0xa8, 0x00, 0x9e, 0x27, 0x2f, 0x12, 0xfe, 0x2a, 0xd1, 0x03,
-	0xd1, 0x66, 0xa4, 0x86, 0x88, 0x1a, 0x98, 0xcf, 0xf2, 0xb6, 0x53, 0x56, 0x8b, 0xa7, 0x79, 0xec,
-	0x98, 0x6a, 0xb1, 0xc3, 0x7a, 0x4e, 0x05, 0x25, 0x3c, 0x72, 0xaa, 0x5b, 0x77, 0xbe, 0xbd, 0x7d,
-	0xca, 0x64, 0x27, 0x6f, 0xaf, 0x85, 0xbc, 0xbb, 0xae, 0xa9, 0xbe, 0xc5, 0x78, 0xb1, 0x5a, 0x67,
-	0x89, 0xa4, 0x22, 0x21, 0xf1, 0x3a, 0xb2, 0xbf, 0xae, 0xd8, 0x4f, 0xdb, 0xed, 0x05, 0xfc, 0xba,
-	0xf3, 0x6f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x32, 0x23, 0x5b, 0x87, 0xb6, 0x0f, 0x00, 0x00,
+	// 1613 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x57, 0xcd, 0x93, 0x1b, 0x47,
+	0x15, 0xd7, 0x68, 0xf4, 0x31, 0xf3, 0x24, 0x6b, 0x67, 0x9b, 0x03, 0x8a, 0x8d, 0xb3, 0xcb, 0xe0,
+	0x82, 0x4d, 0xc0, 0xeb, 0x8a, 0x93, 0xd8, 0x95, 0x04, 0x42, 0xf6, 0xc3, 0xf1, 0xaa, 0x88, 0x77,
+	0x97, 0xb1, 0xb3, 0x07, 0x2e, 0x53, 0xad, 0x99, 0x5e, 0xa9, 0xcb, 0xa3, 0x9e, 0x71, 0x4f, 0x8f,
+	0x62, 0xe5, 0xca, 0x89, 0x23, 0x17, 0x6e, 0x9c, 0xb9, 0x73, 0x83, 0x0b, 0x77, 0x8a, 0x03, 0x45,
+	0x71, 0xe0, 0xce, 0x3f, 0x42, 0xf5, 0xeb, 0xd1, 0x97, 0x91, 0xbc, 0xda, 0x62, 0xab, 0xb8, 0xf5,
+	0x7b, 0xfd, 0xde, 0xaf, 0xdf, 0xfb, 0xf5, 0xeb, 0xd7, 0xdd, 0x00, 0x59, 0x42, 0xc5, 0x7e, 0x26,
+	0x53, 0x95, 0x92, 0xed, 0x11, 0x4f, 0xc6, 0x45, 0x6e, 0xa4, 0x7d, 0x3d, 0x71, 0xbb, 0x9d, 0x47,
+	0x43, 0x36, 0xa2, 0x46, 0xe5, 0xff, 0xd5, 0x82, 0xf6, 0x53, 0x26, 0x98, 0xe4, 0xd1, 0x05, 0x4d,
+	0x0a, 0x46, 0xee, 0x80, 0xd3, 0x4f, 0xd3, 0x24, 0x1c, 0xd3, 0xa4, 0x6b, 0xed, 0x5a, 0x7b, 0xce,
+	0x49, 0x25, 0x68, 0x6a, 0xcd, 0x05, 0x4d, 0xc8, 0x5d, 0x70, 0xb9, 0x50, 0x8f, 0x3e, 0xc2, 0xd9,
+	0xea, 0xae, 0xb5, 0x67, 0x9f, 0x54, 0x02, 0x07, 0x55, 0xe5, 0xf4, 0x65, 0x92, 0x52, 0x85, 0xd3,
+	0xf6, 0xae, 0xb5, 0x67, 0xe9, 0x69, 0x54, 0xe9, 0xe9, 0x1d, 0x80, 0x5c, 0x49, 0x2e, 0x06, 0x38,
+	0x5f, 0xdb, 0xb5, 0xf6, 0xdc, 0x93, 0x4a, 0xe0, 0x1a, 0x9d, 0x36, 0x78, 0x0c, 0x2e, 0x95, 0x92,
+	0x4e, 0x70, 0xbe, 0xbe, 0x6b, 0xed, 0xb5, 0x1e, 0x76, 0xf7, 0xff, 0x2b, 0x83, 0xfd, 0x03, 0x6d,
+	0xa3, 0x91, 0xd1, 0xf8, 0x82, 0x26, 0x87, 0x75, 0xb0, 0xc7, 0x34, 0xf1, 0x3f, 0x87, 0x3a, 0xce,
+	0x91, 0x8f, 0xa1, 0x8e, 0x73, 0x5d, 0x6b, 0xd7, 0xde, 0x6b, 0x3d, 0xdc, 0x59, 0x01, 0xb2, 0x98,
+	0x74, 0x60, 0xac, 0xfd, 0x7f, 0x5a, 0xe0, 0xfe, 0xb2, 0x60, 0x72, 0xd2, 0x13, 0x97, 0x29, 0x21,
+	0x50, 0x53, 0x69, 0xf6, 0x12, 0x59, 0xb0, 0x03, 0x1c, 0x93, 0x1d, 0x68, 0x8d, 0x98, 0x92, 0x3c,
+	0x0a, 0xd5, 0x24, 0x63, 0x98, 0xa3, 0x1b, 0x80, 0x51, 0xbd, 0x98, 0x64, 0x8c, 0xfc, 0x00, 0x6e,
+	0xe5, 0x8c, 0xca, 0x68, 0x18, 0x66, 0x54, 0xd2, 0x51, 0x6e, 0xd2, 0x0c, 0xda, 0x46, 0x79, 0x8e,
+	0x3a, 0x6d, 0x24, 0xd3, 0x42, 0xc4, 0x61, 0xcc, 0x22, 0x3e, 0x2a, 0x73, 0xb5, 0x83, 0x36, 0x2a,
+	0x8f, 0x8d, 0x8e, 0xbc, 0x07, 0xdb, 0x03, 0x99, 0x16, 0x59, 0xd8, 0x9f, 0x84, 0x97, 0x9c, 0x25,
+	0x71, 0xc8, 0xe3, 0x6e, 0x03, 0x0d, 0x3b, 0x38, 0x71, 0x38, 0xf9, 0x52, 0xab, 0x7b, 0x31, 0xb9,
+	0x0b, 0x60, 0x4c, 0x73, 0xfe, 0x2d, 0xeb, 0x36, 0xd1, 0xc6, 0x45, 0xcd, 0x73, 0xfe, 0x2d, 0xf3,
+	0x7f, 0x53, 0x05, 0x38, 0x4a, 0x93, 0x62, 0x24, 0x30, 0xaf, 0x77, 0xc0, 0x99, 0xe1, 0x99, 0xdc,
+	0x9a, 0x97, 0x25, 0xd0, 0xa7, 0xe0, 0xc6, 0x54, 0x51, 0x93, 0x9c, 0xde, 0xdf, 0xce, 0xc3, 0xbb,
+	0xcb, 0xdc, 0x95, 0xc5, 0x73, 0x4c, 0x15, 0xd5, 0xf9, 0x06, 0x4e, 0x5c, 0x8e, 0xc8, 0x3d, 0xe8,
+	0xf0, 0x3c, 0xcc, 0x24, 0x1f, 0x51, 0x39, 0x09, 0x5f, 0xb2, 0x09, 0xb2, 0xe3, 0x04, 0x6d, 0x9e,
+	0x9f, 0x1b, 0xe5, 0x2f, 0xd8, 0x84, 0xdc, 0x01, 0x97, 0xe7, 0x21, 0x2d, 0x54, 0xda, 0x3b, 0x46,
+	0x6e, 0x9c, 0xc0, 0xe1, 0xf9, 0x01, 0xca, 0x9a, 0x5d, 0xc1, 0x72, 0xc5, 0xe2, 0x30, 0xa3, 0x6a,
+	0xd8, 0xad, 0xef, 0xda, 0x9a, 0x5d, 0xa3, 0x3a, 0xa7, 0x6a, 0x48, 0xbe, 0x80, 0x36, 0x4b, 0xd8,
+	0x88, 0x09, 0x65, 0x42, 0x6c, 0x6c, 0x12, 0x62, 0xab, 0x74, 0xd1, 0x82, 0xff, 0xf3, 0x29, 0x15,
+	0x4f, 0x5e, 0x67, 0x92, 0x7c, 0x00, 0x35, 0x2e, 0x2e, 0x53, 0xa4, 0xa1, 0xf5, 0xf0, 0xee, 0x8a,
+	0x32, 0x99, 0xf3, 0x16, 0xa0, 0xa9, 0x7f, 0x08, 0x2e, 0xd6, 0x0c, 0xfa, 0x7f, 0x0c, 0xf5, 0xb1,
+	0x16, 0x4a, 0x80, 0xab, 0xeb, 0x0c, 0xad, 0xfd, 0x3f, 0x5a, 0xd0, 0xf9, 0x5a, 0x50, 0x39, 0x09,
+	0xa8, 0x18, 0x18, 0xa4, 0xcf, 0xa1, 0x15, 0xe1, 0x52, 0xe1, 0xe6, 0x01, 0x41, 0x34, 0xdf, 0xd4,
+	0xf7, 0xa0, 0x9a, 0x66, 0xe5, 0x96, 0xbd, 0xb3, 0xc2, 0xed, 0x2c, 0x43, 0x2e, 0xaa, 0x69, 0x36,
+	0x0f, 0xda, 0xbe, 0x56, 0xd0, 0x7f, 0xa8, 0xc2, 0xd6, 0x21, 0xbf, 0xd9, 0xa8, 0x7f, 0x04, 0x5b,
+	0x49, 0xfa, 0x0d, 0x93, 0x21, 0x17, 0x51, 0x52, 0xe4, 0x7c, 0x6c, 0xaa, 0xce, 0x09, 0x3a, 0xa8,
+	0xee, 0x4d, 0xb5, 0xda, 0xb0, 0xc8, 0xb2, 0x25, 0x43, 0x53, 0x5d, 0x1d, 0x54, 0xcf, 0x0d, 0xbf,
+	0x80, 0x96, 0x41, 0x34, 0x29, 0xd6, 0x36, 0x4b, 0x11, 0xd0, 0x07, 0xc7, 0x1a, 0xc1, 0x2c, 0x65,
+	0x10, 0xea, 0x1b, 0x22, 0xa0, 0x0f, 0x8e, 0xfd, 0xbf, 0x59, 0xd0, 0x3a, 0x4a, 0x47, 0x19, 0x95,
+	0x86, 0xa5, 0xa7, 0xe0, 0x25, 0xec, 0x52, 0x85, 0xd7, 0xa6, 0xaa, 0xa3, 0xdd, 0xe6, 0x32, 0xe9,
+	0xc1, 0xb6, 0xe4, 0x83, 0xe1, 0x32, 0x52, 0x75, 0x13, 0xa4, 0x2d, 0xf4, 0x3b, 0x7a, 0xb3, 0x5e,
+	0xec, 0x0d, 0xea, 0xc5, 0xff, 0xb5, 0x05, 0xce, 0x0b, 0x26, 0x47, 0x37, 0xb2, 0xe3, 0x8f, 0xa1,
+	0x81, 0xbc, 0xe6, 0xdd, 0xea, 0x66, 0xad, 0xb9, 0x34, 0xf7, 0x7f, 0x57, 0x85, 0x6d, 0x6c, 0xee,
+	0x47, 0xa9, 0x50, 0x94, 0x8b, 0xfc, 0x46, 0xc2, 0xf9, 0x0c, 0x9c, 0xb2, 0x3b, 0x6c, 0x1c, 0xd0,
+	0xcc, 0x81, 0xfc, 0x74, 0x81, 0xc3, 0x9f, 0xac, 0xbb, 0xa7, 0x16, 0xc3, 0x35, 0x9a, 0xb3, 0x0c,
+	0x69, 0xfd, 0x12, 0x9a, 0xa5, 0x48, 0x5a, 0xd0, 0xec, 0x89, 0x31, 0x4d, 0x78, 0xec, 0x55, 0x48,
+	0x1b, 0x9c, 0xa9, 0x8f, 0x67, 0x91, 0x2d, 0x68, 0x4d, 0xa5, 0x83, 0x24, 0xf1, 0xaa, 0x4b, 0x0a,
+	0x31, 0xf1, 0x6c, 0xff, 0xb7, 0x16, 0xb8, 0xd8, 0x4c, 0x90, 0x90, 0x8f, 0x30, 0x26, 0x0b, 0x63,
+	0xba, 0xb7, 0x22, 0xa6, 0x99, 0xa5, 0x19, 0x99, 0x58, 0xc8, 0x7d, 0xa8, 0x47, 0x43, 0x9e, 0xc4,
+	0x65, 0x31, 0x7d, 0x77, 0x85, 0xa3, 0xf6, 0x09, 0x8c, 0x95, 0xbf, 0x03, 0xcd, 0xd2, 0x7b, 0x39,
+	0xf4, 0x26, 0xd8, 0xa7, 0xa9, 0xf2, 0x2c, 0xff, 0x5f, 0x16, 0x80, 0xe9, 0x15, 0x18, 0xd4, 0xa3,
+	0x85, 0xa0, 0x7e, 0xb8, 0x02, 0x7b, 0x6e, 0x5a, 0x0e, 0xcb, 0xb0, 0x7e, 0x0c, 0x35, 0x7d, 0x02,
+	0xae, 0x8a, 0x0a, 0x8d, 0x74, 0x0e, 0x58, 0xe4, 0x5d, 0xfb, 0xed, 0xd6, 0xc6, 0xca, 0x7f, 0x04,
+	0xce, 0x21, 0x5f, 0x95, 0x44, 0x07, 0xe0, 0xab, 0x74, 0xc0, 0x23, 0x9a, 0x1c, 0x88, 0xd8, 0xb3,
+	0xc8, 0x2d, 0x70, 0x4b, 0xf9, 0x4c, 0x7a, 0x55, 0xff, 0xef, 0x16, 0xdc, 0x32, 0x8e, 0x07, 0x92,
+	0xab, 0xe1, 0x59, 0xf6, 0x3f, 0xd7, 0xe0, 0x27, 0xe0, 0x50, 0x0d, 0x15, 0xce, 0x1a, 0xf8, 0xbb,
+	0x2b, 0x8b, 0x09, 0x57, 0xc3, 0x53, 0xd9, 0xa4, 0xe5, 0xd2, 0xc7, 0x70, 0xcb, 0x34, 0x84, 0x34,
+	0x63, 0x92, 0x8a, 0x78, 0xd3, 0x96, 0xde, 0x46, 0xaf, 0x33, 0xe3, 0xe4, 0xff, 0xde, 0x9a, 0x76,
+	0x76, 0x5c, 0x04, 0xb7, 0x6c, 0x4a, 0xbd, 0x75, 0x2d, 0xea, 0xab, 0x9b, 0x50, 0x4f, 0xf6, 0x17,
+	0xce, 0xcd, 0x55, 0xa9, 0xea, 0x93, 0xf2, 0x97, 0x2a, 0xdc, 0x5e, 0xa2, 0xfc, 0xc9, 0x98, 0x26,
+	0x37, 0x77, 0x09, 0xfd, 0xbf, 0xf9, 0x2f, 0x7b, 0x71, 0xed, 0x5a, 0x77, 0x77, 0xfd, 0x5a, 0x77,
+	0xf7, 0x3f, 0x1a, 0x50, 0x43, 0xae, 0x3e, 0x05, 0x57, 0x31, 0x39, 0x0a, 0xd9, 0xeb, 0x4c, 0x96,
+	0x4c, 0xdd, 0x59, 0x81, 0x31, 0x6d, 0xf7, 0xfa, 0x91, 0xad, 0xca, 0x31, 0xf9, 0x19, 0x40, 0xa1,
+	0x37, 0xc1, 0x38, 0x9b, 0xad, 0xfe, 0xde, 0xdb, 0x5a, 0x8c, 0x7e, 0xdc, 0x17, 0x53, 0x41, 0xdf,
+	0xab, 0x7d, 0x3e, 0xf7, 0xb7, 0xd7, 0x6e, 0xd3, 0xbc, 0x1b, 0x9c, 0x54, 0x02, 0xe8, 0xcf, 0x24,
+	0x72, 0x04, 0xed, 0xc8, 0x5c, 0xab, 0x06, 0xc2, 0x5c, 0xee, 0xef, 0xae, 0xdc, 0xe9, 0xd9, 0xed,
+	0x7b, 0x52, 0x09, 0x5a, 0xd1, 0x5c, 0x24, 0xcf, 0xc0, 0x33, 0x59, 0x48, 0x5d, 0x40, 0x06, 0xc8,
+	0x90, 0xf9, 0xfd, 0x75, 0xb9, 0xcc, 0x4a, 0xed, 0xa4, 0x12, 0x74, 0x8a, 0x25, 0x0d, 0x39, 0x87,
+	0xed, 0x3e, 0x7f, 0x13, 0xaf, 0x81, 0x78, 0xfe, 0xda, 0xdc, 0x16, 0x01, 0xb7, 0xfa, 0xcb, 0x2a,
+	0xa2, 0x60, 0xa7, 0x44, 0x9c, 0x56, 0x65, 0xc8, 0xc6, 0x34, 0x59, 0xc4, 0x6f, 0x22, 0xfe, 0xfd,
+	0xb5, 0xf8, 0xab, 0x8e, 0xc9, 0x49, 0x25, 0xb8, 0xdd, 0x5f, 0x7f, 0x88, 0xe6, 0x79, 0x98, 0x55,
+	0x71, 0x1d, 0xe7, 0x8a, 0x3c, 0x66, 0xed, 0x62, 0x9e, 0xc7, 0x4c, 0xa5, 0xcb, 0x05, 0x8b, 0xcf,
+	0x40, 0xb9, 0x6b, 0xcb, 0x65, 0xf6, 0x9a, 0xd6, 0xe5, 0x32, 0x9e, 0x0a, 0xba, 0x5c, 0xca, 0x53,
+	0x8d, 0xfe, 0x70, 0xc5, 0xa9, 0x9e, 0x96, 0x4b, 0x34, 0x93, 0xc8, 0x05, 0x7c, 0xc7, 0xfc, 0x26,
+	0xa3, 0xf2, 0xbe, 0x34, 0x48, 0x2d, 0x44, 0xba, 0xb7, 0xc9, 0x7d, 0x7d, 0x52, 0x09, 0xb6, 0xe9,
+	0x9b, 0xca, 0xc3, 0x06, 0xd4, 0x34, 0x90, 0xff, 0x6f, 0x0b, 0xe0, 0x82, 0x45, 0x2a, 0x95, 0x07,
+	0xa7, 0xa7, 0xcf, 0xcb, 0x9f, 0x8d, 0x61, 0xa1, 0x6b, 0x4d, 0x7f, 0x36, 0x86, 0xa8, 0xa5, 0x3f,
+	0x57, 0x75, 0xf9, 0xcf, 0xf5, 0x18, 0x20, 0x93, 0x2c, 0xe6, 0x11, 0x55, 0x2c, 0xbf, 0xea, 0xf2,
+	0x5a, 0x30, 0x25, 0x9f, 0x01, 0xbc, 0xd2, 0x9f, 0x55, 0xd3, 0xf6, 0x6a, 0x6b, 0x09, 0x9e, 0xfd,
+	0x68, 0x03, 0xf7, 0xd5, 0x74, 0xa8, 0x1f, 0xd4, 0x59, 0x42, 0x23, 0x36, 0x4c, 0x93, 0x98, 0xc9,
+	0x50, 0xd1, 0x01, 0x9e, 0x02, 0x37, 0xe8, 0x2c, 0xa8, 0x5f, 0xd0, 0x81, 0xff, 0x27, 0x0b, 0x9c,
+	0xf3, 0x84, 0x8a, 0xd3, 0x34, 0xc6, 0xb7, 0xf1, 0x18, 0x33, 0x0e, 0xa9, 0x10, 0xf9, 0x5b, 0x5a,
+	0xed, 0x9c, 0x17, 0xbd, 0x29, 0xc6, 0xe7, 0x40, 0x88, 0x9c, 0x7c, 0xb2, 0x94, 0xed, 0xdb, 0xef,
+	0x0b, 0xed, 0xba, 0x90, 0xef, 0x1e, 0x78, 0x69, 0xa1, 0xb2, 0x42, 0xcd, 0xbe, 0xc3, 0x9a, 0x2e,
+	0x5b, 0xff, 0x87, 0x8d, 0xbe, 0xfc, 0x0e, 0xe7, 0x7a, 0x87, 0x44, 0x1a, 0xb3, 0xf7, 0xff, 0x6c,
+	0x41, 0xc3, 0x34, 0xcf, 0xe5, 0x2b, 0x7e, 0x0b, 0x5a, 0x4f, 0x25, 0xa3, 0x8a, 0xc9, 0x17, 0x43,
+	0x2a, 0x3c, 0x8b, 0x78, 0xd0, 0x2e, 0x15, 0x4f, 0x5e, 0x15, 0x54, 0x3f, 0xb3, 0xda, 0xe0, 0x7c,
+	0xc5, 0xf2, 0x1c, 0xe7, 0x6d, 0x7c, 0x03, 0xb0, 0x3c, 0x37, 0x93, 0x35, 0xe2, 0x42, 0xdd, 0x0c,
+	0xeb, 0xda, 0xee, 0x34, 0x55, 0x46, 0x6a, 0x68, 0xe0, 0x73, 0xc9, 0x2e, 0xf9, 0xeb, 0x67, 0x54,
+	0x45, 0x43, 0xaf, 0xa9, 0x81, 0xcf, 0xd3, 0x5c, 0xcd, 0x34, 0x8e, 0xf6, 0x35, 0x43, 0x57, 0x0f,
+	0xf1, 0x00, 0x7a, 0x40, 0x1a, 0x50, 0xed, 0x09, 0xaf, 0xa5, 0x55, 0xa7, 0xa9, 0xea, 0x09, 0xaf,
+	0xfd, 0xfe, 0x53, 0x68, 0x2d, 0xdc, 0x39, 0x3a, 0x81, 0xaf, 0xc5, 0x4b, 0x91, 0x7e, 0x23, 0xcc,
+	0x43, 0xeb, 0x20, 0xd6, 0x8f, 0x93, 0x26, 0xd8, 0xcf, 0x8b, 0xbe, 0x57, 0xd5, 0x83, 0x67, 0x45,
+	0xe2, 0xd9, 0x7a, 0x70, 0xcc, 0xc7, 0x5e, 0x0d, 0x35, 0x69, 0xec, 0xd5, 0x0f, 0x3f, 0xfc, 0xd5,
+	0x07, 0x03, 0xae, 0x86, 0x45, 0x7f, 0x3f, 0x4a, 0x47, 0x0f, 0x0c, 0xd5, 0xf7, 0x79, 0x5a, 0x8e,
+	0x1e, 0x70, 0xa1, 0x98, 0x14, 0x34, 0x79, 0x80, 0xec, 0x3f, 0xd0, 0xec, 0x67, 0xfd, 0x7e, 0x03,
+	0xa5, 0x0f, 0xff, 0x33, 0x00, 0x2a, 0xcc, 0x32, 0x19, 0x4d, 0x12, 0x00, 0x00,
 }
@@ -9,6 +9,7 @@ import (
 	proto "github.com/golang/protobuf/proto"
 	commonpb "github.com/milvus-io/milvus-proto/go-api/commonpb"
 	milvuspb "github.com/milvus-io/milvus-proto/go-api/milvuspb"
+	schemapb "github.com/milvus-io/milvus-proto/go-api/schemapb"
 	internalpb "github.com/milvus-io/milvus/internal/proto/internalpb"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
@@ -298,54 +299,472 @@ func (m *SetRatesRequest) GetRates() []*internalpb.Rate {
 	return nil
 }
 
+// HybridSearchRequest searches multiple vector fields of a collection,
+// the results of all sub searches are merged by the reranker given in rank_params.
+type HybridSearchRequest struct {
+	Base           *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	DbName         string            `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
+	CollectionName string            `protobuf:"bytes,3,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
+	PartitionNames []string          `protobuf:"bytes,4,rep,name=partition_names,json=partitionNames,proto3" json:"partition_names,omitempty"`
+	// one ANN search per vector field, collection and partitions of the sub requests are ignored,
+	// each sub search returns topk+offset hits of rank_params
+	Requests []*milvuspb.SearchRequest `protobuf:"bytes,5,rep,name=requests,proto3" json:"requests,omitempty"`
+	// must be the same among sub requests, so they are set by the hybrid search request
+	OutputFields []string `protobuf:"bytes,6,rep,name=output_fields,json=outputFields,proto3" json:"output_fields,omitempty"`
+	// reranker strategy and its params, and the topk/offset of the merged results
+	RankParams           []*commonpb.KeyValuePair `protobuf:"bytes,7,rep,name=rank_params,json=rankParams,proto3" json:"rank_params,omitempty"`
+	TravelTimestamp      uint64                   `protobuf:"varint,8,opt,name=travel_timestamp,json=travelTimestamp,proto3" json:"travel_timestamp,omitempty"`
+	GuaranteeTimestamp   uint64                   `protobuf:"varint,9,opt,name=guarantee_timestamp,json=guaranteeTimestamp,proto3" json:"guarantee_timestamp,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
+	XXX_unrecognized     []byte                   `json:"-"`
+	XXX_sizecache        int32                    `json:"-"`
+}
+
+func (m *HybridSearchRequest) Reset()         { *m = HybridSearchRequest{} }
+func (m *HybridSearchRequest) String() string { return proto.CompactTextString(m) }
+func (*HybridSearchRequest) ProtoMessage()    {}
+func (*HybridSearchRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_700b50b08ed8dbaf, []int{5}
+}
+
+func (m *HybridSearchRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_HybridSearchRequest.Unmarshal(m, b)
+}
+func (m *HybridSearchRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_HybridSearchRequest.Marshal(b, m, deterministic)
+}
+func (m *HybridSearchRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HybridSearchRequest.Merge(m, src)
+}
+func (m *HybridSearchRequest) XXX_Size() int {
+	return xxx_messageInfo_HybridSearchRequest.Size(m)
+}
+func (m *HybridSearchRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_HybridSearchRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HybridSearchRequest proto.InternalMessageInfo
+
+func (m *HybridSearchRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *HybridSearchRequest) GetDbName() string {
+	if m != nil {
+		return m.DbName
+	}
+	return ""
+}
+
+func (m *HybridSearchRequest) GetCollectionName() string {
+	if m != nil {
+		return m.CollectionName
+	}
+	return ""
+}
+
+func (m *HybridSearchRequest) GetPartitionNames() []string {
+	if m != nil {
+		return m.PartitionNames
+	}
+	return nil
+}
+
+func (m *HybridSearchRequest) GetRequests() []*milvuspb.SearchRequest {
+	if m != nil {
+		return m.Requests
+	}
+	return nil
+}
+
+func (m *HybridSearchRequest) GetOutputFields() []string {
+	if m != nil {
+		return m.OutputFields
+	}
+	return nil
+}
+
+func (m *HybridSearchRequest) GetRankParams() []*commonpb.KeyValuePair {
+	if m != nil {
+		return m.RankParams
+	}
+	return nil
+}
+
+func (m *HybridSearchRequest) GetTravelTimestamp() uint64 {
+	if m != nil {
+		return m.TravelTimestamp
+	}
+	return 0
+}
+
+func (m *HybridSearchRequest) GetGuaranteeTimestamp() uint64 {
+	if m != nil {
+		return m.GuaranteeTimestamp
+	}
+	return 0
+}
+
+// IteratorCursor is where a query or search iterator stops, the next page resumes from it.
+type IteratorCursor struct {
+	// query iterator: primary key of the last returned entity;
+	// search iterator: primary keys of the returned hits whose score equals to last_score.
+	Pks *schemapb.IDs `protobuf:"bytes,1,opt,name=pks,proto3" json:"pks,omitempty"`
+	// all pages of an iterator read the snapshot of this timestamp
+	MvccTimestamp uint64 `protobuf:"varint,2,opt,name=mvcc_timestamp,json=mvccTimestamp,proto3" json:"mvcc_timestamp,omitempty"`
+	// search iterator only, score of the last returned hit as it is in search results
+	LastScore float32 `protobuf:"fixed32,3,opt,name=last_score,json=lastScore,proto3" json:"last_score,omitempty"`
+	// search iterator only, number of hits returned by the previous pages
+	Returned             int64    `protobuf:"varint,4,opt,name=returned,proto3" json:"returned,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *IteratorCursor) Reset()         { *m = IteratorCursor{} }
+func (m *IteratorCursor) String() string { return proto.CompactTextString(m) }
+func (*IteratorCursor) ProtoMessage()    {}
+func (*IteratorCursor) Descriptor() ([]byte, []int) {
+	return fileDescriptor_700b50b08ed8dbaf, []int{6}
+}
+
+func (m *IteratorCursor) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_IteratorCursor.Unmarshal(m, b)
+}
+func (m *IteratorCursor) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_IteratorCursor.Marshal(b, m, deterministic)
+}
+func (m *IteratorCursor) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IteratorCursor.Merge(m, src)
+}
+func (m *IteratorCursor) XXX_Size() int {
+	return xxx_messageInfo_IteratorCursor.Size(m)
+}
+func (m *IteratorCursor) XXX_DiscardUnknown() {
+	xxx_messageInfo_IteratorCursor.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_IteratorCursor proto.InternalMessageInfo
+
+func (m *IteratorCursor) GetPks() *schemapb.IDs {
+	if m != nil {
+		return m.Pks
+	}
+	return nil
+}
+
+func (m *IteratorCursor) GetMvccTimestamp() uint64 {
+	if m != nil {
+		return m.MvccTimestamp
+	}
+	return 0
+}
+
+func (m *IteratorCursor) GetLastScore() float32 {
+	if m != nil {
+		return m.LastScore
+	}
+	return 0
+}
+
+func (m *IteratorCursor) GetReturned() int64 {
+	if m != nil {
+		return m.Returned
+	}
+	return 0
+}
+
+type QueryIteratorRequest struct {
+	Request *milvuspb.QueryRequest `protobuf:"bytes,1,opt,name=request,proto3" json:"request,omitempty"`
+	// cursor returned by the previous page, empty for the first page
+	Cursor               *IteratorCursor `protobuf:"bytes,2,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	BatchSize            int64           `protobuf:"varint,3,opt,name=batch_size,json=batchSize,proto3" json:"batch_size,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *QueryIteratorRequest) Reset()         { *m = QueryIteratorRequest{} }
+func (m *QueryIteratorRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryIteratorRequest) ProtoMessage()    {}
+func (*QueryIteratorRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_700b50b08ed8dbaf, []int{7}
+}
+
+func (m *QueryIteratorRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_QueryIteratorRequest.Unmarshal(m, b)
+}
+func (m *QueryIteratorRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_QueryIteratorRequest.Marshal(b, m, deterministic)
+}
+func (m *QueryIteratorRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryIteratorRequest.Merge(m, src)
+}
+func (m *QueryIteratorRequest) XXX_Size() int {
+	return xxx_messageInfo_QueryIteratorRequest.Size(m)
+}
+func (m *QueryIteratorRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryIteratorRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryIteratorRequest proto.InternalMessageInfo
+
+func (m *QueryIteratorRequest) GetRequest() *milvuspb.QueryRequest {
+	if m != nil {
+		return m.Request
+	}
+	return nil
+}
+
+func (m *QueryIteratorRequest) GetCursor() *IteratorCursor {
+	if m != nil {
+		return m.Cursor
+	}
+	return nil
+}
+
+func (m *QueryIteratorRequest) GetBatchSize() int64 {
+	if m != nil {
+		return m.BatchSize
+	}
+	return 0
+}
+
+type QueryIteratorResults struct {
+	Results *milvuspb.QueryResults `protobuf:"bytes,1,opt,name=results,proto3" json:"results,omitempty"`
+	// empty if there are no more entities
+	Cursor               *IteratorCursor `protobuf:"bytes,2,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *QueryIteratorResults) Reset()         { *m = QueryIteratorResults{} }
+func (m *QueryIteratorResults) String() string { return proto.CompactTextString(m) }
+func (*QueryIteratorResults) ProtoMessage()    {}
+func (*QueryIteratorResults) Descriptor() ([]byte, []int) {
+	return fileDescriptor_700b50b08ed8dbaf, []int{8}
+}
+
+func (m *QueryIteratorResults) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_QueryIteratorResults.Unmarshal(m, b)
+}
+func (m *QueryIteratorResults) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_QueryIteratorResults.Marshal(b, m, deterministic)
+}
+func (m *QueryIteratorResults) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryIteratorResults.Merge(m, src)
+}
+func (m *QueryIteratorResults) XXX_Size() int {
+	return xxx_messageInfo_QueryIteratorResults.Size(m)
+}
+func (m *QueryIteratorResults) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryIteratorResults.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryIteratorResults proto.InternalMessageInfo
+
+func (m *QueryIteratorResults) GetResults() *milvuspb.QueryResults {
+	if m != nil {
+		return m.Results
+	}
+	return nil
+}
+
+func (m *QueryIteratorResults) GetCursor() *IteratorCursor {
+	if m != nil {
+		return m.Cursor
+	}
+	return nil
+}
+
+type SearchIteratorRequest struct {
+	Request *milvuspb.SearchRequest `protobuf:"bytes,1,opt,name=request,proto3" json:"request,omitempty"`
+	// cursor returned by the previous page, empty for the first page
+	Cursor               *IteratorCursor `protobuf:"bytes,2,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	BatchSize            int64           `protobuf:"varint,3,opt,name=batch_size,json=batchSize,proto3" json:"batch_size,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *SearchIteratorRequest) Reset()         { *m = SearchIteratorRequest{} }
+func (m *SearchIteratorRequest) String() string { return proto.CompactTextString(m) }
+func (*SearchIteratorRequest) ProtoMessage()    {}
+func (*SearchIteratorRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_700b50b08ed8dbaf, []int{9}
+}
+
+func (m *SearchIteratorRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SearchIteratorRequest.Unmarshal(m, b)
+}
+func (m *SearchIteratorRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SearchIteratorRequest.Marshal(b, m, deterministic)
+}
+func (m *SearchIteratorRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SearchIteratorRequest.Merge(m, src)
+}
+func (m *SearchIteratorRequest) XXX_Size() int {
+	return xxx_messageInfo_SearchIteratorRequest.Size(m)
+}
+func (m *SearchIteratorRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SearchIteratorRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SearchIteratorRequest proto.InternalMessageInfo
+
+func (m *SearchIteratorRequest) GetRequest() *milvuspb.SearchRequest {
+	if m != nil {
+		return m.Request
+	}
+	return nil
+}
+
+func (m *SearchIteratorRequest) GetCursor() *IteratorCursor {
+	if m != nil {
+		return m.Cursor
+	}
+	return nil
+}
+
+func (m *SearchIteratorRequest) GetBatchSize() int64 {
+	if m != nil {
+		return m.BatchSize
+	}
+	return 0
+}
+
+type SearchIteratorResults struct {
+	Results *milvuspb.SearchResults `protobuf:"bytes,1,opt,name=results,proto3" json:"results,omitempty"`
+	// empty if there are no more hits
+	Cursor               *IteratorCursor `protobuf:"bytes,2,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *SearchIteratorResults) Reset()         { *m = SearchIteratorResults{} }
+func (m *SearchIteratorResults) String() string { return proto.CompactTextString(m) }
+func (*SearchIteratorResults) ProtoMessage()    {}
+func (*SearchIteratorResults) Descriptor() ([]byte, []int) {
+	return fileDescriptor_700b50b08ed8dbaf, []int{10}
+}
+
+func (m *SearchIteratorResults) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SearchIteratorResults.Unmarshal(m, b)
+}
+func (m *SearchIteratorResults) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SearchIteratorResults.Marshal(b, m, deterministic)
+}
+func (m *SearchIteratorResults) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SearchIteratorResults.Merge(m, src)
+}
+func (m *SearchIteratorResults) XXX_Size() int {
+	return xxx_messageInfo_SearchIteratorResults.Size(m)
+}
+func (m *SearchIteratorResults) XXX_DiscardUnknown() {
+	xxx_messageInfo_SearchIteratorResults.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SearchIteratorResults proto.InternalMessageInfo
+
+func (m *SearchIteratorResults) GetResults() *milvuspb.SearchResults {
+	if m != nil {
+		return m.Results
+	}
+	return nil
+}
+
+func (m *SearchIteratorResults) GetCursor() *IteratorCursor {
+	if m != nil {
+		return m.Cursor
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*InvalidateCollMetaCacheRequest)(nil), "milvus.proto.proxy.InvalidateCollMetaCacheRequest")
 	proto.RegisterType((*InvalidateCredCacheRequest)(nil), "milvus.proto.proxy.InvalidateCredCacheRequest")
 	proto.RegisterType((*UpdateCredCacheRequest)(nil), "milvus.proto.proxy.UpdateCredCacheRequest")
 	proto.RegisterType((*RefreshPolicyInfoCacheRequest)(nil), "milvus.proto.proxy.RefreshPolicyInfoCacheRequest")
 	proto.RegisterType((*SetRatesRequest)(nil), "milvus.proto.proxy.SetRatesRequest")
+	proto.RegisterType((*HybridSearchRequest)(nil), "milvus.proto.proxy.HybridSearchRequest")
+	proto.RegisterType((*IteratorCursor)(nil), "milvus.proto.proxy.IteratorCursor")
+	proto.RegisterType((*QueryIteratorRequest)(nil), "milvus.proto.proxy.QueryIteratorRequest")
+	proto.RegisterType((*QueryIteratorResults)(nil), "milvus.proto.proxy.QueryIteratorResults")
+	proto.RegisterType((*SearchIteratorRequest)(nil), "milvus.proto.proxy.SearchIteratorRequest")
+	proto.RegisterType((*SearchIteratorResults)(nil), "milvus.proto.proxy.SearchIteratorResults")
 }
 
 func init() { proto.RegisterFile("proxy.proto", fileDescriptor_700b50b08ed8dbaf) }
 
 var fileDescriptor_700b50b08ed8dbaf = []byte{
-	// 573 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x54, 0xdd, 0x6e, 0xda, 0x30,
-	0x18, 0x6d, 0xda, 0x42, 0xbb, 0x0f, 0x54, 0x24, 0xab, 0x63, 0x2c, 0x5d, 0x27, 0x94, 0x4e, 0x2b,
-	0xaa, 0x34, 0x58, 0xd9, 0x9e, 0xa0, 0x54, 0x42, 0x68, 0xa2, 0xaa, 0xc2, 0x76, 0xb3, 0x9b, 0xc9,
-	0x49, 0xbe, 0x82, 0x51, 0x62, 0xa7, 0xb1, 0x61, 0xe3, 0x6a, 0xd2, 0xde, 0x68, 0x77, 0x7b, 0xbc,
-	0x29, 0x3f, 0x04, 0xc2, 0x42, 0xa3, 0xad, 0xda, 0x1d, 0xc7, 0x3e, 0xf6, 0x39, 0x27, 0xfe, 0x0e,
-	0x50, 0xf1, 0x03, 0xf1, 0x6d, 0xd1, 0xf6, 0x03, 0xa1, 0x04, 0x21, 0x1e, 0x73, 0xe7, 0x33, 0x19,
-	0xa3, 0x76, 0xb4, 0xa3, 0x57, 0x6d, 0xe1, 0x79, 0x82, 0xc7, 0x6b, 0xfa, 0x11, 0xe3, 0x0a, 0x03,
-	0x4e, 0xdd, 0x04, 0x57, 0xd7, 0x4f, 0x18, 0xbf, 0x34, 0x78, 0x39, 0xe0, 0x73, 0xea, 0x32, 0x87,
-	0x2a, 0xec, 0x09, 0xd7, 0x1d, 0xa2, 0xa2, 0x3d, 0x6a, 0x4f, 0xd0, 0xc4, 0xfb, 0x19, 0x4a, 0x45,
-	0xde, 0xc2, 0xbe, 0x45, 0x25, 0x36, 0xb4, 0xa6, 0xd6, 0xaa, 0x74, 0x5f, 0xb4, 0x33, 0x8a, 0x89,
-	0xd4, 0x50, 0x8e, 0xaf, 0xa8, 0x44, 0x33, 0x62, 0x92, 0x67, 0x70, 0xe0, 0x58, 0x5f, 0x38, 0xf5,
-	0xb0, 0xb1, 0xdb, 0xd4, 0x5a, 0x4f, 0xcc, 0xb2, 0x63, 0xdd, 0x50, 0x0f, 0xc9, 0x39, 0xd4, 0x6c,
-	0xe1, 0xba, 0x68, 0x2b, 0x26, 0x78, 0x4c, 0xd8, 0x8b, 0x08, 0x47, 0xab, 0xe5, 0x88, 0x68, 0x40,
-	0x75, 0xb5, 0x32, 0xb8, 0x6e, 0xec, 0x37, 0xb5, 0xd6, 0x9e, 0x99, 0x59, 0x33, 0xa6, 0xa0, 0xaf,
-	0x39, 0x0f, 0xd0, 0x79, 0xa4, 0x6b, 0x1d, 0x0e, 0x67, 0x32, 0xfc, 0x52, 0xa9, 0xed, 0x14, 0x1b,
-	0x3f, 0x34, 0xa8, 0x7f, 0xf2, 0xff, 0xbf, 0x50, 0xb8, 0xe7, 0x53, 0x29, 0xbf, 0x8a, 0xc0, 0x49,
-	0x3e, 0x4d, 0x8a, 0x8d, 0xef, 0x70, 0x6a, 0xe2, 0x5d, 0x80, 0x72, 0x72, 0x2b, 0x5c, 0x66, 0x2f,
-	0x06, 0xfc, 0x4e, 0x3c, 0xd2, 0x4a, 0x1d, 0xca, 0xc2, 0xff, 0xb8, 0xf0, 0x63, 0x23, 0x25, 0x33,
-	0x41, 0xe4, 0x18, 0x4a, 0xc2, 0xff, 0x80, 0x8b, 0xc4, 0x43, 0x0c, 0x8c, 0x39, 0xd4, 0x46, 0xa8,
-	0x4c, 0xaa, 0x50, 0xfe, 0xbb, 0xe4, 0x25, 0x94, 0x82, 0xf0, 0x86, 0xc6, 0x6e, 0x73, 0xaf, 0x55,
-	0xe9, 0x9e, 0x64, 0x8f, 0xa4, 0xc3, 0x1a, 0xaa, 0x98, 0x31, 0xb3, 0xfb, 0xf3, 0x00, 0x4a, 0xb7,
-	0xe1, 0x68, 0x13, 0x17, 0x48, 0x1f, 0x55, 0x4f, 0x78, 0xbe, 0xe0, 0xc8, 0xd5, 0x48, 0x85, 0xfb,
-	0xa4, 0x9d, 0xbd, 0x23, 0x01, 0x7f, 0x12, 0x13, 0xd3, 0xfa, 0xab, 0x5c, 0xfe, 0x06, 0xd9, 0xd8,
-	0x21, 0xf7, 0x70, 0xdc, 0xc7, 0x08, 0x32, 0xa9, 0x98, 0x2d, 0x7b, 0x13, 0xca, 0x39, 0xba, 0xa4,
-	0xbb, 0xc5, 0x73, 0x1e, 0x79, 0xa9, 0x79, 0x96, 0xab, 0x39, 0x52, 0x01, 0xe3, 0x63, 0x13, 0xa5,
-	0x2f, 0xb8, 0x44, 0x63, 0x87, 0x04, 0x70, 0x9a, 0xad, 0x63, 0x3c, 0xee, 0x69, 0x29, 0x37, 0xb5,
-	0xe3, 0xff, 0x82, 0x87, 0x1b, 0xac, 0x9f, 0xe4, 0x3e, 0x4b, 0x68, 0x75, 0x16, 0xc6, 0xa4, 0x50,
-	0xed, 0xa3, 0xba, 0x76, 0x96, 0xf1, 0x2e, 0xb6, 0xc7, 0x4b, 0x49, 0x7f, 0x19, 0x6b, 0x0a, 0xcf,
-	0xb3, 0x5d, 0x45, 0xae, 0x18, 0x75, 0xe3, 0x48, 0xed, 0x82, 0x48, 0x1b, 0x8d, 0x2b, 0x8a, 0x63,
-	0xc1, 0xd3, 0x55, 0x55, 0xd7, 0x75, 0x2e, 0xf2, 0x74, 0xf2, 0x5b, 0x5d, 0xa4, 0x31, 0x85, 0x7a,
-	0x7e, 0x15, 0xc9, 0x65, 0x9e, 0xc8, 0x83, 0xb5, 0x2d, 0xd2, 0x72, 0xa0, 0xd6, 0x47, 0x15, 0xcd,
-	0xff, 0x10, 0x55, 0xc0, 0x6c, 0x49, 0x5e, 0x6f, 0x1b, 0xf8, 0x84, 0xb0, 0xbc, 0xf9, 0xbc, 0x90,
-	0x97, 0xbe, 0xd0, 0x0d, 0x1c, 0x2e, 0xbb, 0x4d, 0xce, 0xf2, 0x32, 0x6c, 0x34, 0xbf, 0xc0, 0xf5,
-	0xd5, 0xfb, 0xcf, 0xdd, 0x31, 0x53, 0x93, 0x99, 0x15, 0xee, 0x74, 0x62, 0xea, 0x1b, 0x26, 0x92,
-	0x5f, 0x9d, 0xe5, 0x50, 0x75, 0xa2, 0xd3, 0x9d, 0x48, 0xc2, 0xb7, 0xac, 0x72, 0x04, 0xdf, 0xfd,
-	0x0e, 0x00, 0x00, 0xff, 0xff, 0x9d, 0xbe, 0x61, 0x31, 0xe4, 0x06, 0x00, 0x00,
+	// 983 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x56, 0x5f, 0x73, 0xdb, 0x44,
+	0x10, 0x8f, 0xe2, 0xc4, 0x49, 0x36, 0x6e, 0xd2, 0xb9, 0xa6, 0x41, 0xb8, 0x84, 0x71, 0x15, 0x20,
+	0x21, 0x33, 0x24, 0xd4, 0xf0, 0x04, 0x1d, 0x1e, 0x92, 0x40, 0xf0, 0x74, 0xdc, 0x09, 0x72, 0xc3,
+	0x03, 0x2f, 0x9e, 0xb3, 0xb4, 0xb1, 0x2f, 0x95, 0x74, 0xea, 0xdd, 0xc9, 0xd4, 0x7d, 0x61, 0x86,
+	0x2f, 0xc0, 0xf0, 0x05, 0xf8, 0x08, 0x0c, 0x3c, 0xc1, 0xc7, 0x63, 0x74, 0x27, 0x29, 0xb6, 0xa3,
+	0xc4, 0xa5, 0xe1, 0xcf, 0x9b, 0x76, 0xef, 0xb7, 0xfa, 0xfd, 0x76, 0x6f, 0x6f, 0x67, 0x61, 0x35,
+	0x16, 0xfc, 0xe5, 0x68, 0x3f, 0x16, 0x5c, 0x71, 0x42, 0x42, 0x16, 0x0c, 0x13, 0x69, 0xac, 0x7d,
+	0x7d, 0x52, 0xaf, 0x79, 0x3c, 0x0c, 0x79, 0x64, 0x7c, 0xf5, 0x35, 0x16, 0x29, 0x14, 0x11, 0x0d,
+	0x32, 0xbb, 0x36, 0x1e, 0x51, 0xaf, 0x49, 0x6f, 0x80, 0x21, 0x35, 0x96, 0xf3, 0xa7, 0x05, 0xef,
+	0xb6, 0xa2, 0x21, 0x0d, 0x98, 0x4f, 0x15, 0x1e, 0xf1, 0x20, 0x68, 0xa3, 0xa2, 0x47, 0xd4, 0x1b,
+	0xa0, 0x8b, 0x2f, 0x12, 0x94, 0x8a, 0x7c, 0x0c, 0x0b, 0x3d, 0x2a, 0xd1, 0xb6, 0x1a, 0xd6, 0xee,
+	0x6a, 0xf3, 0x9d, 0xfd, 0x09, 0xfe, 0x8c, 0xb8, 0x2d, 0xfb, 0x87, 0x54, 0xa2, 0xab, 0x91, 0xe4,
+	0x2d, 0x58, 0xf2, 0x7b, 0xdd, 0x88, 0x86, 0x68, 0xcf, 0x37, 0xac, 0xdd, 0x15, 0xb7, 0xea, 0xf7,
+	0x9e, 0xd2, 0x10, 0xc9, 0x0e, 0xac, 0x7b, 0x3c, 0x08, 0xd0, 0x53, 0x8c, 0x47, 0x06, 0x50, 0xd1,
+	0x80, 0xb5, 0x4b, 0xb7, 0x06, 0x3a, 0x50, 0xbb, 0xf4, 0xb4, 0x8e, 0xed, 0x85, 0x86, 0xb5, 0x5b,
+	0x71, 0x27, 0x7c, 0xce, 0x05, 0xd4, 0xc7, 0x94, 0x0b, 0xf4, 0x6f, 0xa9, 0xba, 0x0e, 0xcb, 0x89,
+	0x44, 0x31, 0x26, 0xbb, 0xb0, 0x9d, 0x1f, 0x2d, 0xd8, 0x3c, 0x8b, 0xff, 0x7d, 0xa2, 0xf4, 0x2c,
+	0xa6, 0x52, 0x7e, 0xcf, 0x85, 0x9f, 0x95, 0xa6, 0xb0, 0x9d, 0x1f, 0x60, 0xcb, 0xc5, 0x73, 0x81,
+	0x72, 0x70, 0xca, 0x03, 0xe6, 0x8d, 0x5a, 0xd1, 0x39, 0xbf, 0xa5, 0x94, 0x4d, 0xa8, 0xf2, 0xf8,
+	0xd9, 0x28, 0x36, 0x42, 0x16, 0xdd, 0xcc, 0x22, 0x1b, 0xb0, 0xc8, 0xe3, 0x27, 0x38, 0xca, 0x34,
+	0x18, 0xc3, 0x19, 0xc2, 0x7a, 0x07, 0x95, 0x4b, 0x15, 0xca, 0x37, 0xa7, 0x7c, 0x04, 0x8b, 0x22,
+	0xfd, 0x83, 0x3d, 0xdf, 0xa8, 0xec, 0xae, 0x36, 0x1f, 0x4c, 0x86, 0x14, 0xad, 0x9b, 0xb2, 0xb8,
+	0x06, 0xe9, 0xfc, 0x51, 0x81, 0x7b, 0x5f, 0x8f, 0x7a, 0x82, 0xf9, 0x1d, 0xa4, 0xc2, 0x1b, 0xfc,
+	0x9f, 0x9d, 0xb9, 0x03, 0xeb, 0x31, 0x15, 0x8a, 0x15, 0x38, 0x69, 0x2f, 0x34, 0x2a, 0x29, 0xb0,
+	0x70, 0xa7, 0x38, 0x49, 0xbe, 0x80, 0x65, 0x61, 0x74, 0x4a, 0x7b, 0x51, 0xa7, 0xea, 0x4c, 0x0a,
+	0xcc, 0x8c, 0x89, 0x94, 0xdc, 0x22, 0x86, 0x6c, 0xc3, 0x1d, 0x9e, 0xa8, 0x38, 0x51, 0xdd, 0x73,
+	0x86, 0x81, 0x2f, 0xed, 0xaa, 0xa6, 0xa9, 0x19, 0xe7, 0x57, 0xda, 0x47, 0x0e, 0x61, 0x55, 0xd0,
+	0xe8, 0x79, 0x37, 0xa6, 0x82, 0x86, 0xd2, 0x5e, 0xd2, 0x3c, 0x0f, 0x4b, 0x0b, 0xf1, 0x04, 0x47,
+	0xdf, 0xd2, 0x20, 0xc1, 0x53, 0xca, 0x84, 0x0b, 0x69, 0xd4, 0xa9, 0x0e, 0x22, 0x1f, 0xc2, 0x5d,
+	0x25, 0xe8, 0x10, 0x83, 0xae, 0x62, 0x21, 0x4a, 0x45, 0xc3, 0xd8, 0x5e, 0x6e, 0x58, 0xbb, 0x0b,
+	0xee, 0xba, 0xf1, 0x3f, 0xcb, 0xdd, 0xe4, 0x00, 0xee, 0xf5, 0x13, 0x2a, 0x68, 0xa4, 0x10, 0xc7,
+	0xd0, 0x2b, 0x1a, 0x4d, 0x8a, 0xa3, 0x22, 0xc0, 0xf9, 0xc5, 0x82, 0xb5, 0x96, 0x42, 0x41, 0x15,
+	0x17, 0x47, 0x89, 0x90, 0x5c, 0x90, 0x3d, 0xa8, 0xc4, 0xcf, 0x65, 0x76, 0x67, 0xf6, 0xa4, 0xd4,
+	0x6c, 0x34, 0xb5, 0x8e, 0xa5, 0x9b, 0x82, 0xc8, 0xfb, 0xb0, 0x16, 0x0e, 0x3d, 0x6f, 0x8c, 0x6a,
+	0x5e, 0x53, 0xdd, 0x49, 0xbd, 0x97, 0xb2, 0xb6, 0x00, 0x02, 0x2a, 0x55, 0x57, 0x7a, 0x5c, 0x98,
+	0x7b, 0x9b, 0x77, 0x57, 0x52, 0x4f, 0x27, 0x75, 0xa4, 0x6f, 0x4a, 0xa0, 0x4a, 0x44, 0x84, 0x7e,
+	0x36, 0x48, 0x0a, 0xdb, 0xf9, 0xd5, 0x82, 0x8d, 0x6f, 0x12, 0x14, 0xa3, 0x5c, 0x65, 0xde, 0x5b,
+	0x9f, 0xc3, 0x52, 0x76, 0x15, 0x99, 0xd4, 0x87, 0xa5, 0xb7, 0xa7, 0x63, 0xf3, 0xcb, 0xcb, 0x23,
+	0xc8, 0x67, 0x50, 0xf5, 0x74, 0xb6, 0x5a, 0xef, 0x95, 0x9b, 0x37, 0xe3, 0x7c, 0xb2, 0x2e, 0x6e,
+	0x16, 0x91, 0x26, 0xd3, 0xa3, 0xca, 0x1b, 0x74, 0x25, 0x7b, 0x65, 0x92, 0xa9, 0xb8, 0x2b, 0xda,
+	0xd3, 0x61, 0xaf, 0xd0, 0xf9, 0xe9, 0xaa, 0x60, 0x99, 0x04, 0x4a, 0x1a, 0xc1, 0xfa, 0xf3, 0x75,
+	0x04, 0x6b, 0xa0, 0x9b, 0x47, 0xdc, 0x46, 0xb0, 0xf3, 0x9b, 0x05, 0xf7, 0x4d, 0x13, 0x4f, 0xd7,
+	0xf0, 0xf1, 0x74, 0x0d, 0x5f, 0xe7, 0x05, 0xfc, 0x17, 0x45, 0xfc, 0xb9, 0x44, 0xb2, 0x29, 0xc4,
+	0xe3, 0xe9, 0x2a, 0xde, 0x2c, 0xf9, 0x9f, 0x2b, 0x63, 0xf3, 0xf7, 0x25, 0x58, 0x3c, 0x4d, 0x01,
+	0x24, 0x00, 0x72, 0x82, 0xea, 0x88, 0x87, 0x31, 0x8f, 0x30, 0x52, 0x1d, 0x45, 0x15, 0x4a, 0xb2,
+	0x5f, 0x2a, 0xe4, 0x2a, 0x30, 0xab, 0x63, 0xfd, 0xbd, 0x52, 0xfc, 0x14, 0xd8, 0x99, 0x23, 0x2f,
+	0x60, 0xe3, 0x04, 0xb5, 0xc9, 0xa4, 0x62, 0x9e, 0x3c, 0x1a, 0xd0, 0x28, 0xc2, 0x80, 0x34, 0xaf,
+	0x19, 0xcc, 0x65, 0xe0, 0x9c, 0x73, 0xbb, 0xbc, 0x58, 0x4a, 0xb0, 0xa8, 0xef, 0xa2, 0x8c, 0x79,
+	0x24, 0xd1, 0x99, 0x23, 0x02, 0xb6, 0x26, 0x77, 0x0e, 0x33, 0x5f, 0x8b, 0xcd, 0x83, 0x34, 0x4b,
+	0xeb, 0x76, 0xe3, 0x9a, 0x52, 0x7f, 0x50, 0x3a, 0xf5, 0x52, 0xa9, 0x49, 0x9a, 0x26, 0x85, 0xda,
+	0x09, 0xaa, 0x63, 0x3f, 0x4f, 0x6f, 0xef, 0xfa, 0xf4, 0x0a, 0xd0, 0xdf, 0x4c, 0xeb, 0x02, 0xde,
+	0x9e, 0x5c, 0x48, 0x30, 0x52, 0x8c, 0x06, 0x26, 0xa5, 0xfd, 0x19, 0x29, 0x4d, 0xad, 0x15, 0xb3,
+	0xd2, 0xe9, 0xc1, 0xfd, 0xb3, 0xb8, 0x8c, 0x67, 0xaf, 0x8c, 0xe7, 0x2c, 0x7e, 0x13, 0x8e, 0x0b,
+	0xd8, 0x2c, 0xdf, 0x37, 0xc8, 0xa3, 0x32, 0x92, 0x1b, 0x77, 0x93, 0x59, 0x5c, 0x3e, 0xac, 0x9f,
+	0xa0, 0xd2, 0xfd, 0xdf, 0x46, 0x25, 0x98, 0x27, 0xc9, 0x07, 0xd7, 0x35, 0x7c, 0x06, 0xc8, 0xff,
+	0xbc, 0x33, 0x13, 0x57, 0xdc, 0xd0, 0x53, 0x58, 0xce, 0x17, 0x18, 0xb2, 0x5d, 0x96, 0xc3, 0xd4,
+	0x7a, 0x33, 0x43, 0x75, 0xb3, 0x0f, 0x77, 0xdb, 0xfa, 0xbc, 0x83, 0x62, 0xc8, 0x3c, 0xfc, 0xf2,
+	0xa5, 0x22, 0x1d, 0xa8, 0x9e, 0xc5, 0x12, 0x85, 0x22, 0xe5, 0xa3, 0xa3, 0x15, 0xa5, 0x87, 0x37,
+	0xb7, 0x56, 0x3b, 0x49, 0x5f, 0x18, 0x8f, 0xcc, 0x80, 0x71, 0xe6, 0x0e, 0x3f, 0xfd, 0xae, 0xd9,
+	0x67, 0x6a, 0x90, 0xf4, 0x52, 0x09, 0x07, 0x06, 0xf5, 0x11, 0xe3, 0xd9, 0xd7, 0x41, 0xde, 0xbd,
+	0x07, 0xfa, 0x2f, 0x07, 0x3a, 0x97, 0xb8, 0xd7, 0xab, 0x6a, 0xf3, 0x93, 0xbf, 0x06, 0x00, 0x7e,
+	0x7f, 0xb6, 0x2f, 0x40, 0x0c, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -715,3 +1134,75 @@ var _Proxy_serviceDesc = grpc.ServiceDesc{
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "proxy.proto",
 }
+
+// MilvusServiceExtClient is the client API for MilvusServiceExt service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MilvusServiceExtClient interface {
+	Upsert(ctx context.Context, in *milvuspb.InsertRequest, opts ...grpc.CallOption) (*milvuspb.MutationResult, error)
+}
+
+type milvusServiceExtClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewMilvusServiceExtClient(cc *grpc.ClientConn) MilvusServiceExtClient {
+	return &milvusServiceExtClient{cc}
+}
+
+func (c *milvusServiceExtClient) Upsert(ctx context.Context, in *milvuspb.InsertRequest, opts ...grpc.CallOption) (*milvuspb.MutationResult, error) {
+	out := new(milvuspb.MutationResult)
+	err := c.cc.Invoke(ctx, "/milvus.proto.proxy.MilvusServiceExt/Upsert", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MilvusServiceExtServer is the server API for MilvusServiceExt service.
+type MilvusServiceExtServer interface {
+	Upsert(context.Context, *milvuspb.InsertRequest) (*milvuspb.MutationResult, error)
+}
+
+// UnimplementedMilvusServiceExtServer can be embedded to have forward compatible implementations.
+type UnimplementedMilvusServiceExtServer struct {
+}
+
+func (*UnimplementedMilvusServiceExtServer) Upsert(ctx context.Context, req *milvuspb.InsertRequest) (*milvuspb.MutationResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Upsert not implemented")
+}
+
+func RegisterMilvusServiceExtServer(s *grpc.Server, srv MilvusServiceExtServer) {
+	s.RegisterService(&_MilvusServiceExt_serviceDesc, srv)
+}
+
+func _MilvusServiceExt_Upsert_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(milvuspb.InsertRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MilvusServiceExtServer).Upsert(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.proxy.MilvusServiceExt/Upsert",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MilvusServiceExtServer).Upsert(ctx, req.(*milvuspb.InsertRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _MilvusServiceExt_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "milvus.proto.proxy.MilvusServiceExt",
+	HandlerType: (*MilvusServiceExtServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Upsert",
+			Handler:    _MilvusServiceExt_Upsert_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "proxy.proto",
+}
@@ -29,6 +29,71 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
 
+type ExportState int32
+
+const (
+	ExportState_ExportPending   ExportState = 0
+	ExportState_ExportFailed    ExportState = 1
+	ExportState_ExportStarted   ExportState = 2
+	ExportState_ExportCompleted ExportState = 3
+)
+
+var ExportState_name = map[int32]string{
+	0: "ExportPending",
+	1: "ExportFailed",
+	2: "ExportStarted",
+	3: "ExportCompleted",
+}
+
+var ExportState_value = map[string]int32{
+	"ExportPending":   0,
+	"ExportFailed":    1,
+	"ExportStarted":   2,
+	"ExportCompleted": 3,
+}
+
+func (x ExportState) String() string {
+	return proto.EnumName(ExportState_name, int32(x))
+}
+
+func (ExportState) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_4513485a144f6b06, []int{0}
+}
+
+type RestoreState int32
+
+const (
+	RestoreState_RestorePending            RestoreState = 0
+	RestoreState_RestoreFailed             RestoreState = 1
+	RestoreState_RestoreCreatingCollection RestoreState = 2
+	RestoreState_RestoringSegments         RestoreState = 3
+	RestoreState_RestoreCompleted          RestoreState = 4
+)
+
+var RestoreState_name = map[int32]string{
+	0: "RestorePending",
+	1: "RestoreFailed",
+	2: "RestoreCreatingCollection",
+	3: "RestoringSegments",
+	4: "RestoreCompleted",
+}
+
+var RestoreState_value = map[string]int32{
+	"RestorePending":            0,
+	"RestoreFailed":             1,
+	"RestoreCreatingCollection": 2,
+	"RestoringSegments":         3,
+	"RestoreCompleted":          4,
+}
+
+func (x RestoreState) String() string {
+	return proto.EnumName(RestoreState_name, int32(x))
+}
+
+func (RestoreState) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_4513485a144f6b06, []int{1}
+}
+
 type AllocTimestampRequest struct {
 	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
 	Count                uint32            `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
@@ -203,127 +268,1050 @@ func (m *AllocIDResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, err
 func (m *AllocIDResponse) XXX_Merge(src proto.Message) {
 	xxx_messageInfo_AllocIDResponse.Merge(m, src)
 }
-func (m *AllocIDResponse) XXX_Size() int {
-	return xxx_messageInfo_AllocIDResponse.Size(m)
+func (m *AllocIDResponse) XXX_Size() int {
+	return xxx_messageInfo_AllocIDResponse.Size(m)
+}
+func (m *AllocIDResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_AllocIDResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AllocIDResponse proto.InternalMessageInfo
+
+func (m *AllocIDResponse) GetStatus() *commonpb.Status {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+func (m *AllocIDResponse) GetID() int64 {
+	if m != nil {
+		return m.ID
+	}
+	return 0
+}
+
+func (m *AllocIDResponse) GetCount() uint32 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+type ImportResult struct {
+	Status               *commonpb.Status         `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	TaskId               int64                    `protobuf:"varint,2,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	DatanodeId           int64                    `protobuf:"varint,3,opt,name=datanode_id,json=datanodeId,proto3" json:"datanode_id,omitempty"`
+	State                commonpb.ImportState     `protobuf:"varint,4,opt,name=state,proto3,enum=milvus.proto.common.ImportState" json:"state,omitempty"`
+	Segments             []int64                  `protobuf:"varint,5,rep,packed,name=segments,proto3" json:"segments,omitempty"`
+	AutoIds              []int64                  `protobuf:"varint,6,rep,packed,name=auto_ids,json=autoIds,proto3" json:"auto_ids,omitempty"`
+	RowCount             int64                    `protobuf:"varint,7,opt,name=row_count,json=rowCount,proto3" json:"row_count,omitempty"`
+	Infos                []*commonpb.KeyValuePair `protobuf:"bytes,8,rep,name=infos,proto3" json:"infos,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
+	XXX_unrecognized     []byte                   `json:"-"`
+	XXX_sizecache        int32                    `json:"-"`
+}
+
+func (m *ImportResult) Reset()         { *m = ImportResult{} }
+func (m *ImportResult) String() string { return proto.CompactTextString(m) }
+func (*ImportResult) ProtoMessage()    {}
+func (*ImportResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4513485a144f6b06, []int{4}
+}
+
+func (m *ImportResult) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ImportResult.Unmarshal(m, b)
+}
+func (m *ImportResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ImportResult.Marshal(b, m, deterministic)
+}
+func (m *ImportResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ImportResult.Merge(m, src)
+}
+func (m *ImportResult) XXX_Size() int {
+	return xxx_messageInfo_ImportResult.Size(m)
+}
+func (m *ImportResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_ImportResult.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ImportResult proto.InternalMessageInfo
+
+func (m *ImportResult) GetStatus() *commonpb.Status {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+func (m *ImportResult) GetTaskId() int64 {
+	if m != nil {
+		return m.TaskId
+	}
+	return 0
+}
+
+func (m *ImportResult) GetDatanodeId() int64 {
+	if m != nil {
+		return m.DatanodeId
+	}
+	return 0
+}
+
+func (m *ImportResult) GetState() commonpb.ImportState {
+	if m != nil {
+		return m.State
+	}
+	return commonpb.ImportState_ImportPending
+}
+
+func (m *ImportResult) GetSegments() []int64 {
+	if m != nil {
+		return m.Segments
+	}
+	return nil
+}
+
+func (m *ImportResult) GetAutoIds() []int64 {
+	if m != nil {
+		return m.AutoIds
+	}
+	return nil
+}
+
+func (m *ImportResult) GetRowCount() int64 {
+	if m != nil {
+		return m.RowCount
+	}
+	return 0
+}
+
+func (m *ImportResult) GetInfos() []*commonpb.KeyValuePair {
+	if m != nil {
+		return m.Infos
+	}
+	return nil
+}
+
+type ExportRequest struct {
+	Base                 *commonpb.MsgBase        `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	CollectionName       string                   `protobuf:"bytes,2,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
+	PartitionNames       []string                 `protobuf:"bytes,3,rep,name=partition_names,json=partitionNames,proto3" json:"partition_names,omitempty"`
+	OutputPrefix         string                   `protobuf:"bytes,4,opt,name=output_prefix,json=outputPrefix,proto3" json:"output_prefix,omitempty"`
+	Format               string                   `protobuf:"bytes,5,opt,name=format,proto3" json:"format,omitempty"`
+	Timestamp            uint64                   `protobuf:"varint,6,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Options              []*commonpb.KeyValuePair `protobuf:"bytes,7,rep,name=options,proto3" json:"options,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
+	XXX_unrecognized     []byte                   `json:"-"`
+	XXX_sizecache        int32                    `json:"-"`
+}
+
+func (m *ExportRequest) Reset()         { *m = ExportRequest{} }
+func (m *ExportRequest) String() string { return proto.CompactTextString(m) }
+func (*ExportRequest) ProtoMessage()    {}
+func (*ExportRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4513485a144f6b06, []int{5}
+}
+
+func (m *ExportRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ExportRequest.Unmarshal(m, b)
+}
+func (m *ExportRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ExportRequest.Marshal(b, m, deterministic)
+}
+func (m *ExportRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExportRequest.Merge(m, src)
+}
+func (m *ExportRequest) XXX_Size() int {
+	return xxx_messageInfo_ExportRequest.Size(m)
+}
+func (m *ExportRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExportRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExportRequest proto.InternalMessageInfo
+
+func (m *ExportRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *ExportRequest) GetCollectionName() string {
+	if m != nil {
+		return m.CollectionName
+	}
+	return ""
+}
+
+func (m *ExportRequest) GetPartitionNames() []string {
+	if m != nil {
+		return m.PartitionNames
+	}
+	return nil
+}
+
+func (m *ExportRequest) GetOutputPrefix() string {
+	if m != nil {
+		return m.OutputPrefix
+	}
+	return ""
+}
+
+func (m *ExportRequest) GetFormat() string {
+	if m != nil {
+		return m.Format
+	}
+	return ""
+}
+
+func (m *ExportRequest) GetTimestamp() uint64 {
+	if m != nil {
+		return m.Timestamp
+	}
+	return 0
+}
+
+func (m *ExportRequest) GetOptions() []*commonpb.KeyValuePair {
+	if m != nil {
+		return m.Options
+	}
+	return nil
+}
+
+type ExportResponse struct {
+	Status               *commonpb.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	TaskId               int64            `protobuf:"varint,2,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	Timestamp            uint64           `protobuf:"varint,3,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *ExportResponse) Reset()         { *m = ExportResponse{} }
+func (m *ExportResponse) String() string { return proto.CompactTextString(m) }
+func (*ExportResponse) ProtoMessage()    {}
+func (*ExportResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4513485a144f6b06, []int{6}
+}
+
+func (m *ExportResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ExportResponse.Unmarshal(m, b)
+}
+func (m *ExportResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ExportResponse.Marshal(b, m, deterministic)
+}
+func (m *ExportResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExportResponse.Merge(m, src)
+}
+func (m *ExportResponse) XXX_Size() int {
+	return xxx_messageInfo_ExportResponse.Size(m)
+}
+func (m *ExportResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExportResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExportResponse proto.InternalMessageInfo
+
+func (m *ExportResponse) GetStatus() *commonpb.Status {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+func (m *ExportResponse) GetTaskId() int64 {
+	if m != nil {
+		return m.TaskId
+	}
+	return 0
+}
+
+func (m *ExportResponse) GetTimestamp() uint64 {
+	if m != nil {
+		return m.Timestamp
+	}
+	return 0
+}
+
+type ExportTaskInfo struct {
+	Id                   int64                    `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	DatanodeId           int64                    `protobuf:"varint,2,opt,name=datanode_id,json=datanodeId,proto3" json:"datanode_id,omitempty"`
+	CollectionId         int64                    `protobuf:"varint,3,opt,name=collection_id,json=collectionId,proto3" json:"collection_id,omitempty"`
+	CollectionName       string                   `protobuf:"bytes,4,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
+	PartitionIds         []int64                  `protobuf:"varint,5,rep,packed,name=partition_ids,json=partitionIds,proto3" json:"partition_ids,omitempty"`
+	PartitionNames       []string                 `protobuf:"bytes,6,rep,name=partition_names,json=partitionNames,proto3" json:"partition_names,omitempty"`
+	Timestamp            uint64                   `protobuf:"varint,7,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	OutputPrefix         string                   `protobuf:"bytes,8,opt,name=output_prefix,json=outputPrefix,proto3" json:"output_prefix,omitempty"`
+	Format               string                   `protobuf:"bytes,9,opt,name=format,proto3" json:"format,omitempty"`
+	CreateTs             int64                    `protobuf:"varint,10,opt,name=create_ts,json=createTs,proto3" json:"create_ts,omitempty"`
+	StartTs              int64                    `protobuf:"varint,11,opt,name=start_ts,json=startTs,proto3" json:"start_ts,omitempty"`
+	State                ExportState              `protobuf:"varint,12,opt,name=state,proto3,enum=milvus.proto.rootcoord.ExportState" json:"state,omitempty"`
+	Files                []string                 `protobuf:"bytes,13,rep,name=files,proto3" json:"files,omitempty"`
+	RowCount             int64                    `protobuf:"varint,14,opt,name=row_count,json=rowCount,proto3" json:"row_count,omitempty"`
+	ErrorMessage         string                   `protobuf:"bytes,15,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
+	Infos                []*commonpb.KeyValuePair `protobuf:"bytes,16,rep,name=infos,proto3" json:"infos,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
+	XXX_unrecognized     []byte                   `json:"-"`
+	XXX_sizecache        int32                    `json:"-"`
+}
+
+func (m *ExportTaskInfo) Reset()         { *m = ExportTaskInfo{} }
+func (m *ExportTaskInfo) String() string { return proto.CompactTextString(m) }
+func (*ExportTaskInfo) ProtoMessage()    {}
+func (*ExportTaskInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4513485a144f6b06, []int{7}
+}
+
+func (m *ExportTaskInfo) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ExportTaskInfo.Unmarshal(m, b)
+}
+func (m *ExportTaskInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ExportTaskInfo.Marshal(b, m, deterministic)
+}
+func (m *ExportTaskInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExportTaskInfo.Merge(m, src)
+}
+func (m *ExportTaskInfo) XXX_Size() int {
+	return xxx_messageInfo_ExportTaskInfo.Size(m)
+}
+func (m *ExportTaskInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExportTaskInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExportTaskInfo proto.InternalMessageInfo
+
+func (m *ExportTaskInfo) GetId() int64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *ExportTaskInfo) GetDatanodeId() int64 {
+	if m != nil {
+		return m.DatanodeId
+	}
+	return 0
+}
+
+func (m *ExportTaskInfo) GetCollectionId() int64 {
+	if m != nil {
+		return m.CollectionId
+	}
+	return 0
+}
+
+func (m *ExportTaskInfo) GetCollectionName() string {
+	if m != nil {
+		return m.CollectionName
+	}
+	return ""
+}
+
+func (m *ExportTaskInfo) GetPartitionIds() []int64 {
+	if m != nil {
+		return m.PartitionIds
+	}
+	return nil
+}
+
+func (m *ExportTaskInfo) GetPartitionNames() []string {
+	if m != nil {
+		return m.PartitionNames
+	}
+	return nil
+}
+
+func (m *ExportTaskInfo) GetTimestamp() uint64 {
+	if m != nil {
+		return m.Timestamp
+	}
+	return 0
+}
+
+func (m *ExportTaskInfo) GetOutputPrefix() string {
+	if m != nil {
+		return m.OutputPrefix
+	}
+	return ""
+}
+
+func (m *ExportTaskInfo) GetFormat() string {
+	if m != nil {
+		return m.Format
+	}
+	return ""
+}
+
+func (m *ExportTaskInfo) GetCreateTs() int64 {
+	if m != nil {
+		return m.CreateTs
+	}
+	return 0
+}
+
+func (m *ExportTaskInfo) GetStartTs() int64 {
+	if m != nil {
+		return m.StartTs
+	}
+	return 0
+}
+
+func (m *ExportTaskInfo) GetState() ExportState {
+	if m != nil {
+		return m.State
+	}
+	return ExportState_ExportPending
+}
+
+func (m *ExportTaskInfo) GetFiles() []string {
+	if m != nil {
+		return m.Files
+	}
+	return nil
+}
+
+func (m *ExportTaskInfo) GetRowCount() int64 {
+	if m != nil {
+		return m.RowCount
+	}
+	return 0
+}
+
+func (m *ExportTaskInfo) GetErrorMessage() string {
+	if m != nil {
+		return m.ErrorMessage
+	}
+	return ""
+}
+
+func (m *ExportTaskInfo) GetInfos() []*commonpb.KeyValuePair {
+	if m != nil {
+		return m.Infos
+	}
+	return nil
+}
+
+type GetExportStateRequest struct {
+	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	TaskId               int64             `protobuf:"varint,2,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *GetExportStateRequest) Reset()         { *m = GetExportStateRequest{} }
+func (m *GetExportStateRequest) String() string { return proto.CompactTextString(m) }
+func (*GetExportStateRequest) ProtoMessage()    {}
+func (*GetExportStateRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4513485a144f6b06, []int{8}
+}
+
+func (m *GetExportStateRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetExportStateRequest.Unmarshal(m, b)
+}
+func (m *GetExportStateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetExportStateRequest.Marshal(b, m, deterministic)
+}
+func (m *GetExportStateRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetExportStateRequest.Merge(m, src)
+}
+func (m *GetExportStateRequest) XXX_Size() int {
+	return xxx_messageInfo_GetExportStateRequest.Size(m)
+}
+func (m *GetExportStateRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetExportStateRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetExportStateRequest proto.InternalMessageInfo
+
+func (m *GetExportStateRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *GetExportStateRequest) GetTaskId() int64 {
+	if m != nil {
+		return m.TaskId
+	}
+	return 0
+}
+
+type GetExportStateResponse struct {
+	Status               *commonpb.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Task                 *ExportTaskInfo  `protobuf:"bytes,2,opt,name=task,proto3" json:"task,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *GetExportStateResponse) Reset()         { *m = GetExportStateResponse{} }
+func (m *GetExportStateResponse) String() string { return proto.CompactTextString(m) }
+func (*GetExportStateResponse) ProtoMessage()    {}
+func (*GetExportStateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4513485a144f6b06, []int{9}
+}
+
+func (m *GetExportStateResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetExportStateResponse.Unmarshal(m, b)
+}
+func (m *GetExportStateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetExportStateResponse.Marshal(b, m, deterministic)
+}
+func (m *GetExportStateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetExportStateResponse.Merge(m, src)
+}
+func (m *GetExportStateResponse) XXX_Size() int {
+	return xxx_messageInfo_GetExportStateResponse.Size(m)
+}
+func (m *GetExportStateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetExportStateResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetExportStateResponse proto.InternalMessageInfo
+
+func (m *GetExportStateResponse) GetStatus() *commonpb.Status {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+func (m *GetExportStateResponse) GetTask() *ExportTaskInfo {
+	if m != nil {
+		return m.Task
+	}
+	return nil
+}
+
+type ListExportTasksRequest struct {
+	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	CollectionName       string            `protobuf:"bytes,2,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
+	Limit                int64             `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *ListExportTasksRequest) Reset()         { *m = ListExportTasksRequest{} }
+func (m *ListExportTasksRequest) String() string { return proto.CompactTextString(m) }
+func (*ListExportTasksRequest) ProtoMessage()    {}
+func (*ListExportTasksRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4513485a144f6b06, []int{10}
+}
+
+func (m *ListExportTasksRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListExportTasksRequest.Unmarshal(m, b)
+}
+func (m *ListExportTasksRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListExportTasksRequest.Marshal(b, m, deterministic)
+}
+func (m *ListExportTasksRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListExportTasksRequest.Merge(m, src)
+}
+func (m *ListExportTasksRequest) XXX_Size() int {
+	return xxx_messageInfo_ListExportTasksRequest.Size(m)
+}
+func (m *ListExportTasksRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListExportTasksRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListExportTasksRequest proto.InternalMessageInfo
+
+func (m *ListExportTasksRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *ListExportTasksRequest) GetCollectionName() string {
+	if m != nil {
+		return m.CollectionName
+	}
+	return ""
+}
+
+func (m *ListExportTasksRequest) GetLimit() int64 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+type ListExportTasksResponse struct {
+	Status               *commonpb.Status  `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Tasks                []*ExportTaskInfo `protobuf:"bytes,2,rep,name=tasks,proto3" json:"tasks,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *ListExportTasksResponse) Reset()         { *m = ListExportTasksResponse{} }
+func (m *ListExportTasksResponse) String() string { return proto.CompactTextString(m) }
+func (*ListExportTasksResponse) ProtoMessage()    {}
+func (*ListExportTasksResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4513485a144f6b06, []int{11}
+}
+
+func (m *ListExportTasksResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListExportTasksResponse.Unmarshal(m, b)
+}
+func (m *ListExportTasksResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListExportTasksResponse.Marshal(b, m, deterministic)
+}
+func (m *ListExportTasksResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListExportTasksResponse.Merge(m, src)
+}
+func (m *ListExportTasksResponse) XXX_Size() int {
+	return xxx_messageInfo_ListExportTasksResponse.Size(m)
+}
+func (m *ListExportTasksResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListExportTasksResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListExportTasksResponse proto.InternalMessageInfo
+
+func (m *ListExportTasksResponse) GetStatus() *commonpb.Status {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+func (m *ListExportTasksResponse) GetTasks() []*ExportTaskInfo {
+	if m != nil {
+		return m.Tasks
+	}
+	return nil
+}
+
+type ExportResult struct {
+	Status               *commonpb.Status         `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	TaskId               int64                    `protobuf:"varint,2,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	DatanodeId           int64                    `protobuf:"varint,3,opt,name=datanode_id,json=datanodeId,proto3" json:"datanode_id,omitempty"`
+	State                ExportState              `protobuf:"varint,4,opt,name=state,proto3,enum=milvus.proto.rootcoord.ExportState" json:"state,omitempty"`
+	Files                []string                 `protobuf:"bytes,5,rep,name=files,proto3" json:"files,omitempty"`
+	RowCount             int64                    `protobuf:"varint,6,opt,name=row_count,json=rowCount,proto3" json:"row_count,omitempty"`
+	Infos                []*commonpb.KeyValuePair `protobuf:"bytes,7,rep,name=infos,proto3" json:"infos,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
+	XXX_unrecognized     []byte                   `json:"-"`
+	XXX_sizecache        int32                    `json:"-"`
+}
+
+func (m *ExportResult) Reset()         { *m = ExportResult{} }
+func (m *ExportResult) String() string { return proto.CompactTextString(m) }
+func (*ExportResult) ProtoMessage()    {}
+func (*ExportResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4513485a144f6b06, []int{12}
+}
+
+func (m *ExportResult) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ExportResult.Unmarshal(m, b)
+}
+func (m *ExportResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ExportResult.Marshal(b, m, deterministic)
+}
+func (m *ExportResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExportResult.Merge(m, src)
+}
+func (m *ExportResult) XXX_Size() int {
+	return xxx_messageInfo_ExportResult.Size(m)
+}
+func (m *ExportResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExportResult.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExportResult proto.InternalMessageInfo
+
+func (m *ExportResult) GetStatus() *commonpb.Status {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+func (m *ExportResult) GetTaskId() int64 {
+	if m != nil {
+		return m.TaskId
+	}
+	return 0
+}
+
+func (m *ExportResult) GetDatanodeId() int64 {
+	if m != nil {
+		return m.DatanodeId
+	}
+	return 0
+}
+
+func (m *ExportResult) GetState() ExportState {
+	if m != nil {
+		return m.State
+	}
+	return ExportState_ExportPending
+}
+
+func (m *ExportResult) GetFiles() []string {
+	if m != nil {
+		return m.Files
+	}
+	return nil
+}
+
+func (m *ExportResult) GetRowCount() int64 {
+	if m != nil {
+		return m.RowCount
+	}
+	return 0
+}
+
+func (m *ExportResult) GetInfos() []*commonpb.KeyValuePair {
+	if m != nil {
+		return m.Infos
+	}
+	return nil
+}
+
+type RestoreCollectionRequest struct {
+	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	CollectionName       string            `protobuf:"bytes,2,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
+	Timestamp            uint64            `protobuf:"varint,3,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	NewCollectionName    string            `protobuf:"bytes,4,opt,name=new_collection_name,json=newCollectionName,proto3" json:"new_collection_name,omitempty"`
+	CollectionID         int64             `protobuf:"varint,5,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *RestoreCollectionRequest) Reset()         { *m = RestoreCollectionRequest{} }
+func (m *RestoreCollectionRequest) String() string { return proto.CompactTextString(m) }
+func (*RestoreCollectionRequest) ProtoMessage()    {}
+func (*RestoreCollectionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4513485a144f6b06, []int{13}
+}
+
+func (m *RestoreCollectionRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RestoreCollectionRequest.Unmarshal(m, b)
+}
+func (m *RestoreCollectionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RestoreCollectionRequest.Marshal(b, m, deterministic)
+}
+func (m *RestoreCollectionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RestoreCollectionRequest.Merge(m, src)
+}
+func (m *RestoreCollectionRequest) XXX_Size() int {
+	return xxx_messageInfo_RestoreCollectionRequest.Size(m)
+}
+func (m *RestoreCollectionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RestoreCollectionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RestoreCollectionRequest proto.InternalMessageInfo
+
+func (m *RestoreCollectionRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *RestoreCollectionRequest) GetCollectionName() string {
+	if m != nil {
+		return m.CollectionName
+	}
+	return ""
+}
+
+func (m *RestoreCollectionRequest) GetTimestamp() uint64 {
+	if m != nil {
+		return m.Timestamp
+	}
+	return 0
+}
+
+func (m *RestoreCollectionRequest) GetNewCollectionName() string {
+	if m != nil {
+		return m.NewCollectionName
+	}
+	return ""
+}
+
+func (m *RestoreCollectionRequest) GetCollectionID() int64 {
+	if m != nil {
+		return m.CollectionID
+	}
+	return 0
+}
+
+type RestoreCollectionResponse struct {
+	Status               *commonpb.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	TaskId               int64            `protobuf:"varint,2,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *RestoreCollectionResponse) Reset()         { *m = RestoreCollectionResponse{} }
+func (m *RestoreCollectionResponse) String() string { return proto.CompactTextString(m) }
+func (*RestoreCollectionResponse) ProtoMessage()    {}
+func (*RestoreCollectionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4513485a144f6b06, []int{14}
+}
+
+func (m *RestoreCollectionResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RestoreCollectionResponse.Unmarshal(m, b)
+}
+func (m *RestoreCollectionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RestoreCollectionResponse.Marshal(b, m, deterministic)
+}
+func (m *RestoreCollectionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RestoreCollectionResponse.Merge(m, src)
+}
+func (m *RestoreCollectionResponse) XXX_Size() int {
+	return xxx_messageInfo_RestoreCollectionResponse.Size(m)
+}
+func (m *RestoreCollectionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RestoreCollectionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RestoreCollectionResponse proto.InternalMessageInfo
+
+func (m *RestoreCollectionResponse) GetStatus() *commonpb.Status {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+func (m *RestoreCollectionResponse) GetTaskId() int64 {
+	if m != nil {
+		return m.TaskId
+	}
+	return 0
+}
+
+type RestoreTaskInfo struct {
+	Id                   int64        `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	SourceCollectionId   int64        `protobuf:"varint,2,opt,name=source_collection_id,json=sourceCollectionId,proto3" json:"source_collection_id,omitempty"`
+	SourceCollectionName string       `protobuf:"bytes,3,opt,name=source_collection_name,json=sourceCollectionName,proto3" json:"source_collection_name,omitempty"`
+	Timestamp            uint64       `protobuf:"varint,4,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	NewCollectionName    string       `protobuf:"bytes,5,opt,name=new_collection_name,json=newCollectionName,proto3" json:"new_collection_name,omitempty"`
+	CollectionId         int64        `protobuf:"varint,6,opt,name=collection_id,json=collectionId,proto3" json:"collection_id,omitempty"`
+	CreateTs             int64        `protobuf:"varint,7,opt,name=create_ts,json=createTs,proto3" json:"create_ts,omitempty"`
+	StartTs              int64        `protobuf:"varint,8,opt,name=start_ts,json=startTs,proto3" json:"start_ts,omitempty"`
+	State                RestoreState `protobuf:"varint,9,opt,name=state,proto3,enum=milvus.proto.rootcoord.RestoreState" json:"state,omitempty"`
+	SegmentIds           []int64      `protobuf:"varint,10,rep,packed,name=segment_ids,json=segmentIds,proto3" json:"segment_ids,omitempty"`
+	RowCount             int64        `protobuf:"varint,11,opt,name=row_count,json=rowCount,proto3" json:"row_count,omitempty"`
+	ErrorMessage         string       `protobuf:"bytes,12,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *RestoreTaskInfo) Reset()         { *m = RestoreTaskInfo{} }
+func (m *RestoreTaskInfo) String() string { return proto.CompactTextString(m) }
+func (*RestoreTaskInfo) ProtoMessage()    {}
+func (*RestoreTaskInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4513485a144f6b06, []int{15}
+}
+
+func (m *RestoreTaskInfo) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RestoreTaskInfo.Unmarshal(m, b)
+}
+func (m *RestoreTaskInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RestoreTaskInfo.Marshal(b, m, deterministic)
+}
+func (m *RestoreTaskInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RestoreTaskInfo.Merge(m, src)
+}
+func (m *RestoreTaskInfo) XXX_Size() int {
+	return xxx_messageInfo_RestoreTaskInfo.Size(m)
+}
+func (m *RestoreTaskInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_RestoreTaskInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RestoreTaskInfo proto.InternalMessageInfo
+
+func (m *RestoreTaskInfo) GetId() int64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *RestoreTaskInfo) GetSourceCollectionId() int64 {
+	if m != nil {
+		return m.SourceCollectionId
+	}
+	return 0
+}
+
+func (m *RestoreTaskInfo) GetSourceCollectionName() string {
+	if m != nil {
+		return m.SourceCollectionName
+	}
+	return ""
+}
+
+func (m *RestoreTaskInfo) GetTimestamp() uint64 {
+	if m != nil {
+		return m.Timestamp
+	}
+	return 0
+}
+
+func (m *RestoreTaskInfo) GetNewCollectionName() string {
+	if m != nil {
+		return m.NewCollectionName
+	}
+	return ""
+}
+
+func (m *RestoreTaskInfo) GetCollectionId() int64 {
+	if m != nil {
+		return m.CollectionId
+	}
+	return 0
+}
+
+func (m *RestoreTaskInfo) GetCreateTs() int64 {
+	if m != nil {
+		return m.CreateTs
+	}
+	return 0
 }
-func (m *AllocIDResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_AllocIDResponse.DiscardUnknown(m)
+
+func (m *RestoreTaskInfo) GetStartTs() int64 {
+	if m != nil {
+		return m.StartTs
+	}
+	return 0
 }
 
-var xxx_messageInfo_AllocIDResponse proto.InternalMessageInfo
+func (m *RestoreTaskInfo) GetState() RestoreState {
+	if m != nil {
+		return m.State
+	}
+	return RestoreState_RestorePending
+}
 
-func (m *AllocIDResponse) GetStatus() *commonpb.Status {
+func (m *RestoreTaskInfo) GetSegmentIds() []int64 {
 	if m != nil {
-		return m.Status
+		return m.SegmentIds
 	}
 	return nil
 }
 
-func (m *AllocIDResponse) GetID() int64 {
+func (m *RestoreTaskInfo) GetRowCount() int64 {
 	if m != nil {
-		return m.ID
+		return m.RowCount
 	}
 	return 0
 }
 
-func (m *AllocIDResponse) GetCount() uint32 {
+func (m *RestoreTaskInfo) GetErrorMessage() string {
 	if m != nil {
-		return m.Count
+		return m.ErrorMessage
 	}
-	return 0
+	return ""
 }
 
-type ImportResult struct {
-	Status               *commonpb.Status         `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
-	TaskId               int64                    `protobuf:"varint,2,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
-	DatanodeId           int64                    `protobuf:"varint,3,opt,name=datanode_id,json=datanodeId,proto3" json:"datanode_id,omitempty"`
-	State                commonpb.ImportState     `protobuf:"varint,4,opt,name=state,proto3,enum=milvus.proto.common.ImportState" json:"state,omitempty"`
-	Segments             []int64                  `protobuf:"varint,5,rep,packed,name=segments,proto3" json:"segments,omitempty"`
-	AutoIds              []int64                  `protobuf:"varint,6,rep,packed,name=auto_ids,json=autoIds,proto3" json:"auto_ids,omitempty"`
-	RowCount             int64                    `protobuf:"varint,7,opt,name=row_count,json=rowCount,proto3" json:"row_count,omitempty"`
-	Infos                []*commonpb.KeyValuePair `protobuf:"bytes,8,rep,name=infos,proto3" json:"infos,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
-	XXX_unrecognized     []byte                   `json:"-"`
-	XXX_sizecache        int32                    `json:"-"`
+type GetRestoreStateRequest struct {
+	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	TaskId               int64             `protobuf:"varint,2,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
 }
 
-func (m *ImportResult) Reset()         { *m = ImportResult{} }
-func (m *ImportResult) String() string { return proto.CompactTextString(m) }
-func (*ImportResult) ProtoMessage()    {}
-func (*ImportResult) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4513485a144f6b06, []int{4}
+func (m *GetRestoreStateRequest) Reset()         { *m = GetRestoreStateRequest{} }
+func (m *GetRestoreStateRequest) String() string { return proto.CompactTextString(m) }
+func (*GetRestoreStateRequest) ProtoMessage()    {}
+func (*GetRestoreStateRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4513485a144f6b06, []int{16}
 }
 
-func (m *ImportResult) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ImportResult.Unmarshal(m, b)
+func (m *GetRestoreStateRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetRestoreStateRequest.Unmarshal(m, b)
 }
-func (m *ImportResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ImportResult.Marshal(b, m, deterministic)
+func (m *GetRestoreStateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetRestoreStateRequest.Marshal(b, m, deterministic)
 }
-func (m *ImportResult) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ImportResult.Merge(m, src)
+func (m *GetRestoreStateRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetRestoreStateRequest.Merge(m, src)
 }
-func (m *ImportResult) XXX_Size() int {
-	return xxx_messageInfo_ImportResult.Size(m)
+func (m *GetRestoreStateRequest) XXX_Size() int {
+	return xxx_messageInfo_GetRestoreStateRequest.Size(m)
 }
-func (m *ImportResult) XXX_DiscardUnknown() {
-	xxx_messageInfo_ImportResult.DiscardUnknown(m)
+func (m *GetRestoreStateRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetRestoreStateRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ImportResult proto.InternalMessageInfo
+var xxx_messageInfo_GetRestoreStateRequest proto.InternalMessageInfo
 
-func (m *ImportResult) GetStatus() *commonpb.Status {
+func (m *GetRestoreStateRequest) GetBase() *commonpb.MsgBase {
 	if m != nil {
-		return m.Status
+		return m.Base
 	}
 	return nil
 }
 
-func (m *ImportResult) GetTaskId() int64 {
+func (m *GetRestoreStateRequest) GetTaskId() int64 {
 	if m != nil {
 		return m.TaskId
 	}
 	return 0
 }
 
-func (m *ImportResult) GetDatanodeId() int64 {
-	if m != nil {
-		return m.DatanodeId
-	}
-	return 0
+type GetRestoreStateResponse struct {
+	Status               *commonpb.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Task                 *RestoreTaskInfo `protobuf:"bytes,2,opt,name=task,proto3" json:"task,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
 }
 
-func (m *ImportResult) GetState() commonpb.ImportState {
-	if m != nil {
-		return m.State
-	}
-	return commonpb.ImportState_ImportPending
+func (m *GetRestoreStateResponse) Reset()         { *m = GetRestoreStateResponse{} }
+func (m *GetRestoreStateResponse) String() string { return proto.CompactTextString(m) }
+func (*GetRestoreStateResponse) ProtoMessage()    {}
+func (*GetRestoreStateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4513485a144f6b06, []int{17}
 }
 
-func (m *ImportResult) GetSegments() []int64 {
-	if m != nil {
-		return m.Segments
-	}
-	return nil
+func (m *GetRestoreStateResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetRestoreStateResponse.Unmarshal(m, b)
 }
-
-func (m *ImportResult) GetAutoIds() []int64 {
-	if m != nil {
-		return m.AutoIds
-	}
-	return nil
+func (m *GetRestoreStateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetRestoreStateResponse.Marshal(b, m, deterministic)
+}
+func (m *GetRestoreStateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetRestoreStateResponse.Merge(m, src)
+}
+func (m *GetRestoreStateResponse) XXX_Size() int {
+	return xxx_messageInfo_GetRestoreStateResponse.Size(m)
+}
+func (m *GetRestoreStateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetRestoreStateResponse.DiscardUnknown(m)
 }
 
-func (m *ImportResult) GetRowCount() int64 {
+var xxx_messageInfo_GetRestoreStateResponse proto.InternalMessageInfo
+
+func (m *GetRestoreStateResponse) GetStatus() *commonpb.Status {
 	if m != nil {
-		return m.RowCount
+		return m.Status
 	}
-	return 0
+	return nil
 }
 
-func (m *ImportResult) GetInfos() []*commonpb.KeyValuePair {
+func (m *GetRestoreStateResponse) GetTask() *RestoreTaskInfo {
 	if m != nil {
-		return m.Infos
+		return m.Task
 	}
 	return nil
 }
@@ -341,7 +1329,7 @@ func (m *DescribeSegmentsRequest) Reset()         { *m = DescribeSegmentsRequest
 func (m *DescribeSegmentsRequest) String() string { return proto.CompactTextString(m) }
 func (*DescribeSegmentsRequest) ProtoMessage()    {}
 func (*DescribeSegmentsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4513485a144f6b06, []int{5}
+	return fileDescriptor_4513485a144f6b06, []int{18}
 }
 
 func (m *DescribeSegmentsRequest) XXX_Unmarshal(b []byte) error {
@@ -396,7 +1384,7 @@ func (m *SegmentBaseInfo) Reset()         { *m = SegmentBaseInfo{} }
 func (m *SegmentBaseInfo) String() string { return proto.CompactTextString(m) }
 func (*SegmentBaseInfo) ProtoMessage()    {}
 func (*SegmentBaseInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4513485a144f6b06, []int{6}
+	return fileDescriptor_4513485a144f6b06, []int{19}
 }
 
 func (m *SegmentBaseInfo) XXX_Unmarshal(b []byte) error {
@@ -451,7 +1439,7 @@ func (m *SegmentInfos) Reset()         { *m = SegmentInfos{} }
 func (m *SegmentInfos) String() string { return proto.CompactTextString(m) }
 func (*SegmentInfos) ProtoMessage()    {}
 func (*SegmentInfos) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4513485a144f6b06, []int{7}
+	return fileDescriptor_4513485a144f6b06, []int{20}
 }
 
 func (m *SegmentInfos) XXX_Unmarshal(b []byte) error {
@@ -506,7 +1494,7 @@ func (m *DescribeSegmentsResponse) Reset()         { *m = DescribeSegmentsRespon
 func (m *DescribeSegmentsResponse) String() string { return proto.CompactTextString(m) }
 func (*DescribeSegmentsResponse) ProtoMessage()    {}
 func (*DescribeSegmentsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4513485a144f6b06, []int{8}
+	return fileDescriptor_4513485a144f6b06, []int{21}
 }
 
 func (m *DescribeSegmentsResponse) XXX_Unmarshal(b []byte) error {
@@ -562,7 +1550,7 @@ func (m *GetCredentialRequest) Reset()         { *m = GetCredentialRequest{} }
 func (m *GetCredentialRequest) String() string { return proto.CompactTextString(m) }
 func (*GetCredentialRequest) ProtoMessage()    {}
 func (*GetCredentialRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4513485a144f6b06, []int{9}
+	return fileDescriptor_4513485a144f6b06, []int{22}
 }
 
 func (m *GetCredentialRequest) XXX_Unmarshal(b []byte) error {
@@ -613,7 +1601,7 @@ func (m *GetCredentialResponse) Reset()         { *m = GetCredentialResponse{} }
 func (m *GetCredentialResponse) String() string { return proto.CompactTextString(m) }
 func (*GetCredentialResponse) ProtoMessage()    {}
 func (*GetCredentialResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4513485a144f6b06, []int{10}
+	return fileDescriptor_4513485a144f6b06, []int{23}
 }
 
 func (m *GetCredentialResponse) XXX_Unmarshal(b []byte) error {
@@ -656,11 +1644,26 @@ func (m *GetCredentialResponse) GetPassword() string {
 }
 
 func init() {
+	proto.RegisterEnum("milvus.proto.rootcoord.ExportState", ExportState_name, ExportState_value)
+	proto.RegisterEnum("milvus.proto.rootcoord.RestoreState", RestoreState_name, RestoreState_value)
 	proto.RegisterType((*AllocTimestampRequest)(nil), "milvus.proto.rootcoord.AllocTimestampRequest")
 	proto.RegisterType((*AllocTimestampResponse)(nil), "milvus.proto.rootcoord.AllocTimestampResponse")
 	proto.RegisterType((*AllocIDRequest)(nil), "milvus.proto.rootcoord.AllocIDRequest")
 	proto.RegisterType((*AllocIDResponse)(nil), "milvus.proto.rootcoord.AllocIDResponse")
 	proto.RegisterType((*ImportResult)(nil), "milvus.proto.rootcoord.ImportResult")
+	proto.RegisterType((*ExportRequest)(nil), "milvus.proto.rootcoord.ExportRequest")
+	proto.RegisterType((*ExportResponse)(nil), "milvus.proto.rootcoord.ExportResponse")
+	proto.RegisterType((*ExportTaskInfo)(nil), "milvus.proto.rootcoord.ExportTaskInfo")
+	proto.RegisterType((*GetExportStateRequest)(nil), "milvus.proto.rootcoord.GetExportStateRequest")
+	proto.RegisterType((*GetExportStateResponse)(nil), "milvus.proto.rootcoord.GetExportStateResponse")
+	proto.RegisterType((*ListExportTasksRequest)(nil), "milvus.proto.rootcoord.ListExportTasksRequest")
+	proto.RegisterType((*ListExportTasksResponse)(nil), "milvus.proto.rootcoord.ListExportTasksResponse")
+	proto.RegisterType((*ExportResult)(nil), "milvus.proto.rootcoord.ExportResult")
+	proto.RegisterType((*RestoreCollectionRequest)(nil), "milvus.proto.rootcoord.RestoreCollectionRequest")
+	proto.RegisterType((*RestoreCollectionResponse)(nil), "milvus.proto.rootcoord.RestoreCollectionResponse")
+	proto.RegisterType((*RestoreTaskInfo)(nil), "milvus.proto.rootcoord.RestoreTaskInfo")
+	proto.RegisterType((*GetRestoreStateRequest)(nil), "milvus.proto.rootcoord.GetRestoreStateRequest")
+	proto.RegisterType((*GetRestoreStateResponse)(nil), "milvus.proto.rootcoord.GetRestoreStateResponse")
 	proto.RegisterType((*DescribeSegmentsRequest)(nil), "milvus.proto.rootcoord.DescribeSegmentsRequest")
 	proto.RegisterType((*SegmentBaseInfo)(nil), "milvus.proto.rootcoord.SegmentBaseInfo")
 	proto.RegisterType((*SegmentInfos)(nil), "milvus.proto.rootcoord.SegmentInfos")
@@ -674,103 +1677,151 @@ func init() {
 func init() { proto.RegisterFile("root_coord.proto", fileDescriptor_4513485a144f6b06) }
 
 var fileDescriptor_4513485a144f6b06 = []byte{
-	// 1535 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x58, 0x6b, 0x93, 0xda, 0x36,
-	0x17, 0x0e, 0x90, 0xbd, 0x70, 0x60, 0x61, 0xa3, 0xc9, 0x85, 0x97, 0xe4, 0x7d, 0x43, 0x48, 0xde,
-	0x86, 0xdc, 0xd8, 0x74, 0x33, 0x93, 0xa6, 0xf9, 0x96, 0x85, 0xcc, 0x86, 0x69, 0x77, 0xb2, 0x35,
-	0x49, 0x27, 0xbd, 0xec, 0x50, 0x61, 0x2b, 0xe0, 0x59, 0x63, 0x11, 0x49, 0xec, 0x65, 0xfa, 0xa9,
-	0x33, 0xfd, 0xde, 0xff, 0xd4, 0xfe, 0x94, 0xfe, 0x90, 0x76, 0x64, 0xd9, 0xc2, 0x36, 0x36, 0xeb,
-	0x4d, 0xf2, 0x0d, 0x49, 0x8f, 0x9f, 0xe7, 0xe8, 0x1c, 0x9d, 0x73, 0x24, 0x60, 0x93, 0x51, 0x2a,
-	0x06, 0x26, 0xa5, 0xcc, 0x6a, 0x4f, 0x19, 0x15, 0x14, 0x5d, 0x9d, 0xd8, 0xce, 0xd1, 0x8c, 0xab,
-	0x51, 0x5b, 0x2e, 0x7b, 0xab, 0xf5, 0xb2, 0x49, 0x27, 0x13, 0xea, 0xaa, 0xf9, 0x7a, 0x39, 0x8c,
-	0xaa, 0x57, 0x6c, 0x57, 0x10, 0xe6, 0x62, 0xc7, 0x1f, 0x97, 0xa6, 0x8c, 0x9e, 0x9c, 0xfa, 0x83,
-	0x2a, 0x11, 0xa6, 0x35, 0x98, 0x10, 0x81, 0xd5, 0x44, 0x73, 0x00, 0x57, 0x5e, 0x38, 0x0e, 0x35,
-	0xdf, 0xd8, 0x13, 0xc2, 0x05, 0x9e, 0x4c, 0x0d, 0xf2, 0x61, 0x46, 0xb8, 0x40, 0x8f, 0xe1, 0xe2,
-	0x10, 0x73, 0x52, 0xcb, 0x35, 0x72, 0xad, 0xd2, 0xf6, 0x8d, 0x76, 0xc4, 0x12, 0x5f, 0x7e, 0x8f,
-	0x8f, 0x76, 0x30, 0x27, 0x86, 0x87, 0x44, 0x97, 0x61, 0xc5, 0xa4, 0x33, 0x57, 0xd4, 0x0a, 0x8d,
-	0x5c, 0x6b, 0xc3, 0x50, 0x83, 0xe6, 0x6f, 0x39, 0xb8, 0x1a, 0x57, 0xe0, 0x53, 0xea, 0x72, 0x82,
-	0x9e, 0xc0, 0x2a, 0x17, 0x58, 0xcc, 0xb8, 0x2f, 0x72, 0x3d, 0x51, 0xa4, 0xef, 0x41, 0x0c, 0x1f,
-	0x8a, 0x6e, 0x40, 0x51, 0x04, 0x4c, 0xb5, 0x7c, 0x23, 0xd7, 0xba, 0x68, 0xcc, 0x27, 0x52, 0x6c,
-	0x78, 0x07, 0x15, 0xcf, 0x84, 0x5e, 0xf7, 0x33, 0xec, 0x2e, 0x1f, 0x66, 0x76, 0xa0, 0xaa, 0x99,
-	0x3f, 0x65, 0x57, 0x15, 0xc8, 0xf7, 0xba, 0x1e, 0x75, 0xc1, 0xc8, 0xf7, 0xba, 0x29, 0xfb, 0xf8,
-	0x33, 0x0f, 0xe5, 0xde, 0x64, 0x4a, 0x99, 0x30, 0x08, 0x9f, 0x39, 0xe2, 0xe3, 0xb4, 0xae, 0xc1,
-	0x9a, 0xc0, 0xfc, 0x70, 0x60, 0x5b, 0xbe, 0xe0, 0xaa, 0x1c, 0xf6, 0x2c, 0x74, 0x13, 0x4a, 0x16,
-	0x16, 0xd8, 0xa5, 0x16, 0x91, 0x8b, 0x05, 0x6f, 0x11, 0x82, 0xa9, 0x9e, 0x85, 0x9e, 0xc2, 0x8a,
-	0xe4, 0x20, 0xb5, 0x8b, 0x8d, 0x5c, 0xab, 0xb2, 0xdd, 0x48, 0x54, 0x53, 0x06, 0x4a, 0x4d, 0x62,
-	0x28, 0x38, 0xaa, 0xc3, 0x3a, 0x27, 0xa3, 0x09, 0x71, 0x05, 0xaf, 0xad, 0x34, 0x0a, 0xad, 0x82,
-	0xa1, 0xc7, 0xe8, 0x3f, 0xb0, 0x8e, 0x67, 0x82, 0x0e, 0x6c, 0x8b, 0xd7, 0x56, 0xbd, 0xb5, 0x35,
-	0x39, 0xee, 0x59, 0x1c, 0x5d, 0x87, 0x22, 0xa3, 0xc7, 0x03, 0xe5, 0x88, 0x35, 0xcf, 0x9a, 0x75,
-	0x46, 0x8f, 0x3b, 0x72, 0x8c, 0xbe, 0x82, 0x15, 0xdb, 0x7d, 0x4f, 0x79, 0x6d, 0xbd, 0x51, 0x68,
-	0x95, 0xb6, 0x6f, 0x25, 0xda, 0xf2, 0x0d, 0x39, 0xfd, 0x1e, 0x3b, 0x33, 0xb2, 0x8f, 0x6d, 0x66,
-	0x28, 0x7c, 0xf3, 0x8f, 0x1c, 0x5c, 0xeb, 0x12, 0x6e, 0x32, 0x7b, 0x48, 0xfa, 0xbe, 0x15, 0x1f,
-	0x7f, 0x2c, 0x9a, 0x50, 0x36, 0xa9, 0xe3, 0x10, 0x53, 0xd8, 0xd4, 0xd5, 0x21, 0x8c, 0xcc, 0xa1,
-	0xff, 0x01, 0xf8, 0xdb, 0xed, 0x75, 0x79, 0xad, 0xe0, 0x6d, 0x32, 0x34, 0xd3, 0x9c, 0x41, 0xd5,
-	0x37, 0x44, 0x12, 0xf7, 0xdc, 0xf7, 0x74, 0x81, 0x36, 0x97, 0x40, 0xdb, 0x80, 0xd2, 0x14, 0x33,
-	0x61, 0x47, 0x94, 0xc3, 0x53, 0x32, 0x57, 0xb4, 0x8c, 0x1f, 0xce, 0xf9, 0x44, 0xf3, 0xef, 0x3c,
-	0x94, 0x7d, 0x5d, 0xa9, 0xc9, 0x51, 0x17, 0x8a, 0x72, 0x4f, 0x03, 0xe9, 0x27, 0xdf, 0x05, 0x77,
-	0xdb, 0xc9, 0x15, 0xa8, 0x1d, 0x33, 0xd8, 0x58, 0x1f, 0x06, 0xa6, 0x77, 0xa1, 0x64, 0xbb, 0x16,
-	0x39, 0x19, 0xa8, 0xf0, 0xe4, 0xbd, 0xf0, 0xdc, 0x8e, 0xf2, 0xc8, 0x2a, 0xd4, 0xd6, 0xda, 0x16,
-	0x39, 0xf1, 0x38, 0xc0, 0x0e, 0x7e, 0x72, 0x44, 0xe0, 0x12, 0x39, 0x11, 0x0c, 0x0f, 0xc2, 0x5c,
-	0x05, 0x8f, 0xeb, 0xeb, 0x33, 0x6c, 0xf2, 0x08, 0xda, 0x2f, 0xe5, 0xd7, 0x9a, 0x9b, 0xbf, 0x74,
-	0x05, 0x3b, 0x35, 0xaa, 0x24, 0x3a, 0x5b, 0xff, 0x05, 0x2e, 0x27, 0x01, 0xd1, 0x26, 0x14, 0x0e,
-	0xc9, 0xa9, 0xef, 0x76, 0xf9, 0x13, 0x6d, 0xc3, 0xca, 0x91, 0x3c, 0x4a, 0x9e, 0x9f, 0x17, 0xce,
-	0x86, 0xb7, 0xa1, 0xf9, 0x4e, 0x14, 0xf4, 0x79, 0xfe, 0x59, 0xae, 0xf9, 0x57, 0x1e, 0x6a, 0x8b,
-	0xc7, 0xed, 0x53, 0x6a, 0x45, 0x96, 0x23, 0x37, 0x82, 0x0d, 0x3f, 0xd0, 0x11, 0xd7, 0xed, 0xa4,
-	0xb9, 0x2e, 0xcd, 0xc2, 0x88, 0x4f, 0x95, 0x0f, 0xcb, 0x3c, 0x34, 0x55, 0x27, 0x70, 0x69, 0x01,
-	0x92, 0xe0, 0xbd, 0xe7, 0x51, 0xef, 0xdd, 0xc9, 0x12, 0xc2, 0xb0, 0x17, 0x2d, 0xb8, 0xbc, 0x4b,
-	0x44, 0x87, 0x11, 0x8b, 0xb8, 0xc2, 0xc6, 0xce, 0xc7, 0x27, 0x6c, 0x1d, 0xd6, 0x67, 0x5c, 0xf6,
-	0xc7, 0x89, 0x32, 0xa6, 0x68, 0xe8, 0x71, 0xf3, 0xf7, 0x1c, 0x5c, 0x89, 0xc9, 0x7c, 0x4a, 0xa0,
-	0x96, 0x48, 0xc9, 0xb5, 0x29, 0xe6, 0xfc, 0x98, 0x32, 0x55, 0x68, 0x8b, 0x86, 0x1e, 0x6f, 0xff,
-	0x73, 0x13, 0x8a, 0x06, 0xa5, 0xa2, 0x23, 0x5d, 0x82, 0x1c, 0x40, 0xd2, 0x26, 0x3a, 0x99, 0x52,
-	0x97, 0xb8, 0xaa, 0xb0, 0x72, 0xd4, 0x8e, 0x1a, 0xe0, 0x0f, 0x16, 0x81, 0xbe, 0xa3, 0xea, 0x77,
-	0x12, 0xf1, 0x31, 0x70, 0xf3, 0x02, 0x9a, 0x78, 0x6a, 0xb2, 0x57, 0xbf, 0xb1, 0xcd, 0xc3, 0xce,
-	0x18, 0xbb, 0x2e, 0x71, 0xd0, 0xe3, 0xe8, 0xd7, 0xfa, 0x86, 0xb1, 0x08, 0x0d, 0xf4, 0x6e, 0x27,
-	0xea, 0xf5, 0x05, 0xb3, 0xdd, 0x51, 0xe0, 0xd5, 0xe6, 0x05, 0xf4, 0xc1, 0x8b, 0xab, 0x54, 0xb7,
-	0xb9, 0xb0, 0x4d, 0x1e, 0x08, 0x6e, 0xa7, 0x0b, 0x2e, 0x80, 0xcf, 0x29, 0x39, 0x80, 0xcd, 0x0e,
-	0x23, 0x58, 0x90, 0x8e, 0x4e, 0x18, 0xf4, 0x30, 0xd9, 0x3b, 0x31, 0x58, 0x20, 0xb4, 0x2c, 0xf8,
-	0xcd, 0x0b, 0xe8, 0x27, 0xa8, 0x74, 0x19, 0x9d, 0x86, 0xe8, 0xef, 0x27, 0xd2, 0x47, 0x41, 0x19,
-	0xc9, 0x07, 0xb0, 0xf1, 0x0a, 0xf3, 0x10, 0xf7, 0xbd, 0x44, 0xee, 0x08, 0x26, 0xa0, 0xbe, 0x95,
-	0x08, 0xdd, 0xa1, 0xd4, 0x09, 0xb9, 0xe7, 0x18, 0x50, 0x50, 0x0c, 0x42, 0x2a, 0xc9, 0xc7, 0x6d,
-	0x11, 0x18, 0x48, 0x6d, 0x65, 0xc6, 0x6b, 0xe1, 0xb7, 0x50, 0x52, 0x0e, 0x7f, 0xe1, 0xd8, 0x98,
-	0xa3, 0xbb, 0x4b, 0x42, 0xe2, 0x21, 0x32, 0x3a, 0xec, 0x3b, 0x28, 0x4a, 0x47, 0x2b, 0xd2, 0xff,
-	0xa7, 0x06, 0xe2, 0x3c, 0x94, 0x7d, 0x80, 0x17, 0x8e, 0x20, 0x4c, 0x71, 0x7e, 0x91, 0xc8, 0x39,
-	0x07, 0x64, 0x24, 0x75, 0xa1, 0xda, 0x1f, 0xcb, 0xcb, 0x4d, 0xe0, 0x1a, 0x8e, 0x1e, 0x24, 0x1f,
-	0xe8, 0x28, 0x2a, 0xa0, 0x7f, 0x98, 0x0d, 0xac, 0xdd, 0x7d, 0x20, 0x6f, 0xae, 0x82, 0xb0, 0x50,
-	0x90, 0x1f, 0xa4, 0xef, 0xe4, 0xdc, 0xe7, 0xf4, 0x00, 0xaa, 0x2a, 0x56, 0xfb, 0xc1, 0x7d, 0x24,
-	0x85, 0x3e, 0x86, 0xca, 0x48, 0xff, 0x03, 0x6c, 0xc8, 0xa8, 0xcd, 0xc9, 0xef, 0xa5, 0x46, 0xf6,
-	0xbc, 0xd4, 0x07, 0x50, 0x7e, 0x85, 0xf9, 0x9c, 0xb9, 0x95, 0x96, 0x60, 0x0b, 0xc4, 0x99, 0xf2,
-	0xeb, 0x10, 0x2a, 0x32, 0x28, 0xfa, 0x63, 0x9e, 0x52, 0x1d, 0xa2, 0xa0, 0x40, 0xe2, 0x41, 0x26,
-	0xac, 0x16, 0x23, 0x50, 0x96, 0x6b, 0x41, 0x57, 0x4f, 0xd9, 0x4b, 0x18, 0x12, 0x08, 0xdd, 0xcb,
-	0x80, 0x0c, 0x55, 0xf1, 0x4a, 0xf4, 0x89, 0x87, 0x1e, 0xa5, 0x35, 0xf8, 0xc4, 0xc7, 0x66, 0xbd,
-	0x9d, 0x15, 0xae, 0x25, 0x7f, 0x86, 0x35, 0xff, 0xe1, 0x15, 0x4f, 0xc0, 0xd8, 0xc7, 0xfa, 0xcd,
-	0x57, 0xbf, 0x7b, 0x26, 0x4e, 0xb3, 0x63, 0xb8, 0xf2, 0x76, 0x6a, 0xc9, 0xe2, 0xaf, 0x5a, 0x4c,
-	0xd0, 0xe4, 0xe2, 0xc7, 0x4c, 0xf7, 0xa5, 0x18, 0x6e, 0x8f, 0x8f, 0xce, 0x3a, 0x66, 0x0c, 0xfe,
-	0xdb, 0x73, 0x8f, 0xb0, 0x63, 0x5b, 0x91, 0x1e, 0xb3, 0x47, 0x04, 0xee, 0x60, 0x73, 0x4c, 0xe2,
-	0x2d, 0x50, 0xbd, 0xe2, 0xa3, 0x9f, 0x68, 0x70, 0xc6, 0xa3, 0xfd, 0x2b, 0x20, 0x55, 0x10, 0xdc,
-	0xf7, 0xf6, 0x68, 0xc6, 0xb0, 0x3a, 0x7f, 0x69, 0xcd, 0x7d, 0x11, 0x1a, 0xc8, 0x7c, 0x79, 0x8e,
-	0x2f, 0x42, 0x7d, 0x17, 0x76, 0x89, 0xd8, 0x23, 0x82, 0xd9, 0x66, 0x5a, 0xd5, 0x9c, 0x03, 0x52,
-	0x82, 0x96, 0x80, 0xd3, 0x02, 0x7d, 0x58, 0x55, 0x6f, 0x4f, 0xd4, 0x4c, 0xfc, 0x28, 0x78, 0x39,
-	0x2f, 0xbb, 0x2d, 0xe8, 0xd7, 0x75, 0x28, 0x5d, 0x77, 0x89, 0x08, 0xbd, 0x69, 0x53, 0xd2, 0x35,
-	0x0a, 0x5a, 0x9e, 0xae, 0x71, 0xac, 0x16, 0x73, 0xa1, 0xfa, 0xad, 0xcd, 0xfd, 0xc5, 0x37, 0x98,
-	0x1f, 0xa6, 0xf5, 0x80, 0x18, 0x6a, 0x79, 0x0f, 0x58, 0x00, 0x87, 0x3c, 0x56, 0x36, 0x88, 0x5c,
-	0xf0, 0xfd, 0x96, 0x7a, 0x2d, 0x0f, 0xff, 0xe9, 0x70, 0xd6, 0x21, 0x7b, 0xa7, 0xef, 0x57, 0xfa,
-	0x1a, 0x1d, 0xef, 0xbb, 0xf3, 0xb4, 0xd1, 0x10, 0x79, 0xe3, 0xcf, 0xc0, 0xec, 0x67, 0xe5, 0xe7,
-	0x66, 0x1e, 0xc0, 0x66, 0x97, 0x38, 0x24, 0xc2, 0xfc, 0x30, 0xe5, 0x0a, 0x13, 0x85, 0x65, 0xcc,
-	0xbc, 0x31, 0x6c, 0xc8, 0x30, 0xc8, 0xef, 0xde, 0x72, 0xc2, 0x78, 0x4a, 0xbf, 0x8a, 0x60, 0x02,
-	0xea, 0xfb, 0x59, 0xa0, 0xa1, 0x33, 0xb4, 0x11, 0x79, 0xc2, 0xc4, 0xf7, 0x31, 0x0f, 0x6a, 0xd2,
-	0x83, 0xaa, 0xfe, 0x28, 0x23, 0x3a, 0x74, 0x86, 0x40, 0x85, 0xdb, 0xa0, 0x0e, 0x49, 0x49, 0xeb,
-	0x39, 0x20, 0xa3, 0xbb, 0x5e, 0xc3, 0xba, 0x6c, 0xdd, 0x1e, 0xe5, 0x9d, 0xd4, 0xce, 0x7e, 0x0e,
-	0xc2, 0x03, 0xa8, 0xbe, 0x9e, 0x12, 0x86, 0x05, 0x91, 0xfe, 0xf2, 0x78, 0x93, 0x33, 0x2b, 0x86,
-	0xca, 0x7c, 0x2b, 0x87, 0x3e, 0x91, 0x15, 0x7c, 0x89, 0x13, 0xe6, 0x80, 0xe5, 0xb5, 0x2d, 0x8c,
-	0x0b, 0x17, 0x4f, 0x35, 0x2f, 0x0d, 0x5b, 0x2a, 0xe0, 0x59, 0x9e, 0x41, 0x40, 0xe1, 0xc2, 0xaf,
-	0x22, 0x7f, 0xeb, 0xfb, 0xcc, 0x3e, 0xb2, 0x1d, 0x32, 0x22, 0x29, 0x19, 0x10, 0x87, 0x65, 0x74,
-	0xd1, 0x10, 0x4a, 0x4a, 0x78, 0x97, 0x61, 0x57, 0xa0, 0x65, 0xa6, 0x79, 0x88, 0x80, 0xb6, 0x75,
-	0x36, 0x50, 0x6f, 0xc2, 0x04, 0x90, 0x69, 0xb1, 0x4f, 0x1d, 0xdb, 0x3c, 0x8d, 0x5f, 0x76, 0x74,
-	0x69, 0x98, 0x43, 0x52, 0x2e, 0x3b, 0x89, 0x48, 0x2d, 0x32, 0x84, 0x52, 0x67, 0x4c, 0xcc, 0xc3,
-	0x57, 0x04, 0x3b, 0x62, 0x9c, 0xf6, 0x4e, 0x99, 0x23, 0x96, 0x6f, 0x24, 0x02, 0x0c, 0x34, 0x76,
-	0x9e, 0xfd, 0xf8, 0x74, 0x64, 0x8b, 0xf1, 0x6c, 0x28, 0xdd, 0xb8, 0xa5, 0xa0, 0x8f, 0x6c, 0xea,
-	0xff, 0xda, 0x0a, 0x0c, 0xdc, 0xf2, 0xa8, 0xb6, 0x74, 0x92, 0x4e, 0x87, 0xc3, 0x55, 0x6f, 0xea,
-	0xc9, 0xbf, 0x01, 0x00, 0x00, 0xff, 0xff, 0x94, 0x82, 0xd4, 0x6e, 0x4c, 0x18, 0x00, 0x00,
+	// 2295 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x59, 0xdb, 0x72, 0x1b, 0xb7,
+	0x19, 0x36, 0x49, 0x91, 0x14, 0x7f, 0x1e, 0x85, 0xc8, 0x36, 0xc3, 0x24, 0x0d, 0xb3, 0xf2, 0x41,
+	0x3e, 0x51, 0x8e, 0xd2, 0x49, 0x63, 0xa7, 0x37, 0x36, 0xa9, 0xda, 0x9a, 0xd6, 0x8d, 0xba, 0xb2,
+	0x3b, 0x6e, 0x5a, 0x0f, 0xbb, 0xe2, 0x42, 0xd4, 0x8e, 0x97, 0x0b, 0x66, 0x01, 0x5a, 0x72, 0xdb,
+	0x9b, 0xcc, 0xf4, 0xa6, 0x87, 0x99, 0xbe, 0x44, 0xef, 0xfa, 0x0a, 0xbd, 0x6a, 0x1f, 0xa1, 0x8f,
+	0xd0, 0x8b, 0xbe, 0x46, 0x07, 0xc0, 0x9e, 0x4f, 0x5c, 0x4a, 0x6e, 0x7a, 0x47, 0x00, 0x1f, 0xbe,
+	0x0f, 0xf8, 0x0f, 0xf8, 0x41, 0x2c, 0x74, 0x6c, 0x42, 0xd8, 0x78, 0x42, 0x88, 0xad, 0x0f, 0xe6,
+	0x36, 0x61, 0x04, 0x5d, 0x99, 0x19, 0xe6, 0x9b, 0x05, 0x95, 0xad, 0x01, 0x1f, 0x16, 0xa3, 0xbd,
+	0xc6, 0x84, 0xcc, 0x66, 0xc4, 0x92, 0xfd, 0xbd, 0x46, 0x10, 0xd5, 0x6b, 0x19, 0x16, 0xc3, 0xb6,
+	0xa5, 0x99, 0x4e, 0xbb, 0x3e, 0xb7, 0xc9, 0xd9, 0x5b, 0xa7, 0xd1, 0xc6, 0x6c, 0xa2, 0x8f, 0x67,
+	0x98, 0x69, 0xb2, 0x43, 0x19, 0xc3, 0xe5, 0x47, 0xa6, 0x49, 0x26, 0xcf, 0x8d, 0x19, 0xa6, 0x4c,
+	0x9b, 0xcd, 0x55, 0xfc, 0xcd, 0x02, 0x53, 0x86, 0xee, 0xc3, 0xda, 0x91, 0x46, 0x71, 0xb7, 0xd0,
+	0x2f, 0x6c, 0xd7, 0x77, 0x3f, 0x1c, 0x84, 0x56, 0xe2, 0xc8, 0x3f, 0xa3, 0xd3, 0xc7, 0x1a, 0xc5,
+	0xaa, 0x40, 0xa2, 0x4d, 0x28, 0x4f, 0xc8, 0xc2, 0x62, 0xdd, 0x52, 0xbf, 0xb0, 0xdd, 0x54, 0x65,
+	0x43, 0xf9, 0xb6, 0x00, 0x57, 0xa2, 0x0a, 0x74, 0x4e, 0x2c, 0x8a, 0xd1, 0x67, 0x50, 0xa1, 0x4c,
+	0x63, 0x0b, 0xea, 0x88, 0x7c, 0x90, 0x28, 0x72, 0x28, 0x20, 0xaa, 0x03, 0x45, 0x1f, 0x42, 0x8d,
+	0xb9, 0x4c, 0xdd, 0x62, 0xbf, 0xb0, 0xbd, 0xa6, 0xfa, 0x1d, 0x29, 0x6b, 0x78, 0x09, 0x2d, 0xb1,
+	0x84, 0xfd, 0xd1, 0x3b, 0xd8, 0x5d, 0x31, 0xc8, 0x6c, 0x42, 0xdb, 0x63, 0xbe, 0xc8, 0xae, 0x5a,
+	0x50, 0xdc, 0x1f, 0x09, 0xea, 0x92, 0x5a, 0xdc, 0x1f, 0xa5, 0xec, 0xe3, 0x1f, 0x45, 0x68, 0xec,
+	0xcf, 0xe6, 0xc4, 0x66, 0x2a, 0xa6, 0x0b, 0x93, 0x9d, 0x4f, 0xeb, 0x2a, 0x54, 0x99, 0x46, 0x5f,
+	0x8f, 0x0d, 0xdd, 0x11, 0xac, 0xf0, 0xe6, 0xbe, 0x8e, 0x3e, 0x86, 0xba, 0xae, 0x31, 0xcd, 0x22,
+	0x3a, 0xe6, 0x83, 0x25, 0x31, 0x08, 0x6e, 0xd7, 0xbe, 0x8e, 0x3e, 0x87, 0x32, 0xe7, 0xc0, 0xdd,
+	0xb5, 0x7e, 0x61, 0xbb, 0xb5, 0xdb, 0x4f, 0x54, 0x93, 0x0b, 0xe4, 0x9a, 0x58, 0x95, 0x70, 0xd4,
+	0x83, 0x75, 0x8a, 0xa7, 0x33, 0x6c, 0x31, 0xda, 0x2d, 0xf7, 0x4b, 0xdb, 0x25, 0xd5, 0x6b, 0xa3,
+	0xf7, 0x61, 0x5d, 0x5b, 0x30, 0x32, 0x36, 0x74, 0xda, 0xad, 0x88, 0xb1, 0x2a, 0x6f, 0xef, 0xeb,
+	0x14, 0x7d, 0x00, 0x35, 0x9b, 0x9c, 0x8e, 0xa5, 0x21, 0xaa, 0x62, 0x35, 0xeb, 0x36, 0x39, 0x1d,
+	0xf2, 0x36, 0xfa, 0x01, 0x94, 0x0d, 0xeb, 0x98, 0xd0, 0xee, 0x7a, 0xbf, 0xb4, 0x5d, 0xdf, 0xfd,
+	0x24, 0x71, 0x2d, 0x3f, 0xc6, 0x6f, 0x7f, 0xae, 0x99, 0x0b, 0x7c, 0xa0, 0x19, 0xb6, 0x2a, 0xf1,
+	0xca, 0xdf, 0x8a, 0xd0, 0xdc, 0x3b, 0x93, 0x46, 0x3c, 0x6f, 0x30, 0xdc, 0x84, 0xf6, 0x84, 0x98,
+	0x26, 0x9e, 0x30, 0x83, 0x58, 0x63, 0x4b, 0x9b, 0x61, 0x61, 0xca, 0x9a, 0xda, 0xf2, 0xbb, 0x7f,
+	0xaa, 0xcd, 0x04, 0x70, 0xae, 0xd9, 0xcc, 0xf0, 0x70, 0xb4, 0x5b, 0xea, 0x97, 0x38, 0xd0, 0xeb,
+	0xe6, 0x38, 0x8a, 0xb6, 0xa0, 0x49, 0x16, 0x6c, 0xbe, 0x60, 0xe3, 0xb9, 0x8d, 0x8f, 0x8d, 0x33,
+	0x61, 0xe2, 0x9a, 0xda, 0x90, 0x9d, 0x07, 0xa2, 0x0f, 0x5d, 0x81, 0xca, 0x31, 0xb1, 0x67, 0x1a,
+	0xeb, 0x96, 0xc5, 0xa8, 0xd3, 0x0a, 0xe7, 0x44, 0x25, 0x9a, 0x13, 0x5f, 0x42, 0x95, 0xcc, 0xb9,
+	0x12, 0xed, 0x56, 0xf3, 0xda, 0xca, 0x9d, 0xa1, 0xfc, 0x0e, 0x5a, 0xae, 0xb1, 0x2e, 0x12, 0xdf,
+	0xa9, 0x31, 0x17, 0x5a, 0x7a, 0x29, 0xb2, 0x74, 0xe5, 0xef, 0x6b, 0xae, 0xfc, 0x73, 0x0e, 0xb7,
+	0x8e, 0x09, 0xcf, 0x14, 0x43, 0x17, 0xd2, 0x25, 0xb5, 0x68, 0xc4, 0x82, 0xb6, 0x18, 0x0b, 0xda,
+	0x2d, 0x68, 0x06, 0x7c, 0xe5, 0xc5, 0x75, 0xc3, 0xef, 0xdc, 0xd7, 0x93, 0x1c, 0xba, 0x96, 0xe8,
+	0xd0, 0x2d, 0x68, 0xfa, 0x0e, 0x35, 0x74, 0x37, 0x9e, 0x1b, 0x5e, 0x27, 0x0f, 0xdc, 0x04, 0xaf,
+	0x57, 0x12, 0xbd, 0x1e, 0xda, 0x7d, 0x35, 0xea, 0xb8, 0x58, 0x4c, 0xac, 0x67, 0xc6, 0x44, 0x2d,
+	0x14, 0x13, 0x1f, 0x40, 0x6d, 0x62, 0x63, 0x8d, 0xe1, 0x31, 0xa3, 0x5d, 0x90, 0xc9, 0x23, 0x3b,
+	0x9e, 0x8b, 0xa4, 0xa3, 0x4c, 0xb3, 0x19, 0x1f, 0xab, 0x8b, 0xb1, 0xaa, 0x68, 0x3f, 0xa7, 0xe8,
+	0x81, 0x9b, 0xe3, 0x0d, 0x91, 0xe3, 0x5b, 0x83, 0xe4, 0x12, 0x34, 0x90, 0x6e, 0x09, 0xa5, 0xf9,
+	0x26, 0x94, 0x8f, 0x0d, 0x13, 0xd3, 0x6e, 0x53, 0x6c, 0x56, 0x36, 0xc2, 0x59, 0xdc, 0x8a, 0x64,
+	0xf1, 0x16, 0x34, 0xb1, 0x6d, 0x13, 0x7b, 0x3c, 0xc3, 0x94, 0x6a, 0x53, 0xdc, 0x6d, 0xcb, 0x2d,
+	0x8a, 0xce, 0x67, 0xb2, 0xcf, 0x4f, 0xf5, 0xce, 0x8a, 0xa9, 0x7e, 0x04, 0x97, 0x9f, 0x60, 0x16,
+	0x5c, 0xe9, 0xb9, 0x33, 0x3e, 0x2d, 0x80, 0x95, 0x3f, 0x14, 0xe0, 0x4a, 0x54, 0xe4, 0x22, 0x99,
+	0xf2, 0x10, 0xd6, 0x38, 0xb3, 0x50, 0xa9, 0xef, 0xde, 0xc8, 0x36, 0xbf, 0x9b, 0x15, 0xaa, 0x98,
+	0x23, 0xd6, 0xf2, 0x13, 0x83, 0x32, 0x7f, 0x90, 0x7e, 0x07, 0x67, 0xdc, 0x26, 0x94, 0x4d, 0x63,
+	0x66, 0x30, 0x27, 0xb1, 0x64, 0x43, 0xf9, 0x73, 0x01, 0xae, 0xc6, 0xd6, 0x72, 0x11, 0xc3, 0xfc,
+	0x10, 0xca, 0x7c, 0x93, 0xb4, 0x5b, 0xec, 0x97, 0x56, 0xb0, 0x8c, 0x9c, 0xa4, 0xfc, 0xb5, 0x08,
+	0x8d, 0xbd, 0xb3, 0xff, 0x5f, 0xe9, 0x7c, 0x10, 0x2e, 0x9d, 0xe7, 0x4a, 0xab, 0x72, 0x6a, 0x5a,
+	0x55, 0xd2, 0x8a, 0x63, 0x75, 0xc5, 0x8c, 0xf9, 0x4f, 0x01, 0xba, 0x2a, 0xa6, 0x8c, 0xd8, 0x78,
+	0xe8, 0x79, 0xf9, 0x3b, 0x88, 0xa1, 0xcc, 0x32, 0x80, 0x06, 0xf0, 0x9e, 0x85, 0x4f, 0xc7, 0xfe,
+	0x9c, 0xe0, 0x09, 0xbd, 0x61, 0xe1, 0xd3, 0x61, 0x98, 0x4d, 0x81, 0xe0, 0xe9, 0x3e, 0xea, 0x96,
+	0x63, 0x27, 0xfe, 0x48, 0x31, 0xe0, 0xfd, 0x84, 0x8d, 0xfe, 0x2f, 0x6a, 0x9c, 0xf2, 0xaf, 0x12,
+	0xb4, 0x1d, 0xad, 0xd4, 0x32, 0x76, 0x1f, 0x36, 0x29, 0x59, 0xd8, 0x13, 0x3c, 0x0e, 0x17, 0x2b,
+	0xc9, 0x84, 0xe4, 0xd8, 0x30, 0x58, 0xb2, 0xbe, 0x0f, 0x57, 0xe2, 0x33, 0x84, 0x5d, 0x4a, 0xc2,
+	0x2e, 0x9b, 0xd1, 0x39, 0x71, 0x43, 0xaf, 0xe5, 0x34, 0x74, 0x39, 0xcd, 0xd0, 0xb1, 0xda, 0x5a,
+	0x49, 0xa8, 0xad, 0xa1, 0x4a, 0x54, 0xcd, 0xa8, 0x44, 0xeb, 0xe1, 0x4a, 0xf4, 0xd0, 0x4d, 0x99,
+	0x9a, 0x48, 0x99, 0x6b, 0x69, 0x29, 0xe3, 0x98, 0x36, 0x94, 0x33, 0x1f, 0x43, 0xdd, 0xb9, 0x61,
+	0x8a, 0x22, 0x0d, 0xa2, 0x48, 0x83, 0xd3, 0x15, 0xbb, 0x5b, 0xd6, 0x97, 0x55, 0xa5, 0x46, 0xbc,
+	0x2a, 0x29, 0x13, 0x71, 0xee, 0x87, 0xc4, 0xdf, 0x7d, 0x75, 0xf9, 0x53, 0x01, 0xae, 0xc6, 0x54,
+	0x2e, 0x12, 0xa4, 0x5f, 0x86, 0xca, 0xcb, 0xcd, 0x25, 0x36, 0x8d, 0xd4, 0x97, 0xbf, 0x14, 0xe0,
+	0xea, 0x08, 0xd3, 0x89, 0x6d, 0x1c, 0xe1, 0x43, 0xe7, 0x02, 0x7f, 0xfe, 0x4d, 0x47, 0xb3, 0xb4,
+	0x18, 0xcf, 0x52, 0xf4, 0x3d, 0xf0, 0x9c, 0x36, 0x92, 0x57, 0xe7, 0x80, 0x1b, 0x47, 0x54, 0x59,
+	0x40, 0xdb, 0x59, 0x08, 0x27, 0x16, 0x99, 0x15, 0xa5, 0x2d, 0x24, 0xd0, 0xf6, 0xa1, 0xee, 0x5f,
+	0xd8, 0x5c, 0xe5, 0x60, 0x17, 0xcf, 0x13, 0x4f, 0xc6, 0x39, 0xce, 0xfd, 0x0e, 0xe5, 0xdf, 0x45,
+	0x68, 0x38, 0xba, 0x5c, 0x93, 0xa2, 0x11, 0xd4, 0xf8, 0x9e, 0xc6, 0xfc, 0x14, 0xed, 0x16, 0xb2,
+	0x6d, 0x1b, 0x59, 0xb0, 0xba, 0x7e, 0xe4, 0x2e, 0x7d, 0x04, 0x75, 0xc3, 0xd2, 0xf1, 0xd9, 0x58,
+	0x1e, 0xde, 0xb2, 0xd0, 0x45, 0x4a, 0x05, 0xff, 0x03, 0x3f, 0xf0, 0xb4, 0x75, 0x7c, 0x26, 0x38,
+	0xc0, 0x70, 0x7f, 0x52, 0x84, 0x61, 0x03, 0x9f, 0x31, 0x5b, 0x1b, 0x07, 0xb9, 0x4a, 0x82, 0xeb,
+	0xc1, 0x92, 0x35, 0x09, 0x82, 0xc1, 0x1e, 0x9f, 0xed, 0x71, 0xd3, 0x3d, 0x8b, 0xd9, 0x6f, 0xd5,
+	0x36, 0x0e, 0xf7, 0xf6, 0x7e, 0x0d, 0x9b, 0x49, 0x40, 0xd4, 0x81, 0xd2, 0x6b, 0xfc, 0xd6, 0x31,
+	0x3b, 0xff, 0x89, 0x76, 0xa1, 0xfc, 0x86, 0x17, 0x9a, 0x6e, 0x31, 0x29, 0x36, 0xc4, 0x86, 0xfc,
+	0x9d, 0x48, 0xe8, 0xc3, 0xe2, 0x17, 0x05, 0xe5, 0x9f, 0x45, 0xe8, 0xc6, 0xc3, 0xed, 0x22, 0xd1,
+	0x9f, 0x27, 0xe4, 0xa6, 0xd0, 0xf4, 0x8e, 0x8e, 0x80, 0xe9, 0x1e, 0xa7, 0x99, 0x2e, 0x6d, 0x85,
+	0x21, 0x9b, 0x4a, 0x1b, 0x36, 0x68, 0xa0, 0xab, 0x87, 0x61, 0x23, 0x06, 0x49, 0xb0, 0xde, 0xc3,
+	0xb0, 0xf5, 0xae, 0xe5, 0x71, 0x61, 0xd0, 0x8a, 0x3a, 0x6c, 0x3e, 0xc1, 0x6c, 0x68, 0x63, 0x1d,
+	0x5b, 0xcc, 0xd0, 0xcc, 0xf3, 0x27, 0x6c, 0x0f, 0xd6, 0x17, 0x14, 0xdb, 0x81, 0x32, 0xee, 0xb5,
+	0x95, 0xdf, 0x17, 0xe0, 0x72, 0x44, 0xe6, 0x22, 0x8e, 0xca, 0x90, 0xe2, 0x63, 0x73, 0x8d, 0xd2,
+	0x53, 0x62, 0xeb, 0x4e, 0xa9, 0xf3, 0xda, 0xb7, 0xbf, 0x86, 0x7a, 0xe0, 0x06, 0x85, 0x36, 0xdc,
+	0xbf, 0xfa, 0x07, 0xd8, 0xd2, 0x0d, 0x6b, 0xda, 0xb9, 0x84, 0x3a, 0xee, 0x3d, 0xf0, 0x47, 0x9a,
+	0x61, 0x62, 0xbd, 0x53, 0xf0, 0x41, 0x87, 0xbc, 0xf0, 0x60, 0xbd, 0x53, 0x44, 0xef, 0x41, 0x5b,
+	0x76, 0x0d, 0xc9, 0x6c, 0x6e, 0x62, 0xde, 0x59, 0xba, 0xfd, 0x6d, 0x01, 0x1a, 0xc1, 0x83, 0x18,
+	0x21, 0x68, 0x39, 0x6d, 0x9f, 0x7e, 0x03, 0x9a, 0x4e, 0x9f, 0xc7, 0xff, 0x91, 0x7f, 0xd3, 0xe0,
+	0x55, 0xcf, 0xb0, 0xa6, 0x7e, 0x15, 0xed, 0x14, 0xd1, 0x65, 0xd8, 0x90, 0xc3, 0x86, 0x35, 0x75,
+	0x63, 0xa8, 0x53, 0x42, 0x9b, 0xd0, 0x71, 0x67, 0x79, 0x6b, 0x58, 0xdb, 0xfd, 0xe3, 0x75, 0xa8,
+	0xa9, 0x84, 0xb0, 0x21, 0x77, 0x39, 0x32, 0x01, 0x71, 0x9b, 0x93, 0xd9, 0x9c, 0x58, 0xd8, 0x92,
+	0x7b, 0xa6, 0x68, 0x10, 0x36, 0xb0, 0xd3, 0x88, 0x03, 0x9d, 0x40, 0xe8, 0x5d, 0x4b, 0xc4, 0x47,
+	0xc0, 0xca, 0x25, 0x34, 0x13, 0x6a, 0xfc, 0x19, 0xef, 0xb9, 0x31, 0x79, 0x3d, 0x3c, 0xd1, 0x2c,
+	0x0b, 0x9b, 0xe8, 0x7e, 0x78, 0xb6, 0xf7, 0xf8, 0x18, 0x87, 0xba, 0x7a, 0x5b, 0x89, 0x7a, 0x87,
+	0x8c, 0x1b, 0xc0, 0x8d, 0x1a, 0xe5, 0x12, 0xfa, 0x46, 0xc4, 0x2d, 0x57, 0x37, 0x28, 0x33, 0x26,
+	0xd4, 0x15, 0xdc, 0x4d, 0x17, 0x8c, 0x81, 0x57, 0x94, 0x1c, 0x43, 0x47, 0xb8, 0x28, 0x70, 0x69,
+	0x42, 0x77, 0x93, 0xad, 0x13, 0x81, 0xb9, 0x42, 0x59, 0xc1, 0xad, 0x5c, 0x42, 0xbf, 0x84, 0xd6,
+	0xc8, 0x26, 0xf3, 0x00, 0xfd, 0xed, 0x44, 0xfa, 0x30, 0x28, 0x27, 0xf9, 0x18, 0x9a, 0x4f, 0x35,
+	0x1a, 0xe0, 0xbe, 0x95, 0xc8, 0x1d, 0xc2, 0xb8, 0xd4, 0x9f, 0x24, 0x42, 0x1f, 0x13, 0x62, 0x06,
+	0xcc, 0x73, 0x0a, 0xc8, 0x3d, 0xec, 0x02, 0x2a, 0xc9, 0xe1, 0x16, 0x07, 0xba, 0x52, 0x3b, 0xb9,
+	0xf1, 0x9e, 0xf0, 0x0b, 0xa8, 0x4b, 0x83, 0x3f, 0x32, 0x0d, 0x8d, 0xa2, 0x9b, 0x19, 0x2e, 0x11,
+	0x88, 0x9c, 0x06, 0xfb, 0x19, 0xd4, 0xb8, 0xa1, 0x25, 0xe9, 0xf5, 0x54, 0x47, 0xac, 0x42, 0x79,
+	0x08, 0xf0, 0xc8, 0x64, 0xd8, 0x96, 0x9c, 0x37, 0x12, 0x39, 0x7d, 0x40, 0x4e, 0x52, 0x0b, 0xda,
+	0x87, 0x27, 0x24, 0x70, 0xf7, 0xa6, 0xe8, 0x4e, 0x72, 0x40, 0x87, 0x51, 0x2e, 0xfd, 0xdd, 0x7c,
+	0x60, 0xcf, 0xdc, 0xaf, 0xf8, 0xa3, 0x36, 0xc3, 0x76, 0xc0, 0xc9, 0x77, 0xd2, 0x77, 0xb2, 0x72,
+	0x9c, 0xbe, 0x82, 0xb6, 0xf4, 0xd5, 0x81, 0x7b, 0xdf, 0x4a, 0xa1, 0x8f, 0xa0, 0x72, 0xd2, 0xff,
+	0x02, 0x9a, 0xdc, 0x6b, 0x3e, 0xf9, 0xad, 0x54, 0xcf, 0xae, 0x4a, 0xfd, 0x0a, 0x1a, 0x4f, 0x35,
+	0xea, 0x33, 0x6f, 0xa7, 0x25, 0x58, 0x8c, 0x38, 0x57, 0x7e, 0xbd, 0x86, 0x16, 0x77, 0x8a, 0x37,
+	0x99, 0xa6, 0x9c, 0x0e, 0x61, 0x90, 0x2b, 0x71, 0x27, 0x17, 0xd6, 0x13, 0xc3, 0xd0, 0xe0, 0x63,
+	0x6e, 0xc5, 0x49, 0xd9, 0x4b, 0x10, 0xe2, 0x0a, 0xdd, 0xca, 0x81, 0x0c, 0x9c, 0xe2, 0xad, 0xf0,
+	0xd7, 0x1f, 0x74, 0x2f, 0xed, 0x02, 0x93, 0xf8, 0x1d, 0xaa, 0x37, 0xc8, 0x0b, 0xf7, 0x24, 0x7f,
+	0x05, 0x55, 0xe7, 0x9b, 0x0c, 0xba, 0x91, 0x39, 0xd9, 0xfb, 0x1c, 0xd4, 0xbb, 0xb9, 0x14, 0xe7,
+	0xb1, 0x6b, 0x70, 0xf9, 0xc5, 0x5c, 0xe7, 0x87, 0xbf, 0x2c, 0x31, 0x6e, 0x91, 0x43, 0xb7, 0x52,
+	0xea, 0x52, 0x04, 0xf7, 0x8c, 0x4e, 0x97, 0x85, 0x99, 0x0d, 0x1f, 0xed, 0x5b, 0x6f, 0x34, 0xd3,
+	0xd0, 0x43, 0x35, 0xe6, 0x19, 0x66, 0xda, 0x50, 0x9b, 0x9c, 0xe0, 0x68, 0x09, 0x94, 0x1f, 0xf8,
+	0xc2, 0x53, 0x3c, 0x70, 0xce, 0xd0, 0xfe, 0x2d, 0x20, 0x79, 0x20, 0x58, 0xc7, 0xc6, 0x74, 0x61,
+	0x6b, 0x32, 0xfe, 0xd2, 0x8a, 0x7b, 0x1c, 0xea, 0xca, 0x7c, 0xba, 0xc2, 0x8c, 0x40, 0xdd, 0x85,
+	0x27, 0x98, 0x3d, 0xc3, 0xcc, 0x36, 0x26, 0x69, 0xa7, 0xa6, 0x0f, 0x48, 0x71, 0x5a, 0x02, 0xce,
+	0x13, 0x38, 0x84, 0x8a, 0xfc, 0x2c, 0x85, 0x94, 0xc4, 0x49, 0xee, 0x47, 0xb5, 0xac, 0xdb, 0x82,
+	0x8b, 0x09, 0xa6, 0xeb, 0x13, 0xcc, 0x02, 0x9f, 0xbb, 0x52, 0xd2, 0x35, 0x0c, 0xca, 0x4e, 0xd7,
+	0x28, 0xd6, 0x13, 0xb3, 0xa0, 0xcd, 0x5f, 0x53, 0xe5, 0xa0, 0x78, 0x4d, 0x4d, 0x39, 0x34, 0x23,
+	0xa8, 0xec, 0x1a, 0x10, 0x03, 0x07, 0x2c, 0xd6, 0x50, 0x31, 0x1f, 0x70, 0xec, 0x96, 0xfa, 0xb7,
+	0x23, 0xf8, 0x3d, 0x72, 0xf9, 0xd1, 0x5c, 0x91, 0xd7, 0x6a, 0x74, 0x3d, 0x8d, 0x2e, 0xf4, 0x65,
+	0xae, 0x77, 0x63, 0x19, 0x2c, 0x78, 0xce, 0x84, 0x5f, 0xe1, 0xd3, 0xcf, 0x99, 0xc4, 0x4f, 0x02,
+	0xbd, 0x41, 0x5e, 0xb8, 0x27, 0xc9, 0xa4, 0x4b, 0x02, 0x0f, 0xdc, 0x28, 0x95, 0x24, 0xf9, 0x55,
+	0xbe, 0xb7, 0x93, 0x1b, 0x1f, 0x77, 0xcc, 0xde, 0x59, 0xb6, 0x63, 0xf6, 0xce, 0xf2, 0x3b, 0xe6,
+	0x37, 0xee, 0x7f, 0x90, 0xe0, 0xc5, 0xee, 0xfe, 0x92, 0xc7, 0xa1, 0x78, 0xe1, 0xff, 0x74, 0x85,
+	0x19, 0x41, 0x33, 0x46, 0x5e, 0xb8, 0x50, 0x96, 0x2f, 0x12, 0x1e, 0xdc, 0x7a, 0x3b, 0xb9, 0xf1,
+	0x9e, 0xea, 0x4b, 0xef, 0xaa, 0xef, 0xfd, 0x63, 0x45, 0xd7, 0x53, 0xce, 0x2e, 0x1f, 0xc2, 0xff,
+	0x5c, 0x2f, 0xb3, 0xe5, 0x4b, 0xe8, 0x38, 0x05, 0xe2, 0x5d, 0x33, 0x8f, 0xa1, 0x33, 0xc2, 0x26,
+	0x0e, 0x31, 0xdf, 0x4d, 0xb9, 0x4d, 0x87, 0x61, 0x39, 0x8b, 0xc0, 0x09, 0x34, 0x79, 0xe0, 0xf1,
+	0x79, 0x2f, 0x28, 0xb6, 0x69, 0xca, 0xd5, 0x29, 0x84, 0x71, 0xa9, 0x6f, 0xe7, 0x81, 0x06, 0x8e,
+	0xb3, 0x66, 0xe8, 0xb5, 0x00, 0xdd, 0xcd, 0x70, 0x61, 0x7c, 0x1f, 0xf7, 0x72, 0xa2, 0x03, 0x59,
+	0x03, 0xd2, 0xdd, 0x2a, 0x31, 0x71, 0x4a, 0x85, 0xf1, 0x01, 0x39, 0xcd, 0xf5, 0x15, 0xac, 0xf3,
+	0x5b, 0xa4, 0xa0, 0xbc, 0x96, 0x7a, 0xc9, 0x5c, 0x81, 0xf0, 0x15, 0xb4, 0xbf, 0x9a, 0x63, 0x5b,
+	0x63, 0x98, 0xdb, 0x4b, 0xf0, 0x26, 0x1f, 0xf2, 0x11, 0x54, 0xee, 0x3f, 0x88, 0x70, 0x88, 0x79,
+	0x02, 0x66, 0x18, 0xc1, 0x07, 0x64, 0x97, 0xd9, 0x20, 0x2e, 0x58, 0xc7, 0x65, 0x3f, 0x5f, 0x58,
+	0xa6, 0x80, 0x58, 0x79, 0x0e, 0x01, 0x89, 0x0b, 0xfe, 0x41, 0x77, 0xb6, 0x7e, 0x60, 0x1b, 0x6f,
+	0x0c, 0x13, 0x4f, 0x71, 0x4a, 0x06, 0x44, 0x61, 0x39, 0x4d, 0x74, 0x04, 0x75, 0x29, 0xfc, 0xc4,
+	0xd6, 0x2c, 0x86, 0xb2, 0x96, 0x26, 0x10, 0x2e, 0xed, 0xf6, 0x72, 0xa0, 0xb7, 0x89, 0x09, 0x00,
+	0x4f, 0x8b, 0x03, 0x62, 0x1a, 0x93, 0xb7, 0x68, 0x3b, 0xe5, 0x68, 0xf0, 0x21, 0x29, 0xf7, 0xee,
+	0x44, 0xa4, 0x27, 0x72, 0x04, 0xf5, 0xe1, 0x09, 0x9e, 0xbc, 0x7e, 0x8a, 0x35, 0x93, 0x9d, 0xa4,
+	0xfd, 0x65, 0xf6, 0x11, 0xd9, 0x1b, 0x09, 0x01, 0x5d, 0x8d, 0xc7, 0x5f, 0x7c, 0xfd, 0xf9, 0xd4,
+	0x60, 0x27, 0x8b, 0x23, 0x6e, 0xc6, 0x1d, 0x09, 0xbd, 0x67, 0x10, 0xe7, 0xd7, 0x8e, 0xbb, 0xc0,
+	0x1d, 0x41, 0xb5, 0xe3, 0x25, 0xe9, 0xfc, 0xe8, 0xa8, 0x22, 0xba, 0x3e, 0xfb, 0xef, 0x00, 0xeb,
+	0xa5, 0x8e, 0x4c, 0xf2, 0x26, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -788,28 +1839,28 @@ type RootCoordClient interface {
 	GetComponentStates(ctx context.Context, in *milvuspb.GetComponentStatesRequest, opts ...grpc.CallOption) (*milvuspb.ComponentStates, error)
 	GetTimeTickChannel(ctx context.Context, in *internalpb.GetTimeTickChannelRequest, opts ...grpc.CallOption) (*milvuspb.StringResponse, error)
 	GetStatisticsChannel(ctx context.Context, in *internalpb.GetStatisticsChannelRequest, opts ...grpc.CallOption) (*milvuspb.StringResponse, error)
-	//*
+	//
 	// @brief This method is used to create collection
 	//
 	// @param CreateCollectionRequest, use to provide collection information to be created.
 	//
 	// @return Status
 	CreateCollection(ctx context.Context, in *milvuspb.CreateCollectionRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
-	//*
+	//
 	// @brief This method is used to delete collection.
 	//
 	// @param DropCollectionRequest, collection name is going to be deleted.
 	//
 	// @return Status
 	DropCollection(ctx context.Context, in *milvuspb.DropCollectionRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
-	//*
+	//
 	// @brief This method is used to test collection existence.
 	//
 	// @param HasCollectionRequest, collection name is going to be tested.
 	//
 	// @return BoolResponse
 	HasCollection(ctx context.Context, in *milvuspb.HasCollectionRequest, opts ...grpc.CallOption) (*milvuspb.BoolResponse, error)
-	//*
+	//
 	// @brief This method is used to get collection schema.
 	//
 	// @param DescribeCollectionRequest, target collection name.
@@ -819,28 +1870,28 @@ type RootCoordClient interface {
 	CreateAlias(ctx context.Context, in *milvuspb.CreateAliasRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
 	DropAlias(ctx context.Context, in *milvuspb.DropAliasRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
 	AlterAlias(ctx context.Context, in *milvuspb.AlterAliasRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
-	//*
+	//
 	// @brief This method is used to list all collections.
 	//
 	// @return StringListResponse, collection name list
 	ShowCollections(ctx context.Context, in *milvuspb.ShowCollectionsRequest, opts ...grpc.CallOption) (*milvuspb.ShowCollectionsResponse, error)
 	AlterCollection(ctx context.Context, in *milvuspb.AlterCollectionRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
-	//*
+	//
 	// @brief This method is used to create partition
 	//
 	// @return Status
 	CreatePartition(ctx context.Context, in *milvuspb.CreatePartitionRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
-	//*
+	//
 	// @brief This method is used to drop partition
 	//
 	// @return Status
 	DropPartition(ctx context.Context, in *milvuspb.DropPartitionRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
-	//*
+	//
 	// @brief This method is used to test partition existence.
 	//
 	// @return BoolResponse
 	HasPartition(ctx context.Context, in *milvuspb.HasPartitionRequest, opts ...grpc.CallOption) (*milvuspb.BoolResponse, error)
-	//*
+	//
 	// @brief This method is used to show partition information
 	//
 	// @param ShowPartitionRequest, target collection name.
@@ -861,6 +1912,12 @@ type RootCoordClient interface {
 	GetImportState(ctx context.Context, in *milvuspb.GetImportStateRequest, opts ...grpc.CallOption) (*milvuspb.GetImportStateResponse, error)
 	ListImportTasks(ctx context.Context, in *milvuspb.ListImportTasksRequest, opts ...grpc.CallOption) (*milvuspb.ListImportTasksResponse, error)
 	ReportImport(ctx context.Context, in *ImportResult, opts ...grpc.CallOption) (*commonpb.Status, error)
+	Export(ctx context.Context, in *ExportRequest, opts ...grpc.CallOption) (*ExportResponse, error)
+	GetExportState(ctx context.Context, in *GetExportStateRequest, opts ...grpc.CallOption) (*GetExportStateResponse, error)
+	ListExportTasks(ctx context.Context, in *ListExportTasksRequest, opts ...grpc.CallOption) (*ListExportTasksResponse, error)
+	ReportExport(ctx context.Context, in *ExportResult, opts ...grpc.CallOption) (*commonpb.Status, error)
+	RestoreCollection(ctx context.Context, in *RestoreCollectionRequest, opts ...grpc.CallOption) (*RestoreCollectionResponse, error)
+	GetRestoreState(ctx context.Context, in *GetRestoreStateRequest, opts ...grpc.CallOption) (*GetRestoreStateResponse, error)
 	// https://wiki.lfaidata.foundation/display/MIL/MEP+27+--+Support+Basic+Authentication
 	CreateCredential(ctx context.Context, in *internalpb.CredentialInfo, opts ...grpc.CallOption) (*commonpb.Status, error)
 	UpdateCredential(ctx context.Context, in *internalpb.CredentialInfo, opts ...grpc.CallOption) (*commonpb.Status, error)
@@ -1131,6 +2188,60 @@ func (c *rootCoordClient) ReportImport(ctx context.Context, in *ImportResult, op
 	return out, nil
 }
 
+func (c *rootCoordClient) Export(ctx context.Context, in *ExportRequest, opts ...grpc.CallOption) (*ExportResponse, error) {
+	out := new(ExportResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.rootcoord.RootCoord/Export", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rootCoordClient) GetExportState(ctx context.Context, in *GetExportStateRequest, opts ...grpc.CallOption) (*GetExportStateResponse, error) {
+	out := new(GetExportStateResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.rootcoord.RootCoord/GetExportState", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rootCoordClient) ListExportTasks(ctx context.Context, in *ListExportTasksRequest, opts ...grpc.CallOption) (*ListExportTasksResponse, error) {
+	out := new(ListExportTasksResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.rootcoord.RootCoord/ListExportTasks", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rootCoordClient) ReportExport(ctx context.Context, in *ExportResult, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.rootcoord.RootCoord/ReportExport", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rootCoordClient) RestoreCollection(ctx context.Context, in *RestoreCollectionRequest, opts ...grpc.CallOption) (*RestoreCollectionResponse, error) {
+	out := new(RestoreCollectionResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.rootcoord.RootCoord/RestoreCollection", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rootCoordClient) GetRestoreState(ctx context.Context, in *GetRestoreStateRequest, opts ...grpc.CallOption) (*GetRestoreStateResponse, error) {
+	out := new(GetRestoreStateResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.rootcoord.RootCoord/GetRestoreState", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *rootCoordClient) CreateCredential(ctx context.Context, in *internalpb.CredentialInfo, opts ...grpc.CallOption) (*commonpb.Status, error) {
 	out := new(commonpb.Status)
 	err := c.cc.Invoke(ctx, "/milvus.proto.rootcoord.RootCoord/CreateCredential", in, out, opts...)
@@ -1262,28 +2373,28 @@ type RootCoordServer interface {
 	GetComponentStates(context.Context, *milvuspb.GetComponentStatesRequest) (*milvuspb.ComponentStates, error)
 	GetTimeTickChannel(context.Context, *internalpb.GetTimeTickChannelRequest) (*milvuspb.StringResponse, error)
 	GetStatisticsChannel(context.Context, *internalpb.GetStatisticsChannelRequest) (*milvuspb.StringResponse, error)
-	//*
+	//
 	// @brief This method is used to create collection
 	//
 	// @param CreateCollectionRequest, use to provide collection information to be created.
 	//
 	// @return Status
 	CreateCollection(context.Context, *milvuspb.CreateCollectionRequest) (*commonpb.Status, error)
-	//*
+	//
 	// @brief This method is used to delete collection.
 	//
 	// @param DropCollectionRequest, collection name is going to be deleted.
 	//
 	// @return Status
 	DropCollection(context.Context, *milvuspb.DropCollectionRequest) (*commonpb.Status, error)
-	//*
+	//
 	// @brief This method is used to test collection existence.
 	//
 	// @param HasCollectionRequest, collection name is going to be tested.
 	//
 	// @return BoolResponse
 	HasCollection(context.Context, *milvuspb.HasCollectionRequest) (*milvuspb.BoolResponse, error)
-	//*
+	//
 	// @brief This method is used to get collection schema.
 	//
 	// @param DescribeCollectionRequest, target collection name.
@@ -1293,28 +2404,28 @@ type RootCoordServer interface {
 	CreateAlias(context.Context, *milvuspb.CreateAliasRequest) (*commonpb.Status, error)
 	DropAlias(context.Context, *milvuspb.DropAliasRequest) (*commonpb.Status, error)
 	AlterAlias(context.Context, *milvuspb.AlterAliasRequest) (*commonpb.Status, error)
-	//*
+	//
 	// @brief This method is used to list all collections.
 	//
 	// @return StringListResponse, collection name list
 	ShowCollections(context.Context, *milvuspb.ShowCollectionsRequest) (*milvuspb.ShowCollectionsResponse, error)
 	AlterCollection(context.Context, *milvuspb.AlterCollectionRequest) (*commonpb.Status, error)
-	//*
+	//
 	// @brief This method is used to create partition
 	//
 	// @return Status
 	CreatePartition(context.Context, *milvuspb.CreatePartitionRequest) (*commonpb.Status, error)
-	//*
+	//
 	// @brief This method is used to drop partition
 	//
 	// @return Status
 	DropPartition(context.Context, *milvuspb.DropPartitionRequest) (*commonpb.Status, error)
-	//*
+	//
 	// @brief This method is used to test partition existence.
 	//
 	// @return BoolResponse
 	HasPartition(context.Context, *milvuspb.HasPartitionRequest) (*milvuspb.BoolResponse, error)
-	//*
+	//
 	// @brief This method is used to show partition information
 	//
 	// @param ShowPartitionRequest, target collection name.
@@ -1335,6 +2446,12 @@ type RootCoordServer interface {
 	GetImportState(context.Context, *milvuspb.GetImportStateRequest) (*milvuspb.GetImportStateResponse, error)
 	ListImportTasks(context.Context, *milvuspb.ListImportTasksRequest) (*milvuspb.ListImportTasksResponse, error)
 	ReportImport(context.Context, *ImportResult) (*commonpb.Status, error)
+	Export(context.Context, *ExportRequest) (*ExportResponse, error)
+	GetExportState(context.Context, *GetExportStateRequest) (*GetExportStateResponse, error)
+	ListExportTasks(context.Context, *ListExportTasksRequest) (*ListExportTasksResponse, error)
+	ReportExport(context.Context, *ExportResult) (*commonpb.Status, error)
+	RestoreCollection(context.Context, *RestoreCollectionRequest) (*RestoreCollectionResponse, error)
+	GetRestoreState(context.Context, *GetRestoreStateRequest) (*GetRestoreStateResponse, error)
 	// https://wiki.lfaidata.foundation/display/MIL/MEP+27+--+Support+Basic+Authentication
 	CreateCredential(context.Context, *internalpb.CredentialInfo) (*commonpb.Status, error)
 	UpdateCredential(context.Context, *internalpb.CredentialInfo) (*commonpb.Status, error)
@@ -1439,6 +2556,24 @@ func (*UnimplementedRootCoordServer) ListImportTasks(ctx context.Context, req *m
 func (*UnimplementedRootCoordServer) ReportImport(ctx context.Context, req *ImportResult) (*commonpb.Status, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ReportImport not implemented")
 }
+func (*UnimplementedRootCoordServer) Export(ctx context.Context, req *ExportRequest) (*ExportResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Export not implemented")
+}
+func (*UnimplementedRootCoordServer) GetExportState(ctx context.Context, req *GetExportStateRequest) (*GetExportStateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetExportState not implemented")
+}
+func (*UnimplementedRootCoordServer) ListExportTasks(ctx context.Context, req *ListExportTasksRequest) (*ListExportTasksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListExportTasks not implemented")
+}
+func (*UnimplementedRootCoordServer) ReportExport(ctx context.Context, req *ExportResult) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReportExport not implemented")
+}
+func (*UnimplementedRootCoordServer) RestoreCollection(ctx context.Context, req *RestoreCollectionRequest) (*RestoreCollectionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreCollection not implemented")
+}
+func (*UnimplementedRootCoordServer) GetRestoreState(ctx context.Context, req *GetRestoreStateRequest) (*GetRestoreStateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRestoreState not implemented")
+}
 func (*UnimplementedRootCoordServer) CreateCredential(ctx context.Context, req *internalpb.CredentialInfo) (*commonpb.Status, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateCredential not implemented")
 }
@@ -1972,6 +3107,114 @@ func _RootCoord_ReportImport_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _RootCoord_Export_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RootCoordServer).Export(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.rootcoord.RootCoord/Export",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RootCoordServer).Export(ctx, req.(*ExportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RootCoord_GetExportState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetExportStateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RootCoordServer).GetExportState(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.rootcoord.RootCoord/GetExportState",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RootCoordServer).GetExportState(ctx, req.(*GetExportStateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RootCoord_ListExportTasks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListExportTasksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RootCoordServer).ListExportTasks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.rootcoord.RootCoord/ListExportTasks",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RootCoordServer).ListExportTasks(ctx, req.(*ListExportTasksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RootCoord_ReportExport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportResult)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RootCoordServer).ReportExport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.rootcoord.RootCoord/ReportExport",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RootCoordServer).ReportExport(ctx, req.(*ExportResult))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RootCoord_RestoreCollection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreCollectionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RootCoordServer).RestoreCollection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.rootcoord.RootCoord/RestoreCollection",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RootCoordServer).RestoreCollection(ctx, req.(*RestoreCollectionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RootCoord_GetRestoreState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRestoreStateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RootCoordServer).GetRestoreState(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.rootcoord.RootCoord/GetRestoreState",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RootCoordServer).GetRestoreState(ctx, req.(*GetRestoreStateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _RootCoord_CreateCredential_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(internalpb.CredentialInfo)
 	if err := dec(in); err != nil {
@@ -2336,6 +3579,30 @@ var _RootCoord_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ReportImport",
 			Handler:    _RootCoord_ReportImport_Handler,
 		},
+		{
+			MethodName: "Export",
+			Handler:    _RootCoord_Export_Handler,
+		},
+		{
+			MethodName: "GetExportState",
+			Handler:    _RootCoord_GetExportState_Handler,
+		},
+		{
+			MethodName: "ListExportTasks",
+			Handler:    _RootCoord_ListExportTasks_Handler,
+		},
+		{
+			MethodName: "ReportExport",
+			Handler:    _RootCoord_ReportExport_Handler,
+		},
+		{
+			MethodName: "RestoreCollection",
+			Handler:    _RootCoord_RestoreCollection_Handler,
+		},
+		{
+			MethodName: "GetRestoreState",
+			Handler:    _RootCoord_GetRestoreState_Handler,
+		},
 		{
 			MethodName: "CreateCredential",
 			Handler:    _RootCoord_CreateCredential_Handler,
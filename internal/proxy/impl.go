@@ -37,6 +37,7 @@ import (
 	"github.com/milvus-io/milvus/internal/proto/internalpb"
 	"github.com/milvus-io/milvus/internal/proto/proxypb"
 	"github.com/milvus-io/milvus/internal/proto/querypb"
+	"github.com/milvus-io/milvus/internal/proto/rootcoordpb"
 	"github.com/milvus-io/milvus/internal/util"
 	"github.com/milvus-io/milvus/internal/util/commonpbutil"
 	"github.com/milvus-io/milvus/internal/util/crypto"
@@ -2086,6 +2087,129 @@ func (node *Proxy) Insert(ctx context.Context, request *milvuspb.InsertRequest)
 	return it.result, nil
 }
 
+// Upsert replaces the records with the same primary keys in collection, records that don't exist are inserted.
+func (node *Proxy) Upsert(ctx context.Context, request *milvuspb.InsertRequest) (*milvuspb.MutationResult, error) {
+	sp, ctx := trace.StartSpanFromContextWithOperationName(ctx, "Proxy-Upsert")
+	defer sp.Finish()
+	log := log.Ctx(ctx)
+	log.Debug("Start processing upsert request in Proxy")
+	defer log.Debug("Finish processing upsert request in Proxy")
+
+	if !node.checkHealthy() {
+		return &milvuspb.MutationResult{
+			Status: unhealthyStatus(),
+		}, nil
+	}
+	method := "Upsert"
+	tr := timerecord.NewTimeRecorder(method)
+	receiveSize := proto.Size(request)
+	rateCol.Add(internalpb.RateType_DMLInsert.String(), float64(receiveSize))
+	metrics.ProxyReceiveBytes.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10), metrics.UpsertLabel).Add(float64(receiveSize))
+
+	metrics.ProxyFunctionCall.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10), method, metrics.TotalLabel).Inc()
+	it := &insertTask{
+		ctx:       ctx,
+		Condition: NewTaskCondition(ctx),
+		BaseInsertTask: BaseInsertTask{
+			BaseMsg: msgstream.BaseMsg{
+				HashValues: request.HashKeys,
+			},
+			InsertRequest: internalpb.InsertRequest{
+				Base: commonpbutil.NewMsgBase(
+					commonpbutil.WithMsgType(commonpb.MsgType_Insert),
+					commonpbutil.WithMsgID(0),
+					commonpbutil.WithSourceID(paramtable.GetNodeID()),
+				),
+				CollectionName: request.CollectionName,
+				PartitionName:  request.PartitionName,
+				FieldsData:     request.FieldsData,
+				NumRows:        uint64(request.NumRows),
+				Version:        internalpb.InsertDataVersion_ColumnBased,
+			},
+		},
+		idAllocator:   node.rowIDAllocator,
+		segIDAssigner: node.segAssigner,
+		chMgr:         node.chMgr,
+		chTicker:      node.chTicker,
+	}
+	if len(it.PartitionName) <= 0 {
+		it.PartitionName = Params.CommonCfg.DefaultPartitionName
+	}
+	ut := &upsertTask{
+		ctx:        ctx,
+		Condition:  NewTaskCondition(ctx),
+		insertTask: it,
+		chMgr:      node.chMgr,
+	}
+
+	constructFailedResponse := func(err error) *milvuspb.MutationResult {
+		numRows := request.NumRows
+		errIndex := make([]uint32, numRows)
+		for i := uint32(0); i < numRows; i++ {
+			errIndex[i] = i
+		}
+
+		return &milvuspb.MutationResult{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				Reason:    err.Error(),
+			},
+			ErrIndex: errIndex,
+		}
+	}
+
+	log.Debug("Enqueue upsert request in Proxy",
+		zap.String("role", typeutil.ProxyRole),
+		zap.String("db", request.DbName),
+		zap.String("collection", request.CollectionName),
+		zap.String("partition", request.PartitionName),
+		zap.Int("len(FieldsData)", len(request.FieldsData)),
+		zap.Int("len(HashKeys)", len(request.HashKeys)),
+		zap.Uint32("NumRows", request.NumRows))
+
+	if err := node.sched.dmQueue.Enqueue(ut); err != nil {
+		log.Warn("Failed to enqueue upsert task: " + err.Error())
+		metrics.ProxyFunctionCall.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10), method,
+			metrics.AbandonLabel).Inc()
+		return constructFailedResponse(err), nil
+	}
+
+	log.Debug("Detail of upsert request in Proxy",
+		zap.String("role", typeutil.ProxyRole),
+		zap.Int64("msgID", ut.ID()),
+		zap.Uint64("BeginTS", ut.BeginTs()),
+		zap.Uint64("EndTS", ut.EndTs()),
+		zap.String("db", request.DbName),
+		zap.String("collection", request.CollectionName),
+		zap.String("partition", request.PartitionName),
+		zap.Uint32("NumRows", request.NumRows))
+
+	if err := ut.WaitToFinish(); err != nil {
+		log.Warn("Failed to execute upsert task in task scheduler: " + err.Error())
+		metrics.ProxyFunctionCall.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10), method,
+			metrics.FailLabel).Inc()
+		return constructFailedResponse(err), nil
+	}
+
+	if ut.result.Status.ErrorCode != commonpb.ErrorCode_Success {
+		numRows := request.NumRows
+		errIndex := make([]uint32, numRows)
+		for i := uint32(0); i < numRows; i++ {
+			errIndex[i] = i
+		}
+		ut.result.ErrIndex = errIndex
+	}
+
+	// UpsertCnt always equals to the number of entities in the request
+	ut.result.UpsertCnt = int64(request.NumRows)
+
+	metrics.ProxyFunctionCall.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10), method,
+		metrics.SuccessLabel).Inc()
+	metrics.ProxyMutationLatency.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10), metrics.UpsertLabel).Observe(float64(tr.ElapseSpan().Milliseconds()))
+	metrics.ProxyCollectionMutationLatency.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10), metrics.UpsertLabel, request.CollectionName).Observe(float64(tr.ElapseSpan().Milliseconds()))
+	return ut.result, nil
+}
+
 // Delete delete records from collection, then these records cannot be searched.
 func (node *Proxy) Delete(ctx context.Context, request *milvuspb.DeleteRequest) (*milvuspb.MutationResult, error) {
 	sp, ctx := trace.StartSpanFromContextWithOperationName(ctx, "Proxy-Delete")
@@ -2293,6 +2417,242 @@ func (node *Proxy) Search(ctx context.Context, request *milvuspb.SearchRequest)
 	return qt.result, nil
 }
 
+// HybridSearch searches multiple vector fields of a collection, and merges the results with a reranker.
+func (node *Proxy) HybridSearch(ctx context.Context, request *proxypb.HybridSearchRequest) (*milvuspb.SearchResults, error) {
+	receiveSize := proto.Size(request)
+	metrics.ProxyReceiveBytes.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10), metrics.SearchLabel).Add(float64(receiveSize))
+
+	for _, subReq := range request.GetRequests() {
+		rateCol.Add(internalpb.RateType_DQLSearch.String(), float64(subReq.GetNq()))
+	}
+
+	if !node.checkHealthy() {
+		return &milvuspb.SearchResults{
+			Status: unhealthyStatus(),
+		}, nil
+	}
+	method := "HybridSearch"
+	tr := timerecord.NewTimeRecorder(method)
+	metrics.ProxyFunctionCall.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10), method,
+		metrics.TotalLabel).Inc()
+
+	sp, ctx := trace.StartSpanFromContextWithOperationName(ctx, "Proxy-HybridSearch")
+	defer sp.Finish()
+
+	qt := &hybridSearchTask{
+		ctx:       ctx,
+		Condition: NewTaskCondition(ctx),
+		base: commonpbutil.NewMsgBase(
+			commonpbutil.WithMsgType(commonpb.MsgType_Search),
+			commonpbutil.WithSourceID(paramtable.GetNodeID()),
+		),
+		request:  request,
+		qc:       node.queryCoord,
+		tr:       timerecord.NewTimeRecorder("hybrid search"),
+		shardMgr: node.shardMgr,
+	}
+
+	log := log.Ctx(ctx).With(
+		zap.String("role", typeutil.ProxyRole),
+		zap.String("db", request.DbName),
+		zap.String("collection", request.CollectionName),
+		zap.Any("partitions", request.PartitionNames),
+		zap.Int("len(requests)", len(request.Requests)),
+		zap.Any("OutputFields", request.OutputFields),
+		zap.Any("rank_params", request.RankParams),
+		zap.Uint64("travel_timestamp", request.TravelTimestamp),
+		zap.Uint64("guarantee_timestamp", request.GuaranteeTimestamp))
+
+	log.Debug(
+		rpcReceived(method))
+
+	if err := node.sched.dqQueue.Enqueue(qt); err != nil {
+		log.Warn(
+			rpcFailedToEnqueue(method),
+			zap.Error(err))
+
+		metrics.ProxyFunctionCall.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10), method,
+			metrics.AbandonLabel).Inc()
+
+		return &milvuspb.SearchResults{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				Reason:    err.Error(),
+			},
+		}, nil
+	}
+	tr.CtxRecord(ctx, "hybrid search request enqueue")
+
+	log.Debug(
+		rpcEnqueued(method),
+		zap.Uint64("timestamp", qt.BeginTs()))
+
+	if err := qt.WaitToFinish(); err != nil {
+		log.Warn(
+			rpcFailedToWaitToFinish(method),
+			zap.Error(err))
+
+		metrics.ProxyFunctionCall.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10), method,
+			metrics.FailLabel).Inc()
+
+		return &milvuspb.SearchResults{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				Reason:    err.Error(),
+			},
+		}, nil
+	}
+
+	log.Debug(rpcDone(method))
+
+	metrics.ProxyFunctionCall.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10), method,
+		metrics.SuccessLabel).Inc()
+	searchDur := tr.ElapseSpan().Milliseconds()
+	metrics.ProxySQLatency.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10),
+		metrics.SearchLabel).Observe(float64(searchDur))
+	metrics.ProxyCollectionSQLatency.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10),
+		metrics.SearchLabel, request.CollectionName).Observe(float64(searchDur))
+	if qt.result != nil {
+		sentSize := proto.Size(qt.result)
+		metrics.ProxyReadReqSendBytes.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10)).Add(float64(sentSize))
+		rateCol.Add(metricsinfo.ReadResultThroughput, float64(sentSize))
+	}
+	return qt.result, nil
+}
+
+// SearchIterator searches the collection page by page, each page resumes from the cursor returned by the previous one.
+func (node *Proxy) SearchIterator(ctx context.Context, request *proxypb.SearchIteratorRequest) (*proxypb.SearchIteratorResults, error) {
+	receiveSize := proto.Size(request)
+	metrics.ProxyReceiveBytes.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10), metrics.SearchLabel).Add(float64(receiveSize))
+
+	rateCol.Add(internalpb.RateType_DQLSearch.String(), float64(request.GetRequest().GetNq()))
+
+	if !node.checkHealthy() {
+		return &proxypb.SearchIteratorResults{
+			Results: &milvuspb.SearchResults{
+				Status: unhealthyStatus(),
+			},
+		}, nil
+	}
+	method := "SearchIterator"
+	tr := timerecord.NewTimeRecorder(method)
+	metrics.ProxyFunctionCall.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10), method,
+		metrics.TotalLabel).Inc()
+
+	sp, ctx := trace.StartSpanFromContextWithOperationName(ctx, "Proxy-SearchIterator")
+	defer sp.Finish()
+
+	iterator, err := newIteratorParams(request.GetBatchSize(), request.GetCursor())
+	if err != nil {
+		metrics.ProxyFunctionCall.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10), method,
+			metrics.FailLabel).Inc()
+		return &proxypb.SearchIteratorResults{
+			Results: &milvuspb.SearchResults{
+				Status: &commonpb.Status{
+					ErrorCode: commonpb.ErrorCode_IllegalArgument,
+					Reason:    err.Error(),
+				},
+			},
+		}, nil
+	}
+
+	searchReq := request.GetRequest()
+	if searchReq == nil {
+		searchReq = &milvuspb.SearchRequest{}
+	}
+	qt := &searchTask{
+		ctx:       ctx,
+		Condition: NewTaskCondition(ctx),
+		SearchRequest: &internalpb.SearchRequest{
+			Base: commonpbutil.NewMsgBase(
+				commonpbutil.WithMsgType(commonpb.MsgType_Search),
+				commonpbutil.WithSourceID(paramtable.GetNodeID()),
+			),
+			ReqID: paramtable.GetNodeID(),
+		},
+		request:  searchReq,
+		qc:       node.queryCoord,
+		tr:       timerecord.NewTimeRecorder("search iterator"),
+		shardMgr: node.shardMgr,
+		iterator: iterator,
+	}
+
+	log := log.Ctx(ctx).With(
+		zap.String("role", typeutil.ProxyRole),
+		zap.String("db", searchReq.DbName),
+		zap.String("collection", searchReq.CollectionName),
+		zap.Any("partitions", searchReq.PartitionNames),
+		zap.Any("dsl", searchReq.Dsl),
+		zap.Any("OutputFields", searchReq.OutputFields),
+		zap.Any("search_params", searchReq.SearchParams),
+		zap.Int64("batch_size", request.GetBatchSize()),
+		zap.Uint64("mvcc_timestamp", request.GetCursor().GetMvccTimestamp()),
+		zap.Int64("returned", request.GetCursor().GetReturned()))
+
+	log.Debug(
+		rpcReceived(method))
+
+	if err := node.sched.dqQueue.Enqueue(qt); err != nil {
+		log.Warn(
+			rpcFailedToEnqueue(method),
+			zap.Error(err))
+
+		metrics.ProxyFunctionCall.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10), method,
+			metrics.AbandonLabel).Inc()
+
+		return &proxypb.SearchIteratorResults{
+			Results: &milvuspb.SearchResults{
+				Status: &commonpb.Status{
+					ErrorCode: commonpb.ErrorCode_UnexpectedError,
+					Reason:    err.Error(),
+				},
+			},
+		}, nil
+	}
+	tr.CtxRecord(ctx, "search iterator request enqueue")
+
+	log.Debug(
+		rpcEnqueued(method),
+		zap.Uint64("timestamp", qt.Base.Timestamp))
+
+	if err := qt.WaitToFinish(); err != nil {
+		log.Warn(
+			rpcFailedToWaitToFinish(method),
+			zap.Error(err))
+
+		metrics.ProxyFunctionCall.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10), method,
+			metrics.FailLabel).Inc()
+
+		return &proxypb.SearchIteratorResults{
+			Results: &milvuspb.SearchResults{
+				Status: &commonpb.Status{
+					ErrorCode: commonpb.ErrorCode_UnexpectedError,
+					Reason:    err.Error(),
+				},
+			},
+		}, nil
+	}
+
+	log.Debug(rpcDone(method))
+
+	metrics.ProxyFunctionCall.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10), method,
+		metrics.SuccessLabel).Inc()
+	searchDur := tr.ElapseSpan().Milliseconds()
+	metrics.ProxySQLatency.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10),
+		metrics.SearchLabel).Observe(float64(searchDur))
+	metrics.ProxyCollectionSQLatency.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10),
+		metrics.SearchLabel, searchReq.CollectionName).Observe(float64(searchDur))
+	if qt.result != nil {
+		sentSize := proto.Size(qt.result)
+		metrics.ProxyReadReqSendBytes.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10)).Add(float64(sentSize))
+		rateCol.Add(metricsinfo.ReadResultThroughput, float64(sentSize))
+	}
+	return &proxypb.SearchIteratorResults{
+		Results: qt.result,
+		Cursor:  iterator.next,
+	}, nil
+}
+
 // Flush notify data nodes to persist the data of collection.
 func (node *Proxy) Flush(ctx context.Context, request *milvuspb.FlushRequest) (*milvuspb.FlushResponse, error) {
 	resp := &milvuspb.FlushResponse{
@@ -2476,6 +2836,141 @@ func (node *Proxy) Query(ctx context.Context, request *milvuspb.QueryRequest) (*
 	return ret, nil
 }
 
+// QueryIterator queries the collection page by page in the order of primary keys,
+// each page resumes from the cursor returned by the previous one.
+func (node *Proxy) QueryIterator(ctx context.Context, request *proxypb.QueryIteratorRequest) (*proxypb.QueryIteratorResults, error) {
+	receiveSize := proto.Size(request)
+	metrics.ProxyReceiveBytes.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10), metrics.QueryLabel).Add(float64(receiveSize))
+
+	rateCol.Add(internalpb.RateType_DQLQuery.String(), 1)
+
+	if !node.checkHealthy() {
+		return &proxypb.QueryIteratorResults{
+			Results: &milvuspb.QueryResults{
+				Status: unhealthyStatus(),
+			},
+		}, nil
+	}
+
+	sp, ctx := trace.StartSpanFromContextWithOperationName(ctx, "Proxy-QueryIterator")
+	defer sp.Finish()
+	tr := timerecord.NewTimeRecorder("QueryIterator")
+
+	method := "QueryIterator"
+
+	metrics.ProxyFunctionCall.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10), method,
+		metrics.TotalLabel).Inc()
+
+	iterator, err := newIteratorParams(request.GetBatchSize(), request.GetCursor())
+	if err != nil {
+		metrics.ProxyFunctionCall.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10), method,
+			metrics.FailLabel).Inc()
+		return &proxypb.QueryIteratorResults{
+			Results: &milvuspb.QueryResults{
+				Status: &commonpb.Status{
+					ErrorCode: commonpb.ErrorCode_IllegalArgument,
+					Reason:    err.Error(),
+				},
+			},
+		}, nil
+	}
+
+	queryReq := request.GetRequest()
+	if queryReq == nil {
+		queryReq = &milvuspb.QueryRequest{}
+	}
+	qt := &queryTask{
+		ctx:       ctx,
+		Condition: NewTaskCondition(ctx),
+		RetrieveRequest: &internalpb.RetrieveRequest{
+			Base: commonpbutil.NewMsgBase(
+				commonpbutil.WithMsgType(commonpb.MsgType_Retrieve),
+				commonpbutil.WithSourceID(paramtable.GetNodeID()),
+			),
+			ReqID: paramtable.GetNodeID(),
+		},
+		request:          queryReq,
+		qc:               node.queryCoord,
+		queryShardPolicy: mergeRoundRobinPolicy,
+		shardMgr:         node.shardMgr,
+		iterator:         iterator,
+	}
+
+	log := log.Ctx(ctx).With(
+		zap.String("role", typeutil.ProxyRole),
+		zap.String("db", queryReq.DbName),
+		zap.String("collection", queryReq.CollectionName),
+		zap.Strings("partitions", queryReq.PartitionNames))
+
+	log.Debug(
+		rpcReceived(method),
+		zap.String("expr", queryReq.Expr),
+		zap.Strings("OutputFields", queryReq.OutputFields),
+		zap.Int64("batch_size", request.GetBatchSize()),
+		zap.Uint64("mvcc_timestamp", request.GetCursor().GetMvccTimestamp()))
+
+	if err := node.sched.dqQueue.Enqueue(qt); err != nil {
+		log.Warn(
+			rpcFailedToEnqueue(method),
+			zap.Error(err))
+
+		metrics.ProxyFunctionCall.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10), method,
+			metrics.AbandonLabel).Inc()
+
+		return &proxypb.QueryIteratorResults{
+			Results: &milvuspb.QueryResults{
+				Status: &commonpb.Status{
+					ErrorCode: commonpb.ErrorCode_UnexpectedError,
+					Reason:    err.Error(),
+				},
+			},
+		}, nil
+	}
+	tr.CtxRecord(ctx, "query iterator request enqueue")
+
+	log.Debug(rpcEnqueued(method))
+
+	if err := qt.WaitToFinish(); err != nil {
+		log.Warn(
+			rpcFailedToWaitToFinish(method),
+			zap.Error(err))
+
+		metrics.ProxyFunctionCall.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10), method,
+			metrics.FailLabel).Inc()
+
+		return &proxypb.QueryIteratorResults{
+			Results: &milvuspb.QueryResults{
+				Status: &commonpb.Status{
+					ErrorCode: commonpb.ErrorCode_UnexpectedError,
+					Reason:    err.Error(),
+				},
+			},
+		}, nil
+	}
+
+	log.Debug(rpcDone(method))
+
+	metrics.ProxyFunctionCall.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10), method,
+		metrics.SuccessLabel).Inc()
+
+	metrics.ProxySQLatency.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10),
+		metrics.QueryLabel).Observe(float64(tr.ElapseSpan().Milliseconds()))
+	metrics.ProxyCollectionSQLatency.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10),
+		metrics.QueryLabel, queryReq.CollectionName).Observe(float64(tr.ElapseSpan().Milliseconds()))
+
+	ret := &proxypb.QueryIteratorResults{
+		Results: &milvuspb.QueryResults{
+			Status:     qt.result.Status,
+			FieldsData: qt.result.FieldsData,
+		},
+		Cursor: iterator.next,
+	}
+	sentSize := proto.Size(qt.result)
+	rateCol.Add(metricsinfo.ReadResultThroughput, float64(sentSize))
+	metrics.ProxyReadReqSendBytes.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10)).Add(float64(sentSize))
+	return ret, nil
+}
+
 // CreateAlias create alias for collection, then you can search the collection with alias.
 func (node *Proxy) CreateAlias(ctx context.Context, request *milvuspb.CreateAliasRequest) (*commonpb.Status, error) {
 	if !node.checkHealthy() {
@@ -3523,6 +4018,196 @@ func (node *Proxy) ListImportTasks(ctx context.Context, req *milvuspb.ListImport
 	return resp, err
 }
 
+// Export exports data of a collection into files on MinIO/S3 storage, the export task is processed asynchronously
+func (node *Proxy) Export(ctx context.Context, req *rootcoordpb.ExportRequest) (*rootcoordpb.ExportResponse, error) {
+	sp, ctx := trace.StartSpanFromContextWithOperationName(ctx, "Proxy-Export")
+	defer sp.Finish()
+
+	log := log.Ctx(ctx)
+
+	log.Debug("received export request",
+		zap.String("collection", req.GetCollectionName()),
+		zap.Strings("partitions", req.GetPartitionNames()),
+		zap.String("output prefix", req.GetOutputPrefix()),
+		zap.String("format", req.GetFormat()))
+	if !node.checkHealthy() {
+		return &rootcoordpb.ExportResponse{Status: unhealthyStatus()}, nil
+	}
+	method := "Export"
+	tr := timerecord.NewTimeRecorder(method)
+	metrics.ProxyFunctionCall.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10), method,
+		metrics.TotalLabel).Inc()
+	resp, err := node.rootCoord.Export(ctx, req)
+	if err != nil {
+		metrics.ProxyFunctionCall.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10), method, metrics.FailLabel).Inc()
+		log.Error("failed to execute export", zap.Error(err))
+		return &rootcoordpb.ExportResponse{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				Reason:    err.Error(),
+			},
+		}, nil
+	}
+
+	log.Debug("successfully received export response",
+		zap.Int64("task ID", resp.GetTaskId()),
+		zap.Uint64("timestamp", resp.GetTimestamp()))
+	metrics.ProxyFunctionCall.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10), method, metrics.SuccessLabel).Inc()
+	metrics.ProxyReqLatency.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10), method).Observe(float64(tr.ElapseSpan().Milliseconds()))
+	return resp, nil
+}
+
+// GetExportState checks the state of an export task
+func (node *Proxy) GetExportState(ctx context.Context, req *rootcoordpb.GetExportStateRequest) (*rootcoordpb.GetExportStateResponse, error) {
+	sp, ctx := trace.StartSpanFromContextWithOperationName(ctx, "Proxy-GetExportState")
+	defer sp.Finish()
+
+	log := log.Ctx(ctx)
+
+	log.Debug("received get export state request",
+		zap.Int64("task ID", req.GetTaskId()))
+	if !node.checkHealthy() {
+		return &rootcoordpb.GetExportStateResponse{Status: unhealthyStatus()}, nil
+	}
+	method := "GetExportState"
+	tr := timerecord.NewTimeRecorder(method)
+	metrics.ProxyFunctionCall.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10), method,
+		metrics.TotalLabel).Inc()
+	resp, err := node.rootCoord.GetExportState(ctx, req)
+	if err != nil {
+		metrics.ProxyFunctionCall.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10), method, metrics.FailLabel).Inc()
+		log.Error("failed to execute get export state", zap.Error(err))
+		return &rootcoordpb.GetExportStateResponse{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				Reason:    err.Error(),
+			},
+		}, nil
+	}
+
+	log.Debug("successfully received get export state response",
+		zap.Any("state", resp.GetTask().GetState()))
+	metrics.ProxyFunctionCall.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10), method, metrics.SuccessLabel).Inc()
+	metrics.ProxyReqLatency.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10), method).Observe(float64(tr.ElapseSpan().Milliseconds()))
+	return resp, nil
+}
+
+// ListExportTasks gets information of export tasks from rootcoord
+func (node *Proxy) ListExportTasks(ctx context.Context, req *rootcoordpb.ListExportTasksRequest) (*rootcoordpb.ListExportTasksResponse, error) {
+	sp, ctx := trace.StartSpanFromContextWithOperationName(ctx, "Proxy-ListExportTasks")
+	defer sp.Finish()
+
+	log := log.Ctx(ctx)
+
+	log.Debug("received list export tasks request",
+		zap.String("collection", req.GetCollectionName()))
+	if !node.checkHealthy() {
+		return &rootcoordpb.ListExportTasksResponse{Status: unhealthyStatus()}, nil
+	}
+	method := "ListExportTasks"
+	tr := timerecord.NewTimeRecorder(method)
+	metrics.ProxyFunctionCall.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10), method,
+		metrics.TotalLabel).Inc()
+	resp, err := node.rootCoord.ListExportTasks(ctx, req)
+	if err != nil {
+		metrics.ProxyFunctionCall.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10), method, metrics.FailLabel).Inc()
+		log.Error("failed to execute list export tasks", zap.Error(err))
+		return &rootcoordpb.ListExportTasksResponse{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				Reason:    err.Error(),
+			},
+		}, nil
+	}
+
+	log.Debug("successfully received list export tasks response",
+		zap.Int("task num", len(resp.GetTasks())))
+	metrics.ProxyFunctionCall.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10), method, metrics.SuccessLabel).Inc()
+	metrics.ProxyReqLatency.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10), method).Observe(float64(tr.ElapseSpan().Milliseconds()))
+	return resp, nil
+}
+
+// RestoreCollection restores a collection as it was at a timestamp into a new collection, the restore runs in background
+func (node *Proxy) RestoreCollection(ctx context.Context, req *rootcoordpb.RestoreCollectionRequest) (*rootcoordpb.RestoreCollectionResponse, error) {
+	sp, ctx := trace.StartSpanFromContextWithOperationName(ctx, "Proxy-RestoreCollection")
+	defer sp.Finish()
+
+	log := log.Ctx(ctx)
+
+	log.Debug("received restore collection request",
+		zap.String("collection", req.GetCollectionName()),
+		zap.Int64("collection ID", req.GetCollectionID()),
+		zap.Uint64("timestamp", req.GetTimestamp()),
+		zap.String("new collection", req.GetNewCollectionName()))
+	if !node.checkHealthy() {
+		return &rootcoordpb.RestoreCollectionResponse{Status: unhealthyStatus()}, nil
+	}
+	if err := validateCollectionName(req.GetNewCollectionName()); err != nil {
+		return &rootcoordpb.RestoreCollectionResponse{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_IllegalArgument,
+				Reason:    err.Error(),
+			},
+		}, nil
+	}
+	method := "RestoreCollection"
+	tr := timerecord.NewTimeRecorder(method)
+	metrics.ProxyFunctionCall.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10), method,
+		metrics.TotalLabel).Inc()
+	resp, err := node.rootCoord.RestoreCollection(ctx, req)
+	if err != nil {
+		metrics.ProxyFunctionCall.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10), method, metrics.FailLabel).Inc()
+		log.Error("failed to execute restore collection", zap.Error(err))
+		return &rootcoordpb.RestoreCollectionResponse{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				Reason:    err.Error(),
+			},
+		}, nil
+	}
+
+	log.Debug("successfully received restore collection response",
+		zap.Int64("task ID", resp.GetTaskId()))
+	metrics.ProxyFunctionCall.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10), method, metrics.SuccessLabel).Inc()
+	metrics.ProxyReqLatency.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10), method).Observe(float64(tr.ElapseSpan().Milliseconds()))
+	return resp, nil
+}
+
+// GetRestoreState checks the state of a restore task from rootcoord
+func (node *Proxy) GetRestoreState(ctx context.Context, req *rootcoordpb.GetRestoreStateRequest) (*rootcoordpb.GetRestoreStateResponse, error) {
+	sp, ctx := trace.StartSpanFromContextWithOperationName(ctx, "Proxy-GetRestoreState")
+	defer sp.Finish()
+
+	log := log.Ctx(ctx)
+
+	log.Debug("received get restore state request",
+		zap.Int64("task ID", req.GetTaskId()))
+	if !node.checkHealthy() {
+		return &rootcoordpb.GetRestoreStateResponse{Status: unhealthyStatus()}, nil
+	}
+	method := "GetRestoreState"
+	tr := timerecord.NewTimeRecorder(method)
+	metrics.ProxyFunctionCall.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10), method,
+		metrics.TotalLabel).Inc()
+	resp, err := node.rootCoord.GetRestoreState(ctx, req)
+	if err != nil {
+		metrics.ProxyFunctionCall.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10), method, metrics.FailLabel).Inc()
+		log.Error("failed to execute get restore state", zap.Error(err))
+		return &rootcoordpb.GetRestoreStateResponse{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				Reason:    err.Error(),
+			},
+		}, nil
+	}
+
+	log.Debug("successfully received get restore state response",
+		zap.Any("state", resp.GetTask().GetState()))
+	metrics.ProxyFunctionCall.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10), method, metrics.SuccessLabel).Inc()
+	metrics.ProxyReqLatency.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10), method).Observe(float64(tr.ElapseSpan().Milliseconds()))
+	return resp, nil
+}
+
 // InvalidateCredentialCache invalidate the credential cache of specified username.
 func (node *Proxy) InvalidateCredentialCache(ctx context.Context, request *proxypb.InvalidateCredCacheRequest) (*commonpb.Status, error) {
 	ctx = logutil.WithModule(ctx, moduleName)
@@ -0,0 +1,295 @@
+package proxy
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+
+	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/milvuspb"
+	"github.com/milvus-io/milvus-proto/go-api/schemapb"
+	"github.com/milvus-io/milvus/internal/common"
+	"github.com/milvus-io/milvus/internal/proto/planpb"
+	"github.com/milvus-io/milvus/internal/proto/proxypb"
+	"github.com/milvus-io/milvus/internal/util/distance"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
+)
+
+// iteratorParams holds the page size and the position of a query or search iterator.
+// All pages of an iterator read the same MVCC snapshot, and each page resumes from
+// the cursor returned by the previous one instead of skipping the previous results by offset.
+type iteratorParams struct {
+	batchSize int64
+	// nil for the first page
+	cursor *proxypb.IteratorCursor
+	// cursor of the next page, set after the page is reduced
+	next *proxypb.IteratorCursor
+}
+
+func newIteratorParams(batchSize int64, cursor *proxypb.IteratorCursor) (*iteratorParams, error) {
+	if err := validateLimit(batchSize); err != nil {
+		return nil, fmt.Errorf("batch size [%d] is invalid, %w", batchSize, err)
+	}
+	if cursor != nil && cursor.GetMvccTimestamp() == 0 {
+		return nil, errors.New("mvcc timestamp of iterator cursor is missing")
+	}
+	return &iteratorParams{
+		batchSize: batchSize,
+		cursor:    cursor,
+	}, nil
+}
+
+// mvccTimestamp returns the snapshot timestamp of the iterator, or travelTs for the first page.
+func (p *iteratorParams) mvccTimestamp(travelTs Timestamp) Timestamp {
+	if p.cursor != nil {
+		return p.cursor.GetMvccTimestamp()
+	}
+	return travelTs
+}
+
+// appendIteratorCursorExpr restricts the predicates of a retrieve plan to the entities whose
+// primary keys are greater than the last primary key of the cursor.
+func appendIteratorCursorExpr(plan *planpb.PlanNode, pkField *schemapb.FieldSchema, lastPKs *schemapb.IDs) error {
+	if typeutil.GetSizeOfIDs(lastPKs) == 0 {
+		return nil
+	}
+	value := &planpb.GenericValue{}
+	switch pkField.GetDataType() {
+	case schemapb.DataType_Int64:
+		pks := lastPKs.GetIntId().GetData()
+		if len(pks) == 0 {
+			return fmt.Errorf("primary key of iterator cursor mis-match with type %s", pkField.GetDataType().String())
+		}
+		value.Val = &planpb.GenericValue_Int64Val{Int64Val: pks[len(pks)-1]}
+	case schemapb.DataType_VarChar:
+		pks := lastPKs.GetStrId().GetData()
+		if len(pks) == 0 {
+			return fmt.Errorf("primary key of iterator cursor mis-match with type %s", pkField.GetDataType().String())
+		}
+		value.Val = &planpb.GenericValue_StringVal{StringVal: pks[len(pks)-1]}
+	default:
+		return errors.New("unsupported pk type")
+	}
+
+	cursorExpr := &planpb.Expr{
+		Expr: &planpb.Expr_UnaryRangeExpr{
+			UnaryRangeExpr: &planpb.UnaryRangeExpr{
+				ColumnInfo: &planpb.ColumnInfo{
+					FieldId:      pkField.GetFieldID(),
+					DataType:     pkField.GetDataType(),
+					IsPrimaryKey: true,
+					IsAutoID:     pkField.GetAutoID(),
+				},
+				Op:    planpb.OpType_GreaterThan,
+				Value: value,
+			},
+		},
+	}
+
+	predicates := plan.GetPredicates()
+	if predicates != nil {
+		cursorExpr = &planpb.Expr{
+			Expr: &planpb.Expr_BinaryExpr{
+				BinaryExpr: &planpb.BinaryExpr{
+					Op:    planpb.BinaryExpr_LogicalAnd,
+					Left:  predicates,
+					Right: cursorExpr,
+				},
+			},
+		}
+	}
+	plan.Node = &planpb.PlanNode_Predicates{Predicates: cursorExpr}
+	return nil
+}
+
+// nextQueryCursor returns the cursor after the entities of result, nil is returned if
+// there are less than batchSize entities, which means that the iteration is finished.
+func nextQueryCursor(result *milvuspb.QueryResults, pkFieldID int64, batchSize int64, mvccTs Timestamp) *proxypb.IteratorCursor {
+	for _, fieldData := range result.GetFieldsData() {
+		if fieldData.GetFieldId() != pkFieldID {
+			continue
+		}
+		lastPKs := &schemapb.IDs{}
+		switch fieldData.GetType() {
+		case schemapb.DataType_Int64:
+			pks := fieldData.GetScalars().GetLongData().GetData()
+			if int64(len(pks)) < batchSize {
+				return nil
+			}
+			typeutil.AppendPKs(lastPKs, pks[len(pks)-1])
+		case schemapb.DataType_VarChar:
+			pks := fieldData.GetScalars().GetStringData().GetData()
+			if int64(len(pks)) < batchSize {
+				return nil
+			}
+			typeutil.AppendPKs(lastPKs, pks[len(pks)-1])
+		default:
+			return nil
+		}
+		return &proxypb.IteratorCursor{
+			Pks:           lastPKs,
+			MvccTimestamp: mvccTs,
+		}
+	}
+	return nil
+}
+
+// withTopK returns a copy of the search params whose topk is replaced.
+func withTopK(searchParams []*commonpb.KeyValuePair, topk int64) []*commonpb.KeyValuePair {
+	ret := make([]*commonpb.KeyValuePair, 0, len(searchParams)+1)
+	for _, kv := range searchParams {
+		if kv.GetKey() != TopKKey {
+			ret = append(ret, kv)
+		}
+	}
+	return append(ret, &commonpb.KeyValuePair{Key: TopKKey, Value: strconv.FormatInt(topk, 10)})
+}
+
+// withIteratorCursor narrows the range of a search to the hits after the cursor, i.e. the hits
+// whose scores are not better than the last score and which are not returned by the previous pages.
+func withIteratorCursor(params *rangeSearchParams, cursor *proxypb.IteratorCursor, metricType string) *rangeSearchParams {
+	lastScore := cursor.GetLastScore()
+	if !distance.PositivelyRelated(metricType) {
+		lastScore = -lastScore
+	}
+
+	ret := &rangeSearchParams{radius: float32(math.Inf(-1))}
+	if params != nil {
+		*ret = *params
+	}
+	if !ret.hasRangeFilter || lastScore < ret.rangeFilter {
+		ret.hasRangeFilter = true
+		ret.rangeFilter = lastScore
+	}
+	ret.returnedPKs = make(map[interface{}]struct{})
+	for i := 0; i < typeutil.GetSizeOfIDs(cursor.GetPks()); i++ {
+		ret.returnedPKs[typeutil.GetPK(cursor.GetPks(), int64(i))] = struct{}{}
+	}
+	return ret
+}
+
+// nextSearchCursor returns the cursor after the hits of result, nil is returned if
+// there are less than batchSize hits, which means that the iteration is finished.
+// Only one query is supported by search iterator.
+func nextSearchCursor(result *schemapb.SearchResultData, prev *proxypb.IteratorCursor, batchSize int64, mvccTs Timestamp) *proxypb.IteratorCursor {
+	if len(result.GetTopks()) != 1 || result.GetTopks()[0] < batchSize {
+		return nil
+	}
+	scores := result.GetScores()
+	lastScore := scores[len(scores)-1]
+
+	// hits with the same score as the last one are kept in cursor, they're skipped by the next page
+	pks := &schemapb.IDs{}
+	if prev != nil && prev.GetLastScore() == lastScore {
+		for i := 0; i < typeutil.GetSizeOfIDs(prev.GetPks()); i++ {
+			typeutil.AppendPKs(pks, typeutil.GetPK(prev.GetPks(), int64(i)))
+		}
+	}
+	for i := len(scores) - 1; i >= 0 && scores[i] == lastScore; i-- {
+		typeutil.AppendPKs(pks, typeutil.GetPK(result.GetIds(), int64(i)))
+	}
+	return &proxypb.IteratorCursor{
+		Pks:           pks,
+		MvccTimestamp: mvccTs,
+		LastScore:     lastScore,
+		Returned:      prev.GetReturned() + int64(len(scores)),
+	}
+}
+
+// withIteratorRange returns the search params whose radius and range_filter are replaced by the bounds of params,
+// so that the query nodes search the hits after the cursor instead of the nearest ones. params is returned by
+// withIteratorCursor, its bounds are scores, larger is better.
+// Note that the query nodes explore at most 16384 candidates of a segment for a range search,
+// so the hits after them can't be reached by the iterator.
+func withIteratorRange(searchParamStr string, params *rangeSearchParams, metricType string) (string, error) {
+	searchParamMap := make(map[string]interface{})
+	if err := json.Unmarshal([]byte(searchParamStr), &searchParamMap); err != nil {
+		return "", fmt.Errorf("%s [%s] is invalid, %w", SearchParamsKey, searchParamStr, err)
+	}
+	if searchParamMap == nil {
+		searchParamMap = make(map[string]interface{})
+	}
+
+	radius, rangeFilter := params.radius, params.rangeFilter
+	if math.IsInf(float64(radius), -1) {
+		radius = -math.MaxFloat32
+	}
+	if !distance.PositivelyRelated(metricType) {
+		radius, rangeFilter = -radius, -rangeFilter
+	}
+	// float64 keeps the exact value of float32 in json
+	searchParamMap[common.RadiusKey] = float64(radius)
+	searchParamMap[common.RangeFilterKey] = float64(rangeFilter)
+
+	ret, err := json.Marshal(searchParamMap)
+	if err != nil {
+		return "", err
+	}
+	return string(ret), nil
+}
+
+// appendExcludedPKsExpr restricts the predicates of a search plan to the entities whose primary keys are not in pks,
+// i.e. the hits of the previous pages whose scores equal to the last score.
+func appendExcludedPKsExpr(plan *planpb.PlanNode, pkField *schemapb.FieldSchema, pks *schemapb.IDs) error {
+	if typeutil.GetSizeOfIDs(pks) == 0 {
+		return nil
+	}
+	vectorAnns := plan.GetVectorAnns()
+	if vectorAnns == nil {
+		return errors.New("not a search plan")
+	}
+
+	var values []*planpb.GenericValue
+	switch pkField.GetDataType() {
+	case schemapb.DataType_Int64:
+		for _, pk := range pks.GetIntId().GetData() {
+			values = append(values, &planpb.GenericValue{Val: &planpb.GenericValue_Int64Val{Int64Val: pk}})
+		}
+	case schemapb.DataType_VarChar:
+		for _, pk := range pks.GetStrId().GetData() {
+			values = append(values, &planpb.GenericValue{Val: &planpb.GenericValue_StringVal{StringVal: pk}})
+		}
+	default:
+		return errors.New("unsupported pk type")
+	}
+	if len(values) == 0 {
+		return fmt.Errorf("primary key of iterator cursor mis-match with type %s", pkField.GetDataType().String())
+	}
+
+	excludedExpr := &planpb.Expr{
+		Expr: &planpb.Expr_UnaryExpr{
+			UnaryExpr: &planpb.UnaryExpr{
+				Op: planpb.UnaryExpr_Not,
+				Child: &planpb.Expr{
+					Expr: &planpb.Expr_TermExpr{
+						TermExpr: &planpb.TermExpr{
+							ColumnInfo: &planpb.ColumnInfo{
+								FieldId:      pkField.GetFieldID(),
+								DataType:     pkField.GetDataType(),
+								IsPrimaryKey: true,
+								IsAutoID:     pkField.GetAutoID(),
+							},
+							Values: values,
+						},
+					},
+				},
+			},
+		},
+	}
+
+	if vectorAnns.GetPredicates() != nil {
+		excludedExpr = &planpb.Expr{
+			Expr: &planpb.Expr_BinaryExpr{
+				BinaryExpr: &planpb.BinaryExpr{
+					Op:    planpb.BinaryExpr_LogicalAnd,
+					Left:  vectorAnns.GetPredicates(),
+					Right: excludedExpr,
+				},
+			},
+		}
+	}
+	vectorAnns.Predicates = excludedExpr
+	return nil
+}
@@ -0,0 +1,235 @@
+package proxy
+
+import (
+	"encoding/json"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/milvuspb"
+	"github.com/milvus-io/milvus-proto/go-api/schemapb"
+	"github.com/milvus-io/milvus/internal/common"
+	"github.com/milvus-io/milvus/internal/proto/planpb"
+	"github.com/milvus-io/milvus/internal/proto/proxypb"
+	"github.com/milvus-io/milvus/internal/util/distance"
+)
+
+func genIntIDs(ids ...int64) *schemapb.IDs {
+	return &schemapb.IDs{IdField: &schemapb.IDs_IntId{IntId: &schemapb.LongArray{Data: ids}}}
+}
+
+func TestIterator_newIteratorParams(t *testing.T) {
+	p, err := newIteratorParams(10, nil)
+	require.NoError(t, err)
+	assert.Equal(t, Timestamp(100), p.mvccTimestamp(100))
+
+	p, err = newIteratorParams(10, &proxypb.IteratorCursor{MvccTimestamp: 50})
+	require.NoError(t, err)
+	assert.Equal(t, Timestamp(50), p.mvccTimestamp(100))
+
+	_, err = newIteratorParams(0, nil)
+	assert.Error(t, err)
+
+	_, err = newIteratorParams(10, &proxypb.IteratorCursor{Pks: genIntIDs(1)})
+	assert.Error(t, err)
+}
+
+func TestIterator_appendIteratorCursorExpr(t *testing.T) {
+	int64PK := &schemapb.FieldSchema{FieldID: 100, DataType: schemapb.DataType_Int64, IsPrimaryKey: true}
+	varCharPK := &schemapb.FieldSchema{FieldID: 100, DataType: schemapb.DataType_VarChar, IsPrimaryKey: true}
+
+	t.Run("no predicates", func(t *testing.T) {
+		plan := &planpb.PlanNode{}
+		require.NoError(t, appendIteratorCursorExpr(plan, int64PK, genIntIDs(5)))
+		expr := plan.GetPredicates().GetUnaryRangeExpr()
+		assert.Equal(t, planpb.OpType_GreaterThan, expr.GetOp())
+		assert.Equal(t, int64(100), expr.GetColumnInfo().GetFieldId())
+		assert.Equal(t, int64(5), expr.GetValue().GetInt64Val())
+	})
+
+	t.Run("with predicates", func(t *testing.T) {
+		predicates := &planpb.Expr{Expr: &planpb.Expr_TermExpr{TermExpr: &planpb.TermExpr{}}}
+		plan := &planpb.PlanNode{Node: &planpb.PlanNode_Predicates{Predicates: predicates}}
+		require.NoError(t, appendIteratorCursorExpr(plan, varCharPK,
+			&schemapb.IDs{IdField: &schemapb.IDs_StrId{StrId: &schemapb.StringArray{Data: []string{"a"}}}}))
+		expr := plan.GetPredicates().GetBinaryExpr()
+		assert.Equal(t, planpb.BinaryExpr_LogicalAnd, expr.GetOp())
+		assert.Equal(t, predicates, expr.GetLeft())
+		assert.Equal(t, "a", expr.GetRight().GetUnaryRangeExpr().GetValue().GetStringVal())
+	})
+
+	t.Run("no cursor", func(t *testing.T) {
+		plan := &planpb.PlanNode{}
+		require.NoError(t, appendIteratorCursorExpr(plan, int64PK, nil))
+		assert.Nil(t, plan.GetPredicates())
+	})
+
+	t.Run("pk type mis-match", func(t *testing.T) {
+		assert.Error(t, appendIteratorCursorExpr(&planpb.PlanNode{}, varCharPK, genIntIDs(5)))
+	})
+}
+
+func TestIterator_nextQueryCursor(t *testing.T) {
+	result := &milvuspb.QueryResults{
+		FieldsData: []*schemapb.FieldData{
+			{
+				Type:    schemapb.DataType_Int64,
+				FieldId: 101,
+				Field: &schemapb.FieldData_Scalars{
+					Scalars: &schemapb.ScalarField{
+						Data: &schemapb.ScalarField_LongData{LongData: &schemapb.LongArray{Data: []int64{30, 20, 10}}},
+					},
+				},
+			},
+			{
+				Type:    schemapb.DataType_Int64,
+				FieldId: 100,
+				Field: &schemapb.FieldData_Scalars{
+					Scalars: &schemapb.ScalarField{
+						Data: &schemapb.ScalarField_LongData{LongData: &schemapb.LongArray{Data: []int64{1, 2, 3}}},
+					},
+				},
+			},
+		},
+	}
+
+	cursor := nextQueryCursor(result, 100, 3, 50)
+	require.NotNil(t, cursor)
+	assert.Equal(t, []int64{3}, cursor.GetPks().GetIntId().GetData())
+	assert.Equal(t, uint64(50), cursor.GetMvccTimestamp())
+
+	// the last page
+	assert.Nil(t, nextQueryCursor(result, 100, 4, 50))
+	assert.Nil(t, nextQueryCursor(&milvuspb.QueryResults{}, 100, 4, 50))
+}
+
+func TestIterator_withTopK(t *testing.T) {
+	params := []*commonpb.KeyValuePair{
+		{Key: AnnsFieldKey, Value: "vec"},
+		{Key: TopKKey, Value: "10"},
+	}
+	ret := withTopK(params, 30)
+	assert.Equal(t, []*commonpb.KeyValuePair{
+		{Key: AnnsFieldKey, Value: "vec"},
+		{Key: TopKKey, Value: "30"},
+	}, ret)
+	// the original params are not modified
+	assert.Equal(t, "10", params[1].GetValue())
+}
+
+func TestIterator_withIteratorCursor(t *testing.T) {
+	cursor := &proxypb.IteratorCursor{Pks: genIntIDs(1, 2), LastScore: 0.5, MvccTimestamp: 50}
+
+	ret := withIteratorCursor(nil, cursor, distance.L2)
+	assert.Equal(t, float32(math.Inf(-1)), ret.radius)
+	assert.True(t, ret.hasRangeFilter)
+	assert.Equal(t, float32(-0.5), ret.rangeFilter)
+	assert.True(t, ret.returned(int64(1)))
+	assert.True(t, ret.returned(int64(2)))
+	assert.False(t, ret.returned(int64(3)))
+
+	// the range filter of range search is kept if it's tighter
+	ret = withIteratorCursor(&rangeSearchParams{radius: 0.1, rangeFilter: 0.3, hasRangeFilter: true}, cursor, distance.IP)
+	assert.Equal(t, float32(0.1), ret.radius)
+	assert.Equal(t, float32(0.3), ret.rangeFilter)
+
+	ret = withIteratorCursor(&rangeSearchParams{radius: 0.1, rangeFilter: 0.9, hasRangeFilter: true}, cursor, distance.IP)
+	assert.Equal(t, float32(0.5), ret.rangeFilter)
+}
+
+func TestIterator_nextSearchCursor(t *testing.T) {
+	result := &schemapb.SearchResultData{
+		NumQueries: 1,
+		TopK:       4,
+		Ids:        genIntIDs(1, 2, 3, 4),
+		Scores:     []float32{0.9, 0.8, 0.7, 0.7},
+		Topks:      []int64{4},
+	}
+
+	cursor := nextSearchCursor(result, nil, 4, 50)
+	require.NotNil(t, cursor)
+	assert.ElementsMatch(t, []int64{3, 4}, cursor.GetPks().GetIntId().GetData())
+	assert.Equal(t, float32(0.7), cursor.GetLastScore())
+	assert.Equal(t, int64(4), cursor.GetReturned())
+	assert.Equal(t, uint64(50), cursor.GetMvccTimestamp())
+
+	// the hits of the previous page with the same score are kept
+	next := &schemapb.SearchResultData{
+		NumQueries: 1,
+		TopK:       1,
+		Ids:        genIntIDs(5),
+		Scores:     []float32{0.7},
+		Topks:      []int64{1},
+	}
+	cursor = nextSearchCursor(next, cursor, 1, 50)
+	require.NotNil(t, cursor)
+	assert.ElementsMatch(t, []int64{3, 4, 5}, cursor.GetPks().GetIntId().GetData())
+	assert.Equal(t, int64(5), cursor.GetReturned())
+
+	// the last page
+	assert.Nil(t, nextSearchCursor(result, nil, 5, 50))
+}
+
+func TestIterator_withIteratorRange(t *testing.T) {
+	cursor := &proxypb.IteratorCursor{Pks: genIntIDs(1), LastScore: 0.5, MvccTimestamp: 50}
+
+	params := withIteratorCursor(nil, cursor, distance.L2)
+	ret, err := withIteratorRange(`{"nprobe": 10}`, params, distance.L2)
+	require.NoError(t, err)
+	searchParams := make(map[string]interface{})
+	require.NoError(t, json.Unmarshal([]byte(ret), &searchParams))
+	assert.Equal(t, float64(10), searchParams["nprobe"])
+	assert.Equal(t, float64(math.MaxFloat32), searchParams[common.RadiusKey])
+	assert.Equal(t, 0.5, searchParams[common.RangeFilterKey])
+
+	// the radius of range search is kept
+	params = withIteratorCursor(&rangeSearchParams{radius: 0.1}, cursor, distance.IP)
+	ret, err = withIteratorRange(`{"radius": 0.1}`, params, distance.IP)
+	require.NoError(t, err)
+	searchParams = make(map[string]interface{})
+	require.NoError(t, json.Unmarshal([]byte(ret), &searchParams))
+	assert.InDelta(t, 0.1, searchParams[common.RadiusKey], 1e-6)
+	assert.Equal(t, 0.5, searchParams[common.RangeFilterKey])
+
+	_, err = withIteratorRange(`invalid`, params, distance.IP)
+	assert.Error(t, err)
+}
+
+func TestIterator_appendExcludedPKsExpr(t *testing.T) {
+	pkField := &schemapb.FieldSchema{FieldID: 100, Name: "pk", DataType: schemapb.DataType_Int64, IsPrimaryKey: true}
+	newPlan := func(predicates *planpb.Expr) *planpb.PlanNode {
+		return &planpb.PlanNode{Node: &planpb.PlanNode_VectorAnns{VectorAnns: &planpb.VectorANNS{Predicates: predicates}}}
+	}
+
+	plan := newPlan(nil)
+	require.NoError(t, appendExcludedPKsExpr(plan, pkField, genIntIDs(1, 2)))
+	excluded := plan.GetVectorAnns().GetPredicates().GetUnaryExpr()
+	require.NotNil(t, excluded)
+	assert.Equal(t, planpb.UnaryExpr_Not, excluded.GetOp())
+	values := excluded.GetChild().GetTermExpr().GetValues()
+	require.Len(t, values, 2)
+	assert.Equal(t, int64(1), values[0].GetInt64Val())
+	assert.Equal(t, int64(2), values[1].GetInt64Val())
+
+	// the excluded primary keys are combined with the predicates
+	predicates := &planpb.Expr{Expr: &planpb.Expr_UnaryRangeExpr{UnaryRangeExpr: &planpb.UnaryRangeExpr{Op: planpb.OpType_GreaterThan}}}
+	plan = newPlan(predicates)
+	require.NoError(t, appendExcludedPKsExpr(plan, pkField, genIntIDs(1)))
+	binaryExpr := plan.GetVectorAnns().GetPredicates().GetBinaryExpr()
+	require.NotNil(t, binaryExpr)
+	assert.Equal(t, planpb.BinaryExpr_LogicalAnd, binaryExpr.GetOp())
+	assert.Equal(t, predicates, binaryExpr.GetLeft())
+
+	// nothing to exclude
+	plan = newPlan(nil)
+	require.NoError(t, appendExcludedPKsExpr(plan, pkField, &schemapb.IDs{}))
+	assert.Nil(t, plan.GetVectorAnns().GetPredicates())
+
+	// primary keys mis-match with the pk type
+	varCharField := &schemapb.FieldSchema{FieldID: 100, Name: "pk", DataType: schemapb.DataType_VarChar, IsPrimaryKey: true}
+	assert.Error(t, appendExcludedPKsExpr(newPlan(nil), varCharField, genIntIDs(1)))
+	assert.Error(t, appendExcludedPKsExpr(&planpb.PlanNode{}, pkField, genIntIDs(1)))
+}
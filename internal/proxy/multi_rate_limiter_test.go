@@ -27,8 +27,8 @@ import (
 
 func TestMultiRateLimiter(t *testing.T) {
 	t.Run("test multiRateLimiter", func(t *testing.T) {
-		bak := Params.QuotaConfig.QuotaAndLimitsEnabled
-		Params.QuotaConfig.QuotaAndLimitsEnabled = true
+		bak := Params.QuotaConfig.Get().QuotaAndLimitsEnabled
+		Params.QuotaConfig.Get().QuotaAndLimitsEnabled = true
 		multiLimiter := NewMultiRateLimiter()
 		for _, rt := range internalpb.RateType_value {
 			multiLimiter.globalRateLimiter.limiters[internalpb.RateType(rt)] = ratelimitutil.NewLimiter(ratelimitutil.Limit(1000), 1)
@@ -41,33 +41,33 @@ func TestMultiRateLimiter(t *testing.T) {
 			ok, _ = multiLimiter.Limit(internalpb.RateType(rt), math.MaxInt)
 			assert.True(t, ok)
 		}
-		Params.QuotaConfig.QuotaAndLimitsEnabled = bak
+		Params.QuotaConfig.Get().QuotaAndLimitsEnabled = bak
 	})
 
 	t.Run("not enable quotaAndLimit", func(t *testing.T) {
 		multiLimiter := NewMultiRateLimiter()
-		bak := Params.QuotaConfig.QuotaAndLimitsEnabled
-		Params.QuotaConfig.QuotaAndLimitsEnabled = false
+		bak := Params.QuotaConfig.Get().QuotaAndLimitsEnabled
+		Params.QuotaConfig.Get().QuotaAndLimitsEnabled = false
 		for _, rt := range internalpb.RateType_value {
 			ok, r := multiLimiter.Limit(internalpb.RateType(rt), 1)
 			assert.False(t, ok)
 			assert.NotEqual(t, float64(0), r)
 		}
-		Params.QuotaConfig.QuotaAndLimitsEnabled = bak
+		Params.QuotaConfig.Get().QuotaAndLimitsEnabled = bak
 	})
 
 	t.Run("test limit", func(t *testing.T) {
 		run := func(insertRate float64) {
-			bakInsertRate := Params.QuotaConfig.DMLMaxInsertRate
-			Params.QuotaConfig.DMLMaxInsertRate = insertRate
+			bakInsertRate := Params.QuotaConfig.Get().DMLMaxInsertRate
+			Params.QuotaConfig.Get().DMLMaxInsertRate = insertRate
 			multiLimiter := NewMultiRateLimiter()
-			bak := Params.QuotaConfig.QuotaAndLimitsEnabled
-			Params.QuotaConfig.QuotaAndLimitsEnabled = true
+			bak := Params.QuotaConfig.Get().QuotaAndLimitsEnabled
+			Params.QuotaConfig.Get().QuotaAndLimitsEnabled = true
 			ok, r := multiLimiter.Limit(internalpb.RateType_DMLInsert, 1*1024*1024)
 			assert.False(t, ok)
 			assert.NotEqual(t, float64(0), r)
-			Params.QuotaConfig.QuotaAndLimitsEnabled = bak
-			Params.QuotaConfig.DMLMaxInsertRate = bakInsertRate
+			Params.QuotaConfig.Get().QuotaAndLimitsEnabled = bak
+			Params.QuotaConfig.Get().DMLMaxInsertRate = bakInsertRate
 		}
 		run(math.MaxFloat64)
 		run(math.MaxFloat64 / 1.2)
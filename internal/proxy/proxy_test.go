@@ -371,6 +371,7 @@ func (s *proxyTestServer) startGrpc(ctx context.Context, wg *sync.WaitGroup) {
 		grpc.StreamInterceptor(ot.StreamServerInterceptor(opts...)))
 	proxypb.RegisterProxyServer(s.grpcServer, s)
 	milvuspb.RegisterMilvusServiceServer(s.grpcServer, s)
+	proxypb.RegisterMilvusServiceExtServer(s.grpcServer, s)
 
 	log.Debug("create Proxy grpc server",
 		zap.Any("enforcement policy", kaep),
@@ -1061,6 +1062,59 @@ func TestProxy(t *testing.T) {
 		assert.Equal(t, int64(rowNum), resp.InsertCnt)
 	})
 
+	wg.Add(1)
+	t.Run("upsert by grpc", func(t *testing.T) {
+		defer wg.Done()
+		conn, err := grpc.DialContext(ctx, proxy.address, grpc.WithBlock(), grpc.WithInsecure())
+		assert.NoError(t, err)
+		defer conn.Close()
+		client := proxypb.NewMilvusServiceExtClient(conn)
+
+		// the primary keys must be given by user to upsert
+		resp, err := client.Upsert(ctx, constructCollectionInsertRequest())
+		assert.NoError(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+
+		upsertCollectionName := collectionName + "_upsert"
+		upsertSchema := constructCollectionSchema()
+		upsertSchema.Name = upsertCollectionName
+		upsertSchema.Fields[0].AutoID = false
+		bs, err := proto.Marshal(upsertSchema)
+		assert.NoError(t, err)
+		status, err := proxy.CreateCollection(ctx, &milvuspb.CreateCollectionRequest{
+			DbName:         dbName,
+			CollectionName: upsertCollectionName,
+			Schema:         bs,
+			ShardsNum:      shardsNum,
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, status.ErrorCode)
+		defer func() {
+			status, err := proxy.DropCollection(ctx, &milvuspb.DropCollectionRequest{
+				DbName:         dbName,
+				CollectionName: upsertCollectionName,
+			})
+			assert.NoError(t, err)
+			assert.Equal(t, commonpb.ErrorCode_Success, status.ErrorCode)
+		}()
+
+		resp, err = client.Upsert(ctx, &milvuspb.InsertRequest{
+			DbName:         dbName,
+			CollectionName: upsertCollectionName,
+			FieldsData: []*schemapb.FieldData{
+				newScalarFieldData(upsertSchema.Fields[0], int64Field, rowNum),
+				newFloatVectorFieldData(floatVecField, rowNum, dim),
+			},
+			HashKeys: generateHashKeys(rowNum),
+			NumRows:  uint32(rowNum),
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+		assert.Equal(t, rowNum, len(resp.GetSuccIndex()))
+		assert.Equal(t, 0, len(resp.GetErrIndex()))
+		assert.Equal(t, int64(rowNum), resp.GetUpsertCnt())
+	})
+
 	// TODO(dragondriver): proxy.Delete()
 
 	flushed := true
@@ -3948,6 +4002,88 @@ func TestProxy_ListImportTasks(t *testing.T) {
 	})
 }
 
+func TestProxy_Export(t *testing.T) {
+	rootCoord := &RootCoordMock{}
+	rootCoord.state.Store(commonpb.StateCode_Healthy)
+	t.Run("test export", func(t *testing.T) {
+		proxy := &Proxy{rootCoord: rootCoord}
+		proxy.stateCode.Store(commonpb.StateCode_Healthy)
+
+		resp, err := proxy.Export(context.TODO(), &rootcoordpb.ExportRequest{CollectionName: "dummy"})
+		assert.Nil(t, err)
+		assert.EqualValues(t, commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+		assert.EqualValues(t, 1, resp.GetTaskId())
+
+		stateResp, err := proxy.GetExportState(context.TODO(), &rootcoordpb.GetExportStateRequest{TaskId: 1})
+		assert.Nil(t, err)
+		assert.EqualValues(t, commonpb.ErrorCode_Success, stateResp.GetStatus().GetErrorCode())
+		assert.EqualValues(t, 1, stateResp.GetTask().GetId())
+
+		listResp, err := proxy.ListExportTasks(context.TODO(), &rootcoordpb.ListExportTasksRequest{})
+		assert.Nil(t, err)
+		assert.EqualValues(t, commonpb.ErrorCode_Success, listResp.GetStatus().GetErrorCode())
+		assert.Equal(t, 3, len(listResp.GetTasks()))
+	})
+	t.Run("test export with unhealthy", func(t *testing.T) {
+		proxy := &Proxy{rootCoord: rootCoord}
+		proxy.stateCode.Store(commonpb.StateCode_Abnormal)
+
+		resp, err := proxy.Export(context.TODO(), &rootcoordpb.ExportRequest{})
+		assert.Nil(t, err)
+		assert.EqualValues(t, unhealthyStatus(), resp.GetStatus())
+
+		stateResp, err := proxy.GetExportState(context.TODO(), &rootcoordpb.GetExportStateRequest{})
+		assert.Nil(t, err)
+		assert.EqualValues(t, unhealthyStatus(), stateResp.GetStatus())
+
+		listResp, err := proxy.ListExportTasks(context.TODO(), &rootcoordpb.ListExportTasksRequest{})
+		assert.Nil(t, err)
+		assert.EqualValues(t, unhealthyStatus(), listResp.GetStatus())
+	})
+}
+
+func TestProxy_RestoreCollection(t *testing.T) {
+	rootCoord := &RootCoordMock{}
+	rootCoord.state.Store(commonpb.StateCode_Healthy)
+	t.Run("test restore collection", func(t *testing.T) {
+		proxy := &Proxy{rootCoord: rootCoord}
+		proxy.stateCode.Store(commonpb.StateCode_Healthy)
+
+		resp, err := proxy.RestoreCollection(context.TODO(), &rootcoordpb.RestoreCollectionRequest{
+			CollectionName:    "dummy",
+			Timestamp:         1,
+			NewCollectionName: "restored",
+		})
+		assert.Nil(t, err)
+		assert.EqualValues(t, commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+		assert.EqualValues(t, 1, resp.GetTaskId())
+
+		stateResp, err := proxy.GetRestoreState(context.TODO(), &rootcoordpb.GetRestoreStateRequest{TaskId: 1})
+		assert.Nil(t, err)
+		assert.EqualValues(t, commonpb.ErrorCode_Success, stateResp.GetStatus().GetErrorCode())
+		assert.EqualValues(t, rootcoordpb.RestoreState_RestoreCompleted, stateResp.GetTask().GetState())
+
+		resp, err = proxy.RestoreCollection(context.TODO(), &rootcoordpb.RestoreCollectionRequest{
+			CollectionName: "dummy",
+			Timestamp:      1,
+		})
+		assert.Nil(t, err)
+		assert.EqualValues(t, commonpb.ErrorCode_IllegalArgument, resp.GetStatus().GetErrorCode())
+	})
+	t.Run("test restore collection with unhealthy", func(t *testing.T) {
+		proxy := &Proxy{rootCoord: rootCoord}
+		proxy.stateCode.Store(commonpb.StateCode_Abnormal)
+
+		resp, err := proxy.RestoreCollection(context.TODO(), &rootcoordpb.RestoreCollectionRequest{})
+		assert.Nil(t, err)
+		assert.EqualValues(t, unhealthyStatus(), resp.GetStatus())
+
+		stateResp, err := proxy.GetRestoreState(context.TODO(), &rootcoordpb.GetRestoreStateRequest{})
+		assert.Nil(t, err)
+		assert.EqualValues(t, unhealthyStatus(), stateResp.GetStatus())
+	})
+}
+
 func TestProxy_GetStatistics(t *testing.T) {
 
 }
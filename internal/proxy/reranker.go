@@ -0,0 +1,265 @@
+package proxy
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/milvuspb"
+	"github.com/milvus-io/milvus-proto/go-api/schemapb"
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/util/distance"
+	"github.com/milvus-io/milvus/internal/util/funcutil"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
+)
+
+const (
+	RankStrategyKey = "strategy"
+
+	RRFRankStrategy      = "rrf"
+	WeightedRankStrategy = "weighted"
+
+	RRFParamKKey     = "k"
+	WeightsParamKey  = "weights"
+	defaultRRFParamK = 60
+)
+
+// reranker scores the hits of the sub searches of a hybrid search. The score of an entity is
+// the sum of the scores of its hits in all sub searches, larger is better.
+type reranker interface {
+	strategy() string
+	// score returns the score of the rank-th (starting from 0) hit of the searchIdx-th sub search,
+	// distance is the original score of the hit measured by metricType.
+	score(searchIdx int, rank int, distance float32, metricType string) float32
+}
+
+// rrfReranker implements reciprocal rank fusion, the score of a hit is 1/(k+rank).
+type rrfReranker struct {
+	k float64
+}
+
+func (r *rrfReranker) strategy() string {
+	return RRFRankStrategy
+}
+
+func (r *rrfReranker) score(searchIdx int, rank int, distance float32, metricType string) float32 {
+	return float32(1 / (r.k + float64(rank+1)))
+}
+
+// weightedReranker normalizes the distances of all metric types to [0, 1], larger is better,
+// and the score of a hit is its normalized distance multiplied by the weight of the sub search.
+type weightedReranker struct {
+	weights []float64
+}
+
+func (r *weightedReranker) strategy() string {
+	return WeightedRankStrategy
+}
+
+func (r *weightedReranker) score(searchIdx int, rank int, dist float32, metricType string) float32 {
+	return float32(r.weights[searchIdx] * normalizeDistance(dist, metricType))
+}
+
+// normalizeDistance maps distances to [0, 1] with arctan, so that the distances of
+// different metric types are comparable. Larger is better for the returned value.
+func normalizeDistance(dist float32, metricType string) float64 {
+	if distance.PositivelyRelated(metricType) {
+		return 0.5 + math.Atan(float64(dist))/math.Pi
+	}
+	// distances of other metric types are non-negative, smaller is better
+	return 1 - 2*math.Atan(float64(dist))/math.Pi
+}
+
+// newReranker creates the reranker of a hybrid search with numSearches sub searches.
+// RRF is used if no strategy is given in rankParams.
+func newReranker(rankParams []*commonpb.KeyValuePair, numSearches int) (reranker, error) {
+	strategy, err := funcutil.GetAttrByKeyFromRepeatedKV(RankStrategyKey, rankParams)
+	if err != nil {
+		strategy = RRFRankStrategy
+	}
+
+	params := make(map[string]interface{})
+	paramsStr, err := funcutil.GetAttrByKeyFromRepeatedKV(SearchParamsKey, rankParams)
+	if err == nil {
+		if err := json.Unmarshal([]byte(paramsStr), &params); err != nil {
+			return nil, fmt.Errorf("rank %s [%s] is invalid, %w", SearchParamsKey, paramsStr, err)
+		}
+	}
+
+	switch strategy {
+	case RRFRankStrategy:
+		k := float64(defaultRRFParamK)
+		if value, ok := params[RRFParamKKey]; ok {
+			k, ok = value.(float64)
+			if !ok || k <= 0 {
+				return nil, fmt.Errorf("%s of %s reranker [%v] is invalid, should be a positive number", RRFParamKKey, strategy, value)
+			}
+		}
+		return &rrfReranker{k: k}, nil
+
+	case WeightedRankStrategy:
+		value, ok := params[WeightsParamKey]
+		if !ok {
+			return nil, fmt.Errorf("%s of %s reranker not found in rank params", WeightsParamKey, strategy)
+		}
+		values, ok := value.([]interface{})
+		if !ok || len(values) != numSearches {
+			return nil, fmt.Errorf("%s of %s reranker [%v] is invalid, should be %d numbers", WeightsParamKey, strategy, value, numSearches)
+		}
+		weights := make([]float64, 0, len(values))
+		for _, v := range values {
+			weight, ok := v.(float64)
+			if !ok || weight < 0 || weight > 1 {
+				return nil, fmt.Errorf("%s of %s reranker [%v] is invalid, should be in range [0, 1]", WeightsParamKey, strategy, v)
+			}
+			weights = append(weights, weight)
+		}
+		return &weightedReranker{weights: weights}, nil
+	}
+	return nil, fmt.Errorf("unsupported rank %s [%s]", RankStrategyKey, strategy)
+}
+
+// parseRankParams returns the reranker, the topk and the offset of the merged results of a hybrid search.
+func parseRankParams(rankParams []*commonpb.KeyValuePair, numSearches int) (reranker, int64, int64, error) {
+	rk, err := newReranker(rankParams, numSearches)
+	if err != nil {
+		return nil, 0, 0, err
+	}
+
+	topKStr, err := funcutil.GetAttrByKeyFromRepeatedKV(TopKKey, rankParams)
+	if err != nil {
+		return nil, 0, 0, errors.New(TopKKey + " not found in rank_params")
+	}
+	topK, err := strconv.ParseInt(topKStr, 0, 64)
+	if err != nil {
+		return nil, 0, 0, fmt.Errorf("%s [%s] is invalid", TopKKey, topKStr)
+	}
+	if err := validateLimit(topK); err != nil {
+		return nil, 0, 0, fmt.Errorf("%s [%d] is invalid, %w", TopKKey, topK, err)
+	}
+
+	var offset int64
+	offsetStr, err := funcutil.GetAttrByKeyFromRepeatedKV(OffsetKey, rankParams)
+	if err == nil {
+		offset, err = strconv.ParseInt(offsetStr, 0, 64)
+		if err != nil || offset < 0 {
+			return nil, 0, 0, fmt.Errorf("%s [%s] is invalid", OffsetKey, offsetStr)
+		}
+	}
+	if err := validateLimit(topK + offset); err != nil {
+		return nil, 0, 0, fmt.Errorf("%s+%s [%d] is invalid, %w", OffsetKey, TopKKey, topK+offset, err)
+	}
+	return rk, topK, offset, nil
+}
+
+// rankedHit is an entity hit by the sub searches of a hybrid search.
+type rankedHit struct {
+	id    interface{}
+	score float32
+	// location of the first hit of the entity, where the output fields are copied from
+	searchIdx int
+	dataIdx   int64
+}
+
+// rerankSearchResults merges the results of the sub searches of a hybrid search,
+// keeps at most topk entities with the highest scores for each query after skipping offset entities.
+func rerankSearchResults(ctx context.Context, rk reranker, results []*milvuspb.SearchResults, metricTypes []string,
+	nq int64, topk int64, offset int64, pkType schemapb.DataType) (*milvuspb.SearchResults, error) {
+	log.Ctx(ctx).Debug("rerankSearchResults",
+		zap.String("strategy", rk.strategy()),
+		zap.Int("len(results)", len(results)),
+		zap.Int64("nq", nq),
+		zap.Int64("topk", topk),
+		zap.Int64("offset", offset))
+
+	ret := &milvuspb.SearchResults{
+		Status: &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_Success,
+		},
+		Results: &schemapb.SearchResultData{
+			NumQueries: nq,
+			Scores:     []float32{},
+			Ids:        &schemapb.IDs{},
+			Topks:      []int64{},
+		},
+	}
+
+	switch pkType {
+	case schemapb.DataType_Int64:
+		ret.GetResults().Ids.IdField = &schemapb.IDs_IntId{
+			IntId: &schemapb.LongArray{
+				Data: make([]int64, 0),
+			},
+		}
+	case schemapb.DataType_VarChar:
+		ret.GetResults().Ids.IdField = &schemapb.IDs_StrId{
+			StrId: &schemapb.StringArray{
+				Data: make([]string, 0),
+			},
+		}
+	default:
+		return nil, errors.New("unsupported pk type")
+	}
+
+	// for results of each sub search, storing the start offset of each query of nq queries
+	nqOffsets := make([][]int64, len(results))
+	for i, result := range results {
+		data := result.GetResults()
+		if int64(len(data.GetTopks())) != nq {
+			return nil, fmt.Errorf("the number of topks(%d) of sub search %d mis-match with nq(%d)", len(data.GetTopks()), i, nq)
+		}
+		if len(data.GetFieldsData()) > len(ret.Results.FieldsData) {
+			ret.Results.FieldsData = make([]*schemapb.FieldData, len(data.GetFieldsData()))
+		}
+		nqOffsets[i] = make([]int64, nq)
+		for j := int64(1); j < nq; j++ {
+			nqOffsets[i][j] = nqOffsets[i][j-1] + data.Topks[j-1]
+		}
+	}
+
+	var maxTopK int64
+	for qi := int64(0); qi < nq; qi++ {
+		hits := make([]*rankedHit, 0)
+		hitIdx := make(map[interface{}]int)
+		for i, result := range results {
+			data := result.GetResults()
+			for rank := int64(0); rank < data.Topks[qi]; rank++ {
+				idx := nqOffsets[i][qi] + rank
+				id := typeutil.GetPK(data.GetIds(), idx)
+				score := rk.score(i, int(rank), data.Scores[idx], metricTypes[i])
+				if k, ok := hitIdx[id]; ok {
+					hits[k].score += score
+					continue
+				}
+				hitIdx[id] = len(hits)
+				hits = append(hits, &rankedHit{id: id, score: score, searchIdx: i, dataIdx: idx})
+			}
+		}
+
+		sort.SliceStable(hits, func(i, j int) bool {
+			return hits[i].score > hits[j].score
+		})
+
+		var j int64
+		for k := offset; k < int64(len(hits)) && j < topk; k++ {
+			hit := hits[k]
+			typeutil.AppendFieldData(ret.Results.FieldsData, results[hit.searchIdx].GetResults().GetFieldsData(), hit.dataIdx)
+			typeutil.AppendPKs(ret.Results.Ids, hit.id)
+			ret.Results.Scores = append(ret.Results.Scores, hit.score)
+			j++
+		}
+		ret.Results.Topks = append(ret.Results.Topks, j)
+		if j > maxTopK {
+			maxTopK = j
+		}
+	}
+	ret.Results.TopK = maxTopK
+	return ret, nil
+}
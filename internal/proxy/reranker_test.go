@@ -0,0 +1,188 @@
+package proxy
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/milvuspb"
+	"github.com/milvus-io/milvus-proto/go-api/schemapb"
+	"github.com/milvus-io/milvus/internal/util/distance"
+)
+
+func TestReranker_newReranker(t *testing.T) {
+	t.Run("default rrf", func(t *testing.T) {
+		rk, err := newReranker(nil, 2)
+		require.NoError(t, err)
+		assert.Equal(t, RRFRankStrategy, rk.strategy())
+		assert.Equal(t, float64(defaultRRFParamK), rk.(*rrfReranker).k)
+	})
+
+	t.Run("rrf with k", func(t *testing.T) {
+		rk, err := newReranker([]*commonpb.KeyValuePair{
+			{Key: RankStrategyKey, Value: RRFRankStrategy},
+			{Key: SearchParamsKey, Value: `{"k": 10}`},
+		}, 2)
+		require.NoError(t, err)
+		assert.Equal(t, float64(10), rk.(*rrfReranker).k)
+	})
+
+	t.Run("weighted", func(t *testing.T) {
+		rk, err := newReranker([]*commonpb.KeyValuePair{
+			{Key: RankStrategyKey, Value: WeightedRankStrategy},
+			{Key: SearchParamsKey, Value: `{"weights": [0.7, 0.3]}`},
+		}, 2)
+		require.NoError(t, err)
+		assert.Equal(t, WeightedRankStrategy, rk.strategy())
+		assert.Equal(t, []float64{0.7, 0.3}, rk.(*weightedReranker).weights)
+	})
+
+	invalidParams := []struct {
+		description string
+		strategy    string
+		params      string
+	}{
+		{"unknown strategy", "unknown", "{}"},
+		{"invalid json", RRFRankStrategy, "{"},
+		{"negative k", RRFRankStrategy, `{"k": -1}`},
+		{"string k", RRFRankStrategy, `{"k": "a"}`},
+		{"no weights", WeightedRankStrategy, "{}"},
+		{"weights mis-match with searches", WeightedRankStrategy, `{"weights": [0.5]}`},
+		{"weight out of range", WeightedRankStrategy, `{"weights": [0.5, 1.5]}`},
+		{"string weight", WeightedRankStrategy, `{"weights": [0.5, "a"]}`},
+	}
+	for _, test := range invalidParams {
+		t.Run(test.description, func(t *testing.T) {
+			_, err := newReranker([]*commonpb.KeyValuePair{
+				{Key: RankStrategyKey, Value: test.strategy},
+				{Key: SearchParamsKey, Value: test.params},
+			}, 2)
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestReranker_parseRankParams(t *testing.T) {
+	rk, topk, offset, err := parseRankParams([]*commonpb.KeyValuePair{
+		{Key: TopKKey, Value: "10"},
+		{Key: OffsetKey, Value: "5"},
+	}, 2)
+	require.NoError(t, err)
+	assert.Equal(t, RRFRankStrategy, rk.strategy())
+	assert.Equal(t, int64(10), topk)
+	assert.Equal(t, int64(5), offset)
+
+	invalidParams := []struct {
+		description string
+		params      []*commonpb.KeyValuePair
+	}{
+		{"no topk", []*commonpb.KeyValuePair{}},
+		{"invalid topk", []*commonpb.KeyValuePair{{Key: TopKKey, Value: "a"}}},
+		{"topk out of range", []*commonpb.KeyValuePair{{Key: TopKKey, Value: "0"}}},
+		{"invalid offset", []*commonpb.KeyValuePair{{Key: TopKKey, Value: "10"}, {Key: OffsetKey, Value: "a"}}},
+		{"negative offset", []*commonpb.KeyValuePair{{Key: TopKKey, Value: "10"}, {Key: OffsetKey, Value: "-1"}}},
+		{"invalid reranker", []*commonpb.KeyValuePair{{Key: TopKKey, Value: "10"}, {Key: RankStrategyKey, Value: "unknown"}}},
+	}
+	for _, test := range invalidParams {
+		t.Run(test.description, func(t *testing.T) {
+			_, _, _, err := parseRankParams(test.params, 2)
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestReranker_normalizeDistance(t *testing.T) {
+	// larger is better for IP
+	assert.Greater(t, normalizeDistance(2, distance.IP), normalizeDistance(1, distance.IP))
+	assert.Equal(t, 0.5, normalizeDistance(0, distance.IP))
+	// smaller is better for L2
+	assert.Greater(t, normalizeDistance(1, distance.L2), normalizeDistance(2, distance.L2))
+	assert.Equal(t, float64(1), normalizeDistance(0, distance.L2))
+}
+
+func TestReranker_rerankSearchResults(t *testing.T) {
+	genResults := func(ids []int64, scores []float32, topks []int64) *milvuspb.SearchResults {
+		tags := make([]int64, 0, len(ids))
+		for _, id := range ids {
+			tags = append(tags, id*10)
+		}
+		return &milvuspb.SearchResults{
+			Results: &schemapb.SearchResultData{
+				NumQueries: int64(len(topks)),
+				Ids:        &schemapb.IDs{IdField: &schemapb.IDs_IntId{IntId: &schemapb.LongArray{Data: ids}}},
+				Scores:     scores,
+				Topks:      topks,
+				FieldsData: []*schemapb.FieldData{{
+					Type:      schemapb.DataType_Int64,
+					FieldName: "tag",
+					FieldId:   101,
+					Field: &schemapb.FieldData_Scalars{
+						Scalars: &schemapb.ScalarField{
+							Data: &schemapb.ScalarField_LongData{LongData: &schemapb.LongArray{Data: tags}},
+						},
+					},
+				}},
+			},
+		}
+	}
+
+	// 2 queries, ids of the first query are hit by both searches
+	results := []*milvuspb.SearchResults{
+		genResults([]int64{1, 2, 3, 7}, []float32{0.9, 0.8, 0.7, 0.6}, []int64{3, 1}),
+		genResults([]int64{3, 4, 1, 8, 7}, []float32{0.1, 0.2, 0.3, 0.1, 0.2}, []int64{3, 2}),
+	}
+	metricTypes := []string{distance.IP, distance.L2}
+
+	t.Run("rrf", func(t *testing.T) {
+		ret, err := rerankSearchResults(context.TODO(), &rrfReranker{k: defaultRRFParamK}, results, metricTypes, 2, 3, 0, schemapb.DataType_Int64)
+		require.NoError(t, err)
+		// 1: 1/61 + 1/63, 3: 1/63 + 1/61, 2: 1/62, 4: 1/62
+		// 7: 1/61 + 1/62, 8: 1/61
+		assert.Equal(t, []int64{1, 3, 2, 7, 8}, ret.GetResults().GetIds().GetIntId().GetData())
+		assert.Equal(t, []int64{3, 2}, ret.GetResults().GetTopks())
+		assert.Equal(t, int64(3), ret.GetResults().GetTopK())
+		assert.InDelta(t, 1.0/61+1.0/63, ret.GetResults().GetScores()[0], 1e-6)
+		assert.Equal(t, []int64{10, 30, 20, 70, 80}, ret.GetResults().GetFieldsData()[0].GetScalars().GetLongData().GetData())
+		assert.Equal(t, "tag", ret.GetResults().GetFieldsData()[0].GetFieldName())
+	})
+
+	t.Run("rrf with offset", func(t *testing.T) {
+		ret, err := rerankSearchResults(context.TODO(), &rrfReranker{k: defaultRRFParamK}, results, metricTypes, 2, 2, 1, schemapb.DataType_Int64)
+		require.NoError(t, err)
+		assert.Equal(t, []int64{3, 2, 8}, ret.GetResults().GetIds().GetIntId().GetData())
+		assert.Equal(t, []int64{2, 1}, ret.GetResults().GetTopks())
+	})
+
+	t.Run("weighted", func(t *testing.T) {
+		// the second search dominates
+		ret, err := rerankSearchResults(context.TODO(), &weightedReranker{weights: []float64{0, 1}}, results, metricTypes, 2, 3, 0, schemapb.DataType_Int64)
+		require.NoError(t, err)
+		assert.Equal(t, []int64{3, 4, 1, 8, 7}, ret.GetResults().GetIds().GetIntId().GetData())
+
+		ret, err = rerankSearchResults(context.TODO(), &weightedReranker{weights: []float64{1, 0}}, results, metricTypes, 2, 3, 0, schemapb.DataType_Int64)
+		require.NoError(t, err)
+		assert.Equal(t, []int64{1, 2, 3, 7, 8}, ret.GetResults().GetIds().GetIntId().GetData())
+	})
+
+	t.Run("empty sub search result", func(t *testing.T) {
+		empty := &milvuspb.SearchResults{Results: &schemapb.SearchResultData{NumQueries: 2, Topks: []int64{0, 0}}}
+		ret, err := rerankSearchResults(context.TODO(), &rrfReranker{k: defaultRRFParamK},
+			[]*milvuspb.SearchResults{empty, results[0]}, metricTypes, 2, 3, 0, schemapb.DataType_Int64)
+		require.NoError(t, err)
+		assert.Equal(t, []int64{1, 2, 3, 7}, ret.GetResults().GetIds().GetIntId().GetData())
+		assert.Equal(t, []int64{3, 1}, ret.GetResults().GetTopks())
+	})
+
+	t.Run("topks mis-match with nq", func(t *testing.T) {
+		_, err := rerankSearchResults(context.TODO(), &rrfReranker{k: defaultRRFParamK}, results, metricTypes, 3, 3, 0, schemapb.DataType_Int64)
+		assert.Error(t, err)
+	})
+
+	t.Run("unsupported pk type", func(t *testing.T) {
+		_, err := rerankSearchResults(context.TODO(), &rrfReranker{k: defaultRRFParamK}, results, metricTypes, 2, 3, 0, schemapb.DataType_Float)
+		assert.Error(t, err)
+	})
+}
@@ -49,8 +49,11 @@ const (
 	RoundDecimalKey = "round_decimal"
 	OffsetKey       = "offset"
 	LimitKey        = "limit"
+	GroupByFieldKey = "group_by_field"
+	GroupSizeKey    = "group_size"
 
 	InsertTaskName             = "InsertTask"
+	UpsertTaskName             = "UpsertTask"
 	CreateCollectionTaskName   = "CreateCollectionTask"
 	DropCollectionTaskName     = "DropCollectionTask"
 	HasCollectionTaskName      = "HasCollectionTask"
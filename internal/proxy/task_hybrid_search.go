@@ -0,0 +1,218 @@
+package proxy
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/golang/protobuf/proto"
+	"go.uber.org/zap"
+	"golang.org/x/sync/errgroup"
+
+	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/milvuspb"
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/internal/proto/proxypb"
+	"github.com/milvus-io/milvus/internal/types"
+	"github.com/milvus-io/milvus/internal/util/commonpbutil"
+	"github.com/milvus-io/milvus/internal/util/funcutil"
+	"github.com/milvus-io/milvus/internal/util/paramtable"
+	"github.com/milvus-io/milvus/internal/util/timerecord"
+	"github.com/milvus-io/milvus/internal/util/trace"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
+)
+
+const (
+	HybridSearchTaskName = "HybridSearchTask"
+)
+
+// hybridSearchTask does one ANN search for each sub request, usually on different vector fields,
+// and merges the results of the sub searches with a reranker.
+// The sub searches share the timestamp of the hybrid search task, so they see the same data.
+type hybridSearchTask struct {
+	Condition
+	ctx  context.Context
+	base *commonpb.MsgBase
+
+	request  *proxypb.HybridSearchRequest
+	result   *milvuspb.SearchResults
+	qc       types.QueryCoord
+	tr       *timerecord.TimeRecorder
+	shardMgr *shardClientMgr
+
+	searchTasks []*searchTask
+	reranker    reranker
+	topk        int64
+	offset      int64
+
+	searchShardPolicy pickShardPolicy
+}
+
+func (t *hybridSearchTask) TraceCtx() context.Context {
+	return t.ctx
+}
+
+func (t *hybridSearchTask) ID() UniqueID {
+	return t.base.MsgID
+}
+
+func (t *hybridSearchTask) SetID(uid UniqueID) {
+	t.base.MsgID = uid
+}
+
+func (t *hybridSearchTask) Name() string {
+	return HybridSearchTaskName
+}
+
+func (t *hybridSearchTask) Type() commonpb.MsgType {
+	return t.base.MsgType
+}
+
+func (t *hybridSearchTask) BeginTs() Timestamp {
+	return t.base.Timestamp
+}
+
+func (t *hybridSearchTask) EndTs() Timestamp {
+	return t.base.Timestamp
+}
+
+func (t *hybridSearchTask) SetTs(ts Timestamp) {
+	t.base.Timestamp = ts
+}
+
+func (t *hybridSearchTask) OnEnqueue() error {
+	t.base = commonpbutil.NewMsgBase(
+		commonpbutil.WithMsgType(commonpb.MsgType_Search),
+		commonpbutil.WithSourceID(paramtable.GetNodeID()),
+	)
+	return nil
+}
+
+func (t *hybridSearchTask) PreExecute(ctx context.Context) error {
+	sp, ctx := trace.StartSpanFromContextWithOperationName(t.TraceCtx(), "Proxy-HybridSearch-PreExecute")
+	defer sp.Finish()
+
+	if len(t.request.GetRequests()) == 0 {
+		return errors.New("no sub search request in hybrid search")
+	}
+
+	var err error
+	t.reranker, t.topk, t.offset, err = parseRankParams(t.request.GetRankParams(), len(t.request.GetRequests()))
+	if err != nil {
+		return err
+	}
+
+	t.searchTasks = make([]*searchTask, 0, len(t.request.GetRequests()))
+	for i, subReq := range t.request.GetRequests() {
+		req, err := t.newSubSearchRequest(subReq)
+		if err != nil {
+			return fmt.Errorf("sub search %d of hybrid search is invalid, %w", i, err)
+		}
+
+		st := &searchTask{
+			ctx:       t.ctx,
+			Condition: NewTaskCondition(t.ctx),
+			SearchRequest: &internalpb.SearchRequest{
+				Base:  proto.Clone(t.base).(*commonpb.MsgBase),
+				ReqID: paramtable.GetNodeID(),
+			},
+			request:           req,
+			qc:                t.qc,
+			tr:                timerecord.NewTimeRecorder("search"),
+			shardMgr:          t.shardMgr,
+			searchShardPolicy: t.searchShardPolicy,
+		}
+		if err := st.PreExecute(ctx); err != nil {
+			return fmt.Errorf("sub search %d of hybrid search is invalid, %w", i, err)
+		}
+		if i > 0 && st.SearchRequest.GetNq() != t.searchTasks[0].SearchRequest.GetNq() {
+			return fmt.Errorf("nq(%d) of sub search %d mis-match with nq(%d) of sub search 0",
+				st.SearchRequest.GetNq(), i, t.searchTasks[0].SearchRequest.GetNq())
+		}
+		t.searchTasks = append(t.searchTasks, st)
+	}
+
+	log.Ctx(ctx).Debug("hybrid search PreExecute done.",
+		zap.Int("number of sub searches", len(t.searchTasks)),
+		zap.String("strategy", t.reranker.strategy()),
+		zap.Int64("topk", t.topk),
+		zap.Int64("offset", t.offset))
+	return nil
+}
+
+// newSubSearchRequest returns the search request of a sub search. Each sub search returns topk+offset hits,
+// so that the entities ranked in [offset, offset+topk) by the reranker are hit by the sub searches.
+func (t *hybridSearchTask) newSubSearchRequest(subReq *milvuspb.SearchRequest) (*milvuspb.SearchRequest, error) {
+	if _, err := funcutil.GetAttrByKeyFromRepeatedKV(OffsetKey, subReq.GetSearchParams()); err == nil {
+		return nil, fmt.Errorf("%s of sub search is not supported, set it in rank params instead", OffsetKey)
+	}
+
+	// collection, partitions, output fields and timestamps are shared by all sub searches
+	req := proto.Clone(subReq).(*milvuspb.SearchRequest)
+	req.DbName = t.request.GetDbName()
+	req.CollectionName = t.request.GetCollectionName()
+	req.PartitionNames = t.request.GetPartitionNames()
+	req.OutputFields = t.request.GetOutputFields()
+	req.TravelTimestamp = t.request.GetTravelTimestamp()
+	req.GuaranteeTimestamp = t.request.GetGuaranteeTimestamp()
+	req.SearchParams = withTopK(req.GetSearchParams(), t.topk+t.offset)
+	return req, nil
+}
+
+func (t *hybridSearchTask) Execute(ctx context.Context) error {
+	sp, ctx := trace.StartSpanFromContextWithOperationName(t.TraceCtx(), "Proxy-HybridSearch-Execute")
+	defer sp.Finish()
+
+	tr := timerecord.NewTimeRecorder(fmt.Sprintf("proxy execute hybrid search %d", t.ID()))
+	defer tr.CtxElapse(ctx, "done")
+
+	// sub searches are sent to the shard leaders concurrently
+	group, ctx := errgroup.WithContext(ctx)
+	for _, st := range t.searchTasks {
+		st := st
+		group.Go(func() error {
+			return st.Execute(ctx)
+		})
+	}
+	if err := group.Wait(); err != nil {
+		return err
+	}
+
+	log.Ctx(ctx).Debug("HybridSearch Execute done.")
+	return nil
+}
+
+func (t *hybridSearchTask) PostExecute(ctx context.Context) error {
+	sp, ctx := trace.StartSpanFromContextWithOperationName(t.TraceCtx(), "Proxy-HybridSearch-PostExecute")
+	defer sp.Finish()
+
+	tr := timerecord.NewTimeRecorder("hybridSearchTask PostExecute")
+	defer func() {
+		tr.CtxElapse(ctx, "done")
+	}()
+
+	results := make([]*milvuspb.SearchResults, 0, len(t.searchTasks))
+	metricTypes := make([]string, 0, len(t.searchTasks))
+	for _, st := range t.searchTasks {
+		if err := st.PostExecute(ctx); err != nil {
+			return err
+		}
+		results = append(results, st.result)
+		metricTypes = append(metricTypes, st.SearchRequest.GetMetricType())
+	}
+
+	primaryFieldSchema, err := typeutil.GetPrimaryFieldSchema(t.searchTasks[0].schema)
+	if err != nil {
+		return err
+	}
+	t.result, err = rerankSearchResults(ctx, t.reranker, results, metricTypes,
+		t.searchTasks[0].SearchRequest.GetNq(), t.topk, t.offset, primaryFieldSchema.GetDataType())
+	if err != nil {
+		return err
+	}
+	t.result.CollectionName = t.request.GetCollectionName()
+
+	log.Ctx(ctx).Debug("HybridSearch post execute done")
+	return nil
+}
@@ -0,0 +1,335 @@
+package proxy
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/milvuspb"
+	"github.com/milvus-io/milvus-proto/go-api/schemapb"
+	"github.com/milvus-io/milvus/internal/allocator"
+	"github.com/milvus-io/milvus/internal/common"
+	"github.com/milvus-io/milvus/internal/mq/msgstream"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/internal/proto/proxypb"
+	"github.com/milvus-io/milvus/internal/proto/querypb"
+	"github.com/milvus-io/milvus/internal/types"
+	"github.com/milvus-io/milvus/internal/util/distance"
+	"github.com/milvus-io/milvus/internal/util/funcutil"
+	"github.com/milvus-io/milvus/internal/util/paramtable"
+	"github.com/milvus-io/milvus/internal/util/timerecord"
+)
+
+func TestHybridSearchTask_PreExecute(t *testing.T) {
+	newTask := func(req *proxypb.HybridSearchRequest) *hybridSearchTask {
+		ctx := context.Background()
+		task := &hybridSearchTask{
+			ctx:       ctx,
+			Condition: NewTaskCondition(ctx),
+			request:   req,
+		}
+		assert.NoError(t, task.OnEnqueue())
+		return task
+	}
+
+	t.Run("no sub requests", func(t *testing.T) {
+		task := newTask(&proxypb.HybridSearchRequest{
+			CollectionName: "test",
+			RankParams:     []*commonpb.KeyValuePair{{Key: TopKKey, Value: "10"}},
+		})
+		assert.Error(t, task.PreExecute(context.Background()))
+	})
+
+	t.Run("invalid rank params", func(t *testing.T) {
+		task := newTask(&proxypb.HybridSearchRequest{
+			CollectionName: "test",
+			Requests:       []*milvuspb.SearchRequest{{}, {}},
+			RankParams: []*commonpb.KeyValuePair{
+				{Key: TopKKey, Value: "10"},
+				{Key: RankStrategyKey, Value: WeightedRankStrategy},
+				{Key: SearchParamsKey, Value: `{"weights": [1.0]}`},
+			},
+		})
+		assert.Error(t, task.PreExecute(context.Background()))
+	})
+}
+
+func TestHybridSearchTask_newSubSearchRequest(t *testing.T) {
+	task := &hybridSearchTask{
+		request: &proxypb.HybridSearchRequest{
+			CollectionName: "test",
+			OutputFields:   []string{"tag"},
+		},
+		topk:   10,
+		offset: 5,
+	}
+
+	req, err := task.newSubSearchRequest(&milvuspb.SearchRequest{
+		CollectionName: "ignored",
+		SearchParams:   []*commonpb.KeyValuePair{{Key: AnnsFieldKey, Value: "vec"}, {Key: TopKKey, Value: "3"}},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "test", req.GetCollectionName())
+	assert.Equal(t, []string{"tag"}, req.GetOutputFields())
+	topk, err := funcutil.GetAttrByKeyFromRepeatedKV(TopKKey, req.GetSearchParams())
+	assert.NoError(t, err)
+	assert.Equal(t, "15", topk)
+	annsField, err := funcutil.GetAttrByKeyFromRepeatedKV(AnnsFieldKey, req.GetSearchParams())
+	assert.NoError(t, err)
+	assert.Equal(t, "vec", annsField)
+
+	_, err = task.newSubSearchRequest(&milvuspb.SearchRequest{
+		SearchParams: []*commonpb.KeyValuePair{{Key: OffsetKey, Value: "1"}},
+	})
+	assert.Error(t, err)
+}
+
+func TestHybridSearchTask_PostExecute(t *testing.T) {
+	ctx := context.Background()
+	schema := &schemapb.CollectionSchema{
+		Name: "test",
+		Fields: []*schemapb.FieldSchema{
+			{FieldID: 100, Name: "pk", DataType: schemapb.DataType_Int64, IsPrimaryKey: true},
+			{FieldID: 101, Name: "text_vec", DataType: schemapb.DataType_FloatVector},
+			{FieldID: 102, Name: "image_vec", DataType: schemapb.DataType_FloatVector},
+		},
+	}
+	// each sub search returns topk+offset hits
+	newSearchTask := func(ids []int64, scores []float32) *searchTask {
+		blob, err := proto.Marshal(&schemapb.SearchResultData{
+			NumQueries: 1,
+			TopK:       3,
+			Ids:        &schemapb.IDs{IdField: &schemapb.IDs_IntId{IntId: &schemapb.LongArray{Data: ids}}},
+			Scores:     scores,
+			Topks:      []int64{int64(len(ids))},
+		})
+		require.NoError(t, err)
+		st := &searchTask{
+			ctx:       ctx,
+			Condition: NewTaskCondition(ctx),
+			SearchRequest: &internalpb.SearchRequest{
+				Nq:         1,
+				Topk:       3,
+				MetricType: distance.IP,
+			},
+			request:         &milvuspb.SearchRequest{},
+			schema:          schema,
+			tr:              timerecord.NewTimeRecorder("search"),
+			resultBuf:       make(chan *internalpb.SearchResults, 1),
+			toReduceResults: make([]*internalpb.SearchResults, 0),
+		}
+		st.resultBuf <- &internalpb.SearchResults{SlicedBlob: blob}
+		return st
+	}
+
+	task := &hybridSearchTask{
+		ctx:       ctx,
+		Condition: NewTaskCondition(ctx),
+		request:   &proxypb.HybridSearchRequest{CollectionName: "test"},
+		reranker:  &rrfReranker{k: defaultRRFParamK},
+		topk:      2,
+		offset:    1,
+		searchTasks: []*searchTask{
+			newSearchTask([]int64{1, 2, 3}, []float32{0.9, 0.8, 0.7}),
+			newSearchTask([]int64{3, 1, 4}, []float32{0.9, 0.8, 0.7}),
+		},
+	}
+	require.NoError(t, task.PostExecute(ctx))
+
+	// 1: 1/61 + 1/62, 3: 1/63 + 1/61, 2: 1/62, 4: 1/63, the first one is skipped by offset
+	assert.Equal(t, []int64{3, 2}, task.result.GetResults().GetIds().GetIntId().GetData())
+	assert.Equal(t, []int64{2}, task.result.GetResults().GetTopks())
+	assert.InDelta(t, 1.0/63+1.0/61, task.result.GetResults().GetScores()[0], 1e-6)
+	assert.InDelta(t, 1.0/62, task.result.GetResults().GetScores()[1], 1e-6)
+	assert.Equal(t, "test", task.result.GetCollectionName())
+}
+
+func TestHybridSearchTask_MultipleVectorFields(t *testing.T) {
+	var (
+		ctx = context.Background()
+
+		rc = NewRootCoordMock()
+		qc = NewQueryCoordMock(withValidShardLeaders())
+		qn = &QueryNodeMock{}
+
+		shardsNum      = int32(2)
+		collectionName = t.Name() + funcutil.GenRandomStr()
+		nb             = 10
+	)
+
+	mgr := newShardClientMgr(withShardClientCreator(func(ctx context.Context, address string) (types.QueryNode, error) {
+		return qn, nil
+	}))
+
+	rc.Start()
+	defer rc.Stop()
+	qc.Start()
+	defer qc.Stop()
+
+	require.NoError(t, InitMetaCache(ctx, rc, qc, mgr))
+
+	fieldName2Types := map[string]schemapb.DataType{
+		testInt64Field:     schemapb.DataType_Int64,
+		testFloatVecField:  schemapb.DataType_FloatVector,
+		testBinaryVecField: schemapb.DataType_BinaryVector,
+	}
+
+	t.Run("create collection", func(t *testing.T) {
+		schema := constructCollectionSchemaByDataType(collectionName, fieldName2Types, testInt64Field, false)
+		marshaledSchema, err := proto.Marshal(schema)
+		require.NoError(t, err)
+
+		createColT := &createCollectionTask{
+			Condition: NewTaskCondition(ctx),
+			CreateCollectionRequest: &milvuspb.CreateCollectionRequest{
+				CollectionName: collectionName,
+				Schema:         marshaledSchema,
+				ShardsNum:      shardsNum,
+			},
+			ctx:       ctx,
+			rootCoord: rc,
+		}
+		require.NoError(t, createColT.OnEnqueue())
+		require.NoError(t, createColT.PreExecute(ctx))
+		require.NoError(t, createColT.Execute(ctx))
+		require.NoError(t, createColT.PostExecute(ctx))
+	})
+
+	collectionID, err := globalMetaCache.GetCollectionID(ctx, collectionName)
+	require.NoError(t, err)
+
+	t.Run("insert", func(t *testing.T) {
+		chMgr := newChannelsMgrImpl(getDmlChannelsFunc(ctx, rc), nil, newSimpleMockMsgStreamFactory())
+		defer chMgr.removeAllDMLStream()
+		_, err := chMgr.getOrCreateDmlStream(collectionID)
+		require.NoError(t, err)
+		pchans, err := chMgr.getChannels(collectionID)
+		require.NoError(t, err)
+
+		ticker := newChannelsTimeTicker(ctx, 10*time.Millisecond, []string{}, newGetStatisticsFunc(pchans), newMockTsoAllocator())
+		_ = ticker.start()
+		defer ticker.close()
+
+		idAllocator, err := allocator.NewIDAllocator(ctx, rc, paramtable.GetNodeID())
+		require.NoError(t, err)
+		_ = idAllocator.Start()
+		defer idAllocator.Close()
+
+		segAllocator, err := newSegIDAssigner(ctx, &mockDataCoord{expireTime: Timestamp(2500)}, getLastTick1)
+		require.NoError(t, err)
+		_ = segAllocator.Start()
+		defer segAllocator.Close()
+
+		task := &insertTask{
+			BaseInsertTask: BaseInsertTask{
+				BaseMsg: msgstream.BaseMsg{
+					HashValues: generateHashKeys(nb),
+				},
+				InsertRequest: internalpb.InsertRequest{
+					Base: &commonpb.MsgBase{
+						MsgType:  commonpb.MsgType_Insert,
+						SourceID: paramtable.GetNodeID(),
+					},
+					CollectionName: collectionName,
+					NumRows:        uint64(nb),
+					Version:        internalpb.InsertDataVersion_ColumnBased,
+				},
+			},
+			Condition: NewTaskCondition(ctx),
+			ctx:       ctx,
+			result: &milvuspb.MutationResult{
+				Status: &commonpb.Status{
+					ErrorCode: commonpb.ErrorCode_Success,
+				},
+			},
+			idAllocator:   idAllocator,
+			segIDAssigner: segAllocator,
+			chMgr:         chMgr,
+			chTicker:      ticker,
+		}
+		for fieldName, dataType := range fieldName2Types {
+			task.FieldsData = append(task.FieldsData, generateFieldData(dataType, fieldName, nb))
+		}
+		require.NoError(t, task.OnEnqueue())
+		require.NoError(t, task.PreExecute(ctx))
+		require.NoError(t, task.Execute(ctx))
+		require.NoError(t, task.PostExecute(ctx))
+		assert.Equal(t, int64(nb), task.result.GetInsertCnt())
+	})
+
+	t.Run("hybrid search", func(t *testing.T) {
+		status, err := qc.LoadCollection(ctx, &querypb.LoadCollectionRequest{
+			Base: &commonpb.MsgBase{
+				MsgType:  commonpb.MsgType_LoadCollection,
+				SourceID: paramtable.GetNodeID(),
+			},
+			CollectionID: collectionID,
+		})
+		require.NoError(t, err)
+		require.Equal(t, commonpb.ErrorCode_Success, status.ErrorCode)
+
+		floatReq := constructSearchRequest("", collectionName, "", testFloatVecField, 1, testVecDim, 10, 10, -1)
+		binaryPlg, err := proto.Marshal(&commonpb.PlaceholderGroup{
+			Placeholders: []*commonpb.PlaceholderValue{{
+				Tag:    "$0",
+				Type:   commonpb.PlaceholderType_BinaryVector,
+				Values: [][]byte{make([]byte, testVecDim/8)},
+			}},
+		})
+		require.NoError(t, err)
+		binaryReq := &milvuspb.SearchRequest{
+			PlaceholderGroup: binaryPlg,
+			DslType:          commonpb.DslType_BoolExprV1,
+			SearchParams: []*commonpb.KeyValuePair{
+				{Key: common.MetricTypeKey, Value: distance.HAMMING},
+				{Key: SearchParamsKey, Value: `{"nprobe": 10}`},
+				{Key: AnnsFieldKey, Value: testBinaryVecField},
+				{Key: TopKKey, Value: "10"},
+			},
+		}
+
+		// every shard returns the same hits for both sub searches
+		blob, err := proto.Marshal(&schemapb.SearchResultData{
+			NumQueries: 1,
+			TopK:       3,
+			Ids:        &schemapb.IDs{IdField: &schemapb.IDs_IntId{IntId: &schemapb.LongArray{Data: []int64{1, 2, 3}}}},
+			Scores:     []float32{0.1, 0.2, 0.3},
+			Topks:      []int64{3},
+		})
+		require.NoError(t, err)
+		qn.withSearchResult = &internalpb.SearchResults{
+			Status:     &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success},
+			MetricType: distance.L2,
+			NumQueries: 1,
+			TopK:       3,
+			SlicedBlob: blob,
+		}
+
+		task := &hybridSearchTask{
+			ctx:       ctx,
+			Condition: NewTaskCondition(ctx),
+			request: &proxypb.HybridSearchRequest{
+				CollectionName: collectionName,
+				Requests:       []*milvuspb.SearchRequest{floatReq, binaryReq},
+				RankParams:     []*commonpb.KeyValuePair{{Key: TopKKey, Value: "2"}},
+			},
+			qc:       qc,
+			tr:       timerecord.NewTimeRecorder("hybrid search"),
+			shardMgr: mgr,
+		}
+		require.NoError(t, task.OnEnqueue())
+		require.NoError(t, task.PreExecute(ctx))
+		require.Len(t, task.searchTasks, 2)
+		assert.NotEqual(t, task.searchTasks[0].SearchRequest.GetMetricType(), task.searchTasks[1].SearchRequest.GetMetricType())
+		require.NoError(t, task.Execute(ctx))
+		require.NoError(t, task.PostExecute(ctx))
+
+		assert.Equal(t, []int64{1, 2}, task.result.GetResults().GetIds().GetIntId().GetData())
+		assert.Equal(t, []int64{2}, task.result.GetResults().GetTopks())
+	})
+}
@@ -247,6 +247,12 @@ func (it *insertTask) PreExecute(ctx context.Context) error {
 		return err
 	}
 
+	if err = validateJSONFieldData(it.GetFieldsData()); err != nil {
+		log.Error("json field data is invalid",
+			zap.Error(err))
+		return err
+	}
+
 	// check that all field's number rows are equal
 	if err = it.CheckAligned(); err != nil {
 		log.Error("field data is not aligned",
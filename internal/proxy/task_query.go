@@ -51,6 +51,8 @@ type queryTask struct {
 	ids            *schemapb.IDs
 	collectionName string
 	queryParams    *queryParams
+	iterator       *iteratorParams
+	pkFieldID      int64
 
 	resultBuf       chan *internalpb.RetrieveResults
 	toReduceResults []*internalpb.RetrieveResults
@@ -220,6 +222,12 @@ func (t *queryTask) PreExecute(ctx context.Context) error {
 	if err != nil {
 		return err
 	}
+	if t.iterator != nil {
+		if queryParams.offset != 0 {
+			return fmt.Errorf("%s is not supported by query iterator", OffsetKey)
+		}
+		queryParams.limit = t.iterator.batchSize
+	}
 	t.queryParams = queryParams
 	t.RetrieveRequest.Limit = queryParams.limit + queryParams.offset
 
@@ -251,6 +259,17 @@ func (t *queryTask) PreExecute(ctx context.Context) error {
 	if err != nil {
 		return err
 	}
+	if t.iterator != nil {
+		// resume from the last primary key, query results are sorted by primary key
+		pkField, err := typeutil.GetPrimaryFieldSchema(schema)
+		if err != nil {
+			return err
+		}
+		if err := appendIteratorCursorExpr(plan, pkField, t.iterator.cursor.GetPks()); err != nil {
+			return err
+		}
+		t.pkFieldID = pkField.GetFieldID()
+	}
 	t.request.OutputFields, err = translateOutputFields(t.request.OutputFields, schema, true)
 	if err != nil {
 		return err
@@ -279,6 +298,10 @@ func (t *queryTask) PreExecute(ctx context.Context) error {
 	} else {
 		t.TravelTimestamp = t.request.TravelTimestamp
 	}
+	if t.iterator != nil {
+		// all pages of an iterator query the same snapshot
+		t.TravelTimestamp = t.iterator.mvccTimestamp(t.TravelTimestamp)
+	}
 
 	err = validateTravelTimestamp(t.TravelTimestamp, t.BeginTs())
 	if err != nil {
@@ -365,10 +388,20 @@ func (t *queryTask) PostExecute(ctx context.Context) error {
 	metrics.ProxyReduceResultLatency.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10), metrics.QueryLabel).Observe(float64(tr.RecordSpan().Milliseconds()))
 	t.result.CollectionName = t.collectionName
 
+	if t.iterator != nil {
+		t.iterator.next = nextQueryCursor(t.result, t.pkFieldID, t.iterator.batchSize, t.TravelTimestamp)
+	}
+
 	if len(t.result.FieldsData) > 0 {
 		t.result.Status = &commonpb.Status{
 			ErrorCode: commonpb.ErrorCode_Success,
 		}
+	} else if t.iterator != nil {
+		// no more entities after the cursor
+		t.result.Status = &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_Success,
+		}
+		return nil
 	} else {
 		log.Ctx(ctx).Warn("Query result is nil",
 			zap.Any("requestType", "query"))
@@ -472,18 +505,23 @@ func reduceRetrieveResults(ctx context.Context, retrieveResults []*internalpb.Re
 	if queryParams != nil && queryParams.limit != typeutil.Unlimited {
 		loopEnd = int(queryParams.limit)
 
-		if queryParams.offset > 0 {
-			for i := int64(0); i < queryParams.offset; i++ {
-				sel := typeutil.SelectMinPK(validRetrieveResults, cursors)
-				if sel == -1 {
-					return ret, nil
-				}
-				cursors[sel]++
+		// duplicated primary keys don't count in the offset
+		for i := int64(0); i < queryParams.offset; {
+			sel := typeutil.SelectMinPK(validRetrieveResults, cursors)
+			if sel == -1 {
+				return ret, nil
+			}
+			pk := typeutil.GetPK(validRetrieveResults[sel].GetIds(), cursors[sel])
+			if _, ok := idSet[pk]; !ok {
+				idSet[pk] = struct{}{}
+				i++
 			}
+			cursors[sel]++
 		}
 	}
 
-	for j := 0; j < loopEnd; j++ {
+	// k-way merge of the results sorted by primary key, duplicated primary keys don't count in the limit
+	for j := 0; j < loopEnd; {
 		sel := typeutil.SelectMinPK(validRetrieveResults, cursors)
 		if sel == -1 {
 			break
@@ -493,6 +531,7 @@ func reduceRetrieveResults(ctx context.Context, retrieveResults []*internalpb.Re
 		if _, ok := idSet[pk]; !ok {
 			typeutil.AppendFieldData(ret.FieldsData, validRetrieveResults[sel].GetFieldsData(), cursors[sel])
 			idSet[pk] = struct{}{}
+			j++
 		} else {
 			// primary keys duplicate
 			skipDupCnt++
@@ -518,6 +518,37 @@ func TestTaskQuery_functions(t *testing.T) {
 				}
 			})
 		})
+
+		t.Run("test duplicated pks don't count in limit and offset", func(t *testing.T) {
+			r1 := &internalpb.RetrieveResults{
+				Ids: &schemapb.IDs{
+					IdField: &schemapb.IDs_IntId{
+						IntId: &schemapb.LongArray{
+							Data: []int64{1, 2},
+						},
+					},
+				},
+				FieldsData: []*schemapb.FieldData{getFieldData(Int64FieldName, Int64FieldID, schemapb.DataType_Int64, []int64{10, 20}, 1)},
+			}
+			r2 := &internalpb.RetrieveResults{
+				Ids: &schemapb.IDs{
+					IdField: &schemapb.IDs_IntId{
+						IntId: &schemapb.LongArray{
+							Data: []int64{1, 3},
+						},
+					},
+				},
+				FieldsData: []*schemapb.FieldData{getFieldData(Int64FieldName, Int64FieldID, schemapb.DataType_Int64, []int64{10, 30}, 1)},
+			}
+
+			result, err := reduceRetrieveResults(context.Background(), []*internalpb.RetrieveResults{r1, r2}, &queryParams{limit: 2})
+			assert.NoError(t, err)
+			assert.Equal(t, []int64{10, 20}, result.GetFieldsData()[0].GetScalars().GetLongData().GetData())
+
+			result, err = reduceRetrieveResults(context.Background(), []*internalpb.RetrieveResults{r1, r2}, &queryParams{limit: 2, offset: 1})
+			assert.NoError(t, err)
+			assert.Equal(t, []int64{20, 30}, result.GetFieldsData()[0].GetScalars().GetLongData().GetData())
+		})
 	})
 }
 
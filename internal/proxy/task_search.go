@@ -54,6 +54,10 @@ type searchTask struct {
 	schema         *schemapb.CollectionSchema
 
 	offset          int64
+	rangeParams     *rangeSearchParams
+	groupByFieldID  int64
+	groupSize       int64
+	iterator        *iteratorParams
 	resultBuf       chan *internalpb.SearchResults
 	toReduceResults []*internalpb.SearchResults
 
@@ -217,6 +221,137 @@ func parseSearchInfo(searchParamsPair []*commonpb.KeyValuePair) (*planpb.QueryIn
 	}, offset, nil
 }
 
+// rangeSearchParams holds the bounds of a range search. The bounds are converted to scores,
+// larger is better, so that they can be compared with the scores of search results directly.
+type rangeSearchParams struct {
+	radius         float32 // exclusive lower bound of score
+	rangeFilter    float32 // inclusive upper bound of score
+	hasRangeFilter bool
+	// hits returned by the previous pages of a search iterator
+	returnedPKs map[interface{}]struct{}
+}
+
+// belowRadius returns true if the score is out of the radius, the results after it are out of range too.
+func (p *rangeSearchParams) belowRadius(score float32) bool {
+	return score <= p.radius
+}
+
+// aboveRangeFilter returns true if the score is filtered by range filter.
+func (p *rangeSearchParams) aboveRangeFilter(score float32) bool {
+	return p.hasRangeFilter && score > p.rangeFilter
+}
+
+// returned returns true if the hit is returned by the previous pages of a search iterator.
+func (p *rangeSearchParams) returned(id interface{}) bool {
+	_, ok := p.returnedPKs[id]
+	return ok
+}
+
+func parseRangeSearchValue(key string, value interface{}) (float32, error) {
+	switch v := value.(type) {
+	case float64: // for numeric values, json unmarshal will interpret it as float64
+		return float32(v), nil
+	case string:
+		f, err := strconv.ParseFloat(v, 32)
+		if err != nil {
+			return 0, fmt.Errorf("%s [%s] is invalid", key, v)
+		}
+		return float32(f), nil
+	default:
+		return 0, fmt.Errorf("%s [%v] is invalid", key, value)
+	}
+}
+
+// parseRangeSearchParams returns the range search params if `radius` is specified in the search params,
+// nil is returned for a normal top-k search. The query nodes search the hits in range of each segment,
+// the params are used to filter the reduced results again.
+// For IP, a hit is in range if radius < distance <= range_filter;
+// for other metrics, a hit is in range if range_filter <= distance < radius.
+func parseRangeSearchParams(searchParamStr string, metricType string) (*rangeSearchParams, error) {
+	searchParamMap := make(map[string]interface{})
+	if err := json.Unmarshal([]byte(searchParamStr), &searchParamMap); err != nil {
+		return nil, fmt.Errorf("%s [%s] is invalid, %w", SearchParamsKey, searchParamStr, err)
+	}
+	radiusValue, ok := searchParamMap[common.RadiusKey]
+	if !ok {
+		if _, ok := searchParamMap[common.RangeFilterKey]; ok {
+			return nil, fmt.Errorf("%s must be used together with %s", common.RangeFilterKey, common.RadiusKey)
+		}
+		return nil, nil
+	}
+	radius, err := parseRangeSearchValue(common.RadiusKey, radiusValue)
+	if err != nil {
+		return nil, err
+	}
+
+	positivelyRelated := distance.PositivelyRelated(metricType)
+	params := &rangeSearchParams{radius: radius}
+	if !positivelyRelated {
+		params.radius = -radius
+	}
+
+	rangeFilterValue, ok := searchParamMap[common.RangeFilterKey]
+	if !ok {
+		return params, nil
+	}
+	rangeFilter, err := parseRangeSearchValue(common.RangeFilterKey, rangeFilterValue)
+	if err != nil {
+		return nil, err
+	}
+	if positivelyRelated && rangeFilter <= radius {
+		return nil, fmt.Errorf("%s [%v] must be greater than %s [%v] for metric type %s",
+			common.RangeFilterKey, rangeFilter, common.RadiusKey, radius, metricType)
+	}
+	if !positivelyRelated && rangeFilter >= radius {
+		return nil, fmt.Errorf("%s [%v] must be less than %s [%v] for metric type %s",
+			common.RangeFilterKey, rangeFilter, common.RadiusKey, radius, metricType)
+	}
+	params.hasRangeFilter = true
+	params.rangeFilter = rangeFilter
+	if !positivelyRelated {
+		params.rangeFilter = -rangeFilter
+	}
+	return params, nil
+}
+
+// parseGroupByInfo returns the field which search results are grouped by and the max number of hits in each group,
+// zero field ID is returned if search results are not grouped.
+func parseGroupByInfo(searchParamsPair []*commonpb.KeyValuePair, schema *schemapb.CollectionSchema) (int64, int64, error) {
+	groupByFieldName, err := funcutil.GetAttrByKeyFromRepeatedKV(GroupByFieldKey, searchParamsPair)
+	if err != nil {
+		if _, err := funcutil.GetAttrByKeyFromRepeatedKV(GroupSizeKey, searchParamsPair); err == nil {
+			return 0, 0, fmt.Errorf("%s must be used together with %s", GroupSizeKey, GroupByFieldKey)
+		}
+		return 0, 0, nil
+	}
+
+	var groupByField *schemapb.FieldSchema
+	for _, field := range schema.GetFields() {
+		if field.GetName() == groupByFieldName {
+			groupByField = field
+			break
+		}
+	}
+	if groupByField == nil {
+		return 0, 0, fmt.Errorf("%s [%s] not found in schema", GroupByFieldKey, groupByFieldName)
+	}
+	dataType := groupByField.GetDataType()
+	if !typeutil.IsBoolType(dataType) && !typeutil.IsIntegerType(dataType) && dataType != schemapb.DataType_VarChar {
+		return 0, 0, fmt.Errorf("%s [%s] of type %s is invalid, only bool, integer and varchar fields are supported",
+			GroupByFieldKey, groupByFieldName, dataType.String())
+	}
+
+	groupSize := int64(1)
+	groupSizeStr, err := funcutil.GetAttrByKeyFromRepeatedKV(GroupSizeKey, searchParamsPair)
+	if err == nil {
+		groupSize, err = strconv.ParseInt(groupSizeStr, 0, 64)
+		if err != nil || groupSize <= 0 {
+			return 0, 0, fmt.Errorf("%s [%s] is invalid, should be a positive integer", GroupSizeKey, groupSizeStr)
+		}
+	}
+	return groupByField.GetFieldID(), groupSize, nil
+}
+
 func getOutputFieldIDs(schema *schemapb.CollectionSchema, outputFields []string) (outputFieldIDs []UniqueID, err error) {
 	outputFieldIDs = make([]UniqueID, 0, len(outputFields))
 	for _, name := range outputFields {
@@ -307,12 +442,56 @@ func (t *searchTask) PreExecute(ctx context.Context) error {
 			return errors.New(AnnsFieldKey + " not found in search_params")
 		}
 
+		if t.iterator != nil {
+			// each page searches the hits after the cursor, see withIteratorRange
+			t.request.SearchParams = withTopK(t.request.GetSearchParams(), t.iterator.batchSize)
+		}
+
 		queryInfo, offset, err := parseSearchInfo(t.request.GetSearchParams())
 		if err != nil {
 			return err
 		}
 		t.offset = offset
 
+		t.rangeParams, err = parseRangeSearchParams(queryInfo.GetSearchParams(), queryInfo.GetMetricType())
+		if err != nil {
+			return err
+		}
+
+		t.groupByFieldID, t.groupSize, err = parseGroupByInfo(t.request.GetSearchParams(), t.schema)
+		if err != nil {
+			return err
+		}
+		if t.groupByFieldID != 0 {
+			if offset != 0 {
+				return fmt.Errorf("%s is not supported when search results are grouped by field", OffsetKey)
+			}
+			// topk groups are returned, each group keeps at most group_size hits
+			queryInfo.Topk *= t.groupSize
+			if err := validateLimit(queryInfo.Topk); err != nil {
+				return fmt.Errorf("%s*%s [%d] is invalid, %w", TopKKey, GroupSizeKey, queryInfo.Topk, err)
+			}
+			queryInfo.GroupByFieldId = t.groupByFieldID
+			queryInfo.GroupSize = t.groupSize
+		}
+
+		if t.iterator != nil {
+			if offset != 0 || t.groupByFieldID != 0 {
+				return fmt.Errorf("%s and %s are not supported by search iterator", OffsetKey, GroupByFieldKey)
+			}
+			// the cursor is compared with the scores, they must not be rounded
+			if queryInfo.GetRoundDecimal() != -1 {
+				return fmt.Errorf("%s is not supported by search iterator", RoundDecimalKey)
+			}
+			if t.iterator.cursor != nil {
+				t.rangeParams = withIteratorCursor(t.rangeParams, t.iterator.cursor, queryInfo.GetMetricType())
+				queryInfo.SearchParams, err = withIteratorRange(queryInfo.GetSearchParams(), t.rangeParams, queryInfo.GetMetricType())
+				if err != nil {
+					return err
+				}
+			}
+		}
+
 		plan, err := planparserv2.CreateSearchPlan(t.schema, t.request.Dsl, annsField, queryInfo)
 		if err != nil {
 			log.Ctx(ctx).Warn("failed to create query plan", zap.Error(err),
@@ -320,6 +499,15 @@ func (t *searchTask) PreExecute(ctx context.Context) error {
 				zap.String("anns field", annsField), zap.Any("query info", queryInfo))
 			return fmt.Errorf("failed to create query plan: %v", err)
 		}
+		if t.iterator != nil && t.iterator.cursor != nil {
+			pkField, err := typeutil.GetPrimaryFieldSchema(t.schema)
+			if err != nil {
+				return err
+			}
+			if err := appendExcludedPKsExpr(plan, pkField, t.iterator.cursor.GetPks()); err != nil {
+				return err
+			}
+		}
 		log.Ctx(ctx).Debug("create query plan",
 			zap.String("dsl", t.request.Dsl), // may be very large if large term passed.
 			zap.String("anns field", annsField), zap.Any("query info", queryInfo))
@@ -328,6 +516,11 @@ func (t *searchTask) PreExecute(ctx context.Context) error {
 		if err != nil {
 			return err
 		}
+		if t.groupByFieldID != 0 && !funcutil.SliceContain(outputFieldIDs, t.groupByFieldID) {
+			// the values of group by field are required to reduce search results,
+			// it's removed from the results in PostExecute.
+			outputFieldIDs = append(outputFieldIDs, t.groupByFieldID)
+		}
 
 		t.SearchRequest.OutputFieldsId = outputFieldIDs
 		plan.OutputFieldIds = outputFieldIDs
@@ -346,6 +539,13 @@ func (t *searchTask) PreExecute(ctx context.Context) error {
 	}
 
 	travelTimestamp := t.request.TravelTimestamp
+	if t.iterator != nil {
+		// all pages of an iterator search the same snapshot
+		if travelTimestamp == 0 {
+			travelTimestamp = t.BeginTs()
+		}
+		travelTimestamp = t.iterator.mvccTimestamp(travelTimestamp)
+	}
 	if travelTimestamp == 0 {
 		travelTimestamp = typeutil.MaxTimestamp
 	}
@@ -375,6 +575,9 @@ func (t *searchTask) PreExecute(ctx context.Context) error {
 	if err := validateLimit(nq); err != nil {
 		return fmt.Errorf("%s [%d] is invalid, %w", NQKey, nq, err)
 	}
+	if t.iterator != nil && nq != 1 {
+		return fmt.Errorf("%s [%d] is invalid, search iterator supports only one query", NQKey, nq)
+	}
 	t.SearchRequest.Nq = nq
 
 	log.Ctx(ctx).Debug("search PreExecute done.",
@@ -434,38 +637,60 @@ func (t *searchTask) PostExecute(ctx context.Context) error {
 		MetricType = t.SearchRequest.GetMetricType()
 	)
 
-	if err := t.collectSearchResults(ctx); err != nil {
-		return err
-	}
-
-	// Decode all search results
-	tr.CtxRecord(ctx, "decodeResultStart")
-	validSearchResults, err := decodeSearchResults(ctx, t.toReduceResults)
+	primaryFieldSchema, err := typeutil.GetPrimaryFieldSchema(t.schema)
 	if err != nil {
 		return err
 	}
-	metrics.ProxyDecodeResultLatency.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10),
-		metrics.SearchLabel).Observe(float64(tr.RecordSpan().Milliseconds()))
 
-	if len(validSearchResults) <= 0 {
-		log.Ctx(ctx).Warn("search result is empty")
+	for {
+		if err := t.collectSearchResults(ctx); err != nil {
+			return err
+		}
 
-		t.fillInEmptyResult(Nq)
-		return nil
+		// Decode all search results
+		tr.CtxRecord(ctx, "decodeResultStart")
+		validSearchResults, err := decodeSearchResults(ctx, t.toReduceResults)
+		if err != nil {
+			return err
+		}
+		metrics.ProxyDecodeResultLatency.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10),
+			metrics.SearchLabel).Observe(float64(tr.RecordSpan().Milliseconds()))
+
+		if len(validSearchResults) <= 0 {
+			log.Ctx(ctx).Warn("search result is empty")
+
+			t.fillInEmptyResult(Nq)
+			return nil
+		}
+
+		// Reduce all search results
+		log.Ctx(ctx).Debug("proxy search post execute reduce",
+			zap.Int("number of valid search results", len(validSearchResults)))
+		tr.CtxRecord(ctx, "reduceResultStart")
+		t.result, err = reduceSearchResultData(ctx, validSearchResults, Nq, Topk, MetricType, primaryFieldSchema.DataType, t.offset, t.rangeParams, t.groupByFieldID, t.groupSize)
+		if err != nil {
+			return err
+		}
+
+		if t.groupByFieldID == 0 || !groupsUnfilled(t.result.GetResults(), t.groupByFieldID, Topk/t.groupSize, t.groupSize) {
+			break
+		}
+		searched, err := t.searchMoreCandidates(ctx)
+		if err != nil {
+			return err
+		}
+		if !searched {
+			break
+		}
 	}
 
-	// Reduce all search results
-	log.Ctx(ctx).Debug("proxy search post execute reduce",
-		zap.Int("number of valid search results", len(validSearchResults)))
-	tr.CtxRecord(ctx, "reduceResultStart")
-	primaryFieldSchema, err := typeutil.GetPrimaryFieldSchema(t.schema)
-	if err != nil {
-		return err
+	if t.iterator != nil {
+		t.iterator.next = nextSearchCursor(t.result.Results, t.iterator.cursor, t.iterator.batchSize, t.TravelTimestamp)
 	}
 
-	t.result, err = reduceSearchResultData(ctx, validSearchResults, Nq, Topk, MetricType, primaryFieldSchema.DataType, t.offset)
-	if err != nil {
-		return err
+	// the group by field is only output for reducing if it's not in the output fields
+	if t.groupByFieldID != 0 && len(t.result.Results.FieldsData) > len(t.request.GetOutputFields()) {
+		t.result.Results.FieldsData = t.result.Results.FieldsData[:len(t.request.GetOutputFields())]
 	}
 
 	metrics.ProxyReduceResultLatency.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10), metrics.SearchLabel).Observe(float64(tr.RecordSpan().Milliseconds()))
@@ -477,6 +702,70 @@ func (t *searchTask) PostExecute(ctx context.Context) error {
 	return nil
 }
 
+// groupsUnfilled returns true if a query gets less than topk groups while some of its groups are full.
+// The hits of full groups are skipped, they may take the places of the other groups' hits in the candidates
+// searched by the query nodes, so more candidates may fill the groups.
+func groupsUnfilled(data *schemapb.SearchResultData, groupByFieldID int64, topk int64, groupSize int64) bool {
+	var groupByField *schemapb.FieldData
+	for _, fieldData := range data.GetFieldsData() {
+		if fieldData.GetFieldId() == groupByFieldID {
+			groupByField = fieldData
+			break
+		}
+	}
+	if groupByField == nil {
+		return false
+	}
+
+	var offset int64
+	for _, hits := range data.GetTopks() {
+		groupCounts := make(map[interface{}]int64)
+		full := false
+		for i := offset; i < offset+hits; i++ {
+			groupValue := typeutil.GetScalarData(groupByField, i)
+			groupCounts[groupValue]++
+			if groupCounts[groupValue] >= groupSize {
+				full = true
+			}
+		}
+		offset += hits
+		if full && int64(len(groupCounts)) < topk {
+			return true
+		}
+	}
+	return false
+}
+
+// searchMoreCandidates searches again with twice the candidates of the last search,
+// false is returned if the candidates can't be increased.
+func (t *searchTask) searchMoreCandidates(ctx context.Context) (bool, error) {
+	topk := t.SearchRequest.GetTopk() * 2
+	if topk > searchCountLimit {
+		topk = searchCountLimit
+	}
+	if topk <= t.SearchRequest.GetTopk() {
+		return false, nil
+	}
+
+	plan := &planpb.PlanNode{}
+	if err := proto.Unmarshal(t.SearchRequest.GetSerializedExprPlan(), plan); err != nil {
+		return false, err
+	}
+	plan.GetVectorAnns().GetQueryInfo().Topk = topk
+	serializedPlan, err := proto.Marshal(plan)
+	if err != nil {
+		return false, err
+	}
+	log.Ctx(ctx).Debug("groups of search results are not filled, search more candidates",
+		zap.Int64("lastTopK", t.SearchRequest.GetTopk()), zap.Int64("topK", topk))
+	t.SearchRequest.SerializedExprPlan = serializedPlan
+	t.SearchRequest.Topk = topk
+	if err := t.Execute(ctx); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
 func (t *searchTask) searchShard(ctx context.Context, nodeID int64, qn types.QueryNode, channelIDs []string) error {
 	req := &querypb.SearchRequest{
 		Req:         t.SearchRequest,
@@ -614,7 +903,8 @@ func checkSearchResultData(data *schemapb.SearchResultData, nq int64, topk int64
 	if data.NumQueries != nq {
 		return fmt.Errorf("search result's nq(%d) mis-match with %d", data.NumQueries, nq)
 	}
-	if data.TopK != topk {
+	// more candidates than topk are searched if the groups of results are not filled
+	if data.TopK < topk {
 		return fmt.Errorf("search result's topk(%d) mis-match with %d", data.TopK, topk)
 	}
 
@@ -623,6 +913,18 @@ func checkSearchResultData(data *schemapb.SearchResultData, nq int64, topk int64
 		return fmt.Errorf("search result's score length invalid, score length=%d, expectedLength=%d",
 			len(data.Scores), pkHitNum)
 	}
+
+	// the number of hits varies between queries, e.g. range search or duplicated results removed
+	if int64(len(data.GetTopks())) != nq {
+		return fmt.Errorf("search result's topks length(%d) mis-match with nq(%d)", len(data.GetTopks()), nq)
+	}
+	var totalHits int64
+	for _, k := range data.GetTopks() {
+		totalHits += k
+	}
+	if totalHits != int64(pkHitNum) {
+		return fmt.Errorf("search result's sum of topks(%d) mis-match with hit number(%d)", totalHits, pkHitNum)
+	}
 	return nil
 }
 
@@ -658,7 +960,7 @@ func selectHighestScoreIndex(subSearchResultData []*schemapb.SearchResultData, s
 	return subSearchIdx, resultDataIdx
 }
 
-func reduceSearchResultData(ctx context.Context, subSearchResultData []*schemapb.SearchResultData, nq int64, topk int64, metricType string, pkType schemapb.DataType, offset int64) (*milvuspb.SearchResults, error) {
+func reduceSearchResultData(ctx context.Context, subSearchResultData []*schemapb.SearchResultData, nq int64, topk int64, metricType string, pkType schemapb.DataType, offset int64, rangeParams *rangeSearchParams, groupByFieldID int64, groupSize int64) (*milvuspb.SearchResults, error) {
 	tr := timerecord.NewTimeRecorder("reduceSearchResultData")
 	defer func() {
 		tr.CtxElapse(ctx, "done")
@@ -670,6 +972,8 @@ func reduceSearchResultData(ctx context.Context, subSearchResultData []*schemapb
 		zap.Int64("nq", nq),
 		zap.Int64("offset", offset),
 		zap.Int64("limit", limit),
+		zap.Bool("rangeSearch", rangeParams != nil),
+		zap.Int64("groupByFieldID", groupByFieldID),
 		zap.String("metricType", metricType))
 
 	ret := &milvuspb.SearchResults{
@@ -728,9 +1032,27 @@ func reduceSearchResultData(ctx context.Context, subSearchResultData []*schemapb
 		}
 	}
 
+	// index of the group by field in FieldsData of each subSearchResultData
+	groupByFieldIdx := make([]int, subSearchNum)
+	if groupByFieldID != 0 {
+		for i, sData := range subSearchResultData {
+			groupByFieldIdx[i] = -1
+			for k, fieldData := range sData.GetFieldsData() {
+				if fieldData.GetFieldId() == groupByFieldID {
+					groupByFieldIdx[i] = k
+					break
+				}
+			}
+			if groupByFieldIdx[i] == -1 {
+				return ret, fmt.Errorf("group by field %d not found in search results", groupByFieldID)
+			}
+		}
+	}
+
 	var (
-		skipDupCnt int64
-		realTopK   int64 = -1
+		skipDupCnt   int64
+		skipGroupCnt int64
+		maxTopK      int64
 	)
 
 	// reducing nq * topk results
@@ -743,16 +1065,25 @@ func reduceSearchResultData(ctx context.Context, subSearchResultData []*schemapb
 
 			j     int64
 			idSet = make(map[interface{}]struct{})
+			// number of hits of each group value
+			groupCounts = make(map[interface{}]int64)
 		)
 
 		// skip offset results
-		for k := int64(0); k < offset; k++ {
-			subSearchIdx, _ := selectHighestScoreIndex(subSearchResultData, subSearchNqOffset, cursors, i)
+		for k := int64(0); k < offset; {
+			subSearchIdx, resultDataIdx := selectHighestScoreIndex(subSearchResultData, subSearchNqOffset, cursors, i)
 			if subSearchIdx == -1 {
 				break
 			}
+			score := subSearchResultData[subSearchIdx].Scores[resultDataIdx]
+			if rangeParams != nil && rangeParams.belowRadius(score) {
+				break
+			}
 
 			cursors[subSearchIdx]++
+			if rangeParams == nil || !rangeParams.aboveRangeFilter(score) {
+				k++
+			}
 		}
 
 		// keep limit results
@@ -768,8 +1099,30 @@ func reduceSearchResultData(ctx context.Context, subSearchResultData []*schemapb
 			id := typeutil.GetPK(subSearchResultData[subSearchIdx].GetIds(), resultDataIdx)
 			score := subSearchResultData[subSearchIdx].Scores[resultDataIdx]
 
+			// the scores are in descending order, the rest results are all out of radius
+			if rangeParams != nil && rangeParams.belowRadius(score) {
+				break
+			}
+
+			if rangeParams != nil && (rangeParams.aboveRangeFilter(score) || rangeParams.returned(id)) {
+				// skip entity filtered by range filter
+				cursors[subSearchIdx]++
+				continue
+			}
+
 			// remove duplicates
 			if _, ok := idSet[id]; !ok {
+				if groupByFieldID != 0 {
+					groupValue := typeutil.GetScalarData(subSearchResultData[subSearchIdx].FieldsData[groupByFieldIdx[subSearchIdx]], resultDataIdx)
+					count, ok := groupCounts[groupValue]
+					// skip entity if its group is full, or it's a new group but there are enough groups already
+					if count >= groupSize || (!ok && int64(len(groupCounts))*groupSize >= limit) {
+						skipGroupCnt++
+						cursors[subSearchIdx]++
+						continue
+					}
+					groupCounts[groupValue] = count + 1
+				}
 				typeutil.AppendFieldData(ret.Results.FieldsData, subSearchResultData[subSearchIdx].FieldsData, resultDataIdx)
 				typeutil.AppendPKs(ret.Results.Ids, id)
 				ret.Results.Scores = append(ret.Results.Scores, score)
@@ -781,20 +1134,22 @@ func reduceSearchResultData(ctx context.Context, subSearchResultData []*schemapb
 			}
 			cursors[subSearchIdx]++
 		}
-		if realTopK != -1 && realTopK != j {
-			log.Ctx(ctx).Warn("Proxy Reduce Search Result", zap.Error(errors.New("the length (topk) between all result of query is different")))
-			// return nil, errors.New("the length (topk) between all result of query is different")
+		// the number of results varies between queries, e.g. range search or duplicated results removed
+		if j > maxTopK {
+			maxTopK = j
 		}
-		realTopK = j
-		ret.Results.Topks = append(ret.Results.Topks, realTopK)
+		ret.Results.Topks = append(ret.Results.Topks, j)
 	}
 	log.Ctx(ctx).Debug("skip duplicated search result", zap.Int64("count", skipDupCnt))
 
 	if skipDupCnt > 0 {
 		log.Info("skip duplicated search result", zap.Int64("count", skipDupCnt))
 	}
+	if skipGroupCnt > 0 {
+		log.Ctx(ctx).Debug("skip search result of full groups", zap.Int64("count", skipGroupCnt))
+	}
 
-	ret.Results.TopK = realTopK // realTopK is the topK of the nq-th query
+	ret.Results.TopK = maxTopK // maxTopK is the max number of results among all queries
 	if !distance.PositivelyRelated(metricType) {
 		for k := range ret.Results.Scores {
 			ret.Results.Scores[k] *= -1
@@ -1182,6 +1182,30 @@ func Test_checkSearchResultData(t *testing.T) {
 				nq:   1,
 				topk: 1,
 			}},
+		{"size of topks != nq", true,
+			args{
+				data: &schemapb.SearchResultData{
+					NumQueries: 2,
+					TopK:       1,
+					Ids: &schemapb.IDs{
+						IdField: &schemapb.IDs_IntId{IntId: &schemapb.LongArray{Data: []int64{1}}}},
+					Scores: []float32{0.99},
+					Topks:  []int64{1}},
+				nq:   2,
+				topk: 1,
+			}},
+		{"sum of topks != size of IDs", true,
+			args{
+				data: &schemapb.SearchResultData{
+					NumQueries: 2,
+					TopK:       2,
+					Ids: &schemapb.IDs{
+						IdField: &schemapb.IDs_IntId{IntId: &schemapb.LongArray{Data: []int64{1}}}},
+					Scores: []float32{0.99},
+					Topks:  []int64{1, 1}},
+				nq:   2,
+				topk: 2,
+			}},
 		{"correct params", false,
 			args{
 				data: &schemapb.SearchResultData{
@@ -1189,10 +1213,23 @@ func Test_checkSearchResultData(t *testing.T) {
 					TopK:       1,
 					Ids: &schemapb.IDs{
 						IdField: &schemapb.IDs_IntId{IntId: &schemapb.LongArray{Data: []int64{1}}}},
-					Scores: []float32{0.99}},
+					Scores: []float32{0.99},
+					Topks:  []int64{1}},
 				nq:   1,
 				topk: 1,
 			}},
+		{"variable topks", false,
+			args{
+				data: &schemapb.SearchResultData{
+					NumQueries: 2,
+					TopK:       2,
+					Ids: &schemapb.IDs{
+						IdField: &schemapb.IDs_IntId{IntId: &schemapb.LongArray{Data: []int64{1}}}},
+					Scores: []float32{0.99},
+					Topks:  []int64{0, 1}},
+				nq:   2,
+				topk: 2,
+			}},
 	}
 
 	for _, test := range tests {
@@ -1397,7 +1434,7 @@ func TestTaskSearch_reduceSearchResultData(t *testing.T) {
 
 		for _, test := range tests {
 			t.Run(test.description, func(t *testing.T) {
-				reduced, err := reduceSearchResultData(context.TODO(), results, nq, topk, distance.L2, schemapb.DataType_Int64, test.offset)
+				reduced, err := reduceSearchResultData(context.TODO(), results, nq, topk, distance.L2, schemapb.DataType_Int64, test.offset, nil, 0, 0)
 				assert.NoError(t, err)
 				assert.Equal(t, test.outData, reduced.GetResults().GetIds().GetIntId().GetData())
 				assert.Equal(t, []int64{test.limit, test.limit}, reduced.GetResults().GetTopks())
@@ -1437,7 +1474,7 @@ func TestTaskSearch_reduceSearchResultData(t *testing.T) {
 
 		for _, test := range lessThanLimitTests {
 			t.Run(test.description, func(t *testing.T) {
-				reduced, err := reduceSearchResultData(context.TODO(), results, nq, topk, distance.L2, schemapb.DataType_Int64, test.offset)
+				reduced, err := reduceSearchResultData(context.TODO(), results, nq, topk, distance.L2, schemapb.DataType_Int64, test.offset, nil, 0, 0)
 				assert.NoError(t, err)
 				assert.Equal(t, test.outData, reduced.GetResults().GetIds().GetIntId().GetData())
 				assert.Equal(t, []int64{test.outLimit, test.outLimit}, reduced.GetResults().GetTopks())
@@ -1447,6 +1484,123 @@ func TestTaskSearch_reduceSearchResultData(t *testing.T) {
 		}
 	})
 
+	t.Run("Range search", func(t *testing.T) {
+		var results []*schemapb.SearchResultData
+		for i := range data {
+			r := getSearchResultData(nq, topk)
+
+			r.Ids.IdField = &schemapb.IDs_IntId{IntId: &schemapb.LongArray{Data: data[i]}}
+			r.Scores = score[i]
+			r.Topks = []int64{5, 5}
+
+			results = append(results, r)
+		}
+
+		tests := []struct {
+			description string
+			params      *rangeSearchParams
+			offset      int64
+
+			outTopks []int64
+			outData  []int64
+		}{
+			{"radius only", &rangeSearchParams{radius: 44}, 0,
+				[]int64{5, 1},
+				[]int64{50, 49, 48, 47, 46, 45}},
+			{"radius and range filter", &rangeSearchParams{radius: 44, rangeFilter: 49, hasRangeFilter: true}, 0,
+				[]int64{4, 1},
+				[]int64{49, 48, 47, 46, 45}},
+			{"with offset", &rangeSearchParams{radius: 44, rangeFilter: 49, hasRangeFilter: true}, 2,
+				[]int64{2, 0},
+				[]int64{47, 46}},
+			{"out of radius", &rangeSearchParams{radius: 50}, 0,
+				[]int64{0, 0},
+				[]int64{}},
+			{"returned by iterator", &rangeSearchParams{radius: 44, rangeFilter: 49, hasRangeFilter: true,
+				returnedPKs: map[interface{}]struct{}{int64(49): {}}}, 0,
+				[]int64{3, 1},
+				[]int64{48, 47, 46, 45}},
+		}
+		for _, test := range tests {
+			t.Run(test.description, func(t *testing.T) {
+				reduced, err := reduceSearchResultData(context.TODO(), results, nq, topk, distance.IP, schemapb.DataType_Int64, test.offset, test.params, 0, 0)
+				assert.NoError(t, err)
+				assert.Equal(t, test.outData, reduced.GetResults().GetIds().GetIntId().GetData())
+				assert.Equal(t, test.outTopks, reduced.GetResults().GetTopks())
+				assert.Equal(t, test.outTopks[0], reduced.GetResults().GetTopK())
+			})
+		}
+	})
+
+	t.Run("Group by", func(t *testing.T) {
+		const groupByFieldID = int64(101)
+		genResults := func(topk int64) []*schemapb.SearchResultData {
+			var results []*schemapb.SearchResultData
+			for i := range data {
+				r := getSearchResultData(nq, topk)
+
+				r.Ids.IdField = &schemapb.IDs_IntId{IntId: &schemapb.LongArray{Data: data[i]}}
+				r.Scores = score[i]
+				r.Topks = []int64{5, 5}
+
+				// entities with id 2n and 2n+1 are in the same group
+				groupValues := make([]int64, 0, len(data[i]))
+				for _, id := range data[i] {
+					groupValues = append(groupValues, id/2)
+				}
+				r.FieldsData = []*schemapb.FieldData{{
+					Type:    schemapb.DataType_Int64,
+					FieldId: groupByFieldID,
+					Field: &schemapb.FieldData_Scalars{
+						Scalars: &schemapb.ScalarField{
+							Data: &schemapb.ScalarField_LongData{LongData: &schemapb.LongArray{Data: groupValues}},
+						},
+					},
+				}}
+
+				results = append(results, r)
+			}
+			return results
+		}
+
+		tests := []struct {
+			description string
+			groupSize   int64
+			groupTopK   int64
+
+			outTopks []int64
+			outData  []int64
+		}{
+			{"group size 1", 1, 5,
+				[]int64{5, 5},
+				[]int64{50, 49, 47, 40, 39, 45, 43, 41, 35, 33}},
+			{"group size 2", 2, 2,
+				[]int64{3, 4},
+				[]int64{50, 49, 48, 45, 44, 43, 42}},
+		}
+		for _, test := range tests {
+			t.Run(test.description, func(t *testing.T) {
+				queryTopK := test.groupTopK * test.groupSize
+				reduced, err := reduceSearchResultData(context.TODO(), genResults(queryTopK), nq, queryTopK, distance.IP, schemapb.DataType_Int64, 0, nil, groupByFieldID, test.groupSize)
+				assert.NoError(t, err)
+				assert.Equal(t, test.outData, reduced.GetResults().GetIds().GetIntId().GetData())
+				assert.Equal(t, test.outTopks, reduced.GetResults().GetTopks())
+			})
+		}
+
+		t.Run("more candidates than topk", func(t *testing.T) {
+			reduced, err := reduceSearchResultData(context.TODO(), genResults(8), nq, 4, distance.IP, schemapb.DataType_Int64, 0, nil, groupByFieldID, 2)
+			assert.NoError(t, err)
+			assert.Equal(t, []int64{50, 49, 48, 45, 44, 43, 42}, reduced.GetResults().GetIds().GetIntId().GetData())
+			assert.Equal(t, []int64{3, 4}, reduced.GetResults().GetTopks())
+		})
+
+		t.Run("group by field not found", func(t *testing.T) {
+			_, err := reduceSearchResultData(context.TODO(), genResults(topk), nq, topk, distance.IP, schemapb.DataType_Int64, 0, nil, 102, 1)
+			assert.Error(t, err)
+		})
+	})
+
 	t.Run("Int64 ID", func(t *testing.T) {
 		resultData := []int64{50, 49, 48, 47, 46, 45, 44, 43, 42, 41}
 
@@ -1461,7 +1615,7 @@ func TestTaskSearch_reduceSearchResultData(t *testing.T) {
 			results = append(results, r)
 		}
 
-		reduced, err := reduceSearchResultData(context.TODO(), results, nq, topk, distance.L2, schemapb.DataType_Int64, 0)
+		reduced, err := reduceSearchResultData(context.TODO(), results, nq, topk, distance.L2, schemapb.DataType_Int64, 0, nil, 0, 0)
 
 		assert.NoError(t, err)
 		assert.Equal(t, resultData, reduced.GetResults().GetIds().GetIntId().GetData())
@@ -1488,7 +1642,7 @@ func TestTaskSearch_reduceSearchResultData(t *testing.T) {
 			results = append(results, r)
 		}
 
-		reduced, err := reduceSearchResultData(context.TODO(), results, nq, topk, distance.L2, schemapb.DataType_VarChar, 0)
+		reduced, err := reduceSearchResultData(context.TODO(), results, nq, topk, distance.L2, schemapb.DataType_VarChar, 0, nil, 0, 0)
 
 		assert.NoError(t, err)
 		assert.Equal(t, resultData, reduced.GetResults().GetIds().GetStrId().GetData())
@@ -2099,3 +2253,162 @@ func getSearchResultData(nq, topk int64) *schemapb.SearchResultData {
 	}
 	return &result
 }
+
+func TestTaskSearch_parseRangeSearchParams(t *testing.T) {
+	t.Run("not range search", func(t *testing.T) {
+		params, err := parseRangeSearchParams(`{"nprobe": 10}`, distance.L2)
+		assert.NoError(t, err)
+		assert.Nil(t, params)
+
+		params, err = parseRangeSearchParams(`null`, distance.L2)
+		assert.NoError(t, err)
+		assert.Nil(t, params)
+	})
+
+	t.Run("L2", func(t *testing.T) {
+		params, err := parseRangeSearchParams(`{"nprobe": 10, "radius": 20, "range_filter": "10"}`, distance.L2)
+		assert.NoError(t, err)
+		assert.NotNil(t, params)
+		assert.Equal(t, float32(-20), params.radius)
+		assert.Equal(t, float32(-10), params.rangeFilter)
+		assert.True(t, params.belowRadius(-20))
+		assert.False(t, params.belowRadius(-15))
+		assert.True(t, params.aboveRangeFilter(-5))
+		assert.False(t, params.aboveRangeFilter(-10))
+	})
+
+	t.Run("IP", func(t *testing.T) {
+		params, err := parseRangeSearchParams(`{"radius": 0.5}`, distance.IP)
+		assert.NoError(t, err)
+		assert.NotNil(t, params)
+		assert.Equal(t, float32(0.5), params.radius)
+		assert.False(t, params.hasRangeFilter)
+		assert.False(t, params.aboveRangeFilter(100))
+	})
+
+	invalidParams := []struct {
+		description string
+		params      string
+		metricType  string
+	}{
+		{"malformed params", `invalid`, distance.L2},
+		{"malformed range search params", `{"radius": 10, "range_filter": }`, distance.L2},
+		{"range filter without radius", `{"range_filter": 10}`, distance.L2},
+		{"invalid radius", `{"radius": "abc"}`, distance.L2},
+		{"invalid radius type", `{"radius": [1]}`, distance.L2},
+		{"invalid range filter", `{"radius": 10, "range_filter": "abc"}`, distance.L2},
+		{"L2 range filter greater than radius", `{"radius": 10, "range_filter": 20}`, distance.L2},
+		{"IP range filter less than radius", `{"radius": 0.8, "range_filter": 0.5}`, distance.IP},
+	}
+	for _, test := range invalidParams {
+		t.Run(test.description, func(t *testing.T) {
+			_, err := parseRangeSearchParams(test.params, test.metricType)
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestTaskSearch_groupsUnfilled(t *testing.T) {
+	const groupByFieldID = int64(101)
+	genResult := func(topks []int64, groupValues []int64) *schemapb.SearchResultData {
+		return &schemapb.SearchResultData{
+			NumQueries: int64(len(topks)),
+			Topks:      topks,
+			FieldsData: []*schemapb.FieldData{{
+				Type:    schemapb.DataType_Int64,
+				FieldId: groupByFieldID,
+				Field: &schemapb.FieldData_Scalars{
+					Scalars: &schemapb.ScalarField{
+						Data: &schemapb.ScalarField_LongData{LongData: &schemapb.LongArray{Data: groupValues}},
+					},
+				},
+			}},
+		}
+	}
+
+	tests := []struct {
+		description string
+		data        *schemapb.SearchResultData
+		topk        int64
+		groupSize   int64
+		unfilled    bool
+	}{
+		{"filled", genResult([]int64{4, 3}, []int64{1, 1, 2, 2, 3, 3, 4}), 2, 2, false},
+		{"no full group", genResult([]int64{2}, []int64{1, 2}), 3, 2, false},
+		{"unfilled", genResult([]int64{4, 3}, []int64{1, 1, 2, 2, 3, 3, 4}), 3, 2, true},
+		{"unfilled with group size 1", genResult([]int64{2}, []int64{1, 2}), 3, 1, true},
+		{"empty result", &schemapb.SearchResultData{NumQueries: 1, Topks: []int64{0}, FieldsData: []*schemapb.FieldData{nil}}, 3, 1, false},
+	}
+	for _, test := range tests {
+		t.Run(test.description, func(t *testing.T) {
+			assert.Equal(t, test.unfilled, groupsUnfilled(test.data, groupByFieldID, test.topk, test.groupSize))
+		})
+	}
+}
+
+func TestTaskSearch_searchMoreCandidates(t *testing.T) {
+	task := &searchTask{
+		SearchRequest: &internalpb.SearchRequest{Topk: searchCountLimit},
+	}
+	searched, err := task.searchMoreCandidates(context.TODO())
+	assert.NoError(t, err)
+	assert.False(t, searched)
+
+	task.SearchRequest.Topk = 10
+	task.SearchRequest.SerializedExprPlan = []byte("invalid")
+	_, err = task.searchMoreCandidates(context.TODO())
+	assert.Error(t, err)
+}
+
+func TestTaskSearch_parseGroupByInfo(t *testing.T) {
+	schema := &schemapb.CollectionSchema{
+		Fields: []*schemapb.FieldSchema{
+			{FieldID: 100, Name: "pk", DataType: schemapb.DataType_Int64, IsPrimaryKey: true},
+			{FieldID: 101, Name: "doc_id", DataType: schemapb.DataType_VarChar},
+			{FieldID: 102, Name: "score", DataType: schemapb.DataType_Float},
+			{FieldID: 103, Name: "vec", DataType: schemapb.DataType_FloatVector},
+		},
+	}
+
+	t.Run("not grouped", func(t *testing.T) {
+		fieldID, groupSize, err := parseGroupByInfo([]*commonpb.KeyValuePair{{Key: TopKKey, Value: "10"}}, schema)
+		assert.NoError(t, err)
+		assert.Equal(t, int64(0), fieldID)
+		assert.Equal(t, int64(0), groupSize)
+	})
+
+	t.Run("default group size", func(t *testing.T) {
+		fieldID, groupSize, err := parseGroupByInfo([]*commonpb.KeyValuePair{{Key: GroupByFieldKey, Value: "doc_id"}}, schema)
+		assert.NoError(t, err)
+		assert.Equal(t, int64(101), fieldID)
+		assert.Equal(t, int64(1), groupSize)
+	})
+
+	t.Run("group size", func(t *testing.T) {
+		fieldID, groupSize, err := parseGroupByInfo([]*commonpb.KeyValuePair{
+			{Key: GroupByFieldKey, Value: "pk"},
+			{Key: GroupSizeKey, Value: "3"},
+		}, schema)
+		assert.NoError(t, err)
+		assert.Equal(t, int64(100), fieldID)
+		assert.Equal(t, int64(3), groupSize)
+	})
+
+	invalidParams := []struct {
+		description string
+		params      []*commonpb.KeyValuePair
+	}{
+		{"group size without group by field", []*commonpb.KeyValuePair{{Key: GroupSizeKey, Value: "3"}}},
+		{"field not exist", []*commonpb.KeyValuePair{{Key: GroupByFieldKey, Value: "not_exist"}}},
+		{"float field", []*commonpb.KeyValuePair{{Key: GroupByFieldKey, Value: "score"}}},
+		{"vector field", []*commonpb.KeyValuePair{{Key: GroupByFieldKey, Value: "vec"}}},
+		{"invalid group size", []*commonpb.KeyValuePair{{Key: GroupByFieldKey, Value: "doc_id"}, {Key: GroupSizeKey, Value: "a"}}},
+		{"zero group size", []*commonpb.KeyValuePair{{Key: GroupByFieldKey, Value: "doc_id"}, {Key: GroupSizeKey, Value: "0"}}},
+	}
+	for _, test := range invalidParams {
+		t.Run(test.description, func(t *testing.T) {
+			_, _, err := parseGroupByInfo(test.params, schema)
+			assert.Error(t, err)
+		})
+	}
+}
@@ -0,0 +1,246 @@
+package proxy
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/milvuspb"
+	"github.com/milvus-io/milvus-proto/go-api/schemapb"
+	"github.com/milvus-io/milvus/internal/common"
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/metrics"
+	"github.com/milvus-io/milvus/internal/mq/msgstream"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/internal/util/commonpbutil"
+	"github.com/milvus-io/milvus/internal/util/paramtable"
+	"github.com/milvus-io/milvus/internal/util/timerecord"
+	"github.com/milvus-io/milvus/internal/util/trace"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
+)
+
+// upsertTask replaces the entities identified by the primary keys of the request.
+// The delete msgs of the old entities and the insert msgs of the new ones share a single
+// timestamp and are produced in the same MsgPack, so there is no visibility gap between them.
+// Consumers treat a delete as affecting only rows inserted strictly before its timestamp,
+// which keeps the freshly inserted rows visible regardless of the order they are applied in.
+type upsertTask struct {
+	Condition
+	ctx context.Context
+
+	// insertTask does the validation and the segment assignment for the insert part.
+	insertTask *insertTask
+	result     *milvuspb.MutationResult
+	chMgr      channelsMgr
+}
+
+// TraceCtx returns upsertTask context
+func (ut *upsertTask) TraceCtx() context.Context {
+	return ut.ctx
+}
+
+func (ut *upsertTask) ID() UniqueID {
+	return ut.insertTask.ID()
+}
+
+func (ut *upsertTask) SetID(uid UniqueID) {
+	ut.insertTask.SetID(uid)
+}
+
+func (ut *upsertTask) Name() string {
+	return UpsertTaskName
+}
+
+func (ut *upsertTask) Type() commonpb.MsgType {
+	return ut.insertTask.Type()
+}
+
+func (ut *upsertTask) BeginTs() Timestamp {
+	return ut.insertTask.BeginTs()
+}
+
+func (ut *upsertTask) SetTs(ts Timestamp) {
+	ut.insertTask.SetTs(ts)
+}
+
+func (ut *upsertTask) EndTs() Timestamp {
+	return ut.insertTask.EndTs()
+}
+
+func (ut *upsertTask) getPChanStats() (map[pChan]pChanStatistics, error) {
+	return ut.insertTask.getPChanStats()
+}
+
+func (ut *upsertTask) getChannels() ([]pChan, error) {
+	return ut.insertTask.getChannels()
+}
+
+func (ut *upsertTask) OnEnqueue() error {
+	return nil
+}
+
+func (ut *upsertTask) PreExecute(ctx context.Context) error {
+	sp, ctx := trace.StartSpanFromContextWithOperationName(ut.ctx, "Proxy-Upsert-PreExecute")
+	defer sp.Finish()
+
+	collectionName := ut.insertTask.CollectionName
+	if err := validateCollectionName(collectionName); err != nil {
+		log.Error("valid collection name failed", zap.String("collectionName", collectionName), zap.Error(err))
+		return err
+	}
+
+	schema, err := globalMetaCache.GetCollectionSchema(ctx, collectionName)
+	if err != nil {
+		log.Error("get collection schema from global meta cache failed", zap.String("collectionName", collectionName), zap.Error(err))
+		return err
+	}
+	primaryFieldSchema, err := typeutil.GetPrimaryFieldSchema(schema)
+	if err != nil {
+		log.Error("get primary field schema failed", zap.String("collectionName", collectionName), zap.Error(err))
+		return err
+	}
+	// the old entities can only be located if the primary keys are given by user
+	if primaryFieldSchema.AutoID {
+		return fmt.Errorf("upsert can not assign primary field data when auto id enabled %v", primaryFieldSchema.Name)
+	}
+
+	if err := ut.insertTask.PreExecute(ctx); err != nil {
+		return err
+	}
+	ut.result = ut.insertTask.result
+	ut.result.Timestamp = ut.EndTs()
+
+	log.Ctx(ctx).Debug("Proxy Upsert PreExecute done", zap.String("collectionName", collectionName))
+	return nil
+}
+
+// repackDeleteMsgs packs the delete msgs of the upserted primary keys by dml channel,
+// it must be called after the insert msgs are repacked since the hash values are shared.
+// The delete msgs are applied to all partitions, the old entity of a primary key may
+// live in a partition other than the one the new entity is inserted into.
+func (ut *upsertTask) repackDeleteMsgs(ctx context.Context) []msgstream.TsMsg {
+	it := ut.insertTask
+	result := make(map[uint32]*msgstream.DeleteMsg)
+	keys := make([]uint32, 0)
+	for index, key := range it.HashValues {
+		curMsg, ok := result[key]
+		if !ok {
+			curMsg = &msgstream.DeleteMsg{
+				BaseMsg: msgstream.BaseMsg{
+					Ctx: ctx,
+				},
+				DeleteRequest: internalpb.DeleteRequest{
+					Base: commonpbutil.NewMsgBase(
+						commonpbutil.WithMsgType(commonpb.MsgType_Delete),
+						commonpbutil.WithMsgID(it.Base.MsgID),
+						commonpbutil.WithTimeStamp(it.BeginTs()),
+						commonpbutil.WithSourceID(it.Base.SourceID),
+					),
+					CollectionID:   it.CollectionID,
+					PartitionID:    common.InvalidPartitionID,
+					CollectionName: it.CollectionName,
+					PrimaryKeys:    &schemapb.IDs{},
+				},
+			}
+			result[key] = curMsg
+			keys = append(keys, key)
+		}
+		curMsg.HashValues = append(curMsg.HashValues, key)
+		curMsg.Timestamps = append(curMsg.Timestamps, it.Timestamps[index])
+		typeutil.AppendIDs(curMsg.PrimaryKeys, it.result.IDs, index)
+		curMsg.NumRows++
+	}
+
+	msgs := make([]msgstream.TsMsg, 0, len(keys))
+	for _, key := range keys {
+		msgs = append(msgs, result[key])
+	}
+	return msgs
+}
+
+func (ut *upsertTask) Execute(ctx context.Context) error {
+	sp, ctx := trace.StartSpanFromContextWithOperationName(ut.ctx, "Proxy-Upsert-Execute")
+	defer sp.Finish()
+
+	tr := timerecord.NewTimeRecorder(fmt.Sprintf("proxy execute upsert %d", ut.ID()))
+	defer tr.Elapse("upsert execute done")
+
+	it := ut.insertTask
+	collectionName := it.CollectionName
+	collID, err := globalMetaCache.GetCollectionID(ctx, collectionName)
+	if err != nil {
+		return err
+	}
+	it.CollectionID = collID
+	partitionID, err := globalMetaCache.GetPartitionID(ctx, collectionName, it.PartitionName)
+	if err != nil {
+		return err
+	}
+	it.PartitionID = partitionID
+	tr.Record("get collection id & partition id from cache")
+
+	stream, err := ut.chMgr.getOrCreateDmlStream(collID)
+	if err != nil {
+		return err
+	}
+
+	channelNames, err := ut.chMgr.getVChannels(collID)
+	if err != nil {
+		log.Ctx(ctx).Error("get vChannels failed",
+			zap.Int64("collectionID", collID),
+			zap.Error(err))
+		ut.result.Status.ErrorCode = commonpb.ErrorCode_UnexpectedError
+		ut.result.Status.Reason = err.Error()
+		return err
+	}
+
+	log.Ctx(ctx).Debug("send upsert request to virtual channels",
+		zap.String("collection", collectionName),
+		zap.String("partition", it.PartitionName),
+		zap.Int64("collection_id", collID),
+		zap.Int64("partition_id", partitionID),
+		zap.Strings("virtual_channels", channelNames),
+		zap.Int64("task_id", ut.ID()))
+
+	insertPack, err := it.assignSegmentID(channelNames)
+	if err != nil {
+		log.Error("assign segmentID and repack insert data failed",
+			zap.Int64("collectionID", collID),
+			zap.Error(err))
+		ut.result.Status.ErrorCode = commonpb.ErrorCode_UnexpectedError
+		ut.result.Status.Reason = err.Error()
+		return err
+	}
+	tr.Record("assign segment id")
+
+	// delete msgs go before the insert msgs in the same pack, so a consumer that applies
+	// msgs in order never observes the new entities being deleted
+	msgPack := &msgstream.MsgPack{
+		BeginTs: ut.BeginTs(),
+		EndTs:   ut.EndTs(),
+	}
+	msgPack.Msgs = append(msgPack.Msgs, ut.repackDeleteMsgs(ctx)...)
+	msgPack.Msgs = append(msgPack.Msgs, insertPack.Msgs...)
+	tr.Record("pack messages")
+
+	err = stream.Produce(msgPack)
+	if err != nil {
+		ut.result.Status.ErrorCode = commonpb.ErrorCode_UnexpectedError
+		ut.result.Status.Reason = err.Error()
+		return err
+	}
+	sendMsgDur := tr.Record("send upsert request to dml channel")
+	metrics.ProxySendMutationReqLatency.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10), metrics.UpsertLabel).Observe(float64(sendMsgDur.Milliseconds()))
+
+	log.Debug("Proxy Upsert Execute done",
+		zap.String("collectionName", collectionName))
+
+	return nil
+}
+
+func (ut *upsertTask) PostExecute(ctx context.Context) error {
+	return nil
+}
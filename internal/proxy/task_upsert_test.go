@@ -0,0 +1,64 @@
+package proxy
+
+import (
+	"context"
+	"testing"
+
+	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/milvuspb"
+	"github.com/milvus-io/milvus-proto/go-api/schemapb"
+	"github.com/milvus-io/milvus/internal/common"
+	"github.com/milvus-io/milvus/internal/mq/msgstream"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUpsertTask_repackDeleteMsgs(t *testing.T) {
+	ts := Timestamp(100)
+	it := &insertTask{
+		BaseInsertTask: BaseInsertTask{
+			BaseMsg: msgstream.BaseMsg{
+				HashValues: []uint32{0, 1, 0},
+			},
+			InsertRequest: internalpb.InsertRequest{
+				Base: &commonpb.MsgBase{
+					MsgType: commonpb.MsgType_Insert,
+					MsgID:   1,
+				},
+				CollectionName: "TestUpsertTask_repackDeleteMsgs",
+				CollectionID:   1,
+				PartitionID:    2,
+				Timestamps:     []uint64{ts, ts, ts},
+			},
+		},
+		result: &milvuspb.MutationResult{
+			IDs: &schemapb.IDs{
+				IdField: &schemapb.IDs_IntId{
+					IntId: &schemapb.LongArray{
+						Data: []int64{10, 11, 12},
+					},
+				},
+			},
+		},
+	}
+	it.SetTs(ts)
+	ut := &upsertTask{insertTask: it}
+
+	msgs := ut.repackDeleteMsgs(context.Background())
+	assert.Equal(t, 2, len(msgs))
+
+	first := msgs[0].(*msgstream.DeleteMsg)
+	assert.Equal(t, commonpb.MsgType_Delete, first.Type())
+	// the old entities are deleted from all partitions
+	assert.Equal(t, common.InvalidPartitionID, first.PartitionID)
+	assert.Empty(t, first.PartitionName)
+	assert.Equal(t, int64(2), first.NumRows)
+	assert.ElementsMatch(t, []int64{10, 12}, first.PrimaryKeys.GetIntId().GetData())
+	assert.Equal(t, []uint64{ts, ts}, first.Timestamps)
+	assert.Equal(t, ts, first.Base.GetTimestamp())
+
+	second := msgs[1].(*msgstream.DeleteMsg)
+	assert.Equal(t, int64(1), second.NumRows)
+	assert.Equal(t, []int64{11}, second.PrimaryKeys.GetIntId().GetData())
+	assert.Equal(t, []uint32{1}, second.HashValues)
+}
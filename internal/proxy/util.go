@@ -18,6 +18,7 @@ package proxy
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"strconv"
@@ -45,7 +46,7 @@ const (
 	boundedTS = 2
 
 	// enableMultipleVectorFields indicates whether to enable multiple vector fields.
-	enableMultipleVectorFields = false
+	enableMultipleVectorFields = true
 
 	// maximum length of variable-length strings
 	maxVarCharLengthKey = "max_length"
@@ -278,6 +279,12 @@ func validateFieldType(schema *schemapb.CollectionSchema) error {
 			return errors.New("string data type not supported yet, please use VarChar type instead")
 		case schemapb.DataType_None:
 			return errors.New("data type None is not valid")
+		case typeutil.DataTypeJSON:
+			// segcore can't load json fields yet, reject them before any data is inserted
+			return fmt.Errorf("json data type of field %s is not supported by query nodes yet", field.GetName())
+		case typeutil.DataTypeArray:
+			// segcore can't load array fields yet, reject them before any data is inserted
+			return fmt.Errorf("array data type of field %s is not supported by query nodes yet", field.GetName())
 		}
 	}
 	return nil
@@ -464,6 +471,7 @@ func validateSchema(coll *schemapb.CollectionSchema) error {
 func validateMultipleVectorFields(schema *schemapb.CollectionSchema) error {
 	vecExist := false
 	var vecName string
+	var vecNum int64
 
 	for i := range schema.Fields {
 		name := schema.Fields[i].Name
@@ -478,8 +486,12 @@ func validateMultipleVectorFields(schema *schemapb.CollectionSchema) error {
 		} else if isVec {
 			vecExist = true
 			vecName = name
+			vecNum++
 		}
 	}
+	if vecNum > Params.ProxyCfg.MaxVectorFieldNum {
+		return fmt.Errorf("maximum vector field's number should be limited to %d", Params.ProxyCfg.MaxVectorFieldNum)
+	}
 
 	return nil
 }
@@ -558,6 +570,27 @@ func fillFieldIDBySchema(columns []*schemapb.FieldData, schema *schemapb.Collect
 	return nil
 }
 
+// validateJSONFieldData checks that every row of the json fields is a serialized json object,
+// it should be called after the field types are filled by fillFieldIDBySchema.
+func validateJSONFieldData(columns []*schemapb.FieldData) error {
+	for _, fieldData := range columns {
+		if !typeutil.IsJSONType(fieldData.GetType()) {
+			continue
+		}
+		bytesData := fieldData.GetScalars().GetBytesData()
+		if bytesData == nil {
+			return fmt.Errorf("json field %s should be passed as bytes data", fieldData.GetFieldName())
+		}
+		for i, row := range bytesData.GetData() {
+			var obj map[string]interface{}
+			if err := json.Unmarshal(row, &obj); err != nil {
+				return fmt.Errorf("the %dth row of json field %s is not a valid json object: %w", i, fieldData.GetFieldName(), err)
+			}
+		}
+	}
+	return nil
+}
+
 func ValidateUsername(username string) error {
 	username = strings.TrimSpace(username)
 
@@ -335,6 +335,14 @@ func TestValidateFieldType(t *testing.T) {
 			dt:       schemapb.DataType_VarChar,
 			validate: true,
 		},
+		{
+			dt:       typeutil.DataTypeJSON,
+			validate: false,
+		},
+		{
+			dt:       typeutil.DataTypeArray,
+			validate: false,
+		},
 	}
 
 	for _, tc := range cases {
@@ -567,6 +575,16 @@ func TestValidateMultipleVectorFields(t *testing.T) {
 	} else {
 		assert.Error(t, validateMultipleVectorFields(schema3))
 	}
+
+	// case4, too many vector fields
+	schema4 := &schemapb.CollectionSchema{}
+	for i := int64(0); i <= Params.ProxyCfg.MaxVectorFieldNum; i++ {
+		schema4.Fields = append(schema4.Fields, &schemapb.FieldSchema{
+			Name:     fmt.Sprintf("case4_%d", i),
+			DataType: schemapb.DataType_FloatVector,
+		})
+	}
+	assert.Error(t, validateMultipleVectorFields(schema4))
 }
 
 func TestFillFieldIDBySchema(t *testing.T) {
@@ -710,6 +728,38 @@ func TestGetCurUserFromContext(t *testing.T) {
 	assert.Equal(t, "root", username)
 }
 
+func TestValidateJSONFieldData(t *testing.T) {
+	newJSONField := func(rows ...string) *schemapb.FieldData {
+		data := make([][]byte, 0, len(rows))
+		for _, row := range rows {
+			data = append(data, []byte(row))
+		}
+		return &schemapb.FieldData{
+			Type:      typeutil.DataTypeJSON,
+			FieldName: "meta",
+			Field: &schemapb.FieldData_Scalars{
+				Scalars: &schemapb.ScalarField{
+					Data: &schemapb.ScalarField_BytesData{
+						BytesData: &schemapb.BytesArray{Data: data},
+					},
+				},
+			},
+		}
+	}
+
+	err := validateJSONFieldData([]*schemapb.FieldData{newJSONField(`{"brand": {"name": "x"}}`, `{}`)})
+	assert.NoError(t, err)
+
+	err = validateJSONFieldData([]*schemapb.FieldData{newJSONField(`{"brand": `)})
+	assert.Error(t, err)
+
+	err = validateJSONFieldData([]*schemapb.FieldData{newJSONField(`[1, 2]`)})
+	assert.Error(t, err)
+
+	err = validateJSONFieldData([]*schemapb.FieldData{{Type: typeutil.DataTypeJSON, FieldName: "meta"}})
+	assert.Error(t, err)
+}
+
 func TestGetRole(t *testing.T) {
 	globalMetaCache = nil
 	_, err := GetRole("foo")
@@ -230,6 +230,9 @@ func (iNode *insertNode) Operate(in []flowgraph.Msg) []flowgraph.Msg {
 	}
 	wg.Wait()
 
+	// deletes are applied after inserts of the same msg pack, an upsert puts the delete and
+	// the insert of a pk at the same timestamp, and segcore keeps the rows whose insert
+	// timestamp is not less than the delete timestamp, so the upserted rows stay visible
 	delData := &deleteData{
 		deleteIDs:        make(map[UniqueID][]primaryKey),
 		deleteTimestamps: make(map[UniqueID][]Timestamp),
@@ -748,7 +748,13 @@ func (node *QueryNode) Search(ctx context.Context, req *querypb.SearchRequest) (
 	if err := runningGp.Wait(); err != nil {
 		return failRet, nil
 	}
-	ret, err := reduceSearchResults(ctx, toReduceResults, req.Req.GetNq(), req.Req.GetTopk(), req.Req.GetMetricType())
+	groupByFieldID, groupSize, err := getGroupByInfo(req.GetReq())
+	if err != nil {
+		failRet.Status.ErrorCode = commonpb.ErrorCode_UnexpectedError
+		failRet.Status.Reason = err.Error()
+		return failRet, nil
+	}
+	ret, err := reduceSearchResults(ctx, toReduceResults, req.Req.GetNq(), req.Req.GetTopk(), req.Req.GetMetricType(), groupByFieldID, groupSize)
 	if err != nil {
 		failRet.Status.ErrorCode = commonpb.ErrorCode_UnexpectedError
 		failRet.Status.Reason = err.Error()
@@ -894,7 +900,12 @@ func (node *QueryNode) searchWithDmlChannel(ctx context.Context, req *querypb.Se
 		msgID, req.GetFromShardLeader(), dmlChannel, req.GetSegmentIDs()))
 
 	results = append(results, streamingResult)
-	ret, err2 := reduceSearchResults(ctx, results, req.Req.GetNq(), req.Req.GetTopk(), req.Req.GetMetricType())
+	groupByFieldID, groupSize, err2 := getGroupByInfo(req.GetReq())
+	if err2 != nil {
+		failRet.Status.Reason = err2.Error()
+		return failRet, nil
+	}
+	ret, err2 := reduceSearchResults(ctx, results, req.Req.GetNq(), req.Req.GetTopk(), req.Req.GetMetricType(), groupByFieldID, groupSize)
 	if err2 != nil {
 		failRet.Status.Reason = err2.Error()
 		return failRet, nil
@@ -114,7 +114,9 @@ type QueryNode struct {
 	eventCh <-chan *sessionutil.SessionEvent
 
 	vectorStorage storage.ChunkManager
-	etcdKV        *etcdkv.EtcdKV
+	// diskCache caches the objects of vectorStorage on local disk, nil if disabled
+	diskCache *storage.TieredChunkManager
+	etcdKV    *etcdkv.EtcdKV
 
 	// shard cluster service, handle shard leader functions
 	ShardClusterService *ShardClusterService
@@ -252,6 +254,18 @@ func (node *QueryNode) Init() error {
 			initError = err
 			return
 		}
+		if Params.QueryNodeCfg.DiskCacheCapacity > 0 {
+			cachePath := path.Join(Params.LocalStorageCfg.Path, "cache", typeutil.QueryNodeRole, fmt.Sprint(paramtable.GetNodeID()))
+			node.vectorStorage, node.diskCache, err = storage.NewDiskCachedChunkManager(node.vectorStorage, cachePath,
+				Params.QueryNodeCfg.DiskCacheCapacity, typeutil.QueryNodeRole)
+			if err != nil {
+				log.Error("QueryNode init disk cache failed", zap.Error(err))
+				initError = err
+				return
+			}
+			log.Info("QueryNode init disk cache done", zap.String("path", cachePath),
+				zap.Int64("capacity", Params.QueryNodeCfg.DiskCacheCapacity))
+		}
 
 		node.etcdKV = etcdkv.NewEtcdKV(node.etcdCli, Params.EtcdCfg.MetaRootPath)
 		log.Info("queryNode try to connect etcd success", zap.Any("MetaRootPath", Params.EtcdCfg.MetaRootPath))
@@ -369,6 +383,12 @@ func (node *QueryNode) Stop() error {
 		node.queryShardService.close()
 	}
 
+	if node.diskCache != nil {
+		if err := node.diskCache.Close(); err != nil {
+			log.Warn("QueryNode failed to clean disk cache", zap.Error(err))
+		}
+	}
+
 	node.session.Revoke(time.Second)
 	node.wg.Wait()
 	return nil
@@ -20,6 +20,7 @@ import (
 	"context"
 	"fmt"
 	"math"
+	"sort"
 	"strconv"
 
 	"github.com/golang/protobuf/proto"
@@ -30,6 +31,7 @@ import (
 
 	"github.com/milvus-io/milvus/internal/log"
 	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/internal/proto/planpb"
 	"github.com/milvus-io/milvus/internal/proto/segcorepb"
 	"github.com/milvus-io/milvus/internal/util/funcutil"
 	"github.com/milvus-io/milvus/internal/util/typeutil"
@@ -77,7 +79,21 @@ func reduceStatisticResponse(results []*internalpb.GetStatisticsResponse) (*inte
 	return ret, nil
 }
 
-func reduceSearchResults(ctx context.Context, results []*internalpb.SearchResults, nq int64, topk int64, metricType string) (*internalpb.SearchResults, error) {
+// getGroupByInfo returns the field which search results are grouped by and the max number of hits in each group,
+// zero field ID is returned if search results are not grouped.
+func getGroupByInfo(req *internalpb.SearchRequest) (int64, int64, error) {
+	if len(req.GetSerializedExprPlan()) == 0 {
+		return 0, 0, nil
+	}
+	plan := &planpb.PlanNode{}
+	if err := proto.Unmarshal(req.GetSerializedExprPlan(), plan); err != nil {
+		return 0, 0, err
+	}
+	queryInfo := plan.GetVectorAnns().GetQueryInfo()
+	return queryInfo.GetGroupByFieldId(), queryInfo.GetGroupSize(), nil
+}
+
+func reduceSearchResults(ctx context.Context, results []*internalpb.SearchResults, nq int64, topk int64, metricType string, groupByFieldID int64, groupSize int64) (*internalpb.SearchResults, error) {
 	searchResultData, err := decodeSearchResults(results)
 	if err != nil {
 		log.Ctx(ctx).Warn("shard leader decode search results errors", zap.Error(err))
@@ -92,7 +108,7 @@ func reduceSearchResults(ctx context.Context, results []*internalpb.SearchResult
 			zap.Int64("topk", sData.TopK))
 	}
 
-	reducedResultData, err := reduceSearchResultData(ctx, searchResultData, nq, topk)
+	reducedResultData, err := reduceSearchResultData(ctx, searchResultData, nq, topk, groupByFieldID, groupSize)
 	if err != nil {
 		log.Ctx(ctx).Warn("shard leader reduce errors", zap.Error(err))
 		return nil, err
@@ -114,7 +130,10 @@ func reduceSearchResults(ctx context.Context, results []*internalpb.SearchResult
 	return searchResults, nil
 }
 
-func reduceSearchResultData(ctx context.Context, searchResultData []*schemapb.SearchResultData, nq int64, topk int64) (*schemapb.SearchResultData, error) {
+// reduceSearchResultData merges search results, keeps at most topk hits for each query.
+// If groupByFieldID is not zero, hits are grouped by the value of the field,
+// at most topk/groupSize groups are kept and each group keeps at most groupSize hits.
+func reduceSearchResultData(ctx context.Context, searchResultData []*schemapb.SearchResultData, nq int64, topk int64, groupByFieldID int64, groupSize int64) (*schemapb.SearchResultData, error) {
 	if len(searchResultData) == 0 {
 		return &schemapb.SearchResultData{
 			NumQueries: nq,
@@ -134,19 +153,42 @@ func reduceSearchResultData(ctx context.Context, searchResultData []*schemapb.Se
 		Topks:      make([]int64, 0),
 	}
 
+	// the number of hits of each query is variable, e.g. range search may return less than topk hits,
+	// so the offsets of queries are calculated by topks of each query
 	resultOffsets := make([][]int64, len(searchResultData))
 	for i := 0; i < len(searchResultData); i++ {
+		if int64(len(searchResultData[i].Topks)) != nq {
+			return nil, fmt.Errorf("search result's topks length(%d) mis-match with nq(%d)", len(searchResultData[i].Topks), nq)
+		}
 		resultOffsets[i] = make([]int64, len(searchResultData[i].Topks))
 		for j := int64(1); j < nq; j++ {
 			resultOffsets[i][j] = resultOffsets[i][j-1] + searchResultData[i].Topks[j-1]
 		}
 	}
 
+	// index of the group by field in FieldsData of each searchResultData
+	groupByFieldIdx := make([]int, len(searchResultData))
+	if groupByFieldID != 0 {
+		for i, sData := range searchResultData {
+			groupByFieldIdx[i] = -1
+			for k, fieldData := range sData.GetFieldsData() {
+				if fieldData.GetFieldId() == groupByFieldID {
+					groupByFieldIdx[i] = k
+					break
+				}
+			}
+			if groupByFieldIdx[i] == -1 {
+				return nil, fmt.Errorf("group by field %d not found in search results", groupByFieldID)
+			}
+		}
+	}
+
 	var skipDupCnt int64
 	for i := int64(0); i < nq; i++ {
 		offsets := make([]int64, len(searchResultData))
 
 		var idSet = make(map[interface{}]struct{})
+		var groupCounts = make(map[interface{}]int64)
 		var j int64
 		for j = 0; j < topk; {
 			sel := selectSearchResultData(searchResultData, resultOffsets, offsets, i)
@@ -160,6 +202,16 @@ func reduceSearchResultData(ctx context.Context, searchResultData []*schemapb.Se
 
 			// remove duplicates
 			if _, ok := idSet[id]; !ok {
+				if groupByFieldID != 0 {
+					groupValue := typeutil.GetScalarData(searchResultData[sel].FieldsData[groupByFieldIdx[sel]], idx)
+					count, ok := groupCounts[groupValue]
+					// skip entity if its group is full, or it's a new group but there are enough groups already
+					if count >= groupSize || (!ok && int64(len(groupCounts))*groupSize >= topk) {
+						offsets[sel]++
+						continue
+					}
+					groupCounts[groupValue] = count + 1
+				}
 				typeutil.AppendFieldData(ret.FieldsData, searchResultData[sel].FieldsData, idx)
 				typeutil.AppendPKs(ret.Ids, id)
 				ret.Scores = append(ret.Scores, score)
@@ -287,7 +339,8 @@ func mergeInternalRetrieveResult(ctx context.Context, retrieveResults []*interna
 	ret.FieldsData = make([]*schemapb.FieldData, len(validRetrieveResults[0].GetFieldsData()))
 	idSet := make(map[interface{}]struct{})
 	cursors := make([]int64, len(validRetrieveResults))
-	for j := 0; j < loopEnd; j++ {
+	// k-way merge of the results sorted by primary key, duplicated primary keys don't count in the limit
+	for j := 0; j < loopEnd; {
 		sel := typeutil.SelectMinPK(validRetrieveResults, cursors)
 		if sel == -1 {
 			break
@@ -298,6 +351,7 @@ func mergeInternalRetrieveResult(ctx context.Context, retrieveResults []*interna
 			typeutil.AppendPKs(ret.Ids, pk)
 			typeutil.AppendFieldData(ret.FieldsData, validRetrieveResults[sel].GetFieldsData(), cursors[sel])
 			idSet[pk] = struct{}{}
+			j++
 		} else {
 			// primary keys duplicate
 			skipDupCnt++
@@ -332,6 +386,10 @@ func mergeSegcoreRetrieveResults(ctx context.Context, retrieveResults []*segcore
 		if r == nil || len(r.GetOffset()) == 0 || size == 0 {
 			continue
 		}
+		// rows are retrieved by segcore in the order of offsets, the merge requires them sorted by primary key
+		if sorter := (&byPK{r}); !sort.IsSorted(sorter) {
+			sort.Sort(sorter)
+		}
 		validRetrieveResults = append(validRetrieveResults, r)
 		loopEnd += size
 	}
@@ -347,7 +405,8 @@ func mergeSegcoreRetrieveResults(ctx context.Context, retrieveResults []*segcore
 	ret.FieldsData = make([]*schemapb.FieldData, len(validRetrieveResults[0].GetFieldsData()))
 	idSet := make(map[interface{}]struct{})
 	cursors := make([]int64, len(validRetrieveResults))
-	for j := 0; j < loopEnd; j++ {
+	// k-way merge of the results sorted by primary key, duplicated primary keys don't count in the limit
+	for j := 0; j < loopEnd; {
 		sel := typeutil.SelectMinPK(validRetrieveResults, cursors)
 		if sel == -1 {
 			break
@@ -358,6 +417,7 @@ func mergeSegcoreRetrieveResults(ctx context.Context, retrieveResults []*segcore
 			typeutil.AppendPKs(ret.Ids, pk)
 			typeutil.AppendFieldData(ret.FieldsData, validRetrieveResults[sel].GetFieldsData(), cursors[sel])
 			idSet[pk] = struct{}{}
+			j++
 		} else {
 			// primary keys duplicate
 			skipDupCnt++
@@ -194,6 +194,70 @@ func TestResult_mergeSegcoreRetrieveResults(t *testing.T) {
 	})
 }
 
+func TestResult_mergeSegcoreRetrieveResults_unsorted(t *testing.T) {
+	const Int64FieldID = common.StartOfUserFieldID + 1
+	// rows of segcore retrieve results are in the order of offsets, pks are inserted out of order across segments
+	newResult := func(pks []int64) *segcorepb.RetrieveResults {
+		offsets := make([]int64, len(pks))
+		values := make([]int64, len(pks))
+		for i, pk := range pks {
+			offsets[i] = int64(i)
+			values[i] = pk * 10
+		}
+		return &segcorepb.RetrieveResults{
+			Ids:        &schemapb.IDs{IdField: &schemapb.IDs_IntId{IntId: &schemapb.LongArray{Data: pks}}},
+			Offset:     offsets,
+			FieldsData: []*schemapb.FieldData{genFieldData("Int64Field", Int64FieldID, schemapb.DataType_Int64, values, 1)},
+		}
+	}
+
+	t.Run("smallest pks are returned", func(t *testing.T) {
+		results := []*segcorepb.RetrieveResults{newResult([]int64{9, 1, 5, 3}), newResult([]int64{8, 2, 7, 4, 6})}
+		result, err := mergeSegcoreRetrieveResults(context.Background(), results, 3)
+		assert.NoError(t, err)
+		assert.Equal(t, []int64{1, 2, 3}, result.GetIds().GetIntId().GetData())
+		assert.Equal(t, []int64{10, 20, 30}, result.GetFieldsData()[0].GetScalars().GetLongData().GetData())
+	})
+
+	t.Run("duplicated pks don't count in the limit", func(t *testing.T) {
+		results := []*segcorepb.RetrieveResults{newResult([]int64{3, 1, 2}), newResult([]int64{2, 1, 4})}
+		result, err := mergeSegcoreRetrieveResults(context.Background(), results, 3)
+		assert.NoError(t, err)
+		assert.Equal(t, []int64{1, 2, 3}, result.GetIds().GetIntId().GetData())
+		assert.Equal(t, []int64{10, 20, 30}, result.GetFieldsData()[0].GetScalars().GetLongData().GetData())
+	})
+
+	t.Run("pages by cursor cover all pks", func(t *testing.T) {
+		segments := [][]int64{{9, 1, 5, 3}, {8, 2, 7, 4, 6}}
+		var (
+			all  []int64
+			last int64 = -1
+		)
+		for {
+			// segcore filters the rows after the cursor, the rows are kept in the order of offsets
+			results := make([]*segcorepb.RetrieveResults, 0, len(segments))
+			for _, pks := range segments {
+				var after []int64
+				for _, pk := range pks {
+					if pk > last {
+						after = append(after, pk)
+					}
+				}
+				results = append(results, newResult(after))
+			}
+			result, err := mergeSegcoreRetrieveResults(context.Background(), results, 2)
+			assert.NoError(t, err)
+			page := result.GetIds().GetIntId().GetData()
+			all = append(all, page...)
+			if len(page) < 2 {
+				break
+			}
+			last = page[len(page)-1]
+		}
+		assert.Equal(t, []int64{1, 2, 3, 4, 5, 6, 7, 8, 9}, all)
+	})
+}
+
 func TestResult_mergeInternalRetrieveResults(t *testing.T) {
 	const (
 		Dim                  = 8
@@ -356,7 +420,7 @@ func TestResult_reduceSearchResultData(t *testing.T) {
 		dataArray := make([]*schemapb.SearchResultData, 0)
 		dataArray = append(dataArray, data1)
 		dataArray = append(dataArray, data2)
-		res, err := reduceSearchResultData(context.TODO(), dataArray, nq, topk)
+		res, err := reduceSearchResultData(context.TODO(), dataArray, nq, topk, 0, 0)
 		assert.Nil(t, err)
 		assert.Equal(t, ids, res.Ids.GetIntId().Data)
 		assert.Equal(t, scores, res.Scores)
@@ -373,10 +437,60 @@ func TestResult_reduceSearchResultData(t *testing.T) {
 		dataArray := make([]*schemapb.SearchResultData, 0)
 		dataArray = append(dataArray, data1)
 		dataArray = append(dataArray, data2)
-		res, err := reduceSearchResultData(context.TODO(), dataArray, nq, topk)
+		res, err := reduceSearchResultData(context.TODO(), dataArray, nq, topk, 0, 0)
 		assert.Nil(t, err)
 		assert.ElementsMatch(t, []int64{1, 5, 2, 3}, res.Ids.GetIntId().Data)
 	})
+	t.Run("variable topks", func(t *testing.T) {
+		// results of range search, the number of hits of each query differs
+		data1 := genSearchResultData(2, topk, []int64{1, 2, 3}, []float32{-1.0, -2.0, -3.0}, []int64{3, 0})
+		data2 := genSearchResultData(2, topk, []int64{4, 5}, []float32{-1.5, -2.5}, []int64{1, 1})
+		dataArray := []*schemapb.SearchResultData{data1, data2}
+		res, err := reduceSearchResultData(context.TODO(), dataArray, 2, topk, 0, 0)
+		assert.Nil(t, err)
+		assert.Equal(t, []int64{1, 4, 2, 3, 5}, res.Ids.GetIntId().Data)
+		assert.Equal(t, []float32{-1.0, -1.5, -2.0, -3.0, -2.5}, res.Scores)
+		assert.Equal(t, []int64{4, 1}, res.Topks)
+	})
+	t.Run("group by", func(t *testing.T) {
+		genGroupByField := func(values []int64) []*schemapb.FieldData {
+			return []*schemapb.FieldData{{
+				Type:    schemapb.DataType_Int64,
+				FieldId: 101,
+				Field: &schemapb.FieldData_Scalars{
+					Scalars: &schemapb.ScalarField{
+						Data: &schemapb.ScalarField_LongData{LongData: &schemapb.LongArray{Data: values}},
+					},
+				},
+			}}
+		}
+		data1 := genSearchResultData(nq, topk, []int64{1, 2, 3, 4}, []float32{-1.0, -2.0, -3.0, -4.0}, []int64{4})
+		data1.FieldsData = genGroupByField([]int64{10, 10, 20, 30})
+		data2 := genSearchResultData(nq, topk, []int64{5, 6, 7, 8}, []float32{-1.5, -2.5, -3.5, -4.5}, []int64{4})
+		data2.FieldsData = genGroupByField([]int64{10, 20, 20, 40})
+		dataArray := []*schemapb.SearchResultData{data1, data2}
+
+		// 4 groups, 1 hit in each group
+		res, err := reduceSearchResultData(context.TODO(), dataArray, nq, topk, 101, 1)
+		assert.Nil(t, err)
+		assert.Equal(t, []int64{1, 6, 4, 8}, res.Ids.GetIntId().Data)
+		assert.Equal(t, []int64{10, 20, 30, 40}, res.FieldsData[0].GetScalars().GetLongData().GetData())
+		assert.Equal(t, []int64{4}, res.Topks)
+
+		// 2 groups, 2 hits in each group
+		res, err = reduceSearchResultData(context.TODO(), dataArray, nq, topk, 101, 2)
+		assert.Nil(t, err)
+		assert.Equal(t, []int64{1, 5, 6, 3}, res.Ids.GetIntId().Data)
+		assert.Equal(t, []int64{4}, res.Topks)
+
+		_, err = reduceSearchResultData(context.TODO(), dataArray, nq, topk, 102, 1)
+		assert.Error(t, err)
+	})
+	t.Run("topks mis-match with nq", func(t *testing.T) {
+		data := genSearchResultData(2, topk, []int64{1, 2}, []float32{-1.0, -2.0}, []int64{2})
+		_, err := reduceSearchResultData(context.TODO(), []*schemapb.SearchResultData{data}, 2, topk, 0, 0)
+		assert.Error(t, err)
+	})
 }
 
 func TestResult_selectSearchResultData_int(t *testing.T) {
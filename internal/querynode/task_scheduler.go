@@ -262,7 +262,7 @@ func (s *taskScheduler) popAndAddToExecute() {
 		return
 	}
 
-	remain := Params.QueryNodeCfg.MaxReadConcurrency - readConcurrency
+	remain := Params.QueryNodeCfg.GetMaxReadConcurrency() - readConcurrency
 	if remain <= 0 {
 		return
 	}
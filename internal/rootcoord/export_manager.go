@@ -0,0 +1,562 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rootcoord
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+	"github.com/milvus-io/milvus/internal/kv"
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/proto/rootcoordpb"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
+
+	"go.uber.org/zap"
+)
+
+// exportManager manager for export tasks
+type exportManager struct {
+	ctx       context.Context // reserved
+	taskStore kv.TxnKV        // Persistent task info storage.
+	busyNodes map[int64]int64 // Set of all current working DataNode IDs and related task create timestamp.
+
+	pendingTasks  []*rootcoordpb.ExportTaskInfo         // pending tasks
+	workingTasks  map[int64]*rootcoordpb.ExportTaskInfo // in-progress tasks
+	pendingLock   sync.RWMutex                          // lock pending task list
+	workingLock   sync.RWMutex                          // lock working task map
+	busyNodesLock sync.RWMutex                          // lock for working nodes.
+
+	startOnce sync.Once
+
+	idAllocator       func(count uint32) (typeutil.UniqueID, typeutil.UniqueID, error)
+	callExportService func(ctx context.Context, req *datapb.ExportTaskRequest) (*datapb.ExportTaskResponse, error)
+}
+
+// newExportManager helper function to create an exportManager
+func newExportManager(ctx context.Context, client kv.TxnKV,
+	idAlloc func(count uint32) (typeutil.UniqueID, typeutil.UniqueID, error),
+	exportService func(ctx context.Context, req *datapb.ExportTaskRequest) (*datapb.ExportTaskResponse, error)) *exportManager {
+	mgr := &exportManager{
+		ctx:               ctx,
+		taskStore:         client,
+		pendingTasks:      make([]*rootcoordpb.ExportTaskInfo, 0, MaxPendingCount),
+		workingTasks:      make(map[int64]*rootcoordpb.ExportTaskInfo),
+		busyNodes:         make(map[int64]int64),
+		idAllocator:       idAlloc,
+		callExportService: exportService,
+	}
+	return mgr
+}
+
+func (m *exportManager) init(ctx context.Context) {
+	m.startOnce.Do(func() {
+		// Read tasks from Etcd and save them as pending tasks and mark them as failed.
+		if _, err := m.loadFromTaskStore(true); err != nil {
+			log.Error("exportManager init failed, read tasks from Etcd failed, about to panic")
+			panic(err)
+		}
+		// Send out tasks to dataCoord.
+		if err := m.sendOutTasks(ctx); err != nil {
+			log.Error("exportManager init failed, send out tasks to dataCoord failed")
+		}
+	})
+}
+
+// sendOutTasksLoop periodically calls `sendOutTasks` to process left over pending tasks.
+func (m *exportManager) sendOutTasksLoop(wg *sync.WaitGroup) {
+	defer wg.Done()
+	ticker := time.NewTicker(time.Duration(checkPendingTasksInterval) * time.Millisecond)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-m.ctx.Done():
+			log.Debug("export manager context done, exit check sendOutTasksLoop")
+			return
+		case <-ticker.C:
+			if err := m.sendOutTasks(m.ctx); err != nil {
+				log.Error("exportManager sendOutTasksLoop fail to send out tasks")
+			}
+		}
+	}
+}
+
+// cleanupLoop starts a loop that checks and expires old tasks every `cleanUpLoopInterval` seconds.
+// There are two types of tasks to clean up:
+// (1) working tasks that existed for over `ExportTaskExpiration` seconds, these tasks will be marked as failed and
+// removed from memory.
+// (2) any export tasks that has been created over `ExportTaskRetention` seconds ago, these tasks will be removed from Etcd.
+func (m *exportManager) cleanupLoop(wg *sync.WaitGroup) {
+	defer wg.Done()
+	ticker := time.NewTicker(time.Duration(cleanUpLoopInterval) * time.Millisecond)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-m.ctx.Done():
+			log.Debug("(in cleanupLoop) export manager context done, exit cleanupLoop")
+			return
+		case <-ticker.C:
+			log.Debug("(in cleanupLoop) trying to expire old export tasks from memory and Etcd")
+			m.expireOldTasksFromMem()
+			m.expireOldTasksFromEtcd()
+			m.releaseHangingBusyDataNode()
+		}
+	}
+}
+
+// sendOutTasks pushes all pending tasks to DataCoord, gets DataCoord response and re-add these tasks as working tasks.
+func (m *exportManager) sendOutTasks(ctx context.Context) error {
+	m.pendingLock.Lock()
+	m.busyNodesLock.Lock()
+	defer m.pendingLock.Unlock()
+	defer m.busyNodesLock.Unlock()
+
+	for len(m.pendingTasks) > 0 {
+		log.Debug("try to send out pending export tasks", zap.Int("task_number", len(m.pendingTasks)))
+		task := m.pendingTasks[0]
+		et := &datapb.ExportTask{
+			TaskId:       task.GetId(),
+			CollectionId: task.GetCollectionId(),
+			PartitionIds: task.GetPartitionIds(),
+			Timestamp:    task.GetTimestamp(),
+			OutputPrefix: task.GetOutputPrefix(),
+			Format:       task.GetFormat(),
+			Infos:        task.GetInfos(),
+		}
+
+		// Get all busy dataNodes for reference.
+		var busyNodeList []int64
+		for k := range m.busyNodes {
+			busyNodeList = append(busyNodeList, k)
+		}
+
+		// Send export task to dataCoord, which will then distribute the export task to dataNode.
+		resp, err := m.callExportService(ctx, &datapb.ExportTaskRequest{
+			ExportTask:   et,
+			WorkingNodes: busyNodeList,
+		})
+		if err != nil {
+			log.Error("export task get error", zap.Error(err))
+			break
+		}
+		if resp.GetStatus().GetErrorCode() != commonpb.ErrorCode_Success {
+			log.Warn("export task is rejected",
+				zap.Int64("task ID", et.GetTaskId()),
+				zap.Any("error code", resp.GetStatus().GetErrorCode()),
+				zap.String("cause", resp.GetStatus().GetReason()))
+			break
+		}
+
+		// Successfully assigned dataNode for the export task. Add task to working task list and update task store.
+		log.Debug("export task successfully assigned to dataNode",
+			zap.Int64("task ID", et.GetTaskId()),
+			zap.Int64("dataNode ID", resp.GetDatanodeId()))
+		m.busyNodes[resp.GetDatanodeId()] = time.Now().Unix()
+		err = func() error {
+			m.workingLock.Lock()
+			defer m.workingLock.Unlock()
+			toPersist := cloneExportTaskInfo(task)
+			toPersist.DatanodeId = resp.GetDatanodeId()
+			toPersist.State = rootcoordpb.ExportState_ExportStarted
+			toPersist.StartTs = time.Now().Unix()
+			// first update the export task into meta store and then put it into working tasks
+			if err := m.persistTaskInfo(toPersist); err != nil {
+				return err
+			}
+			m.workingTasks[toPersist.GetId()] = toPersist
+			return nil
+		}()
+		if err != nil {
+			return err
+		}
+		// Remove this task from head of pending list.
+		m.pendingTasks = append(m.pendingTasks[:0], m.pendingTasks[1:]...)
+	}
+
+	return nil
+}
+
+// exportJob creates a pending export task for the request, persists it and tries to send it out to DataCoord.
+func (m *exportManager) exportJob(ctx context.Context, req *rootcoordpb.ExportRequest, colID int64,
+	partitionIDs []int64, ts uint64) *rootcoordpb.ExportResponse {
+	returnErrorFunc := func(reason string) *rootcoordpb.ExportResponse {
+		return &rootcoordpb.ExportResponse{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				Reason:    reason,
+			},
+		}
+	}
+
+	if req == nil {
+		return returnErrorFunc("export request is empty")
+	}
+
+	var taskID int64
+	err := func() error {
+		m.pendingLock.Lock()
+		defer m.pendingLock.Unlock()
+
+		if len(m.pendingTasks) >= MaxPendingCount {
+			return fmt.Errorf("export task queue max size is %v, currently there are %v tasks is pending. Not able to execute this request with %v tasks",
+				MaxPendingCount, len(m.pendingTasks), 1)
+		}
+		tID, _, err := m.idAllocator(1)
+		if err != nil {
+			return err
+		}
+		newTask := &rootcoordpb.ExportTaskInfo{
+			Id:             tID,
+			CollectionId:   colID,
+			CollectionName: req.GetCollectionName(),
+			PartitionIds:   partitionIDs,
+			PartitionNames: req.GetPartitionNames(),
+			Timestamp:      ts,
+			OutputPrefix:   req.GetOutputPrefix(),
+			Format:         req.GetFormat(),
+			CreateTs:       time.Now().Unix(),
+			State:          rootcoordpb.ExportState_ExportPending,
+			Infos:          req.GetOptions(),
+		}
+		if err := m.persistTaskInfo(newTask); err != nil {
+			return err
+		}
+		m.pendingTasks = append(m.pendingTasks, newTask)
+		taskID = newTask.GetId()
+		log.Info("new export task created as pending task", zap.Int64("task ID", taskID))
+		return nil
+	}()
+	if err != nil {
+		return returnErrorFunc(err.Error())
+	}
+
+	resp := &rootcoordpb.ExportResponse{
+		Status: &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_Success,
+		},
+		TaskId:    taskID,
+		Timestamp: ts,
+	}
+
+	if sendOutTasksErr := m.sendOutTasks(ctx); sendOutTasksErr != nil {
+		log.Error("fail to send out export tasks", zap.Error(sendOutTasksErr))
+	}
+	return resp
+}
+
+// updateTaskInfo updates the task's state in in-memory working tasks map and in task store, given ExportResult.
+// The DataNode that processed the task is released once the task is finished.
+func (m *exportManager) updateTaskInfo(er *rootcoordpb.ExportResult) (*rootcoordpb.ExportTaskInfo, error) {
+	if er == nil {
+		return nil, errors.New("export result is nil")
+	}
+	log.Debug("export manager update task export result", zap.Int64("taskID", er.GetTaskId()))
+
+	ti, finished, err := func() (*rootcoordpb.ExportTaskInfo, bool, error) {
+		m.workingLock.Lock()
+		defer m.workingLock.Unlock()
+		return m.updateWorkingTask(er)
+	}()
+	if err != nil {
+		return nil, err
+	}
+	if finished {
+		// The task is finished, make the DataNode available again.
+		m.busyNodesLock.Lock()
+		delete(m.busyNodes, er.GetDatanodeId())
+		m.busyNodesLock.Unlock()
+	}
+	return ti, nil
+}
+
+// updateWorkingTask updates and persists the working task with the export result, the caller must hold workingLock.
+// The returned bool indicates whether the task is finished and removed from memory.
+func (m *exportManager) updateWorkingTask(er *rootcoordpb.ExportResult) (*rootcoordpb.ExportTaskInfo, bool, error) {
+	v, ok := m.workingTasks[er.GetTaskId()]
+	if !ok {
+		log.Debug("export manager update task export result failed", zap.Int64("task ID", er.GetTaskId()))
+		return nil, false, errors.New("failed to update export task, ID not found: " + strconv.FormatInt(er.GetTaskId(), 10))
+	}
+	// If the task has already been finished. Prevent further state updating and return an error.
+	if v.GetState() == rootcoordpb.ExportState_ExportFailed || v.GetState() == rootcoordpb.ExportState_ExportCompleted {
+		log.Warn("trying to update an already finished export task which will end up being a no-op")
+		return nil, false, errors.New("trying to update an already finished task " + strconv.FormatInt(er.GetTaskId(), 10))
+	}
+
+	// Meta persist should be done before memory objs change.
+	toPersist := cloneExportTaskInfo(v)
+	toPersist.State = er.GetState()
+	toPersist.Files = er.GetFiles()
+	toPersist.RowCount = er.GetRowCount()
+	for _, kv := range er.GetInfos() {
+		if kv.GetKey() == FailedReason {
+			toPersist.ErrorMessage = kv.GetValue()
+			break
+		}
+	}
+	if err := m.persistTaskInfo(toPersist); err != nil {
+		return nil, false, err
+	}
+
+	if toPersist.GetState() == rootcoordpb.ExportState_ExportFailed || toPersist.GetState() == rootcoordpb.ExportState_ExportCompleted {
+		delete(m.workingTasks, er.GetTaskId())
+		return toPersist, true, nil
+	}
+	m.workingTasks[er.GetTaskId()] = toPersist
+	return toPersist, false, nil
+}
+
+// getTaskState looks for task with the given ID and returns its export state.
+func (m *exportManager) getTaskState(tID int64) *rootcoordpb.GetExportStateResponse {
+	resp := &rootcoordpb.GetExportStateResponse{
+		Status: &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    "export task id doesn't exist",
+		},
+	}
+	log.Debug("getting export task state", zap.Int64("task ID", tID))
+
+	var task *rootcoordpb.ExportTaskInfo
+	// (1) Search in pending tasks list.
+	m.pendingLock.RLock()
+	for _, t := range m.pendingTasks {
+		if tID == t.GetId() {
+			task = t
+			break
+		}
+	}
+	m.pendingLock.RUnlock()
+	// (2) Search in working tasks map.
+	if task == nil {
+		m.workingLock.RLock()
+		task = m.workingTasks[tID]
+		m.workingLock.RUnlock()
+	}
+	// (3) Search in Etcd.
+	if task == nil {
+		v, err := m.taskStore.Load(BuildExportTaskKey(tID))
+		if err != nil || v == "" {
+			log.Warn("failed to load export task info from Etcd", zap.Int64("task ID", tID), zap.Error(err))
+			return resp
+		}
+		ti := &rootcoordpb.ExportTaskInfo{}
+		if err := proto.Unmarshal([]byte(v), ti); err != nil {
+			log.Error("failed to unmarshal proto", zap.String("taskInfo", v), zap.Error(err))
+			return resp
+		}
+		task = ti
+	}
+
+	resp.Status = &commonpb.Status{
+		ErrorCode: commonpb.ErrorCode_Success,
+	}
+	resp.Task = cloneExportTaskInfo(task)
+	return resp
+}
+
+// loadFromTaskStore loads task info from task store (Etcd).
+// loadFromTaskStore also adds pending tasks back to pending task list, and marks
+// other in-progress tasks as failed, when `load2Mem` is set to `true`.
+// loadFromTaskStore instead returns a list of all export tasks if `load2Mem` is set to `false`.
+func (m *exportManager) loadFromTaskStore(load2Mem bool) ([]*rootcoordpb.ExportTaskInfo, error) {
+	log.Info("export manager starts loading from Etcd")
+	_, v, err := m.taskStore.LoadWithPrefix(Params.RootCoordCfg.ExportTaskSubPath)
+	if err != nil {
+		log.Error("export manager failed to load from Etcd", zap.Error(err))
+		return nil, err
+	}
+	var taskList []*rootcoordpb.ExportTaskInfo
+
+	for i := range v {
+		ti := &rootcoordpb.ExportTaskInfo{}
+		if err := proto.Unmarshal([]byte(v[i]), ti); err != nil {
+			log.Error("failed to unmarshal proto", zap.String("taskInfo", v[i]), zap.Error(err))
+			// Ignore bad protos.
+			continue
+		}
+
+		if !load2Mem {
+			taskList = append(taskList, ti)
+			continue
+		}
+		switch ti.GetState() {
+		case rootcoordpb.ExportState_ExportPending:
+			// Put pending tasks back to pending task list.
+			log.Info("export task has been reloaded as a pending task", zap.Int64("task ID", ti.GetId()))
+			m.pendingLock.Lock()
+			m.pendingTasks = append(m.pendingTasks, ti)
+			m.pendingLock.Unlock()
+		case rootcoordpb.ExportState_ExportStarted:
+			// The DataNode which executes the task won't report to the new RootCoord, mark the task failed.
+			ti.State = rootcoordpb.ExportState_ExportFailed
+			tryUpdateExportErrMsg("task marked failed as service restarted", ti)
+			if err := m.persistTaskInfo(ti); err != nil {
+				log.Error("failed to mark an old export task as failed",
+					zap.Int64("task ID", ti.GetId()),
+					zap.Error(err))
+			}
+			log.Info("export task has been marked failed while reloading", zap.Int64("task ID", ti.GetId()))
+		}
+	}
+	return taskList, nil
+}
+
+// persistTaskInfo stores or updates the export task info in Etcd.
+func (m *exportManager) persistTaskInfo(ti *rootcoordpb.ExportTaskInfo) error {
+	log.Info("updating export task info in Etcd", zap.Int64("task ID", ti.GetId()))
+	taskInfo, err := proto.Marshal(ti)
+	if err != nil {
+		log.Error("failed to marshall export task info proto",
+			zap.Int64("task ID", ti.GetId()),
+			zap.Error(err))
+		return err
+	}
+	if err = m.taskStore.Save(BuildExportTaskKey(ti.GetId()), string(taskInfo)); err != nil {
+		log.Error("failed to update export task info in Etcd",
+			zap.Int64("task ID", ti.GetId()),
+			zap.Error(err))
+		return err
+	}
+	return nil
+}
+
+// expireOldTasksFromMem marks working tasks that have run over `ExportTaskExpiration` seconds as failed,
+// and removes them from memory.
+func (m *exportManager) expireOldTasksFromMem() {
+	var releasedNodes []int64
+	m.workingLock.Lock()
+	for id, v := range m.workingTasks {
+		if !exportTaskExpired(v) {
+			continue
+		}
+		log.Info("a working export task has expired and will be marked as failed",
+			zap.Int64("task ID", id),
+			zap.Int64("startTs", v.GetStartTs()),
+			zap.Float64("ExportTaskExpiration", Params.RootCoordCfg.ExportTaskExpiration))
+		toPersist := cloneExportTaskInfo(v)
+		toPersist.State = rootcoordpb.ExportState_ExportFailed
+		tryUpdateExportErrMsg("the export task has timed out", toPersist)
+		if err := m.persistTaskInfo(toPersist); err != nil {
+			continue
+		}
+		delete(m.workingTasks, id)
+		releasedNodes = append(releasedNodes, v.GetDatanodeId())
+	}
+	m.workingLock.Unlock()
+
+	// Remove DataNodes from busy node list, so they can serve other tasks again.
+	m.busyNodesLock.Lock()
+	for _, nodeID := range releasedNodes {
+		delete(m.busyNodes, nodeID)
+	}
+	m.busyNodesLock.Unlock()
+}
+
+// expireOldTasksFromEtcd removes tasks from Etcd that are over `ExportTaskRetention` seconds old.
+func (m *exportManager) expireOldTasksFromEtcd() {
+	tasks, err := m.loadFromTaskStore(false)
+	if err != nil {
+		log.Error("failed to load export tasks from Etcd during task cleanup")
+		return
+	}
+	for _, ti := range tasks {
+		if !exportTaskPastRetention(ti) {
+			continue
+		}
+		log.Info("an export task has passed retention period and will be removed from Etcd",
+			zap.Int64("task ID", ti.GetId()),
+			zap.Int64("createTs", ti.GetCreateTs()),
+			zap.Float64("ExportTaskRetention", Params.RootCoordCfg.ExportTaskRetention))
+		if err := m.taskStore.Remove(BuildExportTaskKey(ti.GetId())); err != nil {
+			log.Error("failed to remove export task from Etcd",
+				zap.Int64("task ID", ti.GetId()),
+				zap.Error(err))
+		}
+	}
+}
+
+// releaseHangingBusyDataNode removes DataNodes that have been 'busy' for over `ExportTaskExpiration` seconds
+// from the busy list.
+func (m *exportManager) releaseHangingBusyDataNode() {
+	m.busyNodesLock.Lock()
+	defer m.busyNodesLock.Unlock()
+	for nodeID, ts := range m.busyNodes {
+		if Params.RootCoordCfg.ExportTaskExpiration <= float64(time.Now().Unix()-ts) {
+			log.Warn("release a hanging busy DataNode of export", zap.Int64("node ID", nodeID))
+			delete(m.busyNodes, nodeID)
+		}
+	}
+}
+
+// listAllTasks returns the export tasks of a collection in ascending order of task ID, if colID is negative,
+// tasks of all collections are returned. If limit is positive, only the latest `limit` tasks are returned.
+func (m *exportManager) listAllTasks(colID int64, limit int64) ([]*rootcoordpb.ExportTaskInfo, error) {
+	exportTasks, err := m.loadFromTaskStore(false)
+	if err != nil {
+		log.Error("failed to load from task store", zap.Error(err))
+		return nil, fmt.Errorf("failed to load export task list from etcd, error: %w", err)
+	}
+
+	tasks := make([]*rootcoordpb.ExportTaskInfo, 0)
+	for _, task := range exportTasks {
+		if colID < 0 || colID == task.GetCollectionId() {
+			tasks = append(tasks, task)
+		}
+	}
+	sort.Slice(tasks, func(i, j int) bool {
+		return tasks[i].GetId() < tasks[j].GetId()
+	})
+
+	if limit <= 0 || limit >= int64(len(tasks)) {
+		return tasks, nil
+	}
+	return tasks[len(tasks)-int(limit):], nil
+}
+
+// BuildExportTaskKey constructs and returns an Etcd key with given task ID.
+func BuildExportTaskKey(taskID int64) string {
+	return fmt.Sprintf("%s%s%d", Params.RootCoordCfg.ExportTaskSubPath, delimiter, taskID)
+}
+
+// exportTaskExpired returns true if the in-mem task is considered expired.
+func exportTaskExpired(ti *rootcoordpb.ExportTaskInfo) bool {
+	return Params.RootCoordCfg.ExportTaskExpiration <= float64(time.Now().Unix()-ti.GetStartTs())
+}
+
+// exportTaskPastRetention returns true if the task is considered expired in Etcd.
+func exportTaskPastRetention(ti *rootcoordpb.ExportTaskInfo) bool {
+	return Params.RootCoordCfg.ExportTaskRetention <= float64(time.Now().Unix()-ti.GetCreateTs())
+}
+
+func tryUpdateExportErrMsg(errReason string, ti *rootcoordpb.ExportTaskInfo) {
+	if ti.GetErrorMessage() == "" {
+		ti.ErrorMessage = errReason
+	} else {
+		ti.ErrorMessage = fmt.Sprintf("%s; %s", ti.GetErrorMessage(), errReason)
+	}
+}
+
+func cloneExportTaskInfo(taskInfo *rootcoordpb.ExportTaskInfo) *rootcoordpb.ExportTaskInfo {
+	return proto.Clone(taskInfo).(*rootcoordpb.ExportTaskInfo)
+}
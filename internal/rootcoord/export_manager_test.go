@@ -0,0 +1,356 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rootcoord
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+	memkv "github.com/milvus-io/milvus/internal/kv/mem"
+	"github.com/milvus-io/milvus/internal/kv/mocks"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/proto/rootcoordpb"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func newTestExportIDAllocator() func(count uint32) (typeutil.UniqueID, typeutil.UniqueID, error) {
+	var countLock sync.Mutex
+	globalCount := typeutil.UniqueID(0)
+	return func(count uint32) (typeutil.UniqueID, typeutil.UniqueID, error) {
+		countLock.Lock()
+		defer countLock.Unlock()
+		globalCount++
+		return globalCount, 0, nil
+	}
+}
+
+func saveExportTaskInfo(t *testing.T, kv *memkv.MemoryKV, ti *rootcoordpb.ExportTaskInfo) {
+	value, err := proto.Marshal(ti)
+	assert.NoError(t, err)
+	err = kv.Save(BuildExportTaskKey(ti.GetId()), string(value))
+	assert.NoError(t, err)
+}
+
+func TestExportManager_NewExportManager(t *testing.T) {
+	Params.RootCoordCfg.ExportTaskSubPath = "test_export_task"
+	mockKv := memkv.NewMemoryKV()
+	saveExportTaskInfo(t, mockKv, &rootcoordpb.ExportTaskInfo{
+		Id:       100,
+		State:    rootcoordpb.ExportState_ExportPending,
+		CreateTs: time.Now().Unix() - 100,
+	})
+	saveExportTaskInfo(t, mockKv, &rootcoordpb.ExportTaskInfo{
+		Id:       200,
+		State:    rootcoordpb.ExportState_ExportStarted,
+		CreateTs: time.Now().Unix() - 100,
+	})
+	saveExportTaskInfo(t, mockKv, &rootcoordpb.ExportTaskInfo{
+		Id:       300,
+		State:    rootcoordpb.ExportState_ExportCompleted,
+		CreateTs: time.Now().Unix() - 100,
+	})
+	mockKv.Save(BuildExportTaskKey(1), "value")
+
+	t.Run("reload tasks", func(t *testing.T) {
+		callExportServiceFn := func(ctx context.Context, req *datapb.ExportTaskRequest) (*datapb.ExportTaskResponse, error) {
+			return &datapb.ExportTaskResponse{
+				Status: &commonpb.Status{
+					ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				},
+			}, nil
+		}
+		mgr := newExportManager(context.TODO(), mockKv, newTestExportIDAllocator(), callExportServiceFn)
+		mgr.init(context.TODO())
+		assert.Equal(t, 1, len(mgr.pendingTasks))
+		assert.Equal(t, 0, len(mgr.workingTasks))
+
+		// the started task is marked as failed
+		resp := mgr.getTaskState(200)
+		assert.Equal(t, commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+		assert.Equal(t, rootcoordpb.ExportState_ExportFailed, resp.GetTask().GetState())
+		assert.NotEmpty(t, resp.GetTask().GetErrorMessage())
+
+		resp = mgr.getTaskState(300)
+		assert.Equal(t, rootcoordpb.ExportState_ExportCompleted, resp.GetTask().GetState())
+	})
+
+	t.Run("send out reloaded tasks", func(t *testing.T) {
+		callExportServiceFn := func(ctx context.Context, req *datapb.ExportTaskRequest) (*datapb.ExportTaskResponse, error) {
+			return &datapb.ExportTaskResponse{
+				Status: &commonpb.Status{
+					ErrorCode: commonpb.ErrorCode_Success,
+				},
+				DatanodeId: 1,
+			}, nil
+		}
+		mgr := newExportManager(context.TODO(), mockKv, newTestExportIDAllocator(), callExportServiceFn)
+		mgr.init(context.TODO())
+		assert.Equal(t, 0, len(mgr.pendingTasks))
+		assert.Equal(t, 1, len(mgr.workingTasks))
+		assert.Equal(t, 1, len(mgr.busyNodes))
+
+		resp := mgr.getTaskState(100)
+		assert.Equal(t, rootcoordpb.ExportState_ExportStarted, resp.GetTask().GetState())
+		assert.Equal(t, int64(1), resp.GetTask().GetDatanodeId())
+	})
+
+	t.Run("load from task store failed", func(t *testing.T) {
+		mockTxnKV := &mocks.TxnKV{}
+		mockTxnKV.EXPECT().LoadWithPrefix(mock.Anything).Return(nil, nil, errors.New("mock error"))
+		mgr := newExportManager(context.TODO(), mockTxnKV, newTestExportIDAllocator(), nil)
+		assert.Panics(t, func() {
+			mgr.init(context.TODO())
+		})
+	})
+}
+
+func TestExportManager_ExportJob(t *testing.T) {
+	Params.RootCoordCfg.ExportTaskSubPath = "test_export_task"
+	var busy bool
+	var sentTasks []*datapb.ExportTask
+	callExportServiceFn := func(ctx context.Context, req *datapb.ExportTaskRequest) (*datapb.ExportTaskResponse, error) {
+		if busy {
+			return nil, errors.New("mock error")
+		}
+		sentTasks = append(sentTasks, req.GetExportTask())
+		return &datapb.ExportTaskResponse{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_Success,
+			},
+			DatanodeId: 1,
+		}, nil
+	}
+
+	req := &rootcoordpb.ExportRequest{
+		CollectionName: "c1",
+		PartitionNames: []string{"p1"},
+		OutputPrefix:   "export",
+		Format:         "json",
+	}
+
+	t.Run("nil request", func(t *testing.T) {
+		mgr := newExportManager(context.TODO(), memkv.NewMemoryKV(), newTestExportIDAllocator(), callExportServiceFn)
+		resp := mgr.exportJob(context.TODO(), nil, 1, nil, 100)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+	})
+
+	t.Run("normal case", func(t *testing.T) {
+		mgr := newExportManager(context.TODO(), memkv.NewMemoryKV(), newTestExportIDAllocator(), callExportServiceFn)
+		resp := mgr.exportJob(context.TODO(), req, 1, []int64{2}, 100)
+		assert.Equal(t, commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+		assert.Equal(t, int64(1), resp.GetTaskId())
+		assert.Equal(t, uint64(100), resp.GetTimestamp())
+
+		assert.Equal(t, 1, len(sentTasks))
+		assert.Equal(t, int64(1), sentTasks[0].GetCollectionId())
+		assert.Equal(t, []int64{2}, sentTasks[0].GetPartitionIds())
+		assert.Equal(t, uint64(100), sentTasks[0].GetTimestamp())
+		assert.Equal(t, "export", sentTasks[0].GetOutputPrefix())
+		assert.Equal(t, "json", sentTasks[0].GetFormat())
+
+		state := mgr.getTaskState(resp.GetTaskId())
+		assert.Equal(t, rootcoordpb.ExportState_ExportStarted, state.GetTask().GetState())
+		assert.Equal(t, []string{"p1"}, state.GetTask().GetPartitionNames())
+	})
+
+	t.Run("task stays pending", func(t *testing.T) {
+		busy = true
+		defer func() { busy = false }()
+		mgr := newExportManager(context.TODO(), memkv.NewMemoryKV(), newTestExportIDAllocator(), callExportServiceFn)
+		resp := mgr.exportJob(context.TODO(), req, 1, nil, 100)
+		assert.Equal(t, commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+		state := mgr.getTaskState(resp.GetTaskId())
+		assert.Equal(t, rootcoordpb.ExportState_ExportPending, state.GetTask().GetState())
+		assert.Equal(t, 1, len(mgr.pendingTasks))
+
+		// too many pending tasks
+		for i := 1; i < MaxPendingCount; i++ {
+			resp = mgr.exportJob(context.TODO(), req, 1, nil, 100)
+			assert.Equal(t, commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+		}
+		resp = mgr.exportJob(context.TODO(), req, 1, nil, 100)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+	})
+
+	t.Run("id allocation failed", func(t *testing.T) {
+		idAlloc := func(count uint32) (typeutil.UniqueID, typeutil.UniqueID, error) {
+			return 0, 0, errors.New("mock error")
+		}
+		mgr := newExportManager(context.TODO(), memkv.NewMemoryKV(), idAlloc, callExportServiceFn)
+		resp := mgr.exportJob(context.TODO(), req, 1, nil, 100)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+	})
+
+	t.Run("persist failed", func(t *testing.T) {
+		mockTxnKV := &mocks.TxnKV{}
+		mockTxnKV.EXPECT().Save(mock.Anything, mock.Anything).Return(errors.New("mock error"))
+		mgr := newExportManager(context.TODO(), mockTxnKV, newTestExportIDAllocator(), callExportServiceFn)
+		resp := mgr.exportJob(context.TODO(), req, 1, nil, 100)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+	})
+}
+
+func TestExportManager_UpdateTaskInfo(t *testing.T) {
+	Params.RootCoordCfg.ExportTaskSubPath = "test_export_task"
+	callExportServiceFn := func(ctx context.Context, req *datapb.ExportTaskRequest) (*datapb.ExportTaskResponse, error) {
+		return &datapb.ExportTaskResponse{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_Success,
+			},
+			DatanodeId: 2,
+		}, nil
+	}
+	mgr := newExportManager(context.TODO(), memkv.NewMemoryKV(), newTestExportIDAllocator(), callExportServiceFn)
+	resp := mgr.exportJob(context.TODO(), &rootcoordpb.ExportRequest{CollectionName: "c1"}, 1, nil, 100)
+	assert.Equal(t, commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+	assert.Equal(t, 1, len(mgr.busyNodes))
+
+	_, err := mgr.updateTaskInfo(nil)
+	assert.Error(t, err)
+
+	_, err = mgr.updateTaskInfo(&rootcoordpb.ExportResult{TaskId: 1000})
+	assert.Error(t, err)
+
+	ti, err := mgr.updateTaskInfo(&rootcoordpb.ExportResult{
+		TaskId:     resp.GetTaskId(),
+		DatanodeId: 2,
+		State:      rootcoordpb.ExportState_ExportCompleted,
+		Files:      []string{"a.json", "b.json"},
+		RowCount:   10,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, rootcoordpb.ExportState_ExportCompleted, ti.GetState())
+	assert.Equal(t, 0, len(mgr.workingTasks))
+	assert.Equal(t, 0, len(mgr.busyNodes))
+
+	state := mgr.getTaskState(resp.GetTaskId())
+	assert.Equal(t, commonpb.ErrorCode_Success, state.GetStatus().GetErrorCode())
+	assert.Equal(t, rootcoordpb.ExportState_ExportCompleted, state.GetTask().GetState())
+	assert.Equal(t, []string{"a.json", "b.json"}, state.GetTask().GetFiles())
+	assert.Equal(t, int64(10), state.GetTask().GetRowCount())
+
+	// the finished task can not be updated again
+	_, err = mgr.updateTaskInfo(&rootcoordpb.ExportResult{
+		TaskId: resp.GetTaskId(),
+		State:  rootcoordpb.ExportState_ExportFailed,
+	})
+	assert.Error(t, err)
+
+	// failed reason is recorded
+	resp = mgr.exportJob(context.TODO(), &rootcoordpb.ExportRequest{CollectionName: "c1"}, 1, nil, 100)
+	ti, err = mgr.updateTaskInfo(&rootcoordpb.ExportResult{
+		TaskId:     resp.GetTaskId(),
+		DatanodeId: 2,
+		State:      rootcoordpb.ExportState_ExportFailed,
+		Infos:      []*commonpb.KeyValuePair{{Key: FailedReason, Value: "mock reason"}},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "mock reason", ti.GetErrorMessage())
+
+	// task not exist
+	state = mgr.getTaskState(1000)
+	assert.NotEqual(t, commonpb.ErrorCode_Success, state.GetStatus().GetErrorCode())
+}
+
+func TestExportManager_ExpireOldTasks(t *testing.T) {
+	Params.RootCoordCfg.ExportTaskSubPath = "test_export_task"
+	defer func() {
+		Params.RootCoordCfg.ExportTaskExpiration = 60 * 60
+		Params.RootCoordCfg.ExportTaskRetention = 24 * 60 * 60
+	}()
+	Params.RootCoordCfg.ExportTaskExpiration = 1
+	Params.RootCoordCfg.ExportTaskRetention = 50
+
+	mockKv := memkv.NewMemoryKV()
+	saveExportTaskInfo(t, mockKv, &rootcoordpb.ExportTaskInfo{
+		Id:       100,
+		State:    rootcoordpb.ExportState_ExportCompleted,
+		CreateTs: time.Now().Unix() - 100,
+	})
+	saveExportTaskInfo(t, mockKv, &rootcoordpb.ExportTaskInfo{
+		Id:       200,
+		State:    rootcoordpb.ExportState_ExportCompleted,
+		CreateTs: time.Now().Unix(),
+	})
+	mgr := newExportManager(context.TODO(), mockKv, newTestExportIDAllocator(), nil)
+	mgr.workingTasks[300] = &rootcoordpb.ExportTaskInfo{
+		Id:         300,
+		DatanodeId: 3,
+		State:      rootcoordpb.ExportState_ExportStarted,
+		CreateTs:   time.Now().Unix(),
+		StartTs:    time.Now().Unix() - 10,
+	}
+	mgr.busyNodes[3] = time.Now().Unix() - 10
+	mgr.busyNodes[4] = time.Now().Unix() - 10
+
+	mgr.expireOldTasksFromMem()
+	assert.Equal(t, 0, len(mgr.workingTasks))
+	assert.Equal(t, 1, len(mgr.busyNodes))
+	state := mgr.getTaskState(300)
+	assert.Equal(t, rootcoordpb.ExportState_ExportFailed, state.GetTask().GetState())
+
+	mgr.releaseHangingBusyDataNode()
+	assert.Equal(t, 0, len(mgr.busyNodes))
+
+	mgr.expireOldTasksFromEtcd()
+	tasks, err := mgr.listAllTasks(-1, 0)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, len(tasks))
+	assert.Equal(t, int64(200), tasks[0].GetId())
+	assert.Equal(t, int64(300), tasks[1].GetId())
+}
+
+func TestExportManager_ListAllTasks(t *testing.T) {
+	Params.RootCoordCfg.ExportTaskSubPath = "test_export_task"
+	mockKv := memkv.NewMemoryKV()
+	for i := int64(1); i <= 5; i++ {
+		saveExportTaskInfo(t, mockKv, &rootcoordpb.ExportTaskInfo{
+			Id:           i * 100,
+			CollectionId: i % 2,
+			State:        rootcoordpb.ExportState_ExportCompleted,
+			CreateTs:     time.Now().Unix(),
+		})
+	}
+	mgr := newExportManager(context.TODO(), mockKv, newTestExportIDAllocator(), nil)
+
+	tasks, err := mgr.listAllTasks(-1, 0)
+	assert.NoError(t, err)
+	assert.Equal(t, 5, len(tasks))
+
+	tasks, err = mgr.listAllTasks(1, 0)
+	assert.NoError(t, err)
+	assert.Equal(t, 3, len(tasks))
+
+	tasks, err = mgr.listAllTasks(-1, 2)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, len(tasks))
+	assert.Equal(t, int64(400), tasks[0].GetId())
+	assert.Equal(t, int64(500), tasks[1].GetId())
+
+	mockTxnKV := &mocks.TxnKV{}
+	mockTxnKV.EXPECT().LoadWithPrefix(mock.Anything).Return(nil, nil, errors.New("mock error"))
+	mgr.taskStore = mockTxnKV
+	_, err = mgr.listAllTasks(-1, 0)
+	assert.Error(t, err)
+}
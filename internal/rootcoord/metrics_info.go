@@ -24,6 +24,7 @@ import (
 	"github.com/milvus-io/milvus-proto/go-api/commonpb"
 	"github.com/milvus-io/milvus-proto/go-api/milvuspb"
 	"github.com/milvus-io/milvus/internal/log"
+	rmqserver "github.com/milvus-io/milvus/internal/mq/mqimpl/rocksmq/server"
 	"github.com/milvus-io/milvus/internal/proto/internalpb"
 	"github.com/milvus-io/milvus/internal/util/hardware"
 	"github.com/milvus-io/milvus/internal/util/metricsinfo"
@@ -52,6 +53,37 @@ func getComponentConfigurations(ctx context.Context, req *internalpb.ShowConfigu
 	}
 }
 
+// getRocksmqMetrics returns the stats of topics if rocksmq is running in the same process
+func getRocksmqMetrics() []metricsinfo.RocksmqTopicMetrics {
+	if rmqserver.Rmq == nil {
+		return nil
+	}
+	allStats, err := rmqserver.GetRocksMQStats()
+	if err != nil {
+		log.Warn("failed to get rocksmq stats", zap.Error(err))
+		return nil
+	}
+	topics := make([]metricsinfo.RocksmqTopicMetrics, 0, len(allStats))
+	for _, stats := range allStats {
+		groups := make([]metricsinfo.RocksmqGroupMetrics, 0, len(stats.Groups))
+		for _, group := range stats.Groups {
+			groups = append(groups, metricsinfo.RocksmqGroupMetrics{
+				GroupName: group.GroupName,
+				CurrentID: group.CurrentID,
+				Lag:       group.Lag,
+			})
+		}
+		topics = append(topics, metricsinfo.RocksmqTopicMetrics{
+			Topic:      stats.Topic,
+			FirstMsgID: stats.FirstMsgID,
+			LastMsgID:  stats.LastMsgID,
+			Size:       stats.Size,
+			Groups:     groups,
+		})
+	}
+	return topics
+}
+
 func (c *Core) getSystemInfoMetrics(ctx context.Context, req *milvuspb.GetMetricsRequest) (*milvuspb.GetMetricsResponse, error) {
 	rootCoordTopology := metricsinfo.RootCoordTopology{
 		Self: metricsinfo.RootCoordInfos{
@@ -75,6 +107,7 @@ func (c *Core) getSystemInfoMetrics(ctx context.Context, req *milvuspb.GetMetric
 			SystemConfigurations: metricsinfo.RootCoordConfiguration{
 				MinSegmentSizeToEnableIndex: Params.RootCoordCfg.MinSegmentSizeToEnableIndex,
 			},
+			RocksmqMetrics: getRocksmqMetrics(),
 		},
 		Connections: metricsinfo.ConnTopology{
 			Name: metricsinfo.ConstructComponentName(typeutil.RootCoordRole, c.session.ServerID),
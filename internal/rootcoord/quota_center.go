@@ -29,6 +29,7 @@ import (
 	"github.com/milvus-io/milvus-proto/go-api/commonpb"
 	"github.com/milvus-io/milvus/internal/log"
 	"github.com/milvus-io/milvus/internal/metrics"
+	rmqserver "github.com/milvus-io/milvus/internal/mq/mqimpl/rocksmq/server"
 	"github.com/milvus-io/milvus/internal/proto/internalpb"
 	"github.com/milvus-io/milvus/internal/proto/proxypb"
 	"github.com/milvus-io/milvus/internal/tso"
@@ -95,6 +96,8 @@ type QuotaCenter struct {
 	dataNodeMetrics  map[UniqueID]*metricsinfo.DataNodeQuotaMetrics
 	proxyMetrics     map[UniqueID]*metricsinfo.ProxyQuotaMetrics
 	dataCoordMetrics *metricsinfo.DataCoordQuotaMetrics
+	// mqTopicsSize is the size of topics in the rocksmq running in the same process, only in standalone mode
+	mqTopicsSize map[string]int64
 
 	currentRates map[internalpb.RateType]Limit
 	tsoAllocator tso.Allocator
@@ -121,8 +124,8 @@ func NewQuotaCenter(proxies *proxyClientManager, queryCoord types.QueryCoord, da
 
 // run starts the service of QuotaCenter.
 func (q *QuotaCenter) run() {
-	log.Info("Start QuotaCenter", zap.Float64("collectInterval/s", Params.QuotaConfig.QuotaCenterCollectInterval))
-	ticker := time.NewTicker(time.Duration(Params.QuotaConfig.QuotaCenterCollectInterval * float64(time.Second)))
+	log.Info("Start QuotaCenter", zap.Float64("collectInterval/s", Params.QuotaConfig.Get().QuotaCenterCollectInterval))
+	ticker := time.NewTicker(time.Duration(Params.QuotaConfig.Get().QuotaCenterCollectInterval * float64(time.Second)))
 	defer ticker.Stop()
 	for {
 		select {
@@ -160,6 +163,7 @@ func (q *QuotaCenter) clearMetrics() {
 	q.dataNodeMetrics = make(map[UniqueID]*metricsinfo.DataNodeQuotaMetrics, 0)
 	q.queryNodeMetrics = make(map[UniqueID]*metricsinfo.QueryNodeQuotaMetrics, 0)
 	q.proxyMetrics = make(map[UniqueID]*metricsinfo.ProxyQuotaMetrics, 0)
+	q.mqTopicsSize = nil
 }
 
 // syncMetrics sends GetMetrics requests to DataCoord and QueryCoord to sync the metrics in DataNodes and QueryNodes.
@@ -242,6 +246,7 @@ func (q *QuotaCenter) syncMetrics() error {
 	if err != nil {
 		return err
 	}
+	q.mqTopicsSize = rmqserver.GetRocksMQTopicsSize()
 	//log.Debug("QuotaCenter sync metrics done",
 	//	zap.Any("dataNodeMetrics", q.dataNodeMetrics),
 	//	zap.Any("queryNodeMetrics", q.queryNodeMetrics),
@@ -287,12 +292,12 @@ func (q *QuotaCenter) guaranteeMinRate(minRate float64, rateType internalpb.Rate
 
 // calculateReadRates calculates and sets dql rates.
 func (q *QuotaCenter) calculateReadRates() {
-	if Params.QuotaConfig.ForceDenyReading {
+	if Params.QuotaConfig.Get().ForceDenyReading {
 		q.forceDenyReading(ManualForceDeny)
 		return
 	}
 
-	coolOffSpeed := Params.QuotaConfig.CoolOffSpeed
+	coolOffSpeed := Params.QuotaConfig.Get().CoolOffSpeed
 	coolOff := func(realTimeSearchRate float64, realTimeQueryRate float64) {
 		if q.currentRates[internalpb.RateType_DQLSearch] != Inf && realTimeSearchRate > 0 {
 			q.currentRates[internalpb.RateType_DQLSearch] = Limit(realTimeSearchRate * coolOffSpeed)
@@ -300,8 +305,8 @@ func (q *QuotaCenter) calculateReadRates() {
 		if q.currentRates[internalpb.RateType_DQLQuery] != Inf && realTimeSearchRate > 0 {
 			q.currentRates[internalpb.RateType_DQLQuery] = Limit(realTimeQueryRate * coolOffSpeed)
 		}
-		q.guaranteeMinRate(Params.QuotaConfig.DQLMinSearchRate, internalpb.RateType_DQLSearch)
-		q.guaranteeMinRate(Params.QuotaConfig.DQLMinQueryRate, internalpb.RateType_DQLQuery)
+		q.guaranteeMinRate(Params.QuotaConfig.Get().DQLMinSearchRate, internalpb.RateType_DQLSearch)
+		q.guaranteeMinRate(Params.QuotaConfig.Get().DQLMinQueryRate, internalpb.RateType_DQLQuery)
 		log.Warn("QuotaCenter cool read rates off done",
 			zap.Any("searchRate", q.currentRates[internalpb.RateType_DQLSearch]),
 			zap.Any("queryRate", q.currentRates[internalpb.RateType_DQLQuery]))
@@ -332,7 +337,7 @@ func (q *QuotaCenter) calculateReadRates() {
 
 // calculateWriteRates calculates and sets dml rates.
 func (q *QuotaCenter) calculateWriteRates() error {
-	if Params.QuotaConfig.ForceDenyWriting {
+	if Params.QuotaConfig.Get().ForceDenyWriting {
 		q.forceDenyWriting(ManualForceDeny)
 		return nil
 	}
@@ -369,8 +374,8 @@ func (q *QuotaCenter) calculateWriteRates() error {
 	if q.currentRates[internalpb.RateType_DMLDelete] != Inf {
 		q.currentRates[internalpb.RateType_DMLDelete] *= Limit(ttFactor)
 	}
-	q.guaranteeMinRate(Params.QuotaConfig.DMLMinInsertRate, internalpb.RateType_DMLInsert)
-	q.guaranteeMinRate(Params.QuotaConfig.DMLMinDeleteRate, internalpb.RateType_DMLDelete)
+	q.guaranteeMinRate(Params.QuotaConfig.Get().DMLMinInsertRate, internalpb.RateType_DMLInsert)
+	q.guaranteeMinRate(Params.QuotaConfig.Get().DMLMinDeleteRate, internalpb.RateType_DMLDelete)
 	return nil
 }
 
@@ -394,15 +399,15 @@ func (q *QuotaCenter) resetCurrentRates() {
 		rt := internalpb.RateType(rateType)
 		switch rt {
 		case internalpb.RateType_DMLInsert:
-			q.currentRates[rt] = Limit(Params.QuotaConfig.DMLMaxInsertRate)
+			q.currentRates[rt] = Limit(Params.QuotaConfig.Get().DMLMaxInsertRate)
 		case internalpb.RateType_DMLDelete:
-			q.currentRates[rt] = Limit(Params.QuotaConfig.DMLMaxDeleteRate)
+			q.currentRates[rt] = Limit(Params.QuotaConfig.Get().DMLMaxDeleteRate)
 		case internalpb.RateType_DMLBulkLoad:
-			q.currentRates[rt] = Limit(Params.QuotaConfig.DMLMaxBulkLoadRate)
+			q.currentRates[rt] = Limit(Params.QuotaConfig.Get().DMLMaxBulkLoadRate)
 		case internalpb.RateType_DQLSearch:
-			q.currentRates[rt] = Limit(Params.QuotaConfig.DQLMaxSearchRate)
+			q.currentRates[rt] = Limit(Params.QuotaConfig.Get().DQLMaxSearchRate)
 		case internalpb.RateType_DQLQuery:
-			q.currentRates[rt] = Limit(Params.QuotaConfig.DQLMaxQueryRate)
+			q.currentRates[rt] = Limit(Params.QuotaConfig.Get().DQLMaxQueryRate)
 		}
 		if q.currentRates[rt] < 0 {
 			q.currentRates[rt] = Inf // no limit
@@ -445,11 +450,11 @@ func (q *QuotaCenter) getTimeTickDelayFactor(ts Timestamp) float64 {
 		}
 	}
 
-	if !Params.QuotaConfig.TtProtectionEnabled {
+	if !Params.QuotaConfig.Get().TtProtectionEnabled {
 		return 1
 	}
 
-	maxDelay := Params.QuotaConfig.MaxTimeTickDelay
+	maxDelay := Params.QuotaConfig.Get().MaxTimeTickDelay
 	if maxDelay < 0 {
 		// < 0 means disable tt protection
 		return 1
@@ -485,7 +490,7 @@ func (q *QuotaCenter) getTimeTickDelayFactor(ts Timestamp) float64 {
 // getNQInQueryFactor checks search&query nq in QueryNode,
 // and return the factor according to NQInQueueThreshold.
 func (q *QuotaCenter) getNQInQueryFactor() float64 {
-	if !Params.QuotaConfig.QueueProtectionEnabled {
+	if !Params.QuotaConfig.Get().QueueProtectionEnabled {
 		return 1
 	}
 
@@ -493,7 +498,7 @@ func (q *QuotaCenter) getNQInQueryFactor() float64 {
 		return ri.UnsolvedQueue + ri.ReadyQueue + ri.ReceiveChan + ri.ExecuteChan
 	}
 
-	nqInQueueThreshold := Params.QuotaConfig.NQInQueueThreshold
+	nqInQueueThreshold := Params.QuotaConfig.Get().NQInQueueThreshold
 	if nqInQueueThreshold < 0 {
 		// < 0 means disable queue length protection
 		return 1
@@ -503,7 +508,7 @@ func (q *QuotaCenter) getNQInQueryFactor() float64 {
 		queryTasksSum := sum(metric.QueryQueue)
 		nqInQueue := searchNQSum + queryTasksSum // We think of the NQ of query request as 1.
 		if nqInQueue >= nqInQueueThreshold {
-			return Params.QuotaConfig.CoolOffSpeed
+			return Params.QuotaConfig.Get().CoolOffSpeed
 		}
 	}
 	return 1
@@ -512,11 +517,11 @@ func (q *QuotaCenter) getNQInQueryFactor() float64 {
 // getQueryLatencyFactor checks queueing latency in QueryNode for search&query requests,
 // and return the factor according to QueueLatencyThreshold.
 func (q *QuotaCenter) getQueryLatencyFactor() float64 {
-	if !Params.QuotaConfig.QueueProtectionEnabled {
+	if !Params.QuotaConfig.Get().QueueProtectionEnabled {
 		return 1
 	}
 
-	queueLatencyThreshold := Params.QuotaConfig.QueueLatencyThreshold
+	queueLatencyThreshold := Params.QuotaConfig.Get().QueueLatencyThreshold
 	if queueLatencyThreshold < 0 {
 		// < 0 means disable queue latency protection
 		return 1
@@ -525,7 +530,7 @@ func (q *QuotaCenter) getQueryLatencyFactor() float64 {
 		searchLatency := metric.SearchQueue.AvgQueueDuration
 		queryLatency := metric.QueryQueue.AvgQueueDuration
 		if float64(searchLatency) >= queueLatencyThreshold || float64(queryLatency) >= queueLatencyThreshold {
-			return Params.QuotaConfig.CoolOffSpeed
+			return Params.QuotaConfig.Get().CoolOffSpeed
 		}
 	}
 	return 1
@@ -534,11 +539,11 @@ func (q *QuotaCenter) getQueryLatencyFactor() float64 {
 // getReadResultFactor checks search result rate in Proxy,
 // and return the factor according to MaxReadResultRate.
 func (q *QuotaCenter) getReadResultFactor() float64 {
-	if !Params.QuotaConfig.ResultProtectionEnabled {
+	if !Params.QuotaConfig.Get().ResultProtectionEnabled {
 		return 1
 	}
 
-	maxRate := Params.QuotaConfig.MaxReadResultRate
+	maxRate := Params.QuotaConfig.Get().MaxReadResultRate
 	rateCount := float64(0)
 	for _, metric := range q.proxyMetrics {
 		for _, rm := range metric.Rms {
@@ -548,7 +553,7 @@ func (q *QuotaCenter) getReadResultFactor() float64 {
 		}
 	}
 	if rateCount >= maxRate {
-		return Params.QuotaConfig.CoolOffSpeed
+		return Params.QuotaConfig.Get().CoolOffSpeed
 	}
 	return 1
 }
@@ -557,14 +562,14 @@ func (q *QuotaCenter) getReadResultFactor() float64 {
 // and return the factor according to max memory water level.
 func (q *QuotaCenter) getMemoryFactor() float64 {
 	factor := float64(1)
-	if !Params.QuotaConfig.MemProtectionEnabled {
+	if !Params.QuotaConfig.Get().MemProtectionEnabled {
 		return 1
 	}
 
-	dataNodeMemoryLowWaterLevel := Params.QuotaConfig.DataNodeMemoryLowWaterLevel
-	dataNodeMemoryHighWaterLevel := Params.QuotaConfig.DataNodeMemoryHighWaterLevel
-	queryNodeMemoryLowWaterLevel := Params.QuotaConfig.QueryNodeMemoryLowWaterLevel
-	queryNodeMemoryHighWaterLevel := Params.QuotaConfig.QueryNodeMemoryHighWaterLevel
+	dataNodeMemoryLowWaterLevel := Params.QuotaConfig.Get().DataNodeMemoryLowWaterLevel
+	dataNodeMemoryHighWaterLevel := Params.QuotaConfig.Get().DataNodeMemoryHighWaterLevel
+	queryNodeMemoryLowWaterLevel := Params.QuotaConfig.Get().QueryNodeMemoryLowWaterLevel
+	queryNodeMemoryHighWaterLevel := Params.QuotaConfig.Get().QueryNodeMemoryHighWaterLevel
 
 	for nodeID, metric := range q.queryNodeMetrics {
 		memoryWaterLevel := float64(metric.Hms.MemoryUsage) / float64(metric.Hms.Memory)
@@ -621,17 +626,26 @@ func (q *QuotaCenter) getMemoryFactor() float64 {
 
 // ifDiskQuotaExceeded checks if disk quota exceeded.
 func (q *QuotaCenter) ifDiskQuotaExceeded() bool {
-	if !Params.QuotaConfig.DiskProtectionEnabled {
+	if !Params.QuotaConfig.Get().DiskProtectionEnabled {
 		return false
 	}
-	if q.dataCoordMetrics == nil {
+	if q.dataCoordMetrics == nil && len(q.mqTopicsSize) == 0 {
 		return false
 	}
-	diskQuota := Params.QuotaConfig.DiskQuota
-	totalSize := q.dataCoordMetrics.TotalBinlogSize
+	diskQuota := Params.QuotaConfig.Get().DiskQuota
+	var binlogSize, mqSize int64
+	if q.dataCoordMetrics != nil {
+		binlogSize = q.dataCoordMetrics.TotalBinlogSize
+	}
+	for _, size := range q.mqTopicsSize {
+		mqSize += size
+	}
+	totalSize := binlogSize + mqSize
 	if float64(totalSize) >= diskQuota {
 		log.Warn("QuotaCenter: disk quota exceeded",
 			zap.Int64("curDiskUsage", totalSize),
+			zap.Int64("binlogSize", binlogSize),
+			zap.Int64("mqSize", mqSize),
 			zap.Float64("diskQuota", diskQuota))
 		log.Info("DataCoordMetric",
 			zap.Any("metric", q.dataCoordMetrics))
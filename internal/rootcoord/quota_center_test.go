@@ -143,13 +143,13 @@ func TestQuotaCenter(t *testing.T) {
 
 		now := time.Now()
 
-		Params.QuotaConfig.TtProtectionEnabled = true
-		Params.QuotaConfig.MaxTimeTickDelay = 3 * time.Second
+		Params.QuotaConfig.Get().TtProtectionEnabled = true
+		Params.QuotaConfig.Get().MaxTimeTickDelay = 3 * time.Second
 
 		// test force deny writing
 		alloc := newMockTsoAllocator()
 		alloc.GenerateTSOF = func(count uint32) (typeutil.Timestamp, error) {
-			added := now.Add(Params.QuotaConfig.MaxTimeTickDelay)
+			added := now.Add(Params.QuotaConfig.Get().MaxTimeTickDelay)
 			ts := tsoutil.ComposeTSByTime(added, 0)
 			return ts, nil
 		}
@@ -166,7 +166,7 @@ func TestQuotaCenter(t *testing.T) {
 
 		// test one-third time tick delay
 		alloc.GenerateTSOF = func(count uint32) (typeutil.Timestamp, error) {
-			oneThirdDelay := Params.QuotaConfig.MaxTimeTickDelay / 3
+			oneThirdDelay := Params.QuotaConfig.Get().MaxTimeTickDelay / 3
 			added := now.Add(oneThirdDelay)
 			oneThirdTs := tsoutil.ComposeTSByTime(added, 0)
 			return oneThirdTs, nil
@@ -204,10 +204,10 @@ func TestQuotaCenter(t *testing.T) {
 			{10 * time.Second, t0.Add(100 * time.Second), t0, 0},
 		}
 
-		backup := Params.QuotaConfig.MaxTimeTickDelay
+		backup := Params.QuotaConfig.Get().MaxTimeTickDelay
 
 		for i, c := range ttCases {
-			Params.QuotaConfig.MaxTimeTickDelay = c.maxTtDelay
+			Params.QuotaConfig.Get().MaxTimeTickDelay = c.maxTtDelay
 			fgTs := tsoutil.ComposeTSByTime(c.fgTt, 0)
 			quotaCenter.queryNodeMetrics = map[UniqueID]*metricsinfo.QueryNodeQuotaMetrics{1: {Fgm: metricsinfo.FlowGraphMetric{NumFlowGraph: 1, MinFlowGraphTt: fgTs}}}
 			curTs := tsoutil.ComposeTSByTime(c.curTt, 0)
@@ -218,7 +218,7 @@ func TestQuotaCenter(t *testing.T) {
 			}
 		}
 
-		Params.QuotaConfig.MaxTimeTickDelay = backup
+		Params.QuotaConfig.Get().MaxTimeTickDelay = backup
 	})
 
 	t.Run("test getNQInQueryFactor", func(t *testing.T) {
@@ -227,19 +227,19 @@ func TestQuotaCenter(t *testing.T) {
 		assert.Equal(t, float64(1), factor)
 
 		// test cool off
-		Params.QuotaConfig.QueueProtectionEnabled = true
-		Params.QuotaConfig.NQInQueueThreshold = 100
+		Params.QuotaConfig.Get().QueueProtectionEnabled = true
+		Params.QuotaConfig.Get().NQInQueueThreshold = 100
 		quotaCenter.queryNodeMetrics = map[UniqueID]*metricsinfo.QueryNodeQuotaMetrics{
 			1: {SearchQueue: metricsinfo.ReadInfoInQueue{
-				UnsolvedQueue: Params.QuotaConfig.NQInQueueThreshold,
+				UnsolvedQueue: Params.QuotaConfig.Get().NQInQueueThreshold,
 			}}}
 		factor = quotaCenter.getNQInQueryFactor()
-		assert.Equal(t, Params.QuotaConfig.CoolOffSpeed, factor)
+		assert.Equal(t, Params.QuotaConfig.Get().CoolOffSpeed, factor)
 
 		// test no cool off
 		quotaCenter.queryNodeMetrics = map[UniqueID]*metricsinfo.QueryNodeQuotaMetrics{
 			1: {SearchQueue: metricsinfo.ReadInfoInQueue{
-				UnsolvedQueue: Params.QuotaConfig.NQInQueueThreshold - 1,
+				UnsolvedQueue: Params.QuotaConfig.Get().NQInQueueThreshold - 1,
 			}}}
 		factor = quotaCenter.getNQInQueryFactor()
 		assert.Equal(t, 1.0, factor)
@@ -253,15 +253,15 @@ func TestQuotaCenter(t *testing.T) {
 		assert.Equal(t, float64(1), factor)
 
 		// test cool off
-		Params.QuotaConfig.QueueProtectionEnabled = true
-		Params.QuotaConfig.QueueLatencyThreshold = float64(3 * time.Second)
+		Params.QuotaConfig.Get().QueueProtectionEnabled = true
+		Params.QuotaConfig.Get().QueueLatencyThreshold = float64(3 * time.Second)
 
 		quotaCenter.queryNodeMetrics = map[UniqueID]*metricsinfo.QueryNodeQuotaMetrics{
 			1: {SearchQueue: metricsinfo.ReadInfoInQueue{
-				AvgQueueDuration: time.Duration(Params.QuotaConfig.QueueLatencyThreshold),
+				AvgQueueDuration: time.Duration(Params.QuotaConfig.Get().QueueLatencyThreshold),
 			}}}
 		factor = quotaCenter.getQueryLatencyFactor()
-		assert.Equal(t, Params.QuotaConfig.CoolOffSpeed, factor)
+		assert.Equal(t, Params.QuotaConfig.Get().CoolOffSpeed, factor)
 
 		// test no cool off
 		quotaCenter.queryNodeMetrics = map[UniqueID]*metricsinfo.QueryNodeQuotaMetrics{
@@ -278,15 +278,15 @@ func TestQuotaCenter(t *testing.T) {
 		assert.Equal(t, float64(1), factor)
 
 		// test cool off
-		Params.QuotaConfig.ResultProtectionEnabled = true
-		Params.QuotaConfig.MaxReadResultRate = 1
+		Params.QuotaConfig.Get().ResultProtectionEnabled = true
+		Params.QuotaConfig.Get().MaxReadResultRate = 1
 
 		quotaCenter.proxyMetrics = map[UniqueID]*metricsinfo.ProxyQuotaMetrics{
 			1: {Rms: []metricsinfo.RateMetric{
 				{Label: metricsinfo.ReadResultThroughput, Rate: 1.2},
 			}}}
 		factor = quotaCenter.getReadResultFactor()
-		assert.Equal(t, Params.QuotaConfig.CoolOffSpeed, factor)
+		assert.Equal(t, Params.QuotaConfig.Get().CoolOffSpeed, factor)
 
 		// test no cool off
 		quotaCenter.proxyMetrics = map[UniqueID]*metricsinfo.ProxyQuotaMetrics{
@@ -305,28 +305,28 @@ func TestQuotaCenter(t *testing.T) {
 				{Label: internalpb.RateType_DQLQuery.String(), Rate: 100},
 			}}}
 
-		Params.QuotaConfig.ForceDenyReading = false
-		Params.QuotaConfig.QueueProtectionEnabled = true
-		Params.QuotaConfig.QueueLatencyThreshold = 100
+		Params.QuotaConfig.Get().ForceDenyReading = false
+		Params.QuotaConfig.Get().QueueProtectionEnabled = true
+		Params.QuotaConfig.Get().QueueLatencyThreshold = 100
 		quotaCenter.queryNodeMetrics = map[UniqueID]*metricsinfo.QueryNodeQuotaMetrics{
 			1: {SearchQueue: metricsinfo.ReadInfoInQueue{
-				AvgQueueDuration: time.Duration(Params.QuotaConfig.QueueLatencyThreshold),
+				AvgQueueDuration: time.Duration(Params.QuotaConfig.Get().QueueLatencyThreshold),
 			}}}
 		quotaCenter.calculateReadRates()
 		assert.Equal(t, Limit(100.0*0.9), quotaCenter.currentRates[internalpb.RateType_DQLSearch])
 		assert.Equal(t, Limit(100.0*0.9), quotaCenter.currentRates[internalpb.RateType_DQLQuery])
 
-		Params.QuotaConfig.NQInQueueThreshold = 100
+		Params.QuotaConfig.Get().NQInQueueThreshold = 100
 		quotaCenter.queryNodeMetrics = map[UniqueID]*metricsinfo.QueryNodeQuotaMetrics{
 			1: {SearchQueue: metricsinfo.ReadInfoInQueue{
-				UnsolvedQueue: Params.QuotaConfig.NQInQueueThreshold,
+				UnsolvedQueue: Params.QuotaConfig.Get().NQInQueueThreshold,
 			}}}
 		quotaCenter.calculateReadRates()
 		assert.Equal(t, Limit(100.0*0.9), quotaCenter.currentRates[internalpb.RateType_DQLSearch])
 		assert.Equal(t, Limit(100.0*0.9), quotaCenter.currentRates[internalpb.RateType_DQLQuery])
 
-		Params.QuotaConfig.ResultProtectionEnabled = true
-		Params.QuotaConfig.MaxReadResultRate = 1
+		Params.QuotaConfig.Get().ResultProtectionEnabled = true
+		Params.QuotaConfig.Get().MaxReadResultRate = 1
 		quotaCenter.proxyMetrics = map[UniqueID]*metricsinfo.ProxyQuotaMetrics{
 			1: {Rms: []metricsinfo.RateMetric{
 				{Label: internalpb.RateType_DQLSearch.String(), Rate: 100},
@@ -345,23 +345,23 @@ func TestQuotaCenter(t *testing.T) {
 		assert.NoError(t, err)
 
 		// DiskQuota exceeded
-		quotaBackup := Params.QuotaConfig.DiskQuota
-		Params.QuotaConfig.DiskQuota = 99
+		quotaBackup := Params.QuotaConfig.Get().DiskQuota
+		Params.QuotaConfig.Get().DiskQuota = 99
 		quotaCenter.dataCoordMetrics = &metricsinfo.DataCoordQuotaMetrics{TotalBinlogSize: 100}
 		err = quotaCenter.calculateWriteRates()
 		assert.NoError(t, err)
 		assert.Equal(t, Limit(0), quotaCenter.currentRates[internalpb.RateType_DMLInsert])
 		assert.Equal(t, Limit(0), quotaCenter.currentRates[internalpb.RateType_DMLDelete])
-		Params.QuotaConfig.DiskQuota = quotaBackup
+		Params.QuotaConfig.Get().DiskQuota = quotaBackup
 
 		// force deny
-		forceBak := Params.QuotaConfig.ForceDenyWriting
-		Params.QuotaConfig.ForceDenyWriting = true
+		forceBak := Params.QuotaConfig.Get().ForceDenyWriting
+		Params.QuotaConfig.Get().ForceDenyWriting = true
 		err = quotaCenter.calculateWriteRates()
 		assert.NoError(t, err)
 		assert.Equal(t, Limit(0), quotaCenter.currentRates[internalpb.RateType_DMLInsert])
 		assert.Equal(t, Limit(0), quotaCenter.currentRates[internalpb.RateType_DMLDelete])
-		Params.QuotaConfig.ForceDenyWriting = forceBak
+		Params.QuotaConfig.Get().ForceDenyWriting = forceBak
 	})
 
 	t.Run("test getMemoryFactor basic", func(t *testing.T) {
@@ -401,12 +401,12 @@ func TestQuotaCenter(t *testing.T) {
 			{0.85, 0.95, 95, 100, 0},
 		}
 
-		lowBackup := Params.QuotaConfig.DataNodeMemoryLowWaterLevel
-		highBackup := Params.QuotaConfig.DataNodeMemoryHighWaterLevel
+		lowBackup := Params.QuotaConfig.Get().DataNodeMemoryLowWaterLevel
+		highBackup := Params.QuotaConfig.Get().DataNodeMemoryHighWaterLevel
 
 		for i, c := range memCases {
-			Params.QuotaConfig.QueryNodeMemoryLowWaterLevel = c.lowWater
-			Params.QuotaConfig.QueryNodeMemoryHighWaterLevel = c.highWater
+			Params.QuotaConfig.Get().QueryNodeMemoryLowWaterLevel = c.lowWater
+			Params.QuotaConfig.Get().QueryNodeMemoryHighWaterLevel = c.highWater
 			quotaCenter.queryNodeMetrics = map[UniqueID]*metricsinfo.QueryNodeQuotaMetrics{1: {Hms: metricsinfo.HardwareMetrics{MemoryUsage: c.memUsage, Memory: c.memTotal}}}
 			factor := quotaCenter.getMemoryFactor()
 			if math.Abs(factor-c.expectedFactor) > 0.000001 {
@@ -415,29 +415,40 @@ func TestQuotaCenter(t *testing.T) {
 			}
 		}
 
-		Params.QuotaConfig.QueryNodeMemoryLowWaterLevel = lowBackup
-		Params.QuotaConfig.QueryNodeMemoryHighWaterLevel = highBackup
+		Params.QuotaConfig.Get().QueryNodeMemoryLowWaterLevel = lowBackup
+		Params.QuotaConfig.Get().QueryNodeMemoryHighWaterLevel = highBackup
 	})
 
 	t.Run("test ifDiskQuotaExceeded", func(t *testing.T) {
 		quotaCenter := NewQuotaCenter(pcm, &queryCoordMockForQuota{}, &dataCoordMockForQuota{}, core.tsoAllocator)
 
-		Params.QuotaConfig.DiskProtectionEnabled = false
+		Params.QuotaConfig.Get().DiskProtectionEnabled = false
 		ok := quotaCenter.ifDiskQuotaExceeded()
 		assert.False(t, ok)
-		Params.QuotaConfig.DiskProtectionEnabled = true
+		Params.QuotaConfig.Get().DiskProtectionEnabled = true
 
-		quotaBackup := Params.QuotaConfig.DiskQuota
-		Params.QuotaConfig.DiskQuota = 99
+		quotaBackup := Params.QuotaConfig.Get().DiskQuota
+		Params.QuotaConfig.Get().DiskQuota = 99
 		quotaCenter.dataCoordMetrics = &metricsinfo.DataCoordQuotaMetrics{TotalBinlogSize: 100}
 		ok = quotaCenter.ifDiskQuotaExceeded()
 		assert.True(t, ok)
 
-		Params.QuotaConfig.DiskQuota = 101
+		Params.QuotaConfig.Get().DiskQuota = 101
 		quotaCenter.dataCoordMetrics = &metricsinfo.DataCoordQuotaMetrics{TotalBinlogSize: 100}
 		ok = quotaCenter.ifDiskQuotaExceeded()
 		assert.False(t, ok)
-		Params.QuotaConfig.DiskQuota = quotaBackup
+
+		// messages retained by rocksmq are counted
+		quotaCenter.mqTopicsSize = map[string]int64{"dml_0": 1}
+		ok = quotaCenter.ifDiskQuotaExceeded()
+		assert.True(t, ok)
+		quotaCenter.dataCoordMetrics = nil
+		ok = quotaCenter.ifDiskQuotaExceeded()
+		assert.False(t, ok)
+		quotaCenter.mqTopicsSize = map[string]int64{"dml_0": 101}
+		ok = quotaCenter.ifDiskQuotaExceeded()
+		assert.True(t, ok)
+		Params.QuotaConfig.Get().DiskQuota = quotaBackup
 	})
 
 	t.Run("test setRates", func(t *testing.T) {
@@ -0,0 +1,290 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rootcoord
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+	"github.com/milvus-io/milvus/internal/kv"
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/proto/rootcoordpb"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
+
+	"go.uber.org/zap"
+)
+
+// restoreFunc executes a restore task, the progress of the task is updated by the function itself.
+type restoreFunc func(ctx context.Context, task *rootcoordpb.RestoreTaskInfo) error
+
+// restoreManager runs restore tasks in background and keeps their states in the task store.
+type restoreManager struct {
+	ctx       context.Context // context of the background restore tasks
+	taskStore kv.TxnKV        // Persistent task info storage.
+
+	workingTasks map[int64]*rootcoordpb.RestoreTaskInfo // unfinished tasks
+	workingLock  sync.RWMutex                           // lock working task map
+
+	startOnce sync.Once
+
+	idAllocator func(count uint32) (typeutil.UniqueID, typeutil.UniqueID, error)
+}
+
+// newRestoreManager helper function to create a restoreManager
+func newRestoreManager(ctx context.Context, client kv.TxnKV,
+	idAlloc func(count uint32) (typeutil.UniqueID, typeutil.UniqueID, error)) *restoreManager {
+	return &restoreManager{
+		ctx:          ctx,
+		taskStore:    client,
+		workingTasks: make(map[int64]*rootcoordpb.RestoreTaskInfo),
+		idAllocator:  idAlloc,
+	}
+}
+
+func (m *restoreManager) init() {
+	m.startOnce.Do(func() {
+		// Read tasks from Etcd and mark the unfinished ones as failed.
+		if _, err := m.loadFromTaskStore(true); err != nil {
+			log.Error("restoreManager init failed, read tasks from Etcd failed, about to panic")
+			panic(err)
+		}
+	})
+}
+
+// cleanupLoop starts a loop that removes restore tasks created over `RestoreTaskRetention` seconds ago from Etcd.
+func (m *restoreManager) cleanupLoop(wg *sync.WaitGroup) {
+	defer wg.Done()
+	ticker := time.NewTicker(time.Duration(cleanUpLoopInterval) * time.Millisecond)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-m.ctx.Done():
+			log.Debug("restore manager context done, exit cleanupLoop")
+			return
+		case <-ticker.C:
+			m.expireOldTasksFromEtcd()
+		}
+	}
+}
+
+// restoreJob creates a pending restore task, persists it and runs it in background by fn.
+// The task fails if fn returns an error, otherwise fn is responsible for marking the task completed.
+func (m *restoreManager) restoreJob(req *rootcoordpb.RestoreCollectionRequest, sourceCollID int64, fn restoreFunc) (int64, error) {
+	m.workingLock.Lock()
+	defer m.workingLock.Unlock()
+
+	// the restored collection is created by name, tasks restoring into the same collection would mix their segments
+	for _, task := range m.workingTasks {
+		if task.GetNewCollectionName() == req.GetNewCollectionName() {
+			return 0, fmt.Errorf("collection %s is being restored by task %d", req.GetNewCollectionName(), task.GetId())
+		}
+	}
+	tID, _, err := m.idAllocator(1)
+	if err != nil {
+		return 0, err
+	}
+	task := &rootcoordpb.RestoreTaskInfo{
+		Id:                   tID,
+		SourceCollectionId:   sourceCollID,
+		SourceCollectionName: req.GetCollectionName(),
+		Timestamp:            req.GetTimestamp(),
+		NewCollectionName:    req.GetNewCollectionName(),
+		CreateTs:             time.Now().Unix(),
+		State:                rootcoordpb.RestoreState_RestorePending,
+	}
+	if err := m.persistTaskInfo(task); err != nil {
+		return 0, err
+	}
+	m.workingTasks[tID] = task
+	log.Info("new restore task created", zap.Int64("task ID", tID))
+
+	go m.run(cloneRestoreTaskInfo(task), fn)
+	return tID, nil
+}
+
+func (m *restoreManager) run(task *rootcoordpb.RestoreTaskInfo, fn restoreFunc) {
+	err := fn(m.ctx, task)
+	if err == nil {
+		return
+	}
+	log.Warn("restore task failed", zap.Int64("task ID", task.GetId()), zap.Error(err))
+	if err := m.updateTaskInfo(task.GetId(), func(ti *rootcoordpb.RestoreTaskInfo) {
+		ti.State = rootcoordpb.RestoreState_RestoreFailed
+		ti.ErrorMessage = err.Error()
+	}); err != nil {
+		log.Error("failed to mark restore task as failed", zap.Int64("task ID", task.GetId()), zap.Error(err))
+	}
+}
+
+// updateTaskInfo updates and persists the working task by update, the task is removed from memory once it's finished.
+func (m *restoreManager) updateTaskInfo(tID int64, update func(ti *rootcoordpb.RestoreTaskInfo)) error {
+	m.workingLock.Lock()
+	defer m.workingLock.Unlock()
+
+	v, ok := m.workingTasks[tID]
+	if !ok {
+		return fmt.Errorf("failed to update restore task, ID not found: %d", tID)
+	}
+	// Meta persist should be done before memory objs change.
+	toPersist := cloneRestoreTaskInfo(v)
+	update(toPersist)
+	if err := m.persistTaskInfo(toPersist); err != nil {
+		return err
+	}
+	if restoreTaskFinished(toPersist) {
+		delete(m.workingTasks, tID)
+		log.Info("restore task finished", zap.Int64("task ID", tID), zap.String("state", toPersist.GetState().String()))
+		return nil
+	}
+	m.workingTasks[tID] = toPersist
+	return nil
+}
+
+// getTaskState looks for task with the given ID and returns its restore state.
+func (m *restoreManager) getTaskState(tID int64) *rootcoordpb.GetRestoreStateResponse {
+	resp := &rootcoordpb.GetRestoreStateResponse{
+		Status: &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    "restore task id doesn't exist",
+		},
+	}
+	log.Debug("getting restore task state", zap.Int64("task ID", tID))
+
+	// (1) Search in working tasks map.
+	m.workingLock.RLock()
+	task := m.workingTasks[tID]
+	m.workingLock.RUnlock()
+	// (2) Search in Etcd.
+	if task == nil {
+		v, err := m.taskStore.Load(BuildRestoreTaskKey(tID))
+		if err != nil || v == "" {
+			log.Warn("failed to load restore task info from Etcd", zap.Int64("task ID", tID), zap.Error(err))
+			return resp
+		}
+		ti := &rootcoordpb.RestoreTaskInfo{}
+		if err := proto.Unmarshal([]byte(v), ti); err != nil {
+			log.Error("failed to unmarshal proto", zap.String("taskInfo", v), zap.Error(err))
+			return resp
+		}
+		task = ti
+	}
+
+	resp.Status = &commonpb.Status{
+		ErrorCode: commonpb.ErrorCode_Success,
+	}
+	resp.Task = cloneRestoreTaskInfo(task)
+	return resp
+}
+
+// loadFromTaskStore loads task info from task store (Etcd).
+// loadFromTaskStore also marks unfinished tasks as failed when `markFailed` is set to `true`, since the goroutines
+// running them are gone with the old RootCoord.
+func (m *restoreManager) loadFromTaskStore(markFailed bool) ([]*rootcoordpb.RestoreTaskInfo, error) {
+	_, v, err := m.taskStore.LoadWithPrefix(Params.RootCoordCfg.RestoreTaskSubPath)
+	if err != nil {
+		log.Error("restore manager failed to load from Etcd", zap.Error(err))
+		return nil, err
+	}
+	var taskList []*rootcoordpb.RestoreTaskInfo
+
+	for i := range v {
+		ti := &rootcoordpb.RestoreTaskInfo{}
+		if err := proto.Unmarshal([]byte(v[i]), ti); err != nil {
+			log.Error("failed to unmarshal proto", zap.String("taskInfo", v[i]), zap.Error(err))
+			// Ignore bad protos.
+			continue
+		}
+		if markFailed && !restoreTaskFinished(ti) {
+			ti.ErrorMessage = "task marked failed as service restarted"
+			if ti.GetState() != rootcoordpb.RestoreState_RestorePending {
+				ti.ErrorMessage += fmt.Sprintf(", collection %s may be partially restored", ti.GetNewCollectionName())
+			}
+			ti.State = rootcoordpb.RestoreState_RestoreFailed
+			if err := m.persistTaskInfo(ti); err != nil {
+				log.Error("failed to mark an old restore task as failed",
+					zap.Int64("task ID", ti.GetId()),
+					zap.Error(err))
+			}
+			log.Info("restore task has been marked failed while reloading", zap.Int64("task ID", ti.GetId()))
+		}
+		taskList = append(taskList, ti)
+	}
+	return taskList, nil
+}
+
+// persistTaskInfo stores or updates the restore task info in Etcd.
+func (m *restoreManager) persistTaskInfo(ti *rootcoordpb.RestoreTaskInfo) error {
+	taskInfo, err := proto.Marshal(ti)
+	if err != nil {
+		log.Error("failed to marshall restore task info proto",
+			zap.Int64("task ID", ti.GetId()),
+			zap.Error(err))
+		return err
+	}
+	if err = m.taskStore.Save(BuildRestoreTaskKey(ti.GetId()), string(taskInfo)); err != nil {
+		log.Error("failed to update restore task info in Etcd",
+			zap.Int64("task ID", ti.GetId()),
+			zap.Error(err))
+		return err
+	}
+	return nil
+}
+
+// expireOldTasksFromEtcd removes finished tasks from Etcd that are over `RestoreTaskRetention` seconds old.
+func (m *restoreManager) expireOldTasksFromEtcd() {
+	tasks, err := m.loadFromTaskStore(false)
+	if err != nil {
+		log.Error("failed to load restore tasks from Etcd during task cleanup")
+		return
+	}
+	for _, ti := range tasks {
+		if !restoreTaskFinished(ti) || !restoreTaskPastRetention(ti) {
+			continue
+		}
+		log.Info("a restore task has passed retention period and will be removed from Etcd",
+			zap.Int64("task ID", ti.GetId()),
+			zap.Int64("createTs", ti.GetCreateTs()),
+			zap.Float64("RestoreTaskRetention", Params.RootCoordCfg.RestoreTaskRetention))
+		if err := m.taskStore.Remove(BuildRestoreTaskKey(ti.GetId())); err != nil {
+			log.Error("failed to remove restore task from Etcd",
+				zap.Int64("task ID", ti.GetId()),
+				zap.Error(err))
+		}
+	}
+}
+
+// BuildRestoreTaskKey constructs and returns an Etcd key with given task ID.
+func BuildRestoreTaskKey(taskID int64) string {
+	return fmt.Sprintf("%s%s%d", Params.RootCoordCfg.RestoreTaskSubPath, delimiter, taskID)
+}
+
+func restoreTaskFinished(ti *rootcoordpb.RestoreTaskInfo) bool {
+	return ti.GetState() == rootcoordpb.RestoreState_RestoreFailed || ti.GetState() == rootcoordpb.RestoreState_RestoreCompleted
+}
+
+// restoreTaskPastRetention returns true if the task is considered expired in Etcd.
+func restoreTaskPastRetention(ti *rootcoordpb.RestoreTaskInfo) bool {
+	return Params.RootCoordCfg.RestoreTaskRetention <= float64(time.Now().Unix()-ti.GetCreateTs())
+}
+
+func cloneRestoreTaskInfo(taskInfo *rootcoordpb.RestoreTaskInfo) *rootcoordpb.RestoreTaskInfo {
+	return proto.Clone(taskInfo).(*rootcoordpb.RestoreTaskInfo)
+}
@@ -0,0 +1,196 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rootcoord
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+	memkv "github.com/milvus-io/milvus/internal/kv/mem"
+	"github.com/milvus-io/milvus/internal/kv/mocks"
+	"github.com/milvus-io/milvus/internal/proto/rootcoordpb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func saveRestoreTaskInfo(t *testing.T, kv *memkv.MemoryKV, ti *rootcoordpb.RestoreTaskInfo) {
+	value, err := proto.Marshal(ti)
+	assert.NoError(t, err)
+	err = kv.Save(BuildRestoreTaskKey(ti.GetId()), string(value))
+	assert.NoError(t, err)
+}
+
+func TestRestoreManager_Init(t *testing.T) {
+	Params.RootCoordCfg.RestoreTaskSubPath = "test_restore_task"
+	mockKv := memkv.NewMemoryKV()
+	saveRestoreTaskInfo(t, mockKv, &rootcoordpb.RestoreTaskInfo{
+		Id:    100,
+		State: rootcoordpb.RestoreState_RestorePending,
+	})
+	saveRestoreTaskInfo(t, mockKv, &rootcoordpb.RestoreTaskInfo{
+		Id:           200,
+		State:        rootcoordpb.RestoreState_RestoringSegments,
+		CollectionId: 10,
+	})
+	saveRestoreTaskInfo(t, mockKv, &rootcoordpb.RestoreTaskInfo{
+		Id:    300,
+		State: rootcoordpb.RestoreState_RestoreCompleted,
+	})
+
+	mgr := newRestoreManager(context.TODO(), mockKv, newTestExportIDAllocator())
+	mgr.init()
+
+	// unfinished tasks are marked failed since their goroutines are gone
+	resp := mgr.getTaskState(100)
+	assert.Equal(t, commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+	assert.Equal(t, rootcoordpb.RestoreState_RestoreFailed, resp.GetTask().GetState())
+	assert.Equal(t, "task marked failed as service restarted", resp.GetTask().GetErrorMessage())
+	resp = mgr.getTaskState(200)
+	assert.Equal(t, rootcoordpb.RestoreState_RestoreFailed, resp.GetTask().GetState())
+	assert.Contains(t, resp.GetTask().GetErrorMessage(), "partially restored")
+	resp = mgr.getTaskState(300)
+	assert.Equal(t, rootcoordpb.RestoreState_RestoreCompleted, resp.GetTask().GetState())
+
+	resp = mgr.getTaskState(400)
+	assert.NotEqual(t, commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+
+	t.Run("failed to load", func(t *testing.T) {
+		txnKv := &mocks.TxnKV{}
+		txnKv.EXPECT().LoadWithPrefix(mock.Anything).Return(nil, nil, errors.New("mock"))
+		mgr := newRestoreManager(context.TODO(), txnKv, newTestExportIDAllocator())
+		assert.Panics(t, mgr.init)
+	})
+}
+
+func TestRestoreManager_RestoreJob(t *testing.T) {
+	Params.RootCoordCfg.RestoreTaskSubPath = "test_restore_task"
+	req := &rootcoordpb.RestoreCollectionRequest{
+		CollectionName:    "coll",
+		Timestamp:         1000,
+		NewCollectionName: "restored",
+	}
+	waitState := func(t *testing.T, mgr *restoreManager, taskID int64, state rootcoordpb.RestoreState) *rootcoordpb.RestoreTaskInfo {
+		var task *rootcoordpb.RestoreTaskInfo
+		assert.Eventually(t, func() bool {
+			task = mgr.getTaskState(taskID).GetTask()
+			return task.GetState() == state
+		}, 5*time.Second, 10*time.Millisecond)
+		return task
+	}
+
+	t.Run("completed", func(t *testing.T) {
+		mgr := newRestoreManager(context.TODO(), memkv.NewMemoryKV(), newTestExportIDAllocator())
+		proceed := make(chan struct{})
+		taskID, err := mgr.restoreJob(req, 100, func(ctx context.Context, task *rootcoordpb.RestoreTaskInfo) error {
+			if err := mgr.updateTaskInfo(task.GetId(), func(ti *rootcoordpb.RestoreTaskInfo) {
+				ti.State = rootcoordpb.RestoreState_RestoringSegments
+				ti.CollectionId = 200
+			}); err != nil {
+				return err
+			}
+			<-proceed
+			return mgr.updateTaskInfo(task.GetId(), func(ti *rootcoordpb.RestoreTaskInfo) {
+				ti.State = rootcoordpb.RestoreState_RestoreCompleted
+				ti.SegmentIds = []int64{1, 2}
+				ti.RowCount = 10
+			})
+		})
+		assert.NoError(t, err)
+
+		task := waitState(t, mgr, taskID, rootcoordpb.RestoreState_RestoringSegments)
+		assert.Equal(t, int64(100), task.GetSourceCollectionId())
+		assert.Equal(t, "coll", task.GetSourceCollectionName())
+		assert.Equal(t, int64(200), task.GetCollectionId())
+
+		// another task restoring into the same collection is rejected until the task is finished
+		_, err = mgr.restoreJob(req, 100, func(ctx context.Context, task *rootcoordpb.RestoreTaskInfo) error {
+			return nil
+		})
+		assert.Error(t, err)
+
+		close(proceed)
+		task = waitState(t, mgr, taskID, rootcoordpb.RestoreState_RestoreCompleted)
+		assert.ElementsMatch(t, []int64{1, 2}, task.GetSegmentIds())
+		assert.Equal(t, int64(10), task.GetRowCount())
+		mgr.workingLock.RLock()
+		assert.Empty(t, mgr.workingTasks)
+		mgr.workingLock.RUnlock()
+	})
+
+	t.Run("failed", func(t *testing.T) {
+		mgr := newRestoreManager(context.TODO(), memkv.NewMemoryKV(), newTestExportIDAllocator())
+		taskID, err := mgr.restoreJob(req, 100, func(ctx context.Context, task *rootcoordpb.RestoreTaskInfo) error {
+			return errors.New("mock restore error")
+		})
+		assert.NoError(t, err)
+		task := waitState(t, mgr, taskID, rootcoordpb.RestoreState_RestoreFailed)
+		assert.Equal(t, "mock restore error", task.GetErrorMessage())
+	})
+
+	t.Run("failed to alloc id", func(t *testing.T) {
+		mgr := newRestoreManager(context.TODO(), memkv.NewMemoryKV(), func(count uint32) (UniqueID, UniqueID, error) {
+			return 0, 0, errors.New("mock")
+		})
+		_, err := mgr.restoreJob(req, 100, nil)
+		assert.Error(t, err)
+	})
+
+	t.Run("failed to persist", func(t *testing.T) {
+		txnKv := &mocks.TxnKV{}
+		txnKv.EXPECT().Save(mock.Anything, mock.Anything).Return(errors.New("mock"))
+		mgr := newRestoreManager(context.TODO(), txnKv, newTestExportIDAllocator())
+		_, err := mgr.restoreJob(req, 100, nil)
+		assert.Error(t, err)
+	})
+}
+
+func TestRestoreManager_ExpireOldTasksFromEtcd(t *testing.T) {
+	Params.RootCoordCfg.RestoreTaskSubPath = "test_restore_task"
+	retention := Params.RootCoordCfg.RestoreTaskRetention
+	defer func() {
+		Params.RootCoordCfg.RestoreTaskRetention = retention
+	}()
+	Params.RootCoordCfg.RestoreTaskRetention = 10
+
+	mockKv := memkv.NewMemoryKV()
+	old := time.Now().Add(-time.Minute).Unix()
+	saveRestoreTaskInfo(t, mockKv, &rootcoordpb.RestoreTaskInfo{
+		Id:       100,
+		State:    rootcoordpb.RestoreState_RestoreCompleted,
+		CreateTs: old,
+	})
+	saveRestoreTaskInfo(t, mockKv, &rootcoordpb.RestoreTaskInfo{
+		Id:       200,
+		State:    rootcoordpb.RestoreState_RestoringSegments,
+		CreateTs: old,
+	})
+	saveRestoreTaskInfo(t, mockKv, &rootcoordpb.RestoreTaskInfo{
+		Id:       300,
+		State:    rootcoordpb.RestoreState_RestoreFailed,
+		CreateTs: time.Now().Unix(),
+	})
+
+	mgr := newRestoreManager(context.TODO(), mockKv, newTestExportIDAllocator())
+	mgr.expireOldTasksFromEtcd()
+	assert.NotEqual(t, commonpb.ErrorCode_Success, mgr.getTaskState(100).GetStatus().GetErrorCode())
+	assert.Equal(t, commonpb.ErrorCode_Success, mgr.getTaskState(200).GetStatus().GetErrorCode())
+	assert.Equal(t, commonpb.ErrorCode_Success, mgr.getTaskState(300).GetStatus().GetErrorCode())
+}
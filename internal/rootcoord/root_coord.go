@@ -27,6 +27,7 @@ import (
 	"syscall"
 	"time"
 
+	"github.com/golang/protobuf/proto"
 	"golang.org/x/sync/errgroup"
 
 	"github.com/milvus-io/milvus-proto/go-api/commonpb"
@@ -45,6 +46,7 @@ import (
 	kvmetestore "github.com/milvus-io/milvus/internal/metastore/kv/rootcoord"
 	"github.com/milvus-io/milvus/internal/metastore/model"
 	"github.com/milvus-io/milvus/internal/metrics"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
 	pb "github.com/milvus-io/milvus/internal/proto/etcdpb"
 	"github.com/milvus-io/milvus/internal/proto/internalpb"
 	"github.com/milvus-io/milvus/internal/proto/proxypb"
@@ -56,6 +58,7 @@ import (
 	"github.com/milvus-io/milvus/internal/util/crypto"
 	"github.com/milvus-io/milvus/internal/util/dependency"
 	"github.com/milvus-io/milvus/internal/util/errorutil"
+	"github.com/milvus-io/milvus/internal/util/exportutil"
 	"github.com/milvus-io/milvus/internal/util/funcutil"
 	"github.com/milvus-io/milvus/internal/util/metricsinfo"
 	"github.com/milvus-io/milvus/internal/util/paramtable"
@@ -129,6 +132,9 @@ type Core struct {
 	factory dependency.Factory
 
 	importManager *importManager
+	exportManager *exportManager
+
+	restoreManager *restoreManager
 
 	enableActiveStandBy bool
 	activateFunc        func()
@@ -432,6 +438,39 @@ func (c *Core) initImportManager() error {
 	return nil
 }
 
+func (c *Core) initExportManager() error {
+	expTaskKv, err := c.metaKVCreator(Params.EtcdCfg.KvRootPath)
+	if err != nil {
+		return err
+	}
+
+	c.exportManager = newExportManager(
+		c.ctx,
+		expTaskKv,
+		IDAllocatorWithCore(c),
+		c.broker.Export,
+	)
+	c.exportManager.init(c.ctx)
+
+	return nil
+}
+
+func (c *Core) initRestoreManager() error {
+	restoreTaskKv, err := c.metaKVCreator(Params.EtcdCfg.KvRootPath)
+	if err != nil {
+		return err
+	}
+
+	c.restoreManager = newRestoreManager(
+		c.ctx,
+		restoreTaskKv,
+		IDAllocatorWithCore(c),
+	)
+	c.restoreManager.init()
+
+	return nil
+}
+
 func (c *Core) initInternal() error {
 	if err := c.initSession(); err != nil {
 		return err
@@ -482,6 +521,14 @@ func (c *Core) initInternal() error {
 		return err
 	}
 
+	if err := c.initExportManager(); err != nil {
+		return err
+	}
+
+	if err := c.initRestoreManager(); err != nil {
+		return err
+	}
+
 	if err := c.initCredentials(); err != nil {
 		return err
 	}
@@ -624,17 +671,20 @@ func (c *Core) startInternal() error {
 		panic(err)
 	}
 
-	c.wg.Add(6)
+	c.wg.Add(9)
 	go c.startTimeTickLoop()
 	go c.tsLoop()
 	go c.chanTimeTick.startWatch(&c.wg)
 	go c.importManager.cleanupLoop(&c.wg)
 	go c.importManager.sendOutTasksLoop(&c.wg)
 	go c.importManager.flipTaskStateLoop(&c.wg)
+	go c.exportManager.cleanupLoop(&c.wg)
+	go c.exportManager.sendOutTasksLoop(&c.wg)
+	go c.restoreManager.cleanupLoop(&c.wg)
 	Params.RootCoordCfg.CreatedTime = time.Now()
 	Params.RootCoordCfg.UpdatedTime = time.Now()
 
-	if Params.QuotaConfig.QuotaAndLimitsEnabled {
+	if Params.QuotaConfig.Get().QuotaAndLimitsEnabled {
 		go c.quotaCenter.run()
 	}
 
@@ -1816,6 +1866,358 @@ func (c *Core) ReportImport(ctx context.Context, ir *rootcoordpb.ImportResult) (
 	}, nil
 }
 
+// Export exports data of a collection at a snapshot timestamp into files on MinIO/S3 storage.
+// Only flushed segments are exported, data in growing segments is not included.
+func (c *Core) Export(ctx context.Context, req *rootcoordpb.ExportRequest) (*rootcoordpb.ExportResponse, error) {
+	if code, ok := c.checkHealthy(); !ok {
+		return &rootcoordpb.ExportResponse{
+			Status: failStatus(commonpb.ErrorCode_UnexpectedError, "StateCode="+commonpb.StateCode_name[int32(code)]),
+		}, nil
+	}
+
+	if !exportutil.IsValidFormat(req.GetFormat()) {
+		return &rootcoordpb.ExportResponse{
+			Status: failStatus(commonpb.ErrorCode_IllegalArgument, fmt.Sprintf("unsupported export format '%s'", req.GetFormat())),
+		}, nil
+	}
+	if req.GetOutputPrefix() == "" {
+		return &rootcoordpb.ExportResponse{
+			Status: failStatus(commonpb.ErrorCode_IllegalArgument, "output prefix of export is empty"),
+		}, nil
+	}
+
+	// Get collection/partition ID from collection/partition name.
+	colInfo, err := c.meta.GetCollectionByName(ctx, req.GetCollectionName(), typeutil.MaxTimestamp)
+	if err != nil {
+		log.Error("failed to find collection ID from its name",
+			zap.String("collection name", req.GetCollectionName()),
+			zap.Error(err))
+		return &rootcoordpb.ExportResponse{
+			Status: failStatus(commonpb.ErrorCode_IllegalCollectionName, err.Error()),
+		}, nil
+	}
+	partitionIDs := make([]UniqueID, 0, len(req.GetPartitionNames()))
+	for _, partitionName := range req.GetPartitionNames() {
+		pID, err := c.meta.GetPartitionByName(colInfo.CollectionID, partitionName, typeutil.MaxTimestamp)
+		if err != nil {
+			log.Error("failed to get partition ID from its name",
+				zap.String("partition name", partitionName),
+				zap.Error(err))
+			return &rootcoordpb.ExportResponse{
+				Status: failStatus(commonpb.ErrorCode_IllegalArgument, err.Error()),
+			}, nil
+		}
+		partitionIDs = append(partitionIDs, pID)
+	}
+
+	// Use current time as the snapshot if the timestamp is not specified.
+	ts := req.GetTimestamp()
+	if ts == 0 {
+		if ts, err = c.tsoAllocator.GenerateTSO(1); err != nil {
+			return &rootcoordpb.ExportResponse{
+				Status: failStatus(commonpb.ErrorCode_UnexpectedError, err.Error()),
+			}, nil
+		}
+	}
+	log.Info("RootCoord receive export request",
+		zap.String("collection name", req.GetCollectionName()),
+		zap.Int64("collection ID", colInfo.CollectionID),
+		zap.Strings("partition names", req.GetPartitionNames()),
+		zap.Int64s("partition IDs", partitionIDs),
+		zap.String("output prefix", req.GetOutputPrefix()),
+		zap.String("format", req.GetFormat()),
+		zap.Uint64("timestamp", ts))
+	return c.exportManager.exportJob(ctx, req, colInfo.CollectionID, partitionIDs, ts), nil
+}
+
+// GetExportState returns the current state of an export task.
+func (c *Core) GetExportState(ctx context.Context, req *rootcoordpb.GetExportStateRequest) (*rootcoordpb.GetExportStateResponse, error) {
+	if code, ok := c.checkHealthy(); !ok {
+		return &rootcoordpb.GetExportStateResponse{
+			Status: failStatus(commonpb.ErrorCode_UnexpectedError, "StateCode="+commonpb.StateCode_name[int32(code)]),
+		}, nil
+	}
+	return c.exportManager.getTaskState(req.GetTaskId()), nil
+}
+
+// ListExportTasks returns information of export tasks, filtered by collection name if it is specified.
+func (c *Core) ListExportTasks(ctx context.Context, req *rootcoordpb.ListExportTasksRequest) (*rootcoordpb.ListExportTasksResponse, error) {
+	if code, ok := c.checkHealthy(); !ok {
+		return &rootcoordpb.ListExportTasksResponse{
+			Status: failStatus(commonpb.ErrorCode_UnexpectedError, "StateCode="+commonpb.StateCode_name[int32(code)]),
+		}, nil
+	}
+
+	colID := int64(-1)
+	if len(req.GetCollectionName()) != 0 {
+		colInfo, err := c.meta.GetCollectionByName(ctx, req.GetCollectionName(), typeutil.MaxTimestamp)
+		if err != nil {
+			err = fmt.Errorf("failed to find collection ID from its name: '%s', error: %w", req.GetCollectionName(), err)
+			log.Error("ListExportTasks failed", zap.Error(err))
+			return &rootcoordpb.ListExportTasksResponse{
+				Status: failStatus(commonpb.ErrorCode_IllegalCollectionName, err.Error()),
+			}, nil
+		}
+		colID = colInfo.CollectionID
+	}
+
+	tasks, err := c.exportManager.listAllTasks(colID, req.GetLimit())
+	if err != nil {
+		err = fmt.Errorf("failed to list export tasks, collection name: '%s', error: %w", req.GetCollectionName(), err)
+		log.Error("ListExportTasks failed", zap.Error(err))
+		return &rootcoordpb.ListExportTasksResponse{
+			Status: failStatus(commonpb.ErrorCode_UnexpectedError, err.Error()),
+		}, nil
+	}
+
+	return &rootcoordpb.ListExportTasksResponse{
+		Status: succStatus(),
+		Tasks:  tasks,
+	}, nil
+}
+
+// ReportExport reports export task state to RootCoord.
+func (c *Core) ReportExport(ctx context.Context, er *rootcoordpb.ExportResult) (*commonpb.Status, error) {
+	log.Info("RootCoord receive export state report",
+		zap.Int64("task ID", er.GetTaskId()),
+		zap.Any("export state", er.GetState()))
+	if code, ok := c.checkHealthy(); !ok {
+		return failStatus(commonpb.ErrorCode_UnexpectedError, "StateCode="+commonpb.StateCode_name[int32(code)]), nil
+	}
+
+	if _, err := c.exportManager.updateTaskInfo(er); err != nil {
+		return failStatus(commonpb.ErrorCode_UnexpectedError, err.Error()), nil
+	}
+
+	// The DataNode may be available again, send out the pending tasks.
+	if err := c.exportManager.sendOutTasks(c.exportManager.ctx); err != nil {
+		log.Error("fail to send out export task to datanodes", zap.Error(err))
+	}
+	return succStatus(), nil
+}
+
+// RestoreCollection restores a collection as it was at a timestamp into a new collection.
+// The schema and partitions are rebuilt from the meta snapshot at the timestamp, the segments valid at the timestamp
+// are copied by DataCoord, so the timestamp must be within the restore window of the DataCoord garbage collector.
+// The restore runs in background, the returned task id is used to check its state by GetRestoreState.
+func (c *Core) RestoreCollection(ctx context.Context, req *rootcoordpb.RestoreCollectionRequest) (*rootcoordpb.RestoreCollectionResponse, error) {
+	if code, ok := c.checkHealthy(); !ok {
+		return &rootcoordpb.RestoreCollectionResponse{
+			Status: failStatus(commonpb.ErrorCode_UnexpectedError, "StateCode="+commonpb.StateCode_name[int32(code)]),
+		}, nil
+	}
+
+	ts := req.GetTimestamp()
+	if ts == 0 || isMaxTs(ts) {
+		return &rootcoordpb.RestoreCollectionResponse{
+			Status: failStatus(commonpb.ErrorCode_IllegalArgument, "timestamp of restore is not specified"),
+		}, nil
+	}
+	if req.GetNewCollectionName() == "" {
+		return &rootcoordpb.RestoreCollectionResponse{
+			Status: failStatus(commonpb.ErrorCode_IllegalArgument, "name of the restored collection is empty"),
+		}, nil
+	}
+
+	log := log.Ctx(ctx).With(
+		zap.String("collection name", req.GetCollectionName()),
+		zap.Int64("collection ID", req.GetCollectionID()),
+		zap.String("new collection name", req.GetNewCollectionName()),
+		zap.Uint64("timestamp", ts))
+	log.Info("RootCoord receive restore collection request")
+
+	source, err := c.getRestoreSource(ctx, req)
+	if err != nil {
+		log.Error("failed to find collection at the timestamp", zap.Error(err))
+		return &rootcoordpb.RestoreCollectionResponse{
+			Status: failStatus(commonpb.ErrorCode_IllegalCollectionName, err.Error()),
+		}, nil
+	}
+	if _, err := c.meta.GetCollectionByName(ctx, req.GetNewCollectionName(), typeutil.MaxTimestamp); err == nil {
+		return &rootcoordpb.RestoreCollectionResponse{
+			Status: failStatus(commonpb.ErrorCode_IllegalCollectionName,
+				fmt.Sprintf("collection %s already exists", req.GetNewCollectionName())),
+		}, nil
+	}
+	schema, err := restoreSchema(source)
+	if err != nil {
+		log.Error("failed to restore schema of collection", zap.Error(err))
+		return &rootcoordpb.RestoreCollectionResponse{
+			Status: failStatus(commonpb.ErrorCode_UnexpectedError, err.Error()),
+		}, nil
+	}
+	schema.Name = req.GetNewCollectionName()
+	marshaledSchema, err := proto.Marshal(schema)
+	if err != nil {
+		return &rootcoordpb.RestoreCollectionResponse{
+			Status: failStatus(commonpb.ErrorCode_UnexpectedError, err.Error()),
+		}, nil
+	}
+
+	taskID, err := c.restoreManager.restoreJob(req, source.CollectionID, func(ctx context.Context, task *rootcoordpb.RestoreTaskInfo) error {
+		return c.restoreCollection(ctx, task, req, source, marshaledSchema)
+	})
+	if err != nil {
+		log.Error("failed to create restore task", zap.Error(err))
+		return &rootcoordpb.RestoreCollectionResponse{
+			Status: failStatus(commonpb.ErrorCode_UnexpectedError, err.Error()),
+		}, nil
+	}
+	log.Info("restore task created", zap.Int64("task ID", taskID), zap.Int64("source collection ID", source.CollectionID))
+	return &rootcoordpb.RestoreCollectionResponse{
+		Status: succStatus(),
+		TaskId: taskID,
+	}, nil
+}
+
+// GetRestoreState returns the current state of a restore task.
+func (c *Core) GetRestoreState(ctx context.Context, req *rootcoordpb.GetRestoreStateRequest) (*rootcoordpb.GetRestoreStateResponse, error) {
+	if code, ok := c.checkHealthy(); !ok {
+		return &rootcoordpb.GetRestoreStateResponse{
+			Status: failStatus(commonpb.ErrorCode_UnexpectedError, "StateCode="+commonpb.StateCode_name[int32(code)]),
+		}, nil
+	}
+	return c.restoreManager.getTaskState(req.GetTaskId()), nil
+}
+
+// getRestoreSource returns the source collection of the restore as it was at the timestamp. The name is resolved
+// against the collections at the timestamp instead of the current ones, since it may have been reused by another
+// collection after the source collection was dropped.
+func (c *Core) getRestoreSource(ctx context.Context, req *rootcoordpb.RestoreCollectionRequest) (*model.Collection, error) {
+	ts := req.GetTimestamp()
+	if req.GetCollectionID() != 0 {
+		return c.meta.GetCollectionByID(ctx, req.GetCollectionID(), ts)
+	}
+	colls, err := c.meta.ListCollections(ctx, ts)
+	if err != nil {
+		return nil, err
+	}
+	for _, coll := range colls {
+		if coll.Name == req.GetCollectionName() {
+			return filterUnavailable(coll), nil
+		}
+	}
+	return nil, common.NewCollectionNotExistError(fmt.Sprintf("can't find collection %s at timestamp %d", req.GetCollectionName(), ts))
+}
+
+// restoreCollection executes the restore task, it creates the restored collection with the schema of the source
+// collection, then restores the partitions and segments of the source collection into it.
+// The restored collection is dropped if the task fails after the collection is created.
+func (c *Core) restoreCollection(ctx context.Context, task *rootcoordpb.RestoreTaskInfo, req *rootcoordpb.RestoreCollectionRequest,
+	source *model.Collection, schema []byte) error {
+	log := log.Ctx(ctx).With(
+		zap.Int64("task ID", task.GetId()),
+		zap.String("new collection name", req.GetNewCollectionName()))
+
+	if err := c.restoreManager.updateTaskInfo(task.GetId(), func(ti *rootcoordpb.RestoreTaskInfo) {
+		ti.State = rootcoordpb.RestoreState_RestoreCreatingCollection
+		ti.StartTs = time.Now().Unix()
+	}); err != nil {
+		return err
+	}
+	status, _ := c.CreateCollection(ctx, &milvuspb.CreateCollectionRequest{
+		Base:             req.GetBase(),
+		CollectionName:   req.GetNewCollectionName(),
+		Schema:           schema,
+		ShardsNum:        source.ShardsNum,
+		ConsistencyLevel: source.ConsistencyLevel,
+		Properties:       source.Properties,
+	})
+	if status.GetErrorCode() != commonpb.ErrorCode_Success {
+		return fmt.Errorf("failed to create the restored collection, reason: %s", status.GetReason())
+	}
+
+	resp, err := c.restoreCollectionData(ctx, task, req, source)
+	if err != nil {
+		log.Error("failed to restore collection, drop the restored collection", zap.Error(err))
+		status, _ := c.DropCollection(ctx, &milvuspb.DropCollectionRequest{
+			CollectionName: req.GetNewCollectionName(),
+		})
+		if status.GetErrorCode() != commonpb.ErrorCode_Success {
+			log.Warn("failed to drop the restored collection", zap.String("reason", status.GetReason()))
+		}
+		return err
+	}
+
+	log.Info("done to restore collection",
+		zap.Int("segment num", len(resp.GetSegmentIDs())),
+		zap.Int64("row count", resp.GetRowCount()))
+	return c.restoreManager.updateTaskInfo(task.GetId(), func(ti *rootcoordpb.RestoreTaskInfo) {
+		ti.State = rootcoordpb.RestoreState_RestoreCompleted
+		ti.SegmentIds = resp.GetSegmentIDs()
+		ti.RowCount = resp.GetRowCount()
+	})
+}
+
+// restoreCollectionData creates the partitions of the source collection in the restored collection,
+// and asks DataCoord to restore the segments of the source collection valid at the timestamp.
+func (c *Core) restoreCollectionData(ctx context.Context, task *rootcoordpb.RestoreTaskInfo, req *rootcoordpb.RestoreCollectionRequest,
+	source *model.Collection) (*datapb.RestoreSegmentsResponse, error) {
+	for _, partition := range source.Partitions {
+		if partition.PartitionName == Params.CommonCfg.DefaultPartitionName {
+			continue
+		}
+		status, _ := c.CreatePartition(ctx, &milvuspb.CreatePartitionRequest{
+			CollectionName: req.GetNewCollectionName(),
+			PartitionName:  partition.PartitionName,
+		})
+		if status.GetErrorCode() != commonpb.ErrorCode_Success {
+			return nil, fmt.Errorf("failed to create partition %s, reason: %s", partition.PartitionName, status.GetReason())
+		}
+	}
+
+	restored, err := c.meta.GetCollectionByName(ctx, req.GetNewCollectionName(), typeutil.MaxTimestamp)
+	if err != nil {
+		return nil, err
+	}
+	for _, field := range source.Fields {
+		restoredField, err := GetFieldSchemaByID(restored, field.FieldID)
+		if err != nil || restoredField.Name != field.Name {
+			return nil, fmt.Errorf("field %s is not restored with id %d", field.Name, field.FieldID)
+		}
+	}
+	partitionIDs := make(map[int64]int64, len(source.Partitions))
+	for _, partition := range source.Partitions {
+		pID, err := c.meta.GetPartitionByName(restored.CollectionID, partition.PartitionName, typeutil.MaxTimestamp)
+		if err != nil {
+			return nil, err
+		}
+		partitionIDs[partition.PartitionID] = pID
+	}
+	if len(source.VirtualChannelNames) != len(restored.VirtualChannelNames) {
+		return nil, fmt.Errorf("shards num of the restored collection mismatch, expected: %d, actual: %d",
+			len(source.VirtualChannelNames), len(restored.VirtualChannelNames))
+	}
+	vchannels := make(map[string]string, len(source.VirtualChannelNames))
+	for idx, vchannel := range source.VirtualChannelNames {
+		vchannels[vchannel] = restored.VirtualChannelNames[idx]
+	}
+
+	if err := c.restoreManager.updateTaskInfo(task.GetId(), func(ti *rootcoordpb.RestoreTaskInfo) {
+		ti.State = rootcoordpb.RestoreState_RestoringSegments
+		ti.CollectionId = restored.CollectionID
+	}); err != nil {
+		return nil, err
+	}
+	resp, err := c.broker.RestoreSegments(ctx, &datapb.RestoreSegmentsRequest{
+		Base: commonpbutil.NewMsgBase(
+			commonpbutil.WithSourceID(c.session.ServerID),
+		),
+		SourceCollectionID: source.CollectionID,
+		Timestamp:          req.GetTimestamp(),
+		CollectionID:       restored.CollectionID,
+		PartitionIDs:       partitionIDs,
+		Vchannels:          vchannels,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if resp.GetStatus().GetErrorCode() != commonpb.ErrorCode_Success {
+		return nil, errors.New(resp.GetStatus().GetReason())
+	}
+	return resp, nil
+}
+
 // ExpireCredCache will call invalidate credential cache
 func (c *Core) ExpireCredCache(ctx context.Context, username string) error {
 	req := proxypb.InvalidateCredCacheRequest{
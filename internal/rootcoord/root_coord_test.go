@@ -13,6 +13,7 @@ import (
 	"github.com/milvus-io/milvus-proto/go-api/commonpb"
 	"github.com/milvus-io/milvus-proto/go-api/milvuspb"
 	"github.com/milvus-io/milvus/internal/allocator"
+	"github.com/milvus-io/milvus/internal/common"
 	memkv "github.com/milvus-io/milvus/internal/kv/mem"
 	"github.com/milvus-io/milvus/internal/kv/mocks"
 	"github.com/milvus-io/milvus/internal/metastore/model"
@@ -31,6 +32,7 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"go.uber.org/atomic"
 )
 
 func TestRootCoord_CreateCollection(t *testing.T) {
@@ -1204,6 +1206,140 @@ func TestCore_ReportImport(t *testing.T) {
 	})
 }
 
+func TestCore_Export(t *testing.T) {
+	Params.RootCoordCfg.ExportTaskSubPath = "test_export_task"
+	meta := newMockMetaTable()
+	meta.GetCollectionByNameFunc = func(ctx context.Context, collectionName string, ts Timestamp) (*model.Collection, error) {
+		if collectionName == "c1" {
+			return &model.Collection{CollectionID: 100, Name: "c1"}, nil
+		}
+		return nil, errors.New("collection name not found")
+	}
+	meta.GetPartitionByNameFunc = func(collID UniqueID, partitionName string, ts Timestamp) (UniqueID, error) {
+		if partitionName == "p1" {
+			return 101, nil
+		}
+		return 0, errors.New("partition name not found")
+	}
+	tsoAllocator := newMockTsoAllocator()
+	tsoAllocator.GenerateTSOF = func(count uint32) (uint64, error) {
+		return 1000, nil
+	}
+
+	var sentTask *datapb.ExportTask
+	callExportServiceFn := func(ctx context.Context, req *datapb.ExportTaskRequest) (*datapb.ExportTaskResponse, error) {
+		sentTask = req.GetExportTask()
+		return &datapb.ExportTaskResponse{
+			Status:     succStatus(),
+			DatanodeId: 1,
+		}, nil
+	}
+	idAlloc := func(count uint32) (typeutil.UniqueID, typeutil.UniqueID, error) {
+		return 10, 0, nil
+	}
+	newCore := func(opts ...Opt) *Core {
+		c := newTestCore(append([]Opt{withHealthyCode(), withMeta(meta), withTsoAllocator(tsoAllocator)}, opts...)...)
+		c.exportManager = newExportManager(context.TODO(), memkv.NewMemoryKV(), idAlloc, callExportServiceFn)
+		return c
+	}
+
+	t.Run("not healthy", func(t *testing.T) {
+		c := newTestCore(withAbnormalCode())
+		resp, err := c.Export(context.TODO(), &rootcoordpb.ExportRequest{})
+		assert.NoError(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+
+		stateResp, err := c.GetExportState(context.TODO(), &rootcoordpb.GetExportStateRequest{})
+		assert.NoError(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, stateResp.GetStatus().GetErrorCode())
+
+		listResp, err := c.ListExportTasks(context.TODO(), &rootcoordpb.ListExportTasksRequest{})
+		assert.NoError(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, listResp.GetStatus().GetErrorCode())
+
+		status, err := c.ReportExport(context.TODO(), &rootcoordpb.ExportResult{})
+		assert.NoError(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, status.GetErrorCode())
+	})
+
+	t.Run("illegal request", func(t *testing.T) {
+		c := newCore()
+		reqs := []*rootcoordpb.ExportRequest{
+			{CollectionName: "c1", OutputPrefix: "export", Format: "csv"},
+			{CollectionName: "c1", Format: "json"},
+			{CollectionName: "c2", OutputPrefix: "export", Format: "json"},
+			{CollectionName: "c1", PartitionNames: []string{"p2"}, OutputPrefix: "export", Format: "json"},
+		}
+		for _, req := range reqs {
+			resp, err := c.Export(context.TODO(), req)
+			assert.NoError(t, err)
+			assert.NotEqual(t, commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+		}
+
+		c = newCore(withInvalidTsoAllocator())
+		resp, err := c.Export(context.TODO(), &rootcoordpb.ExportRequest{CollectionName: "c1", OutputPrefix: "export", Format: "json"})
+		assert.NoError(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+	})
+
+	t.Run("normal case", func(t *testing.T) {
+		c := newCore()
+		resp, err := c.Export(context.TODO(), &rootcoordpb.ExportRequest{
+			CollectionName: "c1",
+			PartitionNames: []string{"p1"},
+			OutputPrefix:   "export",
+			Format:         "parquet",
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+		assert.Equal(t, int64(10), resp.GetTaskId())
+		assert.Equal(t, uint64(1000), resp.GetTimestamp())
+		assert.Equal(t, int64(100), sentTask.GetCollectionId())
+		assert.Equal(t, []int64{101}, sentTask.GetPartitionIds())
+
+		stateResp, err := c.GetExportState(context.TODO(), &rootcoordpb.GetExportStateRequest{TaskId: 10})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, stateResp.GetStatus().GetErrorCode())
+		assert.Equal(t, rootcoordpb.ExportState_ExportStarted, stateResp.GetTask().GetState())
+
+		status, err := c.ReportExport(context.TODO(), &rootcoordpb.ExportResult{
+			TaskId:     10,
+			DatanodeId: 1,
+			State:      rootcoordpb.ExportState_ExportCompleted,
+			Files:      []string{"export/10/1.parquet"},
+			RowCount:   100,
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, status.GetErrorCode())
+
+		// the task has been finished
+		status, err = c.ReportExport(context.TODO(), &rootcoordpb.ExportResult{
+			TaskId: 10,
+			State:  rootcoordpb.ExportState_ExportFailed,
+		})
+		assert.NoError(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, status.GetErrorCode())
+
+		listResp, err := c.ListExportTasks(context.TODO(), &rootcoordpb.ListExportTasksRequest{CollectionName: "c1"})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, listResp.GetStatus().GetErrorCode())
+		assert.Equal(t, 1, len(listResp.GetTasks()))
+		assert.Equal(t, rootcoordpb.ExportState_ExportCompleted, listResp.GetTasks()[0].GetState())
+		assert.Equal(t, []string{"export/10/1.parquet"}, listResp.GetTasks()[0].GetFiles())
+
+		listResp, err = c.ListExportTasks(context.TODO(), &rootcoordpb.ListExportTasksRequest{CollectionName: "c2"})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_IllegalCollectionName, listResp.GetStatus().GetErrorCode())
+
+		mockTxnKV := &mocks.TxnKV{}
+		mockTxnKV.EXPECT().LoadWithPrefix(mock.Anything).Return(nil, nil, errors.New("mock error"))
+		c.exportManager.taskStore = mockTxnKV
+		listResp, err = c.ListExportTasks(context.TODO(), &rootcoordpb.ListExportTasksRequest{})
+		assert.NoError(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, listResp.GetStatus().GetErrorCode())
+	})
+}
+
 func TestCore_Rbac(t *testing.T) {
 	ctx := context.Background()
 	c := &Core{
@@ -1458,3 +1594,223 @@ func TestCore_Stop(t *testing.T) {
 		assert.Equal(t, commonpb.StateCode_Abnormal, code)
 	})
 }
+
+func TestRootCoord_RestoreCollection(t *testing.T) {
+	Params.RootCoordCfg.RestoreTaskSubPath = "test_restore_task"
+	const ts = Timestamp(1000)
+
+	newSource := func(collID UniqueID) *model.Collection {
+		return &model.Collection{
+			CollectionID: collID,
+			Name:         "coll",
+			ShardsNum:    1,
+			Fields: []*model.Field{
+				{FieldID: RowIDField, Name: RowIDFieldName},
+				{FieldID: StartOfUserFieldID, Name: "pk"},
+			},
+			Partitions: []*model.Partition{
+				{PartitionID: collID + 1, PartitionName: Params.CommonCfg.DefaultPartitionName, State: etcdpb.PartitionState_PartitionCreated},
+			},
+			VirtualChannelNames: []string{fmt.Sprintf("vchan_%d", collID)},
+			State:               etcdpb.CollectionState_CollectionCreated,
+		}
+	}
+	// created is set once the restored collection is created by the scheduler
+	newMeta := func(created *atomic.Bool) *mockMetaTable {
+		meta := newMockMetaTable()
+		// the name is reused by collection 200 after collection 100 was dropped
+		meta.ListCollectionsFunc = func(ctx context.Context, ts Timestamp) ([]*model.Collection, error) {
+			return []*model.Collection{newSource(100)}, nil
+		}
+		meta.GetCollectionByIDFunc = func(ctx context.Context, collectionID UniqueID, ts Timestamp) (*model.Collection, error) {
+			if collectionID == 100 {
+				return newSource(100), nil
+			}
+			return nil, common.NewCollectionNotExistError("not exist")
+		}
+		meta.GetCollectionByNameFunc = func(ctx context.Context, collectionName string, ts Timestamp) (*model.Collection, error) {
+			switch {
+			case collectionName == "coll":
+				return newSource(200), nil
+			case collectionName == "restored" && created.Load():
+				coll := newSource(300)
+				coll.Name = "restored"
+				return coll, nil
+			}
+			return nil, common.NewCollectionNotExistError("not exist")
+		}
+		meta.GetPartitionByNameFunc = func(collID UniqueID, partitionName string, ts Timestamp) (UniqueID, error) {
+			return collID + 1, nil
+		}
+		return meta
+	}
+	newScheduler := func(created, dropped *atomic.Bool) IScheduler {
+		sched := newMockScheduler()
+		sched.AddTaskFunc = func(t task) error {
+			switch t.(type) {
+			case *createCollectionTask:
+				created.Store(true)
+			case *dropCollectionTask:
+				dropped.Store(true)
+			}
+			t.NotifyDone(nil)
+			return nil
+		}
+		return sched
+	}
+	newBroker := func(sourceCollID *atomic.Int64, restoreErr error) *mockBroker {
+		broker := newMockBroker()
+		broker.RestoreSegmentsFunc = func(ctx context.Context, req *datapb.RestoreSegmentsRequest) (*datapb.RestoreSegmentsResponse, error) {
+			sourceCollID.Store(req.GetSourceCollectionID())
+			if restoreErr != nil {
+				return nil, restoreErr
+			}
+			return &datapb.RestoreSegmentsResponse{
+				Status:     succStatus(),
+				SegmentIDs: []int64{1, 2},
+				RowCount:   10,
+			}, nil
+		}
+		return broker
+	}
+	newCore := func(created, dropped *atomic.Bool, broker Broker) *Core {
+		c := newTestCore(withHealthyCode(),
+			withScheduler(newScheduler(created, dropped)),
+			withMeta(newMeta(created)),
+			withBroker(broker))
+		c.restoreManager = newRestoreManager(context.TODO(), memkv.NewMemoryKV(), newTestExportIDAllocator())
+		return c
+	}
+	waitFinished := func(t *testing.T, c *Core, taskID int64) *rootcoordpb.RestoreTaskInfo {
+		var task *rootcoordpb.RestoreTaskInfo
+		assert.Eventually(t, func() bool {
+			resp, err := c.GetRestoreState(context.TODO(), &rootcoordpb.GetRestoreStateRequest{TaskId: taskID})
+			assert.NoError(t, err)
+			assert.Equal(t, commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+			task = resp.GetTask()
+			return restoreTaskFinished(task)
+		}, 5*time.Second, 10*time.Millisecond)
+		return task
+	}
+
+	t.Run("not healthy", func(t *testing.T) {
+		c := newTestCore(withAbnormalCode())
+		resp, err := c.RestoreCollection(context.Background(), &rootcoordpb.RestoreCollectionRequest{})
+		assert.NoError(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+
+		stateResp, err := c.GetRestoreState(context.Background(), &rootcoordpb.GetRestoreStateRequest{})
+		assert.NoError(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, stateResp.GetStatus().GetErrorCode())
+	})
+
+	t.Run("invalid arguments", func(t *testing.T) {
+		c := newTestCore(withHealthyCode())
+		resp, err := c.RestoreCollection(context.Background(), &rootcoordpb.RestoreCollectionRequest{
+			CollectionName:    "coll",
+			NewCollectionName: "restored",
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_IllegalArgument, resp.GetStatus().GetErrorCode())
+
+		resp, err = c.RestoreCollection(context.Background(), &rootcoordpb.RestoreCollectionRequest{
+			CollectionName: "coll",
+			Timestamp:      ts,
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_IllegalArgument, resp.GetStatus().GetErrorCode())
+	})
+
+	t.Run("collection not exist at the timestamp", func(t *testing.T) {
+		c := newCore(atomic.NewBool(false), atomic.NewBool(false), newMockBroker())
+		resp, err := c.RestoreCollection(context.Background(), &rootcoordpb.RestoreCollectionRequest{
+			CollectionName:    "not_exist",
+			NewCollectionName: "restored",
+			Timestamp:         ts,
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_IllegalCollectionName, resp.GetStatus().GetErrorCode())
+
+		resp, err = c.RestoreCollection(context.Background(), &rootcoordpb.RestoreCollectionRequest{
+			CollectionID:      200,
+			NewCollectionName: "restored",
+			Timestamp:         ts,
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_IllegalCollectionName, resp.GetStatus().GetErrorCode())
+	})
+
+	t.Run("restored collection already exists", func(t *testing.T) {
+		c := newCore(atomic.NewBool(true), atomic.NewBool(false), newMockBroker())
+		resp, err := c.RestoreCollection(context.Background(), &rootcoordpb.RestoreCollectionRequest{
+			CollectionName:    "coll",
+			NewCollectionName: "restored",
+			Timestamp:         ts,
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_IllegalCollectionName, resp.GetStatus().GetErrorCode())
+	})
+
+	t.Run("reused name is resolved at the timestamp", func(t *testing.T) {
+		sourceCollID := atomic.NewInt64(0)
+		dropped := atomic.NewBool(false)
+		c := newCore(atomic.NewBool(false), dropped, newBroker(sourceCollID, nil))
+		resp, err := c.RestoreCollection(context.Background(), &rootcoordpb.RestoreCollectionRequest{
+			CollectionName:    "coll",
+			NewCollectionName: "restored",
+			Timestamp:         ts,
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+
+		task := waitFinished(t, c, resp.GetTaskId())
+		assert.Equal(t, rootcoordpb.RestoreState_RestoreCompleted, task.GetState())
+		assert.Equal(t, int64(100), sourceCollID.Load())
+		assert.Equal(t, int64(100), task.GetSourceCollectionId())
+		assert.Equal(t, int64(300), task.GetCollectionId())
+		assert.ElementsMatch(t, []int64{1, 2}, task.GetSegmentIds())
+		assert.Equal(t, int64(10), task.GetRowCount())
+		assert.False(t, dropped.Load())
+	})
+
+	t.Run("restore by collection id", func(t *testing.T) {
+		sourceCollID := atomic.NewInt64(0)
+		c := newCore(atomic.NewBool(false), atomic.NewBool(false), newBroker(sourceCollID, nil))
+		resp, err := c.RestoreCollection(context.Background(), &rootcoordpb.RestoreCollectionRequest{
+			CollectionName:    "coll",
+			CollectionID:      100,
+			NewCollectionName: "restored",
+			Timestamp:         ts,
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+
+		task := waitFinished(t, c, resp.GetTaskId())
+		assert.Equal(t, rootcoordpb.RestoreState_RestoreCompleted, task.GetState())
+		assert.Equal(t, int64(100), sourceCollID.Load())
+	})
+
+	t.Run("failed to restore segments", func(t *testing.T) {
+		dropped := atomic.NewBool(false)
+		c := newCore(atomic.NewBool(false), dropped, newBroker(atomic.NewInt64(0), errors.New("mock")))
+		resp, err := c.RestoreCollection(context.Background(), &rootcoordpb.RestoreCollectionRequest{
+			CollectionName:    "coll",
+			NewCollectionName: "restored",
+			Timestamp:         ts,
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+
+		task := waitFinished(t, c, resp.GetTaskId())
+		assert.Equal(t, rootcoordpb.RestoreState_RestoreFailed, task.GetState())
+		assert.NotEmpty(t, task.GetErrorMessage())
+		assert.True(t, dropped.Load())
+	})
+
+	t.Run("task not exist", func(t *testing.T) {
+		c := newCore(atomic.NewBool(false), atomic.NewBool(false), newMockBroker())
+		resp, err := c.GetRestoreState(context.Background(), &rootcoordpb.GetRestoreStateRequest{TaskId: 1})
+		assert.NoError(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+	})
+}
@@ -19,6 +19,7 @@ package rootcoord
 import (
 	"encoding/json"
 	"fmt"
+	"sort"
 
 	"github.com/milvus-io/milvus/internal/log"
 	"go.uber.org/zap"
@@ -28,6 +29,7 @@ import (
 	"github.com/milvus-io/milvus/internal/metastore/model"
 
 	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/schemapb"
 	"github.com/milvus-io/milvus/internal/mq/msgstream"
 	"github.com/milvus-io/milvus/internal/util/typeutil"
 )
@@ -137,3 +139,27 @@ func getTravelTs(req TimeTravelRequest) Timestamp {
 func isMaxTs(ts Timestamp) bool {
 	return ts == typeutil.MaxTimestamp
 }
+
+// restoreSchema rebuilds the schema a collection was created with. The user fields are kept in the order of their
+// ids so that a collection created with the schema gets the same field ids, which the binlogs are keyed by.
+func restoreSchema(coll *model.Collection) (*schemapb.CollectionSchema, error) {
+	fields := make([]*model.Field, 0, len(coll.Fields))
+	for _, field := range coll.Fields {
+		if field.FieldID >= StartOfUserFieldID {
+			fields = append(fields, field)
+		}
+	}
+	sort.Slice(fields, func(i, j int) bool { return fields[i].FieldID < fields[j].FieldID })
+	for idx, field := range fields {
+		if field.FieldID != int64(idx+StartOfUserFieldID) {
+			return nil, fmt.Errorf("field ids of collection %s are not contiguous, field %s has id %d",
+				coll.Name, field.Name, field.FieldID)
+		}
+	}
+	return &schemapb.CollectionSchema{
+		Name:        coll.Name,
+		Description: coll.Description,
+		AutoID:      coll.AutoID,
+		Fields:      model.MarshalFieldModels(fields),
+	}, nil
+}
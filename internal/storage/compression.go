@@ -0,0 +1,92 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/milvus-io/milvus/internal/util/compressor"
+)
+
+// compressionKey is the descriptor event extra recording the codec of the payload.
+const compressionKey = "compression"
+
+// zstdMagic is the magic number leading every zstd frame.
+var zstdMagic = []byte{0x28, 0xb5, 0x2f, 0xfd}
+
+// CodecOption is used to config the codecs.
+type CodecOption func(*codecConfig)
+
+type codecConfig struct {
+	compressType compressor.CompressType
+}
+
+func newCodecConfig(opts ...CodecOption) codecConfig {
+	c := codecConfig{}
+	for _, opt := range opts {
+		opt(&c)
+	}
+	return c
+}
+
+// WithCompression sets the compression codec of the serialized blobs, empty means no compression.
+func WithCompression(compressType compressor.CompressType) CodecOption {
+	return func(c *codecConfig) {
+		c.compressType = compressType
+	}
+}
+
+func compressBytes(compressType compressor.CompressType, src []byte) ([]byte, error) {
+	switch compressType {
+	case compressor.CompressTypeZstd:
+		return compressor.ZstdCompressBytes(src, nil), nil
+	default:
+		return nil, fmt.Errorf("unsupported compression type: %s", compressType)
+	}
+}
+
+func decompressBytes(compressType compressor.CompressType, src []byte) ([]byte, error) {
+	switch compressType {
+	case compressor.CompressTypeZstd:
+		return compressor.ZstdDecompressBytes(src, nil)
+	default:
+		return nil, fmt.Errorf("unsupported compression type: %s", compressType)
+	}
+}
+
+// getCompressType returns the codec recorded in descriptor event @extras, empty if not compressed.
+func getCompressType(extras map[string]interface{}) (compressor.CompressType, error) {
+	v, ok := extras[compressionKey]
+	if !ok {
+		return "", nil
+	}
+	s, ok := v.(string)
+	if !ok {
+		return "", fmt.Errorf("value of %v must in string format", compressionKey)
+	}
+	return compressor.CompressType(s), nil
+}
+
+// maybeDecompressStats decompresses stats blob written with compression,
+// stats blobs have no descriptor event so the codec is detected by the frame magic.
+func maybeDecompressStats(buffer []byte) ([]byte, error) {
+	if bytes.HasPrefix(buffer, zstdMagic) {
+		return decompressBytes(compressor.CompressTypeZstd, buffer)
+	}
+	return buffer, nil
+}
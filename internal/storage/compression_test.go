@@ -0,0 +1,76 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus-proto/go-api/schemapb"
+	"github.com/milvus-io/milvus/internal/common"
+	"github.com/milvus-io/milvus/internal/util/compressor"
+)
+
+func TestCompressBytes(t *testing.T) {
+	src := []byte("compressible compressible compressible compressible")
+	compressed, err := compressBytes(compressor.CompressTypeZstd, src)
+	assert.NoError(t, err)
+	decompressed, err := decompressBytes(compressor.CompressTypeZstd, compressed)
+	assert.NoError(t, err)
+	assert.Equal(t, src, decompressed)
+
+	_, err = compressBytes("unknown", src)
+	assert.Error(t, err)
+	_, err = decompressBytes("unknown", compressed)
+	assert.Error(t, err)
+	_, err = decompressBytes(compressor.CompressTypeZstd, src)
+	assert.Error(t, err)
+}
+
+func TestGetCompressType(t *testing.T) {
+	compressType, err := getCompressType(map[string]interface{}{})
+	assert.NoError(t, err)
+	assert.Equal(t, compressor.CompressType(""), compressType)
+
+	compressType, err = getCompressType(map[string]interface{}{compressionKey: "zstd"})
+	assert.NoError(t, err)
+	assert.Equal(t, compressor.CompressTypeZstd, compressType)
+
+	_, err = getCompressType(map[string]interface{}{compressionKey: 1})
+	assert.Error(t, err)
+}
+
+func TestDeserializeCompressedStats(t *testing.T) {
+	sw := &StatsWriter{}
+	err := sw.GeneratePrimaryKeyStats(common.RowIDField, schemapb.DataType_Int64, &Int64FieldData{Data: []int64{1, 2, 3}})
+	assert.NoError(t, err)
+	compressed, err := compressBytes(compressor.CompressTypeZstd, sw.GetBuffer())
+	assert.NoError(t, err)
+
+	// compressed and uncompressed stats could be mixed
+	stats, err := DeserializeStats([]*Blob{{Value: compressed}, {Value: sw.GetBuffer()}})
+	assert.NoError(t, err)
+	assert.Equal(t, 2, len(stats))
+	for _, stat := range stats {
+		assert.True(t, stat.MinPk.EQ(&Int64PrimaryKey{Value: 1}))
+		assert.True(t, stat.MaxPk.EQ(&Int64PrimaryKey{Value: 3}))
+	}
+
+	_, err = DeserializeStats([]*Blob{{Value: append(zstdMagic, 1, 2, 3)}})
+	assert.Error(t, err)
+}
@@ -17,6 +17,7 @@
 package storage
 
 import (
+	"bytes"
 	"encoding/binary"
 	"encoding/json"
 	"errors"
@@ -27,12 +28,14 @@ import (
 	"strings"
 	"time"
 
+	"github.com/golang/protobuf/proto"
 	"go.uber.org/zap"
 
 	"github.com/milvus-io/milvus-proto/go-api/schemapb"
 	"github.com/milvus-io/milvus/internal/common"
 	"github.com/milvus-io/milvus/internal/log"
 	"github.com/milvus-io/milvus/internal/proto/etcdpb"
+	"github.com/milvus-io/milvus/internal/util/compressor"
 	"github.com/milvus-io/milvus/internal/util/typeutil"
 )
 
@@ -145,6 +148,15 @@ type StringFieldData struct {
 	NumRows []int64
 	Data    []string
 }
+type JSONFieldData struct {
+	NumRows []int64
+	Data    [][]byte
+}
+type ArrayFieldData struct {
+	ElementType schemapb.DataType
+	NumRows     []int64
+	Data        []*schemapb.ScalarField
+}
 type BinaryVectorFieldData struct {
 	NumRows []int64
 	Data    []byte
@@ -165,6 +177,8 @@ func (data *Int64FieldData) RowNum() int        { return len(data.Data) }
 func (data *FloatFieldData) RowNum() int        { return len(data.Data) }
 func (data *DoubleFieldData) RowNum() int       { return len(data.Data) }
 func (data *StringFieldData) RowNum() int       { return len(data.Data) }
+func (data *JSONFieldData) RowNum() int         { return len(data.Data) }
+func (data *ArrayFieldData) RowNum() int        { return len(data.Data) }
 func (data *BinaryVectorFieldData) RowNum() int { return len(data.Data) * 8 / data.Dim }
 func (data *FloatVectorFieldData) RowNum() int  { return len(data.Data) / data.Dim }
 
@@ -177,6 +191,8 @@ func (data *Int64FieldData) GetRow(i int) interface{}  { return data.Data[i] }
 func (data *FloatFieldData) GetRow(i int) interface{}  { return data.Data[i] }
 func (data *DoubleFieldData) GetRow(i int) interface{} { return data.Data[i] }
 func (data *StringFieldData) GetRow(i int) interface{} { return data.Data[i] }
+func (data *JSONFieldData) GetRow(i int) interface{}   { return data.Data[i] }
+func (data *ArrayFieldData) GetRow(i int) interface{}  { return data.Data[i] }
 func (data *BinaryVectorFieldData) GetRow(i int) interface{} {
 	return data.Data[i*data.Dim/8 : (i+1)*data.Dim/8]
 }
@@ -226,6 +242,22 @@ func (data *StringFieldData) GetMemorySize() int {
 	return binary.Size(data.NumRows) + binary.Size(data.Data)
 }
 
+func (data *JSONFieldData) GetMemorySize() int {
+	size := binary.Size(data.NumRows)
+	for _, val := range data.Data {
+		size += len(val)
+	}
+	return size
+}
+
+func (data *ArrayFieldData) GetMemorySize() int {
+	size := binary.Size(data.NumRows) + binary.Size(data.ElementType)
+	for _, val := range data.Data {
+		size += proto.Size(val)
+	}
+	return size
+}
+
 func (data *BinaryVectorFieldData) GetMemorySize() int {
 	return binary.Size(data.NumRows) + binary.Size(data.Data) + binary.Size(data.Dim)
 }
@@ -260,11 +292,29 @@ type InsertData struct {
 // ${tenant}/insert_log/${collection_id}/${partition_id}/${segment_id}/${field_id}/${log_idx}
 type InsertCodec struct {
 	Schema *etcdpb.CollectionMeta
+	config codecConfig
 }
 
 // NewInsertCodec creates an InsertCodec with provided collection meta
-func NewInsertCodec(schema *etcdpb.CollectionMeta) *InsertCodec {
-	return &InsertCodec{Schema: schema}
+func NewInsertCodec(schema *etcdpb.CollectionMeta, opts ...CodecOption) *InsertCodec {
+	return &InsertCodec{Schema: schema, config: newCodecConfig(opts...)}
+}
+
+// getElementType returns the element type of an array field, DataType_None is returned if it's unknown.
+func (insertCodec *InsertCodec) getElementType(fieldID FieldID) schemapb.DataType {
+	if insertCodec.Schema == nil {
+		return schemapb.DataType_None
+	}
+	for _, field := range insertCodec.Schema.GetSchema().GetFields() {
+		if field.GetFieldID() == fieldID {
+			elementType, err := typeutil.GetElementType(field)
+			if err != nil {
+				return schemapb.DataType_None
+			}
+			return elementType
+		}
+	}
+	return schemapb.DataType_None
 }
 
 // Serialize transfer insert data to blob. It will sort insert data by timestamp.
@@ -386,6 +436,26 @@ func (insertCodec *InsertCodec) Serialize(partitionID UniqueID, segmentID Unique
 				}
 			}
 			writer.AddExtra(originalSizeKey, fmt.Sprintf("%v", singleData.(*StringFieldData).GetMemorySize()))
+		case typeutil.DataTypeJSON:
+			for _, singleJSON := range singleData.(*JSONFieldData).Data {
+				err = eventWriter.AddOneJSONToPayload(singleJSON)
+				if err != nil {
+					eventWriter.Close()
+					writer.Close()
+					return nil, nil, err
+				}
+			}
+			writer.AddExtra(originalSizeKey, fmt.Sprintf("%v", singleData.(*JSONFieldData).GetMemorySize()))
+		case typeutil.DataTypeArray:
+			for _, singleArray := range singleData.(*ArrayFieldData).Data {
+				err = eventWriter.AddOneArrayToPayload(singleArray)
+				if err != nil {
+					eventWriter.Close()
+					writer.Close()
+					return nil, nil, err
+				}
+			}
+			writer.AddExtra(originalSizeKey, fmt.Sprintf("%v", singleData.(*ArrayFieldData).GetMemorySize()))
 		case schemapb.DataType_BinaryVector:
 			err = eventWriter.AddBinaryVectorToPayload(singleData.(*BinaryVectorFieldData).Data, singleData.(*BinaryVectorFieldData).Dim)
 			if err != nil {
@@ -439,6 +509,12 @@ func (insertCodec *InsertCodec) Serialize(partitionID UniqueID, segmentID Unique
 				return nil, nil, err
 			}
 			statsBuffer := statsWriter.GetBuffer()
+			if compressType := insertCodec.config.compressType; compressType != "" {
+				statsBuffer, err = compressBytes(compressType, statsBuffer)
+				if err != nil {
+					return nil, nil, err
+				}
+			}
 			statsBlobs = append(statsBlobs, &Blob{
 				Key:   blobKey,
 				Value: statsBuffer,
@@ -670,6 +746,49 @@ func (insertCodec *InsertCodec) DeserializeInto(fieldBinlogs []*Blob, rowNum int
 				stringFieldData.NumRows = append(stringFieldData.NumRows, int64(len(stringPayload)))
 				insertData.Data[fieldID] = stringFieldData
 
+			case typeutil.DataTypeJSON:
+				jsonPayload, err := eventReader.GetJSONFromPayload()
+				if err != nil {
+					eventReader.Close()
+					binlogReader.Close()
+					return InvalidUniqueID, InvalidUniqueID, InvalidUniqueID, err
+				}
+
+				if insertData.Data[fieldID] == nil {
+					insertData.Data[fieldID] = &JSONFieldData{
+						NumRows: make([]int64, 0),
+						Data:    make([][]byte, 0, rowNum),
+					}
+				}
+				jsonFieldData := insertData.Data[fieldID].(*JSONFieldData)
+
+				jsonFieldData.Data = append(jsonFieldData.Data, jsonPayload...)
+				totalLength += len(jsonPayload)
+				jsonFieldData.NumRows = append(jsonFieldData.NumRows, int64(len(jsonPayload)))
+				insertData.Data[fieldID] = jsonFieldData
+
+			case typeutil.DataTypeArray:
+				arrayPayload, err := eventReader.GetArrayFromPayload()
+				if err != nil {
+					eventReader.Close()
+					binlogReader.Close()
+					return InvalidUniqueID, InvalidUniqueID, InvalidUniqueID, err
+				}
+
+				if insertData.Data[fieldID] == nil {
+					insertData.Data[fieldID] = &ArrayFieldData{
+						ElementType: insertCodec.getElementType(fieldID),
+						NumRows:     make([]int64, 0),
+						Data:        make([]*schemapb.ScalarField, 0, rowNum),
+					}
+				}
+				arrayFieldData := insertData.Data[fieldID].(*ArrayFieldData)
+
+				arrayFieldData.Data = append(arrayFieldData.Data, arrayPayload...)
+				totalLength += len(arrayPayload)
+				arrayFieldData.NumRows = append(arrayFieldData.NumRows, int64(len(arrayPayload)))
+				insertData.Data[fieldID] = arrayFieldData
+
 			case schemapb.DataType_BinaryVector:
 				var singleData []byte
 				singleData, dim, err = eventReader.GetBinaryVectorFromPayload()
@@ -826,17 +945,24 @@ func (data *DeleteData) Append(pk PrimaryKey, ts Timestamp) {
 
 // DeleteCodec serializes and deserializes the delete data
 type DeleteCodec struct {
+	config codecConfig
 }
 
 // NewDeleteCodec returns a DeleteCodec
-func NewDeleteCodec() *DeleteCodec {
-	return &DeleteCodec{}
+func NewDeleteCodec(opts ...CodecOption) *DeleteCodec {
+	return &DeleteCodec{config: newCodecConfig(opts...)}
 }
 
 // Serialize transfer delete data to blob. .
 // For each delete message, it will save "pk,ts" string to binlog.
+// If compression is enabled, the delete messages are joined by newline and saved as one compressed byte payload.
 func (deleteCodec *DeleteCodec) Serialize(collectionID UniqueID, partitionID UniqueID, segmentID UniqueID, data *DeleteData) (*Blob, error) {
-	binlogWriter := NewDeleteBinlogWriter(schemapb.DataType_String, collectionID, partitionID, segmentID)
+	compressType := deleteCodec.config.compressType
+	payloadDataType := schemapb.DataType_String
+	if compressType != "" {
+		payloadDataType = schemapb.DataType_Int8
+	}
+	binlogWriter := NewDeleteBinlogWriter(payloadDataType, collectionID, partitionID, segmentID)
 	eventWriter, err := binlogWriter.NextDeleteEventWriter()
 	if err != nil {
 		binlogWriter.Close()
@@ -855,6 +981,7 @@ func (deleteCodec *DeleteCodec) Serialize(collectionID UniqueID, partitionID Uni
 	sizeTotal := 0
 	var startTs, endTs Timestamp
 	startTs, endTs = math.MaxUint64, 0
+	var joined bytes.Buffer
 	for i := 0; i < length; i++ {
 		ts := data.Tss[i]
 		if ts < startTs {
@@ -869,11 +996,28 @@ func (deleteCodec *DeleteCodec) Serialize(collectionID UniqueID, partitionID Uni
 		if err != nil {
 			return nil, err
 		}
-		err = eventWriter.AddOneStringToPayload(string(serializedPayload))
+		if compressType != "" {
+			if i > 0 {
+				joined.WriteByte('\n')
+			}
+			joined.Write(serializedPayload)
+		} else {
+			err = eventWriter.AddOneStringToPayload(string(serializedPayload))
+			if err != nil {
+				return nil, err
+			}
+		}
+		sizeTotal += binary.Size(serializedPayload)
+	}
+	if compressType != "" {
+		compressed, err := compressBytes(compressType, joined.Bytes())
 		if err != nil {
 			return nil, err
 		}
-		sizeTotal += binary.Size(serializedPayload)
+		if err = eventWriter.AddByteToPayload(compressed); err != nil {
+			return nil, err
+		}
+		binlogWriter.AddExtra(compressionKey, string(compressType))
 	}
 	eventWriter.SetEventTimestamp(startTs, endTs)
 	binlogWriter.SetEventTimeStamp(startTs, endTs)
@@ -919,7 +1063,7 @@ func (deleteCodec *DeleteCodec) Deserialize(blobs []*Blob) (partitionID UniqueID
 			return InvalidUniqueID, InvalidUniqueID, nil, err
 		}
 
-		stringArray, err := eventReader.GetStringFromPayload()
+		stringArray, err := ReadDeleteLogStrings(binlogReader, eventReader)
 		if err != nil {
 			eventReader.Close()
 			binlogReader.Close()
@@ -967,6 +1111,30 @@ func (deleteCodec *DeleteCodec) Deserialize(blobs []*Blob) (partitionID UniqueID
 	return pid, sid, result, nil
 }
 
+// ReadDeleteLogStrings reads the serialized delete messages of a deltalog event,
+// decompressing the payload if the deltalog is written with compression.
+func ReadDeleteLogStrings(binlogReader *BinlogReader, eventReader *EventReader) ([]string, error) {
+	compressType, err := getCompressType(binlogReader.descriptorEvent.Extras)
+	if err != nil {
+		return nil, err
+	}
+	if compressType == "" {
+		return eventReader.GetStringFromPayload()
+	}
+	content, err := eventReader.GetByteFromPayload()
+	if err != nil {
+		return nil, err
+	}
+	content, err = decompressBytes(compressType, content)
+	if err != nil {
+		return nil, err
+	}
+	if len(content) == 0 {
+		return nil, nil
+	}
+	return strings.Split(string(content), "\n"), nil
+}
+
 // DataDefinitionCodec serializes and deserializes the data definition
 // Blob key example:
 // ${tenant}/data_definition_log/${collection_id}/ts/${log_idx}
@@ -1161,12 +1329,15 @@ func (dataDefinitionCodec *DataDefinitionCodec) Deserialize(blobs []*Blob) (ts [
 	return resultTs, requestsStrings, nil
 }
 
+// IndexFileBinlogCodec serializes and deserializes the index files and the index params file, all of them are
+// compressed if compression is enabled. DiskANN index files are uploaded by segcore and never pass this codec.
 type IndexFileBinlogCodec struct {
+	config codecConfig
 }
 
 // NewIndexFileBinlogCodec is constructor for IndexFileBinlogCodec
-func NewIndexFileBinlogCodec() *IndexFileBinlogCodec {
-	return &IndexFileBinlogCodec{}
+func NewIndexFileBinlogCodec(opts ...CodecOption) *IndexFileBinlogCodec {
+	return &IndexFileBinlogCodec{config: newCodecConfig(opts...)}
 }
 
 func (codec *IndexFileBinlogCodec) serializeImpl(
@@ -1181,10 +1352,21 @@ func (codec *IndexFileBinlogCodec) serializeImpl(
 	key string,
 	value []byte,
 	ts Timestamp,
+	compressType compressor.CompressType,
 ) (*Blob, error) {
 	writer := NewIndexFileBinlogWriter(indexBuildID, version, collectionID, partitionID, segmentID, fieldID, indexName, indexID, key)
 	defer writer.Close()
 
+	originalSize := len(value)
+	if compressType != "" {
+		compressed, err := compressBytes(compressType, value)
+		if err != nil {
+			return nil, err
+		}
+		value = compressed
+		writer.AddExtra(compressionKey, string(compressType))
+	}
+
 	eventWriter, err := writer.NextIndexFileEventWriter()
 	if err != nil {
 		return nil, err
@@ -1202,7 +1384,7 @@ func (codec *IndexFileBinlogCodec) serializeImpl(
 
 	// https://github.com/milvus-io/milvus/issues/9620
 	// len(params) is also not accurate, indexParams is a map
-	writer.AddExtra(originalSizeKey, fmt.Sprintf("%v", len(value)))
+	writer.AddExtra(originalSizeKey, fmt.Sprintf("%v", originalSize))
 
 	err = writer.Finish()
 	if err != nil {
@@ -1236,7 +1418,7 @@ func (codec *IndexFileBinlogCodec) SerializeIndexParams(
 	// save index params.
 	// querycoord will parse index extra info from binlog, better to let this key appear first.
 	params, _ := json.Marshal(indexParams)
-	indexParamBlob, err := codec.serializeImpl(indexBuildID, version, collectionID, partitionID, segmentID, fieldID, indexName, indexID, IndexParamsKey, params, ts)
+	indexParamBlob, err := codec.serializeImpl(indexBuildID, version, collectionID, partitionID, segmentID, fieldID, indexName, indexID, IndexParamsKey, params, ts, codec.config.compressType)
 	if err != nil {
 		return nil, err
 	}
@@ -1272,7 +1454,7 @@ func (codec *IndexFileBinlogCodec) Serialize(
 	blobs = append(blobs, indexParamBlob)
 
 	for pos := range datas {
-		blob, err := codec.serializeImpl(indexBuildID, version, collectionID, partitionID, segmentID, fieldID, indexName, indexID, datas[pos].Key, datas[pos].Value, ts)
+		blob, err := codec.serializeImpl(indexBuildID, version, collectionID, partitionID, segmentID, fieldID, indexName, indexID, datas[pos].Key, datas[pos].Value, ts, codec.config.compressType)
 		if err != nil {
 			return nil, err
 		}
@@ -1339,6 +1521,12 @@ func (codec *IndexFileBinlogCodec) DeserializeImpl(blobs []*Blob) (
 
 		key := extra["key"].(string)
 
+		compressType, err := getCompressType(extra)
+		if err != nil {
+			binlogReader.Close()
+			return 0, 0, 0, 0, 0, 0, nil, "", 0, nil, err
+		}
+
 		for {
 			eventReader, err := binlogReader.NextEventReader()
 			if err != nil {
@@ -1360,6 +1548,16 @@ func (codec *IndexFileBinlogCodec) DeserializeImpl(blobs []*Blob) (
 					binlogReader.Close()
 					return 0, 0, 0, 0, 0, 0, nil, "", 0, nil, err
 				}
+				if compressType != "" {
+					content, err = decompressBytes(compressType, content)
+					if err != nil {
+						log.Warn("failed to decompress index file",
+							zap.String("key", key), zap.Error(err))
+						eventReader.Close()
+						binlogReader.Close()
+						return 0, 0, 0, 0, 0, 0, nil, "", 0, nil, err
+					}
+				}
 
 				if key == IndexParamsKey {
 					_ = json.Unmarshal(content, &indexParams)
@@ -21,6 +21,7 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/milvus-io/milvus/internal/util/compressor"
 	"github.com/milvus-io/milvus/internal/util/funcutil"
 	"github.com/milvus-io/milvus/internal/util/uniquegenerator"
 
@@ -385,6 +386,31 @@ func TestDeleteCodec(t *testing.T) {
 	})
 }
 
+func TestDeleteCodecCompression(t *testing.T) {
+	deleteCodec := NewDeleteCodec(WithCompression(compressor.CompressTypeZstd))
+	deleteData := &DeleteData{}
+	deleteData.Append(NewVarCharPrimaryKey("test1"), 43757345)
+	deleteData.Append(&Int64PrimaryKey{Value: 2}, 23578294723)
+	blob, err := deleteCodec.Serialize(CollectionID, 1, 1, deleteData)
+	assert.Nil(t, err)
+
+	binlogReader, err := NewBinlogReader(blob.Value)
+	assert.Nil(t, err)
+	assert.Equal(t, schemapb.DataType_Int8, binlogReader.PayloadDataType)
+	assert.Equal(t, string(compressor.CompressTypeZstd), binlogReader.descriptorEvent.Extras[compressionKey])
+	binlogReader.Close()
+
+	// readers detect the codec, no matter how the codec is configured
+	pid, sid, data, err := NewDeleteCodec().Deserialize([]*Blob{blob})
+	assert.Nil(t, err)
+	assert.Equal(t, pid, int64(1))
+	assert.Equal(t, sid, int64(1))
+	assert.Equal(t, deleteData, data)
+
+	_, err = NewDeleteCodec(WithCompression("unknown")).Serialize(CollectionID, 1, 1, deleteData)
+	assert.Error(t, err)
+}
+
 func TestUpgradeDeleteLog(t *testing.T) {
 	binlogWriter := NewDeleteBinlogWriter(schemapb.DataType_String, CollectionID, 1, 1)
 	eventWriter, err := binlogWriter.NextDeleteEventWriter()
@@ -515,6 +541,34 @@ func TestIndexFileBinlogCodec(t *testing.T) {
 	assert.NotNil(t, err)
 }
 
+func TestIndexFileBinlogCodecCompression(t *testing.T) {
+	indexParams := map[string]string{"index_type": "IVF_FLAT", "nlist": "128"}
+	datas := []*Blob{
+		{
+			Key:   "ivf1",
+			Value: []byte{1, 2, 3},
+		},
+	}
+
+	codec := NewIndexFileBinlogCodec(WithCompression(compressor.CompressTypeZstd))
+	serializedBlobs, err := codec.Serialize(1, 1, CollectionID, PartitionID, SegmentID, 100, indexParams, "index", 1, datas)
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(serializedBlobs))
+
+	// both the index params file and the index files are compressed
+	for _, blob := range serializedBlobs {
+		binlogReader, err := NewBinlogReader(blob.Value)
+		assert.Nil(t, err)
+		assert.Equal(t, string(compressor.CompressTypeZstd), binlogReader.descriptorEvent.Extras[compressionKey])
+		binlogReader.Close()
+	}
+
+	blobs, params, _, _, err := NewIndexFileBinlogCodec().Deserialize(serializedBlobs)
+	assert.Nil(t, err)
+	assert.Equal(t, indexParams, params)
+	assert.ElementsMatch(t, datas, blobs)
+}
+
 func TestIndexFileBinlogCodecError(t *testing.T) {
 	var err error
 
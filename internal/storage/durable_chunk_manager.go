@@ -0,0 +1,478 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"hash/crc32"
+	"io"
+	"io/ioutil"
+	"os"
+	"path"
+	"strings"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+	"golang.org/x/exp/mmap"
+
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/util/errorutil"
+)
+
+const (
+	// checksumFileSuffix is appended to an object path to name its checksum sidecar.
+	checksumFileSuffix = ".crc32c"
+	// tempFileSuffix marks files that are still being written and not yet renamed into place.
+	tempFileSuffix = ".writing"
+)
+
+var (
+	// ErrChecksumMismatch is returned when the content of an object does not match its recorded checksum.
+	ErrChecksumMismatch = errors.New("checksum mismatch")
+	// ErrChecksumMissing is reported by Verify for objects without a checksum sidecar.
+	ErrChecksumMissing = errors.New("checksum missing")
+
+	crc32cTable = crc32.MakeTable(crc32.Castagnoli)
+)
+
+// objectStat identifies a version of a file on disk.
+type objectStat struct {
+	size    int64
+	modTime time.Time
+}
+
+// DurableChunkManager is a LocalChunkManager hardened against crashes and silent corruption.
+// Objects are written to a temporary file, fsynced and renamed into place, and a CRC32C
+// sidecar is kept next to each object. The checksum is verified before content is served.
+// Objects without a sidecar, such as those written by LocalChunkManager, are served unverified.
+//
+// The old sidecar is removed before the object is renamed into place and the new sidecar is
+// committed after it, so a crash in between leaves an object without checksum, which is served
+// unverified, instead of an object next to the checksum of another version.
+type DurableChunkManager struct {
+	*LocalChunkManager
+
+	// verified caches objects whose checksum was checked, so that repeated ReadAt and Mmap
+	// calls do not re-hash the whole file.
+	verified sync.Map // absolute path -> objectStat
+}
+
+var _ ChunkManager = (*DurableChunkManager)(nil)
+
+// NewDurableChunkManager creates a new durable local chunk manager.
+func NewDurableChunkManager(opts ...Option) *DurableChunkManager {
+	return &DurableChunkManager{
+		LocalChunkManager: NewLocalChunkManager(opts...),
+	}
+}
+
+func (dcm *DurableChunkManager) absPath(filePath string) string {
+	return path.Join(dcm.localPath, filePath)
+}
+
+// Write writes the data to a temporary file and atomically replaces @filePath with it.
+func (dcm *DurableChunkManager) Write(ctx context.Context, filePath string, content []byte) error {
+	absPath := dcm.absPath(filePath)
+	dir := path.Dir(absPath)
+	if err := os.MkdirAll(dir, os.ModePerm); err != nil {
+		return err
+	}
+	dcm.verified.Delete(absPath)
+
+	tmpPath, err := writeTempFile(absPath, content)
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmpPath)
+
+	// the old sidecar must not be observed next to the new object, it's restored if the object is not replaced
+	checksumPath := absPath + checksumFileSuffix
+	oldChecksum, err := ioutil.ReadFile(checksumPath)
+	if err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	if err := os.Remove(checksumPath); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	restoreChecksum := func(err error) error {
+		if oldChecksum == nil {
+			return err
+		}
+		if rerr := writeFileAtomic(checksumPath, oldChecksum); rerr != nil {
+			log.Warn("durable chunk manager failed to restore checksum file", zap.String("path", absPath), zap.Error(rerr))
+		}
+		return err
+	}
+	if err := syncDir(dir); err != nil {
+		return restoreChecksum(err)
+	}
+	if err := os.Rename(tmpPath, absPath); err != nil {
+		return restoreChecksum(err)
+	}
+	if err := syncDir(dir); err != nil {
+		return err
+	}
+
+	checksum := encodeChecksum(crc32.Checksum(content, crc32cTable), int64(len(content)))
+	if err := writeFileAtomic(checksumPath, checksum); err != nil {
+		return err
+	}
+	return syncDir(dir)
+}
+
+// MultiWrite writes the data to local storage.
+func (dcm *DurableChunkManager) MultiWrite(ctx context.Context, contents map[string][]byte) error {
+	var el errorutil.ErrorList
+	for filePath, content := range contents {
+		err := dcm.Write(ctx, filePath, content)
+		if err != nil {
+			el = append(el, err)
+		}
+	}
+	if len(el) == 0 {
+		return nil
+	}
+	return el
+}
+
+// Read reads the local storage data and verifies it against its checksum.
+func (dcm *DurableChunkManager) Read(ctx context.Context, filePath string) ([]byte, error) {
+	exist, err := dcm.Exist(ctx, filePath)
+	if err != nil {
+		return nil, err
+	}
+	if !exist {
+		return nil, fmt.Errorf("file not exist: %s", filePath)
+	}
+	absPath := dcm.absPath(filePath)
+	content, err := ioutil.ReadFile(absPath)
+	if err != nil {
+		return nil, err
+	}
+	expected, size, err := readChecksum(absPath)
+	if errors.Is(err, os.ErrNotExist) {
+		return content, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if err := matchChecksum(filePath, crc32.Checksum(content, crc32cTable), int64(len(content)), expected, size); err != nil {
+		return nil, err
+	}
+	return content, nil
+}
+
+// MultiRead reads the local storage data if exists.
+func (dcm *DurableChunkManager) MultiRead(ctx context.Context, filePaths []string) ([][]byte, error) {
+	results := make([][]byte, len(filePaths))
+	var el errorutil.ErrorList
+	for i, filePath := range filePaths {
+		content, err := dcm.Read(ctx, filePath)
+		if err != nil {
+			el = append(el, err)
+		}
+		results[i] = content
+	}
+	if len(el) == 0 {
+		return results, nil
+	}
+	return results, el
+}
+
+// Reader verifies @filePath and returns a reader for it.
+func (dcm *DurableChunkManager) Reader(ctx context.Context, filePath string) (FileReader, error) {
+	exist, err := dcm.Exist(ctx, filePath)
+	if err != nil {
+		return nil, err
+	}
+	if !exist {
+		return nil, errors.New("local file cannot be found with filePath:" + filePath)
+	}
+	if err := dcm.verify(filePath); err != nil {
+		return nil, err
+	}
+	return os.Open(dcm.absPath(filePath))
+}
+
+// ListWithPrefix lists the objects with @prefix, checksum sidecars and unfinished writes are skipped.
+func (dcm *DurableChunkManager) ListWithPrefix(ctx context.Context, prefix string, recursive bool) ([]string, []time.Time, error) {
+	filePaths, modTimes, err := dcm.LocalChunkManager.ListWithPrefix(ctx, prefix, recursive)
+	if err != nil {
+		return nil, nil, err
+	}
+	var objects []string
+	var objectModTimes []time.Time
+	for i, filePath := range filePaths {
+		if isDurableInternalFile(filePath) {
+			continue
+		}
+		objects = append(objects, filePath)
+		objectModTimes = append(objectModTimes, modTimes[i])
+	}
+	return objects, objectModTimes, nil
+}
+
+// ReadWithPrefix reads files with same @prefix and returns contents.
+func (dcm *DurableChunkManager) ReadWithPrefix(ctx context.Context, prefix string) ([]string, [][]byte, error) {
+	filePaths, _, err := dcm.ListWithPrefix(ctx, prefix, true)
+	if err != nil {
+		return nil, nil, err
+	}
+	result, err := dcm.MultiRead(ctx, filePaths)
+	return filePaths, result, err
+}
+
+// ReadAt verifies @filePath and reads specific position data of it.
+func (dcm *DurableChunkManager) ReadAt(ctx context.Context, filePath string, off int64, length int64) ([]byte, error) {
+	if off < 0 || length < 0 {
+		return nil, io.EOF
+	}
+	if err := dcm.verify(filePath); err != nil {
+		return nil, err
+	}
+	return dcm.LocalChunkManager.ReadAt(ctx, filePath, off, length)
+}
+
+// Mmap maps @filePath into memory and verifies the mapped content.
+func (dcm *DurableChunkManager) Mmap(ctx context.Context, filePath string) (*mmap.ReaderAt, error) {
+	absPath := dcm.absPath(filePath)
+	reader, err := mmap.Open(path.Clean(absPath))
+	if err != nil {
+		return nil, err
+	}
+	expected, size, err := readChecksum(absPath)
+	if errors.Is(err, os.ErrNotExist) {
+		return reader, nil
+	}
+	if err == nil {
+		err = checkReader(filePath, io.NewSectionReader(reader, 0, int64(reader.Len())), expected, size)
+	}
+	if err != nil {
+		reader.Close()
+		return nil, err
+	}
+	return reader, nil
+}
+
+// Remove deletes @filePath together with its checksum sidecar.
+func (dcm *DurableChunkManager) Remove(ctx context.Context, filePath string) error {
+	absPath := dcm.absPath(filePath)
+	dcm.verified.Delete(absPath)
+	if err := os.RemoveAll(absPath); err != nil {
+		return err
+	}
+	if err := os.Remove(absPath + checksumFileSuffix); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	return nil
+}
+
+func (dcm *DurableChunkManager) MultiRemove(ctx context.Context, filePaths []string) error {
+	var el errorutil.ErrorList
+	for _, filePath := range filePaths {
+		err := dcm.Remove(ctx, filePath)
+		if err != nil {
+			el = append(el, err)
+		}
+	}
+	if len(el) == 0 {
+		return nil
+	}
+	return el
+}
+
+// RemoveWithPrefix removes all files with @prefix, including sidecars and leftovers of interrupted writes.
+func (dcm *DurableChunkManager) RemoveWithPrefix(ctx context.Context, prefix string) error {
+	filePaths, _, err := dcm.LocalChunkManager.ListWithPrefix(ctx, prefix, true)
+	if err != nil {
+		return err
+	}
+	return dcm.MultiRemove(ctx, filePaths)
+}
+
+// Verify scans all objects with @prefix and checks them against their checksums.
+// It returns the objects that failed verification with the reason, objects without a
+// checksum are reported with ErrChecksumMissing.
+func (dcm *DurableChunkManager) Verify(ctx context.Context, prefix string) (map[string]error, error) {
+	filePaths, _, err := dcm.LocalChunkManager.ListWithPrefix(ctx, prefix, true)
+	if err != nil {
+		return nil, err
+	}
+	objects := make(map[string]struct{})
+	var sidecars []string
+	for _, filePath := range filePaths {
+		switch {
+		case strings.HasSuffix(filePath, checksumFileSuffix):
+			sidecars = append(sidecars, strings.TrimSuffix(filePath, checksumFileSuffix))
+		case strings.HasSuffix(filePath, tempFileSuffix):
+		default:
+			objects[filePath] = struct{}{}
+		}
+	}
+
+	corrupted := make(map[string]error)
+	for filePath := range objects {
+		if ctx.Err() != nil {
+			return corrupted, ctx.Err()
+		}
+		dcm.verified.Delete(dcm.absPath(filePath))
+		if err := dcm.verify(filePath); err != nil {
+			corrupted[filePath] = err
+			continue
+		}
+		if _, _, err := readChecksum(dcm.absPath(filePath)); errors.Is(err, os.ErrNotExist) {
+			corrupted[filePath] = fmt.Errorf("%w: %s", ErrChecksumMissing, filePath)
+		}
+	}
+	for _, filePath := range sidecars {
+		if _, ok := objects[filePath]; !ok {
+			corrupted[filePath] = fmt.Errorf("object of checksum file not found: %s", filePath)
+		}
+	}
+	if len(corrupted) > 0 {
+		log.Warn("durable chunk manager found corrupted objects", zap.String("prefix", prefix), zap.Int("count", len(corrupted)))
+	}
+	return corrupted, nil
+}
+
+// verify checks the whole content of @filePath against its checksum,
+// objects without checksum and objects verified before are skipped.
+func (dcm *DurableChunkManager) verify(filePath string) error {
+	absPath := dcm.absPath(filePath)
+	fi, err := os.Stat(absPath)
+	if err != nil {
+		return err
+	}
+	stat := objectStat{size: fi.Size(), modTime: fi.ModTime()}
+	if v, ok := dcm.verified.Load(absPath); ok && v.(objectStat) == stat {
+		return nil
+	}
+
+	expected, size, err := readChecksum(absPath)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	file, err := os.Open(absPath)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+	if err := checkReader(filePath, file, expected, size); err != nil {
+		return err
+	}
+	dcm.verified.Store(absPath, stat)
+	return nil
+}
+
+func checkReader(filePath string, reader io.Reader, expected uint32, size int64) error {
+	h := crc32.New(crc32cTable)
+	n, err := io.Copy(h, reader)
+	if err != nil {
+		return err
+	}
+	return matchChecksum(filePath, h.Sum32(), n, expected, size)
+}
+
+func matchChecksum(filePath string, actual uint32, actualSize int64, expected uint32, expectedSize int64) error {
+	if actualSize != expectedSize {
+		return fmt.Errorf("%w: %s, expected size %d, actual size %d", ErrChecksumMismatch, filePath, expectedSize, actualSize)
+	}
+	if actual != expected {
+		return fmt.Errorf("%w: %s, expected %08x, actual %08x", ErrChecksumMismatch, filePath, expected, actual)
+	}
+	return nil
+}
+
+func encodeChecksum(checksum uint32, size int64) []byte {
+	return []byte(fmt.Sprintf("%08x %d\n", checksum, size))
+}
+
+// readChecksum reads the sidecar of @absPath, the returned error wraps os.ErrNotExist if there is none.
+func readChecksum(absPath string) (uint32, int64, error) {
+	content, err := ioutil.ReadFile(absPath + checksumFileSuffix)
+	if err != nil {
+		return 0, 0, err
+	}
+	var checksum uint32
+	var size int64
+	if _, err := fmt.Sscanf(string(content), "%x %d\n", &checksum, &size); err != nil {
+		return 0, 0, fmt.Errorf("%w: invalid checksum file of %s: %s", ErrChecksumMismatch, absPath, err.Error())
+	}
+	return checksum, size, nil
+}
+
+// tempFilePattern is the glob pattern of the temporary files of @absPath.
+func tempFilePattern(absPath string) string {
+	return path.Join(path.Dir(absPath), "."+path.Base(absPath)+".*"+tempFileSuffix)
+}
+
+// writeTempFile writes @content to a temporary file next to @absPath and fsyncs it,
+// the caller renames it into place or removes it.
+func writeTempFile(absPath string, content []byte) (string, error) {
+	tmp, err := os.CreateTemp(path.Dir(absPath), path.Base(tempFilePattern(absPath)))
+	if err != nil {
+		return "", err
+	}
+	tmpPath := tmp.Name()
+
+	if _, err := tmp.Write(content); err != nil {
+		tmp.Close()
+		os.Remove(tmpPath)
+		return "", err
+	}
+	if err := tmp.Sync(); err != nil {
+		tmp.Close()
+		os.Remove(tmpPath)
+		return "", err
+	}
+	if err := tmp.Close(); err != nil {
+		os.Remove(tmpPath)
+		return "", err
+	}
+	return tmpPath, nil
+}
+
+// writeFileAtomic writes @content to a temporary file in the same directory, fsyncs it
+// and renames it to @absPath, so that readers never observe a partially written file.
+func writeFileAtomic(absPath string, content []byte) error {
+	tmpPath, err := writeTempFile(absPath, content)
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmpPath)
+	return os.Rename(tmpPath, absPath)
+}
+
+// syncDir fsyncs @dir to persist the renames done inside it.
+func syncDir(dir string) error {
+	d, err := os.Open(dir)
+	if err != nil {
+		return err
+	}
+	defer d.Close()
+	return d.Sync()
+}
+
+func isDurableInternalFile(filePath string) bool {
+	return strings.HasSuffix(filePath, checksumFileSuffix) || strings.HasSuffix(filePath, tempFileSuffix)
+}
@@ -0,0 +1,263 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"context"
+	"errors"
+	"hash/crc32"
+	"io/ioutil"
+	"os"
+	"path"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDurableCM(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("test write and read", func(t *testing.T) {
+		testRoot := "test_durable_write"
+		testCM := NewDurableChunkManager(RootPath(localPath))
+		defer testCM.RemoveWithPrefix(ctx, testRoot)
+
+		key := path.Join(testRoot, "a", "b")
+		err := testCM.Write(ctx, key, []byte("123"))
+		require.NoError(t, err)
+		err = testCM.Write(ctx, key, []byte("1234"))
+		require.NoError(t, err)
+
+		content, err := testCM.Read(ctx, key)
+		assert.NoError(t, err)
+		assert.Equal(t, []byte("1234"), content)
+
+		content, err = testCM.ReadAt(ctx, key, 1, 2)
+		assert.NoError(t, err)
+		assert.Equal(t, []byte("23"), content)
+
+		reader, err := testCM.Mmap(ctx, key)
+		assert.NoError(t, err)
+		assert.Equal(t, 4, reader.Len())
+		reader.Close()
+
+		fileReader, err := testCM.Reader(ctx, key)
+		assert.NoError(t, err)
+		content, err = ioutil.ReadAll(fileReader)
+		assert.NoError(t, err)
+		assert.Equal(t, []byte("1234"), content)
+		fileReader.Close()
+
+		_, err = os.Stat(path.Join(localPath, key) + checksumFileSuffix)
+		assert.NoError(t, err)
+
+		_, err = testCM.Read(ctx, path.Join(testRoot, "not_exist"))
+		assert.Error(t, err)
+	})
+
+	t.Run("test list hides internal files", func(t *testing.T) {
+		testRoot := "test_durable_list"
+		testCM := NewDurableChunkManager(RootPath(localPath))
+		defer testCM.RemoveWithPrefix(ctx, testRoot)
+
+		err := testCM.MultiWrite(ctx, map[string][]byte{
+			path.Join(testRoot, "key_1"): []byte("111"),
+			path.Join(testRoot, "key_2"): []byte("222"),
+		})
+		require.NoError(t, err)
+		leftover := path.Join(localPath, testRoot, ".key_3.123"+tempFileSuffix)
+		require.NoError(t, ioutil.WriteFile(leftover, []byte("3"), os.ModePerm))
+
+		filePaths, modTimes, err := testCM.ListWithPrefix(ctx, testRoot, true)
+		assert.NoError(t, err)
+		assert.Equal(t, 2, len(filePaths))
+		assert.Equal(t, 2, len(modTimes))
+
+		keys, values, err := testCM.ReadWithPrefix(ctx, testRoot)
+		assert.NoError(t, err)
+		assert.Equal(t, 2, len(keys))
+		assert.ElementsMatch(t, [][]byte{[]byte("111"), []byte("222")}, values)
+
+		err = testCM.RemoveWithPrefix(ctx, testRoot)
+		assert.NoError(t, err)
+		_, err = os.Stat(leftover)
+		assert.True(t, os.IsNotExist(err))
+		_, err = os.Stat(path.Join(localPath, testRoot, "key_1") + checksumFileSuffix)
+		assert.True(t, os.IsNotExist(err))
+	})
+
+	t.Run("test corrupted object", func(t *testing.T) {
+		testRoot := "test_durable_corrupted"
+		testCM := NewDurableChunkManager(RootPath(localPath))
+		defer testCM.RemoveWithPrefix(ctx, testRoot)
+
+		key := path.Join(testRoot, "key")
+		require.NoError(t, testCM.Write(ctx, key, []byte("12345678")))
+		_, err := testCM.ReadAt(ctx, key, 0, 4)
+		require.NoError(t, err)
+
+		// truncated file
+		require.NoError(t, os.Truncate(path.Join(localPath, key), 4))
+		_, err = testCM.Read(ctx, key)
+		assert.True(t, errors.Is(err, ErrChecksumMismatch))
+		_, err = testCM.ReadAt(ctx, key, 0, 4)
+		assert.True(t, errors.Is(err, ErrChecksumMismatch))
+		_, err = testCM.Mmap(ctx, key)
+		assert.True(t, errors.Is(err, ErrChecksumMismatch))
+		_, err = testCM.Reader(ctx, key)
+		assert.True(t, errors.Is(err, ErrChecksumMismatch))
+
+		// flipped content with the same size
+		require.NoError(t, ioutil.WriteFile(path.Join(localPath, key), []byte("12345679"), os.ModePerm))
+		_, err = testCM.Read(ctx, key)
+		assert.True(t, errors.Is(err, ErrChecksumMismatch))
+
+		// broken checksum file
+		require.NoError(t, ioutil.WriteFile(path.Join(localPath, key)+checksumFileSuffix, []byte("xx"), os.ModePerm))
+		_, err = testCM.Read(ctx, key)
+		assert.True(t, errors.Is(err, ErrChecksumMismatch))
+	})
+
+	t.Run("test interrupted write", func(t *testing.T) {
+		testRoot := "test_durable_interrupted"
+		testCM := NewDurableChunkManager(RootPath(localPath))
+		defer testCM.RemoveWithPrefix(ctx, testRoot)
+
+		key := path.Join(testRoot, "key")
+		absPath := path.Join(localPath, key)
+		require.NoError(t, testCM.Write(ctx, key, []byte("123")))
+
+		// crash after the old sidecar is removed, the old content is served unverified
+		_, err := writeTempFile(absPath, []byte("1234"))
+		require.NoError(t, err)
+		require.NoError(t, os.Remove(absPath+checksumFileSuffix))
+		content, err := testCM.Read(ctx, key)
+		assert.NoError(t, err)
+		assert.Equal(t, []byte("123"), content)
+		keys, _, err := testCM.ListWithPrefix(ctx, testRoot, true)
+		assert.NoError(t, err)
+		assert.Equal(t, 1, len(keys))
+
+		// crash after the object is renamed into place, the new content is served unverified
+		require.NoError(t, writeFileAtomic(absPath, []byte("12345")))
+		content, err = testCM.ReadAt(ctx, key, 0, 5)
+		assert.NoError(t, err)
+		assert.Equal(t, []byte("12345"), content)
+		reader, err := testCM.Mmap(ctx, key)
+		assert.NoError(t, err)
+		assert.Equal(t, 5, reader.Len())
+		reader.Close()
+		corrupted, err := testCM.Verify(ctx, testRoot)
+		assert.NoError(t, err)
+		assert.Equal(t, 1, len(corrupted))
+		for _, reason := range corrupted {
+			assert.True(t, errors.Is(reason, ErrChecksumMissing))
+		}
+
+		// the next write commits the checksum again
+		require.NoError(t, testCM.Write(ctx, key, []byte("123456")))
+		corrupted, err = testCM.Verify(ctx, testRoot)
+		assert.NoError(t, err)
+		assert.Empty(t, corrupted)
+	})
+
+	t.Run("test failed write", func(t *testing.T) {
+		testRoot := "test_durable_failed_write"
+		testCM := NewDurableChunkManager(RootPath(localPath))
+		defer testCM.RemoveWithPrefix(ctx, testRoot)
+
+		key := path.Join(testRoot, "key")
+		absPath := path.Join(localPath, key)
+		// a non-empty directory can't be replaced by the new object
+		require.NoError(t, os.MkdirAll(path.Join(absPath, "sub"), os.ModePerm))
+		oldChecksum := encodeChecksum(crc32.Checksum([]byte("123"), crc32cTable), 3)
+		require.NoError(t, ioutil.WriteFile(absPath+checksumFileSuffix, oldChecksum, os.ModePerm))
+
+		err := testCM.Write(ctx, key, []byte("1234"))
+		assert.Error(t, err)
+		// the previous sidecar is restored and the temporary file is removed
+		checksum, err := ioutil.ReadFile(absPath + checksumFileSuffix)
+		assert.NoError(t, err)
+		assert.Equal(t, oldChecksum, checksum)
+		tmpPaths, err := filepath.Glob(tempFilePattern(absPath))
+		assert.NoError(t, err)
+		assert.Empty(t, tmpPaths)
+	})
+
+	t.Run("test object without checksum", func(t *testing.T) {
+		testRoot := "test_durable_legacy"
+		testCM := NewDurableChunkManager(RootPath(localPath))
+		defer testCM.RemoveWithPrefix(ctx, testRoot)
+
+		key := path.Join(testRoot, "key")
+		require.NoError(t, NewLocalChunkManager(RootPath(localPath)).Write(ctx, key, []byte("123")))
+		content, err := testCM.Read(ctx, key)
+		assert.NoError(t, err)
+		assert.Equal(t, []byte("123"), content)
+		content, err = testCM.ReadAt(ctx, key, 0, 3)
+		assert.NoError(t, err)
+		assert.Equal(t, []byte("123"), content)
+	})
+
+	t.Run("test verify", func(t *testing.T) {
+		testRoot := "test_durable_verify"
+		testCM := NewDurableChunkManager(RootPath(localPath))
+		defer testCM.RemoveWithPrefix(ctx, testRoot)
+
+		good := path.Join(testRoot, "good")
+		bad := path.Join(testRoot, "bad")
+		legacy := path.Join(testRoot, "legacy")
+		orphan := path.Join(testRoot, "orphan")
+		require.NoError(t, testCM.Write(ctx, good, []byte("good")))
+		require.NoError(t, testCM.Write(ctx, bad, []byte("bad")))
+		require.NoError(t, testCM.Write(ctx, orphan, []byte("orphan")))
+		require.NoError(t, NewLocalChunkManager(RootPath(localPath)).Write(ctx, legacy, []byte("legacy")))
+		require.NoError(t, ioutil.WriteFile(path.Join(localPath, bad), []byte("bae"), os.ModePerm))
+		require.NoError(t, os.Remove(path.Join(localPath, orphan)))
+
+		corrupted, err := testCM.Verify(ctx, testRoot)
+		assert.NoError(t, err)
+		assert.Equal(t, 3, len(corrupted))
+		for filePath, reason := range corrupted {
+			switch path.Base(filePath) {
+			case "bad":
+				assert.True(t, errors.Is(reason, ErrChecksumMismatch))
+			case "legacy":
+				assert.True(t, errors.Is(reason, ErrChecksumMissing))
+			case "orphan":
+				assert.Error(t, reason)
+			default:
+				assert.Fail(t, "unexpected corrupted object", filePath)
+			}
+		}
+
+		canceled, cancel := context.WithCancel(ctx)
+		cancel()
+		_, err = testCM.Verify(canceled, testRoot)
+		assert.Error(t, err)
+	})
+
+	t.Run("test factory", func(t *testing.T) {
+		factory := NewChunkManagerFactory("durable", RootPath(localPath))
+		cm, err := factory.NewPersistentStorageChunkManager(ctx)
+		assert.NoError(t, err)
+		_, ok := cm.(*DurableChunkManager)
+		assert.True(t, ok)
+	})
+}
@@ -0,0 +1,749 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"bytes"
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"path"
+	"strings"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+	"golang.org/x/exp/mmap"
+
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/util/errorutil"
+)
+
+const (
+	// DefaultEncryptionChunkSize is the size of plaintext encrypted as one chunk.
+	DefaultEncryptionChunkSize = 64 * 1024
+
+	encryptionVersion = 1
+	// magic(4) + version(1) + header length(4) + chunk size(4) + plaintext size(8)
+	encryptionFixedHeaderSize = 21
+	dataKeySize               = 32
+	gcmNonceSize              = 12
+	gcmTagSize                = 16
+	// maxCachedObjectKeys bounds the number of unwrapped data keys kept in memory.
+	maxCachedObjectKeys = 4096
+)
+
+var encryptionMagic = []byte("MENC")
+
+var (
+	// ErrInvalidEncryptedObject is returned when an encrypted object is malformed or fails authentication.
+	ErrInvalidEncryptedObject = errors.New("invalid encrypted object")
+	// ErrUnencryptedObject is returned when encryption is required and an object has no encryption header.
+	ErrUnencryptedObject = errors.New("object is not encrypted")
+)
+
+// encryptionHeader leads every encrypted object, it is followed by the encrypted chunks.
+// Each chunk is sealed with the data key, the nonce is derived from the chunk index and the
+// additional data binds the chunk to its position and the object size, so chunks can't be
+// reordered or truncated. The wrapped data key is not authenticated by the chunks, so it can
+// be re-wrapped with another master key without re-encrypting the content.
+type encryptionHeader struct {
+	chunkSize int64
+	plainSize int64
+	keyID     string
+	wrapped   []byte
+}
+
+func (h *encryptionHeader) length() int64 {
+	return int64(encryptionFixedHeaderSize + 2 + len(h.keyID) + 2 + len(h.wrapped))
+}
+
+func (h *encryptionHeader) encode() []byte {
+	buf := bytes.NewBuffer(make([]byte, 0, h.length()))
+	buf.Write(encryptionMagic)
+	buf.WriteByte(encryptionVersion)
+	_ = binary.Write(buf, binary.LittleEndian, uint32(h.length()))
+	_ = binary.Write(buf, binary.LittleEndian, uint32(h.chunkSize))
+	_ = binary.Write(buf, binary.LittleEndian, uint64(h.plainSize))
+	_ = binary.Write(buf, binary.LittleEndian, uint16(len(h.keyID)))
+	buf.WriteString(h.keyID)
+	_ = binary.Write(buf, binary.LittleEndian, uint16(len(h.wrapped)))
+	buf.Write(h.wrapped)
+	return buf.Bytes()
+}
+
+func (h *encryptionHeader) numChunks() int64 {
+	return (h.plainSize + h.chunkSize - 1) / h.chunkSize
+}
+
+// chunkOffset returns the offset of the @i-th encrypted chunk in the object.
+func (h *encryptionHeader) chunkOffset(i int64) int64 {
+	return h.length() + i*(h.chunkSize+gcmTagSize)
+}
+
+// chunkPlainSize returns the plaintext size of the @i-th chunk.
+func (h *encryptionHeader) chunkPlainSize(i int64) int64 {
+	if (i+1)*h.chunkSize > h.plainSize {
+		return h.plainSize - i*h.chunkSize
+	}
+	return h.chunkSize
+}
+
+func (h *encryptionHeader) objectSize() int64 {
+	return h.length() + h.plainSize + h.numChunks()*gcmTagSize
+}
+
+func (h *encryptionHeader) chunkAAD(i int64) []byte {
+	aad := make([]byte, 20)
+	binary.LittleEndian.PutUint64(aad, uint64(h.plainSize))
+	binary.LittleEndian.PutUint32(aad[8:], uint32(h.chunkSize))
+	binary.LittleEndian.PutUint64(aad[12:], uint64(i))
+	return aad
+}
+
+func chunkNonce(i int64) []byte {
+	nonce := make([]byte, gcmNonceSize)
+	binary.LittleEndian.PutUint64(nonce[4:], uint64(i))
+	return nonce
+}
+
+// isEncrypted returns true if @data starts with the magic of encrypted objects.
+func isEncrypted(data []byte) bool {
+	return len(data) >= encryptionFixedHeaderSize && bytes.Equal(data[:len(encryptionMagic)], encryptionMagic)
+}
+
+// decodeFixedHeader decodes the fixed part of the header and returns the total header length.
+func decodeFixedHeader(data []byte) (*encryptionHeader, int64, error) {
+	if data[len(encryptionMagic)] != encryptionVersion {
+		return nil, 0, fmt.Errorf("%w: unsupported version %d", ErrInvalidEncryptedObject, data[len(encryptionMagic)])
+	}
+	headerLen := int64(binary.LittleEndian.Uint32(data[5:]))
+	h := &encryptionHeader{
+		chunkSize: int64(binary.LittleEndian.Uint32(data[9:])),
+		plainSize: int64(binary.LittleEndian.Uint64(data[13:])),
+	}
+	if h.chunkSize <= 0 || headerLen < encryptionFixedHeaderSize+4 {
+		return nil, 0, fmt.Errorf("%w: corrupted header", ErrInvalidEncryptedObject)
+	}
+	return h, headerLen, nil
+}
+
+// decodeEncryptionHeader decodes the header from @data which must contain the whole header.
+func decodeEncryptionHeader(data []byte) (*encryptionHeader, error) {
+	h, headerLen, err := decodeFixedHeader(data)
+	if err != nil {
+		return nil, err
+	}
+	if int64(len(data)) < headerLen {
+		return nil, fmt.Errorf("%w: header truncated", ErrInvalidEncryptedObject)
+	}
+	rest := data[encryptionFixedHeaderSize:headerLen]
+	keyIDLen := int(binary.LittleEndian.Uint16(rest))
+	if len(rest) < 2+keyIDLen+2 {
+		return nil, fmt.Errorf("%w: corrupted header", ErrInvalidEncryptedObject)
+	}
+	h.keyID = string(rest[2 : 2+keyIDLen])
+	rest = rest[2+keyIDLen:]
+	wrappedLen := int(binary.LittleEndian.Uint16(rest))
+	if len(rest) != 2+wrappedLen {
+		return nil, fmt.Errorf("%w: corrupted header", ErrInvalidEncryptedObject)
+	}
+	h.wrapped = rest[2:]
+	return h, nil
+}
+
+func newGCM(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
+
+// wrapKey seals @dataKey with @masterKey, the output is nonce followed by the sealed key.
+func wrapKey(masterKey []byte, keyID string, dataKey []byte) ([]byte, error) {
+	aead, err := newGCM(masterKey)
+	if err != nil {
+		return nil, err
+	}
+	nonce := make([]byte, gcmNonceSize)
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, err
+	}
+	return aead.Seal(nonce, nonce, dataKey, []byte(keyID)), nil
+}
+
+func unwrapKey(masterKey []byte, keyID string, wrapped []byte) ([]byte, error) {
+	aead, err := newGCM(masterKey)
+	if err != nil {
+		return nil, err
+	}
+	if len(wrapped) < gcmNonceSize {
+		return nil, fmt.Errorf("%w: wrapped key too short", ErrInvalidEncryptedObject)
+	}
+	dataKey, err := aead.Open(nil, wrapped[:gcmNonceSize], wrapped[gcmNonceSize:], []byte(keyID))
+	if err != nil {
+		return nil, fmt.Errorf("%w: failed to unwrap data key with %s: %s", ErrInvalidEncryptedObject, keyID, err.Error())
+	}
+	return dataKey, nil
+}
+
+// objectKey caches the header and data key of an object, header is nil for plaintext objects.
+type objectKey struct {
+	header *encryptionHeader
+	aead   cipher.AEAD
+}
+
+// EncryptedChunkManager is a ChunkManager decorator encrypting objects at rest with AES-GCM envelope encryption.
+// Each object is encrypted with a random data key, which is wrapped by a master key of the KeyProvider.
+// Content is encrypted in chunks, so ReadAt only downloads and decrypts the chunks it covers.
+// Objects written without encryption are read as is unless encryption is required,
+// objects under plaintext prefixes are never encrypted.
+type EncryptedChunkManager struct {
+	ChunkManager
+
+	keyProvider       KeyProvider
+	chunkSize         int64
+	plaintextPrefixes []string
+	requireEncryption bool
+
+	reloadMu sync.Mutex
+
+	keysMu sync.RWMutex
+	keys   map[string]*objectKey
+}
+
+var _ ChunkManager = (*EncryptedChunkManager)(nil)
+
+// NewEncryptedChunkManager wraps @inner with encryption, objects with @plaintextPrefixes are stored unencrypted.
+func NewEncryptedChunkManager(inner ChunkManager, keyProvider KeyProvider, chunkSize int64, plaintextPrefixes ...string) (*EncryptedChunkManager, error) {
+	if chunkSize <= 0 || chunkSize > 1<<31 {
+		return nil, fmt.Errorf("invalid encryption chunk size: %d", chunkSize)
+	}
+	if _, err := keyProvider.GetKey(keyProvider.CurrentKeyID()); err != nil {
+		return nil, err
+	}
+	return &EncryptedChunkManager{
+		ChunkManager:      inner,
+		keyProvider:       keyProvider,
+		chunkSize:         chunkSize,
+		plaintextPrefixes: plaintextPrefixes,
+		keys:              make(map[string]*objectKey),
+	}, nil
+}
+
+func (ecm *EncryptedChunkManager) isPlaintextPath(filePath string) bool {
+	for _, prefix := range ecm.plaintextPrefixes {
+		if strings.HasPrefix(filePath, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+func (ecm *EncryptedChunkManager) getCachedKey(filePath string) (*objectKey, bool) {
+	ecm.keysMu.RLock()
+	defer ecm.keysMu.RUnlock()
+	key, ok := ecm.keys[filePath]
+	return key, ok
+}
+
+func (ecm *EncryptedChunkManager) cacheKey(filePath string, key *objectKey) {
+	ecm.keysMu.Lock()
+	defer ecm.keysMu.Unlock()
+	if len(ecm.keys) >= maxCachedObjectKeys {
+		// evict an arbitrary entry, cached keys are cheap to reload
+		for k := range ecm.keys {
+			delete(ecm.keys, k)
+			break
+		}
+	}
+	ecm.keys[filePath] = key
+}
+
+func (ecm *EncryptedChunkManager) evictKey(filePath string) {
+	ecm.keysMu.Lock()
+	defer ecm.keysMu.Unlock()
+	delete(ecm.keys, filePath)
+}
+
+// checkPlaintext returns an error if @filePath is plaintext but encryption is required.
+func (ecm *EncryptedChunkManager) checkPlaintext(filePath string) error {
+	if ecm.requireEncryption && !ecm.isPlaintextPath(filePath) {
+		return fmt.Errorf("%w: %s", ErrUnencryptedObject, filePath)
+	}
+	return nil
+}
+
+// getMasterKey returns the master key of @keyID, the keys are reloaded once if @keyID is unknown,
+// since the object may be re-wrapped by the key rotation of DataCoord with a key added after this node loaded the keys.
+func (ecm *EncryptedChunkManager) getMasterKey(keyID string) ([]byte, error) {
+	masterKey, err := ecm.keyProvider.GetKey(keyID)
+	if !errors.Is(err, ErrEncryptionKeyNotFound) {
+		return masterKey, err
+	}
+	ecm.reloadMu.Lock()
+	defer ecm.reloadMu.Unlock()
+	// others may have reloaded the keys while waiting for the lock
+	if masterKey, err = ecm.keyProvider.GetKey(keyID); !errors.Is(err, ErrEncryptionKeyNotFound) {
+		return masterKey, err
+	}
+	if reloadErr := ecm.keyProvider.Reload(); reloadErr != nil {
+		log.Warn("failed to reload encryption keys", zap.String("keyID", keyID), zap.Error(reloadErr))
+		return nil, err
+	}
+	log.Info("reloaded encryption keys for unknown key", zap.String("keyID", keyID))
+	return ecm.keyProvider.GetKey(keyID)
+}
+
+func (ecm *EncryptedChunkManager) openDataKey(h *encryptionHeader) (cipher.AEAD, error) {
+	masterKey, err := ecm.getMasterKey(h.keyID)
+	if err != nil {
+		return nil, err
+	}
+	dataKey, err := unwrapKey(masterKey, h.keyID, h.wrapped)
+	if err != nil {
+		return nil, err
+	}
+	return newGCM(dataKey)
+}
+
+// loadObjectKey reads the header of @filePath and unwraps its data key.
+func (ecm *EncryptedChunkManager) loadObjectKey(ctx context.Context, filePath string) (*objectKey, error) {
+	if key, ok := ecm.getCachedKey(filePath); ok {
+		return key, nil
+	}
+	if ecm.isPlaintextPath(filePath) {
+		return &objectKey{}, nil
+	}
+	size, err := ecm.ChunkManager.Size(ctx, filePath)
+	if err != nil {
+		return nil, err
+	}
+	key := &objectKey{}
+	if size >= encryptionFixedHeaderSize {
+		fixed, err := ecm.ChunkManager.ReadAt(ctx, filePath, 0, encryptionFixedHeaderSize)
+		if err != nil {
+			return nil, err
+		}
+		if isEncrypted(fixed) {
+			_, headerLen, err := decodeFixedHeader(fixed)
+			if err != nil {
+				return nil, err
+			}
+			rest, err := ecm.ChunkManager.ReadAt(ctx, filePath, encryptionFixedHeaderSize, headerLen-encryptionFixedHeaderSize)
+			if err != nil {
+				return nil, err
+			}
+			if key.header, err = decodeEncryptionHeader(append(fixed, rest...)); err != nil {
+				return nil, err
+			}
+			if key.aead, err = ecm.openDataKey(key.header); err != nil {
+				return nil, err
+			}
+		}
+	}
+	if key.header == nil {
+		if err := ecm.checkPlaintext(filePath); err != nil {
+			return nil, err
+		}
+	}
+	ecm.cacheKey(filePath, key)
+	return key, nil
+}
+
+func (ecm *EncryptedChunkManager) encrypt(filePath string, content []byte) ([]byte, error) {
+	if ecm.isPlaintextPath(filePath) {
+		return content, nil
+	}
+	keyID := ecm.keyProvider.CurrentKeyID()
+	masterKey, err := ecm.keyProvider.GetKey(keyID)
+	if err != nil {
+		return nil, err
+	}
+	dataKey := make([]byte, dataKeySize)
+	if _, err := rand.Read(dataKey); err != nil {
+		return nil, err
+	}
+	wrapped, err := wrapKey(masterKey, keyID, dataKey)
+	if err != nil {
+		return nil, err
+	}
+	aead, err := newGCM(dataKey)
+	if err != nil {
+		return nil, err
+	}
+	h := &encryptionHeader{
+		chunkSize: ecm.chunkSize,
+		plainSize: int64(len(content)),
+		keyID:     keyID,
+		wrapped:   wrapped,
+	}
+	out := make([]byte, 0, h.objectSize())
+	out = append(out, h.encode()...)
+	for i := int64(0); i < h.numChunks(); i++ {
+		start := i * h.chunkSize
+		out = aead.Seal(out, chunkNonce(i), content[start:start+h.chunkPlainSize(i)], h.chunkAAD(i))
+	}
+	return out, nil
+}
+
+// decryptChunks decrypts consecutive encrypted chunks starting from chunk @first.
+func decryptChunks(aead cipher.AEAD, h *encryptionHeader, first int64, data []byte) ([]byte, error) {
+	out := make([]byte, 0, len(data))
+	for i := first; len(data) > 0; i++ {
+		sealedSize := h.chunkPlainSize(i) + gcmTagSize
+		if int64(len(data)) < sealedSize {
+			return nil, fmt.Errorf("%w: chunk %d truncated", ErrInvalidEncryptedObject, i)
+		}
+		var err error
+		out, err = aead.Open(out, chunkNonce(i), data[:sealedSize], h.chunkAAD(i))
+		if err != nil {
+			return nil, fmt.Errorf("%w: failed to decrypt chunk %d: %s", ErrInvalidEncryptedObject, i, err.Error())
+		}
+		data = data[sealedSize:]
+	}
+	return out, nil
+}
+
+func (ecm *EncryptedChunkManager) decrypt(filePath string, content []byte) ([]byte, error) {
+	if ecm.isPlaintextPath(filePath) {
+		return content, nil
+	}
+	if !isEncrypted(content) {
+		if err := ecm.checkPlaintext(filePath); err != nil {
+			return nil, err
+		}
+		return content, nil
+	}
+	h, err := decodeEncryptionHeader(content)
+	if err != nil {
+		return nil, err
+	}
+	if int64(len(content)) != h.objectSize() {
+		return nil, fmt.Errorf("%w: %s, expected size %d, actual size %d", ErrInvalidEncryptedObject, filePath, h.objectSize(), len(content))
+	}
+	key, ok := ecm.getCachedKey(filePath)
+	if !ok || key.header == nil || key.header.keyID != h.keyID || !bytes.Equal(key.header.wrapped, h.wrapped) {
+		key = &objectKey{header: h}
+		if key.aead, err = ecm.openDataKey(h); err != nil {
+			return nil, err
+		}
+		ecm.cacheKey(filePath, key)
+	}
+	return decryptChunks(key.aead, h, 0, content[h.length():])
+}
+
+// Size returns the plaintext size of @filePath.
+func (ecm *EncryptedChunkManager) Size(ctx context.Context, filePath string) (int64, error) {
+	key, err := ecm.loadObjectKey(ctx, filePath)
+	if err != nil {
+		return 0, err
+	}
+	if key.header == nil {
+		return ecm.ChunkManager.Size(ctx, filePath)
+	}
+	return key.header.plainSize, nil
+}
+
+// Write encrypts @content and writes it to @filePath.
+func (ecm *EncryptedChunkManager) Write(ctx context.Context, filePath string, content []byte) error {
+	encrypted, err := ecm.encrypt(filePath, content)
+	if err != nil {
+		return err
+	}
+	ecm.evictKey(filePath)
+	return ecm.ChunkManager.Write(ctx, filePath, encrypted)
+}
+
+// MultiWrite encrypts @contents and writes them.
+func (ecm *EncryptedChunkManager) MultiWrite(ctx context.Context, contents map[string][]byte) error {
+	encrypted := make(map[string][]byte, len(contents))
+	for filePath, content := range contents {
+		data, err := ecm.encrypt(filePath, content)
+		if err != nil {
+			return err
+		}
+		ecm.evictKey(filePath)
+		encrypted[filePath] = data
+	}
+	return ecm.ChunkManager.MultiWrite(ctx, encrypted)
+}
+
+// Read reads and decrypts @filePath.
+func (ecm *EncryptedChunkManager) Read(ctx context.Context, filePath string) ([]byte, error) {
+	content, err := ecm.ChunkManager.Read(ctx, filePath)
+	if err != nil {
+		return nil, err
+	}
+	return ecm.decrypt(filePath, content)
+}
+
+// Reader returns a reader of the decrypted content of @filePath.
+func (ecm *EncryptedChunkManager) Reader(ctx context.Context, filePath string) (FileReader, error) {
+	content, err := ecm.Read(ctx, filePath)
+	if err != nil {
+		return nil, err
+	}
+	return ioutil.NopCloser(bytes.NewReader(content)), nil
+}
+
+// MultiRead reads and decrypts @filePaths.
+func (ecm *EncryptedChunkManager) MultiRead(ctx context.Context, filePaths []string) ([][]byte, error) {
+	results := make([][]byte, len(filePaths))
+	var el errorutil.ErrorList
+	for i, filePath := range filePaths {
+		content, err := ecm.Read(ctx, filePath)
+		if err != nil {
+			el = append(el, err)
+		}
+		results[i] = content
+	}
+	if len(el) == 0 {
+		return results, nil
+	}
+	return results, el
+}
+
+// ReadWithPrefix reads and decrypts files with same @prefix.
+func (ecm *EncryptedChunkManager) ReadWithPrefix(ctx context.Context, prefix string) ([]string, [][]byte, error) {
+	filePaths, contents, err := ecm.ChunkManager.ReadWithPrefix(ctx, prefix)
+	if err != nil {
+		return nil, nil, err
+	}
+	for i, filePath := range filePaths {
+		if contents[i], err = ecm.decrypt(filePath, contents[i]); err != nil {
+			return nil, nil, err
+		}
+	}
+	return filePaths, contents, nil
+}
+
+// ReadAt reads and decrypts the chunks covering [@off, @off+@length) of @filePath.
+func (ecm *EncryptedChunkManager) ReadAt(ctx context.Context, filePath string, off int64, length int64) ([]byte, error) {
+	if off < 0 || length < 0 {
+		return nil, io.EOF
+	}
+	content, err := ecm.readAt(ctx, filePath, off, length)
+	if errors.Is(err, ErrInvalidEncryptedObject) {
+		// the header may be re-wrapped by others since it's cached, reload it and retry
+		ecm.evictKey(filePath)
+		content, err = ecm.readAt(ctx, filePath, off, length)
+	}
+	return content, err
+}
+
+func (ecm *EncryptedChunkManager) readAt(ctx context.Context, filePath string, off int64, length int64) ([]byte, error) {
+	key, err := ecm.loadObjectKey(ctx, filePath)
+	if err != nil {
+		return nil, err
+	}
+	if key.header == nil {
+		return ecm.ChunkManager.ReadAt(ctx, filePath, off, length)
+	}
+	h := key.header
+	if off+length > h.plainSize {
+		return nil, io.EOF
+	}
+	if length == 0 {
+		return []byte{}, nil
+	}
+	first := off / h.chunkSize
+	last := (off + length - 1) / h.chunkSize
+	start := h.chunkOffset(first)
+	end := h.chunkOffset(last) + h.chunkPlainSize(last) + gcmTagSize
+	data, err := ecm.ChunkManager.ReadAt(ctx, filePath, start, end-start)
+	if err != nil {
+		return nil, err
+	}
+	plain, err := decryptChunks(key.aead, h, first, data)
+	if err != nil {
+		return nil, err
+	}
+	skip := off - first*h.chunkSize
+	return plain[skip : skip+length], nil
+}
+
+// Mmap decrypts @filePath into anonymous memory and maps it, the plaintext never reaches the disk.
+func (ecm *EncryptedChunkManager) Mmap(ctx context.Context, filePath string) (*mmap.ReaderAt, error) {
+	key, err := ecm.loadObjectKey(ctx, filePath)
+	if err != nil {
+		return nil, err
+	}
+	if key.header == nil {
+		return ecm.ChunkManager.Mmap(ctx, filePath)
+	}
+	content, err := ecm.Read(ctx, filePath)
+	if err != nil {
+		return nil, err
+	}
+	return mmapBytes(path.Base(filePath), content)
+}
+
+// Remove deletes @filePath.
+func (ecm *EncryptedChunkManager) Remove(ctx context.Context, filePath string) error {
+	ecm.evictKey(filePath)
+	return ecm.ChunkManager.Remove(ctx, filePath)
+}
+
+// MultiRemove deletes @filePaths.
+func (ecm *EncryptedChunkManager) MultiRemove(ctx context.Context, filePaths []string) error {
+	for _, filePath := range filePaths {
+		ecm.evictKey(filePath)
+	}
+	return ecm.ChunkManager.MultiRemove(ctx, filePaths)
+}
+
+// RemoveWithPrefix removes files with same @prefix.
+func (ecm *EncryptedChunkManager) RemoveWithPrefix(ctx context.Context, prefix string) error {
+	ecm.keysMu.Lock()
+	for filePath := range ecm.keys {
+		if strings.HasPrefix(filePath, prefix) {
+			delete(ecm.keys, filePath)
+		}
+	}
+	ecm.keysMu.Unlock()
+	return ecm.ChunkManager.RemoveWithPrefix(ctx, prefix)
+}
+
+// RewrapKeys re-wraps the data keys of objects with @prefix, which are wrapped by other than the current master key.
+// Only headers are rewritten, the encrypted content is kept. It returns the number of re-wrapped objects.
+func (ecm *EncryptedChunkManager) RewrapKeys(ctx context.Context, prefix string) (int, error) {
+	filePaths, _, err := ecm.ChunkManager.ListWithPrefix(ctx, prefix, true)
+	if err != nil {
+		return 0, err
+	}
+	keyID := ecm.keyProvider.CurrentKeyID()
+	masterKey, err := ecm.keyProvider.GetKey(keyID)
+	if err != nil {
+		return 0, err
+	}
+
+	rewrapped := 0
+	var el errorutil.ErrorList
+	for _, filePath := range filePaths {
+		if ctx.Err() != nil {
+			return rewrapped, ctx.Err()
+		}
+		if ecm.isPlaintextPath(filePath) {
+			continue
+		}
+		done, err := ecm.rewrapKey(ctx, filePath, keyID, masterKey)
+		if errors.Is(err, ErrUnencryptedObject) {
+			// e.g. DiskANN index files written by segcore
+			continue
+		}
+		if err != nil {
+			log.Warn("failed to re-wrap data key", zap.String("path", filePath), zap.Error(err))
+			el = append(el, err)
+			continue
+		}
+		if done {
+			rewrapped++
+		}
+	}
+	if len(el) > 0 {
+		return rewrapped, el
+	}
+	return rewrapped, nil
+}
+
+func (ecm *EncryptedChunkManager) rewrapKey(ctx context.Context, filePath string, keyID string, masterKey []byte) (bool, error) {
+	key, err := ecm.loadObjectKey(ctx, filePath)
+	if err != nil {
+		return false, err
+	}
+	if key.header == nil || key.header.keyID == keyID {
+		return false, nil
+	}
+	content, err := ecm.ChunkManager.Read(ctx, filePath)
+	if err != nil {
+		return false, err
+	}
+	if !isEncrypted(content) {
+		ecm.evictKey(filePath)
+		return false, nil
+	}
+	h, err := decodeEncryptionHeader(content)
+	if err != nil {
+		return false, err
+	}
+	oldMasterKey, err := ecm.getMasterKey(h.keyID)
+	if err != nil {
+		return false, err
+	}
+	dataKey, err := unwrapKey(oldMasterKey, h.keyID, h.wrapped)
+	if err != nil {
+		return false, err
+	}
+	wrapped, err := wrapKey(masterKey, keyID, dataKey)
+	if err != nil {
+		return false, err
+	}
+	newHeader := &encryptionHeader{
+		chunkSize: h.chunkSize,
+		plainSize: h.plainSize,
+		keyID:     keyID,
+		wrapped:   wrapped,
+	}
+	body := content[h.length():]
+	out := make([]byte, 0, newHeader.length()+int64(len(body)))
+	out = append(out, newHeader.encode()...)
+	out = append(out, body...)
+	ecm.evictKey(filePath)
+	if err := ecm.ChunkManager.Write(ctx, filePath, out); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// RunKeyRotation reloads master keys and re-wraps data keys of objects with @prefix every @interval until @ctx is done.
+func (ecm *EncryptedChunkManager) RunKeyRotation(ctx context.Context, prefix string, interval time.Duration) {
+	if interval <= 0 {
+		log.Warn("encrypted chunk manager key rotation disabled", zap.Duration("interval", interval))
+		return
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			log.Info("encrypted chunk manager key rotation quit")
+			return
+		case <-ticker.C:
+			if err := ecm.keyProvider.Reload(); err != nil {
+				log.Warn("failed to reload encryption keys", zap.Error(err))
+				continue
+			}
+			rewrapped, err := ecm.RewrapKeys(ctx, prefix)
+			if err != nil {
+				log.Warn("failed to re-wrap some data keys", zap.Int("rewrapped", rewrapped), zap.Error(err))
+				continue
+			}
+			if rewrapped > 0 {
+				log.Info("re-wrapped data keys with current master key",
+					zap.String("keyID", ecm.keyProvider.CurrentKeyID()), zap.Int("rewrapped", rewrapped))
+			}
+		}
+	}
+}
@@ -0,0 +1,31 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build !linux
+// +build !linux
+
+package storage
+
+import (
+	"errors"
+
+	"golang.org/x/exp/mmap"
+)
+
+// mmapBytes is not supported, plaintext must not be written to disk to be mapped.
+func mmapBytes(name string, content []byte) (*mmap.ReaderAt, error) {
+	return nil, errors.New("mmap of encrypted objects is only supported on linux")
+}
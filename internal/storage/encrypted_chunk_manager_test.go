@@ -0,0 +1,348 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"io"
+	"io/ioutil"
+	"path"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func writeKeyFile(t *testing.T, filePath string, current string, keyIDs ...string) {
+	keys := make(map[string]string)
+	for _, keyID := range keyIDs {
+		key := sha256.Sum256([]byte(keyID))
+		keys[keyID] = base64.StdEncoding.EncodeToString(key[:])
+	}
+	content, err := json.Marshal(&fileKeys{Current: current, Keys: keys})
+	require.NoError(t, err)
+	require.NoError(t, ioutil.WriteFile(filePath, content, 0600))
+}
+
+func newTestEncryptedCM(t *testing.T, chunkSize int64) (*EncryptedChunkManager, *LocalChunkManager, string) {
+	dir := t.TempDir()
+	keyFile := filepath.Join(dir, "keys.json")
+	writeKeyFile(t, keyFile, "key-1", "key-1")
+	provider, err := NewFileKeyProvider(keyFile)
+	require.NoError(t, err)
+	inner := NewLocalChunkManager(RootPath(filepath.Join(dir, "data")))
+	ecm, err := NewEncryptedChunkManager(inner, provider, chunkSize, "plain")
+	require.NoError(t, err)
+	return ecm, inner, keyFile
+}
+
+func TestFileKeyProvider(t *testing.T) {
+	dir := t.TempDir()
+	keyFile := filepath.Join(dir, "keys.json")
+	writeKeyFile(t, keyFile, "key-1", "key-1", "key-2")
+
+	provider, err := NewFileKeyProvider(keyFile)
+	assert.NoError(t, err)
+	assert.Equal(t, "key-1", provider.CurrentKeyID())
+	key, err := provider.GetKey("key-2")
+	assert.NoError(t, err)
+	assert.Equal(t, 32, len(key))
+	_, err = provider.GetKey("key-3")
+	assert.Error(t, err)
+
+	writeKeyFile(t, keyFile, "key-2", "key-1", "key-2")
+	assert.NoError(t, provider.Reload())
+	assert.Equal(t, "key-2", provider.CurrentKeyID())
+
+	// current key missing
+	writeKeyFile(t, keyFile, "key-3", "key-1")
+	assert.Error(t, provider.Reload())
+	assert.Equal(t, "key-2", provider.CurrentKeyID())
+
+	// invalid keys
+	require.NoError(t, ioutil.WriteFile(keyFile, []byte(`{"current":"a","keys":{"a":"AAAA"}}`), 0600))
+	_, err = NewFileKeyProvider(keyFile)
+	assert.Error(t, err)
+	require.NoError(t, ioutil.WriteFile(keyFile, []byte(`{"current":"a","keys":{"a":"!"}}`), 0600))
+	_, err = NewFileKeyProvider(keyFile)
+	assert.Error(t, err)
+	require.NoError(t, ioutil.WriteFile(keyFile, []byte(`{`), 0600))
+	_, err = NewFileKeyProvider(keyFile)
+	assert.Error(t, err)
+	_, err = NewFileKeyProvider(filepath.Join(dir, "not_exist"))
+	assert.Error(t, err)
+}
+
+func TestEncryptedCM(t *testing.T) {
+	ctx := context.Background()
+	content := make([]byte, 100)
+	for i := range content {
+		content[i] = byte(i)
+	}
+
+	t.Run("test write and read", func(t *testing.T) {
+		ecm, inner, _ := newTestEncryptedCM(t, 16)
+		key := "a/b"
+		require.NoError(t, ecm.Write(ctx, key, content))
+
+		raw, err := inner.Read(ctx, key)
+		assert.NoError(t, err)
+		assert.True(t, isEncrypted(raw))
+		assert.False(t, bytes.Contains(raw, content[:16]))
+
+		data, err := ecm.Read(ctx, key)
+		assert.NoError(t, err)
+		assert.Equal(t, content, data)
+
+		size, err := ecm.Size(ctx, key)
+		assert.NoError(t, err)
+		assert.Equal(t, int64(100), size)
+
+		reader, err := ecm.Reader(ctx, key)
+		assert.NoError(t, err)
+		data, err = ioutil.ReadAll(reader)
+		assert.NoError(t, err)
+		assert.Equal(t, content, data)
+
+		require.NoError(t, ecm.MultiWrite(ctx, map[string][]byte{"a/c": content[:10], "a/d": {}}))
+		keys, values, err := ecm.ReadWithPrefix(ctx, "a/")
+		assert.NoError(t, err)
+		assert.Equal(t, 3, len(keys))
+		for i, key := range keys {
+			switch path.Base(key) {
+			case "b":
+				assert.Equal(t, content, values[i])
+			case "c":
+				assert.Equal(t, content[:10], values[i])
+			case "d":
+				assert.Equal(t, 0, len(values[i]))
+			}
+		}
+		multi, err := ecm.MultiRead(ctx, []string{"a/c", "a/d"})
+		assert.NoError(t, err)
+		assert.Equal(t, content[:10], multi[0])
+
+		require.NoError(t, ecm.RemoveWithPrefix(ctx, "a/"))
+		exist, err := ecm.Exist(ctx, key)
+		assert.NoError(t, err)
+		assert.False(t, exist)
+	})
+
+	t.Run("test read at", func(t *testing.T) {
+		ecm, _, _ := newTestEncryptedCM(t, 16)
+		key := "read_at"
+		require.NoError(t, ecm.Write(ctx, key, content))
+
+		for _, c := range []struct{ off, length int64 }{{0, 100}, {0, 1}, {15, 2}, {16, 16}, {30, 50}, {99, 1}, {50, 0}} {
+			data, err := ecm.ReadAt(ctx, key, c.off, c.length)
+			assert.NoError(t, err)
+			assert.Equal(t, content[c.off:c.off+c.length], data)
+		}
+		_, err := ecm.ReadAt(ctx, key, 90, 20)
+		assert.ErrorIs(t, err, io.EOF)
+		_, err = ecm.ReadAt(ctx, key, -1, 20)
+		assert.ErrorIs(t, err, io.EOF)
+	})
+
+	t.Run("test mmap", func(t *testing.T) {
+		ecm, _, _ := newTestEncryptedCM(t, 16)
+		key := "mmap"
+		require.NoError(t, ecm.Write(ctx, key, content))
+		reader, err := ecm.Mmap(ctx, key)
+		if err != nil {
+			t.Skip("mmap of encrypted objects is not supported", err)
+		}
+		defer reader.Close()
+		assert.Equal(t, 100, reader.Len())
+		data := make([]byte, 100)
+		_, err = reader.ReadAt(data, 0)
+		assert.NoError(t, err)
+		assert.Equal(t, content, data)
+	})
+
+	t.Run("test plaintext objects", func(t *testing.T) {
+		ecm, inner, _ := newTestEncryptedCM(t, 16)
+
+		// objects under plaintext prefixes are not encrypted
+		require.NoError(t, ecm.Write(ctx, "plain/a", content))
+		raw, err := inner.Read(ctx, "plain/a")
+		assert.NoError(t, err)
+		assert.Equal(t, content, raw)
+
+		// objects written without encryption are readable
+		require.NoError(t, inner.Write(ctx, "legacy", content))
+		require.NoError(t, inner.Write(ctx, "short", []byte("abc")))
+		data, err := ecm.Read(ctx, "legacy")
+		assert.NoError(t, err)
+		assert.Equal(t, content, data)
+		data, err = ecm.ReadAt(ctx, "legacy", 10, 5)
+		assert.NoError(t, err)
+		assert.Equal(t, content[10:15], data)
+		data, err = ecm.ReadAt(ctx, "short", 1, 2)
+		assert.NoError(t, err)
+		assert.Equal(t, []byte("bc"), data)
+		size, err := ecm.Size(ctx, "legacy")
+		assert.NoError(t, err)
+		assert.Equal(t, int64(100), size)
+	})
+
+	t.Run("test tampered object", func(t *testing.T) {
+		ecm, inner, _ := newTestEncryptedCM(t, 16)
+		key := "tampered"
+		require.NoError(t, ecm.Write(ctx, key, content))
+		raw, err := inner.Read(ctx, key)
+		require.NoError(t, err)
+
+		flipped := append([]byte{}, raw...)
+		flipped[len(flipped)-1] ^= 1
+		require.NoError(t, inner.Write(ctx, key, flipped))
+		_, err = ecm.Read(ctx, key)
+		assert.True(t, errors.Is(err, ErrInvalidEncryptedObject))
+		_, err = ecm.ReadAt(ctx, key, 96, 4)
+		assert.True(t, errors.Is(err, ErrInvalidEncryptedObject))
+
+		require.NoError(t, inner.Write(ctx, key, raw[:len(raw)-16-4]))
+		_, err = ecm.Read(ctx, key)
+		assert.True(t, errors.Is(err, ErrInvalidEncryptedObject))
+	})
+
+	t.Run("test key rotation", func(t *testing.T) {
+		ecm, inner, keyFile := newTestEncryptedCM(t, 16)
+		require.NoError(t, ecm.Write(ctx, "rotate/a", content))
+		require.NoError(t, ecm.Write(ctx, "rotate/b", content[:33]))
+		require.NoError(t, inner.Write(ctx, "rotate/legacy", content))
+		before, err := inner.Read(ctx, "rotate/a")
+		require.NoError(t, err)
+		// another instance caching the header before rotation
+		other, err := NewEncryptedChunkManager(inner, ecm.keyProvider, 16)
+		require.NoError(t, err)
+		_, err = other.ReadAt(ctx, "rotate/a", 0, 1)
+		require.NoError(t, err)
+
+		writeKeyFile(t, keyFile, "key-10", "key-1", "key-10")
+		require.NoError(t, ecm.keyProvider.Reload())
+		rewrapped, err := ecm.RewrapKeys(ctx, "rotate/")
+		assert.NoError(t, err)
+		assert.Equal(t, 2, rewrapped)
+
+		after, err := inner.Read(ctx, "rotate/a")
+		require.NoError(t, err)
+		oldHeader, err := decodeEncryptionHeader(before)
+		require.NoError(t, err)
+		newHeader, err := decodeEncryptionHeader(after)
+		require.NoError(t, err)
+		assert.Equal(t, "key-10", newHeader.keyID)
+		assert.Equal(t, before[oldHeader.length():], after[newHeader.length():])
+
+		// stale header is reloaded
+		data, err := other.ReadAt(ctx, "rotate/a", 50, 10)
+		assert.NoError(t, err)
+		assert.Equal(t, content[50:60], data)
+
+		// old key removed, objects are still readable
+		writeKeyFile(t, keyFile, "key-10", "key-10")
+		require.NoError(t, ecm.keyProvider.Reload())
+		data, err = ecm.Read(ctx, "rotate/a")
+		assert.NoError(t, err)
+		assert.Equal(t, content, data)
+		data, err = ecm.ReadAt(ctx, "rotate/b", 20, 13)
+		assert.NoError(t, err)
+		assert.Equal(t, content[20:33], data)
+
+		rewrapped, err = ecm.RewrapKeys(ctx, "rotate/")
+		assert.NoError(t, err)
+		assert.Equal(t, 0, rewrapped)
+
+		canceled, cancel := context.WithCancel(ctx)
+		cancel()
+		ecm.RunKeyRotation(canceled, "rotate/", time.Millisecond)
+		ecm.RunKeyRotation(ctx, "rotate/", 0)
+	})
+
+	t.Run("test reload unknown key", func(t *testing.T) {
+		ecm, inner, keyFile := newTestEncryptedCM(t, 16)
+		// another node loading the keys before rotation
+		provider, err := NewFileKeyProvider(keyFile)
+		require.NoError(t, err)
+		other, err := NewEncryptedChunkManager(inner, provider, 16)
+		require.NoError(t, err)
+
+		writeKeyFile(t, keyFile, "key-2", "key-1", "key-2")
+		require.NoError(t, ecm.keyProvider.Reload())
+		require.NoError(t, ecm.Write(ctx, "reload/a", content))
+
+		data, err := other.Read(ctx, "reload/a")
+		assert.NoError(t, err)
+		assert.Equal(t, content, data)
+		assert.Equal(t, "key-2", provider.CurrentKeyID())
+
+		// the key is unknown even after reload
+		writeKeyFile(t, keyFile, "key-3", "key-3")
+		require.NoError(t, ecm.keyProvider.Reload())
+		require.NoError(t, ecm.Write(ctx, "reload/b", content))
+		writeKeyFile(t, keyFile, "key-2", "key-2")
+		_, err = other.Read(ctx, "reload/b")
+		assert.True(t, errors.Is(err, ErrEncryptionKeyNotFound))
+	})
+
+	t.Run("test require encryption", func(t *testing.T) {
+		ecm, inner, _ := newTestEncryptedCM(t, 16)
+		ecm.requireEncryption = true
+		require.NoError(t, ecm.Write(ctx, "require/encrypted", content))
+		require.NoError(t, inner.Write(ctx, "require/plain", content))
+		require.NoError(t, inner.Write(ctx, "require/short", content[:4]))
+		require.NoError(t, ecm.Write(ctx, "plain/a", content))
+
+		data, err := ecm.Read(ctx, "require/encrypted")
+		assert.NoError(t, err)
+		assert.Equal(t, content, data)
+		data, err = ecm.Read(ctx, "plain/a")
+		assert.NoError(t, err)
+		assert.Equal(t, content, data)
+
+		_, err = ecm.Read(ctx, "require/plain")
+		assert.True(t, errors.Is(err, ErrUnencryptedObject))
+		_, err = ecm.Read(ctx, "require/short")
+		assert.True(t, errors.Is(err, ErrUnencryptedObject))
+		_, err = ecm.ReadAt(ctx, "require/plain", 0, 10)
+		assert.True(t, errors.Is(err, ErrUnencryptedObject))
+		_, err = ecm.Size(ctx, "require/plain")
+		assert.True(t, errors.Is(err, ErrUnencryptedObject))
+		_, err = ecm.Mmap(ctx, "require/plain")
+		assert.True(t, errors.Is(err, ErrUnencryptedObject))
+
+		// unencrypted objects are skipped by key rotation
+		rewrapped, err := ecm.RewrapKeys(ctx, "require/")
+		assert.NoError(t, err)
+		assert.Equal(t, 0, rewrapped)
+	})
+
+	t.Run("test invalid chunk size", func(t *testing.T) {
+		_, _, keyFile := newTestEncryptedCM(t, 16)
+		provider, err := NewFileKeyProvider(keyFile)
+		require.NoError(t, err)
+		_, err = NewEncryptedChunkManager(NewLocalChunkManager(), provider, 0)
+		assert.Error(t, err)
+	})
+}
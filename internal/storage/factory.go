@@ -13,10 +13,15 @@ type ChunkManagerFactory struct {
 }
 
 func NewChunkManagerFactoryWithParam(params *paramtable.ComponentParam) *ChunkManagerFactory {
+	encryption := Encryption(params.CommonCfg.StorageEncryptionKeyFile, params.CommonCfg.StorageEncryptionChunkSize)
+	encryptionRequired := EncryptionRequired(params.CommonCfg.StorageEncryptionRequired)
 	if params.CommonCfg.StorageType == "local" {
-		return NewChunkManagerFactory("local", RootPath(params.LocalStorageCfg.Path))
+		return NewChunkManagerFactory("local", RootPath(params.LocalStorageCfg.Path), encryption, encryptionRequired)
 	}
-	return NewChunkManagerFactory("minio",
+	if params.CommonCfg.StorageType == "durable" {
+		return NewChunkManagerFactory("durable", RootPath(params.LocalStorageCfg.Path), encryption, encryptionRequired)
+	}
+	return NewChunkManagerFactory("minio", encryption, encryptionRequired,
 		RootPath(params.MinioCfg.RootPath),
 		Address(params.MinioCfg.Address),
 		AccessKeyID(params.MinioCfg.AccessKeyID),
@@ -44,6 +49,8 @@ func (f *ChunkManagerFactory) newChunkManager(ctx context.Context, engine string
 	switch engine {
 	case "local":
 		return NewLocalChunkManager(RootPath(f.config.rootPath)), nil
+	case "durable":
+		return NewDurableChunkManager(RootPath(f.config.rootPath)), nil
 	case "minio":
 		return newMinioChunkManagerWithConfig(ctx, f.config)
 	default:
@@ -52,7 +59,22 @@ func (f *ChunkManagerFactory) newChunkManager(ctx context.Context, engine string
 }
 
 func (f *ChunkManagerFactory) NewPersistentStorageChunkManager(ctx context.Context) (ChunkManager, error) {
-	return f.newChunkManager(ctx, f.persistentStorage)
+	cm, err := f.newChunkManager(ctx, f.persistentStorage)
+	if err != nil || f.config.encryptionKeyFile == "" {
+		return cm, err
+	}
+	keyProvider, err := NewFileKeyProvider(f.config.encryptionKeyFile)
+	if err != nil {
+		return nil, err
+	}
+	// DiskANN index files are written and opened by segcore by path, they never pass the chunk manager and
+	// stay unencrypted. Everything else is encrypted, including the in-memory index files QueryNode reads.
+	ecm, err := NewEncryptedChunkManager(cm, keyProvider, f.config.encryptionChunkSize)
+	if err != nil {
+		return nil, err
+	}
+	ecm.requireEncryption = f.config.encryptionRequired
+	return ecm, nil
 }
 
 type Factory interface {
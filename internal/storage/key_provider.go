@@ -0,0 +1,124 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"crypto/aes"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"sync"
+)
+
+// ErrEncryptionKeyNotFound is returned by KeyProvider.GetKey for unknown key ids.
+var ErrEncryptionKeyNotFound = errors.New("encryption key not found")
+
+// KeyProvider provides the master keys used to wrap the per-object data keys of EncryptedChunkManager.
+type KeyProvider interface {
+	// CurrentKeyID returns the id of the master key used to wrap new data keys.
+	CurrentKeyID() string
+	// GetKey returns the master key of @keyID.
+	GetKey(keyID string) ([]byte, error)
+	// Reload refreshes the keys from the key source, it's called before key rotation
+	// and when an object is wrapped by an unknown key.
+	Reload() error
+}
+
+// fileKeys is the content of the key file of FileKeyProvider, keys are base64 encoded.
+type fileKeys struct {
+	Current string            `json:"current"`
+	Keys    map[string]string `json:"keys"`
+}
+
+// FileKeyProvider is a KeyProvider reading master keys from a local json file, for example:
+//
+//	{"current": "key-2", "keys": {"key-1": "<base64 AES key>", "key-2": "<base64 AES key>"}}
+//
+// To rotate the master key, add a new key to the file and point current to it,
+// old keys must be kept until all objects are re-wrapped.
+type FileKeyProvider struct {
+	filePath string
+
+	mu           sync.RWMutex
+	currentKeyID string
+	keys         map[string][]byte
+}
+
+var _ KeyProvider = (*FileKeyProvider)(nil)
+
+// NewFileKeyProvider creates a FileKeyProvider and loads keys from @filePath.
+func NewFileKeyProvider(filePath string) (*FileKeyProvider, error) {
+	p := &FileKeyProvider{
+		filePath: filePath,
+	}
+	if err := p.Reload(); err != nil {
+		return nil, err
+	}
+	return p, nil
+}
+
+// CurrentKeyID returns the id of the current master key.
+func (p *FileKeyProvider) CurrentKeyID() string {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	return p.currentKeyID
+}
+
+// GetKey returns the master key of @keyID.
+func (p *FileKeyProvider) GetKey(keyID string) ([]byte, error) {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	key, ok := p.keys[keyID]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrEncryptionKeyNotFound, keyID)
+	}
+	return key, nil
+}
+
+// Reload reads the key file again.
+func (p *FileKeyProvider) Reload() error {
+	content, err := ioutil.ReadFile(p.filePath)
+	if err != nil {
+		return err
+	}
+	fk := &fileKeys{}
+	if err := json.Unmarshal(content, fk); err != nil {
+		return fmt.Errorf("failed to parse key file %s: %w", p.filePath, err)
+	}
+	keys := make(map[string][]byte, len(fk.Keys))
+	for keyID, encoded := range fk.Keys {
+		key, err := base64.StdEncoding.DecodeString(encoded)
+		if err != nil {
+			return fmt.Errorf("invalid encryption key %s: %w", keyID, err)
+		}
+		if _, err := aes.NewCipher(key); err != nil {
+			return fmt.Errorf("invalid encryption key %s: %w", keyID, err)
+		}
+		keys[keyID] = key
+	}
+	if _, ok := keys[fk.Current]; !ok {
+		return fmt.Errorf("current encryption key not found in key file: %s", fk.Current)
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.currentKeyID = fk.Current
+	p.keys = keys
+	return nil
+}
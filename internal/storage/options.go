@@ -12,6 +12,10 @@ type config struct {
 	useIAM            bool
 	cloudProvider     string
 	iamEndpoint       string
+
+	encryptionKeyFile   string
+	encryptionChunkSize int64
+	encryptionRequired  bool
 }
 
 func newDefaultConfig() *config {
@@ -79,3 +83,18 @@ func IAMEndpoint(iamEndpoint string) Option {
 		c.iamEndpoint = iamEndpoint
 	}
 }
+
+// Encryption enables client side encryption with master keys in @keyFile.
+func Encryption(keyFile string, chunkSize int64) Option {
+	return func(c *config) {
+		c.encryptionKeyFile = keyFile
+		c.encryptionChunkSize = chunkSize
+	}
+}
+
+// EncryptionRequired rejects reading objects without the encryption header if encryption is enabled.
+func EncryptionRequired(required bool) Option {
+	return func(c *config) {
+		c.encryptionRequired = required
+	}
+}
@@ -29,6 +29,8 @@ import (
 	"reflect"
 	"unsafe"
 
+	"github.com/golang/protobuf/proto"
+
 	"github.com/milvus-io/milvus-proto/go-api/schemapb"
 	"github.com/milvus-io/milvus/internal/util/typeutil"
 )
@@ -45,6 +47,8 @@ type PayloadWriterInterface interface {
 	AddFloatToPayload(msgs []float32) error
 	AddDoubleToPayload(msgs []float64) error
 	AddOneStringToPayload(msgs string) error
+	AddOneJSONToPayload(msg []byte) error
+	AddOneArrayToPayload(msg *schemapb.ScalarField) error
 	AddBinaryVectorToPayload(binVec []byte, dim int) error
 	AddFloatVectorToPayload(binVec []float32, dim int) error
 	FinishPayloadWriter() error
@@ -66,6 +70,8 @@ type PayloadReaderInterface interface {
 	GetFloatFromPayload() ([]float32, error)
 	GetDoubleFromPayload() ([]float64, error)
 	GetStringFromPayload() ([]string, error)
+	GetJSONFromPayload() ([][]byte, error)
+	GetArrayFromPayload() ([]*schemapb.ScalarField, error)
 	GetBinaryVectorFromPayload() ([]byte, int, error)
 	GetFloatVectorFromPayload() ([]float32, int, error)
 	GetPayloadLengthFromReader() (int, error)
@@ -149,6 +155,18 @@ func (w *PayloadWriter) AddDataToPayload(msgs interface{}, dim ...int) error {
 				return errors.New("incorrect data type")
 			}
 			return w.AddOneStringToPayload(val)
+		case typeutil.DataTypeJSON:
+			val, ok := msgs.([]byte)
+			if !ok {
+				return errors.New("incorrect data type")
+			}
+			return w.AddOneJSONToPayload(val)
+		case typeutil.DataTypeArray:
+			val, ok := msgs.(*schemapb.ScalarField)
+			if !ok {
+				return errors.New("incorrect data type")
+			}
+			return w.AddOneArrayToPayload(val)
 		default:
 			return errors.New("incorrect datatype")
 		}
@@ -289,6 +307,35 @@ func (w *PayloadWriter) AddOneStringToPayload(msg string) error {
 	return HandleCStatus(&status, "AddOneStringToPayload failed")
 }
 
+// AddOneJSONToPayload adds one serialized json document into payload
+func (w *PayloadWriter) AddOneJSONToPayload(msg []byte) error {
+	length := len(msg)
+	if length <= 0 {
+		return errors.New("can't add empty json into payload")
+	}
+	cmsg := C.CBytes(msg)
+	clength := C.int(length)
+	defer C.free(cmsg)
+
+	status := C.AddOneJSONToPayload(w.payloadWriterPtr, (*C.uint8_t)(cmsg), clength)
+	return HandleCStatus(&status, "AddOneJSONToPayload failed")
+}
+
+// AddOneArrayToPayload adds the elements of one array row into payload, the row is stored serialized
+func (w *PayloadWriter) AddOneArrayToPayload(msg *schemapb.ScalarField) error {
+	bytes, err := proto.Marshal(msg)
+	if err != nil {
+		return err
+	}
+	length := len(bytes)
+	cmsg := C.CBytes(bytes)
+	clength := C.int(length)
+	defer C.free(cmsg)
+
+	status := C.AddOneArrayToPayload(w.payloadWriterPtr, (*C.uint8_t)(cmsg), clength)
+	return HandleCStatus(&status, "AddOneArrayToPayload failed")
+}
+
 // AddBinaryVectorToPayload dimension > 0 && (%8 == 0)
 func (w *PayloadWriter) AddBinaryVectorToPayload(binVec []byte, dim int) error {
 	length := len(binVec)
@@ -8,8 +8,10 @@ import (
 	"github.com/apache/arrow/go/v8/arrow"
 	"github.com/apache/arrow/go/v8/parquet"
 	"github.com/apache/arrow/go/v8/parquet/file"
+	"github.com/golang/protobuf/proto"
 
 	"github.com/milvus-io/milvus-proto/go-api/schemapb"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
 )
 
 // PayloadReader reads data from payload
@@ -65,6 +67,12 @@ func (r *PayloadReader) GetDataFromPayload() (interface{}, int, error) {
 	case schemapb.DataType_String, schemapb.DataType_VarChar:
 		val, err := r.GetStringFromPayload()
 		return val, 0, err
+	case typeutil.DataTypeJSON:
+		val, err := r.GetJSONFromPayload()
+		return val, 0, err
+	case typeutil.DataTypeArray:
+		val, err := r.GetArrayFromPayload()
+		return val, 0, err
 	default:
 		return nil, 0, errors.New("unknown type")
 	}
@@ -253,6 +261,56 @@ func (r *PayloadReader) GetStringFromPayload() ([]string, error) {
 	return ret, nil
 }
 
+// GetJSONFromPayload returns the serialized json documents of payload
+func (r *PayloadReader) GetJSONFromPayload() ([][]byte, error) {
+	if r.colType != typeutil.DataTypeJSON {
+		return nil, fmt.Errorf("failed to get json from datatype %v", r.colType.String())
+	}
+
+	values := make([]parquet.ByteArray, r.numRows)
+	valuesRead, err := ReadDataFromAllRowGroups[parquet.ByteArray, *file.ByteArrayColumnChunkReader](r.reader, values, 0, r.numRows)
+	if err != nil {
+		return nil, err
+	}
+
+	if valuesRead != r.numRows {
+		return nil, fmt.Errorf("expect %d rows, but got valuesRead = %d", r.numRows, valuesRead)
+	}
+
+	ret := make([][]byte, r.numRows)
+	for i := 0; i < int(r.numRows); i++ {
+		ret[i] = make([]byte, len(values[i]))
+		copy(ret[i], values[i])
+	}
+	return ret, nil
+}
+
+// GetArrayFromPayload returns the array rows of payload
+func (r *PayloadReader) GetArrayFromPayload() ([]*schemapb.ScalarField, error) {
+	if r.colType != typeutil.DataTypeArray {
+		return nil, fmt.Errorf("failed to get array from datatype %v", r.colType.String())
+	}
+
+	values := make([]parquet.ByteArray, r.numRows)
+	valuesRead, err := ReadDataFromAllRowGroups[parquet.ByteArray, *file.ByteArrayColumnChunkReader](r.reader, values, 0, r.numRows)
+	if err != nil {
+		return nil, err
+	}
+
+	if valuesRead != r.numRows {
+		return nil, fmt.Errorf("expect %d rows, but got valuesRead = %d", r.numRows, valuesRead)
+	}
+
+	ret := make([]*schemapb.ScalarField, r.numRows)
+	for i := 0; i < int(r.numRows); i++ {
+		ret[i] = &schemapb.ScalarField{}
+		if err := proto.Unmarshal(values[i], ret[i]); err != nil {
+			return nil, err
+		}
+	}
+	return ret, nil
+}
+
 // GetBinaryVectorFromPayload returns vector, dimension, error
 func (r *PayloadReader) GetBinaryVectorFromPayload() ([]byte, int, error) {
 	if r.colType != schemapb.DataType_BinaryVector {
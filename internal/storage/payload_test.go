@@ -24,6 +24,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/milvus-io/milvus-proto/go-api/schemapb"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
 )
 
 func TestPayload_ReaderAndWriter(t *testing.T) {
@@ -332,6 +333,84 @@ func TestPayload_ReaderAndWriter(t *testing.T) {
 		w.ReleasePayloadWriter()
 	})
 
+	t.Run("TestAddJSON", func(t *testing.T) {
+		w, err := NewPayloadWriter(typeutil.DataTypeJSON)
+		require.Nil(t, err)
+		require.NotNil(t, w)
+
+		err = w.AddOneJSONToPayload([]byte(`{"brand":{"name":"x"}}`))
+		assert.Nil(t, err)
+		err = w.AddDataToPayload([]byte(`{"tags":[1,2]}`))
+		assert.Nil(t, err)
+		err = w.AddOneJSONToPayload(nil)
+		assert.NotNil(t, err)
+		err = w.FinishPayloadWriter()
+		assert.Nil(t, err)
+		length, err := w.GetPayloadLengthFromWriter()
+		assert.Nil(t, err)
+		assert.Equal(t, length, 2)
+		buffer, err := w.GetPayloadBufferFromWriter()
+		assert.Nil(t, err)
+
+		r, err := NewPayloadReader(typeutil.DataTypeJSON, buffer)
+		assert.Nil(t, err)
+		length, err = r.GetPayloadLengthFromReader()
+		assert.Nil(t, err)
+		assert.Equal(t, length, 2)
+
+		values, err := r.GetJSONFromPayload()
+		assert.Nil(t, err)
+		assert.Equal(t, []byte(`{"brand":{"name":"x"}}`), values[0])
+		assert.Equal(t, []byte(`{"tags":[1,2]}`), values[1])
+
+		ivalues, _, err := r.GetDataFromPayload()
+		assert.Nil(t, err)
+		assert.Equal(t, values, ivalues.([][]byte))
+		r.ReleasePayloadReader()
+		w.ReleasePayloadWriter()
+	})
+
+	t.Run("TestAddArray", func(t *testing.T) {
+		w, err := NewPayloadWriter(typeutil.DataTypeArray)
+		require.Nil(t, err)
+		require.NotNil(t, w)
+
+		row0 := &schemapb.ScalarField{
+			Data: &schemapb.ScalarField_StringData{StringData: &schemapb.StringArray{Data: []string{"a", "b"}}},
+		}
+		row1 := &schemapb.ScalarField{
+			Data: &schemapb.ScalarField_StringData{StringData: &schemapb.StringArray{Data: []string{"c"}}},
+		}
+		err = w.AddOneArrayToPayload(row0)
+		assert.Nil(t, err)
+		err = w.AddDataToPayload(row1)
+		assert.Nil(t, err)
+		err = w.FinishPayloadWriter()
+		assert.Nil(t, err)
+		length, err := w.GetPayloadLengthFromWriter()
+		assert.Nil(t, err)
+		assert.Equal(t, length, 2)
+		buffer, err := w.GetPayloadBufferFromWriter()
+		assert.Nil(t, err)
+
+		r, err := NewPayloadReader(typeutil.DataTypeArray, buffer)
+		assert.Nil(t, err)
+		length, err = r.GetPayloadLengthFromReader()
+		assert.Nil(t, err)
+		assert.Equal(t, length, 2)
+
+		values, err := r.GetArrayFromPayload()
+		assert.Nil(t, err)
+		assert.Equal(t, []string{"a", "b"}, values[0].GetStringData().GetData())
+		assert.Equal(t, []string{"c"}, values[1].GetStringData().GetData())
+
+		ivalues, _, err := r.GetDataFromPayload()
+		assert.Nil(t, err)
+		assert.Equal(t, len(values), len(ivalues.([]*schemapb.ScalarField)))
+		r.ReleasePayloadReader()
+		w.ReleasePayloadWriter()
+	})
+
 	t.Run("TestBinaryVector", func(t *testing.T) {
 		w, err := NewPayloadWriter(schemapb.DataType_BinaryVector, 8)
 		require.Nil(t, err)
@@ -27,7 +27,9 @@ import (
 	"github.com/golang/protobuf/proto"
 	"github.com/milvus-io/milvus-proto/go-api/schemapb"
 	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/internal/util/compressor"
 	"github.com/milvus-io/milvus/internal/util/tsoutil"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
 )
 
 // PrintBinlogFiles call printBinlogFile in turn for the file list specified by parameter fileList.
@@ -85,7 +87,7 @@ func printBinlogFile(filename string) error {
 	fmt.Printf("\tStartTimestamp: %v\n", physical)
 	physical, _ = tsoutil.ParseTS(r.descriptorEvent.descriptorEventData.EndTimestamp)
 	fmt.Printf("\tEndTimestamp: %v\n", physical)
-	dataTypeName, ok := schemapb.DataType_name[int32(r.descriptorEvent.descriptorEventData.PayloadDataType)]
+	dataTypeName, ok := typeutil.GetDataTypeName(r.descriptorEvent.descriptorEventData.PayloadDataType)
 	if !ok {
 		return fmt.Errorf("undefine data type %d", r.descriptorEvent.descriptorEventData.PayloadDataType)
 	}
@@ -130,7 +132,7 @@ func printBinlogFile(filename string) error {
 			fmt.Printf("\tStartTimestamp: %v\n", physical)
 			physical, _ = tsoutil.ParseTS(evd.EndTimestamp)
 			fmt.Printf("\tEndTimestamp: %v\n", physical)
-			if err := printPayloadValues(r.descriptorEvent.descriptorEventData.PayloadDataType, event.PayloadReaderInterface); err != nil {
+			if err := printDeletePayloadValues(r, event); err != nil {
 				return err
 			}
 		case CreateCollectionEventType:
@@ -206,7 +208,11 @@ func printBinlogFile(filename string) error {
 			physical, _ = tsoutil.ParseTS(evd.EndTimestamp)
 			fmt.Printf("\tEndTimestamp: %v\n", physical)
 			key := fmt.Sprintf("%v", extra["key"])
-			if err := printIndexFilePayloadValues(event.PayloadReaderInterface, key); err != nil {
+			compressType, err := getCompressType(extra)
+			if err != nil {
+				return err
+			}
+			if err := printIndexFilePayloadValues(event.PayloadReaderInterface, key, compressType); err != nil {
 				return err
 			}
 		default:
@@ -290,6 +296,22 @@ func printPayloadValues(colType schemapb.DataType, reader PayloadReaderInterface
 		for i := 0; i < rows; i++ {
 			fmt.Printf("\t\t%d : %s\n", i, val[i])
 		}
+	case typeutil.DataTypeJSON:
+		val, err := reader.GetJSONFromPayload()
+		if err != nil {
+			return err
+		}
+		for i, v := range val {
+			fmt.Printf("\t\t%d : %s\n", i, v)
+		}
+	case typeutil.DataTypeArray:
+		val, err := reader.GetArrayFromPayload()
+		if err != nil {
+			return err
+		}
+		for i, v := range val {
+			fmt.Printf("\t\t%d : %s\n", i, v.String())
+		}
 	case schemapb.DataType_BinaryVector:
 		val, dim, err := reader.GetBinaryVectorFromPayload()
 		if err != nil {
@@ -384,10 +406,32 @@ func printDDLPayloadValues(eventType EventTypeCode, colType schemapb.DataType, r
 	return nil
 }
 
-// only print slice meta and index params
-func printIndexFilePayloadValues(reader PayloadReaderInterface, key string) error {
-	if key == IndexParamsKey {
+// printDeletePayloadValues prints the delete messages of a deltalog, which are compressed as one byte payload
+// if the deltalog is written with compression.
+func printDeletePayloadValues(binlogReader *BinlogReader, eventReader *EventReader) error {
+	fmt.Println("\tpayload values:")
+	val, err := ReadDeleteLogStrings(binlogReader, eventReader)
+	if err != nil {
+		return err
+	}
+	for i, v := range val {
+		fmt.Printf("\t\t%d : %s\n", i, v)
+	}
+	return nil
+}
+
+// only print slice meta and index params, both are decompressed if the index file is written with compression
+func printIndexFilePayloadValues(reader PayloadReaderInterface, key string, compressType compressor.CompressType) error {
+	readContent := func() ([]byte, error) {
 		content, err := reader.GetByteFromPayload()
+		if err != nil || compressType == "" {
+			return content, err
+		}
+		return decompressBytes(compressType, content)
+	}
+
+	if key == IndexParamsKey {
+		content, err := readContent()
 		if err != nil {
 			return err
 		}
@@ -398,7 +442,7 @@ func printIndexFilePayloadValues(reader PayloadReaderInterface, key string) erro
 	}
 
 	if key == "SLICE_META" {
-		content, err := reader.GetByteFromPayload()
+		content, err := readContent()
 		if err != nil {
 			return err
 		}
@@ -23,6 +23,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/milvus-io/milvus/internal/util/compressor"
 	"github.com/milvus-io/milvus/internal/util/funcutil"
 	"github.com/milvus-io/milvus/internal/util/uniquegenerator"
 
@@ -495,3 +496,36 @@ func TestPrintIndexFile(t *testing.T) {
 		_ = os.RemoveAll(file)
 	}
 }
+
+func TestPrintCompressedFiles(t *testing.T) {
+	deleteCodec := NewDeleteCodec(WithCompression(compressor.CompressTypeZstd))
+	deleteData := &DeleteData{}
+	deleteData.Append(NewInt64PrimaryKey(1), 100)
+	deleteData.Append(NewInt64PrimaryKey(2), 200)
+	deltaBlob, err := deleteCodec.Serialize(CollectionID, 1, 1, deleteData)
+	assert.Nil(t, err)
+
+	indexCodec := NewIndexFileBinlogCodec(WithCompression(compressor.CompressTypeZstd))
+	indexBlobs, err := indexCodec.Serialize(1, 1, CollectionID, PartitionID, SegmentID, 100,
+		map[string]string{"index_type": "IVF_FLAT"}, "index", 1, []*Blob{
+			{Key: "ivf1", Value: []byte{1, 2, 3}},
+			{Key: "SLICE_META", Value: []byte(`{"meta":[{"name":"IVF","slice_num":1,"total_len":3}]}`)},
+		})
+	assert.Nil(t, err)
+
+	var binlogFiles []string
+	for index, blob := range append([]*Blob{deltaBlob}, indexBlobs...) {
+		fileName := fmt.Sprintf("/tmp/compressed_blob_%d.binlog", index)
+		binlogFiles = append(binlogFiles, fileName)
+		err = ioutil.WriteFile(fileName, blob.GetValue(), 0666)
+		assert.Nil(t, err)
+	}
+	defer func() {
+		for _, file := range binlogFiles {
+			_ = os.RemoveAll(file)
+		}
+	}()
+
+	err = PrintBinlogFiles(binlogFiles)
+	assert.Nil(t, err)
+}
@@ -205,8 +205,12 @@ func (sr *StatsReader) SetBuffer(buffer []byte) {
 
 // GetInt64Stats returns buffer as PrimaryKeyStats
 func (sr *StatsReader) GetPrimaryKeyStats() (*PrimaryKeyStats, error) {
+	buffer, err := maybeDecompressStats(sr.buffer)
+	if err != nil {
+		return nil, err
+	}
 	stats := &PrimaryKeyStats{}
-	err := json.Unmarshal(sr.buffer, &stats)
+	err = json.Unmarshal(buffer, &stats)
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,459 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"bytes"
+	"container/list"
+	"context"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"strings"
+	"sync"
+
+	"go.uber.org/zap"
+	"golang.org/x/exp/mmap"
+	"golang.org/x/sync/singleflight"
+
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/metrics"
+)
+
+// cacheEntry is an object cached on local disk.
+type cacheEntry struct {
+	filePath string
+	// cachePath is the path of the cached file, each load caches the object in a new file,
+	// so that the file of an invalidated entry can be kept until its readers are done
+	cachePath string
+	size      int64
+	// refs counts the readers using the cached file, pinned entries are not evicted
+	refs int
+	// removed is set when a pinned entry is invalidated, its file is removed once it's unpinned
+	removed bool
+}
+
+// TieredChunkManager is a read-through cache in front of a remote ChunkManager,
+// objects read from the remote storage are kept on local disk and evicted in LRU order
+// once the total size of the cached objects exceeds the capacity.
+//
+// Writes and removes go to the remote storage and invalidate the cached copies,
+// cached objects are stored as returned by the wrapped ChunkManager.
+type TieredChunkManager struct {
+	ChunkManager
+	cache    *LocalChunkManager
+	name     string
+	capacity int64
+
+	group singleflight.Group
+
+	mu      sync.Mutex
+	used    int64
+	seq     int64
+	lru     *list.List // front is the most recently used
+	entries map[string]*list.Element
+	// loads is the generation of the latest load of each object in flight, a load is discarded
+	// if the object is invalidated or loaded again before it finishes since its content may be stale
+	loads map[string]int64
+}
+
+var _ ChunkManager = (*TieredChunkManager)(nil)
+
+// NewTieredChunkManager creates a TieredChunkManager caching objects of @remote under @cachePath,
+// @capacity is the max bytes of cached objects and @name labels the cache metrics.
+// Files left in @cachePath are removed since they are not tracked.
+func NewTieredChunkManager(remote ChunkManager, cachePath string, capacity int64, name string) (*TieredChunkManager, error) {
+	if capacity <= 0 {
+		return nil, fmt.Errorf("invalid cache capacity: %d", capacity)
+	}
+	if err := os.RemoveAll(cachePath); err != nil {
+		return nil, err
+	}
+	if err := os.MkdirAll(cachePath, os.ModePerm); err != nil {
+		return nil, err
+	}
+	metrics.StorageCacheUsedBytes.WithLabelValues(name).Set(0)
+	return &TieredChunkManager{
+		ChunkManager: remote,
+		cache:        NewLocalChunkManager(RootPath(cachePath)),
+		name:         name,
+		capacity:     capacity,
+		lru:          list.New(),
+		entries:      make(map[string]*list.Element),
+		loads:        make(map[string]int64),
+	}, nil
+}
+
+// NewDiskCachedChunkManager caches the objects of @cm on local disk with a TieredChunkManager.
+// If @cm is an EncryptedChunkManager, the cache is put below the encryption layer,
+// so that only ciphertext reaches the local disk. It returns the chunk manager to use and the cache.
+func NewDiskCachedChunkManager(cm ChunkManager, cachePath string, capacity int64, name string) (ChunkManager, *TieredChunkManager, error) {
+	ecm, ok := cm.(*EncryptedChunkManager)
+	if !ok {
+		tcm, err := NewTieredChunkManager(cm, cachePath, capacity, name)
+		if err != nil {
+			return nil, nil, err
+		}
+		return tcm, tcm, nil
+	}
+	tcm, err := NewTieredChunkManager(ecm.ChunkManager, cachePath, capacity, name)
+	if err != nil {
+		return nil, nil, err
+	}
+	ecm.ChunkManager = tcm
+	return ecm, tcm, nil
+}
+
+// Used returns the bytes of cached objects.
+func (tcm *TieredChunkManager) Used() int64 {
+	tcm.mu.Lock()
+	defer tcm.mu.Unlock()
+	return tcm.used
+}
+
+// Cached returns true if @filePath is cached on local disk.
+func (tcm *TieredChunkManager) Cached(filePath string) bool {
+	tcm.mu.Lock()
+	defer tcm.mu.Unlock()
+	_, ok := tcm.entries[filePath]
+	return ok
+}
+
+// pin marks the cached @filePath as most recently used and prevents its file from being removed,
+// returns nil if @filePath is not cached.
+func (tcm *TieredChunkManager) pin(filePath string) *cacheEntry {
+	tcm.mu.Lock()
+	defer tcm.mu.Unlock()
+	elem, ok := tcm.entries[filePath]
+	if !ok {
+		return nil
+	}
+	entry := elem.Value.(*cacheEntry)
+	entry.refs++
+	tcm.lru.MoveToFront(elem)
+	return entry
+}
+
+func (tcm *TieredChunkManager) unpin(entry *cacheEntry) {
+	tcm.mu.Lock()
+	defer tcm.mu.Unlock()
+	entry.refs--
+	if entry.removed && entry.refs == 0 {
+		tcm.removeFileLocked(entry)
+	}
+	tcm.evictLocked()
+}
+
+// evictLocked removes the least recently used entries which are not pinned until the cache fits the capacity.
+func (tcm *TieredChunkManager) evictLocked() {
+	for elem := tcm.lru.Back(); elem != nil && tcm.used > tcm.capacity; {
+		prev := elem.Prev()
+		entry := elem.Value.(*cacheEntry)
+		if entry.refs == 0 {
+			tcm.removeLocked(elem)
+			metrics.StorageCacheEvictionCounter.WithLabelValues(tcm.name).Inc()
+		}
+		elem = prev
+	}
+}
+
+// removeLocked drops the entry from the cache, the file of a pinned entry is kept until it's unpinned.
+func (tcm *TieredChunkManager) removeLocked(elem *list.Element) {
+	entry := elem.Value.(*cacheEntry)
+	tcm.lru.Remove(elem)
+	delete(tcm.entries, entry.filePath)
+	entry.removed = true
+	if entry.refs == 0 {
+		tcm.removeFileLocked(entry)
+	}
+}
+
+func (tcm *TieredChunkManager) removeFileLocked(entry *cacheEntry) {
+	tcm.used -= entry.size
+	metrics.StorageCacheUsedBytes.WithLabelValues(tcm.name).Set(float64(tcm.used))
+	// opened readers and mmaps of the file are still valid after it's removed
+	if err := tcm.cache.Remove(context.Background(), entry.cachePath); err != nil {
+		log.Warn("failed to remove cached file", zap.String("cache", tcm.name), zap.String("path", entry.cachePath), zap.Error(err))
+	}
+}
+
+// invalidate drops the cached copies of @filePaths and discards their loads in flight,
+// it must be called after the objects are changed in the remote storage.
+func (tcm *TieredChunkManager) invalidate(filePaths ...string) {
+	tcm.mu.Lock()
+	defer tcm.mu.Unlock()
+	for _, filePath := range filePaths {
+		if elem, ok := tcm.entries[filePath]; ok {
+			tcm.removeLocked(elem)
+		}
+		tcm.discardLoadLocked(filePath)
+	}
+}
+
+// invalidatePrefix drops the cached copies of objects with @prefix and discards their loads in flight.
+func (tcm *TieredChunkManager) invalidatePrefix(prefix string) {
+	tcm.mu.Lock()
+	defer tcm.mu.Unlock()
+	for elem := tcm.lru.Front(); elem != nil; {
+		next := elem.Next()
+		if strings.HasPrefix(elem.Value.(*cacheEntry).filePath, prefix) {
+			tcm.removeLocked(elem)
+		}
+		elem = next
+	}
+	for filePath := range tcm.loads {
+		if strings.HasPrefix(filePath, prefix) {
+			tcm.discardLoadLocked(filePath)
+		}
+	}
+}
+
+// discardLoadLocked keeps the load of @filePath in flight from being cached,
+// and makes the following reads start a new load instead of waiting for it.
+func (tcm *TieredChunkManager) discardLoadLocked(filePath string) {
+	if _, ok := tcm.loads[filePath]; ok {
+		delete(tcm.loads, filePath)
+		tcm.group.Forget(filePath)
+	}
+}
+
+// load downloads @filePath from the remote storage and caches it, concurrent loads of the same object are merged.
+// The download is not bound to @ctx since other callers may be waiting for it, @ctx only bounds the wait.
+func (tcm *TieredChunkManager) load(ctx context.Context, filePath string) ([]byte, error) {
+	ch := tcm.group.DoChan(filePath, func() (interface{}, error) {
+		ctx := context.Background()
+		// the generation is taken before reading, so a write finished after it invalidates this load
+		tcm.mu.Lock()
+		tcm.seq++
+		gen := tcm.seq
+		tcm.loads[filePath] = gen
+		tcm.mu.Unlock()
+		finishLoad := func() bool {
+			if tcm.loads[filePath] != gen {
+				return false
+			}
+			delete(tcm.loads, filePath)
+			return true
+		}
+
+		content, err := tcm.ChunkManager.Read(ctx, filePath)
+		size := int64(len(content))
+		if err != nil || size > tcm.capacity {
+			tcm.mu.Lock()
+			finishLoad()
+			tcm.mu.Unlock()
+			return content, err
+		}
+		entry := &cacheEntry{filePath: filePath, cachePath: fmt.Sprintf("%s.%d", filePath, gen), size: size}
+		if err := tcm.cache.Write(ctx, entry.cachePath, content); err != nil {
+			log.Warn("failed to cache file", zap.String("cache", tcm.name), zap.String("path", filePath), zap.Error(err))
+			tcm.mu.Lock()
+			finishLoad()
+			tcm.mu.Unlock()
+			return content, nil
+		}
+
+		tcm.mu.Lock()
+		defer tcm.mu.Unlock()
+		if !finishLoad() {
+			// the object was changed or loaded again during the load, the content may be stale
+			if err := tcm.cache.Remove(ctx, entry.cachePath); err != nil {
+				log.Warn("failed to remove stale cached file", zap.String("cache", tcm.name), zap.String("path", entry.cachePath), zap.Error(err))
+			}
+			return content, nil
+		}
+		if elem, ok := tcm.entries[filePath]; ok {
+			tcm.removeLocked(elem)
+		}
+		tcm.entries[filePath] = tcm.lru.PushFront(entry)
+		tcm.used += size
+		tcm.evictLocked()
+		metrics.StorageCacheUsedBytes.WithLabelValues(tcm.name).Set(float64(tcm.used))
+		return content, nil
+	})
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	case res := <-ch:
+		if res.Err != nil {
+			return nil, res.Err
+		}
+		return res.Val.([]byte), nil
+	}
+}
+
+// get pins the cached @filePath, loading it on miss. If the object could not be cached,
+// it returns nil with the content read from the remote storage.
+// unpin must be called with the returned entry if it's not nil.
+func (tcm *TieredChunkManager) get(ctx context.Context, filePath string) (*cacheEntry, []byte, error) {
+	if entry := tcm.pin(filePath); entry != nil {
+		metrics.StorageCacheAccessCounter.WithLabelValues(tcm.name, metrics.CacheHitLabel).Inc()
+		return entry, nil, nil
+	}
+	metrics.StorageCacheAccessCounter.WithLabelValues(tcm.name, metrics.CacheMissLabel).Inc()
+	content, err := tcm.load(ctx, filePath)
+	if err != nil {
+		return nil, nil, err
+	}
+	if entry := tcm.pin(filePath); entry != nil {
+		return entry, nil, nil
+	}
+	return nil, content, nil
+}
+
+// Size returns the size of @filePath, the cached size is returned if cached.
+func (tcm *TieredChunkManager) Size(ctx context.Context, filePath string) (int64, error) {
+	tcm.mu.Lock()
+	elem, ok := tcm.entries[filePath]
+	if ok {
+		size := elem.Value.(*cacheEntry).size
+		tcm.mu.Unlock()
+		return size, nil
+	}
+	tcm.mu.Unlock()
+	return tcm.ChunkManager.Size(ctx, filePath)
+}
+
+// Write writes @content to the remote storage.
+func (tcm *TieredChunkManager) Write(ctx context.Context, filePath string, content []byte) error {
+	defer tcm.invalidate(filePath)
+	return tcm.ChunkManager.Write(ctx, filePath, content)
+}
+
+// MultiWrite writes @contents to the remote storage.
+func (tcm *TieredChunkManager) MultiWrite(ctx context.Context, contents map[string][]byte) error {
+	filePaths := make([]string, 0, len(contents))
+	for filePath := range contents {
+		filePaths = append(filePaths, filePath)
+	}
+	defer tcm.invalidate(filePaths...)
+	return tcm.ChunkManager.MultiWrite(ctx, contents)
+}
+
+// Read reads @filePath through the cache.
+func (tcm *TieredChunkManager) Read(ctx context.Context, filePath string) ([]byte, error) {
+	entry, content, err := tcm.get(ctx, filePath)
+	if err != nil {
+		return nil, err
+	}
+	if entry == nil {
+		return content, nil
+	}
+	defer tcm.unpin(entry)
+	return tcm.cache.Read(ctx, entry.cachePath)
+}
+
+// Reader returns a reader of @filePath through the cache.
+func (tcm *TieredChunkManager) Reader(ctx context.Context, filePath string) (FileReader, error) {
+	entry, content, err := tcm.get(ctx, filePath)
+	if err != nil {
+		return nil, err
+	}
+	if entry == nil {
+		return ioutil.NopCloser(bytes.NewReader(content)), nil
+	}
+	defer tcm.unpin(entry)
+	return tcm.cache.Reader(ctx, entry.cachePath)
+}
+
+// MultiRead reads @filePaths through the cache.
+func (tcm *TieredChunkManager) MultiRead(ctx context.Context, filePaths []string) ([][]byte, error) {
+	results := make([][]byte, len(filePaths))
+	for i, filePath := range filePaths {
+		content, err := tcm.Read(ctx, filePath)
+		if err != nil {
+			return nil, err
+		}
+		results[i] = content
+	}
+	return results, nil
+}
+
+// ReadWithPrefix reads objects with @prefix through the cache.
+func (tcm *TieredChunkManager) ReadWithPrefix(ctx context.Context, prefix string) ([]string, [][]byte, error) {
+	filePaths, _, err := tcm.ListWithPrefix(ctx, prefix, true)
+	if err != nil {
+		return nil, nil, err
+	}
+	results, err := tcm.MultiRead(ctx, filePaths)
+	if err != nil {
+		return nil, nil, err
+	}
+	return filePaths, results, nil
+}
+
+// Mmap maps the cached file of @filePath, objects larger than the capacity can't be mapped.
+func (tcm *TieredChunkManager) Mmap(ctx context.Context, filePath string) (*mmap.ReaderAt, error) {
+	entry, _, err := tcm.get(ctx, filePath)
+	if err != nil {
+		return nil, err
+	}
+	if entry == nil {
+		return nil, fmt.Errorf("file %s is too large to cache", filePath)
+	}
+	defer tcm.unpin(entry)
+	return tcm.cache.Mmap(ctx, entry.cachePath)
+}
+
+// ReadAt reads @length bytes of @filePath from @off through the cache.
+func (tcm *TieredChunkManager) ReadAt(ctx context.Context, filePath string, off int64, length int64) ([]byte, error) {
+	entry, content, err := tcm.get(ctx, filePath)
+	if err != nil {
+		return nil, err
+	}
+	if entry != nil {
+		defer tcm.unpin(entry)
+		return tcm.cache.ReadAt(ctx, entry.cachePath, off, length)
+	}
+	if off < 0 || length < 0 || off+length > int64(len(content)) {
+		return nil, io.EOF
+	}
+	p := make([]byte, length)
+	copy(p, content[off:])
+	return p, nil
+}
+
+// Remove removes @filePath from the remote storage and the cache.
+func (tcm *TieredChunkManager) Remove(ctx context.Context, filePath string) error {
+	defer tcm.invalidate(filePath)
+	return tcm.ChunkManager.Remove(ctx, filePath)
+}
+
+// MultiRemove removes @filePaths from the remote storage and the cache.
+func (tcm *TieredChunkManager) MultiRemove(ctx context.Context, filePaths []string) error {
+	defer tcm.invalidate(filePaths...)
+	return tcm.ChunkManager.MultiRemove(ctx, filePaths)
+}
+
+// RemoveWithPrefix removes objects with @prefix from the remote storage and the cache.
+func (tcm *TieredChunkManager) RemoveWithPrefix(ctx context.Context, prefix string) error {
+	defer tcm.invalidatePrefix(prefix)
+	return tcm.ChunkManager.RemoveWithPrefix(ctx, prefix)
+}
+
+// Close removes all cached files.
+func (tcm *TieredChunkManager) Close() error {
+	tcm.mu.Lock()
+	defer tcm.mu.Unlock()
+	tcm.lru.Init()
+	tcm.entries = make(map[string]*list.Element)
+	tcm.loads = make(map[string]int64)
+	tcm.used = 0
+	metrics.StorageCacheUsedBytes.WithLabelValues(tcm.name).Set(0)
+	return os.RemoveAll(tcm.cache.RootPath())
+}
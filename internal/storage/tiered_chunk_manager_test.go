@@ -0,0 +1,329 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"io/ioutil"
+	"path/filepath"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// countingChunkManager counts the reads reaching the wrapped ChunkManager.
+type countingChunkManager struct {
+	ChunkManager
+	reads int32
+	block chan struct{}
+	// read is held after reading from the wrapped ChunkManager
+	read chan struct{}
+}
+
+func (cm *countingChunkManager) Read(ctx context.Context, filePath string) ([]byte, error) {
+	atomic.AddInt32(&cm.reads, 1)
+	if cm.block != nil {
+		<-cm.block
+	}
+	data, err := cm.ChunkManager.Read(ctx, filePath)
+	if cm.read != nil {
+		<-cm.read
+	}
+	return data, err
+}
+
+func newTestTieredCM(t *testing.T, capacity int64) (*TieredChunkManager, *countingChunkManager) {
+	dir := t.TempDir()
+	remote := &countingChunkManager{ChunkManager: NewLocalChunkManager(RootPath(filepath.Join(dir, "remote")))}
+	tcm, err := NewTieredChunkManager(remote, filepath.Join(dir, "cache"), capacity, "test")
+	require.NoError(t, err)
+	return tcm, remote
+}
+
+func TestTieredCM(t *testing.T) {
+	ctx := context.Background()
+	content := make([]byte, 100)
+	for i := range content {
+		content[i] = byte(i)
+	}
+
+	t.Run("test read through", func(t *testing.T) {
+		tcm, remote := newTestTieredCM(t, 1000)
+		require.NoError(t, tcm.Write(ctx, "a/b", content))
+		assert.False(t, tcm.Cached("a/b"))
+
+		data, err := tcm.Read(ctx, "a/b")
+		assert.NoError(t, err)
+		assert.Equal(t, content, data)
+		assert.True(t, tcm.Cached("a/b"))
+		assert.Equal(t, int64(100), tcm.Used())
+
+		data, err = tcm.ReadAt(ctx, "a/b", 10, 20)
+		assert.NoError(t, err)
+		assert.Equal(t, content[10:30], data)
+		reader, err := tcm.Reader(ctx, "a/b")
+		assert.NoError(t, err)
+		data, err = ioutil.ReadAll(reader)
+		assert.NoError(t, err)
+		assert.NoError(t, reader.Close())
+		assert.Equal(t, content, data)
+		at, err := tcm.Mmap(ctx, "a/b")
+		assert.NoError(t, err)
+		assert.Equal(t, 100, at.Len())
+		assert.NoError(t, at.Close())
+		size, err := tcm.Size(ctx, "a/b")
+		assert.NoError(t, err)
+		assert.Equal(t, int64(100), size)
+		assert.Equal(t, int32(1), atomic.LoadInt32(&remote.reads))
+
+		_, err = tcm.Read(ctx, "not_exist")
+		assert.Error(t, err)
+		assert.False(t, tcm.Cached("not_exist"))
+	})
+
+	t.Run("test eviction", func(t *testing.T) {
+		tcm, remote := newTestTieredCM(t, 250)
+		require.NoError(t, tcm.MultiWrite(ctx, map[string][]byte{"a": content, "b": content, "c": content}))
+
+		_, err := tcm.MultiRead(ctx, []string{"a", "b"})
+		assert.NoError(t, err)
+		assert.Equal(t, int64(200), tcm.Used())
+		// a is the most recently used
+		_, err = tcm.Read(ctx, "a")
+		assert.NoError(t, err)
+
+		_, err = tcm.Read(ctx, "c")
+		assert.NoError(t, err)
+		assert.True(t, tcm.Cached("a"))
+		assert.False(t, tcm.Cached("b"))
+		assert.True(t, tcm.Cached("c"))
+		assert.Equal(t, int64(200), tcm.Used())
+		assert.Equal(t, int32(3), atomic.LoadInt32(&remote.reads))
+
+		// pinned entries are not evicted
+		pinnedA, pinnedC := tcm.pin("a"), tcm.pin("c")
+		assert.NotNil(t, pinnedA)
+		assert.NotNil(t, pinnedC)
+		data, err := tcm.Read(ctx, "b")
+		assert.NoError(t, err)
+		assert.Equal(t, content, data)
+		assert.True(t, tcm.Cached("a"))
+		assert.True(t, tcm.Cached("c"))
+		assert.False(t, tcm.Cached("b"))
+		tcm.unpin(pinnedA)
+		tcm.unpin(pinnedC)
+		assert.Equal(t, int64(200), tcm.Used())
+	})
+
+	t.Run("test invalidate pinned", func(t *testing.T) {
+		tcm, _ := newTestTieredCM(t, 1000)
+		require.NoError(t, tcm.Write(ctx, "a", content))
+		_, err := tcm.Read(ctx, "a")
+		require.NoError(t, err)
+		pinned := tcm.pin("a")
+		require.NotNil(t, pinned)
+
+		// the file of the pinned entry is kept for its reader, the new content is cached in another file
+		updated := bytes.Repeat([]byte{1}, 10)
+		require.NoError(t, tcm.Write(ctx, "a", updated))
+		assert.False(t, tcm.Cached("a"))
+		data, err := tcm.cache.Read(ctx, pinned.cachePath)
+		assert.NoError(t, err)
+		assert.Equal(t, content, data)
+		data, err = tcm.Read(ctx, "a")
+		assert.NoError(t, err)
+		assert.Equal(t, updated, data)
+		assert.Equal(t, int64(110), tcm.Used())
+
+		tcm.unpin(pinned)
+		exist, err := tcm.cache.Exist(ctx, pinned.cachePath)
+		assert.NoError(t, err)
+		assert.False(t, exist)
+		assert.Equal(t, int64(10), tcm.Used())
+	})
+
+	t.Run("test canceled waiter", func(t *testing.T) {
+		tcm, remote := newTestTieredCM(t, 1000)
+		require.NoError(t, tcm.Write(ctx, "a", content))
+		remote.block = make(chan struct{})
+		canceled, cancel := context.WithCancel(ctx)
+		first := make(chan error)
+		go func() {
+			_, err := tcm.Read(canceled, "a")
+			first <- err
+		}()
+		time.Sleep(50 * time.Millisecond)
+		second := make(chan struct{})
+		go func() {
+			defer close(second)
+			data, err := tcm.Read(ctx, "a")
+			assert.NoError(t, err)
+			assert.Equal(t, content, data)
+		}()
+		time.Sleep(50 * time.Millisecond)
+
+		// the caller starting the load gives up, the load goes on for the others
+		cancel()
+		assert.ErrorIs(t, <-first, context.Canceled)
+		close(remote.block)
+		<-second
+		assert.True(t, tcm.Cached("a"))
+		assert.Equal(t, int32(1), atomic.LoadInt32(&remote.reads))
+	})
+
+	t.Run("test object larger than capacity", func(t *testing.T) {
+		tcm, remote := newTestTieredCM(t, 50)
+		require.NoError(t, tcm.Write(ctx, "large", content))
+		data, err := tcm.Read(ctx, "large")
+		assert.NoError(t, err)
+		assert.Equal(t, content, data)
+		data, err = tcm.ReadAt(ctx, "large", 90, 10)
+		assert.NoError(t, err)
+		assert.Equal(t, content[90:], data)
+		_, err = tcm.ReadAt(ctx, "large", 90, 20)
+		assert.ErrorIs(t, err, io.EOF)
+		reader, err := tcm.Reader(ctx, "large")
+		assert.NoError(t, err)
+		data, err = ioutil.ReadAll(reader)
+		assert.NoError(t, err)
+		assert.Equal(t, content, data)
+		_, err = tcm.Mmap(ctx, "large")
+		assert.Error(t, err)
+		assert.False(t, tcm.Cached("large"))
+		assert.Equal(t, int64(0), tcm.Used())
+		assert.Equal(t, int32(5), atomic.LoadInt32(&remote.reads))
+	})
+
+	t.Run("test invalidation", func(t *testing.T) {
+		tcm, _ := newTestTieredCM(t, 1000)
+		require.NoError(t, tcm.MultiWrite(ctx, map[string][]byte{"p/a": content, "p/b": content, "q": content}))
+		_, err := tcm.MultiRead(ctx, []string{"p/a", "p/b", "q"})
+		assert.NoError(t, err)
+		assert.Equal(t, int64(300), tcm.Used())
+
+		updated := bytes.Repeat([]byte{1}, 10)
+		require.NoError(t, tcm.Write(ctx, "q", updated))
+		assert.False(t, tcm.Cached("q"))
+		data, err := tcm.Read(ctx, "q")
+		assert.NoError(t, err)
+		assert.Equal(t, updated, data)
+
+		require.NoError(t, tcm.RemoveWithPrefix(ctx, "p/"))
+		assert.False(t, tcm.Cached("p/a"))
+		assert.False(t, tcm.Cached("p/b"))
+		require.NoError(t, tcm.MultiRemove(ctx, []string{"q"}))
+		assert.False(t, tcm.Cached("q"))
+		assert.Equal(t, int64(0), tcm.Used())
+
+		require.NoError(t, tcm.Write(ctx, "r", content))
+		_, err = tcm.Read(ctx, "r")
+		assert.NoError(t, err)
+		require.NoError(t, tcm.Remove(ctx, "r"))
+		assert.False(t, tcm.Cached("r"))
+		_, err = tcm.Read(ctx, "r")
+		assert.Error(t, err)
+
+		assert.NoError(t, tcm.Close())
+	})
+
+	t.Run("test concurrent miss", func(t *testing.T) {
+		tcm, remote := newTestTieredCM(t, 1000)
+		require.NoError(t, tcm.Write(ctx, "a", content))
+		// hold the remote read until all readers are waiting
+		remote.block = make(chan struct{})
+		var wg sync.WaitGroup
+		for i := 0; i < 10; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				data, err := tcm.Read(ctx, "a")
+				assert.NoError(t, err)
+				assert.Equal(t, content, data)
+			}()
+		}
+		time.Sleep(100 * time.Millisecond)
+		close(remote.block)
+		wg.Wait()
+		assert.Equal(t, int32(1), atomic.LoadInt32(&remote.reads))
+		assert.Equal(t, int64(100), tcm.Used())
+	})
+
+	t.Run("test stale load", func(t *testing.T) {
+		tcm, remote := newTestTieredCM(t, 1000)
+		require.NoError(t, tcm.Write(ctx, "a", content))
+		remote.read = make(chan struct{})
+		stale := make(chan struct{})
+		go func() {
+			defer close(stale)
+			data, err := tcm.Read(ctx, "a")
+			assert.NoError(t, err)
+			assert.Equal(t, content, data)
+		}()
+		time.Sleep(50 * time.Millisecond)
+
+		// the load read the old content before the write, it must not be cached
+		updated := bytes.Repeat([]byte{1}, 10)
+		require.NoError(t, tcm.Write(ctx, "a", updated))
+		close(remote.read)
+		<-stale
+		assert.False(t, tcm.Cached("a"))
+		assert.Equal(t, int64(0), tcm.Used())
+		data, err := tcm.Read(ctx, "a")
+		assert.NoError(t, err)
+		assert.Equal(t, updated, data)
+		assert.True(t, tcm.Cached("a"))
+		assert.Equal(t, int64(10), tcm.Used())
+	})
+
+	t.Run("test cache below encryption", func(t *testing.T) {
+		ecm, inner, _ := newTestEncryptedCM(t, 16)
+		cm, tcm, err := NewDiskCachedChunkManager(ecm, t.TempDir(), 1000, "test")
+		require.NoError(t, err)
+		assert.Equal(t, ecm, cm)
+		require.NoError(t, cm.Write(ctx, "a", content))
+		data, err := cm.Read(ctx, "a")
+		assert.NoError(t, err)
+		assert.Equal(t, content, data)
+
+		// only ciphertext is cached on disk
+		raw, err := inner.Read(ctx, "a")
+		require.NoError(t, err)
+		assert.True(t, tcm.Cached("a"))
+		assert.Equal(t, int64(len(raw)), tcm.Used())
+		entry := tcm.pin("a")
+		cached, err := tcm.cache.Read(ctx, entry.cachePath)
+		tcm.unpin(entry)
+		assert.NoError(t, err)
+		assert.Equal(t, raw, cached)
+
+		plain := NewLocalChunkManager(RootPath(t.TempDir()))
+		cm, tcm, err = NewDiskCachedChunkManager(plain, t.TempDir(), 1000, "test")
+		require.NoError(t, err)
+		assert.Equal(t, tcm, cm)
+	})
+
+	t.Run("test invalid capacity", func(t *testing.T) {
+		_, err := NewTieredChunkManager(NewLocalChunkManager(), t.TempDir(), 0, "test")
+		assert.Error(t, err)
+	})
+}
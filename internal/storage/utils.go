@@ -550,6 +550,35 @@ func ColumnBasedInsertMsgToInsertData(msg *msgstream.InsertMsg, collSchema *sche
 
 			fieldData.Data = append(fieldData.Data, srcData...)
 			idata.Data[field.FieldID] = fieldData
+		case typeutil.DataTypeJSON:
+			srcData := srcFields[field.FieldID].GetScalars().GetBytesData().GetData()
+
+			fieldData := &JSONFieldData{
+				NumRows: []int64{int64(msg.NumRows)},
+				Data:    make([][]byte, 0, len(srcData)),
+			}
+
+			fieldData.Data = append(fieldData.Data, srcData...)
+			idata.Data[field.FieldID] = fieldData
+		case typeutil.DataTypeArray:
+			srcData := srcFields[field.FieldID].GetScalars().GetBytesData().GetData()
+			elementType, err := typeutil.GetElementType(field)
+			if err != nil {
+				return nil, err
+			}
+
+			fieldData := &ArrayFieldData{
+				ElementType: elementType,
+				NumRows:     []int64{int64(msg.NumRows)},
+				Data:        make([]*schemapb.ScalarField, 0, len(srcData)),
+			}
+
+			rows, err := bytesToArrayRows(srcData)
+			if err != nil {
+				return nil, err
+			}
+			fieldData.Data = append(fieldData.Data, rows...)
+			idata.Data[field.FieldID] = fieldData
 		}
 	}
 
@@ -667,6 +696,33 @@ func mergeStringField(data *InsertData, fid FieldID, field *StringFieldData) {
 	fieldData.NumRows[0] += int64(field.RowNum())
 }
 
+func mergeJSONField(data *InsertData, fid FieldID, field *JSONFieldData) {
+	if _, ok := data.Data[fid]; !ok {
+		fieldData := &JSONFieldData{
+			NumRows: []int64{0},
+			Data:    nil,
+		}
+		data.Data[fid] = fieldData
+	}
+	fieldData := data.Data[fid].(*JSONFieldData)
+	fieldData.Data = append(fieldData.Data, field.Data...)
+	fieldData.NumRows[0] += int64(field.RowNum())
+}
+
+func mergeArrayField(data *InsertData, fid FieldID, field *ArrayFieldData) {
+	if _, ok := data.Data[fid]; !ok {
+		fieldData := &ArrayFieldData{
+			ElementType: field.ElementType,
+			NumRows:     []int64{0},
+			Data:        nil,
+		}
+		data.Data[fid] = fieldData
+	}
+	fieldData := data.Data[fid].(*ArrayFieldData)
+	fieldData.Data = append(fieldData.Data, field.Data...)
+	fieldData.NumRows[0] += int64(field.RowNum())
+}
+
 func mergeBinaryVectorField(data *InsertData, fid FieldID, field *BinaryVectorFieldData) {
 	if _, ok := data.Data[fid]; !ok {
 		fieldData := &BinaryVectorFieldData{
@@ -717,6 +773,10 @@ func MergeFieldData(data *InsertData, fid FieldID, field FieldData) {
 		mergeDoubleField(data, fid, field)
 	case *StringFieldData:
 		mergeStringField(data, fid, field)
+	case *JSONFieldData:
+		mergeJSONField(data, fid, field)
+	case *ArrayFieldData:
+		mergeArrayField(data, fid, field)
 	case *BinaryVectorFieldData:
 		mergeBinaryVectorField(data, fid, field)
 	case *FloatVectorFieldData:
@@ -808,6 +868,46 @@ func stringFieldDataToPbBytes(field *StringFieldData) ([]byte, error) {
 	return proto.Marshal(arr)
 }
 
+func jsonFieldDataToPbBytes(field *JSONFieldData) ([]byte, error) {
+	arr := &schemapb.BytesArray{Data: field.Data}
+	return proto.Marshal(arr)
+}
+
+func arrayFieldDataToPbBytes(field *ArrayFieldData) ([]byte, error) {
+	rows, err := arrayRowsToBytes(field.Data)
+	if err != nil {
+		return nil, err
+	}
+	arr := &schemapb.BytesArray{Data: rows}
+	return proto.Marshal(arr)
+}
+
+// arrayRowsToBytes serializes every array row, each row is stored as a marshaled schemapb.ScalarField.
+func arrayRowsToBytes(rows []*schemapb.ScalarField) ([][]byte, error) {
+	ret := make([][]byte, 0, len(rows))
+	for _, row := range rows {
+		bs, err := proto.Marshal(row)
+		if err != nil {
+			return nil, err
+		}
+		ret = append(ret, bs)
+	}
+	return ret, nil
+}
+
+// bytesToArrayRows is the reverse of arrayRowsToBytes.
+func bytesToArrayRows(data [][]byte) ([]*schemapb.ScalarField, error) {
+	ret := make([]*schemapb.ScalarField, 0, len(data))
+	for _, bs := range data {
+		row := &schemapb.ScalarField{}
+		if err := proto.Unmarshal(bs, row); err != nil {
+			return nil, err
+		}
+		ret = append(ret, row)
+	}
+	return ret, nil
+}
+
 func binaryWrite(endian binary.ByteOrder, data interface{}) ([]byte, error) {
 	buf := new(bytes.Buffer)
 	err := binary.Write(buf, endian, data)
@@ -830,6 +930,10 @@ func FieldDataToBytes(endian binary.ByteOrder, fieldData FieldData) ([]byte, err
 		return boolFieldDataToPbBytes(field)
 	case *StringFieldData:
 		return stringFieldDataToPbBytes(field)
+	case *JSONFieldData:
+		return jsonFieldDataToPbBytes(field)
+	case *ArrayFieldData:
+		return arrayFieldDataToPbBytes(field)
 	case *BinaryVectorFieldData:
 		return field.Data, nil
 	case *FloatVectorFieldData:
@@ -976,6 +1080,38 @@ func TransferInsertDataToInsertRecord(insertData *InsertData) (*segcorepb.Insert
 					},
 				},
 			}
+		case *JSONFieldData:
+			fieldData = &schemapb.FieldData{
+				Type:    typeutil.DataTypeJSON,
+				FieldId: fieldID,
+				Field: &schemapb.FieldData_Scalars{
+					Scalars: &schemapb.ScalarField{
+						Data: &schemapb.ScalarField_BytesData{
+							BytesData: &schemapb.BytesArray{
+								Data: rawData.Data,
+							},
+						},
+					},
+				},
+			}
+		case *ArrayFieldData:
+			rows, err := arrayRowsToBytes(rawData.Data)
+			if err != nil {
+				return insertRecord, err
+			}
+			fieldData = &schemapb.FieldData{
+				Type:    typeutil.DataTypeArray,
+				FieldId: fieldID,
+				Field: &schemapb.FieldData_Scalars{
+					Scalars: &schemapb.ScalarField{
+						Data: &schemapb.ScalarField_BytesData{
+							BytesData: &schemapb.BytesArray{
+								Data: rows,
+							},
+						},
+					},
+				},
+			}
 		case *FloatVectorFieldData:
 			fieldData = &schemapb.FieldData{
 				Type:    schemapb.DataType_FloatVector,
@@ -91,6 +91,16 @@ type DataNode interface {
 	// error is always nil
 	Import(ctx context.Context, req *datapb.ImportTaskRequest) (*commonpb.Status, error)
 
+	// Export reads the flushed segments of an export task from binlogs, and writes the rows visible at the snapshot
+	// timestamp into files(parquet, json or numpy) on MinIO/S3 storage
+	//
+	// ctx is the context to control request deadline and cancellation
+	// req contains the request params, including the segments, the snapshot timestamp and the output prefix
+	//
+	// Return status indicates if this operation is processed successfully or fail cause;
+	// error is always nil
+	Export(ctx context.Context, req *datapb.ExportTaskRequest) (*commonpb.Status, error)
+
 	// ResendSegmentStats resend un-flushed segment stats back upstream to DataCoord by resending DataNode time tick message.
 	// It returns a list of segments to be sent.
 	ResendSegmentStats(ctx context.Context, req *datapb.ResendSegmentStatsRequest) (*datapb.ResendSegmentStatsResponse, error)
@@ -311,6 +321,26 @@ type DataCoord interface {
 	// error is always nil
 	Import(ctx context.Context, req *datapb.ImportTaskRequest) (*datapb.ImportTaskResponse, error)
 
+	// Export fills the flushed segments of the collection into the export task, and sends it to an idle DataNode
+	//
+	// ctx is the context to control request deadline and cancellation
+	// req contains the request params, including the collection, partitions and the snapshot timestamp
+	//
+	// The `Status` in response struct `ExportTaskResponse` indicates if this operation is processed successfully or fail cause;
+	// the `datanode_id` in `ExportTaskResponse` return the id of the DataNode which takes the task.
+	// error is always nil
+	Export(ctx context.Context, req *datapb.ExportTaskRequest) (*datapb.ExportTaskResponse, error)
+
+	// RestoreSegments copies the segments of a collection which were valid at a timestamp into another collection
+	//
+	// ctx is the context to control request deadline and cancellation
+	// req contains the request params, including the source and target collections, the timestamp and the partition/channel mapping
+	//
+	// The `Status` in response struct `RestoreSegmentsResponse` indicates if this operation is processed successfully or fail cause;
+	// the `segmentIDs` in `RestoreSegmentsResponse` return the ids of the restored segments.
+	// error is always nil
+	RestoreSegments(ctx context.Context, req *datapb.RestoreSegmentsRequest) (*datapb.RestoreSegmentsResponse, error)
+
 	// UpdateSegmentStatistics updates a segment's stats.
 	UpdateSegmentStatistics(ctx context.Context, req *datapb.UpdateSegmentStatisticsRequest) (*commonpb.Status, error)
 	// UpdateChannelCheckpoint updates channel checkpoint in dataCoord.
@@ -756,6 +786,65 @@ type RootCoord interface {
 	// error is always nil
 	ReportImport(ctx context.Context, req *rootcoordpb.ImportResult) (*commonpb.Status, error)
 
+	// Export exports the data of a collection at a snapshot timestamp into files on MinIO/S3 storage
+	//
+	// ctx is the context to control request deadline and cancellation
+	// req contains the request params, including the collection, partitions, output prefix and file format
+	//
+	// The `Status` in response struct `ExportResponse` indicates if this operation is processed successfully or fail cause;
+	// the `task_id` in `ExportResponse` return the id of the export task, the `timestamp` return the snapshot timestamp.
+	// error is always nil
+	Export(ctx context.Context, req *rootcoordpb.ExportRequest) (*rootcoordpb.ExportResponse, error)
+
+	// GetExportState checks the state of an export task
+	//
+	// ctx is the context to control request deadline and cancellation
+	// req contains the request params, including a task id
+	//
+	// The `Status` in response struct `GetExportStateResponse` indicates if this operation is processed successfully or fail cause;
+	// the `task` in `GetExportStateResponse` return the state, output files and row count of the export task.
+	// error is always nil
+	GetExportState(ctx context.Context, req *rootcoordpb.GetExportStateRequest) (*rootcoordpb.GetExportStateResponse, error)
+
+	// ListExportTasks lists the export tasks of a collection, or of all collections
+	//
+	// ctx is the context to control request deadline and cancellation
+	// req contains the request params, including the collection name and the limit
+	//
+	// The `Status` in response struct `ListExportTasksResponse` indicates if this operation is processed successfully or fail cause;
+	// the `tasks` in `ListExportTasksResponse` return the export tasks sorted by task id.
+	// error is always nil
+	ListExportTasks(ctx context.Context, req *rootcoordpb.ListExportTasksRequest) (*rootcoordpb.ListExportTasksResponse, error)
+
+	// ReportExport reports export task state to rootCoord
+	//
+	// ctx is the context to control request deadline and cancellation
+	// req contains the export results, including the output files and exported row count
+	//
+	// response status contains the status/error code and failing reason if any error is returned
+	// error is always nil
+	ReportExport(ctx context.Context, req *rootcoordpb.ExportResult) (*commonpb.Status, error)
+
+	// RestoreCollection restores a collection, which may have been dropped, as it was at a timestamp into a new collection
+	//
+	// ctx is the context to control request deadline and cancellation
+	// req contains the request params, including the source collection name or id, the timestamp and the new collection name
+	//
+	// The `Status` in response struct `RestoreCollectionResponse` indicates if this operation is processed successfully or fail cause;
+	// the `task_id` in `RestoreCollectionResponse` return the id of the restore task, which runs in background.
+	// error is always nil
+	RestoreCollection(ctx context.Context, req *rootcoordpb.RestoreCollectionRequest) (*rootcoordpb.RestoreCollectionResponse, error)
+
+	// GetRestoreState checks the state of a restore task
+	//
+	// ctx is the context to control request deadline and cancellation
+	// req contains the request params, including a task id
+	//
+	// The `Status` in response struct `GetRestoreStateResponse` indicates if this operation is processed successfully or fail cause;
+	// the `task` in `GetRestoreStateResponse` return the state, the restored collection and segments of the restore task.
+	// error is always nil
+	GetRestoreState(ctx context.Context, req *rootcoordpb.GetRestoreStateRequest) (*rootcoordpb.GetRestoreStateResponse, error)
+
 	// CreateCredential create new user and password
 	CreateCredential(ctx context.Context, req *internalpb.CredentialInfo) (*commonpb.Status, error)
 	// UpdateCredential update password for a user
@@ -1122,6 +1211,18 @@ type ProxyComponent interface {
 	// error is always nil
 	Insert(ctx context.Context, request *milvuspb.InsertRequest) (*milvuspb.MutationResult, error)
 
+	// Upsert notifies Proxy to replace rows with the same primary keys, rows that don't exist are inserted
+	//
+	// ctx is the context to control request deadline and cancellation
+	// req contains the request params, including database name(reserved), collection name, partition name(optional), fields data
+	//
+	// The `Status` in response struct `MutationResult` indicates if this operation is processed successfully or fail cause;
+	// the `IDs` in `MutationResult` return the id list of upserted rows.
+	// the `UpsertCnt` in `MutationResult` return the number of upserted rows.
+	// the `ErrIndex` in `MutationResult` return the failed number of upsert rows.
+	// error is always nil
+	Upsert(ctx context.Context, request *milvuspb.InsertRequest) (*milvuspb.MutationResult, error)
+
 	// Delete notifies Proxy to delete rows
 	//
 	// ctx is the context to control request deadline and cancellation
@@ -1144,6 +1245,39 @@ type ProxyComponent interface {
 	// error is always nil
 	Search(ctx context.Context, request *milvuspb.SearchRequest) (*milvuspb.SearchResults, error)
 
+	// HybridSearch notifies Proxy to search multiple vector fields and merge the results with a reranker
+	//
+	// ctx is the context to control request deadline and cancellation
+	// req contains the request params, including database name(reserved), collection name, partition name(optional),
+	// one search request for each vector field, and rank params
+	//
+	// The `Status` in response struct `SearchResults` indicates if this operation is processed successfully or fail cause;
+	// the `Results` in `SearchResults` return the reranked search results.
+	// error is always nil
+	HybridSearch(ctx context.Context, request *proxypb.HybridSearchRequest) (*milvuspb.SearchResults, error)
+
+	// SearchIterator notifies Proxy to search the next page of a search iterator
+	//
+	// ctx is the context to control request deadline and cancellation
+	// req contains the search request, the batch size and the cursor returned by the previous page, which is empty for the first page
+	//
+	// The `Status` in response struct `SearchIteratorResults` indicates if this operation is processed successfully or fail cause;
+	// the `Results` in `SearchIteratorResults` return the hits of this page,
+	// and the `Cursor` is empty if there are no more hits.
+	// error is always nil
+	SearchIterator(ctx context.Context, request *proxypb.SearchIteratorRequest) (*proxypb.SearchIteratorResults, error)
+
+	// QueryIterator notifies Proxy to query the next page of a query iterator
+	//
+	// ctx is the context to control request deadline and cancellation
+	// req contains the query request, the batch size and the cursor returned by the previous page, which is empty for the first page
+	//
+	// The `Status` in response struct `QueryIteratorResults` indicates if this operation is processed successfully or fail cause;
+	// the `Results` in `QueryIteratorResults` return the entities of this page sorted by primary key,
+	// and the `Cursor` is empty if there are no more entities.
+	// error is always nil
+	QueryIterator(ctx context.Context, request *proxypb.QueryIteratorRequest) (*proxypb.QueryIteratorResults, error)
+
 	// Flush notifies Proxy to flush buffer into storage
 	//
 	// ctx is the context to control request deadline and cancellation
@@ -1285,6 +1419,58 @@ type ProxyComponent interface {
 	// error is always nil
 	ListImportTasks(ctx context.Context, req *milvuspb.ListImportTasksRequest) (*milvuspb.ListImportTasksResponse, error)
 
+	// Export exports the data of a collection at a snapshot timestamp into files(parquet, json or numpy) on MinIO/S3 storage,
+	// only the data of flushed segments is exported
+	//
+	// ctx is the context to control request deadline and cancellation
+	// req contains the request params, including the collection, partitions, output prefix and file format
+	//
+	// The `Status` in response struct `ExportResponse` indicates if this operation is processed successfully or fail cause;
+	// the `task_id` in `ExportResponse` return the id of the export task.
+	// error is always nil
+	Export(ctx context.Context, req *rootcoordpb.ExportRequest) (*rootcoordpb.ExportResponse, error)
+
+	// GetExportState checks the state of an export task
+	//
+	// ctx is the context to control request deadline and cancellation
+	// req contains the request params, including a task id
+	//
+	// The `Status` in response struct `GetExportStateResponse` indicates if this operation is processed successfully or fail cause;
+	// the `task` in `GetExportStateResponse` return the state, output files and row count of the export task.
+	// error is always nil
+	GetExportState(ctx context.Context, req *rootcoordpb.GetExportStateRequest) (*rootcoordpb.GetExportStateResponse, error)
+
+	// ListExportTasks lists the export tasks of a collection, or of all collections
+	//
+	// ctx is the context to control request deadline and cancellation
+	// req contains the request params, including the collection name and the limit
+	//
+	// The `Status` in response struct `ListExportTasksResponse` indicates if this operation is processed successfully or fail cause;
+	// the `tasks` in `ListExportTasksResponse` return the export tasks sorted by task id.
+	// error is always nil
+	ListExportTasks(ctx context.Context, req *rootcoordpb.ListExportTasksRequest) (*rootcoordpb.ListExportTasksResponse, error)
+
+	// RestoreCollection restores a collection, which may have been dropped, as it was at a timestamp into a new collection,
+	// the timestamp must be within the restore window of the garbage collector
+	//
+	// ctx is the context to control request deadline and cancellation
+	// req contains the request params, including the source collection name or id, the timestamp and the new collection name
+	//
+	// The `Status` in response struct `RestoreCollectionResponse` indicates if this operation is processed successfully or fail cause;
+	// the `task_id` in `RestoreCollectionResponse` return the id of the restore task, which runs in background.
+	// error is always nil
+	RestoreCollection(ctx context.Context, req *rootcoordpb.RestoreCollectionRequest) (*rootcoordpb.RestoreCollectionResponse, error)
+
+	// GetRestoreState checks the state of a restore task
+	//
+	// ctx is the context to control request deadline and cancellation
+	// req contains the request params, including a task id
+	//
+	// The `Status` in response struct `GetRestoreStateResponse` indicates if this operation is processed successfully or fail cause;
+	// the `task` in `GetRestoreStateResponse` return the state, the restored collection and segments of the restore task.
+	// error is always nil
+	GetRestoreState(ctx context.Context, req *rootcoordpb.GetRestoreStateRequest) (*rootcoordpb.GetRestoreStateResponse, error)
+
 	GetReplicas(ctx context.Context, req *milvuspb.GetReplicasRequest) (*milvuspb.GetReplicasResponse, error)
 
 	// CreateCredential create new user and password
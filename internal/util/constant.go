@@ -27,6 +27,9 @@ const (
 	MetaStoreTypeEtcd  = "etcd"
 	MetaStoreTypeMysql = "mysql"
 
+	MetaDBDriverMysql  = "mysql"
+	MetaDBDriverSqlite = "sqlite"
+
 	SegmentMetaPrefix    = "queryCoord-segmentMeta"
 	ChangeInfoMetaPrefix = "queryCoord-sealedSegmentChangeInfo"
 
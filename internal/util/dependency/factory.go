@@ -5,6 +5,7 @@ import (
 
 	"github.com/milvus-io/milvus/internal/mq/msgstream"
 	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/internal/util/compressor"
 	"github.com/milvus-io/milvus/internal/util/paramtable"
 )
 
@@ -24,13 +25,23 @@ func NewDefaultFactory(standAlone bool) *DefaultFactory {
 	}
 }
 
+// NewMemDefaultFactory creates a factory with in-process memory mq, only for test
+func NewMemDefaultFactory(standAlone bool) *DefaultFactory {
+	return &DefaultFactory{
+		standAlone:       standAlone,
+		msgStreamFactory: msgstream.NewMmsFactory(&paramtable.MemmqConfig{}),
+		chunkManagerFactory: storage.NewChunkManagerFactory("local",
+			storage.RootPath("/tmp/milvus")),
+	}
+}
+
 func NewFactory(standAlone bool) *DefaultFactory {
 	return &DefaultFactory{standAlone: standAlone}
 }
 
 // Init create a msg factory(TODO only support one mq at the same time.)
 // In order to guarantee backward compatibility of config file, we still support multiple mq configs.
-// 1. Rocksmq only run on local mode, and it has the highest priority
+// 1. Memmq and Rocksmq only run on local mode, memmq has the highest priority if enabled
 // 2. Pulsar has higher priority than Kafka within remote msg
 func (f *DefaultFactory) Init(params *paramtable.ComponentParam) {
 	// skip if using default factory
@@ -40,6 +51,24 @@ func (f *DefaultFactory) Init(params *paramtable.ComponentParam) {
 
 	f.chunkManagerFactory = storage.NewChunkManagerFactoryWithParam(params)
 
+	err := msgstream.SetDeadLetterConfig(msgstream.DeadLetterConfig{
+		Policy:   msgstream.DeadLetterPolicy(params.CommonCfg.DeadLetterPolicy),
+		Topic:    params.CommonCfg.DeadLetterTopic,
+		FilePath: params.CommonCfg.DeadLetterFilePath,
+	})
+	if err != nil {
+		panic(err)
+	}
+	err = msgstream.SetProduceBatchConfig(msgstream.ProduceBatchConfig{
+		Enable:      params.CommonCfg.ProduceBatchEnable,
+		MaxSize:     params.CommonCfg.ProduceBatchMaxSize,
+		MaxNum:      int(params.CommonCfg.ProduceBatchMaxNum),
+		Compression: compressor.CompressType(params.CommonCfg.ProduceBatchCompression),
+	})
+	if err != nil {
+		panic(err)
+	}
+
 	// init mq storage
 	if f.standAlone {
 		f.msgStreamFactory = f.initMQLocalService(params)
@@ -50,11 +79,14 @@ func (f *DefaultFactory) Init(params *paramtable.ComponentParam) {
 
 	f.msgStreamFactory = f.initMQRemoteService(params)
 	if f.msgStreamFactory == nil {
-		panic("no available remote mq configuration, must config Pulsar or Kafka at least one of these!")
+		panic("no available remote mq configuration, must config Pulsar, Kafka or NATS at least one of these!")
 	}
 }
 
 func (f *DefaultFactory) initMQLocalService(params *paramtable.ComponentParam) msgstream.Factory {
+	if params.MemmqEnable() {
+		return msgstream.NewMmsFactory(&params.MemmqCfg)
+	}
 	if params.RocksmqEnable() {
 		path, err := params.Load("rocksmq.path")
 		if err != nil {
@@ -65,7 +97,7 @@ func (f *DefaultFactory) initMQLocalService(params *paramtable.ComponentParam) m
 	return nil
 }
 
-// initRemoteService Pulsar has higher priority than Kafka.
+// initRemoteService Pulsar has higher priority than Kafka, and Kafka has higher priority than NATS.
 func (f *DefaultFactory) initMQRemoteService(params *paramtable.ComponentParam) msgstream.Factory {
 	if params.PulsarEnable() {
 		return msgstream.NewPmsFactory(&params.PulsarCfg)
@@ -75,6 +107,10 @@ func (f *DefaultFactory) initMQRemoteService(params *paramtable.ComponentParam)
 		return msgstream.NewKmsFactory(&params.KafkaCfg)
 	}
 
+	if params.NatsEnable() {
+		return msgstream.NewNmsFactory(&params.NatsCfg)
+	}
+
 	return nil
 }
 
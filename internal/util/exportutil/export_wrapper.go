@@ -0,0 +1,369 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package exportutil
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"path"
+	"strconv"
+
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus-proto/go-api/schemapb"
+	"github.com/milvus-io/milvus/internal/common"
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/proto/etcdpb"
+	"github.com/milvus-io/milvus/internal/proto/rootcoordpb"
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/internal/util/retry"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
+)
+
+const (
+	// FormatParquet exports each segment into a parquet file
+	FormatParquet = "parquet"
+	// FormatJSON exports each segment into a row-based json file
+	FormatJSON = "json"
+	// FormatNumpy exports each segment into a folder which contains a numpy file for each field
+	FormatNumpy = "numpy"
+
+	ParquetFileExt = ".parquet"
+	JSONFileExt    = ".json"
+	NumpyFileExt   = ".npy"
+)
+
+// ReportExportAttempts is the maximum # of attempts to retry when reporting the export result fails.
+var ReportExportAttempts uint = 10
+
+// IsValidFormat checks the output format of an export task is supported
+func IsValidFormat(format string) bool {
+	switch format {
+	case FormatParquet, FormatJSON, FormatNumpy:
+		return true
+	default:
+		return false
+	}
+}
+
+// ExportWrapper reads the flushed segments of an export task from binlogs, keeps the rows which are visible
+// at the snapshot timestamp of the task, and writes them into files under the output prefix.
+// The output files can be imported back by the bulk insert interface.
+type ExportWrapper struct {
+	ctx              context.Context            // for canceling export process
+	cancel           context.CancelFunc         // for canceling export process
+	collectionMeta   *etcdpb.CollectionMeta     // collection meta to deserialize binlogs
+	collectionSchema *schemapb.CollectionSchema // collection schema without RowID field and Timestamp field
+	chunkManager     storage.ChunkManager
+
+	exportResult         *rootcoordpb.ExportResult                 // export result
+	reportFunc           func(res *rootcoordpb.ExportResult) error // report export state to rootcoord
+	reportExportAttempts uint                                      // attempts count if report function get error
+}
+
+// NewExportWrapper creates an ExportWrapper, returns nil if the collection schema is nil
+func NewExportWrapper(ctx context.Context, collectionID int64, collectionSchema *schemapb.CollectionSchema,
+	cm storage.ChunkManager, exportResult *rootcoordpb.ExportResult,
+	reportFunc func(res *rootcoordpb.ExportResult) error) *ExportWrapper {
+	if collectionSchema == nil {
+		log.Error("export wrapper: collection schema is nil")
+		return nil
+	}
+
+	// ignore the RowID field and Timestamp field
+	realSchema := &schemapb.CollectionSchema{
+		Name:        collectionSchema.GetName(),
+		Description: collectionSchema.GetDescription(),
+		AutoID:      collectionSchema.GetAutoID(),
+		Fields:      make([]*schemapb.FieldSchema, 0),
+	}
+	for i := 0; i < len(collectionSchema.Fields); i++ {
+		schema := collectionSchema.Fields[i]
+		if schema.GetFieldID() == common.RowIDField || schema.GetFieldID() == common.TimeStampField {
+			continue
+		}
+		realSchema.Fields = append(realSchema.Fields, schema)
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+
+	return &ExportWrapper{
+		ctx:    ctx,
+		cancel: cancel,
+		collectionMeta: &etcdpb.CollectionMeta{
+			ID:     collectionID,
+			Schema: collectionSchema,
+		},
+		collectionSchema:     realSchema,
+		chunkManager:         cm,
+		exportResult:         exportResult,
+		reportFunc:           reportFunc,
+		reportExportAttempts: ReportExportAttempts,
+	}
+}
+
+// Cancel method can be used to cancel export process
+func (p *ExportWrapper) Cancel() error {
+	p.cancel()
+	return nil
+}
+
+// Export writes the visible rows of each segment into files, and reports the result to rootcoord
+func (p *ExportWrapper) Export(task *datapb.ExportTask) error {
+	if task == nil {
+		log.Error("export wrapper: export task is nil")
+		return errors.New("export task is nil")
+	}
+	if !IsValidFormat(task.GetFormat()) {
+		log.Error("export wrapper: unsupported output format", zap.String("format", task.GetFormat()))
+		return fmt.Errorf("unsupported output format '%s'", task.GetFormat())
+	}
+	if task.GetFormat() == FormatNumpy {
+		for _, schema := range p.collectionSchema.GetFields() {
+			if schema.GetDataType() == typeutil.DataTypeJSON || schema.GetDataType() == typeutil.DataTypeArray {
+				log.Error("export wrapper: numpy format doesn't support the field", zap.String("fieldName", schema.GetName()))
+				return fmt.Errorf("numpy format doesn't support the data type of field '%s'", schema.GetName())
+			}
+		}
+	}
+
+	outputPath := path.Join(task.GetOutputPrefix(), strconv.FormatInt(task.GetTaskId(), 10))
+	log.Info("export wrapper: begin export", zap.Int64("taskID", task.GetTaskId()),
+		zap.Int("segmentCount", len(task.GetSegments())), zap.String("outputPath", outputPath),
+		zap.String("format", task.GetFormat()), zap.Uint64("timestamp", task.GetTimestamp()))
+
+	for _, segment := range task.GetSegments() {
+		// outside context might be canceled(service stop, or the task is expired)
+		if p.ctx.Err() != nil {
+			log.Error("export wrapper: export task was canceled", zap.Int64("taskID", task.GetTaskId()))
+			return errors.New("export task was canceled")
+		}
+
+		data, rowCount, err := p.readSegment(segment, task.GetTimestamp())
+		if err != nil {
+			return err
+		}
+		if rowCount == 0 {
+			log.Info("export wrapper: no visible row in segment", zap.Int64("segmentID", segment.GetID()))
+			continue
+		}
+
+		files, err := p.writeSegment(data, rowCount, path.Join(outputPath, strconv.FormatInt(segment.GetID(), 10)), task.GetFormat())
+		if err != nil {
+			return err
+		}
+		p.exportResult.Files = append(p.exportResult.Files, files...)
+		p.exportResult.RowCount += int64(rowCount)
+		log.Info("export wrapper: segment exported", zap.Int64("segmentID", segment.GetID()), zap.Int("rowCount", rowCount))
+	}
+
+	return p.reportCompleted(p.reportExportAttempts)
+}
+
+// reportCompleted notifies the rootcoord to mark the task state to be ExportCompleted
+func (p *ExportWrapper) reportCompleted(reportAttempts uint) error {
+	p.exportResult.State = rootcoordpb.ExportState_ExportCompleted
+	// retry more times in case fail this task only because of network error
+	reportErr := retry.Do(p.ctx, func() error {
+		return p.reportFunc(p.exportResult)
+	}, retry.Attempts(reportAttempts))
+	if reportErr != nil {
+		log.Warn("export wrapper: fail to report export state to RootCoord", zap.Error(reportErr))
+		return reportErr
+	}
+	return nil
+}
+
+// readSegment reads the insert binlogs and delta logs of a segment, returns the rows which are visible at
+// the snapshot timestamp: the rows inserted after the snapshot are ignored, the rows deleted before the snapshot are
+// removed. The RowID field and Timestamp field are not returned.
+func (p *ExportWrapper) readSegment(segment *datapb.SegmentInfo, snapshotTs uint64) (map[storage.FieldID]storage.FieldData, int, error) {
+	deleted, err := p.readDeltalogs(segment, snapshotTs)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	pkSchema, err := typeutil.GetPrimaryFieldSchema(p.collectionMeta.GetSchema())
+	if err != nil {
+		log.Error("export wrapper: primary key field is not found", zap.Error(err))
+		return nil, 0, err
+	}
+
+	fields, err := initFieldsData(p.collectionSchema)
+	if err != nil {
+		log.Error("export wrapper: failed to initialize fields data", zap.Error(err))
+		return nil, 0, err
+	}
+	rowCount := 0
+
+	// binlogs of different fields are aligned, the n-th binlog of each field holds the same rows
+	batchCount := 0
+	for _, fieldBinlog := range segment.GetBinlogs() {
+		batchCount = len(fieldBinlog.GetBinlogs())
+		break
+	}
+	for i := 0; i < batchCount; i++ {
+		paths := make([]string, 0, len(segment.GetBinlogs()))
+		for _, fieldBinlog := range segment.GetBinlogs() {
+			if len(fieldBinlog.GetBinlogs()) != batchCount {
+				log.Error("export wrapper: binlog count of fields mismatch", zap.Int64("segmentID", segment.GetID()),
+					zap.Int64("fieldID", fieldBinlog.GetFieldID()))
+				return nil, 0, fmt.Errorf("binlog count of field %d mismatch in segment %d", fieldBinlog.GetFieldID(), segment.GetID())
+			}
+			paths = append(paths, fieldBinlog.GetBinlogs()[i].GetLogPath())
+		}
+
+		values, err := p.chunkManager.MultiRead(p.ctx, paths)
+		if err != nil {
+			log.Error("export wrapper: failed to read insert binlogs", zap.Int64("segmentID", segment.GetID()), zap.Error(err))
+			return nil, 0, fmt.Errorf("failed to read insert binlogs of segment %d, error: %w", segment.GetID(), err)
+		}
+		blobs := make([]*storage.Blob, 0, len(values))
+		for j, value := range values {
+			blobs = append(blobs, &storage.Blob{Key: paths[j], Value: value})
+		}
+
+		_, _, insertData, err := storage.NewInsertCodec(p.collectionMeta).Deserialize(blobs)
+		if err != nil {
+			log.Error("export wrapper: failed to deserialize insert binlogs", zap.Int64("segmentID", segment.GetID()), zap.Error(err))
+			return nil, 0, fmt.Errorf("failed to deserialize insert binlogs of segment %d, error: %w", segment.GetID(), err)
+		}
+
+		tsField, ok := insertData.Data[common.TimeStampField].(*storage.Int64FieldData)
+		if !ok {
+			log.Error("export wrapper: timestamp field is not found in binlogs", zap.Int64("segmentID", segment.GetID()))
+			return nil, 0, fmt.Errorf("timestamp field is not found in binlogs of segment %d", segment.GetID())
+		}
+		pkField, ok := insertData.Data[pkSchema.GetFieldID()]
+		if !ok {
+			log.Error("export wrapper: primary key field is not found in binlogs", zap.Int64("segmentID", segment.GetID()))
+			return nil, 0, fmt.Errorf("primary key field is not found in binlogs of segment %d", segment.GetID())
+		}
+
+		for row := 0; row < len(tsField.Data); row++ {
+			ts := uint64(tsField.Data[row])
+			if ts > snapshotTs {
+				continue
+			}
+			// skip the row if its pk is deleted later on, deleted only keeps the latest delete ts of each pk
+			// no later than the snapshot, so a row inserted again at or after that delete is still exported
+			if deleteTs, ok := deleted[pkField.GetRow(row)]; ok && ts < deleteTs {
+				continue
+			}
+
+			for _, schema := range p.collectionSchema.GetFields() {
+				source, ok := insertData.Data[schema.GetFieldID()]
+				if !ok {
+					log.Error("export wrapper: field is not found in binlogs", zap.Int64("segmentID", segment.GetID()),
+						zap.String("fieldName", schema.GetName()))
+					return nil, 0, fmt.Errorf("field '%s' is not found in binlogs of segment %d", schema.GetName(), segment.GetID())
+				}
+				if err := appendRow(fields[schema.GetFieldID()], source, row); err != nil {
+					return nil, 0, err
+				}
+			}
+			rowCount++
+		}
+	}
+
+	return fields, rowCount, nil
+}
+
+// readDeltalogs returns the latest delete timestamp of each deleted primary key, the deletions after
+// the snapshot timestamp are ignored
+func (p *ExportWrapper) readDeltalogs(segment *datapb.SegmentInfo, snapshotTs uint64) (map[interface{}]uint64, error) {
+	paths := make([]string, 0)
+	for _, fieldBinlog := range segment.GetDeltalogs() {
+		for _, binlog := range fieldBinlog.GetBinlogs() {
+			paths = append(paths, binlog.GetLogPath())
+		}
+	}
+
+	deleted := make(map[interface{}]uint64)
+	if len(paths) == 0 {
+		return deleted, nil
+	}
+
+	values, err := p.chunkManager.MultiRead(p.ctx, paths)
+	if err != nil {
+		log.Error("export wrapper: failed to read delta logs", zap.Int64("segmentID", segment.GetID()), zap.Error(err))
+		return nil, fmt.Errorf("failed to read delta logs of segment %d, error: %w", segment.GetID(), err)
+	}
+	blobs := make([]*storage.Blob, 0, len(values))
+	for i, value := range values {
+		blobs = append(blobs, &storage.Blob{Key: paths[i], Value: value})
+	}
+
+	_, _, deleteData, err := storage.NewDeleteCodec().Deserialize(blobs)
+	if err != nil {
+		log.Error("export wrapper: failed to deserialize delta logs", zap.Int64("segmentID", segment.GetID()), zap.Error(err))
+		return nil, fmt.Errorf("failed to deserialize delta logs of segment %d, error: %w", segment.GetID(), err)
+	}
+
+	for i, pk := range deleteData.Pks {
+		ts := deleteData.Tss[i]
+		if ts > snapshotTs {
+			continue
+		}
+		if ts > deleted[pk.GetValue()] {
+			deleted[pk.GetValue()] = ts
+		}
+	}
+	return deleted, nil
+}
+
+// writeSegment writes the rows of a segment into files, returns the file paths
+func (p *ExportWrapper) writeSegment(fields map[storage.FieldID]storage.FieldData, rowCount int,
+	filePath string, format string) ([]string, error) {
+	files := make([]string, 0)
+	contents := make(map[string][]byte)
+	switch format {
+	case FormatParquet:
+		buf := &bytes.Buffer{}
+		if err := WriteParquet(buf, p.collectionSchema, fields, rowCount); err != nil {
+			return nil, err
+		}
+		files = append(files, filePath+ParquetFileExt)
+		contents[filePath+ParquetFileExt] = buf.Bytes()
+	case FormatJSON:
+		buf := &bytes.Buffer{}
+		if err := WriteJSON(buf, p.collectionSchema, fields, rowCount); err != nil {
+			return nil, err
+		}
+		files = append(files, filePath+JSONFileExt)
+		contents[filePath+JSONFileExt] = buf.Bytes()
+	case FormatNumpy:
+		for _, schema := range p.collectionSchema.GetFields() {
+			buf := &bytes.Buffer{}
+			if err := WriteNumpy(buf, schema, fields[schema.GetFieldID()]); err != nil {
+				return nil, err
+			}
+			filePath := path.Join(filePath, schema.GetName()+NumpyFileExt)
+			files = append(files, filePath)
+			contents[filePath] = buf.Bytes()
+		}
+	}
+
+	if err := p.chunkManager.MultiWrite(p.ctx, contents); err != nil {
+		log.Error("export wrapper: failed to write output files", zap.String("path", filePath), zap.Error(err))
+		return nil, fmt.Errorf("failed to write output files to '%s', error: %w", filePath, err)
+	}
+	return files, nil
+}
@@ -0,0 +1,425 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package exportutil
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/schemapb"
+	"github.com/milvus-io/milvus/internal/common"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/proto/etcdpb"
+	"github.com/milvus-io/milvus/internal/proto/rootcoordpb"
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
+)
+
+const (
+	exportTestDir = "/tmp/milvus_test/export"
+	sampleCollID  = int64(1)
+	samplePartID  = int64(2)
+)
+
+func sampleSchema() *schemapb.CollectionSchema {
+	return &schemapb.CollectionSchema{
+		Name:        "schema",
+		Description: "schema",
+		Fields: []*schemapb.FieldSchema{
+			{FieldID: common.RowIDField, Name: common.RowIDFieldName, DataType: schemapb.DataType_Int64},
+			{FieldID: common.TimeStampField, Name: common.TimeStampFieldName, DataType: schemapb.DataType_Int64},
+			{FieldID: 100, Name: "field_int64", IsPrimaryKey: true, DataType: schemapb.DataType_Int64},
+			{FieldID: 101, Name: "field_bool", DataType: schemapb.DataType_Bool},
+			{FieldID: 102, Name: "field_int8", DataType: schemapb.DataType_Int8},
+			{FieldID: 103, Name: "field_int16", DataType: schemapb.DataType_Int16},
+			{FieldID: 104, Name: "field_int32", DataType: schemapb.DataType_Int32},
+			{FieldID: 105, Name: "field_float", DataType: schemapb.DataType_Float},
+			{FieldID: 106, Name: "field_double", DataType: schemapb.DataType_Double},
+			{FieldID: 107, Name: "field_string", DataType: schemapb.DataType_VarChar,
+				TypeParams: []*commonpb.KeyValuePair{{Key: "max_length", Value: "128"}}},
+			{FieldID: 108, Name: "field_binary_vector", DataType: schemapb.DataType_BinaryVector,
+				TypeParams: []*commonpb.KeyValuePair{{Key: "dim", Value: "16"}}},
+			{FieldID: 109, Name: "field_float_vector", DataType: schemapb.DataType_FloatVector,
+				TypeParams: []*commonpb.KeyValuePair{{Key: "dim", Value: "4"}}},
+		},
+	}
+}
+
+// sampleInsertData generates rows for sampleSchema(), the value of each field in row i is derived from the pk,
+// the timestamp of row i is 100+i
+func sampleInsertData(pks []int64) *storage.InsertData {
+	data := &storage.InsertData{Data: make(map[storage.FieldID]storage.FieldData)}
+	rowIDs := &storage.Int64FieldData{NumRows: []int64{0}}
+	tss := &storage.Int64FieldData{NumRows: []int64{0}}
+	ids := &storage.Int64FieldData{NumRows: []int64{0}}
+	bools := &storage.BoolFieldData{NumRows: []int64{0}}
+	int8s := &storage.Int8FieldData{NumRows: []int64{0}}
+	int16s := &storage.Int16FieldData{NumRows: []int64{0}}
+	int32s := &storage.Int32FieldData{NumRows: []int64{0}}
+	floats := &storage.FloatFieldData{NumRows: []int64{0}}
+	doubles := &storage.DoubleFieldData{NumRows: []int64{0}}
+	strs := &storage.StringFieldData{NumRows: []int64{0}}
+	binVectors := &storage.BinaryVectorFieldData{NumRows: []int64{0}, Dim: 16}
+	floatVectors := &storage.FloatVectorFieldData{NumRows: []int64{0}, Dim: 4}
+	for i, pk := range pks {
+		rowIDs.Data = append(rowIDs.Data, pk)
+		tss.Data = append(tss.Data, int64(100+i))
+		ids.Data = append(ids.Data, pk)
+		bools.Data = append(bools.Data, pk%2 == 0)
+		int8s.Data = append(int8s.Data, int8(pk))
+		int16s.Data = append(int16s.Data, int16(pk))
+		int32s.Data = append(int32s.Data, int32(pk))
+		floats.Data = append(floats.Data, float32(pk)+0.5)
+		doubles.Data = append(doubles.Data, float64(pk)+0.25)
+		strs.Data = append(strs.Data, fmt.Sprintf("str_%d", pk))
+		binVectors.Data = append(binVectors.Data, byte(pk), byte(pk+1))
+		floatVectors.Data = append(floatVectors.Data, float32(pk), float32(pk+1), float32(pk+2), float32(pk+3))
+	}
+	data.Data[common.RowIDField] = rowIDs
+	data.Data[common.TimeStampField] = tss
+	data.Data[100] = ids
+	data.Data[101] = bools
+	data.Data[102] = int8s
+	data.Data[103] = int16s
+	data.Data[104] = int32s
+	data.Data[105] = floats
+	data.Data[106] = doubles
+	data.Data[107] = strs
+	data.Data[108] = binVectors
+	data.Data[109] = floatVectors
+	return data
+}
+
+// createSegment serializes the insert data and delete data into binlogs, returns the segment info
+func createSegment(t *testing.T, cm storage.ChunkManager, segmentID int64, batches []*storage.InsertData,
+	deleteData *storage.DeleteData) *datapb.SegmentInfo {
+	ctx := context.Background()
+	meta := &etcdpb.CollectionMeta{ID: sampleCollID, Schema: sampleSchema()}
+	segment := &datapb.SegmentInfo{
+		ID:           segmentID,
+		CollectionID: sampleCollID,
+		PartitionID:  samplePartID,
+	}
+
+	fieldBinlogs := make(map[int64]*datapb.FieldBinlog)
+	for i, batch := range batches {
+		blobs, _, err := storage.NewInsertCodec(meta).Serialize(samplePartID, segmentID, batch)
+		assert.NoError(t, err)
+		for _, blob := range blobs {
+			fieldID, err := strconv.ParseInt(blob.GetKey(), 10, 64)
+			assert.NoError(t, err)
+			logPath := path.Join("insert_log", strconv.FormatInt(segmentID, 10), blob.GetKey(), strconv.Itoa(i))
+			assert.NoError(t, cm.Write(ctx, logPath, blob.GetValue()))
+			if _, ok := fieldBinlogs[fieldID]; !ok {
+				fieldBinlogs[fieldID] = &datapb.FieldBinlog{FieldID: fieldID}
+				segment.Binlogs = append(segment.Binlogs, fieldBinlogs[fieldID])
+			}
+			fieldBinlogs[fieldID].Binlogs = append(fieldBinlogs[fieldID].Binlogs, &datapb.Binlog{LogPath: logPath})
+		}
+	}
+
+	if deleteData != nil {
+		blob, err := storage.NewDeleteCodec().Serialize(sampleCollID, samplePartID, segmentID, deleteData)
+		assert.NoError(t, err)
+		logPath := path.Join("delta_log", strconv.FormatInt(segmentID, 10))
+		assert.NoError(t, cm.Write(ctx, logPath, blob.GetValue()))
+		segment.Deltalogs = []*datapb.FieldBinlog{{Binlogs: []*datapb.Binlog{{LogPath: logPath}}}}
+	}
+	return segment
+}
+
+func newDeleteData(pks []int64, tss []uint64) *storage.DeleteData {
+	deleteData := &storage.DeleteData{}
+	for i, pk := range pks {
+		deleteData.Append(storage.NewInt64PrimaryKey(pk), tss[i])
+	}
+	return deleteData
+}
+
+type exportFixture struct {
+	cm       storage.ChunkManager
+	segments []*datapb.SegmentInfo
+	result   *rootcoordpb.ExportResult
+	reported []*rootcoordpb.ExportResult
+}
+
+func newExportFixture(t *testing.T) *exportFixture {
+	cm := storage.NewLocalChunkManager(storage.RootPath(exportTestDir))
+	t.Cleanup(func() {
+		os.RemoveAll(exportTestDir)
+	})
+
+	// the snapshot timestamp is 101
+	// segment 1: pk 1~4 in two binlog batches, timestamps are 100~101 for each batch
+	// pk 1 is deleted at 101, pk 3 is deleted at 300(after snapshot), pk 4 is deleted at its insert timestamp
+	segment1 := createSegment(t, cm, 1,
+		[]*storage.InsertData{sampleInsertData([]int64{1, 2}), sampleInsertData([]int64{3, 4})},
+		newDeleteData([]int64{1, 3, 4}, []uint64{101, 300, 101}))
+	// segment 2: pk 5 is inserted at 100, pk 6 is inserted at 101, pk 7 is inserted at 102(after snapshot)
+	segment2 := createSegment(t, cm, 2, []*storage.InsertData{sampleInsertData([]int64{5, 6, 7})}, nil)
+	// segment 3: all rows are deleted
+	segment3 := createSegment(t, cm, 3, []*storage.InsertData{sampleInsertData([]int64{8})},
+		newDeleteData([]int64{8}, []uint64{101}))
+
+	return &exportFixture{
+		cm:       cm,
+		segments: []*datapb.SegmentInfo{segment1, segment2, segment3},
+		result:   &rootcoordpb.ExportResult{TaskId: 10, State: rootcoordpb.ExportState_ExportStarted},
+	}
+}
+
+func (f *exportFixture) reportFunc(res *rootcoordpb.ExportResult) error {
+	f.reported = append(f.reported, res)
+	return nil
+}
+
+func (f *exportFixture) task(format string) *datapb.ExportTask {
+	return &datapb.ExportTask{
+		TaskId:       10,
+		CollectionId: sampleCollID,
+		Timestamp:    101,
+		OutputPrefix: "export",
+		Format:       format,
+		Segments:     f.segments,
+	}
+}
+
+func Test_NewExportWrapper(t *testing.T) {
+	ctx := context.Background()
+	wrapper := NewExportWrapper(ctx, sampleCollID, nil, nil, nil, nil)
+	assert.Nil(t, wrapper)
+
+	wrapper = NewExportWrapper(ctx, sampleCollID, sampleSchema(), nil, nil, nil)
+	assert.NotNil(t, wrapper)
+	// RowID field and Timestamp field are ignored
+	assert.Equal(t, 10, len(wrapper.collectionSchema.GetFields()))
+	assert.NoError(t, wrapper.Cancel())
+}
+
+func Test_ExportWrapperExport(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("json", func(t *testing.T) {
+		f := newExportFixture(t)
+		wrapper := NewExportWrapper(ctx, sampleCollID, sampleSchema(), f.cm, f.result, f.reportFunc)
+		err := wrapper.Export(f.task(FormatJSON))
+		assert.NoError(t, err)
+
+		assert.Equal(t, 1, len(f.reported))
+		assert.Equal(t, rootcoordpb.ExportState_ExportCompleted, f.reported[0].GetState())
+		assert.Equal(t, int64(5), f.reported[0].GetRowCount())
+		assert.Equal(t, []string{"export/10/1.json", "export/10/2.json"}, f.reported[0].GetFiles())
+
+		content, err := f.cm.Read(ctx, "export/10/1.json")
+		assert.NoError(t, err)
+		doc := make(map[string][]map[string]interface{})
+		assert.NoError(t, json.Unmarshal(content, &doc))
+		rows := doc["rows"]
+		assert.Equal(t, 3, len(rows))
+		// pk 3 is deleted after the snapshot, pk 4 is inserted and deleted at the same timestamp
+		assert.Equal(t, float64(2), rows[0]["field_int64"])
+		assert.Equal(t, float64(3), rows[1]["field_int64"])
+		assert.Equal(t, float64(4), rows[2]["field_int64"])
+		assert.Equal(t, "str_3", rows[1]["field_string"])
+		assert.Equal(t, []interface{}{float64(3), float64(4)}, rows[1]["field_binary_vector"])
+		assert.Equal(t, []interface{}{float64(3), float64(4), float64(5), float64(6)}, rows[1]["field_float_vector"])
+		_, ok := rows[0][common.TimeStampFieldName]
+		assert.False(t, ok)
+
+		content, err = f.cm.Read(ctx, "export/10/2.json")
+		assert.NoError(t, err)
+		doc = make(map[string][]map[string]interface{})
+		assert.NoError(t, json.Unmarshal(content, &doc))
+		assert.Equal(t, 2, len(doc["rows"]))
+	})
+
+	t.Run("parquet", func(t *testing.T) {
+		f := newExportFixture(t)
+		wrapper := NewExportWrapper(ctx, sampleCollID, sampleSchema(), f.cm, f.result, f.reportFunc)
+		err := wrapper.Export(f.task(FormatParquet))
+		assert.NoError(t, err)
+		assert.Equal(t, int64(5), f.result.GetRowCount())
+		assert.Equal(t, []string{"export/10/1.parquet", "export/10/2.parquet"}, f.result.GetFiles())
+
+		content, err := f.cm.Read(ctx, "export/10/2.parquet")
+		assert.NoError(t, err)
+		table := readParquet(t, content)
+		defer table.Release()
+		assert.Equal(t, int64(2), table.NumRows())
+		assert.Equal(t, 10, int(table.NumCols()))
+	})
+
+	t.Run("numpy", func(t *testing.T) {
+		f := newExportFixture(t)
+		wrapper := NewExportWrapper(ctx, sampleCollID, sampleSchema(), f.cm, f.result, f.reportFunc)
+		err := wrapper.Export(f.task(FormatNumpy))
+		assert.NoError(t, err)
+		assert.Equal(t, int64(5), f.result.GetRowCount())
+		assert.Equal(t, 20, len(f.result.GetFiles()))
+		assert.Equal(t, "export/10/1/field_int64.npy", f.result.GetFiles()[0])
+
+		content, err := f.cm.Read(ctx, "export/10/1/field_int64.npy")
+		assert.NoError(t, err)
+		var ids []int64
+		readNumpy(t, content, &ids)
+		assert.Equal(t, []int64{2, 3, 4}, ids)
+	})
+
+	t.Run("illegal task", func(t *testing.T) {
+		f := newExportFixture(t)
+		wrapper := NewExportWrapper(ctx, sampleCollID, sampleSchema(), f.cm, f.result, f.reportFunc)
+		assert.Error(t, wrapper.Export(nil))
+		assert.Error(t, wrapper.Export(f.task("csv")))
+		assert.Equal(t, 0, len(f.reported))
+
+		schema := sampleSchema()
+		schema.Fields = append(schema.Fields, &schemapb.FieldSchema{FieldID: 110, Name: "field_json", DataType: typeutil.DataTypeJSON})
+		wrapper = NewExportWrapper(ctx, sampleCollID, schema, f.cm, f.result, f.reportFunc)
+		assert.Error(t, wrapper.Export(f.task(FormatNumpy)))
+	})
+
+	t.Run("binlog not found", func(t *testing.T) {
+		f := newExportFixture(t)
+		wrapper := NewExportWrapper(ctx, sampleCollID, sampleSchema(), f.cm, f.result, f.reportFunc)
+		task := f.task(FormatJSON)
+		task.Segments = []*datapb.SegmentInfo{{
+			ID:      4,
+			Binlogs: []*datapb.FieldBinlog{{FieldID: 100, Binlogs: []*datapb.Binlog{{LogPath: "dummy"}}}},
+		}}
+		assert.Error(t, wrapper.Export(task))
+
+		task.Segments = []*datapb.SegmentInfo{{
+			ID:        4,
+			Deltalogs: []*datapb.FieldBinlog{{Binlogs: []*datapb.Binlog{{LogPath: "dummy"}}}},
+		}}
+		assert.Error(t, wrapper.Export(task))
+	})
+
+	t.Run("canceled", func(t *testing.T) {
+		f := newExportFixture(t)
+		wrapper := NewExportWrapper(ctx, sampleCollID, sampleSchema(), f.cm, f.result, f.reportFunc)
+		wrapper.Cancel()
+		assert.Error(t, wrapper.Export(f.task(FormatJSON)))
+		assert.Equal(t, 0, len(f.reported))
+	})
+
+	t.Run("report failed", func(t *testing.T) {
+		f := newExportFixture(t)
+		wrapper := NewExportWrapper(ctx, sampleCollID, sampleSchema(), f.cm, f.result, func(res *rootcoordpb.ExportResult) error {
+			return errors.New("error")
+		})
+		wrapper.reportExportAttempts = 2
+		assert.Error(t, wrapper.Export(f.task(FormatJSON)))
+	})
+}
+
+func Test_ExportWrapperReadSegment(t *testing.T) {
+	ctx := context.Background()
+
+	f := newExportFixture(t)
+	wrapper := NewExportWrapper(ctx, sampleCollID, sampleSchema(), f.cm, f.result, f.reportFunc)
+
+	// all rows are visible at a later snapshot, except the deleted ones
+	fields, rowCount, err := wrapper.readSegment(f.segments[0], 1000)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, rowCount)
+	assert.Equal(t, []int64{2, 4}, fields[100].(*storage.Int64FieldData).Data)
+
+	// nothing is visible before the first insert
+	_, rowCount, err = wrapper.readSegment(f.segments[1], 99)
+	assert.NoError(t, err)
+	assert.Equal(t, 0, rowCount)
+
+	// binlog count mismatch
+	segment := &datapb.SegmentInfo{
+		ID: 4,
+		Binlogs: []*datapb.FieldBinlog{
+			{FieldID: 100, Binlogs: []*datapb.Binlog{{LogPath: "a"}}},
+			{FieldID: 101, Binlogs: []*datapb.Binlog{{LogPath: "a"}, {LogPath: "b"}}},
+		},
+	}
+	_, _, err = wrapper.readSegment(segment, 1000)
+	assert.Error(t, err)
+
+	// illegal binlog content
+	assert.NoError(t, f.cm.Write(ctx, "dummy", []byte("dummy")))
+	segment = &datapb.SegmentInfo{
+		ID:      4,
+		Binlogs: []*datapb.FieldBinlog{{FieldID: 100, Binlogs: []*datapb.Binlog{{LogPath: "dummy"}}}},
+	}
+	_, _, err = wrapper.readSegment(segment, 1000)
+	assert.Error(t, err)
+
+	segment = &datapb.SegmentInfo{
+		ID:        4,
+		Deltalogs: []*datapb.FieldBinlog{{Binlogs: []*datapb.Binlog{{LogPath: "dummy"}}}},
+	}
+	_, _, err = wrapper.readSegment(segment, 1000)
+	assert.Error(t, err)
+}
+
+func Test_IsValidFormat(t *testing.T) {
+	assert.True(t, IsValidFormat(FormatParquet))
+	assert.True(t, IsValidFormat(FormatJSON))
+	assert.True(t, IsValidFormat(FormatNumpy))
+	assert.False(t, IsValidFormat(""))
+	assert.False(t, IsValidFormat("csv"))
+}
+
+func Test_InitFieldsData(t *testing.T) {
+	fields, err := initFieldsData(sampleSchema())
+	assert.NoError(t, err)
+	assert.Equal(t, 12, len(fields))
+	assert.Equal(t, 16, fields[108].(*storage.BinaryVectorFieldData).Dim)
+	assert.Equal(t, 4, fields[109].(*storage.FloatVectorFieldData).Dim)
+
+	schema := &schemapb.CollectionSchema{
+		Fields: []*schemapb.FieldSchema{{FieldID: 100, Name: "vector", DataType: schemapb.DataType_FloatVector}},
+	}
+	_, err = initFieldsData(schema)
+	assert.Error(t, err)
+
+	schema.Fields[0].TypeParams = []*commonpb.KeyValuePair{{Key: "dim", Value: "x"}}
+	_, err = initFieldsData(schema)
+	assert.Error(t, err)
+
+	schema.Fields[0].DataType = schemapb.DataType_None
+	_, err = initFieldsData(schema)
+	assert.Error(t, err)
+}
+
+func Test_AppendRow(t *testing.T) {
+	source := sampleInsertData([]int64{1, 2})
+	fields, err := initFieldsData(sampleSchema())
+	assert.NoError(t, err)
+	for fieldID, field := range fields {
+		assert.NoError(t, appendRow(field, source.Data[fieldID], 1))
+		assert.Equal(t, 1, field.RowNum())
+		assert.Equal(t, source.Data[fieldID].GetRow(1), field.GetRow(0))
+	}
+
+	assert.Error(t, appendRow(nil, source.Data[100], 0))
+}
@@ -0,0 +1,135 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package exportutil
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/milvus-io/milvus-proto/go-api/schemapb"
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
+)
+
+// getFieldDimension gets dimension of vector field
+func getFieldDimension(schema *schemapb.FieldSchema) (int, error) {
+	for _, kvPair := range schema.GetTypeParams() {
+		key, value := kvPair.GetKey(), kvPair.GetValue()
+		if key == "dim" {
+			dim, err := strconv.Atoi(value)
+			if err != nil {
+				return 0, fmt.Errorf("illegal vector dimension '%s' for field '%s', error: %w", value, schema.GetName(), err)
+			}
+			return dim, nil
+		}
+	}
+
+	return 0, fmt.Errorf("vector dimension is not defined for field '%s'", schema.GetName())
+}
+
+// initFieldsData creates an empty FieldData for each field of the schema
+func initFieldsData(collectionSchema *schemapb.CollectionSchema) (map[storage.FieldID]storage.FieldData, error) {
+	fields := make(map[storage.FieldID]storage.FieldData)
+	for _, schema := range collectionSchema.GetFields() {
+		switch schema.GetDataType() {
+		case schemapb.DataType_Bool:
+			fields[schema.GetFieldID()] = &storage.BoolFieldData{NumRows: []int64{0}}
+		case schemapb.DataType_Int8:
+			fields[schema.GetFieldID()] = &storage.Int8FieldData{NumRows: []int64{0}}
+		case schemapb.DataType_Int16:
+			fields[schema.GetFieldID()] = &storage.Int16FieldData{NumRows: []int64{0}}
+		case schemapb.DataType_Int32:
+			fields[schema.GetFieldID()] = &storage.Int32FieldData{NumRows: []int64{0}}
+		case schemapb.DataType_Int64:
+			fields[schema.GetFieldID()] = &storage.Int64FieldData{NumRows: []int64{0}}
+		case schemapb.DataType_Float:
+			fields[schema.GetFieldID()] = &storage.FloatFieldData{NumRows: []int64{0}}
+		case schemapb.DataType_Double:
+			fields[schema.GetFieldID()] = &storage.DoubleFieldData{NumRows: []int64{0}}
+		case schemapb.DataType_String, schemapb.DataType_VarChar:
+			fields[schema.GetFieldID()] = &storage.StringFieldData{NumRows: []int64{0}}
+		case typeutil.DataTypeJSON:
+			fields[schema.GetFieldID()] = &storage.JSONFieldData{NumRows: []int64{0}}
+		case typeutil.DataTypeArray:
+			elementType, err := typeutil.GetElementType(schema)
+			if err != nil {
+				return nil, err
+			}
+			fields[schema.GetFieldID()] = &storage.ArrayFieldData{ElementType: elementType, NumRows: []int64{0}}
+		case schemapb.DataType_BinaryVector:
+			dim, err := getFieldDimension(schema)
+			if err != nil {
+				return nil, err
+			}
+			fields[schema.GetFieldID()] = &storage.BinaryVectorFieldData{NumRows: []int64{0}, Dim: dim}
+		case schemapb.DataType_FloatVector:
+			dim, err := getFieldDimension(schema)
+			if err != nil {
+				return nil, err
+			}
+			fields[schema.GetFieldID()] = &storage.FloatVectorFieldData{NumRows: []int64{0}, Dim: dim}
+		default:
+			return nil, fmt.Errorf("unsupported data type %s of field '%s'", schema.GetDataType().String(), schema.GetName())
+		}
+	}
+	return fields, nil
+}
+
+// appendRow appends the value of row i in the source field data to the target field data
+func appendRow(target storage.FieldData, source storage.FieldData, i int) error {
+	switch target := target.(type) {
+	case *storage.BoolFieldData:
+		target.Data = append(target.Data, source.GetRow(i).(bool))
+		target.NumRows[0]++
+	case *storage.Int8FieldData:
+		target.Data = append(target.Data, source.GetRow(i).(int8))
+		target.NumRows[0]++
+	case *storage.Int16FieldData:
+		target.Data = append(target.Data, source.GetRow(i).(int16))
+		target.NumRows[0]++
+	case *storage.Int32FieldData:
+		target.Data = append(target.Data, source.GetRow(i).(int32))
+		target.NumRows[0]++
+	case *storage.Int64FieldData:
+		target.Data = append(target.Data, source.GetRow(i).(int64))
+		target.NumRows[0]++
+	case *storage.FloatFieldData:
+		target.Data = append(target.Data, source.GetRow(i).(float32))
+		target.NumRows[0]++
+	case *storage.DoubleFieldData:
+		target.Data = append(target.Data, source.GetRow(i).(float64))
+		target.NumRows[0]++
+	case *storage.StringFieldData:
+		target.Data = append(target.Data, source.GetRow(i).(string))
+		target.NumRows[0]++
+	case *storage.JSONFieldData:
+		target.Data = append(target.Data, source.GetRow(i).([]byte))
+		target.NumRows[0]++
+	case *storage.ArrayFieldData:
+		target.Data = append(target.Data, source.GetRow(i).(*schemapb.ScalarField))
+		target.NumRows[0]++
+	case *storage.BinaryVectorFieldData:
+		target.Data = append(target.Data, source.GetRow(i).([]byte)...)
+		target.NumRows[0]++
+	case *storage.FloatVectorFieldData:
+		target.Data = append(target.Data, source.GetRow(i).([]float32)...)
+		target.NumRows[0]++
+	default:
+		return fmt.Errorf("unsupported field data type %T", target)
+	}
+	return nil
+}
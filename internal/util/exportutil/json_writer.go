@@ -0,0 +1,129 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package exportutil
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus-proto/go-api/schemapb"
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/storage"
+)
+
+// jsonValue converts the value of row i into a value which can be marshaled in the row-based json format
+func jsonValue(field storage.FieldData, i int) (interface{}, error) {
+	switch field := field.(type) {
+	case *storage.JSONFieldData:
+		value := field.Data[i]
+		if !json.Valid(value) {
+			return nil, fmt.Errorf("'%s' is not a valid json document", string(value))
+		}
+		return json.RawMessage(value), nil
+	case *storage.ArrayFieldData:
+		return arrayValues(field.Data[i])
+	case *storage.BinaryVectorFieldData:
+		// a binary vector is represented as an array of uint8 in the json file, not a base64 string
+		bytes := field.GetRow(i).([]byte)
+		values := make([]int, 0, len(bytes))
+		for _, b := range bytes {
+			values = append(values, int(b))
+		}
+		return values, nil
+	default:
+		return field.GetRow(i), nil
+	}
+}
+
+// arrayValues returns the elements of an array field row
+func arrayValues(scalar *schemapb.ScalarField) (interface{}, error) {
+	switch data := scalar.GetData().(type) {
+	case *schemapb.ScalarField_BoolData:
+		return data.BoolData.GetData(), nil
+	case *schemapb.ScalarField_IntData:
+		return data.IntData.GetData(), nil
+	case *schemapb.ScalarField_LongData:
+		return data.LongData.GetData(), nil
+	case *schemapb.ScalarField_FloatData:
+		return data.FloatData.GetData(), nil
+	case *schemapb.ScalarField_DoubleData:
+		return data.DoubleData.GetData(), nil
+	case *schemapb.ScalarField_StringData:
+		return data.StringData.GetData(), nil
+	default:
+		return nil, fmt.Errorf("unsupported array element type %T", data)
+	}
+}
+
+// WriteJSON writes the fields data into a row-based json file, the format is the same as the row-based
+// json file of bulk insert:
+//
+//	{
+//	  "rows": [
+//	    {"id": 1, "vector": [0.1, 0.2]},
+//	    {"id": 2, "vector": [0.3, 0.4]}
+//	  ]
+//	}
+func WriteJSON(w io.Writer, collectionSchema *schemapb.CollectionSchema,
+	fields map[storage.FieldID]storage.FieldData, rowCount int) error {
+	for _, schema := range collectionSchema.GetFields() {
+		field, ok := fields[schema.GetFieldID()]
+		if !ok || field.RowNum() != rowCount {
+			log.Error("JSON writer: row count of field mismatch", zap.String("fieldName", schema.GetName()))
+			return fmt.Errorf("row count of field '%s' mismatch", schema.GetName())
+		}
+	}
+
+	// write rows one by one to avoid holding the whole document in memory twice
+	writer := bufio.NewWriter(w)
+	if _, err := writer.WriteString("{\"rows\":["); err != nil {
+		return err
+	}
+	for i := 0; i < rowCount; i++ {
+		row := make(map[string]interface{}, len(collectionSchema.GetFields()))
+		for _, schema := range collectionSchema.GetFields() {
+			value, err := jsonValue(fields[schema.GetFieldID()], i)
+			if err != nil {
+				log.Error("JSON writer: failed to convert value", zap.String("fieldName", schema.GetName()), zap.Error(err))
+				return fmt.Errorf("failed to convert value of field '%s' at row %d, error: %w", schema.GetName(), i, err)
+			}
+			row[schema.GetName()] = value
+		}
+
+		bytes, err := json.Marshal(row)
+		if err != nil {
+			log.Error("JSON writer: failed to marshal row", zap.Int("row", i), zap.Error(err))
+			return fmt.Errorf("failed to marshal row %d, error: %w", i, err)
+		}
+		if i > 0 {
+			if err = writer.WriteByte(','); err != nil {
+				return err
+			}
+		}
+		if _, err = writer.Write(bytes); err != nil {
+			return err
+		}
+	}
+	if _, err := writer.WriteString("]}"); err != nil {
+		return err
+	}
+	return writer.Flush()
+}
@@ -0,0 +1,98 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package exportutil
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus-proto/go-api/schemapb"
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
+)
+
+func Test_WriteJSON(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		schema := sampleSchema()
+		schema.Fields = schema.Fields[2:]
+		schema.Fields = append(schema.Fields,
+			&schemapb.FieldSchema{FieldID: 110, Name: "field_json", DataType: typeutil.DataTypeJSON},
+			&schemapb.FieldSchema{FieldID: 111, Name: "field_array", DataType: typeutil.DataTypeArray})
+		data := sampleInsertData([]int64{1, 2})
+		data.Data[110] = &storage.JSONFieldData{NumRows: []int64{2}, Data: [][]byte{[]byte(`{"a":1}`), []byte(`{}`)}}
+		data.Data[111] = &storage.ArrayFieldData{
+			ElementType: schemapb.DataType_Int64,
+			NumRows:     []int64{2},
+			Data: []*schemapb.ScalarField{
+				{Data: &schemapb.ScalarField_LongData{LongData: &schemapb.LongArray{Data: []int64{1, 2}}}},
+				{Data: &schemapb.ScalarField_StringData{StringData: &schemapb.StringArray{Data: []string{"a"}}}},
+			},
+		}
+
+		buf := &bytes.Buffer{}
+		err := WriteJSON(buf, schema, data.Data, 2)
+		assert.NoError(t, err)
+
+		doc := make(map[string][]map[string]interface{})
+		assert.NoError(t, json.Unmarshal(buf.Bytes(), &doc))
+		rows := doc["rows"]
+		assert.Equal(t, 2, len(rows))
+		assert.Equal(t, 12, len(rows[0]))
+		assert.Equal(t, float64(2), rows[1]["field_int64"])
+		assert.Equal(t, true, rows[1]["field_bool"])
+		assert.Equal(t, 2.5, rows[1]["field_float"])
+		assert.Equal(t, "str_2", rows[1]["field_string"])
+		assert.Equal(t, []interface{}{float64(1), float64(2)}, rows[0]["field_binary_vector"])
+		assert.Equal(t, map[string]interface{}{"a": float64(1)}, rows[0]["field_json"])
+		assert.Equal(t, []interface{}{float64(1), float64(2)}, rows[0]["field_array"])
+		assert.Equal(t, []interface{}{"a"}, rows[1]["field_array"])
+	})
+
+	t.Run("empty", func(t *testing.T) {
+		buf := &bytes.Buffer{}
+		err := WriteJSON(buf, &schemapb.CollectionSchema{}, nil, 0)
+		assert.NoError(t, err)
+		assert.Equal(t, `{"rows":[]}`, buf.String())
+	})
+
+	t.Run("row count mismatch", func(t *testing.T) {
+		schema := sampleSchema()
+		schema.Fields = schema.Fields[2:]
+		data := sampleInsertData([]int64{1, 2})
+		err := WriteJSON(&bytes.Buffer{}, schema, data.Data, 3)
+		assert.Error(t, err)
+	})
+
+	t.Run("illegal value", func(t *testing.T) {
+		schema := &schemapb.CollectionSchema{
+			Fields: []*schemapb.FieldSchema{{FieldID: 110, Name: "field_json", DataType: typeutil.DataTypeJSON}},
+		}
+		fields := map[storage.FieldID]storage.FieldData{
+			110: &storage.JSONFieldData{NumRows: []int64{1}, Data: [][]byte{[]byte(`{"a":`)}},
+		}
+		err := WriteJSON(&bytes.Buffer{}, schema, fields, 1)
+		assert.Error(t, err)
+
+		schema.Fields[0].DataType = typeutil.DataTypeArray
+		fields[110] = &storage.ArrayFieldData{NumRows: []int64{1}, Data: []*schemapb.ScalarField{{}}}
+		err = WriteJSON(&bytes.Buffer{}, schema, fields, 1)
+		assert.Error(t, err)
+	})
+}
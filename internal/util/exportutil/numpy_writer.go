@@ -0,0 +1,161 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package exportutil
+
+import (
+	"bufio"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"strings"
+	"unicode/utf8"
+
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus-proto/go-api/schemapb"
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/storage"
+)
+
+// the magic string and version of numpy file format 1.0
+const npyMagic = "\x93NUMPY\x01\x00"
+
+// npyHeaderAlignment is the alignment of the numpy file header, the data begins at an aligned offset
+const npyHeaderAlignment = 64
+
+// writeNumpyHeader writes the header of a numpy file(format 1.0), dtype is the numpy type descriptor,
+// shape is the dimensions of the array
+func writeNumpyHeader(w io.Writer, dtype string, shape []int) error {
+	dims := make([]string, 0, len(shape))
+	for _, d := range shape {
+		dims = append(dims, fmt.Sprintf("%d", d))
+	}
+	shapeStr := strings.Join(dims, ", ")
+	if len(shape) == 1 {
+		shapeStr += ","
+	}
+	header := fmt.Sprintf("{'descr': '%s', 'fortran_order': False, 'shape': (%s), }", dtype, shapeStr)
+
+	// magic(8 bytes) + header length(2 bytes) + header + padding spaces + '\n' must be aligned
+	total := len(npyMagic) + 2 + len(header) + 1
+	if padding := total % npyHeaderAlignment; padding != 0 {
+		header += strings.Repeat(" ", npyHeaderAlignment-padding)
+	}
+	header += "\n"
+
+	if _, err := io.WriteString(w, npyMagic); err != nil {
+		return err
+	}
+	if err := binary.Write(w, binary.LittleEndian, uint16(len(header))); err != nil {
+		return err
+	}
+	_, err := io.WriteString(w, header)
+	return err
+}
+
+// WriteNumpy writes the data of a field into a numpy file, the dtype and shape are chosen so that the file
+// can be parsed by the numpy parser of bulk insert:
+//
+//	bool: |b1, int8: |i1, int16: <i2, int32: <i4, int64: <i8, float: <f4, double: <f8
+//	varchar: <U{n}, n is the max length(in characters) of the strings
+//	binary vector: |u1 with shape (rows, dim/8), float vector: <f4 with shape (rows, dim)
+func WriteNumpy(w io.Writer, schema *schemapb.FieldSchema, field storage.FieldData) error {
+	if field == nil {
+		log.Error("numpy writer: field data is nil", zap.String("fieldName", schema.GetName()))
+		return fmt.Errorf("data of field '%s' is nil", schema.GetName())
+	}
+
+	writer := bufio.NewWriter(w)
+	var err error
+	switch field := field.(type) {
+	case *storage.BoolFieldData:
+		if err = writeNumpyHeader(writer, "|b1", []int{len(field.Data)}); err == nil {
+			err = binary.Write(writer, binary.LittleEndian, field.Data)
+		}
+	case *storage.Int8FieldData:
+		if err = writeNumpyHeader(writer, "|i1", []int{len(field.Data)}); err == nil {
+			err = binary.Write(writer, binary.LittleEndian, field.Data)
+		}
+	case *storage.Int16FieldData:
+		if err = writeNumpyHeader(writer, "<i2", []int{len(field.Data)}); err == nil {
+			err = binary.Write(writer, binary.LittleEndian, field.Data)
+		}
+	case *storage.Int32FieldData:
+		if err = writeNumpyHeader(writer, "<i4", []int{len(field.Data)}); err == nil {
+			err = binary.Write(writer, binary.LittleEndian, field.Data)
+		}
+	case *storage.Int64FieldData:
+		if err = writeNumpyHeader(writer, "<i8", []int{len(field.Data)}); err == nil {
+			err = binary.Write(writer, binary.LittleEndian, field.Data)
+		}
+	case *storage.FloatFieldData:
+		if err = writeNumpyHeader(writer, "<f4", []int{len(field.Data)}); err == nil {
+			err = binary.Write(writer, binary.LittleEndian, field.Data)
+		}
+	case *storage.DoubleFieldData:
+		if err = writeNumpyHeader(writer, "<f8", []int{len(field.Data)}); err == nil {
+			err = binary.Write(writer, binary.LittleEndian, field.Data)
+		}
+	case *storage.StringFieldData:
+		err = writeNumpyStrings(writer, field.Data)
+	case *storage.BinaryVectorFieldData:
+		if err = writeNumpyHeader(writer, "|u1", []int{field.RowNum(), field.Dim / 8}); err == nil {
+			err = binary.Write(writer, binary.LittleEndian, field.Data)
+		}
+	case *storage.FloatVectorFieldData:
+		if err = writeNumpyHeader(writer, "<f4", []int{field.RowNum(), field.Dim}); err == nil {
+			err = binary.Write(writer, binary.LittleEndian, field.Data)
+		}
+	default:
+		log.Error("numpy writer: unsupported field", zap.String("fieldName", schema.GetName()))
+		return fmt.Errorf("numpy format doesn't support the data type of field '%s'", schema.GetName())
+	}
+	if err != nil {
+		log.Error("numpy writer: failed to write field", zap.String("fieldName", schema.GetName()), zap.Error(err))
+		return fmt.Errorf("failed to write numpy file for field '%s', error: %w", schema.GetName(), err)
+	}
+	return writer.Flush()
+}
+
+// writeNumpyStrings writes strings with utf32 encoding, each string occupies 4*maxLen bytes
+func writeNumpyStrings(w io.Writer, data []string) error {
+	maxLen := 1
+	for _, str := range data {
+		if n := utf8.RuneCountInString(str); n > maxLen {
+			maxLen = n
+		}
+	}
+	if err := writeNumpyHeader(w, fmt.Sprintf("<U%d", maxLen), []int{len(data)}); err != nil {
+		return err
+	}
+
+	buf := make([]uint32, maxLen)
+	for _, str := range data {
+		i := 0
+		for _, r := range str {
+			buf[i] = uint32(r)
+			i++
+		}
+		for ; i < maxLen; i++ {
+			buf[i] = 0
+		}
+		if err := binary.Write(w, binary.LittleEndian, buf); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,128 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package exportutil
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/sbinet/npyio/npy"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus-proto/go-api/schemapb"
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
+)
+
+func readNumpy(t *testing.T, content []byte, ptr interface{}) *npy.Reader {
+	reader, err := npy.NewReader(bytes.NewReader(content))
+	assert.NoError(t, err)
+	assert.NoError(t, reader.Read(ptr))
+	return reader
+}
+
+func Test_WriteNumpyHeader(t *testing.T) {
+	buf := &bytes.Buffer{}
+	err := writeNumpyHeader(buf, "<f4", []int{3, 4})
+	assert.NoError(t, err)
+	assert.Equal(t, 0, buf.Len()%npyHeaderAlignment)
+	assert.Equal(t, byte('\n'), buf.Bytes()[buf.Len()-1])
+
+	reader, err := npy.NewReader(bytes.NewReader(buf.Bytes()))
+	assert.NoError(t, err)
+	assert.Equal(t, "<f4", reader.Header.Descr.Type)
+	assert.Equal(t, []int{3, 4}, reader.Header.Descr.Shape)
+}
+
+func Test_WriteNumpy(t *testing.T) {
+	schema := sampleSchema()
+	data := sampleInsertData([]int64{1, 2, 3})
+
+	write := func(fieldID storage.FieldID) []byte {
+		buf := &bytes.Buffer{}
+		for _, field := range schema.GetFields() {
+			if field.GetFieldID() == fieldID {
+				assert.NoError(t, WriteNumpy(buf, field, data.Data[fieldID]))
+			}
+		}
+		return buf.Bytes()
+	}
+
+	var bools []bool
+	readNumpy(t, write(101), &bools)
+	assert.Equal(t, []bool{false, true, false}, bools)
+
+	var int8s []int8
+	readNumpy(t, write(102), &int8s)
+	assert.Equal(t, []int8{1, 2, 3}, int8s)
+
+	var int16s []int16
+	readNumpy(t, write(103), &int16s)
+	assert.Equal(t, []int16{1, 2, 3}, int16s)
+
+	var int32s []int32
+	readNumpy(t, write(104), &int32s)
+	assert.Equal(t, []int32{1, 2, 3}, int32s)
+
+	var int64s []int64
+	readNumpy(t, write(100), &int64s)
+	assert.Equal(t, []int64{1, 2, 3}, int64s)
+
+	var floats []float32
+	readNumpy(t, write(105), &floats)
+	assert.Equal(t, []float32{1.5, 2.5, 3.5}, floats)
+
+	var doubles []float64
+	readNumpy(t, write(106), &doubles)
+	assert.Equal(t, []float64{1.25, 2.25, 3.25}, doubles)
+
+	var binVectors []uint8
+	reader := readNumpy(t, write(108), &binVectors)
+	assert.Equal(t, []int{3, 2}, reader.Header.Descr.Shape)
+	assert.Equal(t, []uint8{1, 2, 2, 3, 3, 4}, binVectors)
+
+	var floatVectors []float32
+	reader = readNumpy(t, write(109), &floatVectors)
+	assert.Equal(t, []int{3, 4}, reader.Header.Descr.Shape)
+	assert.Equal(t, []float32{1, 2, 3, 4}, floatVectors[:4])
+
+	// varchar is written with utf32 encoding, each string occupies 4*maxLen bytes
+	content := write(107)
+	reader, err := npy.NewReader(bytes.NewReader(content))
+	assert.NoError(t, err)
+	assert.Equal(t, "<U5", reader.Header.Descr.Type)
+	assert.Equal(t, []int{3}, reader.Header.Descr.Shape)
+	headerLen := len(content) - 3*4*5
+	assert.Equal(t, 0, headerLen%npyHeaderAlignment)
+	assert.Equal(t, []byte{'s', 0, 0, 0, 't', 0, 0, 0}, content[headerLen:headerLen+8])
+
+	// unicode strings are padded to the same length
+	buf := &bytes.Buffer{}
+	err = writeNumpyStrings(buf, []string{"中文", ""})
+	assert.NoError(t, err)
+	reader, err = npy.NewReader(bytes.NewReader(buf.Bytes()))
+	assert.NoError(t, err)
+	assert.Equal(t, "<U2", reader.Header.Descr.Type)
+	assert.Equal(t, 0, (buf.Len()-2*4*2)%npyHeaderAlignment)
+
+	// unsupported field
+	jsonSchema := &schemapb.FieldSchema{FieldID: 110, Name: "field_json", DataType: typeutil.DataTypeJSON}
+	err = WriteNumpy(&bytes.Buffer{}, jsonSchema, &storage.JSONFieldData{NumRows: []int64{0}})
+	assert.Error(t, err)
+	err = WriteNumpy(&bytes.Buffer{}, jsonSchema, nil)
+	assert.Error(t, err)
+}
@@ -0,0 +1,161 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package exportutil
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/apache/arrow/go/v8/arrow"
+	"github.com/apache/arrow/go/v8/arrow/array"
+	"github.com/apache/arrow/go/v8/arrow/memory"
+	"github.com/apache/arrow/go/v8/parquet"
+	"github.com/apache/arrow/go/v8/parquet/pqarrow"
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus-proto/go-api/schemapb"
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
+)
+
+// ParquetRowGroupSize is the maximum row count of a row group in the exported parquet file
+var ParquetRowGroupSize int64 = 64 * 1024
+
+// arrowType returns the arrow type used to store the field in a parquet file, the types are chosen
+// so that the file can be parsed by the parquet parser of bulk insert
+func arrowType(schema *schemapb.FieldSchema) (arrow.DataType, error) {
+	switch schema.GetDataType() {
+	case schemapb.DataType_Bool:
+		return arrow.FixedWidthTypes.Boolean, nil
+	case schemapb.DataType_Int8:
+		return arrow.PrimitiveTypes.Int8, nil
+	case schemapb.DataType_Int16:
+		return arrow.PrimitiveTypes.Int16, nil
+	case schemapb.DataType_Int32:
+		return arrow.PrimitiveTypes.Int32, nil
+	case schemapb.DataType_Int64:
+		return arrow.PrimitiveTypes.Int64, nil
+	case schemapb.DataType_Float:
+		return arrow.PrimitiveTypes.Float32, nil
+	case schemapb.DataType_Double:
+		return arrow.PrimitiveTypes.Float64, nil
+	case schemapb.DataType_String, schemapb.DataType_VarChar, typeutil.DataTypeJSON:
+		return arrow.BinaryTypes.String, nil
+	case schemapb.DataType_BinaryVector:
+		dim, err := getFieldDimension(schema)
+		if err != nil {
+			return nil, err
+		}
+		return &arrow.FixedSizeBinaryType{ByteWidth: dim / 8}, nil
+	case schemapb.DataType_FloatVector:
+		// the fixed size list is not used since the parquet writer of arrow v8 doesn't write its values correctly
+		return arrow.ListOf(arrow.PrimitiveTypes.Float32), nil
+	default:
+		return nil, fmt.Errorf("parquet format doesn't support the data type %s of field '%s'",
+			schema.GetDataType().String(), schema.GetName())
+	}
+}
+
+// appendArrowValues appends the values of a field into the arrow builder
+func appendArrowValues(builder array.Builder, field storage.FieldData, rowCount int) error {
+	switch field := field.(type) {
+	case *storage.BoolFieldData:
+		builder.(*array.BooleanBuilder).AppendValues(field.Data, nil)
+	case *storage.Int8FieldData:
+		builder.(*array.Int8Builder).AppendValues(field.Data, nil)
+	case *storage.Int16FieldData:
+		builder.(*array.Int16Builder).AppendValues(field.Data, nil)
+	case *storage.Int32FieldData:
+		builder.(*array.Int32Builder).AppendValues(field.Data, nil)
+	case *storage.Int64FieldData:
+		builder.(*array.Int64Builder).AppendValues(field.Data, nil)
+	case *storage.FloatFieldData:
+		builder.(*array.Float32Builder).AppendValues(field.Data, nil)
+	case *storage.DoubleFieldData:
+		builder.(*array.Float64Builder).AppendValues(field.Data, nil)
+	case *storage.StringFieldData:
+		builder.(*array.StringBuilder).AppendValues(field.Data, nil)
+	case *storage.JSONFieldData:
+		stringBuilder := builder.(*array.StringBuilder)
+		for _, value := range field.Data {
+			stringBuilder.Append(string(value))
+		}
+	case *storage.BinaryVectorFieldData:
+		binaryBuilder := builder.(*array.FixedSizeBinaryBuilder)
+		for i := 0; i < rowCount; i++ {
+			binaryBuilder.Append(field.GetRow(i).([]byte))
+		}
+	case *storage.FloatVectorFieldData:
+		listBuilder := builder.(*array.ListBuilder)
+		valueBuilder := listBuilder.ValueBuilder().(*array.Float32Builder)
+		for i := 0; i < rowCount; i++ {
+			listBuilder.Append(true)
+			valueBuilder.AppendValues(field.GetRow(i).([]float32), nil)
+		}
+	default:
+		return fmt.Errorf("parquet format doesn't support the field data type %T", field)
+	}
+	return nil
+}
+
+// WriteParquet writes the fields data into a parquet file, each field is a column named by the field name
+func WriteParquet(w io.Writer, collectionSchema *schemapb.CollectionSchema,
+	fields map[storage.FieldID]storage.FieldData, rowCount int) error {
+	arrowFields := make([]arrow.Field, 0, len(collectionSchema.GetFields()))
+	for _, schema := range collectionSchema.GetFields() {
+		dataType, err := arrowType(schema)
+		if err != nil {
+			log.Error("parquet writer: unsupported field", zap.String("fieldName", schema.GetName()), zap.Error(err))
+			return err
+		}
+		arrowFields = append(arrowFields, arrow.Field{Name: schema.GetName(), Type: dataType})
+	}
+	arrowSchema := arrow.NewSchema(arrowFields, nil)
+
+	builder := array.NewRecordBuilder(memory.DefaultAllocator, arrowSchema)
+	defer builder.Release()
+	for i, schema := range collectionSchema.GetFields() {
+		field, ok := fields[schema.GetFieldID()]
+		if !ok || field.RowNum() != rowCount {
+			log.Error("parquet writer: row count of field mismatch", zap.String("fieldName", schema.GetName()))
+			return fmt.Errorf("row count of field '%s' mismatch", schema.GetName())
+		}
+		if err := appendArrowValues(builder.Field(i), field, rowCount); err != nil {
+			return err
+		}
+	}
+
+	record := builder.NewRecord()
+	defer record.Release()
+
+	writer, err := pqarrow.NewFileWriter(arrowSchema, w,
+		parquet.NewWriterProperties(parquet.WithMaxRowGroupLength(ParquetRowGroupSize)), pqarrow.DefaultWriterProps())
+	if err != nil {
+		log.Error("parquet writer: failed to create file writer", zap.Error(err))
+		return fmt.Errorf("failed to create parquet file writer, error: %w", err)
+	}
+	if err = writer.Write(record); err != nil {
+		log.Error("parquet writer: failed to write record", zap.Error(err))
+		return fmt.Errorf("failed to write parquet record, error: %w", err)
+	}
+	if err = writer.Close(); err != nil {
+		log.Error("parquet writer: failed to close file writer", zap.Error(err))
+		return fmt.Errorf("failed to close parquet file writer, error: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,104 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package exportutil
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/apache/arrow/go/v8/arrow"
+	"github.com/apache/arrow/go/v8/arrow/array"
+	"github.com/apache/arrow/go/v8/arrow/memory"
+	"github.com/apache/arrow/go/v8/parquet"
+	"github.com/apache/arrow/go/v8/parquet/pqarrow"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus-proto/go-api/schemapb"
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
+)
+
+func readParquet(t *testing.T, content []byte) arrow.Table {
+	table, err := pqarrow.ReadTable(context.Background(), bytes.NewReader(content), parquet.NewReaderProperties(nil),
+		pqarrow.ArrowReadProperties{}, memory.DefaultAllocator)
+	assert.NoError(t, err)
+	return table
+}
+
+func Test_WriteParquet(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		schema := sampleSchema()
+		schema.Fields = schema.Fields[2:]
+		schema.Fields = append(schema.Fields, &schemapb.FieldSchema{FieldID: 110, Name: "field_json", DataType: typeutil.DataTypeJSON})
+		data := sampleInsertData([]int64{1, 2, 3})
+		data.Data[110] = &storage.JSONFieldData{NumRows: []int64{3}, Data: [][]byte{[]byte(`{"a":1}`), []byte(`{}`), []byte(`{"b":[1]}`)}}
+
+		buf := &bytes.Buffer{}
+		err := WriteParquet(buf, schema, data.Data, 3)
+		assert.NoError(t, err)
+
+		table := readParquet(t, buf.Bytes())
+		defer table.Release()
+		assert.Equal(t, int64(3), table.NumRows())
+		assert.Equal(t, 11, int(table.NumCols()))
+
+		for i, field := range schema.GetFields() {
+			column := table.Column(i)
+			assert.Equal(t, field.GetName(), column.Name())
+			chunk := column.Data().Chunk(0)
+			switch field.GetFieldID() {
+			case 100:
+				assert.Equal(t, []int64{1, 2, 3}, chunk.(*array.Int64).Int64Values())
+			case 107:
+				assert.Equal(t, "str_2", chunk.(*array.String).Value(1))
+			case 108:
+				assert.Equal(t, []byte{3, 4}, chunk.(*array.FixedSizeBinary).Value(2))
+			case 109:
+				list := chunk.(*array.List)
+				values := list.ListValues().(*array.Float32).Float32Values()
+				assert.Equal(t, []float32{2, 3, 4, 5}, values[4:8])
+			case 110:
+				assert.Equal(t, `{"b":[1]}`, chunk.(*array.String).Value(2))
+			}
+		}
+	})
+
+	t.Run("row count mismatch", func(t *testing.T) {
+		schema := sampleSchema()
+		schema.Fields = schema.Fields[2:]
+		data := sampleInsertData([]int64{1, 2, 3})
+		err := WriteParquet(&bytes.Buffer{}, schema, data.Data, 2)
+		assert.Error(t, err)
+
+		delete(data.Data, 100)
+		err = WriteParquet(&bytes.Buffer{}, schema, data.Data, 3)
+		assert.Error(t, err)
+	})
+
+	t.Run("unsupported field", func(t *testing.T) {
+		schema := &schemapb.CollectionSchema{
+			Fields: []*schemapb.FieldSchema{{FieldID: 100, Name: "field_array", DataType: typeutil.DataTypeArray}},
+		}
+		err := WriteParquet(&bytes.Buffer{}, schema, map[storage.FieldID]storage.FieldData{}, 0)
+		assert.Error(t, err)
+
+		schema.Fields[0].DataType = schemapb.DataType_BinaryVector
+		err = WriteParquet(&bytes.Buffer{}, schema, map[storage.FieldID]storage.FieldData{}, 0)
+		assert.Error(t, err)
+	})
+}
@@ -417,6 +417,17 @@ func (p *BinlogFile) ReadVarchar() ([]string, error) {
 			return nil, errors.New("binlog file is not insert log")
 		}
 
+		// delete event payload might be compressed, let storage decode it
+		if event.TypeCode == storage.DeleteEventType {
+			data, err := storage.ReadDeleteLogStrings(p.reader, event)
+			if err != nil {
+				log.Error("Binlog file: failed to read delete log data", zap.Error(err))
+				return nil, fmt.Errorf("failed to read delete log data, error: %w", err)
+			}
+			result = append(result, data...)
+			continue
+		}
+
 		if (p.DataType() != schemapb.DataType_VarChar) && (p.DataType() != schemapb.DataType_String) {
 			log.Error("Binlog file: binlog data type is not varchar")
 			return nil, errors.New("binlog data type is not varchar")
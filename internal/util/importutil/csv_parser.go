@@ -0,0 +1,270 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package importutil
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus-proto/go-api/schemapb"
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/storage"
+)
+
+// utf8BOM is the byte order mark some tools put at the beginning of a csv file
+const utf8BOM = "\ufeff"
+
+// CSVColumnDesc describes the field bound to a column of the csv header
+type CSVColumnDesc struct {
+	schema    *schemapb.FieldSchema // field schema of the column
+	colIndex  int                   // column index in the header, starts from 0
+	validator *Validator            // validator of the field
+}
+
+// CSVParser is the parser for row-based csv files, the first line of the file is a header which
+// contains field names, each of the following lines is a row.
+// The CSVParser converts each cell into the same value type as JSONParser does, so that the rows can be
+// handled by JSONRowValidator and JSONRowConsumer.
+type CSVParser struct {
+	ctx              context.Context                  // for canceling parse process
+	collectionSchema *schemapb.CollectionSchema       // collection schema
+	bufSize          int64                            // max rows in a buffer
+	fields           map[string]*schemapb.FieldSchema // fields need to be parsed
+}
+
+// NewCSVParser helper function to create a CSVParser
+func NewCSVParser(ctx context.Context, collectionSchema *schemapb.CollectionSchema) *CSVParser {
+	fields := make(map[string]*schemapb.FieldSchema)
+	for i := 0; i < len(collectionSchema.Fields); i++ {
+		schema := collectionSchema.Fields[i]
+		fields[schema.GetName()] = schema
+	}
+
+	return &CSVParser{
+		ctx:              ctx,
+		collectionSchema: collectionSchema,
+		bufSize:          estimateBufSize(collectionSchema),
+		fields:           fields,
+	}
+}
+
+// bindHeader maps the header columns to fields of the collection schema
+// each field must have a column except auto-generated primary key, redundant column is not allowed
+func (p *CSVParser) bindHeader(header []string) ([]*CSVColumnDesc, error) {
+	validators := make(map[storage.FieldID]*Validator)
+	if err := initValidators(p.collectionSchema, validators); err != nil {
+		log.Error("CSV parser: failed to initialize validators", zap.Error(err))
+		return nil, fmt.Errorf("failed to initialize validators, error: %w", err)
+	}
+
+	columnDescs := make([]*CSVColumnDesc, 0, len(header))
+	bound := make(map[string]int)
+	for i, name := range header {
+		if i == 0 {
+			name = strings.TrimPrefix(name, utf8BOM)
+		}
+		name = strings.TrimSpace(name)
+
+		schema, ok := p.fields[name]
+		if !ok {
+			log.Error("CSV parser: the column is not defined in collection schema", zap.String("columnName", name),
+				zap.Int("columnNumber", i+1))
+			return nil, fmt.Errorf("the column '%s' at column %d of the header is not defined in collection schema", name, i+1)
+		}
+		if col, ok := bound[name]; ok {
+			log.Error("CSV parser: duplicate column in header", zap.String("columnName", name),
+				zap.Int("columnNumber", i+1), zap.Int("previousColumnNumber", col+1))
+			return nil, fmt.Errorf("duplicate column '%s' at column %d and %d of the header", name, col+1, i+1)
+		}
+		if schema.GetIsPrimaryKey() && schema.GetAutoID() {
+			log.Error("CSV parser: the primary key is auto-generated, no need to provide", zap.String("fieldName", name),
+				zap.Int("columnNumber", i+1))
+			return nil, fmt.Errorf("the primary key '%s' at column %d of the header is auto-generated, no need to provide",
+				name, i+1)
+		}
+
+		bound[name] = i
+		columnDescs = append(columnDescs, &CSVColumnDesc{schema: schema, colIndex: i, validator: validators[schema.GetFieldID()]})
+	}
+
+	for name, schema := range p.fields {
+		if _, ok := bound[name]; !ok && !(schema.GetIsPrimaryKey() && schema.GetAutoID()) {
+			log.Error("CSV parser: there is no column for field", zap.String("fieldName", name))
+			return nil, fmt.Errorf("there is no column for field '%s' in the header", name)
+		}
+	}
+
+	return columnDescs, nil
+}
+
+// parseNumber checks the cell is a number and returns it as json.Number, the same as JSONParser output
+func parseNumber(cell string) (json.Number, error) {
+	value := strings.TrimSpace(cell)
+	if _, err := strconv.ParseFloat(value, 64); err != nil {
+		return "", fmt.Errorf("'%s' is not a number", cell)
+	}
+	return json.Number(value), nil
+}
+
+// parseVector parses a vector cell, the cell is a bracketed list like "[1, 2, 3]",
+// or a list delimited by comma, semicolon or whitespace like "1;2;3"
+func parseVector(cell string) ([]interface{}, error) {
+	value := strings.TrimSpace(cell)
+	if strings.HasPrefix(value, "[") {
+		dec := json.NewDecoder(strings.NewReader(value))
+		dec.UseNumber()
+		var arr []interface{}
+		if err := dec.Decode(&arr); err != nil {
+			return nil, fmt.Errorf("'%s' is not a valid list, error: %w", cell, err)
+		}
+		return arr, nil
+	}
+
+	elements := strings.FieldsFunc(value, func(r rune) bool {
+		return r == ',' || r == ';' || r == ' ' || r == '\t'
+	})
+	arr := make([]interface{}, 0, len(elements))
+	for _, element := range elements {
+		num, err := parseNumber(element)
+		if err != nil {
+			return nil, err
+		}
+		arr = append(arr, num)
+	}
+	return arr, nil
+}
+
+// parseCell converts a cell into the value type that JSONRowValidator expects for the field
+func parseCell(cell string, schema *schemapb.FieldSchema) (interface{}, error) {
+	switch schema.GetDataType() {
+	case schemapb.DataType_Bool:
+		value, err := strconv.ParseBool(strings.TrimSpace(cell))
+		if err != nil {
+			return nil, fmt.Errorf("'%s' is not a bool value", cell)
+		}
+		return value, nil
+	case schemapb.DataType_Int8, schemapb.DataType_Int16, schemapb.DataType_Int32, schemapb.DataType_Int64,
+		schemapb.DataType_Float, schemapb.DataType_Double:
+		return parseNumber(cell)
+	case schemapb.DataType_BinaryVector, schemapb.DataType_FloatVector:
+		return parseVector(cell)
+	default:
+		// varchar and json field accept the cell as it is
+		return cell, nil
+	}
+}
+
+// ParseRows reads the csv file and sends rows to handler, the handler contract is the same as JSONParser.ParseRows
+func (p *CSVParser) ParseRows(r io.Reader, handler JSONRowHandler) error {
+	if handler == nil {
+		log.Error("CSV parse handler is nil")
+		return errors.New("CSV parse handler is nil")
+	}
+
+	reader := csv.NewReader(r)
+	reader.ReuseRecord = true
+
+	header, err := reader.Read()
+	if err != nil {
+		log.Error("CSV parser: failed to read header of the CSV file", zap.Error(err))
+		return fmt.Errorf("failed to read header of the CSV file, error: %w", err)
+	}
+	columnDescs, err := p.bindHeader(header)
+	if err != nil {
+		return err
+	}
+
+	isEmpty := true
+	buf := make([]map[storage.FieldID]interface{}, 0, MinBufferSize)
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			// csv.ParseError has line and column number
+			log.Error("CSV parser: failed to read row", zap.Error(err))
+			return fmt.Errorf("failed to read row of the CSV file, error: %w", err)
+		}
+
+		row := make(map[storage.FieldID]interface{})
+		for _, desc := range columnDescs {
+			value, err := parseCell(record[desc.colIndex], desc.schema)
+			if err != nil {
+				line, _ := reader.FieldPos(desc.colIndex)
+				log.Error("CSV parser: failed to parse cell", zap.String("fieldName", desc.schema.GetName()),
+					zap.Int("rowNumber", line), zap.Int("columnNumber", desc.colIndex+1), zap.Error(err))
+				return fmt.Errorf("failed to parse value for field '%s' at row %d column %d, error: %w",
+					desc.schema.GetName(), line, desc.colIndex+1, err)
+			}
+			// validate the cell here since the downstream validator doesn't know the position of the value in the file
+			if desc.validator != nil {
+				if err := desc.validator.validateFunc(value); err != nil {
+					line, _ := reader.FieldPos(desc.colIndex)
+					log.Error("CSV parser: invalid value", zap.String("fieldName", desc.schema.GetName()),
+						zap.Int("rowNumber", line), zap.Int("columnNumber", desc.colIndex+1), zap.Error(err))
+					return fmt.Errorf("the field '%s' value at row %d column %d is invalid, error: %w",
+						desc.schema.GetName(), line, desc.colIndex+1, err)
+				}
+			}
+			row[desc.schema.GetFieldID()] = value
+		}
+
+		buf = append(buf, row)
+		if len(buf) >= int(p.bufSize) {
+			isEmpty = false
+			if err = handler.Handle(buf); err != nil {
+				log.Error("CSV parser: failed to convert row value to entity", zap.Error(err))
+				return fmt.Errorf("failed to convert row value to entity, error: %w", err)
+			}
+
+			// clear the buffer
+			buf = make([]map[storage.FieldID]interface{}, 0, MinBufferSize)
+		}
+
+		// outside context might be canceled(service stop, or future enhancement for canceling import task)
+		if isCanceled(p.ctx) {
+			log.Error("CSV parser: import task was canceled")
+			return errors.New("import task was canceled")
+		}
+	}
+
+	// some rows in buffer not parsed, parse them
+	if len(buf) > 0 {
+		isEmpty = false
+		if err = handler.Handle(buf); err != nil {
+			log.Error("CSV parser: failed to convert row value to entity", zap.Error(err))
+			return fmt.Errorf("failed to convert row value to entity, error: %w", err)
+		}
+	}
+
+	if isEmpty {
+		log.Error("CSV parser: row count is 0")
+		return errors.New("row count is 0")
+	}
+
+	// send nil to notify the handler all have done
+	return handler.Handle(nil)
+}
@@ -0,0 +1,229 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package importutil
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus-proto/go-api/schemapb"
+	"github.com/milvus-io/milvus/internal/storage"
+)
+
+const sampleCSVHeader = "field_bool,field_int8,field_int16,field_int32,field_int64,field_float,field_double,field_string,field_binary_vector,field_float_vector\n"
+
+type mockCSVRowHandler struct {
+	rows []map[storage.FieldID]interface{}
+	err  error
+}
+
+func (h *mockCSVRowHandler) Handle(rows []map[storage.FieldID]interface{}) error {
+	h.rows = append(h.rows, rows...)
+	return h.err
+}
+
+func Test_CSVParserParseRows(t *testing.T) {
+	ctx := context.Background()
+	schema := sampleSchema()
+
+	t.Run("success", func(t *testing.T) {
+		parser := NewCSVParser(ctx, schema)
+		assert.NotNil(t, parser)
+		parser.bufSize = 1
+
+		reader := strings.NewReader(utf8BOM + sampleCSVHeader +
+			`true,10,101,1001,10001,3.14,1.56,hello world,"[254, 0]","[1.1, 1.2, 1.3, 1.4]"` + "\n" +
+			`false,11,102,1002,10002,3.15,2.56,"hello, world",253 0,2.1;2.2;2.3;2.4` + "\n" +
+			`true,12,103,1003,10003,3.16,3.56,hello world,"252,0","3.1,3.2,3.3,3.4"` + "\n")
+
+		validator, err := NewJSONRowValidator(schema, nil)
+		assert.NoError(t, err)
+		err = parser.ParseRows(reader, validator)
+		assert.NoError(t, err)
+		assert.Equal(t, int64(3), validator.ValidateCount())
+	})
+
+	t.Run("columns in any order", func(t *testing.T) {
+		parser := NewCSVParser(ctx, schema)
+		reader := strings.NewReader("field_float_vector, field_binary_vector,field_string,field_double,field_float,field_int64,field_int32,field_int16,field_int8,field_bool\n" +
+			`"[1.1, 1.2, 1.3, 1.4]","[254, 0]",hello world,1.56,3.14,10001,1001,101,10,true` + "\n")
+
+		handler := &mockCSVRowHandler{}
+		err := parser.ParseRows(reader, handler)
+		assert.NoError(t, err)
+		assert.Equal(t, 1, len(handler.rows))
+		row := handler.rows[0]
+		assert.Equal(t, true, row[102])
+		assert.Equal(t, json.Number("10001"), row[106])
+		assert.Equal(t, "hello world", row[109])
+		assert.Equal(t, []interface{}{json.Number("254"), json.Number("0")}, row[110])
+		assert.Equal(t, []interface{}{json.Number("1.1"), json.Number("1.2"), json.Number("1.3"), json.Number("1.4")}, row[111])
+	})
+
+	t.Run("handler is nil", func(t *testing.T) {
+		parser := NewCSVParser(ctx, schema)
+		err := parser.ParseRows(strings.NewReader(sampleCSVHeader), nil)
+		assert.Error(t, err)
+	})
+
+	t.Run("no row", func(t *testing.T) {
+		parser := NewCSVParser(ctx, schema)
+		err := parser.ParseRows(strings.NewReader(sampleCSVHeader), &mockCSVRowHandler{})
+		assert.Error(t, err)
+
+		err = parser.ParseRows(strings.NewReader(""), &mockCSVRowHandler{})
+		assert.Error(t, err)
+	})
+
+	t.Run("illegal cell", func(t *testing.T) {
+		parser := NewCSVParser(ctx, schema)
+		reader := strings.NewReader(sampleCSVHeader +
+			`true,10,101,1001,10001,3.14,1.56,hello world,"[254, 0]","[1.1, 1.2, 1.3, 1.4]"` + "\n" +
+			`true,10,101,1001,10001,3.14,1.56,hello world,"[254, 0]","[1.1, 1.2, dummy, 1.4]"` + "\n")
+		err := parser.ParseRows(reader, &mockCSVRowHandler{})
+		assert.Error(t, err)
+		assert.True(t, strings.Contains(err.Error(), "row 3 column 10"))
+
+		reader = strings.NewReader(sampleCSVHeader +
+			`dummy,10,101,1001,10001,3.14,1.56,hello world,"[254, 0]","[1.1, 1.2, 1.3, 1.4]"` + "\n")
+		err = parser.ParseRows(reader, &mockCSVRowHandler{})
+		assert.Error(t, err)
+		assert.True(t, strings.Contains(err.Error(), "row 2 column 1"))
+
+		reader = strings.NewReader(sampleCSVHeader +
+			`true,10,101,1001,10001,3.14,1.56,hello world,"254;0",1.1 1.2 x 1.4` + "\n")
+		err = parser.ParseRows(reader, &mockCSVRowHandler{})
+		assert.Error(t, err)
+
+		reader = strings.NewReader(sampleCSVHeader +
+			`true,10,101,1001,10001,3.14,1.56,hello world,"[254, 0]","[1.1, 1.2, 1.3, 1.4"` + "\n")
+		err = parser.ParseRows(reader, &mockCSVRowHandler{})
+		assert.Error(t, err)
+	})
+
+	t.Run("invalid value", func(t *testing.T) {
+		parser := NewCSVParser(ctx, schema)
+		// wrong dimension of the binary vector
+		reader := strings.NewReader(sampleCSVHeader +
+			`true,10,101,1001,10001,3.14,1.56,hello world,"[254, 0]","[1.1, 1.2, 1.3, 1.4]"` + "\n" +
+			`true,10,101,1001,10001,3.14,1.56,hello world,"[254]","[1.1, 1.2, 1.3, 1.4]"` + "\n")
+		err := parser.ParseRows(reader, &mockCSVRowHandler{})
+		assert.Error(t, err)
+		assert.True(t, strings.Contains(err.Error(), "row 3 column 9"))
+
+		// wrong dimension of the float vector, the row is the line where the cell begins
+		reader = strings.NewReader(sampleCSVHeader +
+			`true,10,101,1001,10001,3.14,1.56,"hello` + "\n" + `world","[254, 0]","[1.1, 1.2, 1.3]"` + "\n")
+		err = parser.ParseRows(reader, &mockCSVRowHandler{})
+		assert.Error(t, err)
+		assert.True(t, strings.Contains(err.Error(), "row 3 column 10"))
+	})
+
+	t.Run("wrong number of cells", func(t *testing.T) {
+		parser := NewCSVParser(ctx, schema)
+		reader := strings.NewReader(sampleCSVHeader + `true,10,101` + "\n")
+		err := parser.ParseRows(reader, &mockCSVRowHandler{})
+		assert.Error(t, err)
+	})
+
+	t.Run("handler failed", func(t *testing.T) {
+		parser := NewCSVParser(ctx, schema)
+		reader := strings.NewReader(sampleCSVHeader +
+			`true,10,101,1001,10001,3.14,1.56,hello world,"[254, 0]","[1.1, 1.2, 1.3, 1.4]"` + "\n")
+		err := parser.ParseRows(reader, &mockCSVRowHandler{err: errors.New("error")})
+		assert.Error(t, err)
+	})
+
+	t.Run("canceled", func(t *testing.T) {
+		cancelCtx, cancel := context.WithCancel(ctx)
+		cancel()
+		parser := NewCSVParser(cancelCtx, schema)
+		reader := strings.NewReader(sampleCSVHeader +
+			`true,10,101,1001,10001,3.14,1.56,hello world,"[254, 0]","[1.1, 1.2, 1.3, 1.4]"` + "\n")
+		err := parser.ParseRows(reader, &mockCSVRowHandler{})
+		assert.Error(t, err)
+	})
+}
+
+func Test_CSVParserBindHeader(t *testing.T) {
+	ctx := context.Background()
+	parser := NewCSVParser(ctx, sampleSchema())
+
+	descs, err := parser.bindHeader(strings.Split(strings.TrimSpace(sampleCSVHeader), ","))
+	assert.NoError(t, err)
+	assert.Equal(t, 10, len(descs))
+	assert.Equal(t, 9, descs[9].colIndex)
+	assert.Equal(t, "field_float_vector", descs[9].schema.GetName())
+
+	// redundant column
+	_, err = parser.bindHeader(append(strings.Split(strings.TrimSpace(sampleCSVHeader), ","), "dummy"))
+	assert.Error(t, err)
+
+	// duplicate column
+	_, err = parser.bindHeader(append(strings.Split(strings.TrimSpace(sampleCSVHeader), ","), "field_bool"))
+	assert.Error(t, err)
+
+	// column missed
+	_, err = parser.bindHeader([]string{"field_bool"})
+	assert.Error(t, err)
+
+	// auto-generated primary key is not required, and not allowed
+	schema := &schemapb.CollectionSchema{
+		Name: "schema",
+		Fields: []*schemapb.FieldSchema{
+			{
+				FieldID:      101,
+				Name:         "uid",
+				IsPrimaryKey: true,
+				AutoID:       true,
+				DataType:     schemapb.DataType_Int64,
+			},
+			{
+				FieldID:  102,
+				Name:     "name",
+				DataType: schemapb.DataType_VarChar,
+			},
+		},
+	}
+	parser = NewCSVParser(ctx, schema)
+	descs, err = parser.bindHeader([]string{"name"})
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(descs))
+
+	_, err = parser.bindHeader([]string{"uid", "name"})
+	assert.Error(t, err)
+}
+
+func Test_CSVParserParseVector(t *testing.T) {
+	expected := []interface{}{json.Number("1"), json.Number("2.5"), json.Number("-3")}
+
+	for _, cell := range []string{"[1, 2.5, -3]", " [1,2.5,-3] ", "1,2.5,-3", "1;2.5;-3", "1 2.5 -3", "1\t2.5\t-3"} {
+		arr, err := parseVector(cell)
+		assert.NoError(t, err)
+		assert.Equal(t, expected, arr)
+	}
+
+	_, err := parseVector("[1, 2")
+	assert.Error(t, err)
+	_, err = parseVector("1, a")
+	assert.Error(t, err)
+}
@@ -33,6 +33,7 @@ import (
 	"github.com/milvus-io/milvus/internal/common"
 	"github.com/milvus-io/milvus/internal/log"
 	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
 )
 
 func isCanceled(ctx context.Context) bool {
@@ -112,6 +113,11 @@ func initSegmentData(collectionSchema *schemapb.CollectionSchema) map[storage.Fi
 				Data:    make([]string, 0),
 				NumRows: []int64{0},
 			}
+		case typeutil.DataTypeJSON:
+			segmentData[schema.GetFieldID()] = &storage.JSONFieldData{
+				Data:    make([][]byte, 0),
+				NumRows: []int64{0},
+			}
 		default:
 			log.Error("Import util: unsupported data type", zap.String("DataType", getTypeName(schema.DataType)))
 			return nil
@@ -331,6 +337,40 @@ func initValidators(collectionSchema *schemapb.CollectionSchema, validators map[
 				field.(*storage.StringFieldData).NumRows[0]++
 				return nil
 			}
+		case typeutil.DataTypeJSON:
+			// a json field accepts an object, or a string holding a serialized object
+			validators[schema.GetFieldID()].validateFunc = func(obj interface{}) error {
+				switch value := obj.(type) {
+				case map[string]interface{}:
+					return nil
+				case string:
+					var dummy map[string]interface{}
+					if err := json.Unmarshal([]byte(value), &dummy); err != nil {
+						return fmt.Errorf("'%v' is not a valid json object for json type field '%s', error: %w", obj, schema.GetName(), err)
+					}
+					return nil
+				default:
+					return fmt.Errorf("'%v' is not a json object for json type field '%s'", obj, schema.GetName())
+				}
+			}
+
+			validators[schema.GetFieldID()].convertFunc = func(obj interface{}, field storage.FieldData) error {
+				var value []byte
+				switch obj := obj.(type) {
+				case string:
+					value = []byte(obj)
+				default:
+					bs, err := json.Marshal(obj)
+					if err != nil {
+						return fmt.Errorf("failed to marshal value '%v' for json type field '%s', error: %w",
+							obj, schema.GetName(), err)
+					}
+					value = bs
+				}
+				field.(*storage.JSONFieldData).Data = append(field.(*storage.JSONFieldData).Data, value)
+				field.(*storage.JSONFieldData).NumRows[0]++
+				return nil
+			}
 		default:
 			return fmt.Errorf("unsupport data type: %s", getTypeName(collectionSchema.Fields[i].DataType))
 		}
@@ -523,6 +563,8 @@ func getTypeName(dt schemapb.DataType) string {
 		return "BinaryVector"
 	case schemapb.DataType_FloatVector:
 		return "FloatVector"
+	case typeutil.DataTypeJSON:
+		return "JSON"
 	default:
 		return "InvalidType"
 	}
@@ -24,6 +24,7 @@ import (
 	"github.com/milvus-io/milvus-proto/go-api/commonpb"
 	"github.com/milvus-io/milvus-proto/go-api/schemapb"
 	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -398,6 +399,37 @@ func Test_InitValidators(t *testing.T) {
 		err = initValidators(schema, validators)
 		assert.NotNil(t, err)
 	})
+
+	t.Run("json field", func(t *testing.T) {
+		schema := &schemapb.CollectionSchema{
+			Fields: []*schemapb.FieldSchema{
+				{
+					FieldID:  111,
+					Name:     "field_json",
+					DataType: typeutil.DataTypeJSON,
+				},
+			},
+		}
+		validators := make(map[storage.FieldID]*Validator)
+		err := initValidators(schema, validators)
+		assert.Nil(t, err)
+		fields := initSegmentData(schema)
+		assert.NotNil(t, fields)
+
+		v := validators[111]
+		obj := map[string]interface{}{"brand": map[string]interface{}{"name": "x"}, "price": jsonNumber("10")}
+		assert.Nil(t, v.validateFunc(obj))
+		assert.Nil(t, v.validateFunc(`{"a": 1}`))
+		assert.NotNil(t, v.validateFunc(`{"a": `))
+		assert.NotNil(t, v.validateFunc(jsonNumber("1")))
+
+		fieldData := fields[111]
+		assert.Nil(t, v.convertFunc(obj, fieldData))
+		assert.Nil(t, v.convertFunc(`{"a": 1}`, fieldData))
+		assert.Equal(t, 2, fieldData.RowNum())
+		assert.Equal(t, []byte(`{"brand":{"name":"x"},"price":10}`), fieldData.GetRow(0))
+		assert.Equal(t, []byte(`{"a": 1}`), fieldData.GetRow(1))
+	})
 }
 
 func Test_GetFileNameAndExt(t *testing.T) {
@@ -561,6 +593,8 @@ func Test_GetTypeName(t *testing.T) {
 	assert.NotEmpty(t, str)
 	str = getTypeName(schemapb.DataType_FloatVector)
 	assert.NotEmpty(t, str)
+	str = getTypeName(typeutil.DataTypeJSON)
+	assert.Equal(t, "JSON", str)
 	str = getTypeName(schemapb.DataType_None)
 	assert.Equal(t, "InvalidType", str)
 }
@@ -18,9 +18,12 @@ package importutil
 
 import (
 	"bufio"
+	"bytes"
 	"context"
 	"fmt"
+	"io"
 
+	"github.com/apache/arrow/go/v8/parquet"
 	"go.uber.org/zap"
 
 	"github.com/milvus-io/milvus-proto/go-api/commonpb"
@@ -37,8 +40,10 @@ import (
 )
 
 const (
-	JSONFileExt  = ".json"
-	NumpyFileExt = ".npy"
+	JSONFileExt    = ".json"
+	CSVFileExt     = ".csv"
+	NumpyFileExt   = ".npy"
+	ParquetFileExt = ".parquet"
 
 	// supposed size of a single block, to control a binlog file size, the max biglog file size is no more than 2*SingleBlockSize
 	SingleBlockSize = 16 * 1024 * 1024 // 16MB
@@ -204,38 +209,43 @@ func (p *ImportWrapper) validateColumnBasedFiles(filePaths []string, collectionS
 }
 
 // fileValidation verify the input paths
-// if all the files are json type, return true
+// if all the files are json or csv type, return true
 // if all the files are numpy type, return false, and not allow duplicate file name
+// if all the files are parquet type, return false, each parquet file contains all the fields
 func (p *ImportWrapper) fileValidation(filePaths []string) (bool, error) {
 	// use this map to check duplicate file name(only for numpy file)
 	fileNames := make(map[string]struct{})
 
 	totalSize := int64(0)
 	rowBased := false
+	columnFileType := NumpyFileExt
 	for i := 0; i < len(filePaths); i++ {
 		filePath := filePaths[i]
 		name, fileType := GetFileNameAndExt(filePath)
 
-		// only allow json file or numpy file
-		if fileType != JSONFileExt && fileType != NumpyFileExt {
+		// only allow json file, csv file, numpy file or parquet file
+		if fileType != JSONFileExt && fileType != CSVFileExt && fileType != NumpyFileExt && fileType != ParquetFileExt {
 			log.Error("import wrapper: unsupported file type", zap.String("filePath", filePath))
 			return false, fmt.Errorf("unsupported file type: '%s'", filePath)
 		}
 
 		// we use the first file to determine row-based or column-based
-		if i == 0 && fileType == JSONFileExt {
+		if i == 0 && (fileType == JSONFileExt || fileType == CSVFileExt) {
 			rowBased = true
 		}
+		if i == 0 && fileType == ParquetFileExt {
+			columnFileType = ParquetFileExt
+		}
 
 		// check file type
-		// row-based only support json type, column-based only support numpy type
+		// row-based support json type or csv type, column-based support numpy type or parquet type, but not both
 		if rowBased {
-			if fileType != JSONFileExt {
+			if fileType != JSONFileExt && fileType != CSVFileExt {
 				log.Error("import wrapper: unsupported file type for row-based mode", zap.String("filePath", filePath))
 				return rowBased, fmt.Errorf("unsupported file type for row-based mode: '%s'", filePath)
 			}
 		} else {
-			if fileType != NumpyFileExt {
+			if fileType != columnFileType {
 				log.Error("import wrapper: unsupported file type for column-based mode", zap.String("filePath", filePath))
 				return rowBased, fmt.Errorf("unsupported file type for column-based mode: '%s'", filePath)
 			}
@@ -279,7 +289,8 @@ func (p *ImportWrapper) fileValidation(filePaths []string) (bool, error) {
 	// check redundant files for column-based import
 	// if the field is primary key and autoid is false, the file is required
 	// any redundant file is not allowed
-	if !rowBased {
+	// the columns of parquet files are checked by the ParquetParser since a parquet file contains all the fields
+	if !rowBased && columnFileType == NumpyFileExt {
 		err := p.validateColumnBasedFiles(filePaths, p.collectionSchema)
 		if err != nil {
 			return rowBased, err
@@ -321,8 +332,31 @@ func (p *ImportWrapper) Import(filePaths []string, options ImportOptions) error
 					log.Error("import wrapper: failed to parse row-based json file", zap.Error(err), zap.String("filePath", filePath))
 					return err
 				}
+			} else if fileType == CSVFileExt {
+				err = p.parseRowBasedCSV(filePath, options.OnlyValidate)
+				if err != nil {
+					log.Error("import wrapper: failed to parse row-based csv file", zap.Error(err), zap.String("filePath", filePath))
+					return err
+				}
 			} // no need to check else, since the fileValidation() already do this
 
+			// trigger gc after each file finished
+			triggerGC()
+		}
+	} else if p.isParquetImport(filePaths) {
+		// parse and consume column-based parquet files
+		// each parquet file contains all the fields, the ParquetParser output the fields data row group by row group,
+		// and splitFieldsData() is called for each row group, so that the whole file is not loaded into memory
+		for i := 0; i < len(filePaths); i++ {
+			filePath := filePaths[i]
+			log.Info("import wrapper:  column-based parquet file ", zap.Any("filePath", filePath))
+
+			err = p.parseColumnBasedParquet(filePath, options.OnlyValidate)
+			if err != nil {
+				log.Error("import wrapper: failed to parse column-based parquet file", zap.Error(err), zap.String("filePath", filePath))
+				return err
+			}
+
 			// trigger gc after each file finished
 			triggerGC()
 		}
@@ -501,6 +535,33 @@ func (p *ImportWrapper) doBinlogImport(filePaths []string, tsStartPoint uint64,
 // parseRowBasedJSON is the entry of row-based json import operation
 func (p *ImportWrapper) parseRowBasedJSON(filePath string, onlyValidate bool) error {
 	tr := timerecord.NewTimeRecorder("json row-based parser: " + filePath)
+	parser := NewJSONParser(p.ctx, p.collectionSchema)
+	err := p.parseRowBased(filePath, onlyValidate, parser.ParseRows)
+	if err != nil {
+		return err
+	}
+
+	tr.Elapse("parsed")
+	return nil
+}
+
+// parseRowBasedCSV is the entry of row-based csv import operation
+func (p *ImportWrapper) parseRowBasedCSV(filePath string, onlyValidate bool) error {
+	tr := timerecord.NewTimeRecorder("csv row-based parser: " + filePath)
+	parser := NewCSVParser(p.ctx, p.collectionSchema)
+	err := p.parseRowBased(filePath, onlyValidate, parser.ParseRows)
+	if err != nil {
+		return err
+	}
+
+	tr.Elapse("parsed")
+	return nil
+}
+
+// parseRowBased reads a row-based file by parseFunc, the rows are validated by JSONRowValidator
+// and consumed by JSONRowConsumer
+func (p *ImportWrapper) parseRowBased(filePath string, onlyValidate bool,
+	parseFunc func(r io.Reader, handler JSONRowHandler) error) error {
 
 	// for minio storage, chunkManager will download file into local memory
 	// for local storage, chunkManager open the file directly
@@ -512,7 +573,6 @@ func (p *ImportWrapper) parseRowBasedJSON(filePath string, onlyValidate bool) er
 
 	// parse file
 	reader := bufio.NewReader(file)
-	parser := NewJSONParser(p.ctx, p.collectionSchema)
 	var consumer *JSONRowConsumer
 	if !onlyValidate {
 		flushFunc := func(fields map[storage.FieldID]storage.FieldData, shardID int) error {
@@ -532,7 +592,7 @@ func (p *ImportWrapper) parseRowBasedJSON(filePath string, onlyValidate bool) er
 		return err
 	}
 
-	err = parser.ParseRows(reader, validator)
+	err = parseFunc(reader, validator)
 	if err != nil {
 		return err
 	}
@@ -542,7 +602,6 @@ func (p *ImportWrapper) parseRowBasedJSON(filePath string, onlyValidate bool) er
 		p.importResult.AutoIds = append(p.importResult.AutoIds, consumer.IDRange()...)
 	}
 
-	tr.Elapse("parsed")
 	return nil
 }
 
@@ -594,6 +653,65 @@ func (p *ImportWrapper) parseColumnBasedNumpy(filePath string, onlyValidate bool
 	return nil
 }
 
+// isParquetImport returns true if the files are parquet files, the fileValidation() makes sure
+// that the files of column-based import are all numpy files or all parquet files
+func (p *ImportWrapper) isParquetImport(filePaths []string) bool {
+	if len(filePaths) == 0 {
+		return false
+	}
+	_, fileType := GetFileNameAndExt(filePaths[0])
+	return fileType == ParquetFileExt
+}
+
+// parseColumnBasedParquet is the entry of column-based parquet import operation
+func (p *ImportWrapper) parseColumnBasedParquet(filePath string, onlyValidate bool) error {
+	tr := timerecord.NewTimeRecorder("parquet parser: " + filePath)
+
+	// for minio storage, chunkManager returns a minio object which supports random access
+	// for local storage, chunkManager open the file directly
+	file, err := p.chunkManager.Reader(p.ctx, filePath)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	// parquet reader requires random access to read the footer and the column chunks,
+	// read the whole file into memory if the chunk manager doesn't support it
+	reader, ok := file.(parquet.ReaderAtSeeker)
+	if !ok {
+		content, err := p.chunkManager.Read(p.ctx, filePath)
+		if err != nil {
+			return err
+		}
+		reader = bytes.NewReader(content)
+	}
+
+	// the parquet parser return fields data of a row group, split it into segments
+	flushFunc := func(fields map[storage.FieldID]storage.FieldData) error {
+		fieldsData := initSegmentData(p.collectionSchema)
+		if fieldsData == nil {
+			log.Error("import wrapper: failed to initialize FieldData list")
+			return fmt.Errorf("failed to initialize FieldData list")
+		}
+		for id, data := range fields {
+			fieldsData[id] = data
+		}
+
+		var filePaths = []string{filePath}
+		printFieldsDataInfo(fieldsData, "import wrapper: prepare to split parquet row group", filePaths)
+		return p.splitFieldsData(fieldsData, SingleBlockSize)
+	}
+
+	parser := NewParquetParser(p.ctx, p.collectionSchema, flushFunc)
+	err = parser.Parse(reader, onlyValidate)
+	if err != nil {
+		return err
+	}
+
+	tr.Elapse("parsed")
+	return nil
+}
+
 // appendFunc defines the methods to append data to storage.FieldData
 func (p *ImportWrapper) appendFunc(schema *schemapb.FieldSchema) func(src storage.FieldData, n int, target storage.FieldData) error {
 	switch schema.DataType {
@@ -666,6 +784,13 @@ func (p *ImportWrapper) appendFunc(schema *schemapb.FieldSchema) func(src storag
 			arr.Data = append(arr.Data, src.GetRow(n).(string))
 			return nil
 		}
+	case typeutil.DataTypeJSON:
+		return func(src storage.FieldData, n int, target storage.FieldData) error {
+			arr := target.(*storage.JSONFieldData)
+			arr.Data = append(arr.Data, src.GetRow(n).([]byte))
+			arr.NumRows[0]++
+			return nil
+		}
 	default:
 		return nil
 	}
@@ -28,6 +28,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/apache/arrow/go/v8/arrow"
 	"github.com/stretchr/testify/assert"
 	"golang.org/x/exp/mmap"
 
@@ -309,6 +310,75 @@ func Test_ImportWrapperRowBased(t *testing.T) {
 	assert.NotNil(t, err)
 }
 
+func Test_ImportWrapperRowBased_csv(t *testing.T) {
+	err := os.MkdirAll(TempFilesPath, os.ModePerm)
+	assert.Nil(t, err)
+	defer os.RemoveAll(TempFilesPath)
+
+	f := storage.NewChunkManagerFactory("local", storage.RootPath(TempFilesPath))
+	ctx := context.Background()
+	cm, err := f.NewPersistentStorageChunkManager(ctx)
+	assert.NoError(t, err)
+
+	idAllocator := newIDAllocator(ctx, t, nil)
+
+	content := []byte(sampleCSVHeader +
+		`true,10,101,1001,10001,3.14,1.56,hello world,"[254, 0]","[1.1, 1.2, 1.3, 1.4]"` + "\n" +
+		`false,11,102,1002,10002,3.15,2.56,hello world,"[253, 0]","[2.1, 2.2, 2.3, 2.4]"` + "\n" +
+		`true,12,103,1003,10003,3.16,3.56,hello world,"[252, 0]","[3.1, 3.2, 3.3, 3.4]"` + "\n" +
+		`false,13,104,1004,10004,3.17,4.56,hello world,"[251, 0]","[4.1, 4.2, 4.3, 4.4]"` + "\n" +
+		`true,14,105,1005,10005,3.18,5.56,hello world,"[250, 0]","[5.1, 5.2, 5.3, 5.4]"` + "\n")
+
+	filePath := TempFilesPath + "rows_1.csv"
+	err = cm.Write(ctx, filePath, content)
+	assert.NoError(t, err)
+	defer cm.RemoveWithPrefix(ctx, "")
+
+	rowCounter := &rowCounterTest{}
+	assignSegmentFunc, flushFunc, saveSegmentFunc := createMockCallbackFunctions(t, rowCounter)
+
+	importResult := &rootcoordpb.ImportResult{
+		Status: &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_Success,
+		},
+		TaskId:     1,
+		DatanodeId: 1,
+		State:      commonpb.ImportState_ImportStarted,
+		Segments:   make([]int64, 0),
+		AutoIds:    make([]int64, 0),
+		RowCount:   0,
+	}
+	reportFunc := func(res *rootcoordpb.ImportResult) error {
+		return nil
+	}
+	wrapper := NewImportWrapper(ctx, sampleSchema(), 2, 1, idAllocator, cm, importResult, reportFunc)
+	wrapper.SetCallbackFunctions(assignSegmentFunc, flushFunc, saveSegmentFunc)
+	files := []string{filePath}
+	err = wrapper.Import(files, ImportOptions{OnlyValidate: true})
+	assert.Nil(t, err)
+	assert.Equal(t, 0, rowCounter.rowCount)
+
+	err = wrapper.Import(files, DefaultImportOptions())
+	assert.Nil(t, err)
+	assert.Equal(t, 5, rowCounter.rowCount)
+	assert.Equal(t, commonpb.ImportState_ImportPersisted, importResult.State)
+
+	// parse error
+	content = []byte(sampleCSVHeader +
+		`true,false,101,1001,10001,3.14,1.56,hello world,"[254, 0]","[1.1, 1.2, 1.3, 1.4]"` + "\n")
+
+	filePath = TempFilesPath + "rows_2.csv"
+	err = cm.Write(ctx, filePath, content)
+	assert.NoError(t, err)
+
+	importResult.State = commonpb.ImportState_ImportStarted
+	wrapper = NewImportWrapper(ctx, sampleSchema(), 2, 1, idAllocator, cm, importResult, reportFunc)
+	wrapper.SetCallbackFunctions(assignSegmentFunc, flushFunc, saveSegmentFunc)
+	err = wrapper.Import([]string{filePath}, ImportOptions{OnlyValidate: true})
+	assert.NotNil(t, err)
+	assert.NotEqual(t, commonpb.ImportState_ImportPersisted, importResult.State)
+}
+
 func createSampleNumpyFiles(t *testing.T, cm storage.ChunkManager) []string {
 	ctx := context.Background()
 	files := make([]string, 0)
@@ -452,6 +522,66 @@ func Test_ImportWrapperColumnBased_numpy(t *testing.T) {
 	assert.NotNil(t, err)
 }
 
+func Test_ImportWrapperColumnBased_parquet(t *testing.T) {
+	err := os.MkdirAll(TempFilesPath, os.ModePerm)
+	assert.Nil(t, err)
+	defer os.RemoveAll(TempFilesPath)
+
+	f := storage.NewChunkManagerFactory("local", storage.RootPath(TempFilesPath))
+	ctx := context.Background()
+	cm, err := f.NewPersistentStorageChunkManager(ctx)
+	assert.NoError(t, err)
+	defer cm.RemoveWithPrefix(ctx, "")
+
+	idAllocator := newIDAllocator(ctx, t, nil)
+
+	rowCounter := &rowCounterTest{}
+	assignSegmentFunc, flushFunc, saveSegmentFunc := createMockCallbackFunctions(t, rowCounter)
+
+	// success case
+	importResult := &rootcoordpb.ImportResult{
+		Status: &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_Success,
+		},
+		TaskId:     1,
+		DatanodeId: 1,
+		State:      commonpb.ImportState_ImportStarted,
+		Segments:   make([]int64, 0),
+		AutoIds:    make([]int64, 0),
+		RowCount:   0,
+	}
+	reportFunc := func(res *rootcoordpb.ImportResult) error {
+		return nil
+	}
+	wrapper := NewImportWrapper(ctx, sampleSchema(), 2, 1, idAllocator, cm, importResult, reportFunc)
+	wrapper.SetCallbackFunctions(assignSegmentFunc, flushFunc, saveSegmentFunc)
+
+	arrowSchema := sampleParquetSchema(arrow.ListOf(arrow.PrimitiveTypes.Float32))
+	files := []string{"a/data.parquet", "b/data_2.parquet"}
+	err = cm.Write(ctx, files[0], createParquetData(t, arrowSchema, 10, 3))
+	assert.NoError(t, err)
+	err = cm.Write(ctx, files[1], createParquetData(t, arrowSchema, 5, 3))
+	assert.NoError(t, err)
+
+	err = wrapper.Import(files, DefaultImportOptions())
+	assert.Nil(t, err)
+	assert.Equal(t, 15, rowCounter.rowCount)
+	assert.Equal(t, commonpb.ImportState_ImportPersisted, importResult.State)
+
+	// broken parquet file
+	filePath := "c/data.parquet"
+	err = cm.Write(ctx, filePath, createParquetData(t, arrowSchema, 5, 3)[:10])
+	assert.NoError(t, err)
+
+	importResult.State = commonpb.ImportState_ImportStarted
+	wrapper = NewImportWrapper(ctx, sampleSchema(), 2, 1, idAllocator, cm, importResult, reportFunc)
+	wrapper.SetCallbackFunctions(assignSegmentFunc, flushFunc, saveSegmentFunc)
+
+	err = wrapper.Import([]string{filePath}, DefaultImportOptions())
+	assert.NotNil(t, err)
+	assert.NotEqual(t, commonpb.ImportState_ImportPersisted, importResult.State)
+}
+
 func perfSchema(dim int) *schemapb.CollectionSchema {
 	schema := &schemapb.CollectionSchema{
 		Name:        "schema",
@@ -718,23 +848,53 @@ func Test_ImportWrapperFileValidation(t *testing.T) {
 	assert.NotNil(t, err)
 	assert.True(t, rowBased)
 
+	files = []string{"a/uid.csv", "b/bol.npy"}
+	rowBased, err = wrapper.fileValidation(files)
+	assert.NotNil(t, err)
+	assert.True(t, rowBased)
+
 	// unsupported file for column-based
 	files = []string{"a/uid.npy", "b/bol.json"}
 	rowBased, err = wrapper.fileValidation(files)
 	assert.NotNil(t, err)
 	assert.False(t, rowBased)
 
+	// numpy files and parquet files can't be mixed
+	files = []string{"a/uid.npy", "b/bol.parquet"}
+	rowBased, err = wrapper.fileValidation(files)
+	assert.NotNil(t, err)
+	assert.False(t, rowBased)
+
+	files = []string{"a/1.parquet", "b/bol.npy"}
+	rowBased, err = wrapper.fileValidation(files)
+	assert.NotNil(t, err)
+	assert.False(t, rowBased)
+
 	// valid cases
 	files = []string{"a/1.json", "b/2.json"}
 	rowBased, err = wrapper.fileValidation(files)
 	assert.Nil(t, err)
 	assert.True(t, rowBased)
 
+	files = []string{"a/1.csv", "b/2.json"}
+	rowBased, err = wrapper.fileValidation(files)
+	assert.Nil(t, err)
+	assert.True(t, rowBased)
+
 	files = []string{"a/uid.npy", "b/bol.npy"}
 	rowBased, err = wrapper.fileValidation(files)
 	assert.Nil(t, err)
 	assert.False(t, rowBased)
 
+	// the columns of parquet files are checked by the parser
+	files = []string{"a/1.parquet", "b/2.parquet"}
+	rowBased, err = wrapper.fileValidation(files)
+	assert.Nil(t, err)
+	assert.False(t, rowBased)
+	assert.True(t, wrapper.isParquetImport(files))
+
+	files = []string{"a/uid.npy", "b/bol.npy"}
+
 	// empty file
 	cm.size = 0
 	wrapper = NewImportWrapper(ctx, schema, int32(shardNum), int64(segmentSize), idAllocator, cm, nil, nil)
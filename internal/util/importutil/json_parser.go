@@ -59,19 +59,19 @@ func NewJSONParser(ctx context.Context, collectionSchema *schemapb.CollectionSch
 
 	parser := &JSONParser{
 		ctx:          ctx,
-		bufSize:      MinBufferSize,
+		bufSize:      estimateBufSize(collectionSchema),
 		fields:       fields,
 		name2FieldID: name2FieldID,
 	}
-	adjustBufSize(parser, collectionSchema)
 
 	return parser
 }
 
-func adjustBufSize(parser *JSONParser, collectionSchema *schemapb.CollectionSchema) {
+// estimateBufSize returns how many rows a row-based parser reads into a buffer each time
+func estimateBufSize(collectionSchema *schemapb.CollectionSchema) int64 {
 	sizePerRecord, _ := typeutil.EstimateSizePerRecord(collectionSchema)
 	if sizePerRecord <= 0 {
-		return
+		return MinBufferSize
 	}
 
 	// split the file into no more than MaxBatchCount batches to parse
@@ -85,8 +85,8 @@ func adjustBufSize(parser *JSONParser, collectionSchema *schemapb.CollectionSche
 		bufSize = MinBufferSize
 	}
 
-	log.Info("JSON parser: reset bufSize", zap.Int("sizePerRecord", sizePerRecord), zap.Int("bufSize", bufSize))
-	parser.bufSize = int64(bufSize)
+	log.Info("import parser: estimate bufSize", zap.Int("sizePerRecord", sizePerRecord), zap.Int("bufSize", bufSize))
+	return int64(bufSize)
 }
 
 func (p *JSONParser) ParseRows(r io.Reader, handler JSONRowHandler) error {
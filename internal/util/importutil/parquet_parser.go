@@ -0,0 +1,524 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package importutil
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/apache/arrow/go/v8/arrow"
+	"github.com/apache/arrow/go/v8/arrow/array"
+	"github.com/apache/arrow/go/v8/arrow/memory"
+	"github.com/apache/arrow/go/v8/parquet"
+	"github.com/apache/arrow/go/v8/parquet/file"
+	"github.com/apache/arrow/go/v8/parquet/pqarrow"
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus-proto/go-api/schemapb"
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
+)
+
+// ParquetColumnDesc describes a parquet column and its target field
+type ParquetColumnDesc struct {
+	schema    *schemapb.FieldSchema // schema of the target field
+	colIndex  int                   // index of the column in the arrow schema of the parquet file
+	dimension int                   // only for vector
+}
+
+// ParquetParser parses a column-based parquet file, the column names of the file must be equal to the field names.
+// Unlike the numpy file, a parquet file contains all the fields, and the row groups of the file are parsed
+// and passed to the flush function one by one, so that the whole file is not loaded into memory.
+type ParquetParser struct {
+	ctx              context.Context            // for canceling parse process
+	collectionSchema *schemapb.CollectionSchema // collection schema
+	columnDescs      []*ParquetColumnDesc       // description for target columns
+
+	callFlushFunc func(fields map[storage.FieldID]storage.FieldData) error // call back function to output row group data
+}
+
+// NewParquetParser is helper function to create a ParquetParser
+func NewParquetParser(ctx context.Context, collectionSchema *schemapb.CollectionSchema,
+	flushFunc func(fields map[storage.FieldID]storage.FieldData) error) *ParquetParser {
+	if collectionSchema == nil || flushFunc == nil {
+		return nil
+	}
+
+	parser := &ParquetParser{
+		ctx:              ctx,
+		collectionSchema: collectionSchema,
+		columnDescs:      make([]*ParquetColumnDesc, 0),
+		callFlushFunc:    flushFunc,
+	}
+
+	return parser
+}
+
+// validate checks the columns of the parquet file against the collection schema
+// if the field is primary key and autoid is false, the column is required, any redundant column is not allowed
+func (p *ParquetParser) validate(arrowSchema *arrow.Schema) error {
+	columnIndices := make(map[string]int)
+	for i, field := range arrowSchema.Fields() {
+		if _, ok := columnIndices[field.Name]; ok {
+			log.Error("Parquet parser: duplicate column", zap.String("columnName", field.Name))
+			return fmt.Errorf("duplicate column '%s' in parquet file", field.Name)
+		}
+		columnIndices[field.Name] = i
+	}
+
+	p.columnDescs = make([]*ParquetColumnDesc, 0, len(p.collectionSchema.Fields))
+	for _, schema := range p.collectionSchema.Fields {
+		colIndex, ok := columnIndices[schema.GetName()]
+		if schema.GetIsPrimaryKey() && schema.GetAutoID() {
+			if ok {
+				log.Error("Parquet parser: the primary key is auto-generated, no need to provide", zap.String("fieldName", schema.GetName()))
+				return fmt.Errorf("the primary key '%s' is auto-generated, no need to provide", schema.GetName())
+			}
+			continue
+		}
+		if !ok {
+			log.Error("Parquet parser: there is no column corresponding to field", zap.String("fieldName", schema.GetName()))
+			return fmt.Errorf("there is no column corresponding to field '%s'", schema.GetName())
+		}
+		delete(columnIndices, schema.GetName())
+
+		desc := &ParquetColumnDesc{
+			schema:   schema,
+			colIndex: colIndex,
+		}
+		if schema.GetDataType() == schemapb.DataType_FloatVector || schema.GetDataType() == schemapb.DataType_BinaryVector {
+			var err error
+			desc.dimension, err = getFieldDimension(schema)
+			if err != nil {
+				return err
+			}
+		}
+		if err := validateParquetColumnType(desc, arrowSchema.Field(colIndex).Type); err != nil {
+			return err
+		}
+		p.columnDescs = append(p.columnDescs, desc)
+	}
+
+	for name := range columnIndices {
+		log.Error("Parquet parser: the column has no corresponding field in collection", zap.String("columnName", name))
+		return fmt.Errorf("the column '%s' has no corresponding field in collection", name)
+	}
+
+	return nil
+}
+
+// validateParquetColumnType checks that the arrow type of the column can be converted to the field data type
+func validateParquetColumnType(desc *ParquetColumnDesc, dataType arrow.DataType) error {
+	schema := desc.schema
+	illegalTypeErr := func() error {
+		log.Error("Parquet parser: illegal column type for field", zap.String("fieldName", schema.GetName()),
+			zap.String("columnType", dataType.Name()), zap.String("fieldType", getTypeName(schema.GetDataType())))
+		return fmt.Errorf("illegal column type %s for field '%s' with type %s",
+			dataType.Name(), schema.GetName(), getTypeName(schema.GetDataType()))
+	}
+
+	switch schema.GetDataType() {
+	case schemapb.DataType_Bool:
+		if dataType.ID() != arrow.BOOL {
+			return illegalTypeErr()
+		}
+	case schemapb.DataType_Int8:
+		if dataType.ID() != arrow.INT8 {
+			return illegalTypeErr()
+		}
+	case schemapb.DataType_Int16:
+		if dataType.ID() != arrow.INT16 {
+			return illegalTypeErr()
+		}
+	case schemapb.DataType_Int32:
+		if dataType.ID() != arrow.INT32 {
+			return illegalTypeErr()
+		}
+	case schemapb.DataType_Int64:
+		if dataType.ID() != arrow.INT64 {
+			return illegalTypeErr()
+		}
+	case schemapb.DataType_Float:
+		if dataType.ID() != arrow.FLOAT32 {
+			return illegalTypeErr()
+		}
+	case schemapb.DataType_Double:
+		if dataType.ID() != arrow.FLOAT64 {
+			return illegalTypeErr()
+		}
+	case schemapb.DataType_String, schemapb.DataType_VarChar:
+		if dataType.ID() != arrow.STRING {
+			return illegalTypeErr()
+		}
+	case typeutil.DataTypeJSON:
+		if dataType.ID() != arrow.STRING && dataType.ID() != arrow.BINARY {
+			return illegalTypeErr()
+		}
+	case schemapb.DataType_BinaryVector:
+		// a binary vector is stored as binary, or fixed size binary with dim/8 bytes
+		switch t := dataType.(type) {
+		case *arrow.BinaryType:
+		case *arrow.FixedSizeBinaryType:
+			if t.ByteWidth != desc.dimension/8 {
+				log.Error("Parquet parser: illegal dimension of binary vector column", zap.String("fieldName", schema.GetName()),
+					zap.Int("columnDimension", t.ByteWidth*8), zap.Int("fieldDimension", desc.dimension))
+				return fmt.Errorf("illegal dimension %d of column for binary vector field '%s', dimension should be %d",
+					t.ByteWidth*8, schema.GetName(), desc.dimension)
+			}
+		default:
+			return illegalTypeErr()
+		}
+	case schemapb.DataType_FloatVector:
+		// a float vector is stored as list, or fixed size list with dim elements, the element type is float or double
+		var elemType arrow.DataType
+		switch t := dataType.(type) {
+		case *arrow.ListType:
+			elemType = t.Elem()
+		case *arrow.FixedSizeListType:
+			if int(t.Len()) != desc.dimension {
+				log.Error("Parquet parser: illegal dimension of float vector column", zap.String("fieldName", schema.GetName()),
+					zap.Int32("columnDimension", t.Len()), zap.Int("fieldDimension", desc.dimension))
+				return fmt.Errorf("illegal dimension %d of column for float vector field '%s', dimension should be %d",
+					t.Len(), schema.GetName(), desc.dimension)
+			}
+			elemType = t.Elem()
+		default:
+			return illegalTypeErr()
+		}
+		if elemType.ID() != arrow.FLOAT32 && elemType.ID() != arrow.FLOAT64 {
+			return illegalTypeErr()
+		}
+	default:
+		log.Error("Parquet parser: unsupported data type of field", zap.Any("dataType", schema.GetDataType()),
+			zap.String("fieldName", schema.GetName()))
+		return fmt.Errorf("unsupported data type %s of field '%s'", getTypeName(schema.GetDataType()), schema.GetName())
+	}
+	return nil
+}
+
+// consume converts a column of a row group into storage.FieldData
+func (p *ParquetParser) consume(desc *ParquetColumnDesc, column *arrow.Chunked) (storage.FieldData, error) {
+	schema := desc.schema
+	rowCount := column.Len()
+	if column.NullN() > 0 {
+		log.Error("Parquet parser: null value is not supported", zap.String("fieldName", schema.GetName()))
+		return nil, fmt.Errorf("null value is not supported, field '%s' has %d null values", schema.GetName(), column.NullN())
+	}
+
+	switch schema.GetDataType() {
+	case schemapb.DataType_Bool:
+		data := make([]bool, 0, rowCount)
+		for _, chunk := range column.Chunks() {
+			arr := chunk.(*array.Boolean)
+			for i := 0; i < arr.Len(); i++ {
+				data = append(data, arr.Value(i))
+			}
+		}
+		return &storage.BoolFieldData{
+			NumRows: []int64{int64(len(data))},
+			Data:    data,
+		}, nil
+	case schemapb.DataType_Int8:
+		data := make([]int8, 0, rowCount)
+		for _, chunk := range column.Chunks() {
+			data = append(data, chunk.(*array.Int8).Int8Values()...)
+		}
+		return &storage.Int8FieldData{
+			NumRows: []int64{int64(len(data))},
+			Data:    data,
+		}, nil
+	case schemapb.DataType_Int16:
+		data := make([]int16, 0, rowCount)
+		for _, chunk := range column.Chunks() {
+			data = append(data, chunk.(*array.Int16).Int16Values()...)
+		}
+		return &storage.Int16FieldData{
+			NumRows: []int64{int64(len(data))},
+			Data:    data,
+		}, nil
+	case schemapb.DataType_Int32:
+		data := make([]int32, 0, rowCount)
+		for _, chunk := range column.Chunks() {
+			data = append(data, chunk.(*array.Int32).Int32Values()...)
+		}
+		return &storage.Int32FieldData{
+			NumRows: []int64{int64(len(data))},
+			Data:    data,
+		}, nil
+	case schemapb.DataType_Int64:
+		data := make([]int64, 0, rowCount)
+		for _, chunk := range column.Chunks() {
+			data = append(data, chunk.(*array.Int64).Int64Values()...)
+		}
+		return &storage.Int64FieldData{
+			NumRows: []int64{int64(len(data))},
+			Data:    data,
+		}, nil
+	case schemapb.DataType_Float:
+		data := make([]float32, 0, rowCount)
+		for _, chunk := range column.Chunks() {
+			data = append(data, chunk.(*array.Float32).Float32Values()...)
+		}
+		return &storage.FloatFieldData{
+			NumRows: []int64{int64(len(data))},
+			Data:    data,
+		}, nil
+	case schemapb.DataType_Double:
+		data := make([]float64, 0, rowCount)
+		for _, chunk := range column.Chunks() {
+			data = append(data, chunk.(*array.Float64).Float64Values()...)
+		}
+		return &storage.DoubleFieldData{
+			NumRows: []int64{int64(len(data))},
+			Data:    data,
+		}, nil
+	case schemapb.DataType_String, schemapb.DataType_VarChar:
+		data := make([]string, 0, rowCount)
+		for _, chunk := range column.Chunks() {
+			arr := chunk.(*array.String)
+			for i := 0; i < arr.Len(); i++ {
+				data = append(data, arr.Value(i))
+			}
+		}
+		return &storage.StringFieldData{
+			NumRows: []int64{int64(len(data))},
+			Data:    data,
+		}, nil
+	case typeutil.DataTypeJSON:
+		data := make([][]byte, 0, rowCount)
+		appendJSON := func(value []byte) error {
+			var dummy map[string]interface{}
+			if err := json.Unmarshal(value, &dummy); err != nil {
+				return fmt.Errorf("'%s' is not a valid json object for json type field '%s', error: %w", string(value), schema.GetName(), err)
+			}
+			// the value is copied since the memory of arrow array is released after the row group is consumed
+			data = append(data, append([]byte{}, value...))
+			return nil
+		}
+		for _, chunk := range column.Chunks() {
+			switch arr := chunk.(type) {
+			case *array.String:
+				for i := 0; i < arr.Len(); i++ {
+					if err := appendJSON([]byte(arr.Value(i))); err != nil {
+						return nil, err
+					}
+				}
+			case *array.Binary:
+				for i := 0; i < arr.Len(); i++ {
+					if err := appendJSON(arr.Value(i)); err != nil {
+						return nil, err
+					}
+				}
+			}
+		}
+		return &storage.JSONFieldData{
+			NumRows: []int64{int64(len(data))},
+			Data:    data,
+		}, nil
+	case schemapb.DataType_BinaryVector:
+		byteCount := desc.dimension / 8
+		data := make([]byte, 0, rowCount*byteCount)
+		for _, chunk := range column.Chunks() {
+			for i := 0; i < chunk.Len(); i++ {
+				var value []byte
+				switch arr := chunk.(type) {
+				case *array.FixedSizeBinary:
+					value = arr.Value(i)
+				case *array.Binary:
+					value = arr.Value(i)
+				}
+				if len(value) != byteCount {
+					log.Error("Parquet parser: illegal dimension of binary vector", zap.String("fieldName", schema.GetName()),
+						zap.Int("dimension", len(value)*8), zap.Int("fieldDimension", desc.dimension))
+					return nil, fmt.Errorf("illegal dimension %d of binary vector for field '%s', dimension should be %d",
+						len(value)*8, schema.GetName(), desc.dimension)
+				}
+				data = append(data, value...)
+			}
+		}
+		return &storage.BinaryVectorFieldData{
+			NumRows: []int64{int64(rowCount)},
+			Data:    data,
+			Dim:     desc.dimension,
+		}, nil
+	case schemapb.DataType_FloatVector:
+		data := make([]float32, 0, rowCount*desc.dimension)
+		for _, chunk := range column.Chunks() {
+			var err error
+			data, err = appendFloatVectors(data, chunk, desc)
+			if err != nil {
+				return nil, err
+			}
+		}
+		return &storage.FloatVectorFieldData{
+			NumRows: []int64{int64(rowCount)},
+			Data:    data,
+			Dim:     desc.dimension,
+		}, nil
+	default:
+		log.Error("Parquet parser: unsupported data type of field", zap.Any("dataType", schema.GetDataType()),
+			zap.String("fieldName", schema.GetName()))
+		return nil, fmt.Errorf("unsupported data type %s of field '%s'", getTypeName(schema.GetDataType()), schema.GetName())
+	}
+}
+
+// appendFloatVectors appends the vectors of a list or fixed size list array to data,
+// the float64 elements are converted to float32
+func appendFloatVectors(data []float32, chunk arrow.Array, desc *ParquetColumnDesc) ([]float32, error) {
+	var values arrow.Array
+	var vectorRange func(i int) (int, int)
+	switch arr := chunk.(type) {
+	case *array.List:
+		values = arr.ListValues()
+		offsets := arr.Offsets()[arr.Data().Offset():]
+		vectorRange = func(i int) (int, int) {
+			return int(offsets[i]), int(offsets[i+1])
+		}
+	case *array.FixedSizeList:
+		values = arr.ListValues()
+		offset := arr.Data().Offset()
+		vectorRange = func(i int) (int, int) {
+			return (offset + i) * desc.dimension, (offset + i + 1) * desc.dimension
+		}
+	default:
+		return nil, fmt.Errorf("illegal column type %s for float vector field '%s'", chunk.DataType().Name(), desc.schema.GetName())
+	}
+
+	for i := 0; i < chunk.Len(); i++ {
+		begin, end := vectorRange(i)
+		if end-begin != desc.dimension {
+			log.Error("Parquet parser: illegal dimension of float vector", zap.String("fieldName", desc.schema.GetName()),
+				zap.Int("dimension", end-begin), zap.Int("fieldDimension", desc.dimension))
+			return nil, fmt.Errorf("illegal dimension %d of float vector for field '%s', dimension should be %d",
+				end-begin, desc.schema.GetName(), desc.dimension)
+		}
+		switch elems := values.(type) {
+		case *array.Float32:
+			data = append(data, elems.Float32Values()[begin:end]...)
+		case *array.Float64:
+			for _, f64 := range elems.Float64Values()[begin:end] {
+				data = append(data, float32(f64))
+			}
+		default:
+			return nil, fmt.Errorf("illegal element type %s for float vector field '%s'", values.DataType().Name(), desc.schema.GetName())
+		}
+	}
+	return data, nil
+}
+
+// leafColumnIndices returns the indices of the parquet leaf columns under a field of the schema manifest,
+// a nested field such as a list has more than one node but only the leaves are physical columns
+func leafColumnIndices(field *pqarrow.SchemaField) []int {
+	// SchemaField.IsLeaf is not reliable, ColIndex of a nested field is left 0 instead of -1
+	if len(field.Children) == 0 {
+		return []int{field.ColIndex}
+	}
+	indices := make([]int, 0)
+	for i := range field.Children {
+		indices = append(indices, leafColumnIndices(&field.Children[i])...)
+	}
+	return indices
+}
+
+// consumeRowGroup reads a row group and converts its columns into storage.FieldData
+func (p *ParquetParser) consumeRowGroup(reader *pqarrow.FileReader, rowGroup int) (map[storage.FieldID]storage.FieldData, error) {
+	// the row group is read by leaf column indices, while colIndex of the column desc is the index of the top-level
+	// field in the arrow schema, map each field to its leaves so that the table has a column for each desc in order
+	colIndices := make([]int, 0, reader.ParquetReader().MetaData().Schema.NumColumns())
+	for _, desc := range p.columnDescs {
+		if desc.colIndex >= len(reader.Manifest.Fields) {
+			return nil, fmt.Errorf("column index %d of field '%s' is out of the parquet schema", desc.colIndex, desc.schema.GetName())
+		}
+		colIndices = append(colIndices, leafColumnIndices(&reader.Manifest.Fields[desc.colIndex])...)
+	}
+
+	table, err := reader.ReadRowGroups(p.ctx, colIndices, []int{rowGroup})
+	if err != nil {
+		log.Error("Parquet parser: failed to read row group", zap.Int("rowGroup", rowGroup), zap.Error(err))
+		return nil, fmt.Errorf("failed to read row group %d of parquet file, error: %w", rowGroup, err)
+	}
+	defer table.Release()
+
+	fields := make(map[storage.FieldID]storage.FieldData)
+	for i, desc := range p.columnDescs {
+		data, err := p.consume(desc, table.Column(i).Data())
+		if err != nil {
+			return nil, err
+		}
+		fields[desc.schema.GetFieldID()] = data
+	}
+	return fields, nil
+}
+
+func (p *ParquetParser) Parse(reader parquet.ReaderAtSeeker, onlyValidate bool) error {
+	if reader == nil {
+		log.Error("Parquet parser: parquet reader is nil")
+		return errors.New("parquet reader is nil")
+	}
+
+	parquetReader, err := file.NewParquetReader(reader)
+	if err != nil {
+		log.Error("Parquet parser: failed to open parquet file", zap.Error(err))
+		return fmt.Errorf("failed to open parquet file, error: %w", err)
+	}
+	defer parquetReader.Close()
+
+	fileReader, err := pqarrow.NewFileReader(parquetReader, pqarrow.ArrowReadProperties{}, memory.DefaultAllocator)
+	if err != nil {
+		log.Error("Parquet parser: failed to create arrow reader", zap.Error(err))
+		return fmt.Errorf("failed to create arrow reader of parquet file, error: %w", err)
+	}
+
+	arrowSchema, err := fileReader.Schema()
+	if err != nil {
+		log.Error("Parquet parser: failed to get arrow schema", zap.Error(err))
+		return fmt.Errorf("failed to get arrow schema of parquet file, error: %w", err)
+	}
+
+	// the validation method only check the file schema
+	err = p.validate(arrowSchema)
+	if err != nil {
+		return err
+	}
+
+	if onlyValidate {
+		return nil
+	}
+
+	// read the row groups one by one
+	for i := 0; i < parquetReader.NumRowGroups(); i++ {
+		if isCanceled(p.ctx) {
+			log.Error("Parquet parser: import task was canceled")
+			return errors.New("import task was canceled")
+		}
+
+		fields, err := p.consumeRowGroup(fileReader, i)
+		if err != nil {
+			return err
+		}
+
+		err = p.callFlushFunc(fields)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,344 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package importutil
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/apache/arrow/go/v8/arrow"
+	"github.com/apache/arrow/go/v8/arrow/array"
+	"github.com/apache/arrow/go/v8/arrow/memory"
+	"github.com/apache/arrow/go/v8/parquet"
+	"github.com/apache/arrow/go/v8/parquet/pqarrow"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus-proto/go-api/schemapb"
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
+)
+
+// sampleParquetSchema returns the arrow schema of the parquet file for sampleSchema()
+func sampleParquetSchema(floatVectorType arrow.DataType) *arrow.Schema {
+	return arrow.NewSchema([]arrow.Field{
+		{Name: "field_bool", Type: arrow.FixedWidthTypes.Boolean},
+		{Name: "field_int8", Type: arrow.PrimitiveTypes.Int8},
+		{Name: "field_int16", Type: arrow.PrimitiveTypes.Int16},
+		{Name: "field_int32", Type: arrow.PrimitiveTypes.Int32},
+		{Name: "field_int64", Type: arrow.PrimitiveTypes.Int64},
+		{Name: "field_float", Type: arrow.PrimitiveTypes.Float32},
+		{Name: "field_double", Type: arrow.PrimitiveTypes.Float64},
+		{Name: "field_string", Type: arrow.BinaryTypes.String},
+		{Name: "field_binary_vector", Type: &arrow.FixedSizeBinaryType{ByteWidth: 2}},
+		{Name: "field_float_vector", Type: floatVectorType},
+	}, nil)
+}
+
+// createParquetData generates a parquet file for sampleSchema(), the value of each field in row i is derived from i
+func createParquetData(t *testing.T, arrowSchema *arrow.Schema, rowCount int, rowGroupSize int64) []byte {
+	builder := array.NewRecordBuilder(memory.DefaultAllocator, arrowSchema)
+	defer builder.Release()
+
+	for i := 0; i < rowCount; i++ {
+		builder.Field(0).(*array.BooleanBuilder).Append(i%2 == 0)
+		builder.Field(1).(*array.Int8Builder).Append(int8(i))
+		builder.Field(2).(*array.Int16Builder).Append(int16(i))
+		builder.Field(3).(*array.Int32Builder).Append(int32(i))
+		builder.Field(4).(*array.Int64Builder).Append(int64(i))
+		builder.Field(5).(*array.Float32Builder).Append(float32(i))
+		builder.Field(6).(*array.Float64Builder).Append(float64(i))
+		builder.Field(7).(*array.StringBuilder).Append(fmt.Sprintf("str_%d", i))
+		builder.Field(8).(*array.FixedSizeBinaryBuilder).Append([]byte{byte(i), byte(i + 1)})
+		vectorBuilder := builder.Field(9).(*array.ListBuilder)
+		vectorBuilder.Append(true)
+		switch valueBuilder := vectorBuilder.ValueBuilder().(type) {
+		case *array.Float32Builder:
+			valueBuilder.AppendValues([]float32{float32(i), float32(i + 1), float32(i + 2), float32(i + 3)}, nil)
+		case *array.Float64Builder:
+			valueBuilder.AppendValues([]float64{float64(i), float64(i + 1), float64(i + 2), float64(i + 3)}, nil)
+		}
+	}
+
+	record := builder.NewRecord()
+	defer record.Release()
+
+	buf := &bytes.Buffer{}
+	writer, err := pqarrow.NewFileWriter(arrowSchema, buf,
+		parquet.NewWriterProperties(parquet.WithMaxRowGroupLength(rowGroupSize)), pqarrow.DefaultWriterProps())
+	assert.NoError(t, err)
+	assert.NoError(t, writer.Write(record))
+	assert.NoError(t, writer.Close())
+	return buf.Bytes()
+}
+
+func Test_NewParquetParser(t *testing.T) {
+	ctx := context.Background()
+
+	parser := NewParquetParser(ctx, nil, nil)
+	assert.Nil(t, parser)
+}
+
+func Test_ParquetParserParse(t *testing.T) {
+	ctx := context.Background()
+	schema := sampleSchema()
+
+	t.Run("list of float vectors", func(t *testing.T) {
+		content := createParquetData(t, sampleParquetSchema(arrow.ListOf(arrow.PrimitiveTypes.Float32)), 10, 4)
+
+		rowGroups := make([]map[storage.FieldID]storage.FieldData, 0)
+		flushFunc := func(fields map[storage.FieldID]storage.FieldData) error {
+			rowGroups = append(rowGroups, fields)
+			return nil
+		}
+		parser := NewParquetParser(ctx, schema, flushFunc)
+		err := parser.Parse(bytes.NewReader(content), false)
+		assert.NoError(t, err)
+
+		// 10 rows are written into 3 row groups
+		assert.Equal(t, 3, len(rowGroups))
+		assert.Equal(t, 4, rowGroups[0][106].RowNum())
+		assert.Equal(t, 2, rowGroups[2][106].RowNum())
+
+		lastGroup := rowGroups[2]
+		assert.Equal(t, []bool{true, false}, lastGroup[102].(*storage.BoolFieldData).Data)
+		assert.Equal(t, []int8{8, 9}, lastGroup[103].(*storage.Int8FieldData).Data)
+		assert.Equal(t, []int16{8, 9}, lastGroup[104].(*storage.Int16FieldData).Data)
+		assert.Equal(t, []int32{8, 9}, lastGroup[105].(*storage.Int32FieldData).Data)
+		assert.Equal(t, []int64{8, 9}, lastGroup[106].(*storage.Int64FieldData).Data)
+		assert.Equal(t, []float32{8, 9}, lastGroup[107].(*storage.FloatFieldData).Data)
+		assert.Equal(t, []float64{8, 9}, lastGroup[108].(*storage.DoubleFieldData).Data)
+		assert.Equal(t, []string{"str_8", "str_9"}, lastGroup[109].(*storage.StringFieldData).Data)
+		assert.Equal(t, []byte{8, 9, 9, 10}, lastGroup[110].(*storage.BinaryVectorFieldData).Data)
+		assert.Equal(t, 16, lastGroup[110].(*storage.BinaryVectorFieldData).Dim)
+		assert.Equal(t, []float32{8, 9, 10, 11, 9, 10, 11, 12}, lastGroup[111].(*storage.FloatVectorFieldData).Data)
+		assert.Equal(t, 4, lastGroup[111].(*storage.FloatVectorFieldData).Dim)
+	})
+
+	t.Run("list of double vectors", func(t *testing.T) {
+		content := createParquetData(t, sampleParquetSchema(arrow.ListOf(arrow.PrimitiveTypes.Float64)), 3, 10)
+
+		var fields map[storage.FieldID]storage.FieldData
+		flushFunc := func(data map[storage.FieldID]storage.FieldData) error {
+			fields = data
+			return nil
+		}
+		parser := NewParquetParser(ctx, schema, flushFunc)
+		err := parser.Parse(bytes.NewReader(content), false)
+		assert.NoError(t, err)
+		assert.Equal(t, []float32{0, 1, 2, 3, 1, 2, 3, 4, 2, 3, 4, 5}, fields[111].(*storage.FloatVectorFieldData).Data)
+	})
+
+	t.Run("columns in different order", func(t *testing.T) {
+		content := createParquetData(t, sampleParquetSchema(arrow.ListOf(arrow.PrimitiveTypes.Float32)), 3, 10)
+		// move the float vector column, which is a nested column, to the front
+		table, err := pqarrow.ReadTable(ctx, bytes.NewReader(content), nil, pqarrow.ArrowReadProperties{}, memory.DefaultAllocator)
+		assert.NoError(t, err)
+		defer table.Release()
+		order := []int{9, 8, 7, 6, 5, 4, 3, 2, 1, 0}
+		fields := make([]arrow.Field, 0, len(order))
+		columns := make([]array.Column, 0, len(order))
+		for _, i := range order {
+			fields = append(fields, table.Schema().Field(i))
+			columns = append(columns, *table.Column(i))
+		}
+		reordered := array.NewTable(arrow.NewSchema(fields, nil), columns, table.NumRows())
+		defer reordered.Release()
+		buf := &bytes.Buffer{}
+		err = pqarrow.WriteTable(reordered, buf, 10, nil, pqarrow.DefaultWriterProps())
+		assert.NoError(t, err)
+
+		var data map[storage.FieldID]storage.FieldData
+		flushFunc := func(fields map[storage.FieldID]storage.FieldData) error {
+			data = fields
+			return nil
+		}
+		parser := NewParquetParser(ctx, schema, flushFunc)
+		err = parser.Parse(bytes.NewReader(buf.Bytes()), false)
+		assert.NoError(t, err)
+		assert.Equal(t, []bool{true, false, true}, data[102].(*storage.BoolFieldData).Data)
+		assert.Equal(t, []int64{0, 1, 2}, data[106].(*storage.Int64FieldData).Data)
+		assert.Equal(t, []string{"str_0", "str_1", "str_2"}, data[109].(*storage.StringFieldData).Data)
+		assert.Equal(t, []byte{0, 1, 1, 2, 2, 3}, data[110].(*storage.BinaryVectorFieldData).Data)
+		assert.Equal(t, []float32{0, 1, 2, 3, 1, 2, 3, 4, 2, 3, 4, 5}, data[111].(*storage.FloatVectorFieldData).Data)
+	})
+
+	t.Run("only validate", func(t *testing.T) {
+		content := createParquetData(t, sampleParquetSchema(arrow.ListOf(arrow.PrimitiveTypes.Float32)), 3, 10)
+
+		flushFunc := func(fields map[storage.FieldID]storage.FieldData) error {
+			return fmt.Errorf("should not be called")
+		}
+		parser := NewParquetParser(ctx, schema, flushFunc)
+		err := parser.Parse(bytes.NewReader(content), true)
+		assert.NoError(t, err)
+	})
+
+	t.Run("flush failed", func(t *testing.T) {
+		content := createParquetData(t, sampleParquetSchema(arrow.ListOf(arrow.PrimitiveTypes.Float32)), 3, 10)
+
+		flushFunc := func(fields map[storage.FieldID]storage.FieldData) error {
+			return fmt.Errorf("error")
+		}
+		parser := NewParquetParser(ctx, schema, flushFunc)
+		err := parser.Parse(bytes.NewReader(content), false)
+		assert.Error(t, err)
+	})
+
+	t.Run("illegal file", func(t *testing.T) {
+		parser := NewParquetParser(ctx, schema, func(fields map[storage.FieldID]storage.FieldData) error {
+			return nil
+		})
+		err := parser.Parse(nil, false)
+		assert.Error(t, err)
+
+		err = parser.Parse(bytes.NewReader([]byte("dummy")), false)
+		assert.Error(t, err)
+	})
+}
+
+func Test_ParquetParserValidate(t *testing.T) {
+	ctx := context.Background()
+	flushFunc := func(fields map[storage.FieldID]storage.FieldData) error {
+		return nil
+	}
+	vectorType := arrow.FixedSizeListOf(4, arrow.PrimitiveTypes.Float32)
+
+	t.Run("valid schema", func(t *testing.T) {
+		parser := NewParquetParser(ctx, sampleSchema(), flushFunc)
+		err := parser.validate(sampleParquetSchema(vectorType))
+		assert.NoError(t, err)
+		assert.Equal(t, 10, len(parser.columnDescs))
+	})
+
+	t.Run("missed column", func(t *testing.T) {
+		arrowSchema := sampleParquetSchema(vectorType)
+		parser := NewParquetParser(ctx, sampleSchema(), flushFunc)
+		err := parser.validate(arrow.NewSchema(arrowSchema.Fields()[1:], nil))
+		assert.Error(t, err)
+	})
+
+	t.Run("redundant column", func(t *testing.T) {
+		fields := append(sampleParquetSchema(vectorType).Fields(), arrow.Field{Name: "dummy", Type: arrow.PrimitiveTypes.Int64})
+		parser := NewParquetParser(ctx, sampleSchema(), flushFunc)
+		err := parser.validate(arrow.NewSchema(fields, nil))
+		assert.Error(t, err)
+	})
+
+	t.Run("auto-generated primary key is provided", func(t *testing.T) {
+		schema := sampleSchema()
+		for _, field := range schema.Fields {
+			if field.GetIsPrimaryKey() {
+				field.AutoID = true
+			}
+		}
+		parser := NewParquetParser(ctx, schema, flushFunc)
+		err := parser.validate(sampleParquetSchema(vectorType))
+		assert.Error(t, err)
+	})
+
+	t.Run("illegal column type", func(t *testing.T) {
+		illegalTypes := []struct {
+			description string
+			field       *schemapb.FieldSchema
+			dataType    arrow.DataType
+		}{
+			{"int64 for int32", &schemapb.FieldSchema{Name: "a", DataType: schemapb.DataType_Int32}, arrow.PrimitiveTypes.Int64},
+			{"double for float", &schemapb.FieldSchema{Name: "a", DataType: schemapb.DataType_Float}, arrow.PrimitiveTypes.Float64},
+			{"int64 for varchar", &schemapb.FieldSchema{Name: "a", DataType: schemapb.DataType_VarChar}, arrow.PrimitiveTypes.Int64},
+			{"dimension mis-match of binary vector", sampleSchema().Fields[8], &arrow.FixedSizeBinaryType{ByteWidth: 4}},
+			{"dimension mis-match of float vector", sampleSchema().Fields[9], arrow.FixedSizeListOf(8, arrow.PrimitiveTypes.Float32)},
+			{"int list for float vector", sampleSchema().Fields[9], arrow.ListOf(arrow.PrimitiveTypes.Int32)},
+			{"unsupported data type", &schemapb.FieldSchema{Name: "a", DataType: schemapb.DataType_None}, arrow.PrimitiveTypes.Int64},
+		}
+		for _, test := range illegalTypes {
+			t.Run(test.description, func(t *testing.T) {
+				parser := NewParquetParser(ctx, &schemapb.CollectionSchema{Fields: []*schemapb.FieldSchema{test.field}}, flushFunc)
+				err := parser.validate(arrow.NewSchema([]arrow.Field{{Name: test.field.GetName(), Type: test.dataType}}, nil))
+				assert.Error(t, err)
+			})
+		}
+	})
+}
+
+func Test_ParquetParserConsume(t *testing.T) {
+	ctx := context.Background()
+	flushFunc := func(fields map[storage.FieldID]storage.FieldData) error {
+		return nil
+	}
+	parser := NewParquetParser(ctx, sampleSchema(), flushFunc)
+
+	t.Run("fixed size list of float vectors", func(t *testing.T) {
+		builder := array.NewFixedSizeListBuilder(memory.DefaultAllocator, 4, arrow.PrimitiveTypes.Float32)
+		defer builder.Release()
+		for i := 0; i < 3; i++ {
+			builder.Append(true)
+			builder.ValueBuilder().(*array.Float32Builder).AppendValues(
+				[]float32{float32(i), float32(i + 1), float32(i + 2), float32(i + 3)}, nil)
+		}
+		arr := builder.NewArray()
+		defer arr.Release()
+		sliced := array.NewSlice(arr, 1, 3)
+		defer sliced.Release()
+
+		desc := &ParquetColumnDesc{schema: sampleSchema().Fields[9], dimension: 4}
+		data, err := parser.consume(desc, arrow.NewChunked(arr.DataType(), []arrow.Array{arr, sliced}))
+		assert.NoError(t, err)
+		assert.Equal(t, 5, data.RowNum())
+		assert.Equal(t, []float32{1, 2, 3, 4, 2, 3, 4, 5}, data.(*storage.FloatVectorFieldData).Data[12:])
+	})
+
+	t.Run("dimension mis-match of float vector", func(t *testing.T) {
+		builder := array.NewListBuilder(memory.DefaultAllocator, arrow.PrimitiveTypes.Float32)
+		defer builder.Release()
+		builder.Append(true)
+		builder.ValueBuilder().(*array.Float32Builder).AppendValues([]float32{1, 2, 3}, nil)
+		arr := builder.NewArray()
+		defer arr.Release()
+
+		desc := &ParquetColumnDesc{schema: sampleSchema().Fields[9], dimension: 4}
+		_, err := parser.consume(desc, arrow.NewChunked(arr.DataType(), []arrow.Array{arr}))
+		assert.Error(t, err)
+	})
+
+	t.Run("null value", func(t *testing.T) {
+		builder := array.NewInt64Builder(memory.DefaultAllocator)
+		defer builder.Release()
+		builder.Append(1)
+		builder.AppendNull()
+		arr := builder.NewArray()
+		defer arr.Release()
+
+		desc := &ParquetColumnDesc{schema: sampleSchema().Fields[4]}
+		_, err := parser.consume(desc, arrow.NewChunked(arr.DataType(), []arrow.Array{arr}))
+		assert.Error(t, err)
+	})
+
+	t.Run("illegal json", func(t *testing.T) {
+		builder := array.NewStringBuilder(memory.DefaultAllocator)
+		defer builder.Release()
+		builder.Append(`{"a": 1}`)
+		builder.Append("dummy")
+		arr := builder.NewArray()
+		defer arr.Release()
+
+		desc := &ParquetColumnDesc{schema: &schemapb.FieldSchema{Name: "json", DataType: typeutil.DataTypeJSON}}
+		_, err := parser.consume(desc, arrow.NewChunked(arr.DataType(), []arrow.Array{arr}))
+		assert.Error(t, err)
+	})
+}
@@ -180,8 +180,26 @@ type RootCoordConfiguration struct {
 	MinSegmentSizeToEnableIndex int64 `json:"min_segment_size_to_enable_index"`
 }
 
+// RocksmqGroupMetrics records the consume position and lag of a rocksmq consumer group.
+type RocksmqGroupMetrics struct {
+	GroupName string `json:"group_name"`
+	CurrentID int64  `json:"current_id"`
+	Lag       int64  `json:"lag"`
+}
+
+// RocksmqTopicMetrics records the stats of a rocksmq topic.
+type RocksmqTopicMetrics struct {
+	Topic      string                `json:"topic"`
+	FirstMsgID int64                 `json:"first_msg_id"`
+	LastMsgID  int64                 `json:"last_msg_id"`
+	Size       int64                 `json:"size"`
+	Groups     []RocksmqGroupMetrics `json:"groups"`
+}
+
 // RootCoordInfos implements ComponentInfos
 type RootCoordInfos struct {
 	BaseComponentInfos
 	SystemConfigurations RootCoordConfiguration `json:"system_configurations"`
+	// RocksmqMetrics is only reported in standalone mode with rocksmq
+	RocksmqMetrics []RocksmqTopicMetrics `json:"rocksmq_metrics,omitempty"`
 }
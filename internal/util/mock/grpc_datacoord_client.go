@@ -141,6 +141,14 @@ func (m *GrpcDataCoordClient) Import(ctx context.Context, req *datapb.ImportTask
 	return &datapb.ImportTaskResponse{}, m.Err
 }
 
+func (m *GrpcDataCoordClient) Export(ctx context.Context, req *datapb.ExportTaskRequest, opts ...grpc.CallOption) (*datapb.ExportTaskResponse, error) {
+	return &datapb.ExportTaskResponse{}, m.Err
+}
+
+func (m *GrpcDataCoordClient) RestoreSegments(ctx context.Context, req *datapb.RestoreSegmentsRequest, opts ...grpc.CallOption) (*datapb.RestoreSegmentsResponse, error) {
+	return &datapb.RestoreSegmentsResponse{}, m.Err
+}
+
 func (m *GrpcDataCoordClient) UpdateSegmentStatistics(ctx context.Context, req *datapb.UpdateSegmentStatisticsRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
 	return &commonpb.Status{}, m.Err
 }
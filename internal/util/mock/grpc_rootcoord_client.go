@@ -201,6 +201,30 @@ func (m *GrpcRootCoordClient) ReportImport(ctx context.Context, req *rootcoordpb
 	return &commonpb.Status{}, m.Err
 }
 
+func (m *GrpcRootCoordClient) Export(ctx context.Context, req *rootcoordpb.ExportRequest, opts ...grpc.CallOption) (*rootcoordpb.ExportResponse, error) {
+	return &rootcoordpb.ExportResponse{}, m.Err
+}
+
+func (m *GrpcRootCoordClient) GetExportState(ctx context.Context, req *rootcoordpb.GetExportStateRequest, opts ...grpc.CallOption) (*rootcoordpb.GetExportStateResponse, error) {
+	return &rootcoordpb.GetExportStateResponse{}, m.Err
+}
+
+func (m *GrpcRootCoordClient) ListExportTasks(ctx context.Context, req *rootcoordpb.ListExportTasksRequest, opts ...grpc.CallOption) (*rootcoordpb.ListExportTasksResponse, error) {
+	return &rootcoordpb.ListExportTasksResponse{}, m.Err
+}
+
+func (m *GrpcRootCoordClient) ReportExport(ctx context.Context, req *rootcoordpb.ExportResult, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	return &commonpb.Status{}, m.Err
+}
+
+func (m *GrpcRootCoordClient) RestoreCollection(ctx context.Context, req *rootcoordpb.RestoreCollectionRequest, opts ...grpc.CallOption) (*rootcoordpb.RestoreCollectionResponse, error) {
+	return &rootcoordpb.RestoreCollectionResponse{}, m.Err
+}
+
+func (m *GrpcRootCoordClient) GetRestoreState(ctx context.Context, req *rootcoordpb.GetRestoreStateRequest, opts ...grpc.CallOption) (*rootcoordpb.GetRestoreStateResponse, error) {
+	return &rootcoordpb.GetRestoreStateResponse{}, m.Err
+}
+
 func (m *GrpcRootCoordClient) CreateCredential(ctx context.Context, in *internalpb.CredentialInfo, opts ...grpc.CallOption) (*commonpb.Status, error) {
 	return &commonpb.Status{}, m.Err
 }
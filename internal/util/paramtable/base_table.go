@@ -241,6 +241,31 @@ func (gp *BaseTable) GetConfigSubSet(pattern string) map[string]string {
 	return gp.mgr.GetConfigsByPattern(pattern, false)
 }
 
+// Watch calls watcher when the config of key changes, the returned func cancels the watch
+func (gp *BaseTable) Watch(key string, watcher config.Watcher) func() {
+	return gp.mgr.Watch(key, watcher)
+}
+
+// WatchKeyPrefix calls watcher when a config starting with prefix changes, the returned func cancels the watch
+func (gp *BaseTable) WatchKeyPrefix(prefix string, watcher config.Watcher) func() {
+	return gp.mgr.WatchPrefix(prefix, watcher)
+}
+
+// ConfigsWithSource returns the effective configs with the sources they come from
+func (gp *BaseTable) ConfigsWithSource() []config.ConfigWithSource {
+	return gp.mgr.ConfigsWithSource()
+}
+
+// SaveToEtcd writes the config to the etcd source, so that all nodes apply it
+func (gp *BaseTable) SaveToEtcd(key, value string) error {
+	return gp.mgr.SetSourceConfig(config.EtcdSourceName, key, value)
+}
+
+// RemoveFromEtcd removes the config from the etcd source
+func (gp *BaseTable) RemoveFromEtcd(key string) error {
+	return gp.mgr.DeleteSourceConfig(config.EtcdSourceName, key)
+}
+
 // For compatible reason, only visiable for Test
 func (gp *BaseTable) Remove(key string) error {
 	gp.mgr.DeleteConfig(key)
@@ -54,7 +54,7 @@ type ComponentParam struct {
 	once sync.Once
 
 	CommonCfg       commonConfig
-	QuotaConfig     quotaConfig
+	QuotaConfig     refreshableQuotaConfig
 	AutoIndexConfig autoIndexConfig
 
 	RootCoordCfg  rootCoordConfig
@@ -92,6 +92,8 @@ func (p *ComponentParam) Init() {
 	p.IndexCoordCfg.init(&p.BaseTable)
 	p.IndexNodeCfg.init(&p.BaseTable)
 	p.HookCfg.init()
+
+	p.watchRefreshableParams()
 }
 
 func (p *ComponentParam) RocksmqEnable() bool {
@@ -106,6 +108,14 @@ func (p *ComponentParam) KafkaEnable() bool {
 	return p.KafkaCfg.Address != ""
 }
 
+func (p *ComponentParam) NatsEnable() bool {
+	return p.NatsCfg.Address != ""
+}
+
+func (p *ComponentParam) MemmqEnable() bool {
+	return p.MemmqCfg.Enable
+}
+
 // /////////////////////////////////////////////////////////////////////////////
 // --- common ---
 type commonConfig struct {
@@ -148,8 +158,13 @@ type commonConfig struct {
 	BeamWidthRatio           float64
 	GracefulTime             int64
 
-	StorageType string
-	SimdType    string
+	StorageType                     string
+	StorageCompression              string
+	StorageEncryptionKeyFile        string
+	StorageEncryptionChunkSize      int64
+	StorageEncryptionRewrapInterval time.Duration
+	StorageEncryptionRequired       bool
+	SimdType                        string
 
 	AuthorizationEnabled bool
 
@@ -157,6 +172,18 @@ type commonConfig struct {
 
 	SessionTTL        int64
 	SessionRetryTimes int64
+
+	DeadLetterPolicy   string
+	DeadLetterTopic    string
+	DeadLetterFilePath string
+
+	ProduceBatchEnable      bool
+	ProduceBatchMaxSize     int64
+	ProduceBatchMaxNum      int64
+	ProduceBatchCompression string
+
+	ConfigUpdateEnabled bool
+	ConfigUpdateToken   string
 }
 
 func (p *commonConfig) init(base *BaseTable) {
@@ -199,6 +226,8 @@ func (p *commonConfig) init(base *BaseTable) {
 	p.initBeamWidthRatio()
 	p.initGracefulTime()
 	p.initStorageType()
+	p.initStorageCompression()
+	p.initStorageEncryption()
 	p.initThreadCoreCoefficient()
 
 	p.initEnableAuthorization()
@@ -207,6 +236,10 @@ func (p *commonConfig) init(base *BaseTable) {
 
 	p.initSessionTTL()
 	p.initSessionRetryTimes()
+
+	p.initDeadLetter()
+	p.initProduceBatch()
+	p.initConfigUpdate()
 }
 
 func (p *commonConfig) initClusterPrefix() {
@@ -438,6 +471,17 @@ func (p *commonConfig) initStorageType() {
 	p.StorageType = p.Base.LoadWithDefault("common.storageType", "minio")
 }
 
+func (p *commonConfig) initStorageCompression() {
+	p.StorageCompression = p.Base.LoadWithDefault("common.storageCompression", "")
+}
+
+func (p *commonConfig) initStorageEncryption() {
+	p.StorageEncryptionKeyFile = p.Base.LoadWithDefault("common.storageEncryption.keyFile", "")
+	p.StorageEncryptionChunkSize = p.Base.ParseInt64WithDefault("common.storageEncryption.chunkSize", 64*1024)
+	p.StorageEncryptionRewrapInterval = time.Duration(p.Base.ParseInt64WithDefault("common.storageEncryption.rewrapInterval", 60*60)) * time.Second
+	p.StorageEncryptionRequired = p.Base.ParseBool("common.storageEncryption.required", false)
+}
+
 func (p *commonConfig) initEnableAuthorization() {
 	p.AuthorizationEnabled = p.Base.ParseBool("common.security.authorizationEnabled", false)
 }
@@ -454,6 +498,29 @@ func (p *commonConfig) initSessionRetryTimes() {
 	p.SessionRetryTimes = p.Base.ParseInt64WithDefault("common.session.retryTimes", 30)
 }
 
+func (p *commonConfig) initDeadLetter() {
+	p.DeadLetterPolicy = p.Base.LoadWithDefault("common.deadLetter.policy", "drop")
+	p.DeadLetterTopic = p.Base.LoadWithDefault("common.deadLetter.topic", p.ClusterPrefix+"-dead-letter")
+	p.DeadLetterFilePath = p.Base.LoadWithDefault("common.deadLetter.filePath", "/var/lib/milvus/dead_letter/dead_letter.jsonl")
+}
+
+func (p *commonConfig) initConfigUpdate() {
+	p.ConfigUpdateEnabled = p.Base.ParseBool("common.security.configUpdate.enabled", false)
+	p.ConfigUpdateToken = p.Base.LoadWithDefault("common.security.configUpdate.token", "")
+	// updating configs by http without authentication is refused
+	if p.ConfigUpdateEnabled && p.ConfigUpdateToken == "" {
+		log.Warn("config update is disabled since common.security.configUpdate.token is empty")
+		p.ConfigUpdateEnabled = false
+	}
+}
+
+func (p *commonConfig) initProduceBatch() {
+	p.ProduceBatchEnable = p.Base.ParseBool("common.produceBatch.enable", false)
+	p.ProduceBatchMaxSize = p.Base.ParseInt64WithDefault("common.produceBatch.maxSize", 1024*1024)
+	p.ProduceBatchMaxNum = p.Base.ParseInt64WithDefault("common.produceBatch.maxNum", 1024)
+	p.ProduceBatchCompression = p.Base.LoadWithDefault("common.produceBatch.compression", "")
+}
+
 // /////////////////////////////////////////////////////////////////////////////
 // --- rootcoord ---
 type rootCoordConfig struct {
@@ -464,9 +531,14 @@ type rootCoordConfig struct {
 	MinSegmentSizeToEnableIndex int64
 	ImportTaskExpiration        float64
 	ImportTaskRetention         float64
+	ExportTaskExpiration        float64
+	ExportTaskRetention         float64
+	RestoreTaskRetention        float64
 
 	// --- ETCD Path ---
-	ImportTaskSubPath string
+	ImportTaskSubPath  string
+	ExportTaskSubPath  string
+	RestoreTaskSubPath string
 
 	CreatedTime time.Time
 	UpdatedTime time.Time
@@ -482,6 +554,11 @@ func (p *rootCoordConfig) init(base *BaseTable) {
 	p.ImportTaskExpiration = p.Base.ParseFloatWithDefault("rootCoord.importTaskExpiration", 15*60)
 	p.ImportTaskRetention = p.Base.ParseFloatWithDefault("rootCoord.importTaskRetention", 24*60*60)
 	p.ImportTaskSubPath = "importtask"
+	p.ExportTaskExpiration = p.Base.ParseFloatWithDefault("rootCoord.exportTaskExpiration", 60*60)
+	p.ExportTaskRetention = p.Base.ParseFloatWithDefault("rootCoord.exportTaskRetention", 24*60*60)
+	p.ExportTaskSubPath = "exporttask"
+	p.RestoreTaskRetention = p.Base.ParseFloatWithDefault("rootCoord.restoreTaskRetention", 24*60*60)
+	p.RestoreTaskSubPath = "restoretask"
 	p.EnableActiveStandby = p.Base.ParseBool("rootCoord.enableActiveStandby", false)
 }
 
@@ -519,6 +596,7 @@ type proxyConfig struct {
 	MinPasswordLength        int64
 	MaxPasswordLength        int64
 	MaxFieldNum              int64
+	MaxVectorFieldNum        int64
 	MaxShardNum              int32
 	MaxDimension             int64
 	GinLogging               bool
@@ -546,6 +624,7 @@ func (p *proxyConfig) init(base *BaseTable) {
 	p.initMaxUsernameLength()
 	p.initMaxPasswordLength()
 	p.initMaxFieldNum()
+	p.initMaxVectorFieldNum()
 	p.initMaxShardNum()
 	p.initMaxDimension()
 
@@ -630,6 +709,15 @@ func (p *proxyConfig) initMaxFieldNum() {
 	p.MaxFieldNum = maxFieldNum
 }
 
+func (p *proxyConfig) initMaxVectorFieldNum() {
+	str := p.Base.LoadWithDefault("proxy.maxVectorFieldNum", "4")
+	maxVectorFieldNum, err := strconv.ParseInt(str, 10, 64)
+	if err != nil {
+		panic(err)
+	}
+	p.MaxVectorFieldNum = maxVectorFieldNum
+}
+
 func (p *proxyConfig) initMaxDimension() {
 	str := p.Base.LoadWithDefault("proxy.maxDimension", "32768")
 	maxDimension, err := strconv.ParseInt(str, 10, 64)
@@ -913,6 +1001,9 @@ type queryNodeConfig struct {
 	DiskCapacityLimit      int64
 	MaxDiskUsagePercentage float64
 
+	// bytes of remote objects cached on local disk, 0 means disabled
+	DiskCacheCapacity int64
+
 	// cache limit
 	CacheEnabled     bool
 	CacheMemoryLimit int64
@@ -920,7 +1011,7 @@ type queryNodeConfig struct {
 	GroupEnabled         bool
 	MaxReceiveChanSize   int32
 	MaxUnsolvedQueueSize int32
-	MaxReadConcurrency   int32
+	MaxReadConcurrency   atomic.Value
 	MaxGroupNQ           int64
 	TopKMergeRatio       float64
 	CPURatio             float64
@@ -956,6 +1047,7 @@ func (p *queryNodeConfig) init(base *BaseTable) {
 	p.initEnableDisk()
 	p.initDiskCapacity()
 	p.initMaxDiskUsagePercentage()
+	p.initDiskCacheCapacity()
 
 	p.initGCTunerEnbaled()
 	p.initMaximumGOGC()
@@ -1067,12 +1159,21 @@ func (p *queryNodeConfig) initCPURatio() {
 func (p *queryNodeConfig) initMaxReadConcurrency() {
 	readConcurrencyRatio := p.Base.ParseFloatWithDefault("queryNode.scheduler.maxReadConcurrentRatio", 2.0)
 	cpuNum := int32(runtime.GOMAXPROCS(0))
-	p.MaxReadConcurrency = int32(float64(cpuNum) * readConcurrencyRatio)
-	if p.MaxReadConcurrency < 1 {
-		p.MaxReadConcurrency = 1 // MaxReadConcurrency must >= 1
-	} else if p.MaxReadConcurrency > cpuNum*100 {
-		p.MaxReadConcurrency = cpuNum * 100 // MaxReadConcurrency must <= 100*cpuNum
+	maxReadConcurrency := int32(float64(cpuNum) * readConcurrencyRatio)
+	if maxReadConcurrency < 1 {
+		maxReadConcurrency = 1 // MaxReadConcurrency must >= 1
+	} else if maxReadConcurrency > cpuNum*100 {
+		maxReadConcurrency = cpuNum * 100 // MaxReadConcurrency must <= 100*cpuNum
+	}
+	p.MaxReadConcurrency.Store(maxReadConcurrency)
+}
+
+// GetMaxReadConcurrency returns MaxReadConcurrency, which is refreshed live.
+func (p *queryNodeConfig) GetMaxReadConcurrency() int32 {
+	if v := p.MaxReadConcurrency.Load(); v != nil {
+		return v.(int32)
 	}
+	return 0
 }
 
 func (p *queryNodeConfig) initMaxGroupNQ() {
@@ -1120,6 +1221,10 @@ func (p *queryNodeConfig) initDiskCapacity() {
 	p.DiskCapacityLimit = diskSize * 1024 * 1024 * 1024
 }
 
+func (p *queryNodeConfig) initDiskCacheCapacity() {
+	p.DiskCacheCapacity = p.Base.ParseInt64WithDefault("queryNode.diskCache.capacity", 0)
+}
+
 func (p *queryNodeConfig) initGCTunerEnbaled() {
 	p.GCHelperEnabled = p.Base.ParseBool("queryNode.gchelper.enabled", true)
 }
@@ -1156,22 +1261,23 @@ type dataCoordConfig struct {
 	EnableCompaction     bool
 	EnableAutoCompaction atomic.Value
 
-	MinSegmentToMerge                 int
-	MaxSegmentToMerge                 int
-	SegmentSmallProportion            float64
-	CompactionTimeoutInSeconds        int32
+	MinSegmentToMerge                 atomic.Value
+	MaxSegmentToMerge                 atomic.Value
+	SegmentSmallProportion            atomic.Value
+	CompactionTimeoutInSeconds        atomic.Value
 	CompactionCheckIntervalInSeconds  int64
-	SingleCompactionRatioThreshold    float32
-	SingleCompactionDeltaLogMaxSize   int64
-	SingleCompactionExpiredLogMaxSize int64
-	SingleCompactionBinlogMaxNum      int64
+	SingleCompactionRatioThreshold    atomic.Value
+	SingleCompactionDeltaLogMaxSize   atomic.Value
+	SingleCompactionExpiredLogMaxSize atomic.Value
+	SingleCompactionBinlogMaxNum      atomic.Value
 	GlobalCompactionInterval          time.Duration
 
 	// Garbage Collection
 	EnableGarbageCollection bool
-	GCInterval              time.Duration
-	GCMissingTolerance      time.Duration
-	GCDropTolerance         time.Duration
+	GCInterval              atomic.Value
+	GCMissingTolerance      atomic.Value
+	GCDropTolerance         atomic.Value
+	GCRestoreWindow         atomic.Value
 	EnableActiveStandby     bool
 }
 
@@ -1205,6 +1311,7 @@ func (p *dataCoordConfig) init(base *BaseTable) {
 	p.initGCInterval()
 	p.initGCMissingTolerance()
 	p.initGCDropTolerance()
+	p.initGCRestoreWindow()
 	p.initEnableActiveStandby()
 }
 
@@ -1253,20 +1360,20 @@ func (p *dataCoordConfig) initEnableAutoCompaction() {
 }
 
 func (p *dataCoordConfig) initCompactionMinSegment() {
-	p.MinSegmentToMerge = p.Base.ParseIntWithDefault("dataCoord.compaction.min.segment", 4)
+	p.MinSegmentToMerge.Store(p.Base.ParseIntWithDefault("dataCoord.compaction.min.segment", 4))
 }
 
 func (p *dataCoordConfig) initCompactionMaxSegment() {
-	p.MaxSegmentToMerge = p.Base.ParseIntWithDefault("dataCoord.compaction.max.segment", 30)
+	p.MaxSegmentToMerge.Store(p.Base.ParseIntWithDefault("dataCoord.compaction.max.segment", 30))
 }
 
 func (p *dataCoordConfig) initSegmentSmallProportion() {
-	p.SegmentSmallProportion = p.Base.ParseFloatWithDefault("dataCoord.segment.smallProportion", 0.5)
+	p.SegmentSmallProportion.Store(p.Base.ParseFloatWithDefault("dataCoord.segment.smallProportion", 0.5))
 }
 
 // compaction execution timeout
 func (p *dataCoordConfig) initCompactionTimeoutInSeconds() {
-	p.CompactionTimeoutInSeconds = p.Base.ParseInt32WithDefault("dataCoord.compaction.timeout", 60*3)
+	p.CompactionTimeoutInSeconds.Store(p.Base.ParseInt32WithDefault("dataCoord.compaction.timeout", 60*3))
 }
 
 func (p *dataCoordConfig) initCompactionCheckIntervalInSeconds() {
@@ -1275,22 +1382,22 @@ func (p *dataCoordConfig) initCompactionCheckIntervalInSeconds() {
 
 // if total delete entities is large than a ratio of total entities, trigger single compaction.
 func (p *dataCoordConfig) initSingleCompactionRatioThreshold() {
-	p.SingleCompactionRatioThreshold = float32(p.Base.ParseFloatWithDefault("dataCoord.compaction.single.ratio.threshold", 0.2))
+	p.SingleCompactionRatioThreshold.Store(float32(p.Base.ParseFloatWithDefault("dataCoord.compaction.single.ratio.threshold", 0.2)))
 }
 
 // if total delta file size > SingleCompactionDeltaLogMaxSize, trigger single compaction
 func (p *dataCoordConfig) initSingleCompactionDeltaLogMaxSize() {
-	p.SingleCompactionDeltaLogMaxSize = p.Base.ParseInt64WithDefault("dataCoord.compaction.single.deltalog.maxsize", 2*1024*1024)
+	p.SingleCompactionDeltaLogMaxSize.Store(p.Base.ParseInt64WithDefault("dataCoord.compaction.single.deltalog.maxsize", 2*1024*1024))
 }
 
 // if total expired file size > SingleCompactionExpiredLogMaxSize, trigger single compaction
 func (p *dataCoordConfig) initSingleCompactionExpiredLogMaxSize() {
-	p.SingleCompactionExpiredLogMaxSize = p.Base.ParseInt64WithDefault("dataCoord.compaction.single.expiredlog.maxsize", 10*1024*1024)
+	p.SingleCompactionExpiredLogMaxSize.Store(p.Base.ParseInt64WithDefault("dataCoord.compaction.single.expiredlog.maxsize", 10*1024*1024))
 }
 
 // if total binlog number > SingleCompactionBinlogMaxNum, trigger single compaction to ensure binlog number per segment is limited
 func (p *dataCoordConfig) initSingleCompactionBinlogMaxNum() {
-	p.SingleCompactionBinlogMaxNum = p.Base.ParseInt64WithDefault("dataCoord.compaction.single.binlog.maxnum", 1000)
+	p.SingleCompactionBinlogMaxNum.Store(p.Base.ParseInt64WithDefault("dataCoord.compaction.single.binlog.maxnum", 1000))
 }
 
 // interval we check and trigger global compaction
@@ -1304,15 +1411,19 @@ func (p *dataCoordConfig) initEnableGarbageCollection() {
 }
 
 func (p *dataCoordConfig) initGCInterval() {
-	p.GCInterval = time.Duration(p.Base.ParseInt64WithDefault("dataCoord.gc.interval", 60*60)) * time.Second
+	p.GCInterval.Store(time.Duration(p.Base.ParseInt64WithDefault("dataCoord.gc.interval", 60*60)) * time.Second)
 }
 
 func (p *dataCoordConfig) initGCMissingTolerance() {
-	p.GCMissingTolerance = time.Duration(p.Base.ParseInt64WithDefault("dataCoord.gc.missingTolerance", 24*60*60)) * time.Second
+	p.GCMissingTolerance.Store(time.Duration(p.Base.ParseInt64WithDefault("dataCoord.gc.missingTolerance", 24*60*60)) * time.Second)
 }
 
 func (p *dataCoordConfig) initGCDropTolerance() {
-	p.GCDropTolerance = time.Duration(p.Base.ParseInt64WithDefault("dataCoord.gc.dropTolerance", 24*60*60)) * time.Second
+	p.GCDropTolerance.Store(time.Duration(p.Base.ParseInt64WithDefault("dataCoord.gc.dropTolerance", 24*60*60)) * time.Second)
+}
+
+func (p *dataCoordConfig) initGCRestoreWindow() {
+	p.GCRestoreWindow.Store(time.Duration(p.Base.ParseInt64WithDefault("dataCoord.gc.restoreWindow", 0)) * time.Second)
 }
 
 func (p *dataCoordConfig) SetEnableAutoCompaction(enable bool) {
@@ -1327,6 +1438,102 @@ func (p *dataCoordConfig) GetEnableAutoCompaction() bool {
 	return false
 }
 
+// GetMinSegmentToMerge returns MinSegmentToMerge, which is refreshed live.
+func (p *dataCoordConfig) GetMinSegmentToMerge() int {
+	if v := p.MinSegmentToMerge.Load(); v != nil {
+		return v.(int)
+	}
+	return 0
+}
+
+// GetMaxSegmentToMerge returns MaxSegmentToMerge, which is refreshed live.
+func (p *dataCoordConfig) GetMaxSegmentToMerge() int {
+	if v := p.MaxSegmentToMerge.Load(); v != nil {
+		return v.(int)
+	}
+	return 0
+}
+
+// GetSegmentSmallProportion returns SegmentSmallProportion, which is refreshed live.
+func (p *dataCoordConfig) GetSegmentSmallProportion() float64 {
+	if v := p.SegmentSmallProportion.Load(); v != nil {
+		return v.(float64)
+	}
+	return 0
+}
+
+// GetCompactionTimeoutInSeconds returns CompactionTimeoutInSeconds, which is refreshed live.
+func (p *dataCoordConfig) GetCompactionTimeoutInSeconds() int32 {
+	if v := p.CompactionTimeoutInSeconds.Load(); v != nil {
+		return v.(int32)
+	}
+	return 0
+}
+
+// GetSingleCompactionRatioThreshold returns SingleCompactionRatioThreshold, which is refreshed live.
+func (p *dataCoordConfig) GetSingleCompactionRatioThreshold() float32 {
+	if v := p.SingleCompactionRatioThreshold.Load(); v != nil {
+		return v.(float32)
+	}
+	return 0
+}
+
+// GetSingleCompactionDeltaLogMaxSize returns SingleCompactionDeltaLogMaxSize, which is refreshed live.
+func (p *dataCoordConfig) GetSingleCompactionDeltaLogMaxSize() int64 {
+	if v := p.SingleCompactionDeltaLogMaxSize.Load(); v != nil {
+		return v.(int64)
+	}
+	return 0
+}
+
+// GetSingleCompactionExpiredLogMaxSize returns SingleCompactionExpiredLogMaxSize, which is refreshed live.
+func (p *dataCoordConfig) GetSingleCompactionExpiredLogMaxSize() int64 {
+	if v := p.SingleCompactionExpiredLogMaxSize.Load(); v != nil {
+		return v.(int64)
+	}
+	return 0
+}
+
+// GetSingleCompactionBinlogMaxNum returns SingleCompactionBinlogMaxNum, which is refreshed live.
+func (p *dataCoordConfig) GetSingleCompactionBinlogMaxNum() int64 {
+	if v := p.SingleCompactionBinlogMaxNum.Load(); v != nil {
+		return v.(int64)
+	}
+	return 0
+}
+
+// GetGCInterval returns GCInterval, which is refreshed live.
+func (p *dataCoordConfig) GetGCInterval() time.Duration {
+	if v := p.GCInterval.Load(); v != nil {
+		return v.(time.Duration)
+	}
+	return 0
+}
+
+// GetGCMissingTolerance returns GCMissingTolerance, which is refreshed live.
+func (p *dataCoordConfig) GetGCMissingTolerance() time.Duration {
+	if v := p.GCMissingTolerance.Load(); v != nil {
+		return v.(time.Duration)
+	}
+	return 0
+}
+
+// GetGCDropTolerance returns GCDropTolerance, which is refreshed live.
+func (p *dataCoordConfig) GetGCDropTolerance() time.Duration {
+	if v := p.GCDropTolerance.Load(); v != nil {
+		return v.(time.Duration)
+	}
+	return 0
+}
+
+// GetGCRestoreWindow returns GCRestoreWindow, which is refreshed live.
+func (p *dataCoordConfig) GetGCRestoreWindow() time.Duration {
+	if v := p.GCRestoreWindow.Load(); v != nil {
+		return v.(time.Duration)
+	}
+	return 0
+}
+
 func (p *dataCoordConfig) initEnableActiveStandby() {
 	p.EnableActiveStandby = p.Base.ParseBool("dataCoord.enableActiveStandby", false)
 }
@@ -1485,6 +1692,9 @@ type indexNodeConfig struct {
 	EnableDisk             bool
 	DiskCapacityLimit      int64
 	MaxDiskUsagePercentage float64
+
+	// bytes of remote objects cached on local disk, 0 means disabled
+	DiskCacheCapacity int64
 }
 
 func (p *indexNodeConfig) init(base *BaseTable) {
@@ -1493,6 +1703,7 @@ func (p *indexNodeConfig) init(base *BaseTable) {
 	p.initEnableDisk()
 	p.initDiskCapacity()
 	p.initMaxDiskUsagePercentage()
+	p.initDiskCacheCapacity()
 }
 
 // InitAlias initializes an alias for the IndexNode role.
@@ -1532,6 +1743,10 @@ func (p *indexNodeConfig) initDiskCapacity() {
 	p.DiskCapacityLimit = diskSize * 1024 * 1024 * 1024
 }
 
+func (p *indexNodeConfig) initDiskCacheCapacity() {
+	p.DiskCacheCapacity = p.Base.ParseInt64WithDefault("indexNode.diskCache.capacity", 0)
+}
+
 func (p *indexNodeConfig) initMaxDiskUsagePercentage() {
 	maxDiskUsagePercentageStr := p.Base.LoadWithDefault("indexNode.maxDiskUsagePercentage", "95")
 	maxDiskUsagePercentage, err := strconv.ParseInt(maxDiskUsagePercentageStr, 10, 64)
@@ -119,6 +119,13 @@ func TestComponentParam(t *testing.T) {
 		t.Logf("default session TTL time = %d", Params.SessionTTL)
 		assert.Equal(t, Params.SessionRetryTimes, int64(DefaultSessionRetryTimes))
 		t.Logf("default session retry times = %d", Params.SessionRetryTimes)
+
+		assert.Equal(t, "drop", Params.DeadLetterPolicy)
+		assert.Equal(t, "by-dev-dead-letter", Params.DeadLetterTopic)
+
+		assert.False(t, Params.ProduceBatchEnable)
+		assert.False(t, Params.ConfigUpdateEnabled)
+		assert.Equal(t, int64(1024*1024), Params.ProduceBatchMaxSize)
 	})
 
 	t.Run("test rootCoordConfig", func(t *testing.T) {
@@ -130,6 +137,11 @@ func TestComponentParam(t *testing.T) {
 		t.Logf("master MinSegmentSizeToEnableIndex = %d", Params.MinSegmentSizeToEnableIndex)
 		assert.NotEqual(t, Params.ImportTaskExpiration, 0)
 		t.Logf("master ImportTaskRetention = %f", Params.ImportTaskRetention)
+		assert.Equal(t, 3600.0, Params.ExportTaskExpiration)
+		assert.Equal(t, 86400.0, Params.ExportTaskRetention)
+		assert.Equal(t, "exporttask", Params.ExportTaskSubPath)
+		assert.Equal(t, 86400.0, Params.RestoreTaskRetention)
+		assert.Equal(t, "restoretask", Params.RestoreTaskSubPath)
 		assert.Equal(t, Params.EnableActiveStandby, false)
 		t.Logf("rootCoord EnableActiveStandby = %t", Params.EnableActiveStandby)
 
@@ -150,6 +162,8 @@ func TestComponentParam(t *testing.T) {
 
 		t.Logf("MaxFieldNum: %d", Params.MaxFieldNum)
 
+		t.Logf("MaxVectorFieldNum: %d", Params.MaxVectorFieldNum)
+
 		t.Logf("MaxShardNum: %d", Params.MaxShardNum)
 
 		t.Logf("MaxDimension: %d", Params.MaxDimension)
@@ -203,6 +217,11 @@ func TestComponentParam(t *testing.T) {
 			Params.initMaxFieldNum()
 		})
 
+		shouldPanic(t, "proxy.maxVectorFieldNum", func() {
+			Params.Base.Save("proxy.maxVectorFieldNum", "abc")
+			Params.initMaxVectorFieldNum()
+		})
+
 		shouldPanic(t, "proxy.maxShardNum", func() {
 			Params.Base.Save("proxy.maxShardNum", "abc")
 			Params.initMaxShardNum()
@@ -260,7 +279,7 @@ func TestComponentParam(t *testing.T) {
 		assert.Equal(t, true, Params.GroupEnabled)
 		assert.Equal(t, int32(10240), Params.MaxReceiveChanSize)
 		assert.Equal(t, int32(10240), Params.MaxUnsolvedQueueSize)
-		assert.Equal(t, int32(runtime.GOMAXPROCS(0)*2), Params.MaxReadConcurrency)
+		assert.Equal(t, int32(runtime.GOMAXPROCS(0)*2), Params.GetMaxReadConcurrency())
 		assert.Equal(t, int64(1000), Params.MaxGroupNQ)
 		assert.Equal(t, 10.0, Params.TopKMergeRatio)
 		assert.Equal(t, 10.0, Params.CPURatio)
@@ -0,0 +1,154 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package paramtable
+
+import (
+	"crypto/subtle"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strings"
+
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/internal/config"
+	"github.com/milvus-io/milvus/internal/log"
+)
+
+const redactedConfigValue = "******"
+
+// configs whose normalized key contains one of these words are redacted and can not be updated by http
+var sensitiveConfigWords = []string{"password", "secret", "accesskey", "token", "credential", "authparams"}
+
+func isSensitiveConfig(key string) bool {
+	realKey := config.FormatKey(key)
+	for _, word := range sensitiveConfigWords {
+		if strings.Contains(realKey, word) {
+			return true
+		}
+	}
+	return false
+}
+
+// ConfigItem is an effective config of the node
+type ConfigItem struct {
+	config.ConfigWithSource
+	Refreshable bool `json:"refreshable"`
+}
+
+// ConfigUpdate is the request body to update a config
+type ConfigUpdate struct {
+	Key   string `json:"key"`
+	Value string `json:"value"`
+}
+
+// ConfigHandler serves the effective configs of the node by GET, the configs can be filtered by the prefix
+// in the query. PUT with a ConfigUpdate body and DELETE with the key in the query write the config to etcd,
+// which propagates the change to all nodes, they are allowed only if common.security.configUpdate is enabled
+// with a token, which requests must carry.
+func (p *ComponentParam) ConfigHandler(w http.ResponseWriter, req *http.Request) {
+	switch req.Method {
+	case http.MethodGet:
+		p.listConfigs(w, req)
+	case http.MethodPut, http.MethodDelete:
+		p.updateConfig(w, req)
+	default:
+		w.WriteHeader(http.StatusMethodNotAllowed)
+	}
+}
+
+func (p *ComponentParam) listConfigs(w http.ResponseWriter, req *http.Request) {
+	prefix := req.URL.Query().Get("prefix")
+	items := make([]ConfigItem, 0)
+	for _, c := range p.ConfigsWithSource() {
+		if !config.HasKeyPrefix(c.Key, prefix) {
+			continue
+		}
+		if isSensitiveConfig(c.Key) {
+			c.Value = redactedConfigValue
+		}
+		items = append(items, ConfigItem{ConfigWithSource: c, Refreshable: p.IsRefreshable(c.Key)})
+	}
+	writeConfigResponse(w, http.StatusOK, map[string]interface{}{"configs": items})
+}
+
+func (p *ComponentParam) updateConfig(w http.ResponseWriter, req *http.Request) {
+	if !p.CommonCfg.ConfigUpdateEnabled {
+		writeConfigError(w, http.StatusForbidden, "config update is disabled, see common.security.configUpdate")
+		return
+	}
+	token := p.CommonCfg.ConfigUpdateToken
+	auth := req.Header.Get("Authorization")
+	if token == "" || subtle.ConstantTimeCompare([]byte(auth), []byte("Bearer "+token)) != 1 {
+		writeConfigError(w, http.StatusUnauthorized, "invalid config update token")
+		return
+	}
+
+	var update ConfigUpdate
+	if req.Method == http.MethodPut {
+		if err := json.NewDecoder(req.Body).Decode(&update); err != nil {
+			writeConfigError(w, http.StatusBadRequest, "invalid request body: "+err.Error())
+			return
+		}
+	} else {
+		update.Key = req.URL.Query().Get("key")
+	}
+	if update.Key == "" {
+		writeConfigError(w, http.StatusBadRequest, "config key is empty")
+		return
+	}
+	if isSensitiveConfig(update.Key) || config.HasKeyPrefix(update.Key, "common.security.") {
+		writeConfigError(w, http.StatusForbidden, "config "+update.Key+" can not be updated by http")
+		return
+	}
+
+	var err error
+	if req.Method == http.MethodPut {
+		err = p.SaveToEtcd(update.Key, update.Value)
+	} else {
+		err = p.RemoveFromEtcd(update.Key)
+	}
+	if err != nil {
+		log.Warn("failed to update config", zap.String("method", req.Method), zap.String("key", update.Key), zap.Error(err))
+		status := http.StatusInternalServerError
+		if errors.Is(err, config.ErrNotWritable) {
+			status = http.StatusServiceUnavailable
+		}
+		writeConfigError(w, status, err.Error())
+		return
+	}
+	log.Info("config updated by http", zap.String("method", req.Method), zap.String("key", update.Key),
+		zap.String("value", update.Value), zap.String("remote", req.RemoteAddr))
+	writeConfigResponse(w, http.StatusOK, map[string]interface{}{
+		"key":         update.Key,
+		"value":       update.Value,
+		"source":      config.EtcdSourceName,
+		"refreshable": p.IsRefreshable(update.Key),
+	})
+}
+
+func writeConfigError(w http.ResponseWriter, status int, msg string) {
+	writeConfigResponse(w, status, map[string]string{"error": msg})
+}
+
+func writeConfigResponse(w http.ResponseWriter, status int, body interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	if err := json.NewEncoder(w).Encode(body); err != nil {
+		log.Warn("failed to write config response", zap.Error(err))
+	}
+}
@@ -0,0 +1,106 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package paramtable
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConfigHandler(t *testing.T) {
+	params := ComponentParam{}
+	params.Init()
+
+	serve := func(method, url, body string, header map[string]string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(method, url, strings.NewReader(body))
+		for k, v := range header {
+			req.Header.Set(k, v)
+		}
+		w := httptest.NewRecorder()
+		params.ConfigHandler(w, req)
+		return w
+	}
+
+	t.Run("test list", func(t *testing.T) {
+		w := serve(http.MethodGet, "/config?prefix=minio.", "", nil)
+		require.Equal(t, http.StatusOK, w.Code)
+		var resp struct {
+			Configs []ConfigItem `json:"configs"`
+		}
+		require.NoError(t, json.NewDecoder(w.Body).Decode(&resp))
+		configs := make(map[string]ConfigItem)
+		for _, c := range resp.Configs {
+			assert.True(t, strings.HasPrefix(c.Key, "minio."), c.Key)
+			configs[c.Key] = c
+		}
+		assert.Equal(t, "9000", configs["minio.port"].Value)
+		assert.Equal(t, redactedConfigValue, configs["minio.secretaccesskey"].Value)
+
+		w = serve(http.MethodGet, "/config?prefix=dataCoord.gc.interval", "", nil)
+		require.NoError(t, json.NewDecoder(w.Body).Decode(&resp))
+		require.Equal(t, 1, len(resp.Configs))
+		assert.True(t, resp.Configs[0].Refreshable)
+	})
+
+	t.Run("test update guards", func(t *testing.T) {
+		body := `{"key":"dataCoord.gc.interval","value":"10"}`
+		w := serve(http.MethodPut, "/config", body, nil)
+		assert.Equal(t, http.StatusForbidden, w.Code)
+
+		// enabling without a token is refused
+		params.Save("common.security.configUpdate.enabled", "true")
+		params.CommonCfg.initConfigUpdate()
+		assert.False(t, params.CommonCfg.ConfigUpdateEnabled)
+		w = serve(http.MethodPut, "/config", body, map[string]string{"Authorization": "Bearer "})
+		assert.Equal(t, http.StatusForbidden, w.Code)
+
+		params.Save("common.security.configUpdate.token", "abc")
+		params.CommonCfg.initConfigUpdate()
+		assert.True(t, params.CommonCfg.ConfigUpdateEnabled)
+		defer func() {
+			params.Remove("common.security.configUpdate.enabled")
+			params.Remove("common.security.configUpdate.token")
+			params.CommonCfg.initConfigUpdate()
+		}()
+
+		w = serve(http.MethodPut, "/config", body, nil)
+		assert.Equal(t, http.StatusUnauthorized, w.Code)
+		w = serve(http.MethodPut, "/config", body, map[string]string{"Authorization": "Bearer abd"})
+		assert.Equal(t, http.StatusUnauthorized, w.Code)
+
+		auth := map[string]string{"Authorization": "Bearer abc"}
+		w = serve(http.MethodPut, "/config", "{", auth)
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		w = serve(http.MethodDelete, "/config", "", auth)
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		w = serve(http.MethodPut, "/config", `{"key":"minio.secretAccessKey","value":"x"}`, auth)
+		assert.Equal(t, http.StatusForbidden, w.Code)
+		w = serve(http.MethodDelete, "/config?key=common.security.authorizationEnabled", "", auth)
+		assert.Equal(t, http.StatusForbidden, w.Code)
+	})
+
+	t.Run("test method not allowed", func(t *testing.T) {
+		w := serve(http.MethodPost, "/config", "", nil)
+		assert.Equal(t, http.StatusMethodNotAllowed, w.Code)
+	})
+}
@@ -20,6 +20,7 @@ import (
 	"fmt"
 	"math"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"go.uber.org/zap"
@@ -42,6 +43,26 @@ const (
 	defaultHighWaterLevel = float64(0.95)
 )
 
+// refreshableQuotaConfig holds the quotaConfig refreshed live, a refresh replaces the whole quotaConfig
+// rather than modifying it, so readers always see a consistent quotaConfig without locking.
+type refreshableQuotaConfig struct {
+	config atomic.Value // *quotaConfig
+}
+
+func (p *refreshableQuotaConfig) init(base *BaseTable) {
+	config := &quotaConfig{}
+	config.init(base)
+	p.config.Store(config)
+}
+
+// Get returns the current quotaConfig, which must not be modified except in tests.
+func (p *refreshableQuotaConfig) Get() *quotaConfig {
+	if config, ok := p.config.Load().(*quotaConfig); ok {
+		return config
+	}
+	return &quotaConfig{}
+}
+
 // quotaConfig is configuration for quota and limitations.
 type quotaConfig struct {
 	Base *BaseTable
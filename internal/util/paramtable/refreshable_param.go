@@ -0,0 +1,96 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package paramtable
+
+import (
+	"strings"
+
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/internal/config"
+	"github.com/milvus-io/milvus/internal/log"
+)
+
+// refreshableParam is applied live by calling refresh when the config of its key changes,
+// a key ending with "." watches all the configs under it
+type refreshableParam struct {
+	ParamItem
+	refresh func()
+}
+
+func (p *ComponentParam) refreshableParams() []refreshableParam {
+	item := func(key string) ParamItem {
+		return ParamItem{Key: key, Refreshable: true}
+	}
+	return []refreshableParam{
+		// quota and limits
+		{item("quotaAndLimits."), func() { p.QuotaConfig.init(&p.BaseTable) }},
+
+		// compaction thresholds
+		{item("dataCoord.compaction.enableAutoCompaction"), p.DataCoordCfg.initEnableAutoCompaction},
+		{item("dataCoord.compaction.min.segment"), p.DataCoordCfg.initCompactionMinSegment},
+		{item("dataCoord.compaction.max.segment"), p.DataCoordCfg.initCompactionMaxSegment},
+		{item("dataCoord.segment.smallProportion"), p.DataCoordCfg.initSegmentSmallProportion},
+		{item("dataCoord.compaction.timeout"), p.DataCoordCfg.initCompactionTimeoutInSeconds},
+		{item("dataCoord.compaction.single.ratio.threshold"), p.DataCoordCfg.initSingleCompactionRatioThreshold},
+		{item("dataCoord.compaction.single.deltalog.maxsize"), p.DataCoordCfg.initSingleCompactionDeltaLogMaxSize},
+		{item("dataCoord.compaction.single.expiredlog.maxsize"), p.DataCoordCfg.initSingleCompactionExpiredLogMaxSize},
+		{item("dataCoord.compaction.single.binlog.maxnum"), p.DataCoordCfg.initSingleCompactionBinlogMaxNum},
+
+		// gc intervals, datacoord watches them to reset the running garbage collector
+		{item("dataCoord.gc.interval"), p.DataCoordCfg.initGCInterval},
+		{item("dataCoord.gc.missingTolerance"), p.DataCoordCfg.initGCMissingTolerance},
+		{item("dataCoord.gc.dropTolerance"), p.DataCoordCfg.initGCDropTolerance},
+		{item("dataCoord.gc.restoreWindow"), p.DataCoordCfg.initGCRestoreWindow},
+
+		// search pool size
+		{item("queryNode.scheduler.maxReadConcurrentRatio"), p.QueryNodeCfg.initMaxReadConcurrency},
+	}
+}
+
+// watchRefreshableParams registers the refreshable params to the config manager, they are registered
+// before any component watcher, so components watching the same keys always see the refreshed values
+func (p *ComponentParam) watchRefreshableParams() {
+	for _, param := range p.refreshableParams() {
+		param := param
+		watcher := func(event *config.Event) {
+			log.Info("refresh param", zap.String("key", param.Key), zap.String("configKey", event.Key),
+				zap.String("eventType", event.EventType), zap.String("value", event.Value))
+			param.refresh()
+		}
+		if strings.HasSuffix(param.Key, ".") {
+			p.WatchKeyPrefix(param.Key, watcher)
+		} else {
+			p.Watch(param.Key, watcher)
+		}
+	}
+}
+
+// IsRefreshable returns whether the config of key is applied without restarting the process
+func (p *ComponentParam) IsRefreshable(key string) bool {
+	formatted := config.FormatKey(key)
+	for _, param := range p.refreshableParams() {
+		if strings.HasSuffix(param.Key, ".") {
+			if config.HasKeyPrefix(key, param.Key) {
+				return true
+			}
+		} else if formatted == config.FormatKey(param.Key) {
+			return true
+		}
+	}
+	return false
+}
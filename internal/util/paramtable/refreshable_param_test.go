@@ -0,0 +1,94 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package paramtable
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus/internal/config"
+)
+
+func TestRefreshableParams(t *testing.T) {
+	params := ComponentParam{}
+	params.Init()
+
+	assert.True(t, params.IsRefreshable("quotaAndLimits.dml.insertRate.max"))
+	assert.True(t, params.IsRefreshable("dataCoord.gc.interval"))
+	assert.True(t, params.IsRefreshable("queryNode.scheduler.maxReadConcurrentRatio"))
+	assert.False(t, params.IsRefreshable("dataCoord.enableCompaction"))
+	assert.False(t, params.IsRefreshable("quotaAndLimitsX.enabled"))
+
+	t.Run("test quota", func(t *testing.T) {
+		assert.False(t, params.QuotaConfig.Get().DMLLimitEnabled)
+		params.Save("quotaAndLimits.dml.enabled", "true")
+		params.Save("quotaAndLimits.dml.insertRate.max", "1")
+		assert.True(t, params.QuotaConfig.Get().DMLLimitEnabled)
+		assert.Equal(t, megaBytes2Bytes(1), params.QuotaConfig.Get().DMLMaxInsertRate)
+
+		params.Remove("quotaAndLimits.dml.enabled")
+		assert.False(t, params.QuotaConfig.Get().DMLLimitEnabled)
+		assert.Equal(t, defaultMax, params.QuotaConfig.Get().DMLMaxInsertRate)
+	})
+
+	t.Run("test compaction and gc", func(t *testing.T) {
+		params.Save("dataCoord.compaction.min.segment", "8")
+		assert.Equal(t, 8, params.DataCoordCfg.GetMinSegmentToMerge())
+		params.Save("dataCoord.compaction.enableAutoCompaction", "false")
+		assert.False(t, params.DataCoordCfg.GetEnableAutoCompaction())
+
+		// component watchers see the refreshed value
+		var interval time.Duration
+		cancel := params.WatchKeyPrefix("dataCoord.gc.", func(*config.Event) {
+			interval = params.DataCoordCfg.GetGCInterval()
+		})
+		params.Save("dataCoord.gc.interval", "10")
+		assert.Equal(t, 10*time.Second, interval)
+		cancel()
+		params.Save("dataCoord.gc.interval", "20")
+		assert.Equal(t, 10*time.Second, interval)
+		assert.Equal(t, 20*time.Second, params.DataCoordCfg.GetGCInterval())
+	})
+
+	t.Run("test search pool", func(t *testing.T) {
+		params.Save("queryNode.scheduler.maxReadConcurrentRatio", "1")
+		concurrency := params.QueryNodeCfg.GetMaxReadConcurrency()
+		params.Save("queryNode.scheduler.maxReadConcurrentRatio", "2")
+		assert.Equal(t, concurrency*2, params.QueryNodeCfg.GetMaxReadConcurrency())
+	})
+
+	t.Run("test read while refreshing", func(t *testing.T) {
+		done := make(chan struct{})
+		go func() {
+			defer close(done)
+			for i := 0; i < 100; i++ {
+				_ = params.QuotaConfig.Get().DMLMaxInsertRate
+				_ = params.DataCoordCfg.GetGCInterval()
+				_ = params.QueryNodeCfg.GetMaxReadConcurrency()
+			}
+		}()
+		for i := 0; i < 10; i++ {
+			params.Save("quotaAndLimits.dml.insertRate.max", fmt.Sprint(i))
+			params.Save("dataCoord.gc.interval", fmt.Sprint(i))
+			params.Save("queryNode.scheduler.maxReadConcurrentRatio", fmt.Sprint(i))
+		}
+		<-done
+	})
+}
@@ -50,6 +50,8 @@ type ServiceParam struct {
 	PulsarCfg       PulsarConfig
 	KafkaCfg        KafkaConfig
 	RocksmqCfg      RocksmqConfig
+	NatsCfg         NatsConfig
+	MemmqCfg        MemmqConfig
 	MinioCfg        MinioConfig
 }
 
@@ -66,6 +68,8 @@ func (p *ServiceParam) Init() {
 	p.PulsarCfg.init(&p.BaseTable)
 	p.KafkaCfg.init(&p.BaseTable)
 	p.RocksmqCfg.init(&p.BaseTable)
+	p.NatsCfg.init(&p.BaseTable)
+	p.MemmqCfg.init(&p.BaseTable)
 	p.MinioCfg.init(&p.BaseTable)
 }
 
@@ -237,6 +241,7 @@ type MetaDBConfig struct {
 	Address      string
 	Port         int
 	DBName       string
+	DriverName   string
 	MaxOpenConns int
 	MaxIdleConns int
 }
@@ -252,6 +257,7 @@ func (p *MetaDBConfig) LoadCfgToMemory() {
 	p.initAddress()
 	p.initPort()
 	p.initDbName()
+	p.initDriverName()
 	p.initMaxOpenConns()
 	p.initMaxIdleConns()
 }
@@ -293,6 +299,11 @@ func (p *MetaDBConfig) initDbName() {
 	p.DBName = dbName
 }
 
+// initDriverName inits the dialect of the meta db, the dbName is the path of the database file for sqlite
+func (p *MetaDBConfig) initDriverName() {
+	p.DriverName = p.Base.LoadWithDefault("mysql.driverName", util.MetaDBDriverMysql)
+}
+
 func (p *MetaDBConfig) initMaxOpenConns() {
 	maxOpenConns := p.Base.ParseIntWithDefault("mysql.maxOpenConns", 20)
 	p.MaxOpenConns = maxOpenConns
@@ -475,6 +486,60 @@ func (p *RocksmqConfig) initPath() {
 	p.Path = p.Base.LoadWithDefault("rocksmq.path", "")
 }
 
+// /////////////////////////////////////////////////////////////////////////////
+// --- nats ---
+type NatsConfig struct {
+	Base *BaseTable
+
+	Address                string
+	RetentionTimeInMinutes int64
+}
+
+func (p *NatsConfig) init(base *BaseTable) {
+	p.Base = base
+
+	p.initAddress()
+	p.initRetentionTimeInMinutes()
+}
+
+func (p *NatsConfig) initAddress() {
+	p.Address = p.Base.LoadWithDefault("nats.address", "")
+}
+
+func (p *NatsConfig) initRetentionTimeInMinutes() {
+	p.RetentionTimeInMinutes = p.Base.ParseInt64WithDefault("nats.retentionTimeInMinutes", 0)
+}
+
+// /////////////////////////////////////////////////////////////////////////////
+// --- memmq ---
+type MemmqConfig struct {
+	Base *BaseTable
+
+	Enable                 bool
+	RetentionTimeInMinutes int64
+	RetentionSizeInMB      int64
+}
+
+func (p *MemmqConfig) init(base *BaseTable) {
+	p.Base = base
+
+	p.initEnable()
+	p.initRetentionTimeInMinutes()
+	p.initRetentionSizeInMB()
+}
+
+func (p *MemmqConfig) initEnable() {
+	p.Enable = p.Base.ParseBool("memmq.enable", false)
+}
+
+func (p *MemmqConfig) initRetentionTimeInMinutes() {
+	p.RetentionTimeInMinutes = p.Base.ParseInt64WithDefault("memmq.retentionTimeInMinutes", 0)
+}
+
+func (p *MemmqConfig) initRetentionSizeInMB() {
+	p.RetentionSizeInMB = p.Base.ParseInt64WithDefault("memmq.retentionSizeInMB", 0)
+}
+
 // /////////////////////////////////////////////////////////////////////////////
 // --- minio ---
 type MinioConfig struct {
@@ -27,6 +27,26 @@ import (
 	"go.uber.org/zap"
 )
 
+// DataTypeJSON is the data type of a field holding json documents. It's not defined by the pinned
+// milvus-proto, the value follows the numbering of upstream so that persisted schemas stay compatible
+// once milvus-proto is bumped. Use GetDataTypeName instead of String() to print it.
+// Rows of a json field are carried by schemapb.ScalarField_BytesData, each row a serialized json document.
+const DataTypeJSON schemapb.DataType = 23
+
+// DataTypeArray is the data type of a field holding an array of scalars per row, it's not defined by
+// the pinned milvus-proto either. The type of the elements is kept in the ElementTypeKey type param of the field.
+// Rows of an array field are carried by schemapb.ScalarField_BytesData, each row a serialized schemapb.ScalarField.
+const DataTypeArray schemapb.DataType = 22
+
+// ElementTypeKey is the key of the type param holding the element type name of an array field, e.g. "Int64".
+const ElementTypeKey = "element_type"
+
+// jsonFieldEstimatedSize is the estimated size of a json row, json fields don't have a max length.
+const jsonFieldEstimatedSize = 256
+
+// arrayFieldEstimatedSize is the estimated size of an array row, array fields don't have a max capacity.
+const arrayFieldEstimatedSize = 256
+
 func GetAvgLengthOfVarLengthField(fieldSchema *schemapb.FieldSchema) (int, error) {
 	maxLength := 0
 	var err error
@@ -79,6 +99,10 @@ func EstimateSizePerRecord(schema *schemapb.CollectionSchema) (int, error) {
 				return 0, err
 			}
 			res += maxLengthPerRow
+		case DataTypeJSON:
+			res += jsonFieldEstimatedSize
+		case DataTypeArray:
+			res += arrayFieldEstimatedSize
 		case schemapb.DataType_BinaryVector:
 			for _, kv := range fs.TypeParams {
 				if kv.Key == "dim" {
@@ -124,6 +148,11 @@ func EstimateEntitySize(fieldsData []*schemapb.FieldData, rowOffset int) (int, e
 			}
 			//TODO:: check len(varChar) <= maxLengthPerRow
 			res += len(fs.GetScalars().GetStringData().Data[rowOffset])
+		case DataTypeJSON, DataTypeArray:
+			if rowOffset >= len(fs.GetScalars().GetBytesData().GetData()) {
+				return 0, fmt.Errorf("offset out range of field datas")
+			}
+			res += len(fs.GetScalars().GetBytesData().Data[rowOffset])
 		case schemapb.DataType_BinaryVector:
 			res += int(fs.GetVectors().GetDim())
 		case schemapb.DataType_FloatVector:
@@ -269,6 +298,51 @@ func IsStringType(dataType schemapb.DataType) bool {
 	}
 }
 
+// IsJSONType returns true if input is a json type, otherwise false
+func IsJSONType(dataType schemapb.DataType) bool {
+	return dataType == DataTypeJSON
+}
+
+// GetDataTypeName returns the name of the data type, it knows the types not defined by milvus-proto yet.
+func GetDataTypeName(dataType schemapb.DataType) (string, bool) {
+	if IsJSONType(dataType) {
+		return "JSON", true
+	}
+	if IsArrayType(dataType) {
+		return "Array", true
+	}
+	name, ok := schemapb.DataType_name[int32(dataType)]
+	return name, ok
+}
+
+// IsArrayType returns true if input is an array type, otherwise false
+func IsArrayType(dataType schemapb.DataType) bool {
+	return dataType == DataTypeArray
+}
+
+// GetElementType returns the element type of an array field, only bool, integer, floating
+// and varchar elements are supported.
+func GetElementType(fieldSchema *schemapb.FieldSchema) (schemapb.DataType, error) {
+	if !IsArrayType(fieldSchema.GetDataType()) {
+		return schemapb.DataType_None, fmt.Errorf("field %s is not an array field", fieldSchema.GetName())
+	}
+	for _, kv := range fieldSchema.GetTypeParams() {
+		if kv.GetKey() != ElementTypeKey {
+			continue
+		}
+		value, ok := schemapb.DataType_value[kv.GetValue()]
+		if !ok {
+			return schemapb.DataType_None, fmt.Errorf("unknown element type %s of array field %s", kv.GetValue(), fieldSchema.GetName())
+		}
+		elementType := schemapb.DataType(value)
+		if !IsBoolType(elementType) && !IsArithmetic(elementType) && elementType != schemapb.DataType_VarChar {
+			return schemapb.DataType_None, fmt.Errorf("element type %s of array field %s is not supported", kv.GetValue(), fieldSchema.GetName())
+		}
+		return elementType, nil
+	}
+	return schemapb.DataType_None, fmt.Errorf("the %s was not specified, field name is %s", ElementTypeKey, fieldSchema.GetName())
+}
+
 // AppendFieldData appends fields data of specified index from src to dst
 func AppendFieldData(dst []*schemapb.FieldData, src []*schemapb.FieldData, idx int64) {
 	for i, fieldData := range src {
@@ -346,6 +420,16 @@ func AppendFieldData(dst []*schemapb.FieldData, src []*schemapb.FieldData, idx i
 				} else {
 					dstScalar.GetStringData().Data = append(dstScalar.GetStringData().Data, srcScalar.StringData.Data[idx])
 				}
+			case *schemapb.ScalarField_BytesData:
+				if dstScalar.GetBytesData() == nil {
+					dstScalar.Data = &schemapb.ScalarField_BytesData{
+						BytesData: &schemapb.BytesArray{
+							Data: [][]byte{srcScalar.BytesData.Data[idx]},
+						},
+					}
+				} else {
+					dstScalar.GetBytesData().Data = append(dstScalar.GetBytesData().Data, srcScalar.BytesData.Data[idx])
+				}
 			default:
 				log.Error("Not supported field type", zap.String("field type", fieldData.Type.String()))
 			}
@@ -478,6 +562,16 @@ func MergeFieldData(dst []*schemapb.FieldData, src []*schemapb.FieldData) {
 				} else {
 					dstScalar.GetStringData().Data = append(dstScalar.GetStringData().Data, srcScalar.StringData.Data...)
 				}
+			case *schemapb.ScalarField_BytesData:
+				if dstScalar.GetBytesData() == nil {
+					dstScalar.Data = &schemapb.ScalarField_BytesData{
+						BytesData: &schemapb.BytesArray{
+							Data: srcScalar.BytesData.Data,
+						},
+					}
+				} else {
+					dstScalar.GetBytesData().Data = append(dstScalar.GetBytesData().Data, srcScalar.BytesData.Data...)
+				}
 			default:
 				log.Error("Not supported field type", zap.String("field type", srcFieldData.Type.String()))
 			}
@@ -600,7 +694,7 @@ func AppendIDs(dst *schemapb.IDs, src *schemapb.IDs, idx int) {
 
 func GetSizeOfIDs(data *schemapb.IDs) int {
 	result := 0
-	if data.IdField == nil {
+	if data.GetIdField() == nil {
 		return result
 	}
 
@@ -637,6 +731,42 @@ func GetPK(data *schemapb.IDs, idx int64) interface{} {
 	return nil
 }
 
+// GetScalarData returns the idx-th value of a scalar field data, nil is returned if the type is not supported.
+// The returned value is comparable, so that it can be used as a map key.
+func GetScalarData(field *schemapb.FieldData, idx int64) interface{} {
+	scalars := field.GetScalars()
+	if scalars == nil {
+		return nil
+	}
+	switch data := scalars.GetData().(type) {
+	case *schemapb.ScalarField_BoolData:
+		if idx < int64(len(data.BoolData.GetData())) {
+			return data.BoolData.GetData()[idx]
+		}
+	case *schemapb.ScalarField_IntData:
+		if idx < int64(len(data.IntData.GetData())) {
+			return data.IntData.GetData()[idx]
+		}
+	case *schemapb.ScalarField_LongData:
+		if idx < int64(len(data.LongData.GetData())) {
+			return data.LongData.GetData()[idx]
+		}
+	case *schemapb.ScalarField_FloatData:
+		if idx < int64(len(data.FloatData.GetData())) {
+			return data.FloatData.GetData()[idx]
+		}
+	case *schemapb.ScalarField_DoubleData:
+		if idx < int64(len(data.DoubleData.GetData())) {
+			return data.DoubleData.GetData()[idx]
+		}
+	case *schemapb.ScalarField_StringData:
+		if idx < int64(len(data.StringData.GetData())) {
+			return data.StringData.GetData()[idx]
+		}
+	}
+	return nil
+}
+
 func AppendPKs(pks *schemapb.IDs, pk interface{}) {
 	switch realPK := pk.(type) {
 	case int64:
@@ -541,6 +541,52 @@ func TestGetPrimaryFieldSchema(t *testing.T) {
 	assert.Equal(t, schemapb.DataType_Int64, primaryField.DataType)
 }
 
+func TestGetDataTypeName(t *testing.T) {
+	name, ok := GetDataTypeName(DataTypeJSON)
+	assert.True(t, ok)
+	assert.Equal(t, "JSON", name)
+	_, ok = schemapb.DataType_value["JSON"]
+	assert.False(t, ok)
+
+	name, ok = GetDataTypeName(DataTypeArray)
+	assert.True(t, ok)
+	assert.Equal(t, "Array", name)
+
+	name, ok = GetDataTypeName(schemapb.DataType_Int64)
+	assert.True(t, ok)
+	assert.Equal(t, "Int64", name)
+
+	_, ok = GetDataTypeName(schemapb.DataType(1000))
+	assert.False(t, ok)
+}
+
+func TestGetElementType(t *testing.T) {
+	newArrayField := func(params ...*commonpb.KeyValuePair) *schemapb.FieldSchema {
+		return &schemapb.FieldSchema{
+			FieldID:    100,
+			Name:       "tags",
+			DataType:   DataTypeArray,
+			TypeParams: params,
+		}
+	}
+
+	elementType, err := GetElementType(newArrayField(&commonpb.KeyValuePair{Key: ElementTypeKey, Value: "VarChar"}))
+	assert.NoError(t, err)
+	assert.Equal(t, schemapb.DataType_VarChar, elementType)
+
+	_, err = GetElementType(newArrayField())
+	assert.Error(t, err)
+
+	_, err = GetElementType(newArrayField(&commonpb.KeyValuePair{Key: ElementTypeKey, Value: "Unknown"}))
+	assert.Error(t, err)
+
+	_, err = GetElementType(newArrayField(&commonpb.KeyValuePair{Key: ElementTypeKey, Value: "FloatVector"}))
+	assert.Error(t, err)
+
+	_, err = GetElementType(&schemapb.FieldSchema{Name: "a", DataType: schemapb.DataType_Int64})
+	assert.Error(t, err)
+}
+
 func TestGetPK(t *testing.T) {
 	type args struct {
 		data *schemapb.IDs
@@ -600,6 +646,36 @@ func TestGetPK(t *testing.T) {
 	}
 }
 
+func TestGetScalarData(t *testing.T) {
+	fields := []*schemapb.FieldData{
+		genFieldData("bool", 100, schemapb.DataType_Bool, []bool{true, false}, 1),
+		genFieldData("int32", 101, schemapb.DataType_Int32, []int32{1, 2}, 1),
+		genFieldData("int64", 102, schemapb.DataType_Int64, []int64{1, 2}, 1),
+		genFieldData("float", 103, schemapb.DataType_Float, []float32{1.0, 2.0}, 1),
+		genFieldData("double", 104, schemapb.DataType_Double, []float64{1.0, 2.0}, 1),
+		{
+			Type:      schemapb.DataType_VarChar,
+			FieldName: "varchar",
+			FieldId:   105,
+			Field: &schemapb.FieldData_Scalars{
+				Scalars: &schemapb.ScalarField{
+					Data: &schemapb.ScalarField_StringData{
+						StringData: &schemapb.StringArray{Data: []string{"a", "b"}},
+					},
+				},
+			},
+		},
+	}
+	expected := []interface{}{false, int32(2), int64(2), float32(2.0), float64(2.0), "b"}
+	for i, field := range fields {
+		assert.Equal(t, expected[i], GetScalarData(field, 1))
+		assert.Nil(t, GetScalarData(field, 2))
+	}
+
+	vector := genFieldData("vector", 106, schemapb.DataType_FloatVector, []float32{1.0, 2.0}, 2)
+	assert.Nil(t, GetScalarData(vector, 0))
+}
+
 func TestAppendPKs(t *testing.T) {
 	intPks := &schemapb.IDs{}
 	AppendPKs(intPks, int64(1))
@@ -7,6 +7,7 @@ CREATE DATABASE if not exists milvus_meta CHARACTER SET utf8mb4;
  Notices:
     1. id, tenant_id, is_deleted, created_at, updated_at are 5 common columns for all collections.
     2. Query index in community version CANNOT includes tenant_id, since tenant_id is not existed and will miss query index.
+    3. Tables created by an earlier version of this script are upgraded by upgrade_meta.sql, see the notes in it.
  */
 
 -- collections
@@ -151,10 +152,12 @@ CREATE TABLE if not exists milvus_meta.segments (
     segment_state TINYINT UNSIGNED NOT NULL,
     last_expire_time bigint unsigned COMMENT 'segment assignment expiration time',
     dropped_at bigint unsigned,
-    is_deleted BOOL DEFAULT FALSE,
+    is_importing BOOL DEFAULT FALSE,
+    is_fake BOOL DEFAULT FALSE,
     created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
     updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP on update current_timestamp,
     PRIMARY KEY (id),
+    UNIQUE KEY uk_tenant_id_segment_id (tenant_id, segment_id),
     INDEX idx_tenant_id_collection_id_segment_id (tenant_id, collection_id, segment_id)
 ) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
 
@@ -182,24 +185,89 @@ CREATE TABLE if not exists milvus_meta.segment_indexes (
     INDEX idx_tenant_id_collection_id_segment_id_index_id (tenant_id, collection_id, segment_id, index_id)
 ) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
 
--- binlog files info
-CREATE TABLE if not exists milvus_meta.binlogs (
+-- binlog files info, binlogs of a field are kept in a row
+CREATE TABLE if not exists milvus_meta.segment_binlogs (
     id     BIGINT NOT NULL AUTO_INCREMENT,
     tenant_id VARCHAR(128) DEFAULT NULL,
-    field_id BIGINT NOT NULL,
+    collection_id BIGINT NOT NULL,
+    partition_id BIGINT NOT NULL,
     segment_id BIGINT NOT NULL,
+    field_id BIGINT NOT NULL,
+    binlog_type SMALLINT UNSIGNED NOT NULL COMMENT 'binlog、delta binlog、stats binlog',
+    binlogs MEDIUMTEXT NOT NULL COMMENT 'log id, entries, timestamps, path and size of binlogs',
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP on update current_timestamp,
+    PRIMARY KEY (id),
+    UNIQUE KEY uk_tenant_id_segment_id_field_id_binlog_type (tenant_id, segment_id, field_id, binlog_type)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+-- channel checkpoints
+CREATE TABLE if not exists milvus_meta.channel_checkpoints (
+    id     BIGINT NOT NULL AUTO_INCREMENT,
+    tenant_id VARCHAR(128) DEFAULT NULL,
+    vchannel VARCHAR(128) NOT NULL,
+    channel_name VARCHAR(128) NOT NULL,
+    msg_id VARBINARY(256),
+    msg_group VARCHAR(128),
+    `timestamp` bigint unsigned,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP on update current_timestamp,
+    PRIMARY KEY (id),
+    UNIQUE KEY uk_tenant_id_vchannel (tenant_id, vchannel)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+-- dropped channels whose segments are not yet cleaned
+CREATE TABLE if not exists milvus_meta.dropped_channels (
+    id     BIGINT NOT NULL AUTO_INCREMENT,
+    tenant_id VARCHAR(128) DEFAULT NULL,
+    channel VARCHAR(128) NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP on update current_timestamp,
+    PRIMARY KEY (id),
+    UNIQUE KEY uk_tenant_id_channel (tenant_id, channel)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+-- collection load info of query coord
+CREATE TABLE if not exists milvus_meta.collection_load_infos (
+    id     BIGINT NOT NULL AUTO_INCREMENT,
+    tenant_id VARCHAR(128) DEFAULT NULL,
     collection_id BIGINT NOT NULL,
-    log_type SMALLINT UNSIGNED NOT NULL COMMENT 'binlog、stats binlog、delta binlog',
-    num_entries BIGINT,
-    timestamp_from BIGINT UNSIGNED,
-    timestamp_to BIGINT UNSIGNED,
-    log_path VARCHAR(256) NOT NULL,
-    log_size BIGINT NOT NULL,
-    is_deleted BOOL DEFAULT FALSE,
+    released_partitions VARCHAR(4096),
+    replica_number INT NOT NULL,
+    status INT NOT NULL,
+    field_index_id VARCHAR(4096),
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP on update current_timestamp,
+    PRIMARY KEY (id),
+    UNIQUE KEY uk_tenant_id_collection_id (tenant_id, collection_id)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+-- partition load info of query coord
+CREATE TABLE if not exists milvus_meta.partition_load_infos (
+    id     BIGINT NOT NULL AUTO_INCREMENT,
+    tenant_id VARCHAR(128) DEFAULT NULL,
+    collection_id BIGINT NOT NULL,
+    partition_id BIGINT NOT NULL,
+    replica_number INT NOT NULL,
+    status INT NOT NULL,
+    field_index_id VARCHAR(4096),
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP on update current_timestamp,
+    PRIMARY KEY (id),
+    UNIQUE KEY uk_tenant_id_collection_id_partition_id (tenant_id, collection_id, partition_id)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+-- replicas of query coord
+CREATE TABLE if not exists milvus_meta.replicas (
+    id     BIGINT NOT NULL AUTO_INCREMENT,
+    tenant_id VARCHAR(128) DEFAULT NULL,
+    collection_id BIGINT NOT NULL,
+    replica_id BIGINT NOT NULL,
+    nodes TEXT,
     created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
     updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP on update current_timestamp,
     PRIMARY KEY (id),
-    INDEX idx_tenant_id_segment_id_log_type (tenant_id, segment_id, log_type)
+    UNIQUE KEY uk_tenant_id_collection_id_replica_id (tenant_id, collection_id, replica_id)
 ) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
 
 -- users
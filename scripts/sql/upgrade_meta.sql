@@ -0,0 +1,47 @@
+/*
+ upgrade script of the tables created by an earlier meta.sql
+
+ Upgrade notes:
+    1. Stop milvus and back up the database before upgrading, e.g. mysqldump milvus_meta > milvus_meta.sql.
+    2. Run meta.sql first, it creates the tables added since the last version (segment_binlogs, channel_checkpoints,
+       dropped_channels, collection_load_infos, partition_load_infos and replicas) and leaves existing tables alone.
+    3. Run this script once, it changes the segments table and moves the rows of binlogs into segment_binlogs.
+       MySQL 5.7.22 or later is required for JSON_ARRAYAGG.
+    4. Changes of the tables:
+        segments: soft deleted rows are removed and is_deleted is dropped, since dropped segments are deleted from
+            the table once they are recycled; is_importing and is_fake are added; uk_tenant_id_segment_id is added,
+            the duplicate rows listed by the check query below must be removed before upgrading.
+        binlogs: replaced by segment_binlogs, which keeps binlogs of a field in a row as a JSON array of
+            {entries_num, timestamp_from, timestamp_to, log_path, log_size}.
+            log_type of binlogs is 1 for insert binlog, 2 for stats binlog and 3 for delta binlog,
+            binlog_type of segment_binlogs is 1 for insert binlog, 2 for delta binlog and 3 for stats binlog.
+ */
+
+-- check query, the upgrade fails on adding uk_tenant_id_segment_id if any row is returned
+-- SELECT tenant_id, segment_id, COUNT(*) FROM milvus_meta.segments WHERE is_deleted = FALSE GROUP BY tenant_id, segment_id HAVING COUNT(*) > 1;
+
+-- segments
+DELETE FROM milvus_meta.segments WHERE is_deleted = TRUE;
+
+ALTER TABLE milvus_meta.segments
+    DROP COLUMN is_deleted,
+    ADD COLUMN is_importing BOOL DEFAULT FALSE AFTER dropped_at,
+    ADD COLUMN is_fake BOOL DEFAULT FALSE AFTER is_importing,
+    ADD UNIQUE KEY uk_tenant_id_segment_id (tenant_id, segment_id);
+
+-- binlogs to segment_binlogs
+INSERT INTO milvus_meta.segment_binlogs (tenant_id, collection_id, partition_id, segment_id, field_id, binlog_type, binlogs)
+SELECT b.tenant_id, b.collection_id, s.partition_id, b.segment_id, b.field_id,
+    CASE b.log_type WHEN 2 THEN 3 WHEN 3 THEN 2 ELSE 1 END,
+    JSON_ARRAYAGG(JSON_OBJECT(
+        'entries_num', b.num_entries,
+        'timestamp_from', b.timestamp_from,
+        'timestamp_to', b.timestamp_to,
+        'log_path', b.log_path,
+        'log_size', b.log_size))
+FROM milvus_meta.binlogs b
+    JOIN milvus_meta.segments s ON s.tenant_id <=> b.tenant_id AND s.segment_id = b.segment_id
+WHERE b.is_deleted = FALSE
+GROUP BY b.tenant_id, b.collection_id, s.partition_id, b.segment_id, b.field_id, b.log_type;
+
+DROP TABLE milvus_meta.binlogs;